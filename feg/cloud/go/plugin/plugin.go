@@ -15,6 +15,7 @@ import (
 	"magma/feg/cloud/go/feg"
 	fegconfig "magma/feg/cloud/go/services/controller/config"
 	fegh "magma/feg/cloud/go/services/controller/obsidian/handlers"
+	"magma/orc8r/cloud/go/backup"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/registry"
@@ -68,3 +69,7 @@ func (*FegOrchestratorPlugin) GetObsidianHandlers(metricsConfig *srvconfig.Confi
 func (*FegOrchestratorPlugin) GetStreamerProviders() []providers.StreamProvider {
 	return []providers.StreamProvider{}
 }
+
+func (*FegOrchestratorPlugin) GetBackupSections() []backup.Section {
+	return []backup.Section{}
+}
@@ -117,7 +117,7 @@ func (x ErrorCode) String() string {
 	return proto.EnumName(ErrorCode_name, int32(x))
 }
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{0}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{0}
 }
 
 // Network Access Mode AVP (Section 7.3.21)
@@ -144,7 +144,7 @@ func (x UpdateLocationAnswer_NetworkAccessMode) String() string {
 	return proto.EnumName(UpdateLocationAnswer_NetworkAccessMode_name, int32(x))
 }
 func (UpdateLocationAnswer_NetworkAccessMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 0}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{3, 0}
 }
 
 type UpdateLocationAnswer_APNConfiguration_PDNType int32
@@ -173,7 +173,7 @@ func (x UpdateLocationAnswer_APNConfiguration_PDNType) String() string {
 	return proto.EnumName(UpdateLocationAnswer_APNConfiguration_PDNType_name, int32(x))
 }
 func (UpdateLocationAnswer_APNConfiguration_PDNType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 0, 0}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{3, 0, 0}
 }
 
 type CancelLocationRequest_CancellationType int32
@@ -205,7 +205,7 @@ func (x CancelLocationRequest_CancellationType) String() string {
 	return proto.EnumName(CancelLocationRequest_CancellationType_name, int32(x))
 }
 func (CancelLocationRequest_CancellationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{4, 0}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{4, 0}
 }
 
 // Authentication Information Request (Section 7.2.5)
@@ -219,17 +219,19 @@ type AuthenticationInformationRequest struct {
 	// Indicates to the HSS the values are requested for immediate attach
 	ImmediateResponsePreferred bool `protobuf:"varint,4,opt,name=immediate_response_preferred,json=immediateResponsePreferred,proto3" json:"immediate_response_preferred,omitempty"`
 	// Concatenation of RAND and AUTS in the case of a resync attach case
-	ResyncInfo           []byte   `protobuf:"bytes,5,opt,name=resync_info,json=resyncInfo,proto3" json:"resync_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ResyncInfo []byte `protobuf:"bytes,5,opt,name=resync_info,json=resyncInfo,proto3" json:"resync_info,omitempty"`
+	// Number of GERAN vectors to request in response
+	NumRequestedGeranVectors uint32   `protobuf:"varint,6,opt,name=num_requested_geran_vectors,json=numRequestedGeranVectors,proto3" json:"num_requested_geran_vectors,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *AuthenticationInformationRequest) Reset()         { *m = AuthenticationInformationRequest{} }
 func (m *AuthenticationInformationRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticationInformationRequest) ProtoMessage()    {}
 func (*AuthenticationInformationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{0}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{0}
 }
 func (m *AuthenticationInformationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationInformationRequest.Unmarshal(m, b)
@@ -284,22 +286,31 @@ func (m *AuthenticationInformationRequest) GetResyncInfo() []byte {
 	return nil
 }
 
+func (m *AuthenticationInformationRequest) GetNumRequestedGeranVectors() uint32 {
+	if m != nil {
+		return m.NumRequestedGeranVectors
+	}
+	return 0
+}
+
 // Authentication Information Answer (Section 7.2.6)
 type AuthenticationInformationAnswer struct {
 	// EPC error code on failure
 	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
 	// Authentication vectors matching the requested number
-	EutranVectors        []*AuthenticationInformationAnswer_EUTRANVector `protobuf:"bytes,2,rep,name=eutran_vectors,json=eutranVectors,proto3" json:"eutran_vectors,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                        `json:"-"`
-	XXX_unrecognized     []byte                                          `json:"-"`
-	XXX_sizecache        int32                                           `json:"-"`
+	EutranVectors []*AuthenticationInformationAnswer_EUTRANVector `protobuf:"bytes,2,rep,name=eutran_vectors,json=eutranVectors,proto3" json:"eutran_vectors,omitempty"`
+	// GERAN authentication vectors matching the requested number
+	GeranVectors         []*AuthenticationInformationAnswer_GERANVector `protobuf:"bytes,3,rep,name=geran_vectors,json=geranVectors,proto3" json:"geran_vectors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
 }
 
 func (m *AuthenticationInformationAnswer) Reset()         { *m = AuthenticationInformationAnswer{} }
 func (m *AuthenticationInformationAnswer) String() string { return proto.CompactTextString(m) }
 func (*AuthenticationInformationAnswer) ProtoMessage()    {}
 func (*AuthenticationInformationAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{1}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{1}
 }
 func (m *AuthenticationInformationAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationInformationAnswer.Unmarshal(m, b)
@@ -333,6 +344,13 @@ func (m *AuthenticationInformationAnswer) GetEutranVectors() []*AuthenticationIn
 	return nil
 }
 
+func (m *AuthenticationInformationAnswer) GetGeranVectors() []*AuthenticationInformationAnswer_GERANVector {
+	if m != nil {
+		return m.GeranVectors
+	}
+	return nil
+}
+
 // For details about fields read 3GPP 33.401
 type AuthenticationInformationAnswer_EUTRANVector struct {
 	Rand                 []byte   `protobuf:"bytes,1,opt,name=rand,proto3" json:"rand,omitempty"`
@@ -352,7 +370,7 @@ func (m *AuthenticationInformationAnswer_EUTRANVector) String() string {
 }
 func (*AuthenticationInformationAnswer_EUTRANVector) ProtoMessage() {}
 func (*AuthenticationInformationAnswer_EUTRANVector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{1, 0}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{1, 0}
 }
 func (m *AuthenticationInformationAnswer_EUTRANVector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationInformationAnswer_EUTRANVector.Unmarshal(m, b)
@@ -400,6 +418,65 @@ func (m *AuthenticationInformationAnswer_EUTRANVector) GetKasme() []byte {
 	return nil
 }
 
+// For details about fields read 3GPP 43.020
+type AuthenticationInformationAnswer_GERANVector struct {
+	Rand                 []byte   `protobuf:"bytes,1,opt,name=rand,proto3" json:"rand,omitempty"`
+	Sres                 []byte   `protobuf:"bytes,2,opt,name=sres,proto3" json:"sres,omitempty"`
+	Kc                   []byte   `protobuf:"bytes,3,opt,name=kc,proto3" json:"kc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthenticationInformationAnswer_GERANVector) Reset() {
+	*m = AuthenticationInformationAnswer_GERANVector{}
+}
+func (m *AuthenticationInformationAnswer_GERANVector) String() string {
+	return proto.CompactTextString(m)
+}
+func (*AuthenticationInformationAnswer_GERANVector) ProtoMessage() {}
+func (*AuthenticationInformationAnswer_GERANVector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{1, 1}
+}
+func (m *AuthenticationInformationAnswer_GERANVector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthenticationInformationAnswer_GERANVector.Unmarshal(m, b)
+}
+func (m *AuthenticationInformationAnswer_GERANVector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthenticationInformationAnswer_GERANVector.Marshal(b, m, deterministic)
+}
+func (dst *AuthenticationInformationAnswer_GERANVector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticationInformationAnswer_GERANVector.Merge(dst, src)
+}
+func (m *AuthenticationInformationAnswer_GERANVector) XXX_Size() int {
+	return xxx_messageInfo_AuthenticationInformationAnswer_GERANVector.Size(m)
+}
+func (m *AuthenticationInformationAnswer_GERANVector) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticationInformationAnswer_GERANVector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticationInformationAnswer_GERANVector proto.InternalMessageInfo
+
+func (m *AuthenticationInformationAnswer_GERANVector) GetRand() []byte {
+	if m != nil {
+		return m.Rand
+	}
+	return nil
+}
+
+func (m *AuthenticationInformationAnswer_GERANVector) GetSres() []byte {
+	if m != nil {
+		return m.Sres
+	}
+	return nil
+}
+
+func (m *AuthenticationInformationAnswer_GERANVector) GetKc() []byte {
+	if m != nil {
+		return m.Kc
+	}
+	return nil
+}
+
 // Update Location Request (Section 7.2.3)
 type UpdateLocationRequest struct {
 	// Subscriber identifier
@@ -420,7 +497,7 @@ func (m *UpdateLocationRequest) Reset()         { *m = UpdateLocationRequest{} }
 func (m *UpdateLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateLocationRequest) ProtoMessage()    {}
 func (*UpdateLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{2}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{2}
 }
 func (m *UpdateLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationRequest.Unmarshal(m, b)
@@ -491,7 +568,7 @@ func (m *UpdateLocationAnswer) Reset()         { *m = UpdateLocationAnswer{} }
 func (m *UpdateLocationAnswer) String() string { return proto.CompactTextString(m) }
 func (*UpdateLocationAnswer) ProtoMessage()    {}
 func (*UpdateLocationAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{3}
 }
 func (m *UpdateLocationAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationAnswer.Unmarshal(m, b)
@@ -579,7 +656,7 @@ func (m *UpdateLocationAnswer_APNConfiguration) Reset()         { *m = UpdateLoc
 func (m *UpdateLocationAnswer_APNConfiguration) String() string { return proto.CompactTextString(m) }
 func (*UpdateLocationAnswer_APNConfiguration) ProtoMessage()    {}
 func (*UpdateLocationAnswer_APNConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 0}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{3, 0}
 }
 func (m *UpdateLocationAnswer_APNConfiguration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationAnswer_APNConfiguration.Unmarshal(m, b)
@@ -653,7 +730,7 @@ func (m *UpdateLocationAnswer_APNConfiguration_QoSProfile) String() string {
 }
 func (*UpdateLocationAnswer_APNConfiguration_QoSProfile) ProtoMessage() {}
 func (*UpdateLocationAnswer_APNConfiguration_QoSProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 0, 0}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{3, 0, 0}
 }
 func (m *UpdateLocationAnswer_APNConfiguration_QoSProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationAnswer_APNConfiguration_QoSProfile.Unmarshal(m, b)
@@ -719,7 +796,7 @@ func (m *UpdateLocationAnswer_AggregatedMaximumBitrate) String() string {
 }
 func (*UpdateLocationAnswer_AggregatedMaximumBitrate) ProtoMessage() {}
 func (*UpdateLocationAnswer_AggregatedMaximumBitrate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 1}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{3, 1}
 }
 func (m *UpdateLocationAnswer_AggregatedMaximumBitrate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationAnswer_AggregatedMaximumBitrate.Unmarshal(m, b)
@@ -768,7 +845,7 @@ func (m *CancelLocationRequest) Reset()         { *m = CancelLocationRequest{} }
 func (m *CancelLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelLocationRequest) ProtoMessage()    {}
 func (*CancelLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{4}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{4}
 }
 func (m *CancelLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelLocationRequest.Unmarshal(m, b)
@@ -815,7 +892,7 @@ func (m *CancelLocationAnswer) Reset()         { *m = CancelLocationAnswer{} }
 func (m *CancelLocationAnswer) String() string { return proto.CompactTextString(m) }
 func (*CancelLocationAnswer) ProtoMessage()    {}
 func (*CancelLocationAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{5}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{5}
 }
 func (m *CancelLocationAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelLocationAnswer.Unmarshal(m, b)
@@ -855,7 +932,7 @@ func (m *PurgeUERequest) Reset()         { *m = PurgeUERequest{} }
 func (m *PurgeUERequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeUERequest) ProtoMessage()    {}
 func (*PurgeUERequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{6}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{6}
 }
 func (m *PurgeUERequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurgeUERequest.Unmarshal(m, b)
@@ -895,7 +972,7 @@ func (m *PurgeUEAnswer) Reset()         { *m = PurgeUEAnswer{} }
 func (m *PurgeUEAnswer) String() string { return proto.CompactTextString(m) }
 func (*PurgeUEAnswer) ProtoMessage()    {}
 func (*PurgeUEAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{7}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{7}
 }
 func (m *PurgeUEAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurgeUEAnswer.Unmarshal(m, b)
@@ -935,7 +1012,7 @@ func (m *ResetRequest) Reset()         { *m = ResetRequest{} }
 func (m *ResetRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetRequest) ProtoMessage()    {}
 func (*ResetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{8}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{8}
 }
 func (m *ResetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetRequest.Unmarshal(m, b)
@@ -975,7 +1052,7 @@ func (m *ResetAnswer) Reset()         { *m = ResetAnswer{} }
 func (m *ResetAnswer) String() string { return proto.CompactTextString(m) }
 func (*ResetAnswer) ProtoMessage()    {}
 func (*ResetAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{9}
+	return fileDescriptor_s6a_proxy_f086ab1a41fe98b1, []int{9}
 }
 func (m *ResetAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetAnswer.Unmarshal(m, b)
@@ -1006,6 +1083,7 @@ func init() {
 	proto.RegisterType((*AuthenticationInformationRequest)(nil), "magma.feg.AuthenticationInformationRequest")
 	proto.RegisterType((*AuthenticationInformationAnswer)(nil), "magma.feg.AuthenticationInformationAnswer")
 	proto.RegisterType((*AuthenticationInformationAnswer_EUTRANVector)(nil), "magma.feg.AuthenticationInformationAnswer.EUTRANVector")
+	proto.RegisterType((*AuthenticationInformationAnswer_GERANVector)(nil), "magma.feg.AuthenticationInformationAnswer.GERANVector")
 	proto.RegisterType((*UpdateLocationRequest)(nil), "magma.feg.UpdateLocationRequest")
 	proto.RegisterType((*UpdateLocationAnswer)(nil), "magma.feg.UpdateLocationAnswer")
 	proto.RegisterType((*UpdateLocationAnswer_APNConfiguration)(nil), "magma.feg.UpdateLocationAnswer.APNConfiguration")
@@ -1269,114 +1347,118 @@ var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("feg/protos/s6a_proxy.proto", fileDescriptor_s6a_proxy_a38dd4520bad63f1)
-}
-
-var fileDescriptor_s6a_proxy_a38dd4520bad63f1 = []byte{
-	// 1675 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdb, 0x6e, 0xdb, 0xc8,
-	0xf9, 0xb7, 0x7c, 0x88, 0xad, 0xcf, 0xb2, 0x4d, 0xcf, 0xda, 0x96, 0xec, 0x64, 0x61, 0xff, 0x85,
-	0x7f, 0x11, 0xc3, 0xdb, 0x3a, 0x5b, 0xa7, 0x70, 0x0f, 0x7b, 0xd1, 0xa5, 0xc8, 0x49, 0xcc, 0x84,
-	0x22, 0xb9, 0x43, 0x52, 0xc6, 0x2e, 0x8a, 0x9d, 0x8e, 0xc9, 0xb1, 0x42, 0x84, 0x07, 0x85, 0xa4,
-	0x1c, 0xfb, 0x05, 0x0a, 0x14, 0xed, 0x03, 0x14, 0x68, 0x6f, 0xda, 0x02, 0xbd, 0xea, 0x09, 0xe8,
-	0x55, 0x4f, 0xdb, 0xc3, 0x13, 0xb4, 0x45, 0xf7, 0x21, 0xfa, 0x16, 0x05, 0x87, 0x94, 0x23, 0x29,
-	0x09, 0x92, 0x85, 0x7b, 0xa5, 0xe1, 0xef, 0x3b, 0xfd, 0xe6, 0xf7, 0x7d, 0x33, 0xa4, 0x60, 0xe7,
-	0x9c, 0xf7, 0xef, 0x0d, 0xd2, 0x24, 0x4f, 0xb2, 0x7b, 0xd9, 0x31, 0xa3, 0x83, 0x34, 0xb9, 0xbc,
-	0x3a, 0x14, 0x00, 0xaa, 0x47, 0xac, 0x1f, 0xb1, 0xc3, 0x73, 0xde, 0x6f, 0x7f, 0x6f, 0x16, 0xf6,
-	0xe4, 0x61, 0xfe, 0x84, 0xc7, 0x79, 0xe0, 0xb1, 0x3c, 0x48, 0x62, 0x2d, 0x3e, 0x4f, 0xd2, 0x48,
-	0x2c, 0x09, 0x7f, 0x36, 0xe4, 0x59, 0x8e, 0x6e, 0x43, 0x7d, 0x98, 0xf1, 0x94, 0xc6, 0x2c, 0xe2,
-	0xad, 0xda, 0x5e, 0x6d, 0xbf, 0x4e, 0x96, 0x0a, 0xc0, 0x60, 0x11, 0x47, 0xff, 0x07, 0x8d, 0x8b,
-	0x20, 0x0b, 0x72, 0xee, 0xd3, 0x41, 0x18, 0xc5, 0xad, 0xd9, 0xbd, 0xda, 0x7e, 0x83, 0x2c, 0x57,
-	0x98, 0x15, 0x46, 0x31, 0xfa, 0x36, 0xdc, 0x89, 0x87, 0x11, 0x4d, 0xcb, 0x74, 0xdc, 0xa7, 0x7c,
-	0x98, 0xa7, 0x2c, 0xa6, 0x17, 0xdc, 0xcb, 0x93, 0x34, 0x6b, 0xcd, 0xed, 0xd5, 0xf6, 0x57, 0xc8,
-	0x76, 0x3c, 0x8c, 0xc8, 0xc8, 0x05, 0x0b, 0x8f, 0x5e, 0xe9, 0x80, 0x3e, 0x84, 0x3b, 0x41, 0x14,
-	0x71, 0x3f, 0x60, 0x39, 0xa7, 0x29, 0xcf, 0x06, 0x49, 0x9c, 0x71, 0x3a, 0x48, 0xf9, 0x39, 0x4f,
-	0x53, 0xee, 0xb7, 0xe6, 0xf7, 0x6a, 0xfb, 0x4b, 0x64, 0xe7, 0xda, 0x87, 0x54, 0x2e, 0xd6, 0xc8,
-	0x03, 0xed, 0xc2, 0x72, 0xca, 0xb3, 0xab, 0xd8, 0xa3, 0x41, 0x7c, 0x9e, 0xb4, 0x16, 0x04, 0x49,
-	0x28, 0xa1, 0x62, 0xc7, 0xed, 0x1f, 0xcd, 0xc2, 0xee, 0x6b, 0x85, 0x90, 0xe3, 0xec, 0x39, 0x4f,
-	0xd1, 0x7d, 0x00, 0x9e, 0xa6, 0x49, 0x4a, 0xbd, 0xc4, 0x2f, 0x85, 0x58, 0x3d, 0xda, 0x38, 0xbc,
-	0x16, 0xf3, 0x10, 0x17, 0x46, 0x25, 0xf1, 0x39, 0xa9, 0xf3, 0xd1, 0x12, 0x7d, 0x0a, 0xab, 0x53,
-	0xdb, 0x9d, 0xdd, 0x9b, 0xdb, 0x5f, 0x3e, 0xfa, 0xfa, 0x58, 0xe0, 0x1b, 0x0a, 0x1f, 0x62, 0xd7,
-	0x21, 0xb2, 0x51, 0xaa, 0x41, 0x56, 0xf8, 0xb8, 0x36, 0x3b, 0xdf, 0x85, 0xc6, 0xb8, 0x19, 0x21,
-	0x98, 0x4f, 0x59, 0xec, 0x0b, 0x7a, 0x0d, 0x22, 0xd6, 0x05, 0x76, 0x99, 0xf2, 0xac, 0xea, 0x8d,
-	0x58, 0x17, 0x18, 0x1b, 0xe6, 0xb1, 0x10, 0xbf, 0x41, 0xc4, 0x1a, 0x6d, 0xc0, 0xc2, 0x53, 0x96,
-	0x45, 0x5c, 0x08, 0xda, 0x20, 0xe5, 0x43, 0xfb, 0xb7, 0x35, 0xd8, 0x74, 0x07, 0x3e, 0xcb, 0xb9,
-	0x9e, 0x78, 0xff, 0xd3, 0xc1, 0x78, 0x1f, 0x36, 0xb2, 0xa7, 0xc1, 0x80, 0x66, 0xc3, 0xb3, 0xcc,
-	0x4b, 0x83, 0x33, 0x9e, 0x52, 0x9f, 0xe5, 0x4c, 0x70, 0x5a, 0x22, 0xa8, 0xb0, 0xd9, 0xd7, 0x26,
-	0x95, 0xe5, 0x0c, 0x7d, 0x09, 0x56, 0x83, 0x38, 0xc8, 0x03, 0x16, 0x52, 0x96, 0xe7, 0xcc, 0x7b,
-	0x52, 0xf5, 0x7e, 0xa5, 0x42, 0x65, 0x01, 0xb6, 0xff, 0x51, 0x87, 0x8d, 0x49, 0xca, 0x37, 0x69,
-	0xe1, 0x97, 0x01, 0xf9, 0xfc, 0x9c, 0x0d, 0xc3, 0x9c, 0x7a, 0x49, 0x9c, 0xf3, 0xcb, 0x9c, 0x06,
-	0xbe, 0xd8, 0xcf, 0x0a, 0x91, 0x2a, 0x8b, 0x52, 0x1a, 0x34, 0x1f, 0x9d, 0x02, 0xe4, 0x49, 0x5e,
-	0x10, 0x8c, 0xce, 0x52, 0xb1, 0x95, 0xe5, 0xa3, 0x6f, 0x8c, 0x95, 0x78, 0x15, 0xaf, 0x43, 0xb9,
-	0xdf, 0x4f, 0x79, 0x9f, 0xe5, 0xdc, 0xef, 0xb2, 0xcb, 0x20, 0x1a, 0x46, 0x9d, 0x20, 0x4f, 0x8b,
-	0x49, 0xae, 0x8b, 0x5c, 0x72, 0x74, 0x96, 0xa2, 0x03, 0x58, 0x67, 0x61, 0x48, 0xd9, 0x20, 0xce,
-	0x68, 0x10, 0x7b, 0xe1, 0xd0, 0xbf, 0x1e, 0xfd, 0x35, 0x16, 0x86, 0xf2, 0x20, 0xce, 0xb4, 0x0a,
-	0x46, 0x1d, 0x98, 0x63, 0x83, 0xb8, 0xb5, 0x20, 0x46, 0xed, 0xfd, 0x37, 0x56, 0xb7, 0x0c, 0x25,
-	0x89, 0xcf, 0x83, 0xfe, 0x30, 0x2d, 0xfb, 0x5b, 0x04, 0xa3, 0x2d, 0xb8, 0x15, 0x65, 0x41, 0xe6,
-	0xc7, 0xad, 0x45, 0xd1, 0xba, 0xea, 0x09, 0x31, 0x78, 0x27, 0xe6, 0xf9, 0xf3, 0x24, 0x7d, 0x4a,
-	0x99, 0xe7, 0xf1, 0x2c, 0xa3, 0x51, 0x21, 0xe6, 0x92, 0x10, 0xf3, 0xab, 0x6f, 0xaa, 0x65, 0x94,
-	0xa1, 0xb2, 0x88, 0xec, 0x16, 0x4a, 0xaf, 0xc7, 0xd3, 0xd0, 0xce, 0xbf, 0xe7, 0x41, 0x9a, 0x26,
-	0x85, 0xde, 0x05, 0x18, 0x93, 0xbf, 0x26, 0xe4, 0xaf, 0x7b, 0xd7, 0xba, 0xbf, 0x07, 0xeb, 0x19,
-	0x4f, 0x2f, 0x02, 0x8f, 0xd3, 0x8c, 0x87, 0xdc, 0x2b, 0x62, 0x44, 0x93, 0xea, 0x44, 0xaa, 0x0c,
-	0xf6, 0x08, 0x47, 0xdf, 0x81, 0xe5, 0x67, 0x49, 0x56, 0xdc, 0x8a, 0xe7, 0x41, 0xc8, 0xab, 0x2e,
-	0x7d, 0xf0, 0x45, 0x75, 0x3a, 0xfc, 0x28, 0xb1, 0xad, 0x32, 0x05, 0x81, 0x67, 0x49, 0x56, 0xad,
-	0x91, 0x0e, 0xf3, 0xa2, 0xf9, 0xf3, 0x37, 0x6c, 0xbe, 0xc8, 0x82, 0x1e, 0xc1, 0xdc, 0xc0, 0x8f,
-	0xc5, 0x9d, 0xb5, 0xfa, 0x16, 0xc9, 0xa6, 0x39, 0x5a, 0xaa, 0xe1, 0x5c, 0x0d, 0x38, 0x29, 0x92,
-	0xec, 0x7c, 0x56, 0x03, 0x78, 0x41, 0x1a, 0x6d, 0xc3, 0x92, 0x17, 0xb2, 0x2c, 0x1b, 0x09, 0xba,
-	0x40, 0x16, 0xc5, 0xb3, 0xe6, 0x17, 0x27, 0x6d, 0x90, 0x06, 0x49, 0x1a, 0xe4, 0x57, 0x34, 0xe4,
-	0x17, 0x3c, 0xac, 0x06, 0x7e, 0x65, 0x84, 0xea, 0x05, 0x88, 0xee, 0xc3, 0xe6, 0x20, 0xe5, 0x3c,
-	0x1a, 0x14, 0xb5, 0xa8, 0xc7, 0x06, 0xec, 0x2c, 0x08, 0x83, 0xfc, 0xaa, 0x3a, 0xc3, 0x1b, 0x2f,
-	0x8c, 0xca, 0xb5, 0x0d, 0x7d, 0x13, 0x5a, 0x63, 0x41, 0x17, 0xc3, 0x30, 0xe6, 0xe9, 0x28, 0xae,
-	0x1c, 0xe8, 0xe6, 0x0b, 0x7b, 0x6f, 0xdc, 0xdc, 0xfe, 0x00, 0x16, 0xab, 0x0d, 0xa1, 0x25, 0x98,
-	0xd7, 0xac, 0xde, 0xd7, 0xa4, 0x99, 0x6a, 0x75, 0x2c, 0xd5, 0x10, 0xc0, 0xad, 0x02, 0xeb, 0x1d,
-	0x4b, 0xb3, 0x48, 0x82, 0x46, 0xb1, 0xa6, 0x26, 0xa1, 0xc2, 0x3a, 0xb7, 0x13, 0x43, 0xeb, 0x75,
-	0x5a, 0xa3, 0x7d, 0x90, 0x22, 0x76, 0x49, 0xcf, 0x58, 0xec, 0x3f, 0x0f, 0xfc, 0xfc, 0x09, 0x1d,
-	0x86, 0xd5, 0x8c, 0xad, 0x46, 0xec, 0xb2, 0x33, 0x82, 0xdd, 0xf0, 0x65, 0x4f, 0x7f, 0xa4, 0xcd,
-	0x84, 0xa7, 0x1a, 0xb6, 0x1f, 0xc1, 0xfa, 0x4b, 0xe3, 0x8e, 0xb6, 0x00, 0x59, 0xb2, 0xf2, 0x18,
-	0x3b, 0x54, 0x36, 0x54, 0xaa, 0x68, 0x44, 0x71, 0x35, 0x47, 0x9a, 0x41, 0x0d, 0x58, 0x22, 0xd8,
-	0xc6, 0xa4, 0x87, 0x55, 0xa9, 0x86, 0xd6, 0x60, 0xd9, 0x34, 0xf4, 0x8f, 0x69, 0xe9, 0x2a, 0xcd,
-	0xb6, 0x7f, 0x37, 0x0b, 0x9b, 0x0a, 0x8b, 0x3d, 0x1e, 0x7e, 0xa1, 0x5b, 0xf8, 0x53, 0x58, 0xf7,
-	0x44, 0x54, 0x28, 0x62, 0x68, 0x7e, 0x35, 0xe0, 0x82, 0xed, 0xe4, 0x51, 0x7d, 0x65, 0xe6, 0x0a,
-	0x2d, 0x23, 0xc5, 0x0c, 0x49, 0xde, 0x14, 0xd2, 0xfe, 0x49, 0x0d, 0xa4, 0x69, 0x37, 0xd4, 0x82,
-	0x8d, 0x6e, 0x17, 0x53, 0xd7, 0x52, 0x65, 0x07, 0x53, 0x8b, 0x98, 0x0a, 0x56, 0x5d, 0x82, 0xa5,
-	0x19, 0xb4, 0x0d, 0x9b, 0xf6, 0x43, 0xdb, 0x78, 0xd9, 0x54, 0x43, 0xb7, 0xa1, 0x69, 0xbb, 0x1d,
-	0x5b, 0x21, 0x9a, 0xe5, 0x68, 0xa6, 0x41, 0x4f, 0x35, 0xe7, 0x44, 0x25, 0xf2, 0xa9, 0xac, 0x4b,
-	0xb3, 0x45, 0xc6, 0xe9, 0x10, 0xaa, 0x9d, 0x3e, 0x90, 0xe6, 0xd0, 0x1d, 0x68, 0x69, 0x86, 0xe6,
-	0x68, 0xb2, 0x4e, 0x65, 0xc7, 0x91, 0x95, 0x93, 0xb1, 0xa4, 0xf3, 0xed, 0xc7, 0xb0, 0x31, 0xb9,
-	0xb5, 0x1b, 0xbc, 0x07, 0xda, 0x5f, 0x81, 0x55, 0x6b, 0x98, 0xf6, 0xb9, 0x8b, 0xdf, 0x46, 0xfa,
-	0xb6, 0x0a, 0x2b, 0x95, 0xfb, 0x4d, 0x8a, 0xde, 0x85, 0x06, 0xe1, 0x19, 0xcf, 0x47, 0x25, 0x9b,
-	0xb0, 0x28, 0x4a, 0x8a, 0x13, 0x3b, 0xb7, 0x5f, 0x27, 0xb7, 0x8a, 0x47, 0xcd, 0x6f, 0x77, 0x60,
-	0x59, 0x38, 0xde, 0xa0, 0xd8, 0xc1, 0xe7, 0xf3, 0x50, 0xbf, 0x36, 0xa0, 0x15, 0xa8, 0xbb, 0x86,
-	0x8a, 0x1f, 0x68, 0x06, 0x56, 0xa5, 0x19, 0xb4, 0x09, 0x52, 0xd7, 0xd5, 0x1d, 0x8d, 0x12, 0xd3,
-	0x35, 0x54, 0x2a, 0xbb, 0xce, 0x89, 0xf4, 0x9f, 0x45, 0xd4, 0x80, 0x45, 0xdb, 0x55, 0x14, 0x6c,
-	0xdb, 0xd2, 0x3f, 0xd7, 0xd0, 0x06, 0xac, 0xe9, 0x5a, 0x57, 0x73, 0xb0, 0x4a, 0x47, 0xe8, 0xbf,
-	0xd6, 0x50, 0x13, 0x90, 0x62, 0x76, 0xbb, 0xc5, 0xc0, 0xbb, 0x86, 0xed, 0x5a, 0x26, 0x71, 0xb0,
-	0x2a, 0xfd, 0xbe, 0x89, 0xb6, 0x60, 0xdd, 0x35, 0xe4, 0x8e, 0x8e, 0xa9, 0x63, 0x52, 0x15, 0xeb,
-	0x5a, 0x0f, 0x13, 0xe9, 0x0f, 0xcd, 0xa2, 0x16, 0xc1, 0xb2, 0xde, 0xa5, 0x86, 0xe9, 0xd0, 0xea,
-	0x50, 0xfc, 0xb1, 0x89, 0x56, 0x60, 0xc9, 0x31, 0x4d, 0xda, 0x71, 0xed, 0x8f, 0xa5, 0x3f, 0x35,
-	0x11, 0x82, 0x15, 0xdd, 0x34, 0x2d, 0xaa, 0x62, 0x07, 0x2b, 0x45, 0xc6, 0x3f, 0x37, 0x51, 0x0b,
-	0xde, 0x21, 0x58, 0xd5, 0x08, 0x56, 0x1c, 0xaa, 0x19, 0xaa, 0xa6, 0xc8, 0xc5, 0x34, 0x49, 0x9f,
-	0x35, 0xd1, 0x1d, 0x68, 0xca, 0x96, 0xa5, 0x57, 0x48, 0x49, 0xa4, 0x62, 0xf2, 0x17, 0x51, 0x51,
-	0x33, 0x7a, 0xb2, 0xae, 0xa9, 0x27, 0x54, 0x25, 0xb4, 0xa3, 0x39, 0xb6, 0xf4, 0xd7, 0x71, 0x98,
-	0xca, 0x3d, 0xab, 0x84, 0xff, 0xd6, 0x44, 0xeb, 0xd0, 0x70, 0x8d, 0xc7, 0x86, 0x79, 0x6a, 0x50,
-	0x0b, 0x63, 0x22, 0xfd, 0xbd, 0x4c, 0xef, 0x3a, 0x27, 0xd8, 0x70, 0x46, 0x15, 0x08, 0x7e, 0x54,
-	0xd2, 0xfa, 0xe9, 0x6e, 0x11, 0x60, 0xba, 0x0e, 0x35, 0x1f, 0x50, 0xdb, 0x92, 0x15, 0x2c, 0xfd,
-	0x6c, 0xb7, 0x60, 0x8f, 0x75, 0xac, 0x08, 0x57, 0xdd, 0xb4, 0x1d, 0xe9, 0xe7, 0xbb, 0xe8, 0x36,
-	0x6c, 0x15, 0x49, 0x4c, 0xa2, 0x7d, 0x32, 0x95, 0xe3, 0x07, 0x77, 0x45, 0x51, 0x1b, 0x13, 0x5a,
-	0x55, 0x96, 0xbe, 0x7f, 0x17, 0xbd, 0x0b, 0xad, 0x11, 0x0f, 0x6c, 0xd9, 0x74, 0xfc, 0x00, 0x49,
-	0xbf, 0x3c, 0x28, 0xba, 0x41, 0x64, 0x47, 0x88, 0x28, 0xeb, 0xba, 0x79, 0x8a, 0x55, 0xe9, 0x57,
-	0x07, 0x42, 0x22, 0x53, 0xee, 0x6a, 0xc6, 0xc3, 0x09, 0xcb, 0x0f, 0xef, 0x16, 0xed, 0xc0, 0x1f,
-	0xb9, 0x9a, 0xd5, 0xc5, 0x86, 0x73, 0x5d, 0xe6, 0xd7, 0x22, 0xc2, 0x35, 0x1e, 0x17, 0x55, 0x8a,
-	0x5e, 0x94, 0x81, 0x2a, 0x96, 0x7e, 0x73, 0x80, 0xfe, 0x1f, 0x76, 0xa7, 0x76, 0xad, 0xca, 0x8e,
-	0x4c, 0x5d, 0x43, 0xee, 0xc9, 0x9a, 0x5e, 0x74, 0x56, 0xfa, 0x7c, 0xef, 0xe8, 0xc7, 0xb3, 0xb0,
-	0x64, 0x1f, 0x33, 0xab, 0xf8, 0x13, 0x82, 0x2e, 0x60, 0xfb, 0xb5, 0x1f, 0xbc, 0xe8, 0xbd, 0xb7,
-	0xf9, 0x2c, 0xae, 0xce, 0xc2, 0xce, 0xc1, 0xdb, 0x7f, 0x43, 0xb7, 0x67, 0x90, 0x0b, 0xab, 0x93,
-	0x6f, 0x4c, 0xb4, 0xf7, 0xda, 0x97, 0xe9, 0xa8, 0xc2, 0xee, 0x1b, 0x5e, 0xb7, 0xed, 0x19, 0xf4,
-	0x21, 0x2c, 0x56, 0xc7, 0x1c, 0x6d, 0x8f, 0x79, 0x4f, 0xde, 0x14, 0x3b, 0xad, 0x97, 0x4d, 0xa3,
-	0x0c, 0x47, 0xbf, 0xa8, 0xc1, 0xba, 0x7d, 0xcc, 0x1e, 0xb2, 0x9c, 0x3f, 0x67, 0x57, 0x76, 0xf9,
-	0xad, 0x52, 0xd0, 0x9d, 0xbc, 0xba, 0x26, 0xe8, 0xbe, 0xf2, 0xc2, 0x9e, 0xa0, 0xfb, 0xaa, 0x7b,
-	0xaf, 0x3d, 0x83, 0xbe, 0x05, 0x0b, 0xe2, 0x9a, 0x40, 0xcd, 0x31, 0xdf, 0xf1, 0x1b, 0x66, 0x67,
-	0x6b, 0xda, 0x30, 0x8a, 0xed, 0xdc, 0xfe, 0x64, 0x5b, 0x98, 0xee, 0x15, 0x7f, 0x2e, 0xbd, 0x30,
-	0x19, 0xfa, 0xf7, 0xfa, 0x49, 0xf5, 0x2f, 0xf3, 0xec, 0x96, 0xf8, 0xbd, 0xff, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x77, 0x99, 0xb9, 0xc8, 0x7a, 0x0e, 0x00, 0x00,
+	proto.RegisterFile("feg/protos/s6a_proxy.proto", fileDescriptor_s6a_proxy_f086ab1a41fe98b1)
+}
+
+var fileDescriptor_s6a_proxy_f086ab1a41fe98b1 = []byte{
+	// 1729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5b, 0x6f, 0xe4, 0x48,
+	0x15, 0x4e, 0x77, 0xae, 0x7d, 0xd2, 0x9d, 0x38, 0xb5, 0x49, 0xba, 0xd3, 0x99, 0x55, 0x42, 0x0b,
+	0x34, 0x51, 0x16, 0x32, 0x4b, 0x16, 0x85, 0x85, 0x15, 0x62, 0x1d, 0xbb, 0x26, 0xf1, 0x4c, 0xb7,
+	0xed, 0x2d, 0xdb, 0x89, 0x76, 0x41, 0x5b, 0x54, 0xec, 0x4a, 0x8f, 0x15, 0x5f, 0x7a, 0x6c, 0x77,
+	0x26, 0xf9, 0x09, 0x08, 0xfe, 0x01, 0xbc, 0x00, 0x12, 0x4f, 0xdc, 0x24, 0x9e, 0x80, 0x65, 0xb9,
+	0x48, 0xbc, 0x03, 0x62, 0x7f, 0x04, 0xff, 0x02, 0xb9, 0xda, 0x9d, 0x74, 0x27, 0x13, 0xcd, 0x8c,
+	0xc2, 0x53, 0x97, 0xbf, 0x73, 0xf9, 0xbe, 0x3a, 0xe7, 0x54, 0xd9, 0x0d, 0xcd, 0x53, 0xde, 0x7d,
+	0xd4, 0x4b, 0xe2, 0x2c, 0x4e, 0x1f, 0xa5, 0x7b, 0x8c, 0xf6, 0x92, 0xf8, 0xe2, 0x72, 0x47, 0x00,
+	0xa8, 0x12, 0xb2, 0x6e, 0xc8, 0x76, 0x4e, 0x79, 0xb7, 0xf5, 0x8f, 0x32, 0x6c, 0xca, 0xfd, 0xec,
+	0x19, 0x8f, 0x32, 0xdf, 0x65, 0x99, 0x1f, 0x47, 0x5a, 0x74, 0x1a, 0x27, 0xa1, 0x58, 0x12, 0xfe,
+	0xbc, 0xcf, 0xd3, 0x0c, 0xad, 0x43, 0xa5, 0x9f, 0xf2, 0x84, 0x46, 0x2c, 0xe4, 0x8d, 0xd2, 0x66,
+	0x69, 0xab, 0x42, 0xe6, 0x72, 0x40, 0x67, 0x21, 0x47, 0x5f, 0x82, 0xea, 0xb9, 0x9f, 0xfa, 0x19,
+	0xf7, 0x68, 0x2f, 0x08, 0xa3, 0x46, 0x79, 0xb3, 0xb4, 0x55, 0x25, 0xf3, 0x05, 0x66, 0x06, 0x61,
+	0x84, 0xbe, 0x0b, 0x0f, 0xa2, 0x7e, 0x48, 0x93, 0x41, 0x3a, 0xee, 0x51, 0xde, 0xcf, 0x12, 0x16,
+	0xd1, 0x73, 0xee, 0x66, 0x71, 0x92, 0x36, 0x26, 0x37, 0x4b, 0x5b, 0x35, 0xb2, 0x16, 0xf5, 0x43,
+	0x32, 0x74, 0xc1, 0xc2, 0xe3, 0x68, 0xe0, 0x80, 0x3e, 0x84, 0x07, 0x7e, 0x18, 0x72, 0xcf, 0x67,
+	0x19, 0xa7, 0x09, 0x4f, 0x7b, 0x71, 0x94, 0x72, 0xda, 0x4b, 0xf8, 0x29, 0x4f, 0x12, 0xee, 0x35,
+	0xa6, 0x36, 0x4b, 0x5b, 0x73, 0xa4, 0x79, 0xe5, 0x43, 0x0a, 0x17, 0x73, 0xe8, 0x81, 0x36, 0x60,
+	0x3e, 0xe1, 0xe9, 0x65, 0xe4, 0x52, 0x3f, 0x3a, 0x8d, 0x1b, 0xd3, 0x42, 0x24, 0x0c, 0xa0, 0x7c,
+	0xc7, 0xe8, 0x3b, 0xb0, 0x3e, 0xae, 0xb1, 0xcb, 0x47, 0x25, 0xce, 0x08, 0x89, 0x8d, 0x51, 0x89,
+	0x07, 0xfc, 0x5a, 0x61, 0xeb, 0xb3, 0x49, 0xd8, 0xb8, 0xb3, 0x8e, 0x72, 0x94, 0xbe, 0xe0, 0x09,
+	0x7a, 0x0f, 0x80, 0x27, 0x49, 0x9c, 0x50, 0x37, 0xf6, 0x06, 0x75, 0x5c, 0xd8, 0x5d, 0xde, 0xb9,
+	0xea, 0xc5, 0x0e, 0xce, 0x8d, 0x4a, 0xec, 0x71, 0x52, 0xe1, 0xc3, 0x25, 0xfa, 0x14, 0x16, 0x6e,
+	0x54, 0xab, 0xbc, 0x39, 0xb9, 0x35, 0xbf, 0xfb, 0xcd, 0x91, 0xc0, 0x57, 0x10, 0xef, 0x60, 0xc7,
+	0x26, 0xb2, 0x3e, 0x90, 0x4a, 0x6a, 0x7c, 0xac, 0xb4, 0xdf, 0x83, 0xda, 0xf8, 0x4e, 0x27, 0x45,
+	0xfa, 0xbd, 0x37, 0x48, 0x7f, 0x80, 0xaf, 0xb3, 0x57, 0xbb, 0x23, 0x55, 0x69, 0xfe, 0x00, 0xaa,
+	0xa3, 0xdc, 0x08, 0xc1, 0x54, 0xc2, 0x22, 0x4f, 0xec, 0xbd, 0x4a, 0xc4, 0x3a, 0xc7, 0x2e, 0x12,
+	0x9e, 0x16, 0x73, 0x23, 0xd6, 0x39, 0xc6, 0xfa, 0x59, 0x24, 0x06, 0xa3, 0x4a, 0xc4, 0x1a, 0x2d,
+	0xc3, 0xf4, 0x19, 0x4b, 0x43, 0x2e, 0x9a, 0x5d, 0x25, 0x83, 0x87, 0x26, 0x86, 0xf9, 0x11, 0xfa,
+	0xbb, 0x08, 0xd2, 0x11, 0x82, 0x7c, 0x8d, 0x16, 0xa0, 0x7c, 0xe6, 0x16, 0xe9, 0xcb, 0x67, 0x6e,
+	0xeb, 0x77, 0x25, 0x58, 0x71, 0x7a, 0x1e, 0xcb, 0x78, 0x3b, 0x76, 0xff, 0xaf, 0xb3, 0xff, 0x2e,
+	0x2c, 0xa7, 0x67, 0x7e, 0x8f, 0xa6, 0xfd, 0x93, 0xd4, 0x4d, 0xfc, 0x13, 0x9e, 0x50, 0x8f, 0x65,
+	0x4c, 0x70, 0xcf, 0x11, 0x94, 0xdb, 0xac, 0x2b, 0x93, 0xca, 0x32, 0x86, 0xbe, 0x02, 0x0b, 0x7e,
+	0xe4, 0x67, 0x3e, 0x0b, 0x28, 0xcb, 0x32, 0xe6, 0x3e, 0x2b, 0xc6, 0xbb, 0x56, 0xa0, 0xb2, 0x00,
+	0x5b, 0xff, 0xac, 0xc0, 0xf2, 0xb8, 0xe4, 0xfb, 0x8c, 0xd9, 0x57, 0x01, 0x79, 0xfc, 0x94, 0xf5,
+	0x83, 0x8c, 0xba, 0x71, 0x94, 0xf1, 0x8b, 0x8c, 0xfa, 0x9e, 0xd8, 0x4f, 0x8d, 0x48, 0x85, 0x45,
+	0x19, 0x18, 0x34, 0x0f, 0x1d, 0x03, 0x64, 0x71, 0x96, 0x0b, 0x0c, 0x4f, 0x12, 0xb1, 0x95, 0xf9,
+	0xdd, 0xf7, 0x47, 0x28, 0x5e, 0xa6, 0x6b, 0x47, 0xee, 0x76, 0x13, 0xde, 0x65, 0x19, 0xf7, 0x3a,
+	0xec, 0xc2, 0x0f, 0xfb, 0xe1, 0xbe, 0x9f, 0x25, 0xf9, 0x61, 0xad, 0x88, 0x5c, 0x72, 0x78, 0x92,
+	0xa0, 0x6d, 0x58, 0x62, 0x41, 0x40, 0x59, 0x2f, 0x4a, 0xa9, 0x1f, 0xb9, 0x41, 0xdf, 0xbb, 0x3a,
+	0xdd, 0x8b, 0x2c, 0x08, 0xe4, 0x5e, 0x94, 0x6a, 0x05, 0x8c, 0xf6, 0x61, 0x92, 0xf5, 0xa2, 0xc6,
+	0xb4, 0x98, 0xd7, 0x77, 0x5f, 0xc9, 0x6e, 0xea, 0x4a, 0x1c, 0x9d, 0xfa, 0xdd, 0x7e, 0x32, 0xe8,
+	0x6f, 0x1e, 0x8c, 0x56, 0x61, 0x26, 0x4c, 0xfd, 0xd4, 0x8b, 0x1a, 0xb3, 0xa2, 0x75, 0xc5, 0x13,
+	0x62, 0xf0, 0x56, 0xc4, 0xb3, 0x17, 0x71, 0x72, 0x46, 0x99, 0xeb, 0xf2, 0x34, 0xa5, 0x61, 0x5e,
+	0xcc, 0x39, 0x51, 0xcc, 0xaf, 0xbf, 0x8a, 0x4b, 0x1f, 0x84, 0xca, 0x22, 0xb2, 0x93, 0x57, 0x7a,
+	0x29, 0xba, 0x09, 0x35, 0xff, 0x33, 0x05, 0xd2, 0x4d, 0x51, 0xe8, 0x6d, 0x80, 0x91, 0xf2, 0x97,
+	0x44, 0xf9, 0x2b, 0xee, 0x55, 0xdd, 0xdf, 0x81, 0xa5, 0x94, 0x27, 0xe7, 0xbe, 0xcb, 0x69, 0xca,
+	0x03, 0xee, 0xe6, 0x31, 0xa2, 0x49, 0x15, 0x22, 0x15, 0x06, 0x6b, 0x88, 0xa3, 0xef, 0xc3, 0xfc,
+	0xf3, 0x38, 0xcd, 0x2f, 0xfe, 0x53, 0x3f, 0xe0, 0x45, 0x97, 0x3e, 0x78, 0xd3, 0x3a, 0xed, 0x7c,
+	0x14, 0x5b, 0xe6, 0x20, 0x05, 0x81, 0xe7, 0x71, 0x5a, 0xac, 0x51, 0x1b, 0xa6, 0x44, 0xf3, 0xa7,
+	0xee, 0xd9, 0x7c, 0x91, 0x05, 0x3d, 0x81, 0xc9, 0x9e, 0x17, 0x89, 0x6b, 0x79, 0x61, 0xf7, 0xfd,
+	0x37, 0xd6, 0x68, 0xaa, 0xba, 0x7d, 0xd9, 0xe3, 0x24, 0x4f, 0xd2, 0xfc, 0xbc, 0x04, 0x70, 0x2d,
+	0x1a, 0xad, 0xc1, 0x9c, 0x1b, 0xb0, 0x34, 0x1d, 0x16, 0x74, 0x9a, 0xcc, 0x8a, 0x67, 0xcd, 0xcb,
+	0x4f, 0x5a, 0x2f, 0xf1, 0xe3, 0xc4, 0xcf, 0x2e, 0x69, 0xc0, 0xcf, 0x79, 0x50, 0x0c, 0x7c, 0x6d,
+	0x88, 0xb6, 0x73, 0x10, 0xbd, 0x07, 0x2b, 0xbd, 0x84, 0xf3, 0xb0, 0x97, 0x73, 0x51, 0x97, 0xf5,
+	0xd8, 0x89, 0x1f, 0xf8, 0xd9, 0x65, 0x71, 0x86, 0x97, 0xaf, 0x8d, 0xca, 0x95, 0x0d, 0x7d, 0x0b,
+	0x1a, 0x23, 0x41, 0xe7, 0xfd, 0x20, 0xe2, 0xc9, 0x30, 0x6e, 0x30, 0xd0, 0xf5, 0x6b, 0xfb, 0xd1,
+	0xa8, 0xb9, 0xf5, 0x01, 0xcc, 0x16, 0x1b, 0x42, 0x73, 0x30, 0xa5, 0x99, 0x47, 0xdf, 0x90, 0x26,
+	0x8a, 0xd5, 0x9e, 0x54, 0x42, 0x00, 0x33, 0x39, 0x76, 0xb4, 0x27, 0x95, 0x91, 0x04, 0xd5, 0x7c,
+	0x4d, 0x0d, 0x42, 0x85, 0x75, 0xb2, 0x19, 0x41, 0xe3, 0xae, 0x5a, 0xa3, 0x2d, 0x90, 0x42, 0x76,
+	0x41, 0x4f, 0x58, 0xe4, 0xbd, 0xf0, 0xbd, 0xec, 0x19, 0xed, 0x07, 0xc5, 0x8c, 0x2d, 0x84, 0xec,
+	0x62, 0x7f, 0x08, 0x3b, 0xc1, 0x6d, 0x4f, 0x6f, 0x58, 0x9b, 0x31, 0x4f, 0x35, 0x68, 0x3d, 0x81,
+	0xa5, 0x5b, 0xe3, 0x8e, 0x56, 0x01, 0x99, 0xb2, 0xf2, 0x14, 0xdb, 0x54, 0xd6, 0x55, 0xaa, 0x68,
+	0x44, 0x71, 0x34, 0x5b, 0x9a, 0x40, 0x55, 0x98, 0x23, 0xd8, 0xc2, 0xe4, 0x08, 0xab, 0x52, 0x09,
+	0x2d, 0xc2, 0xbc, 0xa1, 0xb7, 0x3f, 0xa6, 0x03, 0x57, 0xa9, 0xdc, 0xfa, 0x7d, 0x19, 0x56, 0x14,
+	0x16, 0xb9, 0x3c, 0x78, 0xa3, 0x5b, 0xf8, 0x53, 0x58, 0x72, 0x45, 0x54, 0x20, 0x62, 0x68, 0x76,
+	0xd9, 0xe3, 0x8d, 0xf2, 0xad, 0xa3, 0xfa, 0xd2, 0xcc, 0x3b, 0xca, 0x48, 0xa4, 0x98, 0x21, 0xc9,
+	0xbd, 0x81, 0xb4, 0x7e, 0x5a, 0x02, 0xe9, 0xa6, 0x1b, 0x6a, 0xc0, 0x72, 0xa7, 0x83, 0xa9, 0x63,
+	0xaa, 0xb2, 0x8d, 0xa9, 0x49, 0x0c, 0x05, 0xab, 0x0e, 0xc1, 0xd2, 0x04, 0x5a, 0x83, 0x15, 0xeb,
+	0xc0, 0xd2, 0x6f, 0x9b, 0x4a, 0x68, 0x1d, 0xea, 0x96, 0xb3, 0x6f, 0x29, 0x44, 0x33, 0x6d, 0xcd,
+	0xd0, 0xe9, 0xb1, 0x66, 0x1f, 0xaa, 0x44, 0x3e, 0x96, 0xdb, 0x52, 0x39, 0xcf, 0x78, 0x33, 0x84,
+	0x6a, 0xc7, 0x8f, 0xa5, 0x49, 0xf4, 0x00, 0x1a, 0x9a, 0xae, 0xd9, 0x9a, 0xdc, 0xa6, 0xb2, 0x6d,
+	0xcb, 0xca, 0xe1, 0x48, 0xd2, 0xa9, 0xd6, 0x53, 0x58, 0x1e, 0xdf, 0xda, 0x3d, 0xde, 0x03, 0xad,
+	0xaf, 0xc1, 0x82, 0xd9, 0x4f, 0xba, 0xdc, 0xc1, 0xaf, 0x53, 0xfa, 0x96, 0x0a, 0xb5, 0xc2, 0xfd,
+	0x3e, 0xa4, 0x0f, 0xa1, 0x4a, 0x78, 0xca, 0xb3, 0x21, 0x65, 0x1d, 0x66, 0x05, 0xa5, 0x38, 0xb1,
+	0x93, 0x5b, 0x15, 0x32, 0x93, 0x3f, 0x6a, 0x5e, 0x6b, 0x1f, 0xe6, 0x85, 0xe3, 0x3d, 0xc8, 0xb6,
+	0xbf, 0x98, 0x82, 0xca, 0x95, 0x01, 0xd5, 0xa0, 0xe2, 0xe8, 0x2a, 0x7e, 0xac, 0xe9, 0x58, 0x95,
+	0x26, 0xd0, 0x0a, 0x48, 0x1d, 0xa7, 0x6d, 0x6b, 0x94, 0x18, 0x8e, 0xae, 0x52, 0xd9, 0xb1, 0x0f,
+	0xa5, 0xff, 0xce, 0xa2, 0x2a, 0xcc, 0x5a, 0x8e, 0xa2, 0x60, 0xcb, 0x92, 0xfe, 0xb5, 0x88, 0x96,
+	0x61, 0xb1, 0xad, 0x75, 0x34, 0x1b, 0xab, 0x74, 0x88, 0xfe, 0x7b, 0x11, 0xd5, 0x01, 0x29, 0x46,
+	0xa7, 0x93, 0x0f, 0xbc, 0xa3, 0x5b, 0x8e, 0x69, 0x10, 0x1b, 0xab, 0xd2, 0x1f, 0xea, 0x68, 0x15,
+	0x96, 0x1c, 0x5d, 0xde, 0x6f, 0x63, 0x6a, 0x1b, 0x54, 0xc5, 0x6d, 0xed, 0x08, 0x13, 0xe9, 0x8f,
+	0xf5, 0x9c, 0x8b, 0x60, 0xb9, 0xdd, 0xa1, 0xba, 0x61, 0xd3, 0xe2, 0x50, 0xfc, 0xa9, 0x8e, 0x6a,
+	0x30, 0x67, 0x1b, 0x06, 0xdd, 0x77, 0xac, 0x8f, 0xa5, 0xcf, 0xea, 0x08, 0x41, 0xad, 0x6d, 0x18,
+	0x26, 0x55, 0xb1, 0x8d, 0x95, 0x3c, 0xe3, 0x9f, 0xeb, 0xa8, 0x01, 0x6f, 0x11, 0xac, 0x6a, 0x04,
+	0x2b, 0x36, 0xd5, 0x74, 0x55, 0x53, 0xe4, 0x7c, 0x9a, 0xa4, 0xcf, 0xeb, 0xe8, 0x01, 0xd4, 0x65,
+	0xd3, 0x6c, 0x17, 0xc8, 0x40, 0x48, 0xa1, 0xe4, 0x2f, 0x82, 0x51, 0xd3, 0x8f, 0xe4, 0xb6, 0xa6,
+	0x1e, 0x52, 0x95, 0xd0, 0x7d, 0xcd, 0xb6, 0xa4, 0xbf, 0x8e, 0xc2, 0x54, 0x3e, 0x32, 0x07, 0xf0,
+	0xdf, 0xea, 0x68, 0x09, 0xaa, 0x8e, 0xfe, 0x54, 0x37, 0x8e, 0x75, 0x6a, 0x62, 0x4c, 0xa4, 0xbf,
+	0x0f, 0xd2, 0x3b, 0xf6, 0x21, 0xd6, 0xed, 0x21, 0x03, 0xc1, 0x4f, 0x06, 0xb2, 0x7e, 0xb6, 0x91,
+	0x07, 0x18, 0x8e, 0x4d, 0x8d, 0xc7, 0xd4, 0x32, 0x65, 0x05, 0x4b, 0x3f, 0xdf, 0xc8, 0xd5, 0xe3,
+	0x36, 0x56, 0x84, 0x6b, 0xdb, 0xb0, 0x6c, 0xe9, 0x17, 0x1b, 0x68, 0x1d, 0x56, 0xf3, 0x24, 0x06,
+	0xd1, 0x3e, 0xb9, 0x91, 0xe3, 0x47, 0x0f, 0x05, 0xa9, 0x85, 0x09, 0x2d, 0x98, 0xa5, 0x1f, 0x3e,
+	0x44, 0x6f, 0x43, 0x63, 0xa8, 0x03, 0x9b, 0x16, 0x1d, 0x3d, 0x40, 0xd2, 0xaf, 0xb6, 0xf3, 0x6e,
+	0x10, 0xd9, 0x16, 0x45, 0x94, 0xdb, 0x6d, 0xe3, 0x18, 0xab, 0xd2, 0xaf, 0xb7, 0x45, 0x89, 0x0c,
+	0xb9, 0xa3, 0xe9, 0x07, 0x63, 0x96, 0x1f, 0x3f, 0xcc, 0xdb, 0x81, 0x3f, 0x72, 0x34, 0xb3, 0x83,
+	0x75, 0xfb, 0x8a, 0xe6, 0x37, 0x22, 0xc2, 0xd1, 0x9f, 0xe6, 0x2c, 0x79, 0x2f, 0x06, 0x81, 0x2a,
+	0x96, 0x7e, 0xbb, 0x8d, 0xbe, 0x0c, 0x1b, 0x37, 0x76, 0xad, 0xca, 0xb6, 0x4c, 0x1d, 0x5d, 0x3e,
+	0x92, 0xb5, 0x76, 0xde, 0x59, 0xe9, 0x8b, 0xcd, 0xdd, 0x9f, 0x94, 0x61, 0xce, 0xda, 0x63, 0x66,
+	0xfe, 0x3f, 0x0b, 0x9d, 0xc3, 0xda, 0x9d, 0x5f, 0xcd, 0xe8, 0x9d, 0xd7, 0xf9, 0xb6, 0x2e, 0xce,
+	0x42, 0x73, 0xfb, 0xf5, 0x3f, 0xc4, 0x5b, 0x13, 0xc8, 0x81, 0x85, 0xf1, 0x37, 0x26, 0xda, 0xbc,
+	0xf3, 0x65, 0x3a, 0x64, 0xd8, 0x78, 0xc5, 0xeb, 0xb6, 0x35, 0x81, 0x3e, 0x84, 0xd9, 0xe2, 0x98,
+	0xa3, 0xb5, 0x11, 0xef, 0xf1, 0x9b, 0xa2, 0xd9, 0xb8, 0x6d, 0x1a, 0x66, 0xd8, 0xfd, 0x65, 0x09,
+	0x96, 0xac, 0x3d, 0x76, 0xc0, 0x32, 0xfe, 0x82, 0x5d, 0x5a, 0x83, 0x6f, 0x95, 0x5c, 0xee, 0xf8,
+	0xd5, 0x35, 0x26, 0xf7, 0xa5, 0x17, 0x76, 0x73, 0xe3, 0x4e, 0x8f, 0x2b, 0xb9, 0xdf, 0x86, 0x69,
+	0x71, 0x4d, 0xa0, 0xfa, 0x88, 0xef, 0xe8, 0x0d, 0xd3, 0x5c, 0xbd, 0x69, 0x18, 0xc6, 0xee, 0xaf,
+	0x7f, 0xb2, 0x26, 0x4c, 0x8f, 0xf2, 0xff, 0xcf, 0x6e, 0x10, 0xf7, 0xbd, 0x47, 0xdd, 0xb8, 0xf8,
+	0x23, 0x7d, 0x32, 0x23, 0x7e, 0xdf, 0xfb, 0xdf, 0x00, 0x05, 0x19, 0x7e, 0x20, 0x5d, 0x0f, 0x00,
+	0x00,
 }
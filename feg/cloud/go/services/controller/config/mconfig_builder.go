@@ -16,19 +16,48 @@ import (
 	"magma/feg/cloud/go/protos/mconfig"
 	config_protos "magma/feg/cloud/go/services/controller/protos"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/config"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
 )
 
 type Builder struct{}
 
 func (builder *Builder) Build(networkId string, gatewayId string) (map[string]proto.Message, error) {
-	emptyRet := map[string]proto.Message{}
 	gwConfig, err := GetGatewayConfig(networkId, gatewayId)
 	if err != nil {
-		return emptyRet, err
+		return map[string]proto.Message{}, err
 	}
+	return buildFromConfig(gwConfig)
+}
+
+func (builder *Builder) BuildFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (map[string]proto.Message, error) {
+	gwConfig, err := getGatewayConfigFromGraph(network, graph, gatewayId)
+	if err != nil {
+		return map[string]proto.Message{}, err
+	}
+	return buildFromConfig(gwConfig)
+}
+
+// GetAssociations associates the FeG gateway entities with the magmad
+// gateway entities of the same gateways
+func (builder *Builder) GetAssociations(configType string, key string, cfg []byte) ([]storage.GraphEdge, error) {
+	if configType != FegGatewayType {
+		return nil, nil
+	}
+	return []storage.GraphEdge{
+		{
+			From: orc8r_storage.TypeAndKey{Type: magmad_config.MagmadGatewayType, Key: key},
+			To:   orc8r_storage.TypeAndKey{Type: FegGatewayType, Key: key},
+		},
+	}, nil
+}
+
+func buildFromConfig(gwConfig *config_protos.Config) (map[string]proto.Message, error) {
 	if gwConfig == nil {
-		return emptyRet, nil
+		return map[string]proto.Message{}, nil
 	}
 
 	s6ac := gwConfig.GetS6A()
@@ -113,6 +142,33 @@ func GetGatewayConfig(networkId string, gatewayId string) (*config_protos.Config
 	if err != nil {
 		return nil, err
 	}
+	return blendConfigs(networkCfg, nerr, cfg)
+}
+
+func getGatewayConfigFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (*config_protos.Config, error) {
+	var networkCfg *config_protos.Config
+	netCfg, nerr := serde.Deserialize(config.SerdeDomain, FegNetworkType, network.Configs[FegNetworkType])
+	if nerr == nil && netCfg != nil {
+		var ok bool
+		networkCfg, ok = netCfg.(*config_protos.Config)
+		if !ok {
+			nerr = fmt.Errorf(
+				"received unexpected type for network record. Expected *Config but got %T", netCfg)
+			networkCfg = nil
+		}
+	}
+	gatewayEnt, found := graph.GetEntity(orc8r_storage.TypeAndKey{Type: FegGatewayType, Key: gatewayId})
+	if !found {
+		return networkCfg, nerr
+	}
+	cfg, err := serde.Deserialize(config.SerdeDomain, FegGatewayType, gatewayEnt.Config)
+	if err != nil {
+		return nil, err
+	}
+	return blendConfigs(networkCfg, nerr, cfg)
+}
+
+func blendConfigs(networkCfg *config_protos.Config, nerr error, cfg interface{}) (*config_protos.Config, error) {
 	if cfg == nil {
 		return networkCfg, nerr
 	}
@@ -16,8 +16,12 @@ import (
 	feg_config "magma/feg/cloud/go/services/controller/config"
 	config_protos "magma/feg/cloud/go/services/controller/protos"
 	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/config"
 	config_test_init "magma/orc8r/cloud/go/services/config/test_init"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -183,3 +187,62 @@ func TestControllerBuilder_Build(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+// Building from the graph should give the same mconfig as building from the
+// config service
+func TestControllerBuilder_BuildFromGraph(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &fegplugin.FegOrchestratorPlugin{})
+	config_test_init.StartTestService(t)
+	builder := &feg_config.Builder{}
+
+	network := storage.Network{ID: "network", Configs: map[string][]byte{}}
+	actual, err := builder.BuildFromGraph(network, storage.EntityGraph{}, "feg1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]proto.Message{}, actual)
+
+	netCfg := config_protos.NewDefaultNetworkConfig()
+	gwCfg := proto.Clone(netCfg).(*config_protos.Config)
+	gwCfg.S6A.Server.Address = "127.0.0.1:5555"
+	gwCfg.Health.UpdateFailureThreshold = 4
+	network.Configs[feg_config.FegNetworkType], err = serde.Serialize(config.SerdeDomain, feg_config.FegNetworkType, netCfg)
+	assert.NoError(t, err)
+	gwEntityCfg, err := serde.Serialize(config.SerdeDomain, feg_config.FegGatewayType, gwCfg)
+	assert.NoError(t, err)
+	graph := storage.EntityGraph{
+		Entities: []storage.NetworkEntity{
+			{Type: magmad_config.MagmadGatewayType, Key: "feg1"},
+			{Type: feg_config.FegGatewayType, Key: "feg1", Config: gwEntityCfg},
+		},
+	}
+	actual, err = builder.BuildFromGraph(network, graph, "feg1")
+	assert.NoError(t, err)
+
+	err = config.CreateConfig("network", feg_config.FegNetworkType, "network", netCfg)
+	assert.NoError(t, err)
+	err = config.CreateConfig("network", feg_config.FegGatewayType, "feg1", gwCfg)
+	assert.NoError(t, err)
+	expected, err := builder.Build("network", "feg1")
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:5555", expected["s6a_proxy"].(*mconfig.S6AConfig).Server.Address)
+	assert.Equal(t, len(expected), len(actual))
+	for k, v := range expected {
+		assert.True(t, proto.Equal(v, actual[k]), "mconfig %s: expected %v, got %v", k, v, actual[k])
+	}
+}
+
+func TestControllerBuilder_GetAssociations(t *testing.T) {
+	builder := &feg_config.Builder{}
+	actual, err := builder.GetAssociations(feg_config.FegGatewayType, "feg1", nil)
+	assert.NoError(t, err)
+	expected := []storage.GraphEdge{
+		{
+			From: orc8r_storage.TypeAndKey{Type: magmad_config.MagmadGatewayType, Key: "feg1"},
+			To:   orc8r_storage.TypeAndKey{Type: feg_config.FegGatewayType, Key: "feg1"},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	actual, err = builder.GetAssociations(feg_config.FegNetworkType, "network", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+}
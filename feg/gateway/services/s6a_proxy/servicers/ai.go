@@ -60,6 +60,20 @@ func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequ
 		authInfo.AddAVP(resyncInfo)
 	}
 	m.NewAVP(avp.RequestedEUTRANAuthenticationInfo, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, authInfo)
+	if req.NumRequestedGeranVectors > 0 {
+		geranAuthInfo := &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(
+					avp.NumberOfRequestedVectors,
+					avp.Vbit|avp.Mbit,
+					diameter.Vendor3GPP,
+					datatype.Unsigned32(req.NumRequestedGeranVectors)),
+				diam.NewAVP(
+					avp.ImmediateResponsePreferred, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, datatype.Unsigned32(irp)),
+			},
+		}
+		m.NewAVP(avp.RequestedUTRANGERANAuthenticationInfo, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, geranAuthInfo)
+	}
 
 	err = c.SendRequest(m, retryCount)
 	if err != nil {
@@ -134,6 +148,14 @@ func (s *s6aProxy) AuthenticationInformationImpl(
 									Autn:  ev.AUTN.Serialize(),
 									Kasme: ev.KASME.Serialize()})
 						}
+						for _, gv := range ai.GeranVectors {
+							res.GeranVectors = append(
+								res.GeranVectors,
+								&protos.AuthenticationInformationAnswer_GERANVector{
+									Rand: gv.RAND.Serialize(),
+									Sres: gv.SRES.Serialize(),
+									Kc:   gv.Kc.Serialize()})
+						}
 					}
 					return res, err // the only successful "exit" is here
 				} else {
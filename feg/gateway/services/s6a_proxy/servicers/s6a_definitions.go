@@ -61,6 +61,13 @@ type EUtranVector struct {
 	KASME datatype.OctetString `avp:"KASME"`
 }
 
+// GeranVector is a GSM triplet.
+type GeranVector struct {
+	RAND datatype.OctetString `avp:"RAND"`
+	SRES datatype.OctetString `avp:"SRES"`
+	Kc   datatype.OctetString `avp:"Kc"`
+}
+
 type ExperimentalResult struct {
 	VendorId               uint32 `avp:"Vendor-Id"`
 	ExperimentalResultCode uint32 `avp:"Experimental-Result-Code"`
@@ -68,6 +75,7 @@ type ExperimentalResult struct {
 
 type AuthenticationInfo struct {
 	EUtranVectors []EUtranVector `avp:"E-UTRAN-Vector"`
+	GeranVectors  []GeranVector  `avp:"GERAN-Vector"`
 }
 
 type AIA struct {
@@ -320,16 +328,25 @@ type RequestedEUTRANAuthInfo struct {
 	ResyncInfo        datatype.OctetString `avp:"Re-synchronization-Info"`
 }
 
+// RequestedUtranGeranAuthInfo contains the information needed for authentication requests
+// for UTRAN or GERAN.
+type RequestedUtranGeranAuthInfo struct {
+	NumVectors        datatype.Unsigned32  `avp:"Number-Of-Requested-Vectors"`
+	ImmediateResponse datatype.Unsigned32  `avp:"Immediate-Response-Preferred"`
+	ResyncInfo        datatype.OctetString `avp:"Re-synchronization-Info"`
+}
+
 // AIR encapsulates all of the information contained in an authentication information request.
 // This information is sent to fetch data in order to authenticate a subscriber.
 type AIR struct {
-	SessionID               datatype.UTF8String       `avp:"Session-Id"`
-	OriginHost              datatype.DiameterIdentity `avp:"Origin-Host"`
-	OriginRealm             datatype.DiameterIdentity `avp:"Origin-Realm"`
-	AuthSessionState        datatype.UTF8String       `avp:"Auth-Session-State"`
-	UserName                string                    `avp:"User-Name"`
-	VisitedPLMNID           datatype.Unsigned32       `avp:"Visited-PLMN-Id"`
-	RequestedEUTRANAuthInfo RequestedEUTRANAuthInfo   `avp:"Requested-EUTRAN-Authentication-Info"`
+	SessionID                   datatype.UTF8String         `avp:"Session-Id"`
+	OriginHost                  datatype.DiameterIdentity   `avp:"Origin-Host"`
+	OriginRealm                 datatype.DiameterIdentity   `avp:"Origin-Realm"`
+	AuthSessionState            datatype.UTF8String         `avp:"Auth-Session-State"`
+	UserName                    string                      `avp:"User-Name"`
+	VisitedPLMNID               datatype.Unsigned32         `avp:"Visited-PLMN-Id"`
+	RequestedEUTRANAuthInfo     RequestedEUTRANAuthInfo     `avp:"Requested-EUTRAN-Authentication-Info"`
+	RequestedUtranGeranAuthInfo RequestedUtranGeranAuthInfo `avp:"Requested-UTRAN-GERAN-Authentication-Info"`
 }
 
 // ULR is an update location request. It is used to update location information in the HSS.
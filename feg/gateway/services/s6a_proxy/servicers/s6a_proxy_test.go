@@ -86,6 +86,7 @@ func TestS6aProxyService(t *testing.T) {
 		VisitedPlmn:                []byte(test.TEST_PLMN_ID),
 		NumRequestedEutranVectors:  3,
 		ImmediateResponsePreferred: true,
+		NumRequestedGeranVectors:   2,
 	}
 	complChan := make(chan error, TEST_LOOPS+1)
 	testLoopF := func() {
@@ -103,6 +104,9 @@ func TestS6aProxyService(t *testing.T) {
 		if len(r.EutranVectors) != 3 {
 			t.Errorf("Unexpected Number of EutranVectors: %d, Expected: 3", len(r.EutranVectors))
 		}
+		if len(r.GeranVectors) != 2 {
+			t.Errorf("Unexpected Number of GeranVectors: %d, Expected: 2", len(r.GeranVectors))
+		}
 		ulReq := &protos.UpdateLocationRequest{
 			UserName:           test.TEST_IMSI,
 			VisitedPlmn:        []byte(test.TEST_PLMN_ID),
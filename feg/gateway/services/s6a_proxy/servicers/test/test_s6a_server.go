@@ -103,14 +103,14 @@ func testHandleAIR(settings *sm.Settings) diam.HandlerFunc {
 		a.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
 		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
 		a.NewAVP(avp.OriginStateID, avp.Mbit, 0, settings.OriginStateID)
-		_, err = testSendAIA(c, a, int(req.RequestedEUTRANAuthInfo.NumVectors))
+		_, err = testSendAIA(c, a, int(req.RequestedEUTRANAuthInfo.NumVectors), int(req.RequestedUtranGeranAuthInfo.NumVectors))
 		if err != nil {
 			fmt.Printf("Failed to send AIA: %s", err.Error())
 		}
 	}
 }
 
-func testSendAIA(w io.Writer, m *diam.Message, vectors int) (n int64, err error) {
+func testSendAIA(w io.Writer, m *diam.Message, vectors, geranVectors int) (n int64, err error) {
 	if vectors < 0 {
 		vectors = 1
 	}
@@ -131,6 +131,22 @@ func testSendAIA(w io.Writer, m *diam.Message, vectors int) (n int64, err error)
 			},
 		})
 	}
+	if geranVectors > 5 {
+		geranVectors = 5
+	}
+	for ; geranVectors > 0; geranVectors-- {
+		m.NewAVP(avp.AuthenticationInfo, avp.Mbit, VENDOR_3GPP, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.GERANVector, avp.Mbit, VENDOR_3GPP, &diam.GroupedAVP{
+					AVP: []*diam.AVP{
+						diam.NewAVP(avp.RAND, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.OctetString("#U<\xbe\x967\xa8\x9d!\x8a\xe6M\xaeG\xbf"+strconv.Itoa(geranVectors))),
+						diam.NewAVP(avp.SRES, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.OctetString("F\xf8Aj")),
+						diam.NewAVP(avp.Kc, avp.Mbit|avp.Vbit, VENDOR_3GPP, datatype.OctetString("\xea\xe4\xbe\x82:\xf9\xa0\x8b")),
+					},
+				}),
+			},
+		})
+	}
 	return m.WriteTo(w)
 }
 
@@ -45,9 +45,9 @@ func NewAIA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return ConstructFailureAnswer(msg, air.SessionID, srv.Config.Server, uint32(fegprotos.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE)), err
 	}
 
-	lteAuthNextSeq, err := servicers.ResyncLteAuthSeq(subscriber, air.RequestedEUTRANAuthInfo.ResyncInfo.Serialize(), srv.Config.LteAuthOp)
+	err = servicers.ResyncLteAuthSeq(subscriber, air.RequestedEUTRANAuthInfo.ResyncInfo.Serialize(), srv.Config.LteAuthOp, srv.SqnConfig)
 	if err == nil {
-		err = srv.setLteAuthNextSeq(subscriber, lteAuthNextSeq)
+		err = srv.storeSubscriberState(subscriber)
 	}
 	if err != nil {
 		return ConvertAuthErrorToFailureMessage(err, msg, air.SessionID, srv.Config.Server), err
@@ -56,30 +56,55 @@ func NewAIA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 	const plmnOffsetBytes = 1
 	plmn := air.VisitedPLMNID.Serialize()[plmnOffsetBytes:]
 
-	vectors, lteAuthNextSeq, err := servicers.GenerateLteAuthVectors(uint32(air.RequestedEUTRANAuthInfo.NumVectors),
-		srv.Milenage, subscriber, plmn, srv.Config.LteAuthOp, srv.AuthSqnInd)
+	cipher, err := srv.getAuthCipher(subscriber.Lte)
+	if err != nil {
+		return ConvertAuthErrorToFailureMessage(err, msg, air.SessionID, srv.Config.Server), err
+	}
+	vectors, err := servicers.GenerateLteAuthVectors(uint32(air.RequestedEUTRANAuthInfo.NumVectors),
+		cipher, subscriber, plmn, srv.Config.LteAuthOp, srv.SqnConfig)
 	if err == nil {
-		err = srv.setLteAuthNextSeq(subscriber, lteAuthNextSeq)
+		err = srv.storeSubscriberState(subscriber)
 	}
 	if err != nil {
 		return ConvertAuthErrorToFailureMessage(err, msg, air.SessionID, srv.Config.Server), err
 	}
 
-	return srv.NewSuccessfulAIA(msg, air.SessionID, vectors), nil
+	var triplets []*crypto.GsmTriplet
+	if numTriplets := uint32(air.RequestedUtranGeranAuthInfo.NumVectors); numTriplets > 0 {
+		triplets, err = servicers.GenerateGsmAuthTriplets(numTriplets, srv.Milenage, subscriber, srv.Config.LteAuthOp)
+		if err != nil {
+			return ConvertAuthErrorToFailureMessage(err, msg, air.SessionID, srv.Config.Server), err
+		}
+	}
+
+	return srv.NewSuccessfulAIA(msg, air.SessionID, vectors, triplets), nil
+}
+
+// getAuthCipher returns the cipher for the subscriber's authentication algorithm.
+// Milenage subscribers share the server's cipher.
+func (srv *HomeSubscriberServer) getAuthCipher(lte *lteprotos.LTESubscription) (crypto.AuthCipher, error) {
+	if lte.GetAuthAlgo() == lteprotos.LTESubscription_MILENAGE {
+		return srv.Milenage, nil
+	}
+	cipher, err := servicers.NewLteAuthCipher(lte, srv.Config.LteAuthAmf)
+	if err != nil {
+		return nil, servicers.NewAuthRejectedError(err.Error())
+	}
+	return cipher, nil
 }
 
-func (srv *HomeSubscriberServer) setLteAuthNextSeq(subscriber *lteprotos.SubscriberData, lteAuthNextSeq uint64) error {
+// storeSubscriberState stores the subscriber's sequence number state.
+func (srv *HomeSubscriberServer) storeSubscriberState(subscriber *lteprotos.SubscriberData) error {
 	if subscriber.GetState() == nil {
 		return servicers.NewAuthDataUnavailableError("subscriber state was nil")
 	}
-	subscriber.State.LteAuthNextSeq = lteAuthNextSeq
 	return srv.store.UpdateSubscriber(subscriber)
 }
 
 // NewSuccessfulAIA outputs a successful authentication information answer (AIA) to reply to an
 // authentication information request (AIR) message. It populates AIA with all of the mandatory fields
-// and adds the authentication vectors.
-func (srv *HomeSubscriberServer) NewSuccessfulAIA(msg *diam.Message, sessionID datatype.UTF8String, vectors []*crypto.EutranVector) *diam.Message {
+// and adds the E-UTRAN and GERAN authentication vectors.
+func (srv *HomeSubscriberServer) NewSuccessfulAIA(msg *diam.Message, sessionID datatype.UTF8String, vectors []*crypto.EutranVector, triplets []*crypto.GsmTriplet) *diam.Message {
 	answer := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_S6A_APP_ID)
 	for itemNumber, vector := range vectors {
 		answer.NewAVP(avp.AuthenticationInfo, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
@@ -96,6 +121,20 @@ func (srv *HomeSubscriberServer) NewSuccessfulAIA(msg *diam.Message, sessionID d
 			},
 		})
 	}
+	for itemNumber, triplet := range triplets {
+		answer.NewAVP(avp.AuthenticationInfo, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.GERANVector, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+					AVP: []*diam.AVP{
+						diam.NewAVP(avp.ItemNumber, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(itemNumber)),
+						diam.NewAVP(avp.RAND, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(triplet.Rand[:])),
+						diam.NewAVP(avp.SRES, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(triplet.Sres[:])),
+						diam.NewAVP(avp.Kc, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(triplet.Kc[:])),
+					},
+				}),
+			},
+		})
+	}
 	return answer
 }
 
@@ -112,7 +151,10 @@ func ValidateAIR(msg *diam.Message) error {
 	}
 	_, err = msg.FindAVP(avp.RequestedEUTRANAuthenticationInfo, diameter.Vendor3GPP)
 	if err != nil {
-		return errors.New("Missing requested E-UTRAN authentication info in message")
+		_, err = msg.FindAVP(avp.RequestedUTRANGERANAuthenticationInfo, diameter.Vendor3GPP)
+	}
+	if err != nil {
+		return errors.New("Missing requested E-UTRAN or UTRAN/GERAN authentication info in message")
 	}
 	_, err = msg.FindAVP(avp.SessionID, 0)
 	if err != nil {
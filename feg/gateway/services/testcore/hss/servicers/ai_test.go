@@ -116,6 +116,40 @@ func TestNewAIA_MultipleVectors(t *testing.T) {
 	}
 }
 
+func TestNewAIA_GeranVectors(t *testing.T) {
+	server := test.NewTestHomeSubscriberServer(t)
+	air := createGeranAIR("sub1", 2)
+	response, err := hss.NewAIA(server, air)
+	assert.NoError(t, err)
+
+	var aia definitions.AIA
+	err = response.Unmarshal(&aia)
+	assert.NoError(t, err)
+	assert.Equal(t, diam.Success, int(aia.ResultCode))
+	assert.Equal(t, 2, len(aia.AIs))
+
+	for _, ai := range aia.AIs {
+		assert.Equal(t, 0, len(ai.EUtranVectors))
+		assert.Equal(t, 1, len(ai.GeranVectors))
+		vector := ai.GeranVectors[0]
+		assert.Equal(t, crypto.RandChallengeBytes, len(vector.RAND))
+		assert.Equal(t, crypto.SresBytes, len(vector.SRES))
+		assert.Equal(t, crypto.KcBytes, len(vector.Kc))
+	}
+}
+
+func TestNewAIA_GeranVectorsMissingGsmSubscription(t *testing.T) {
+	server := test.NewTestHomeSubscriberServer(t)
+	air := createGeranAIR("missing_auth_key", 1)
+	response, err := hss.NewAIA(server, air)
+	assert.Exactly(t, servicers.NewAuthRejectedError("Subscriber data missing GSM subscription"), err)
+
+	var aia definitions.AIA
+	err = response.Unmarshal(&aia)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(protos.ErrorCode_AUTHORIZATION_REJECTED), aia.ExperimentalResult.ExperimentalResultCode)
+}
+
 func TestNewAIA_MissingAuthKey(t *testing.T) {
 	server := test.NewTestHomeSubscriberServer(t)
 
@@ -180,7 +214,7 @@ func TestValidateAIR_MissingEUTRANInfo(t *testing.T) {
 	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String("magma"))
 	m.NewAVP(avp.VisitedPLMNID, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(0))
 
-	assert.EqualError(t, hss.ValidateAIR(m), "Missing requested E-UTRAN authentication info in message")
+	assert.EqualError(t, hss.ValidateAIR(m), "Missing requested E-UTRAN or UTRAN/GERAN authentication info in message")
 }
 
 func TestValidateAIR_MissingSessionId(t *testing.T) {
@@ -245,6 +279,28 @@ func createAIRExtended(userName string, numRequestedVectors uint32) *diam.Messag
 	return m
 }
 
+// createGeranAIR outputs a mock authentication information request which
+// only requests GERAN vectors.
+func createGeranAIR(userName string, numRequestedVectors uint32) *diam.Message {
+	m := createBaseAIR()
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("magma;123_1234"))
+	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(userName))
+	m.NewAVP(avp.VisitedPLMNID, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(0))
+	authInfo := &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(
+				avp.NumberOfRequestedVectors,
+				avp.Vbit|avp.Mbit,
+				diameter.Vendor3GPP,
+				datatype.Unsigned32(numRequestedVectors)),
+			diam.NewAVP(
+				avp.ImmediateResponsePreferred, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, datatype.Unsigned32(0)),
+		},
+	}
+	m.NewAVP(avp.RequestedUTRANGERANAuthenticationInfo, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, authInfo)
+	return m
+}
+
 func TestNewSuccessfulAIA(t *testing.T) {
 	server := test.NewTestHomeSubscriberServer(t)
 	serverCfg := server.Config.Server
@@ -260,7 +316,7 @@ func TestNewSuccessfulAIA(t *testing.T) {
 	copy(vector.Autn[:], []byte("o\xbf\xa3\x80\x1fW\x80\x00{\xdeY\x88n\x96\xe4\xfe"))
 	copy(vector.Kasme[:], []byte("\x87H\xc1\xc0\xa2\x82o\xa4\x05\xb1\xe2~\xa1\x04CJ\xe5V\xc7e\xe8\xf0a\xeb\xdb\x8a\xe2\x86\xc4F\x16\xc2"))
 
-	response := server.NewSuccessfulAIA(msg, air.SessionID, []*crypto.EutranVector{vector})
+	response := server.NewSuccessfulAIA(msg, air.SessionID, []*crypto.EutranVector{vector}, nil)
 	var aia definitions.AIA
 	err = response.Unmarshal(&aia)
 	assert.NoError(t, err)
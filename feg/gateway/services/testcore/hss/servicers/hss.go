@@ -16,6 +16,7 @@ import (
 	"magma/feg/gateway/services/testcore/hss/storage"
 	lteprotos "magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/eps_authentication/crypto"
+	"magma/lte/cloud/go/services/eps_authentication/servicers"
 	"magma/orc8r/cloud/go/protos"
 
 	"github.com/fiorix/go-diameter/diam"
@@ -32,9 +33,9 @@ type HomeSubscriberServer struct {
 	Config   *mconfig.HSSConfig
 	Milenage *crypto.MilenageCipher
 
-	// authSqnInd is an index used in the array scheme described by 3GPP TS 33.102 Appendix C.1.2 and C.2.2.
+	// SqnConfig configures the SQN array scheme described by 3GPP TS 33.102 Appendix C.1.2 and C.3.
 	// SQN consists of two parts (SQN = SEQ||IND).
-	AuthSqnInd uint64
+	SqnConfig servicers.SqnConfig
 }
 
 // NewHomeSubscriberServer initializes a HomeSubscriberServer with an empty accounts map.
@@ -45,9 +46,10 @@ func NewHomeSubscriberServer(store storage.SubscriberStore, config *mconfig.HSSC
 		return nil, err
 	}
 	return &HomeSubscriberServer{
-		store:    store,
-		Config:   config,
-		Milenage: milenage,
+		store:     store,
+		Config:    config,
+		Milenage:  milenage,
+		SqnConfig: servicers.DefaultSqnConfig,
 	}, nil
 }
 
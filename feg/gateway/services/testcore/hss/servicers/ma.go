@@ -62,9 +62,9 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return getRedirectMessage(msg, mar.SessionID, srv.Config.Server, aaaServer), err
 	}
 
-	lteAuthNextSeq, err := servicers.ResyncLteAuthSeq(subscriber, mar.AuthData.Authorization.Serialize(), srv.Config.LteAuthOp)
+	err = servicers.ResyncLteAuthSeq(subscriber, mar.AuthData.Authorization.Serialize(), srv.Config.LteAuthOp, srv.SqnConfig)
 	if err == nil {
-		err = srv.setLteAuthNextSeq(subscriber, lteAuthNextSeq)
+		err = srv.storeSubscriberState(subscriber)
 	}
 	if err != nil {
 		return ConvertAuthErrorToFailureMessage(err, msg, mar.SessionID, srv.Config.Server), err
@@ -75,9 +75,12 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return ConstructFailureAnswer(msg, mar.SessionID, srv.Config.Server, uint32(diam.UnableToComply)), err
 	}
 
-	vectors, lteAuthNextSeq, err := srv.GenerateSIPAuthVectors(subscriber, mar.NumberAuthItems)
-	if err == nil {
-		err = srv.setLteAuthNextSeq(subscriber, lteAuthNextSeq)
+	vectors, err := srv.GenerateSIPAuthVectors(subscriber, mar.NumberAuthItems)
+	if len(vectors) > 0 {
+		// The SQNs of the generated vectors must not be reused, even if generating the rest failed.
+		if storeErr := srv.storeSubscriberState(subscriber); storeErr != nil {
+			vectors, err = nil, storeErr
+		}
 	}
 	if err != nil {
 		// If we generated any auth vectors successfully, then we can return them.
@@ -115,42 +118,49 @@ func (srv *HomeSubscriberServer) NewSuccessfulMAA(msg *diam.Message, sessionID d
 }
 
 // GenerateSIPAuthVectors generates `numVectors` SIP auth vectors for the subscriber.
-// The vectors and the next value of lteAuthNextSeq are returned (or an error).
-func (srv *HomeSubscriberServer) GenerateSIPAuthVectors(subscriber *lteprotos.SubscriberData, numVectors uint32) ([]*crypto.SIPAuthVector, uint64, error) {
+// The vectors generated before any error are returned along with the error.
+// The subscriber's state is updated and must be stored by the caller if any vectors are returned.
+func (srv *HomeSubscriberServer) GenerateSIPAuthVectors(subscriber *lteprotos.SubscriberData, numVectors uint32) ([]*crypto.SIPAuthVector, error) {
 	var vectors = make([]*crypto.SIPAuthVector, 0, numVectors)
-	lteAuthNextSeq := subscriber.GetState().GetLteAuthNextSeq()
 	for i := uint32(0); i < numVectors; i++ {
-		vector, nextSeq, err := srv.GenerateSIPAuthVector(subscriber)
-		lteAuthNextSeq = nextSeq
+		vector, err := srv.GenerateSIPAuthVector(subscriber)
 		if err != nil {
-			return vectors, 0, err
+			return vectors, err
 		}
 		vectors = append(vectors, vector)
 	}
-	return vectors, lteAuthNextSeq, nil
+	return vectors, nil
 }
 
-// GenerateSIPAuthVector returns the SIP auth vector and the next value of lteAuthNextSeq for the subscriber (or an error).
-func (srv *HomeSubscriberServer) GenerateSIPAuthVector(subscriber *lteprotos.SubscriberData) (*crypto.SIPAuthVector, uint64, error) {
+// GenerateSIPAuthVector returns the SIP auth vector for the subscriber (or an error)
+// and advances the subscriber's SQN state past it.
+func (srv *HomeSubscriberServer) GenerateSIPAuthVector(subscriber *lteprotos.SubscriberData) (*crypto.SIPAuthVector, error) {
 	lte := subscriber.Lte
 	if err := servicers.ValidateLteSubscription(lte); err != nil {
-		return nil, 0, servicers.NewAuthRejectedError(err.Error())
+		return nil, servicers.NewAuthRejectedError(err.Error())
 	}
 	if subscriber.State == nil {
-		return nil, 0, servicers.NewAuthRejectedError("Subscriber data missing subscriber state")
+		return nil, servicers.NewAuthRejectedError("Subscriber data missing subscriber state")
 	}
 
-	opc, err := servicers.GetOrGenerateOpc(lte, srv.Config.LteAuthOp)
+	cipher, err := srv.getAuthCipher(lte)
+	if err != nil {
+		return nil, err
+	}
+	opc, err := servicers.GetLteAuthOperatorKey(lte, srv.Config.LteAuthOp)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	sqn := servicers.SeqToSqn(subscriber.State.LteAuthNextSeq, srv.AuthSqnInd)
-	vector, err := srv.Milenage.GenerateSIPAuthVector(lte.AuthKey, opc, sqn)
+	sqn, err := servicers.NextLteAuthSqn(subscriber.State, srv.SqnConfig)
+	if err != nil {
+		return nil, err
+	}
+	vector, err := cipher.GenerateSIPAuthVector(lte.AuthKey, opc, sqn)
 	if err != nil {
-		return nil, 0, servicers.NewAuthRejectedError(err.Error())
+		return nil, servicers.NewAuthRejectedError(err.Error())
 	}
-	return vector, subscriber.State.LteAuthNextSeq + 1, err
+	return vector, nil
 }
 
 // ValidateMAR returns an error if the message is missing any mandatory AVPs.
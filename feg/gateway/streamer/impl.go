@@ -104,10 +104,11 @@ func (cl *streamerClient) streamUpdates(l *listener) {
 					}
 					break // reconnect and continue or exit
 				}
+				if isKeepalive(updatesBatch) {
+					continue
+				}
 				if !l.Update(updatesBatch) {
 					l.setDone() // Listener indicated not to continue streaming, cleanup and return
-				} else {
-					time.Sleep(StreamingInterval)
 				}
 			}
 			conn.Close()
@@ -127,7 +128,8 @@ func (cl *streamerClient) startStreaming(l *listener) (*grpc.ClientConn, protos.
 	}
 	grpcStreamerClient, err := protos.NewStreamerClient(conn).GetUpdates(
 		context.Background(),
-		&protos.StreamRequest{GatewayId: "", StreamName: l.GetName()},
+		// Persistent streams stay open and the cloud pushes updates as they happen
+		&protos.StreamRequest{GatewayId: "", StreamName: l.GetName(), Persistent: true},
 	)
 	if err != nil {
 		conn.Close()
@@ -136,6 +138,12 @@ func (cl *streamerClient) startStreaming(l *listener) (*grpc.ClientConn, protos.
 	return conn, grpcStreamerClient, err
 }
 
+// isKeepalive returns true for the empty batches the cloud sends on idle
+// persistent streams
+func isKeepalive(batch *protos.DataUpdateBatch) bool {
+	return !batch.GetResync() && len(batch.GetUpdates()) == 0 && len(batch.GetDeletedKeys()) == 0
+}
+
 func (l *listener) clearDone() {
 	atomic.StoreInt32(&l.done, 0)
 }
@@ -14,10 +14,13 @@ import (
 	cellularh "magma/lte/cloud/go/services/cellular/obsidian/handlers"
 	"magma/lte/cloud/go/services/cellular/state"
 	meteringdh "magma/lte/cloud/go/services/meteringd_records/obsidian/handlers"
+	policydbbackup "magma/lte/cloud/go/services/policydb/backup"
 	policydbh "magma/lte/cloud/go/services/policydb/obsidian/handlers"
 	policydbstreamer "magma/lte/cloud/go/services/policydb/streamer"
+	subscriberdbbackup "magma/lte/cloud/go/services/subscriberdb/backup"
 	subscriberdbh "magma/lte/cloud/go/services/subscriberdb/obsidian/handlers"
 	subscriberdbstreamer "magma/lte/cloud/go/services/subscriberdb/streamer"
+	"magma/orc8r/cloud/go/backup"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/registry"
@@ -79,3 +82,10 @@ func (*LteOrchestratorPlugin) GetStreamerProviders() []providers.StreamProvider
 		&policydbstreamer.BaseNamesProvider{},
 	}
 }
+
+func (*LteOrchestratorPlugin) GetBackupSections() []backup.Section {
+	return []backup.Section{
+		&policydbbackup.PoliciesSection{},
+		&subscriberdbbackup.SubscribersSection{},
+	}
+}
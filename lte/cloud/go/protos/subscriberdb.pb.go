@@ -52,7 +52,7 @@ func (x AccessNetworkIdentifier) String() string {
 	return proto.EnumName(AccessNetworkIdentifier_name, int32(x))
 }
 func (AccessNetworkIdentifier) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{0}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{0}
 }
 
 type SubscriberID_IDType int32
@@ -72,7 +72,7 @@ func (x SubscriberID_IDType) String() string {
 	return proto.EnumName(SubscriberID_IDType_name, int32(x))
 }
 func (SubscriberID_IDType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{0, 0}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{0, 0}
 }
 
 type GSMSubscription_GSMSubscriptionState int32
@@ -95,27 +95,33 @@ func (x GSMSubscription_GSMSubscriptionState) String() string {
 	return proto.EnumName(GSMSubscription_GSMSubscriptionState_name, int32(x))
 }
 func (GSMSubscription_GSMSubscriptionState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{2, 0}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{2, 0}
 }
 
 type GSMSubscription_GSMAuthAlgo int32
 
 const (
 	GSMSubscription_PRECOMPUTED_AUTH_TUPLES GSMSubscription_GSMAuthAlgo = 0
+	// COMP128_V1 = 1; Not supported
+	// Tuples are generated from the USIM's K and OPc in the LTE subscription
+	// with the conversion functions c2 and c3 (3GPP TS 33.102 6.8.1.2).
+	GSMSubscription_MILENAGE GSMSubscription_GSMAuthAlgo = 2
 )
 
 var GSMSubscription_GSMAuthAlgo_name = map[int32]string{
 	0: "PRECOMPUTED_AUTH_TUPLES",
+	2: "MILENAGE",
 }
 var GSMSubscription_GSMAuthAlgo_value = map[string]int32{
 	"PRECOMPUTED_AUTH_TUPLES": 0,
+	"MILENAGE":                2,
 }
 
 func (x GSMSubscription_GSMAuthAlgo) String() string {
 	return proto.EnumName(GSMSubscription_GSMAuthAlgo_name, int32(x))
 }
 func (GSMSubscription_GSMAuthAlgo) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{2, 1}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{2, 1}
 }
 
 type LTESubscription_LTESubscriptionState int32
@@ -138,27 +144,30 @@ func (x LTESubscription_LTESubscriptionState) String() string {
 	return proto.EnumName(LTESubscription_LTESubscriptionState_name, int32(x))
 }
 func (LTESubscription_LTESubscriptionState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{3, 0}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{3, 0}
 }
 
 type LTESubscription_LTEAuthAlgo int32
 
 const (
 	LTESubscription_MILENAGE LTESubscription_LTEAuthAlgo = 0
+	LTESubscription_TUAK     LTESubscription_LTEAuthAlgo = 1
 )
 
 var LTESubscription_LTEAuthAlgo_name = map[int32]string{
 	0: "MILENAGE",
+	1: "TUAK",
 }
 var LTESubscription_LTEAuthAlgo_value = map[string]int32{
 	"MILENAGE": 0,
+	"TUAK":     1,
 }
 
 func (x LTESubscription_LTEAuthAlgo) String() string {
 	return proto.EnumName(LTESubscription_LTEAuthAlgo_name, int32(x))
 }
 func (LTESubscription_LTEAuthAlgo) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{3, 1}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{3, 1}
 }
 
 type APNConfiguration_PDNType int32
@@ -187,7 +196,7 @@ func (x APNConfiguration_PDNType) String() string {
 	return proto.EnumName(APNConfiguration_PDNType_name, int32(x))
 }
 func (APNConfiguration_PDNType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{5, 0}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{5, 0}
 }
 
 type Non3GPPUserProfile_Non3GPPIPAccess int32
@@ -212,7 +221,7 @@ func (x Non3GPPUserProfile_Non3GPPIPAccess) String() string {
 	return proto.EnumName(Non3GPPUserProfile_Non3GPPIPAccess_name, int32(x))
 }
 func (Non3GPPUserProfile_Non3GPPIPAccess) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{7, 0}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{7, 0}
 }
 
 type Non3GPPUserProfile_Non3GPPIPAccessAPN int32
@@ -237,7 +246,41 @@ func (x Non3GPPUserProfile_Non3GPPIPAccessAPN) String() string {
 	return proto.EnumName(Non3GPPUserProfile_Non3GPPIPAccessAPN_name, int32(x))
 }
 func (Non3GPPUserProfile_Non3GPPIPAccessAPN) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{7, 1}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{7, 1}
+}
+
+type SubscriberBatchResult_Status int32
+
+const (
+	// The row was written
+	SubscriberBatchResult_OK SubscriberBatchResult_Status = 0
+	// The row is invalid. No row of the batch was written.
+	SubscriberBatchResult_INVALID SubscriberBatchResult_Status = 1
+	// The row is valid, but no row of the batch was written because other
+	// rows are invalid
+	SubscriberBatchResult_SKIPPED SubscriberBatchResult_Status = 2
+	// Writing the row to the store failed
+	SubscriberBatchResult_FAILED SubscriberBatchResult_Status = 3
+)
+
+var SubscriberBatchResult_Status_name = map[int32]string{
+	0: "OK",
+	1: "INVALID",
+	2: "SKIPPED",
+	3: "FAILED",
+}
+var SubscriberBatchResult_Status_value = map[string]int32{
+	"OK":      0,
+	"INVALID": 1,
+	"SKIPPED": 2,
+	"FAILED":  3,
+}
+
+func (x SubscriberBatchResult_Status) String() string {
+	return proto.EnumName(SubscriberBatchResult_Status_name, int32(x))
+}
+func (SubscriberBatchResult_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{18, 0}
 }
 
 // --------------------------------------------------------------------------
@@ -255,7 +298,7 @@ func (m *SubscriberID) Reset()         { *m = SubscriberID{} }
 func (m *SubscriberID) String() string { return proto.CompactTextString(m) }
 func (*SubscriberID) ProtoMessage()    {}
 func (*SubscriberID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{0}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{0}
 }
 func (m *SubscriberID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriberID.Unmarshal(m, b)
@@ -300,7 +343,7 @@ func (m *SubscriberIDSet) Reset()         { *m = SubscriberIDSet{} }
 func (m *SubscriberIDSet) String() string { return proto.CompactTextString(m) }
 func (*SubscriberIDSet) ProtoMessage()    {}
 func (*SubscriberIDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{1}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{1}
 }
 func (m *SubscriberIDSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriberIDSet.Unmarshal(m, b)
@@ -334,7 +377,7 @@ type GSMSubscription struct {
 	State    GSMSubscription_GSMSubscriptionState `protobuf:"varint,1,opt,name=state,proto3,enum=magma.lte.GSMSubscription_GSMSubscriptionState" json:"state,omitempty"`
 	AuthAlgo GSMSubscription_GSMAuthAlgo          `protobuf:"varint,2,opt,name=auth_algo,json=authAlgo,proto3,enum=magma.lte.GSMSubscription_GSMAuthAlgo" json:"auth_algo,omitempty"`
 	// Authentication key (ki). Need for A3/A8 algos. This is not used
-	// when the auth_algo is PRECOMPUTED_AUTH_TUPLES.
+	// when the auth_algo is PRECOMPUTED_AUTH_TUPLES or MILENAGE.
 	AuthKey []byte `protobuf:"bytes,3,opt,name=auth_key,json=authKey,proto3" json:"auth_key,omitempty"`
 	// Precomputed (rand, sres, kc) tuples. Used when the auth_algo is
 	// PRECOMPUTED_AUTH_TUPLES.
@@ -348,7 +391,7 @@ func (m *GSMSubscription) Reset()         { *m = GSMSubscription{} }
 func (m *GSMSubscription) String() string { return proto.CompactTextString(m) }
 func (*GSMSubscription) ProtoMessage()    {}
 func (*GSMSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{2}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{2}
 }
 func (m *GSMSubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GSMSubscription.Unmarshal(m, b)
@@ -402,7 +445,13 @@ type LTESubscription struct {
 	// Authentication key (k).
 	AuthKey []byte `protobuf:"bytes,3,opt,name=auth_key,json=authKey,proto3" json:"auth_key,omitempty"`
 	// Operator configuration field (Op) signed with authentication key (k)
-	AuthOpc              []byte   `protobuf:"bytes,4,opt,name=auth_opc,json=authOpc,proto3" json:"auth_opc,omitempty"`
+	AuthOpc []byte `protobuf:"bytes,4,opt,name=auth_opc,json=authOpc,proto3" json:"auth_opc,omitempty"`
+	// TUAK operator configuration field (TOP) signed with authentication key (k).
+	// Used when the auth_algo is TUAK.
+	AuthTopc []byte `protobuf:"bytes,5,opt,name=auth_topc,json=authTopc,proto3" json:"auth_topc,omitempty"`
+	// Length of the authentication key in bits. 0 means 128 bits; 256 bit
+	// keys are only supported by TUAK.
+	AuthKeyBits          uint32   `protobuf:"varint,6,opt,name=auth_key_bits,json=authKeyBits,proto3" json:"auth_key_bits,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -412,7 +461,7 @@ func (m *LTESubscription) Reset()         { *m = LTESubscription{} }
 func (m *LTESubscription) String() string { return proto.CompactTextString(m) }
 func (*LTESubscription) ProtoMessage()    {}
 func (*LTESubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{3}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{3}
 }
 func (m *LTESubscription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LTESubscription.Unmarshal(m, b)
@@ -460,24 +509,44 @@ func (m *LTESubscription) GetAuthOpc() []byte {
 	return nil
 }
 
+func (m *LTESubscription) GetAuthTopc() []byte {
+	if m != nil {
+		return m.AuthTopc
+	}
+	return nil
+}
+
+func (m *LTESubscription) GetAuthKeyBits() uint32 {
+	if m != nil {
+		return m.AuthKeyBits
+	}
+	return 0
+}
+
 type SubscriberState struct {
-	// Next SEQ to be used for calculating the AUTN.
+	// Next SEQ to be used for calculating the AUTN. With the SQN array scheme
+	// this is one more than the highest SEQ in lte_auth_seq_array.
 	LteAuthNextSeq uint64 `protobuf:"varint,1,opt,name=lte_auth_next_seq,json=lteAuthNextSeq,proto3" json:"lte_auth_next_seq,omitempty"`
 	// The Diameter address of the 3GPP AAA Server which is serving the user.
 	// An empty string indicates that no server is currently serving the user.
 	TgppAaaServerName string `protobuf:"bytes,2,opt,name=tgpp_aaa_server_name,json=tgppAaaServerName,proto3" json:"tgpp_aaa_server_name,omitempty"`
 	// Whether the subscribers User Status is REGISTERED or NOT_REGISTERED.
-	TgppAaaServerRegistered bool     `protobuf:"varint,3,opt,name=tgpp_aaa_server_registered,json=tgppAaaServerRegistered,proto3" json:"tgpp_aaa_server_registered,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	TgppAaaServerRegistered bool `protobuf:"varint,3,opt,name=tgpp_aaa_server_registered,json=tgppAaaServerRegistered,proto3" json:"tgpp_aaa_server_registered,omitempty"`
+	// Highest SEQ used for each IND by the SQN array scheme (3GPP TS 33.102
+	// Annex C.3), indexed by IND. Empty until lte_auth_next_seq is migrated.
+	LteAuthSeqArray []uint64 `protobuf:"varint,4,rep,packed,name=lte_auth_seq_array,json=lteAuthSeqArray,proto3" json:"lte_auth_seq_array,omitempty"`
+	// IND to be used for calculating the next AUTN.
+	LteAuthNextInd       uint32   `protobuf:"varint,5,opt,name=lte_auth_next_ind,json=lteAuthNextInd,proto3" json:"lte_auth_next_ind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SubscriberState) Reset()         { *m = SubscriberState{} }
 func (m *SubscriberState) String() string { return proto.CompactTextString(m) }
 func (*SubscriberState) ProtoMessage()    {}
 func (*SubscriberState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{4}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{4}
 }
 func (m *SubscriberState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriberState.Unmarshal(m, b)
@@ -518,6 +587,20 @@ func (m *SubscriberState) GetTgppAaaServerRegistered() bool {
 	return false
 }
 
+func (m *SubscriberState) GetLteAuthSeqArray() []uint64 {
+	if m != nil {
+		return m.LteAuthSeqArray
+	}
+	return nil
+}
+
+func (m *SubscriberState) GetLteAuthNextInd() uint32 {
+	if m != nil {
+		return m.LteAuthNextInd
+	}
+	return 0
+}
+
 type APNConfiguration struct {
 	// APN identifier
 	ContextId uint32 `protobuf:"varint,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
@@ -537,7 +620,7 @@ func (m *APNConfiguration) Reset()         { *m = APNConfiguration{} }
 func (m *APNConfiguration) String() string { return proto.CompactTextString(m) }
 func (*APNConfiguration) ProtoMessage()    {}
 func (*APNConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{5}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{5}
 }
 func (m *APNConfiguration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_APNConfiguration.Unmarshal(m, b)
@@ -607,7 +690,7 @@ func (m *APNConfiguration_QoSProfile) Reset()         { *m = APNConfiguration_Qo
 func (m *APNConfiguration_QoSProfile) String() string { return proto.CompactTextString(m) }
 func (*APNConfiguration_QoSProfile) ProtoMessage()    {}
 func (*APNConfiguration_QoSProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{5, 0}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{5, 0}
 }
 func (m *APNConfiguration_QoSProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_APNConfiguration_QoSProfile.Unmarshal(m, b)
@@ -669,7 +752,7 @@ func (m *AggregatedMaximumBitrate) Reset()         { *m = AggregatedMaximumBitra
 func (m *AggregatedMaximumBitrate) String() string { return proto.CompactTextString(m) }
 func (*AggregatedMaximumBitrate) ProtoMessage()    {}
 func (*AggregatedMaximumBitrate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{6}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{6}
 }
 func (m *AggregatedMaximumBitrate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AggregatedMaximumBitrate.Unmarshal(m, b)
@@ -719,7 +802,7 @@ func (m *Non3GPPUserProfile) Reset()         { *m = Non3GPPUserProfile{} }
 func (m *Non3GPPUserProfile) String() string { return proto.CompactTextString(m) }
 func (*Non3GPPUserProfile) ProtoMessage()    {}
 func (*Non3GPPUserProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{7}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{7}
 }
 func (m *Non3GPPUserProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Non3GPPUserProfile.Unmarshal(m, b)
@@ -792,18 +875,21 @@ type SubscriberData struct {
 	NetworkId *protos.NetworkID `protobuf:"bytes,4,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
 	State     *SubscriberState  `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
 	// Subscription profile
-	SubProfile           string              `protobuf:"bytes,6,opt,name=sub_profile,json=subProfile,proto3" json:"sub_profile,omitempty"`
-	Non_3Gpp             *Non3GPPUserProfile `protobuf:"bytes,7,opt,name=non_3gpp,json=non3gpp,proto3" json:"non_3gpp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	SubProfile string              `protobuf:"bytes,6,opt,name=sub_profile,json=subProfile,proto3" json:"sub_profile,omitempty"`
+	Non_3Gpp   *Non3GPPUserProfile `protobuf:"bytes,7,opt,name=non_3gpp,json=non3gpp,proto3" json:"non_3gpp,omitempty"`
+	// Secret key fields encrypted at rest. Only set in the subscriberdb store,
+	// never in subscriber data served by the APIs.
+	EncryptedSecrets     *EncryptedSubscriberSecrets `protobuf:"bytes,8,opt,name=encrypted_secrets,json=encryptedSecrets,proto3" json:"encrypted_secrets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *SubscriberData) Reset()         { *m = SubscriberData{} }
 func (m *SubscriberData) String() string { return proto.CompactTextString(m) }
 func (*SubscriberData) ProtoMessage()    {}
 func (*SubscriberData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{8}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{8}
 }
 func (m *SubscriberData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriberData.Unmarshal(m, b)
@@ -872,6 +958,145 @@ func (m *SubscriberData) GetNon_3Gpp() *Non3GPPUserProfile {
 	return nil
 }
 
+func (m *SubscriberData) GetEncryptedSecrets() *EncryptedSubscriberSecrets {
+	if m != nil {
+		return m.EncryptedSecrets
+	}
+	return nil
+}
+
+// Secret key fields of a subscriber, encrypted together at rest
+type SubscriberSecrets struct {
+	LteAuthKey  []byte `protobuf:"bytes,1,opt,name=lte_auth_key,json=lteAuthKey,proto3" json:"lte_auth_key,omitempty"`
+	LteAuthOpc  []byte `protobuf:"bytes,2,opt,name=lte_auth_opc,json=lteAuthOpc,proto3" json:"lte_auth_opc,omitempty"`
+	LteAuthTopc []byte `protobuf:"bytes,3,opt,name=lte_auth_topc,json=lteAuthTopc,proto3" json:"lte_auth_topc,omitempty"`
+	GsmAuthKey  []byte `protobuf:"bytes,4,opt,name=gsm_auth_key,json=gsmAuthKey,proto3" json:"gsm_auth_key,omitempty"`
+	// Precomputed tuples carry the Kc session keys
+	GsmAuthTuples        [][]byte `protobuf:"bytes,5,rep,name=gsm_auth_tuples,json=gsmAuthTuples,proto3" json:"gsm_auth_tuples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriberSecrets) Reset()         { *m = SubscriberSecrets{} }
+func (m *SubscriberSecrets) String() string { return proto.CompactTextString(m) }
+func (*SubscriberSecrets) ProtoMessage()    {}
+func (*SubscriberSecrets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{9}
+}
+func (m *SubscriberSecrets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriberSecrets.Unmarshal(m, b)
+}
+func (m *SubscriberSecrets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriberSecrets.Marshal(b, m, deterministic)
+}
+func (dst *SubscriberSecrets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriberSecrets.Merge(dst, src)
+}
+func (m *SubscriberSecrets) XXX_Size() int {
+	return xxx_messageInfo_SubscriberSecrets.Size(m)
+}
+func (m *SubscriberSecrets) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriberSecrets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriberSecrets proto.InternalMessageInfo
+
+func (m *SubscriberSecrets) GetLteAuthKey() []byte {
+	if m != nil {
+		return m.LteAuthKey
+	}
+	return nil
+}
+
+func (m *SubscriberSecrets) GetLteAuthOpc() []byte {
+	if m != nil {
+		return m.LteAuthOpc
+	}
+	return nil
+}
+
+func (m *SubscriberSecrets) GetLteAuthTopc() []byte {
+	if m != nil {
+		return m.LteAuthTopc
+	}
+	return nil
+}
+
+func (m *SubscriberSecrets) GetGsmAuthKey() []byte {
+	if m != nil {
+		return m.GsmAuthKey
+	}
+	return nil
+}
+
+func (m *SubscriberSecrets) GetGsmAuthTuples() [][]byte {
+	if m != nil {
+		return m.GsmAuthTuples
+	}
+	return nil
+}
+
+// Envelope of the encrypted SubscriberSecrets of a subscriber. The secrets
+// are encrypted with a random data key, which is itself encrypted with the
+// key encryption key identified by key_id.
+type EncryptedSubscriberSecrets struct {
+	// ID of the key encryption key which wrapped the data key
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Data key encrypted with the key encryption key
+	WrappedDataKey []byte `protobuf:"bytes,2,opt,name=wrapped_data_key,json=wrappedDataKey,proto3" json:"wrapped_data_key,omitempty"`
+	// Marshaled SubscriberSecrets encrypted with the data key
+	Ciphertext           []byte   `protobuf:"bytes,3,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncryptedSubscriberSecrets) Reset()         { *m = EncryptedSubscriberSecrets{} }
+func (m *EncryptedSubscriberSecrets) String() string { return proto.CompactTextString(m) }
+func (*EncryptedSubscriberSecrets) ProtoMessage()    {}
+func (*EncryptedSubscriberSecrets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{10}
+}
+func (m *EncryptedSubscriberSecrets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncryptedSubscriberSecrets.Unmarshal(m, b)
+}
+func (m *EncryptedSubscriberSecrets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncryptedSubscriberSecrets.Marshal(b, m, deterministic)
+}
+func (dst *EncryptedSubscriberSecrets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedSubscriberSecrets.Merge(dst, src)
+}
+func (m *EncryptedSubscriberSecrets) XXX_Size() int {
+	return xxx_messageInfo_EncryptedSubscriberSecrets.Size(m)
+}
+func (m *EncryptedSubscriberSecrets) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedSubscriberSecrets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedSubscriberSecrets proto.InternalMessageInfo
+
+func (m *EncryptedSubscriberSecrets) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *EncryptedSubscriberSecrets) GetWrappedDataKey() []byte {
+	if m != nil {
+		return m.WrappedDataKey
+	}
+	return nil
+}
+
+func (m *EncryptedSubscriberSecrets) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return nil
+}
+
 type SubscriberUpdate struct {
 	// Updated subscription data
 	Data *SubscriberData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
@@ -888,7 +1113,7 @@ func (m *SubscriberUpdate) Reset()         { *m = SubscriberUpdate{} }
 func (m *SubscriberUpdate) String() string { return proto.CompactTextString(m) }
 func (*SubscriberUpdate) ProtoMessage()    {}
 func (*SubscriberUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{9}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{11}
 }
 func (m *SubscriberUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriberUpdate.Unmarshal(m, b)
@@ -937,7 +1162,7 @@ func (m *SubscriberLookup) Reset()         { *m = SubscriberLookup{} }
 func (m *SubscriberLookup) String() string { return proto.CompactTextString(m) }
 func (*SubscriberLookup) ProtoMessage()    {}
 func (*SubscriberLookup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{10}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{12}
 }
 func (m *SubscriberLookup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SubscriberLookup.Unmarshal(m, b)
@@ -982,7 +1207,7 @@ func (m *GetAllSubscriberDataResponse) Reset()         { *m = GetAllSubscriberDa
 func (m *GetAllSubscriberDataResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAllSubscriberDataResponse) ProtoMessage()    {}
 func (*GetAllSubscriberDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_subscriberdb_ab4369fc4534f12d, []int{11}
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{13}
 }
 func (m *GetAllSubscriberDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAllSubscriberDataResponse.Unmarshal(m, b)
@@ -1009,6 +1234,353 @@ func (m *GetAllSubscriberDataResponse) GetSubscribers() []*SubscriberData {
 	return nil
 }
 
+type ListSubscribersRequest struct {
+	NetworkId *protos.NetworkID `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Only list subscribers whose IDs (e.g. IMSI001010000000001) start with
+	// the prefix
+	IdPrefix string `protobuf:"bytes,2,opt,name=id_prefix,json=idPrefix,proto3" json:"id_prefix,omitempty"`
+	// Maximum number of subscribers to return, 0 to return all of them
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token returned with the previous page, empty for the first page
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSubscribersRequest) Reset()         { *m = ListSubscribersRequest{} }
+func (m *ListSubscribersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubscribersRequest) ProtoMessage()    {}
+func (*ListSubscribersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{14}
+}
+func (m *ListSubscribersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscribersRequest.Unmarshal(m, b)
+}
+func (m *ListSubscribersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscribersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListSubscribersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscribersRequest.Merge(dst, src)
+}
+func (m *ListSubscribersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSubscribersRequest.Size(m)
+}
+func (m *ListSubscribersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscribersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscribersRequest proto.InternalMessageInfo
+
+func (m *ListSubscribersRequest) GetNetworkId() *protos.NetworkID {
+	if m != nil {
+		return m.NetworkId
+	}
+	return nil
+}
+
+func (m *ListSubscribersRequest) GetIdPrefix() string {
+	if m != nil {
+		return m.IdPrefix
+	}
+	return ""
+}
+
+func (m *ListSubscribersRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListSubscribersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListSubscribersResponse struct {
+	Sids []*SubscriberID `protobuf:"bytes,1,rep,name=sids,proto3" json:"sids,omitempty"`
+	// Token to request the next page with, empty on the last page
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSubscribersResponse) Reset()         { *m = ListSubscribersResponse{} }
+func (m *ListSubscribersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSubscribersResponse) ProtoMessage()    {}
+func (*ListSubscribersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{15}
+}
+func (m *ListSubscribersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscribersResponse.Unmarshal(m, b)
+}
+func (m *ListSubscribersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscribersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListSubscribersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscribersResponse.Merge(dst, src)
+}
+func (m *ListSubscribersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSubscribersResponse.Size(m)
+}
+func (m *ListSubscribersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscribersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscribersResponse proto.InternalMessageInfo
+
+func (m *ListSubscribersResponse) GetSids() []*SubscriberID {
+	if m != nil {
+		return m.Sids
+	}
+	return nil
+}
+
+func (m *ListSubscribersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type SubscriberBatch struct {
+	NetworkId            *protos.NetworkID `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Subscribers          []*SubscriberData `protobuf:"bytes,2,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SubscriberBatch) Reset()         { *m = SubscriberBatch{} }
+func (m *SubscriberBatch) String() string { return proto.CompactTextString(m) }
+func (*SubscriberBatch) ProtoMessage()    {}
+func (*SubscriberBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{16}
+}
+func (m *SubscriberBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriberBatch.Unmarshal(m, b)
+}
+func (m *SubscriberBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriberBatch.Marshal(b, m, deterministic)
+}
+func (dst *SubscriberBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriberBatch.Merge(dst, src)
+}
+func (m *SubscriberBatch) XXX_Size() int {
+	return xxx_messageInfo_SubscriberBatch.Size(m)
+}
+func (m *SubscriberBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriberBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriberBatch proto.InternalMessageInfo
+
+func (m *SubscriberBatch) GetNetworkId() *protos.NetworkID {
+	if m != nil {
+		return m.NetworkId
+	}
+	return nil
+}
+
+func (m *SubscriberBatch) GetSubscribers() []*SubscriberData {
+	if m != nil {
+		return m.Subscribers
+	}
+	return nil
+}
+
+type SubscriberIDBatch struct {
+	NetworkId            *protos.NetworkID `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Sids                 []*SubscriberID   `protobuf:"bytes,2,rep,name=sids,proto3" json:"sids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SubscriberIDBatch) Reset()         { *m = SubscriberIDBatch{} }
+func (m *SubscriberIDBatch) String() string { return proto.CompactTextString(m) }
+func (*SubscriberIDBatch) ProtoMessage()    {}
+func (*SubscriberIDBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{17}
+}
+func (m *SubscriberIDBatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriberIDBatch.Unmarshal(m, b)
+}
+func (m *SubscriberIDBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriberIDBatch.Marshal(b, m, deterministic)
+}
+func (dst *SubscriberIDBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriberIDBatch.Merge(dst, src)
+}
+func (m *SubscriberIDBatch) XXX_Size() int {
+	return xxx_messageInfo_SubscriberIDBatch.Size(m)
+}
+func (m *SubscriberIDBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriberIDBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriberIDBatch proto.InternalMessageInfo
+
+func (m *SubscriberIDBatch) GetNetworkId() *protos.NetworkID {
+	if m != nil {
+		return m.NetworkId
+	}
+	return nil
+}
+
+func (m *SubscriberIDBatch) GetSids() []*SubscriberID {
+	if m != nil {
+		return m.Sids
+	}
+	return nil
+}
+
+type SubscriberBatchResult struct {
+	// Index of the row in the batch
+	Row    uint32                       `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Sid    *SubscriberID                `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	Status SubscriberBatchResult_Status `protobuf:"varint,3,opt,name=status,proto3,enum=magma.lte.SubscriberBatchResult_Status" json:"status,omitempty"`
+	// Why the row was not written, empty if the status is OK
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriberBatchResult) Reset()         { *m = SubscriberBatchResult{} }
+func (m *SubscriberBatchResult) String() string { return proto.CompactTextString(m) }
+func (*SubscriberBatchResult) ProtoMessage()    {}
+func (*SubscriberBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{18}
+}
+func (m *SubscriberBatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriberBatchResult.Unmarshal(m, b)
+}
+func (m *SubscriberBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriberBatchResult.Marshal(b, m, deterministic)
+}
+func (dst *SubscriberBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriberBatchResult.Merge(dst, src)
+}
+func (m *SubscriberBatchResult) XXX_Size() int {
+	return xxx_messageInfo_SubscriberBatchResult.Size(m)
+}
+func (m *SubscriberBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriberBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriberBatchResult proto.InternalMessageInfo
+
+func (m *SubscriberBatchResult) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *SubscriberBatchResult) GetSid() *SubscriberID {
+	if m != nil {
+		return m.Sid
+	}
+	return nil
+}
+
+func (m *SubscriberBatchResult) GetStatus() SubscriberBatchResult_Status {
+	if m != nil {
+		return m.Status
+	}
+	return SubscriberBatchResult_OK
+}
+
+func (m *SubscriberBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SubscriberBatchResponse struct {
+	// One result per row, in the order of the batch
+	Results              []*SubscriberBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SubscriberBatchResponse) Reset()         { *m = SubscriberBatchResponse{} }
+func (m *SubscriberBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscriberBatchResponse) ProtoMessage()    {}
+func (*SubscriberBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{19}
+}
+func (m *SubscriberBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriberBatchResponse.Unmarshal(m, b)
+}
+func (m *SubscriberBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriberBatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubscriberBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriberBatchResponse.Merge(dst, src)
+}
+func (m *SubscriberBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscriberBatchResponse.Size(m)
+}
+func (m *SubscriberBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriberBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriberBatchResponse proto.InternalMessageInfo
+
+func (m *SubscriberBatchResponse) GetResults() []*SubscriberBatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ReencryptSubscribersResponse struct {
+	// Number of subscribers which were re-encrypted with the current key
+	Reencrypted          uint32   `protobuf:"varint,1,opt,name=reencrypted,proto3" json:"reencrypted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReencryptSubscribersResponse) Reset()         { *m = ReencryptSubscribersResponse{} }
+func (m *ReencryptSubscribersResponse) String() string { return proto.CompactTextString(m) }
+func (*ReencryptSubscribersResponse) ProtoMessage()    {}
+func (*ReencryptSubscribersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_subscriberdb_f30183e993895040, []int{20}
+}
+func (m *ReencryptSubscribersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReencryptSubscribersResponse.Unmarshal(m, b)
+}
+func (m *ReencryptSubscribersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReencryptSubscribersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReencryptSubscribersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReencryptSubscribersResponse.Merge(dst, src)
+}
+func (m *ReencryptSubscribersResponse) XXX_Size() int {
+	return xxx_messageInfo_ReencryptSubscribersResponse.Size(m)
+}
+func (m *ReencryptSubscribersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReencryptSubscribersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReencryptSubscribersResponse proto.InternalMessageInfo
+
+func (m *ReencryptSubscribersResponse) GetReencrypted() uint32 {
+	if m != nil {
+		return m.Reencrypted
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SubscriberID)(nil), "magma.lte.SubscriberID")
 	proto.RegisterType((*SubscriberIDSet)(nil), "magma.lte.SubscriberIDSet")
@@ -1020,9 +1592,18 @@ func init() {
 	proto.RegisterType((*AggregatedMaximumBitrate)(nil), "magma.lte.AggregatedMaximumBitrate")
 	proto.RegisterType((*Non3GPPUserProfile)(nil), "magma.lte.Non3GPPUserProfile")
 	proto.RegisterType((*SubscriberData)(nil), "magma.lte.SubscriberData")
+	proto.RegisterType((*SubscriberSecrets)(nil), "magma.lte.SubscriberSecrets")
+	proto.RegisterType((*EncryptedSubscriberSecrets)(nil), "magma.lte.EncryptedSubscriberSecrets")
 	proto.RegisterType((*SubscriberUpdate)(nil), "magma.lte.SubscriberUpdate")
 	proto.RegisterType((*SubscriberLookup)(nil), "magma.lte.SubscriberLookup")
 	proto.RegisterType((*GetAllSubscriberDataResponse)(nil), "magma.lte.GetAllSubscriberDataResponse")
+	proto.RegisterType((*ListSubscribersRequest)(nil), "magma.lte.ListSubscribersRequest")
+	proto.RegisterType((*ListSubscribersResponse)(nil), "magma.lte.ListSubscribersResponse")
+	proto.RegisterType((*SubscriberBatch)(nil), "magma.lte.SubscriberBatch")
+	proto.RegisterType((*SubscriberIDBatch)(nil), "magma.lte.SubscriberIDBatch")
+	proto.RegisterType((*SubscriberBatchResult)(nil), "magma.lte.SubscriberBatchResult")
+	proto.RegisterType((*SubscriberBatchResponse)(nil), "magma.lte.SubscriberBatchResponse")
+	proto.RegisterType((*ReencryptSubscribersResponse)(nil), "magma.lte.ReencryptSubscribersResponse")
 	proto.RegisterEnum("magma.lte.AccessNetworkIdentifier", AccessNetworkIdentifier_name, AccessNetworkIdentifier_value)
 	proto.RegisterEnum("magma.lte.SubscriberID_IDType", SubscriberID_IDType_name, SubscriberID_IDType_value)
 	proto.RegisterEnum("magma.lte.GSMSubscription_GSMSubscriptionState", GSMSubscription_GSMSubscriptionState_name, GSMSubscription_GSMSubscriptionState_value)
@@ -1032,6 +1613,7 @@ func init() {
 	proto.RegisterEnum("magma.lte.APNConfiguration_PDNType", APNConfiguration_PDNType_name, APNConfiguration_PDNType_value)
 	proto.RegisterEnum("magma.lte.Non3GPPUserProfile_Non3GPPIPAccess", Non3GPPUserProfile_Non3GPPIPAccess_name, Non3GPPUserProfile_Non3GPPIPAccess_value)
 	proto.RegisterEnum("magma.lte.Non3GPPUserProfile_Non3GPPIPAccessAPN", Non3GPPUserProfile_Non3GPPIPAccessAPN_name, Non3GPPUserProfile_Non3GPPIPAccessAPN_value)
+	proto.RegisterEnum("magma.lte.SubscriberBatchResult_Status", SubscriberBatchResult_Status_name, SubscriberBatchResult_Status_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1289,8 +1871,35 @@ type SubscriberDBControllerClient interface {
 	// List the subscribers in the store.
 	//
 	ListSubscribers(ctx context.Context, in *protos.NetworkID, opts ...grpc.CallOption) (*SubscriberIDSet, error)
+	// List the subscribers in the store in ascending order of their IDs, one
+	// page at a time.
+	//
+	ListSubscribersPage(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
 	// Get all subscriber data for the network
 	GetAllSubscriberData(ctx context.Context, in *protos.NetworkID, opts ...grpc.CallOption) (*GetAllSubscriberDataResponse, error)
+	// Adds a batch of new subscribers.
+	// All rows are validated before any is written; if a row is invalid (e.g.
+	// the subscriber already exists) no row is written.
+	//
+	AddSubscribers(ctx context.Context, in *SubscriberBatch, opts ...grpc.CallOption) (*SubscriberBatchResponse, error)
+	// Updates a batch of existing subscribers.
+	// All rows are validated before any is written; if a row is invalid (e.g.
+	// the subscriber is missing) no row is written.
+	//
+	UpdateSubscribers(ctx context.Context, in *SubscriberBatch, opts ...grpc.CallOption) (*SubscriberBatchResponse, error)
+	// Deletes a batch of subscribers.
+	// Subscribers which are not present are ignored.
+	//
+	DeleteSubscribers(ctx context.Context, in *SubscriberIDBatch, opts ...grpc.CallOption) (*SubscriberBatchResponse, error)
+	// Streams all subscriber data for the network in ascending order of the
+	// subscriber IDs.
+	//
+	ExportSubscribers(ctx context.Context, in *protos.NetworkID, opts ...grpc.CallOption) (SubscriberDBController_ExportSubscribersClient, error)
+	// Re-encrypts the secrets of all subscribers of the network which are not
+	// encrypted with the current key, e.g. after a key rotation.
+	// Throws FAILED_PRECONDITION if subscriber encryption is not enabled.
+	//
+	ReencryptSubscribers(ctx context.Context, in *protos.NetworkID, opts ...grpc.CallOption) (*ReencryptSubscribersResponse, error)
 }
 
 type subscriberDBControllerClient struct {
@@ -1346,6 +1955,15 @@ func (c *subscriberDBControllerClient) ListSubscribers(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *subscriberDBControllerClient) ListSubscribersPage(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error) {
+	out := new(ListSubscribersResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.SubscriberDBController/ListSubscribersPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriberDBControllerClient) GetAllSubscriberData(ctx context.Context, in *protos.NetworkID, opts ...grpc.CallOption) (*GetAllSubscriberDataResponse, error) {
 	out := new(GetAllSubscriberDataResponse)
 	err := c.cc.Invoke(ctx, "/magma.lte.SubscriberDBController/GetAllSubscriberData", in, out, opts...)
@@ -1355,6 +1973,74 @@ func (c *subscriberDBControllerClient) GetAllSubscriberData(ctx context.Context,
 	return out, nil
 }
 
+func (c *subscriberDBControllerClient) AddSubscribers(ctx context.Context, in *SubscriberBatch, opts ...grpc.CallOption) (*SubscriberBatchResponse, error) {
+	out := new(SubscriberBatchResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.SubscriberDBController/AddSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriberDBControllerClient) UpdateSubscribers(ctx context.Context, in *SubscriberBatch, opts ...grpc.CallOption) (*SubscriberBatchResponse, error) {
+	out := new(SubscriberBatchResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.SubscriberDBController/UpdateSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriberDBControllerClient) DeleteSubscribers(ctx context.Context, in *SubscriberIDBatch, opts ...grpc.CallOption) (*SubscriberBatchResponse, error) {
+	out := new(SubscriberBatchResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.SubscriberDBController/DeleteSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriberDBControllerClient) ExportSubscribers(ctx context.Context, in *protos.NetworkID, opts ...grpc.CallOption) (SubscriberDBController_ExportSubscribersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SubscriberDBController_serviceDesc.Streams[0], "/magma.lte.SubscriberDBController/ExportSubscribers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &subscriberDBControllerExportSubscribersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SubscriberDBController_ExportSubscribersClient interface {
+	Recv() (*SubscriberData, error)
+	grpc.ClientStream
+}
+
+type subscriberDBControllerExportSubscribersClient struct {
+	grpc.ClientStream
+}
+
+func (x *subscriberDBControllerExportSubscribersClient) Recv() (*SubscriberData, error) {
+	m := new(SubscriberData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *subscriberDBControllerClient) ReencryptSubscribers(ctx context.Context, in *protos.NetworkID, opts ...grpc.CallOption) (*ReencryptSubscribersResponse, error) {
+	out := new(ReencryptSubscribersResponse)
+	err := c.cc.Invoke(ctx, "/magma.lte.SubscriberDBController/ReencryptSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriberDBControllerServer is the server API for SubscriberDBController service.
 type SubscriberDBControllerServer interface {
 	// Adds a new subscriber to the store.
@@ -1376,8 +2062,35 @@ type SubscriberDBControllerServer interface {
 	// List the subscribers in the store.
 	//
 	ListSubscribers(context.Context, *protos.NetworkID) (*SubscriberIDSet, error)
+	// List the subscribers in the store in ascending order of their IDs, one
+	// page at a time.
+	//
+	ListSubscribersPage(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
 	// Get all subscriber data for the network
 	GetAllSubscriberData(context.Context, *protos.NetworkID) (*GetAllSubscriberDataResponse, error)
+	// Adds a batch of new subscribers.
+	// All rows are validated before any is written; if a row is invalid (e.g.
+	// the subscriber already exists) no row is written.
+	//
+	AddSubscribers(context.Context, *SubscriberBatch) (*SubscriberBatchResponse, error)
+	// Updates a batch of existing subscribers.
+	// All rows are validated before any is written; if a row is invalid (e.g.
+	// the subscriber is missing) no row is written.
+	//
+	UpdateSubscribers(context.Context, *SubscriberBatch) (*SubscriberBatchResponse, error)
+	// Deletes a batch of subscribers.
+	// Subscribers which are not present are ignored.
+	//
+	DeleteSubscribers(context.Context, *SubscriberIDBatch) (*SubscriberBatchResponse, error)
+	// Streams all subscriber data for the network in ascending order of the
+	// subscriber IDs.
+	//
+	ExportSubscribers(*protos.NetworkID, SubscriberDBController_ExportSubscribersServer) error
+	// Re-encrypts the secrets of all subscribers of the network which are not
+	// encrypted with the current key, e.g. after a key rotation.
+	// Throws FAILED_PRECONDITION if subscriber encryption is not enabled.
+	//
+	ReencryptSubscribers(context.Context, *protos.NetworkID) (*ReencryptSubscribersResponse, error)
 }
 
 func RegisterSubscriberDBControllerServer(s *grpc.Server, srv SubscriberDBControllerServer) {
@@ -1474,6 +2187,24 @@ func _SubscriberDBController_ListSubscribers_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriberDBController_ListSubscribersPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberDBControllerServer).ListSubscribersPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.SubscriberDBController/ListSubscribersPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberDBControllerServer).ListSubscribersPage(ctx, req.(*ListSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubscriberDBController_GetAllSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(protos.NetworkID)
 	if err := dec(in); err != nil {
@@ -1492,6 +2223,99 @@ func _SubscriberDBController_GetAllSubscriberData_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriberDBController_AddSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriberBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberDBControllerServer).AddSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.SubscriberDBController/AddSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberDBControllerServer).AddSubscribers(ctx, req.(*SubscriberBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriberDBController_UpdateSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriberBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberDBControllerServer).UpdateSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.SubscriberDBController/UpdateSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberDBControllerServer).UpdateSubscribers(ctx, req.(*SubscriberBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriberDBController_DeleteSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriberIDBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberDBControllerServer).DeleteSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.SubscriberDBController/DeleteSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberDBControllerServer).DeleteSubscribers(ctx, req.(*SubscriberIDBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriberDBController_ExportSubscribers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(protos.NetworkID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubscriberDBControllerServer).ExportSubscribers(m, &subscriberDBControllerExportSubscribersServer{stream})
+}
+
+type SubscriberDBController_ExportSubscribersServer interface {
+	Send(*SubscriberData) error
+	grpc.ServerStream
+}
+
+type subscriberDBControllerExportSubscribersServer struct {
+	grpc.ServerStream
+}
+
+func (x *subscriberDBControllerExportSubscribersServer) Send(m *SubscriberData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SubscriberDBController_ReencryptSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.NetworkID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberDBControllerServer).ReencryptSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.lte.SubscriberDBController/ReencryptSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberDBControllerServer).ReencryptSubscribers(ctx, req.(*protos.NetworkID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SubscriberDBController_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.lte.SubscriberDBController",
 	HandlerType: (*SubscriberDBControllerServer)(nil),
@@ -1516,113 +2340,176 @@ var _SubscriberDBController_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListSubscribers",
 			Handler:    _SubscriberDBController_ListSubscribers_Handler,
 		},
+		{
+			MethodName: "ListSubscribersPage",
+			Handler:    _SubscriberDBController_ListSubscribersPage_Handler,
+		},
 		{
 			MethodName: "GetAllSubscriberData",
 			Handler:    _SubscriberDBController_GetAllSubscriberData_Handler,
 		},
+		{
+			MethodName: "AddSubscribers",
+			Handler:    _SubscriberDBController_AddSubscribers_Handler,
+		},
+		{
+			MethodName: "UpdateSubscribers",
+			Handler:    _SubscriberDBController_UpdateSubscribers_Handler,
+		},
+		{
+			MethodName: "DeleteSubscribers",
+			Handler:    _SubscriberDBController_DeleteSubscribers_Handler,
+		},
+		{
+			MethodName: "ReencryptSubscribers",
+			Handler:    _SubscriberDBController_ReencryptSubscribers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSubscribers",
+			Handler:       _SubscriberDBController_ExportSubscribers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lte/protos/subscriberdb.proto",
 }
 
 func init() {
-	proto.RegisterFile("lte/protos/subscriberdb.proto", fileDescriptor_subscriberdb_ab4369fc4534f12d)
-}
-
-var fileDescriptor_subscriberdb_ab4369fc4534f12d = []byte{
-	// 1493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x72, 0xdb, 0x36,
-	0x16, 0xd6, 0x9f, 0x7f, 0x74, 0x64, 0xcb, 0x34, 0xc6, 0xb1, 0x65, 0x39, 0xde, 0x78, 0x99, 0xd9,
-	0x5d, 0x27, 0xd9, 0xc8, 0x19, 0x79, 0x93, 0xcd, 0x6e, 0x66, 0xda, 0x52, 0x96, 0xe2, 0x70, 0x2a,
-	0xd1, 0x2a, 0x24, 0x3b, 0x6d, 0x7a, 0xc1, 0x81, 0x44, 0x58, 0xe1, 0x98, 0x7f, 0x26, 0xa1, 0xc4,
-	0x7e, 0x90, 0xbe, 0x40, 0x2f, 0xfb, 0x02, 0xbd, 0xeb, 0x23, 0xf4, 0xb2, 0x37, 0xbd, 0xef, 0x73,
-	0x74, 0x00, 0x92, 0x12, 0x2d, 0x4b, 0x6a, 0xdc, 0x5e, 0x89, 0x38, 0xe7, 0x3b, 0x1f, 0x0e, 0x3e,
-	0x9c, 0x03, 0x40, 0xb0, 0x6b, 0x31, 0x7a, 0xe0, 0xf9, 0x2e, 0x73, 0x83, 0x83, 0x60, 0xd8, 0x0b,
-	0xfa, 0xbe, 0xd9, 0xa3, 0xbe, 0xd1, 0xab, 0x08, 0x1b, 0xca, 0xdb, 0x64, 0x60, 0x93, 0x8a, 0xc5,
-	0x68, 0x79, 0xdb, 0xf5, 0xfb, 0x2f, 0xfd, 0x18, 0xdb, 0x77, 0x6d, 0xdb, 0x75, 0x42, 0x54, 0x79,
-	0x6f, 0xe0, 0xba, 0x03, 0x2b, 0xe2, 0xe9, 0x0d, 0xcf, 0x0f, 0xce, 0x4d, 0x6a, 0x19, 0xba, 0x4d,
-	0x82, 0x8b, 0x10, 0x21, 0x9f, 0xc3, 0x4a, 0x67, 0xc4, 0xae, 0xd6, 0x51, 0x11, 0x32, 0xa6, 0x51,
-	0x4a, 0xef, 0xa5, 0xf7, 0xf3, 0x38, 0x63, 0x1a, 0xa8, 0x0a, 0x39, 0x76, 0xed, 0xd1, 0x52, 0x66,
-	0x2f, 0xbd, 0x5f, 0xac, 0xfe, 0xad, 0x32, 0x9a, 0xb6, 0x92, 0x0c, 0xab, 0xa8, 0xf5, 0xee, 0xb5,
-	0x47, 0xb1, 0xc0, 0xca, 0x08, 0x16, 0xc3, 0x31, 0x5a, 0x86, 0x9c, 0xda, 0xea, 0xa8, 0x52, 0x4a,
-	0xfe, 0x0c, 0xd6, 0x92, 0x01, 0x1d, 0xca, 0xd0, 0x13, 0xc8, 0x05, 0xa6, 0x11, 0x94, 0xd2, 0x7b,
-	0xd9, 0xfd, 0x42, 0x75, 0x6b, 0x06, 0x35, 0x16, 0x20, 0xf9, 0xc7, 0x0c, 0xac, 0x1d, 0x77, 0x5a,
-	0x91, 0xc7, 0x63, 0xa6, 0xeb, 0xa0, 0x06, 0x2c, 0x04, 0x8c, 0x30, 0x2a, 0xd2, 0x2d, 0x56, 0x0f,
-	0x12, 0x0c, 0x13, 0xd0, 0xc9, 0x71, 0x87, 0x87, 0xe1, 0x30, 0x1a, 0x1d, 0x41, 0x9e, 0x0c, 0xd9,
-	0x7b, 0x9d, 0x58, 0x03, 0x37, 0x5a, 0xe7, 0x3f, 0xe7, 0x53, 0x29, 0x43, 0xf6, 0x5e, 0xb1, 0x06,
-	0x2e, 0x5e, 0x26, 0xd1, 0x17, 0xda, 0x06, 0xf1, 0xad, 0x5f, 0xd0, 0xeb, 0x52, 0x76, 0x2f, 0xbd,
-	0xbf, 0x82, 0x97, 0xf8, 0xf8, 0x4b, 0x7a, 0x8d, 0x1e, 0x40, 0x41, 0xb8, 0xd8, 0xd0, 0xb3, 0x68,
-	0x50, 0xca, 0xed, 0x65, 0xf7, 0x57, 0x30, 0x70, 0x53, 0x57, 0x58, 0xe4, 0x67, 0xb0, 0x31, 0x2d,
-	0x3f, 0xb4, 0x02, 0xcb, 0xaa, 0xa6, 0x1c, 0x75, 0xd5, 0xb3, 0x86, 0x94, 0x42, 0x00, 0x8b, 0xd1,
-	0x77, 0x5a, 0x7e, 0x0c, 0x85, 0x44, 0x1a, 0x68, 0x07, 0xb6, 0xda, 0xb8, 0x71, 0x74, 0xd2, 0x6a,
-	0x9f, 0x76, 0x1b, 0x75, 0x5d, 0x39, 0xed, 0xbe, 0xd1, 0xbb, 0xa7, 0xed, 0x66, 0xa3, 0x23, 0xa5,
-	0xe4, 0xef, 0x33, 0xb0, 0xd6, 0xec, 0x36, 0x3e, 0x55, 0xb9, 0x09, 0xe8, 0xe4, 0xf8, 0x2e, 0xca,
-	0x4d, 0xa1, 0xba, 0x9b, 0x72, 0xb1, 0xcb, 0xf5, 0xfa, 0xa5, 0xdc, 0xd8, 0x75, 0xe2, 0xf5, 0xb9,
-	0x66, 0xd3, 0x32, 0x9b, 0xa3, 0xd9, 0x0e, 0x14, 0x12, 0x09, 0x70, 0x60, 0x4b, 0x6d, 0x36, 0x34,
-	0xe5, 0xb8, 0x21, 0xa5, 0xe4, 0x1f, 0xd2, 0xc9, 0xfa, 0x0c, 0xa9, 0x1e, 0xc1, 0xba, 0xc5, 0xa8,
-	0x2e, 0x32, 0x70, 0xe8, 0x15, 0xd3, 0x03, 0x7a, 0x29, 0x04, 0xcb, 0xe1, 0xa2, 0xc5, 0x28, 0x67,
-	0xd2, 0xe8, 0x15, 0xeb, 0xd0, 0x4b, 0x74, 0x00, 0x1b, 0x6c, 0xe0, 0x79, 0x3a, 0x21, 0x44, 0x0f,
-	0xa8, 0xff, 0x81, 0xfa, 0xba, 0x43, 0xec, 0xb0, 0x6b, 0xf2, 0x78, 0x9d, 0xfb, 0x14, 0x42, 0x3a,
-	0xc2, 0xa3, 0x11, 0x9b, 0xa2, 0x57, 0x50, 0x9e, 0x0c, 0xf0, 0xe9, 0xc0, 0x0c, 0x18, 0xf5, 0xa9,
-	0x21, 0x64, 0x58, 0xc6, 0x5b, 0x37, 0xc2, 0xf0, 0xc8, 0x2d, 0x7f, 0x97, 0x03, 0x49, 0x69, 0x6b,
-	0x47, 0xae, 0x73, 0x6e, 0x0e, 0x86, 0x3e, 0x11, 0x5b, 0xba, 0x0b, 0xd0, 0x77, 0x1d, 0xc6, 0xf3,
-	0x8c, 0x1a, 0x78, 0x15, 0xe7, 0x23, 0x8b, 0x6a, 0xa0, 0x27, 0xb0, 0xce, 0xe7, 0x31, 0xfb, 0x54,
-	0x0f, 0xa8, 0x45, 0xfb, 0x3c, 0x26, 0x4a, 0x4f, 0x8a, 0x1c, 0x9d, 0xd8, 0x8e, 0x8e, 0xa1, 0x70,
-	0xe9, 0x06, 0xba, 0xe7, 0xbb, 0xe7, 0xa6, 0x45, 0x45, 0x3a, 0x85, 0x1b, 0x3b, 0x3b, 0x39, 0x7b,
-	0xe5, 0x2b, 0xb7, 0xd3, 0x0e, 0xd1, 0x18, 0x2e, 0xdd, 0x20, 0xfa, 0x46, 0xff, 0x85, 0x1c, 0xb1,
-	0x7b, 0xbe, 0xd8, 0xbc, 0x42, 0xf5, 0x61, 0x92, 0x61, 0x30, 0xf0, 0xe9, 0x80, 0x30, 0x6a, 0xb4,
-	0xc8, 0x95, 0x69, 0x0f, 0xed, 0x9a, 0xc9, 0x7c, 0x5e, 0x5a, 0x22, 0x00, 0x3d, 0x87, 0xac, 0x67,
-	0x38, 0xa5, 0x05, 0x51, 0x53, 0x0f, 0xe7, 0xcd, 0xdc, 0xae, 0x6b, 0xe2, 0xe8, 0xe1, 0xf8, 0xf2,
-	0x4f, 0x69, 0x80, 0x71, 0x2a, 0xbc, 0x7e, 0xfa, 0x16, 0x09, 0x82, 0x58, 0x91, 0x05, 0xbc, 0x24,
-	0xc6, 0xaa, 0x81, 0xfe, 0x01, 0x45, 0xcf, 0x37, 0x5d, 0xdf, 0x64, 0xd7, 0xba, 0x45, 0x3f, 0x50,
-	0x4b, 0x88, 0xb1, 0x8a, 0x57, 0x63, 0x6b, 0x93, 0x1b, 0xd1, 0x21, 0xdc, 0xf3, 0x7c, 0x4a, 0x6d,
-	0x51, 0x61, 0x7a, 0x9f, 0x78, 0xa4, 0x67, 0x5a, 0x26, 0xbb, 0x8e, 0xb6, 0x68, 0x63, 0xec, 0x3c,
-	0x1a, 0xf9, 0xd0, 0xff, 0xa0, 0x94, 0x08, 0xfa, 0x30, 0xb4, 0x1c, 0xea, 0xc7, 0x71, 0xb9, 0x70,
-	0x6b, 0xc7, 0xfe, 0xb3, 0xa4, 0x5b, 0x7e, 0x05, 0x4b, 0xd1, 0x82, 0xc4, 0xd9, 0xd9, 0x3e, 0xfb,
-	0x8f, 0x94, 0x8a, 0xbe, 0x5e, 0x48, 0x69, 0x5e, 0xcf, 0xdc, 0x76, 0xf6, 0x42, 0xca, 0x20, 0x09,
-	0x56, 0xf8, 0xb7, 0x7e, 0x82, 0x75, 0xe1, 0xcd, 0xca, 0x0e, 0x94, 0x66, 0xc9, 0x8a, 0xf6, 0x41,
-	0xb2, 0xc9, 0x95, 0xde, 0x23, 0x8e, 0xf1, 0xd1, 0x34, 0xd8, 0x7b, 0x7d, 0x68, 0x45, 0x45, 0x52,
-	0xb4, 0xc9, 0x55, 0x2d, 0x36, 0x9f, 0x5a, 0xb7, 0x91, 0x46, 0xac, 0xcd, 0x0d, 0x64, 0xdd, 0x92,
-	0x7f, 0xce, 0x01, 0xd2, 0x5c, 0xe7, 0xf0, 0xb8, 0xdd, 0x3e, 0x0d, 0xa8, 0x1f, 0xab, 0xbe, 0x09,
-	0x8b, 0x76, 0x60, 0x06, 0x86, 0x13, 0x5d, 0x23, 0xd1, 0x08, 0xbd, 0x03, 0xe4, 0xb8, 0x8e, 0x7e,
-	0xc8, 0xeb, 0xde, 0xf4, 0x74, 0xd2, 0xef, 0xd3, 0x20, 0x88, 0x8e, 0x8d, 0xa7, 0x89, 0x2d, 0xbe,
-	0x4d, 0x19, 0x9b, 0xd4, 0xb6, 0x22, 0x82, 0xf0, 0x9a, 0xe3, 0x3a, 0x9c, 0x47, 0xf5, 0x42, 0x03,
-	0x32, 0x60, 0xf3, 0x36, 0xb7, 0x4e, 0x3c, 0x47, 0x6c, 0x54, 0xb1, 0xfa, 0xec, 0x4e, 0xfc, 0x4a,
-	0x5b, 0xc3, 0x68, 0x62, 0x0a, 0xc5, 0x73, 0xfe, 0x7c, 0x39, 0xff, 0x1f, 0x80, 0x78, 0x8e, 0xde,
-	0x17, 0x95, 0x2b, 0xaa, 0xba, 0x50, 0xdd, 0x99, 0x53, 0xd5, 0x38, 0x4f, 0x3c, 0x27, 0xb4, 0xa0,
-	0xd7, 0xb0, 0x1a, 0x2d, 0xc7, 0xa1, 0xa2, 0xb7, 0x17, 0xc5, 0x8a, 0xe4, 0x64, 0xb8, 0xf0, 0x6b,
-	0x94, 0x7d, 0x74, 0xfd, 0x0b, 0xd5, 0xa0, 0x0e, 0x33, 0xcf, 0x4d, 0xea, 0xe3, 0x02, 0x89, 0x1d,
-	0xaa, 0x21, 0x9f, 0xc1, 0xda, 0xc4, 0x32, 0xd1, 0xdf, 0x61, 0x57, 0x3b, 0xd1, 0x74, 0x6e, 0xd3,
-	0x3b, 0xa7, 0xb5, 0xce, 0x11, 0x56, 0xdb, 0x5d, 0xf5, 0x44, 0xd3, 0x95, 0x66, 0xf3, 0xe4, 0x6d,
-	0xa3, 0x2e, 0xa5, 0xd0, 0x1e, 0xdc, 0x9f, 0x0e, 0xa9, 0x29, 0x18, 0x37, 0xea, 0x52, 0x5a, 0x56,
-	0x47, 0x45, 0x90, 0x90, 0x0f, 0x95, 0x60, 0x63, 0x14, 0xa7, 0xb4, 0xb5, 0x8e, 0xde, 0xd0, 0x94,
-	0x5a, 0x93, 0x9f, 0xc9, 0xdb, 0x70, 0xef, 0xa6, 0xa7, 0xae, 0x76, 0x84, 0x2b, 0x2d, 0xff, 0x9a,
-	0x81, 0xe2, 0xf8, 0x14, 0xae, 0x13, 0x46, 0xd0, 0x23, 0xc8, 0x06, 0x51, 0xf7, 0xce, 0x79, 0x23,
-	0x70, 0x0c, 0xfa, 0x37, 0x64, 0x07, 0x81, 0x2d, 0x0a, 0xaa, 0x50, 0x2d, 0xcf, 0xbe, 0xc1, 0x31,
-	0x87, 0x71, 0xb4, 0xc5, 0xe2, 0xb3, 0xad, 0x3c, 0xfb, 0xd6, 0xc2, 0x1c, 0x86, 0x9e, 0x03, 0x38,
-	0xa1, 0xbc, 0x7c, 0x07, 0xc2, 0xfd, 0xdf, 0x8c, 0x82, 0xc4, 0xf3, 0xab, 0x12, 0xab, 0x5f, 0xc7,
-	0x79, 0x27, 0xde, 0x08, 0xf4, 0x2c, 0xbe, 0x67, 0x17, 0x6e, 0x4d, 0x33, 0x71, 0xdb, 0xc4, 0x57,
-	0xea, 0x03, 0x28, 0x04, 0xc3, 0xde, 0xe8, 0xe8, 0x5d, 0x14, 0x1d, 0x04, 0xc1, 0xb0, 0x17, 0x77,
-	0xd7, 0x4b, 0x58, 0x8e, 0x2b, 0xbd, 0xb4, 0x24, 0x58, 0x77, 0xe7, 0xd6, 0x36, 0x5e, 0x8a, 0x0a,
-	0x59, 0xbe, 0x04, 0x69, 0x3c, 0xe9, 0xa9, 0x67, 0xf0, 0xe9, 0x9e, 0x42, 0xce, 0x20, 0x8c, 0x44,
-	0xfa, 0x6e, 0x4f, 0xcd, 0x8f, 0xef, 0x03, 0x16, 0x30, 0x54, 0x81, 0x1c, 0x7f, 0x3b, 0x8e, 0x34,
-	0x0e, 0x9f, 0x97, 0x95, 0xf8, 0x79, 0x59, 0x79, 0xcd, 0x9f, 0x97, 0x2d, 0x12, 0x5c, 0x60, 0x81,
-	0x93, 0x59, 0x72, 0xca, 0xa6, 0xeb, 0x5e, 0x0c, 0xbd, 0x09, 0x29, 0xd3, 0x9f, 0x2a, 0x65, 0x54,
-	0x08, 0x99, 0x3f, 0x2e, 0x04, 0xf9, 0x5b, 0xb8, 0x7f, 0x4c, 0x99, 0x62, 0x59, 0x13, 0x6b, 0xa0,
-	0x81, 0xe7, 0x3a, 0x01, 0xbf, 0x7c, 0x0b, 0xe3, 0x17, 0x75, 0xfc, 0xfe, 0x9c, 0xb3, 0xf6, 0x24,
-	0xfa, 0xf1, 0x6b, 0xd8, 0x9a, 0xd1, 0x6e, 0xfc, 0x9c, 0x7e, 0x83, 0xdb, 0xbc, 0x6b, 0xf2, 0xb0,
-	0xf0, 0x56, 0x6d, 0x29, 0x5f, 0x4b, 0x69, 0x6e, 0x7c, 0xdb, 0x54, 0x34, 0x29, 0xc3, 0x5f, 0x1c,
-	0x8d, 0xee, 0x9b, 0x06, 0xd6, 0x1a, 0x5d, 0x29, 0x5b, 0xfd, 0x2d, 0x93, 0x7c, 0x79, 0xd7, 0x6b,
-	0xe8, 0x73, 0x58, 0x55, 0x0c, 0x63, 0x6c, 0x42, 0xb3, 0x33, 0x2a, 0xaf, 0xdf, 0xd0, 0xeb, 0xcc,
-	0x35, 0x0d, 0x39, 0x85, 0xbe, 0x00, 0xa9, 0x4e, 0x2d, 0xca, 0x68, 0x82, 0x63, 0x96, 0x50, 0xd3,
-	0x19, 0xea, 0x20, 0x85, 0x75, 0x91, 0x60, 0xd8, 0x99, 0xca, 0x10, 0xc2, 0xa6, 0xb3, 0xa8, 0xb0,
-	0x7e, 0x4c, 0xd9, 0x44, 0x1f, 0xcf, 0x4c, 0x64, 0xf6, 0x2a, 0xe5, 0x14, 0xaa, 0xc1, 0x5a, 0xd3,
-	0x0c, 0x12, 0x5c, 0x01, 0xba, 0x3d, 0x65, 0xb9, 0x3c, 0x83, 0xbb, 0x43, 0x99, 0x9c, 0xaa, 0xfe,
-	0x92, 0x85, 0xcd, 0xa4, 0xd0, 0x47, 0xae, 0xc3, 0x7c, 0xd7, 0xb2, 0xa8, 0xff, 0xd7, 0x25, 0xaf,
-	0x4f, 0x91, 0x7c, 0xba, 0x60, 0x61, 0xf1, 0x4f, 0x67, 0xa9, 0x4d, 0x91, 0xfd, 0xae, 0x99, 0xb4,
-	0xa6, 0x89, 0x3e, 0x37, 0x95, 0xb9, 0xc2, 0x1f, 0xdf, 0x16, 0x7e, 0x46, 0x8f, 0xce, 0x57, 0x1f,
-	0x7d, 0x03, 0x1b, 0xd3, 0x7a, 0x71, 0x26, 0xdb, 0xbf, 0x92, 0xe7, 0xf6, 0x9c, 0x26, 0x96, 0x53,
-	0xb5, 0x9d, 0x77, 0xdb, 0x02, 0x7b, 0xc0, 0xff, 0x29, 0xf7, 0x2d, 0x77, 0x68, 0x1c, 0x0c, 0xdc,
-	0xe8, 0x6f, 0x70, 0x6f, 0x51, 0xfc, 0x1e, 0xfe, 0x1e, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x73, 0x87,
-	0x63, 0x47, 0x0f, 0x00, 0x00,
+	proto.RegisterFile("lte/protos/subscriberdb.proto", fileDescriptor_subscriberdb_f30183e993895040)
+}
+
+var fileDescriptor_subscriberdb_f30183e993895040 = []byte{
+	// 2089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x73, 0xdb, 0xc6,
+	0x11, 0x27, 0x48, 0x8a, 0x92, 0x96, 0x22, 0x05, 0x5d, 0x65, 0x8b, 0xa6, 0xec, 0x84, 0x41, 0xc6,
+	0xa9, 0xd2, 0x34, 0x94, 0x87, 0x6e, 0x5c, 0x37, 0x99, 0x69, 0x02, 0x8a, 0xb4, 0x8c, 0x9a, 0xa2,
+	0xd8, 0x23, 0x25, 0xb7, 0xe9, 0x4c, 0x31, 0x20, 0x71, 0xa2, 0x31, 0x02, 0x01, 0x08, 0x77, 0xb4,
+	0xa5, 0xcc, 0xf4, 0xa5, 0x33, 0xfd, 0x08, 0xfd, 0x0e, 0x7d, 0xeb, 0x37, 0xe8, 0x5b, 0x5e, 0xfb,
+	0x1d, 0x3a, 0x9d, 0xe9, 0x5b, 0xbf, 0x43, 0xe7, 0x0e, 0x07, 0x0a, 0xe2, 0xbf, 0x48, 0x4e, 0x9f,
+	0x08, 0xec, 0xfe, 0x76, 0x6f, 0x77, 0xef, 0x77, 0x7b, 0x0b, 0xc2, 0x23, 0x97, 0x91, 0xfd, 0x20,
+	0xf4, 0x99, 0x4f, 0xf7, 0xe9, 0xb8, 0x4f, 0x07, 0xa1, 0xd3, 0x27, 0xa1, 0xdd, 0xaf, 0x0a, 0x19,
+	0x5a, 0x1f, 0x59, 0xc3, 0x91, 0x55, 0x75, 0x19, 0x29, 0x3f, 0xf0, 0xc3, 0xc1, 0xf3, 0x30, 0xc6,
+	0x0e, 0xfc, 0xd1, 0xc8, 0xf7, 0x22, 0x54, 0xb9, 0x32, 0xf4, 0xfd, 0xa1, 0x2b, 0xfd, 0xf4, 0xc7,
+	0x67, 0xfb, 0x67, 0x0e, 0x71, 0x6d, 0x73, 0x64, 0xd1, 0xf3, 0x08, 0xa1, 0x9d, 0xc1, 0x46, 0x77,
+	0xe2, 0xdd, 0x68, 0xa0, 0x22, 0xa4, 0x1d, 0xbb, 0xa4, 0x54, 0x94, 0xbd, 0x75, 0x9c, 0x76, 0x6c,
+	0x54, 0x83, 0x2c, 0xbb, 0x0a, 0x48, 0x29, 0x5d, 0x51, 0xf6, 0x8a, 0xb5, 0x0f, 0xaa, 0x93, 0x65,
+	0xab, 0x49, 0xb3, 0xaa, 0xd1, 0xe8, 0x5d, 0x05, 0x04, 0x0b, 0xac, 0x86, 0x20, 0x17, 0xbd, 0xa3,
+	0x35, 0xc8, 0x1a, 0x47, 0x5d, 0x43, 0x4d, 0x69, 0xbf, 0x86, 0xcd, 0xa4, 0x41, 0x97, 0x30, 0xf4,
+	0x19, 0x64, 0xa9, 0x63, 0xd3, 0x92, 0x52, 0xc9, 0xec, 0xe5, 0x6b, 0x3b, 0x0b, 0x5c, 0x63, 0x01,
+	0xd2, 0xbe, 0x4f, 0xc3, 0xe6, 0x61, 0xf7, 0x48, 0x6a, 0x02, 0xe6, 0xf8, 0x1e, 0x6a, 0xc2, 0x0a,
+	0x65, 0x16, 0x23, 0x22, 0xdc, 0x62, 0x6d, 0x3f, 0xe1, 0x61, 0x0a, 0x3a, 0xfd, 0xde, 0xe5, 0x66,
+	0x38, 0xb2, 0x46, 0x07, 0xb0, 0x6e, 0x8d, 0xd9, 0x1b, 0xd3, 0x72, 0x87, 0xbe, 0xcc, 0xf3, 0x93,
+	0xe5, 0xae, 0xf4, 0x31, 0x7b, 0xa3, 0xbb, 0x43, 0x1f, 0xaf, 0x59, 0xf2, 0x09, 0x3d, 0x00, 0xf1,
+	0x6c, 0x9e, 0x93, 0xab, 0x52, 0xa6, 0xa2, 0xec, 0x6d, 0xe0, 0x55, 0xfe, 0xfe, 0x8a, 0x5c, 0xa1,
+	0x0f, 0x21, 0x2f, 0x54, 0x6c, 0x1c, 0xb8, 0x84, 0x96, 0xb2, 0x95, 0xcc, 0xde, 0x06, 0x06, 0x2e,
+	0xea, 0x09, 0x89, 0xf6, 0x04, 0xb6, 0xe7, 0xc5, 0x87, 0x36, 0x60, 0xcd, 0x68, 0xeb, 0x07, 0x3d,
+	0xe3, 0xb4, 0xa9, 0xa6, 0x10, 0x40, 0x4e, 0x3e, 0x2b, 0xda, 0x73, 0xc8, 0x27, 0xc2, 0x40, 0xbb,
+	0xb0, 0xd3, 0xc1, 0xcd, 0x83, 0xe3, 0xa3, 0xce, 0x49, 0xaf, 0xd9, 0x30, 0xf5, 0x93, 0xde, 0x4b,
+	0xb3, 0x77, 0xd2, 0x69, 0x35, 0xbb, 0x6a, 0x8a, 0x7b, 0x39, 0x32, 0x5a, 0xcd, 0xb6, 0x7e, 0xd8,
+	0x54, 0xd3, 0xda, 0x7f, 0xd3, 0xb0, 0xd9, 0xea, 0x35, 0x6f, 0x5b, 0xc7, 0x29, 0xe8, 0xf4, 0xfb,
+	0x5d, 0xea, 0x38, 0xc7, 0xd5, 0xdd, 0xea, 0x18, 0xab, 0xfc, 0x60, 0x50, 0xca, 0x5e, 0xab, 0x8e,
+	0x83, 0x01, 0xda, 0x95, 0x4b, 0x33, 0xae, 0x5b, 0x11, 0x3a, 0x81, 0xed, 0xf9, 0xc1, 0x00, 0x69,
+	0x50, 0x88, 0x5d, 0x9a, 0x7d, 0x87, 0xd1, 0x52, 0xae, 0xa2, 0xec, 0x15, 0x70, 0x5e, 0xfa, 0xad,
+	0x3b, 0x4c, 0x6c, 0xc1, 0xbc, 0xd4, 0x96, 0x6c, 0xc1, 0x63, 0xc8, 0x27, 0x32, 0xb8, 0x51, 0xe5,
+	0x14, 0xe7, 0x7d, 0xef, 0x44, 0x7f, 0xa5, 0x2a, 0xda, 0x9f, 0xd3, 0x49, 0xe2, 0x47, 0x4e, 0x3f,
+	0x85, 0x2d, 0x97, 0x11, 0x53, 0x04, 0xe5, 0x91, 0x4b, 0x66, 0x52, 0x72, 0x21, 0x6a, 0x9f, 0xc5,
+	0x45, 0x97, 0x11, 0xee, 0xb3, 0x4d, 0x2e, 0x59, 0x97, 0x5c, 0xa0, 0x7d, 0xd8, 0x66, 0xc3, 0x20,
+	0x30, 0x2d, 0xcb, 0x32, 0x29, 0x09, 0xdf, 0x92, 0xd0, 0xf4, 0xac, 0x51, 0x74, 0x1c, 0xd7, 0xf1,
+	0x16, 0xd7, 0xe9, 0x96, 0xd5, 0x15, 0x9a, 0xb6, 0x35, 0x22, 0xe8, 0x2b, 0x28, 0x4f, 0x1b, 0x84,
+	0x64, 0xe8, 0x50, 0x46, 0x42, 0x62, 0x8b, 0x8a, 0xae, 0xe1, 0x9d, 0x1b, 0x66, 0x78, 0xa2, 0x46,
+	0x9f, 0x01, 0x9a, 0x04, 0x46, 0xc9, 0x85, 0x69, 0x85, 0xa1, 0x75, 0x25, 0x08, 0x9b, 0xc5, 0x9b,
+	0x32, 0xb2, 0x2e, 0xb9, 0xd0, 0xb9, 0x78, 0x36, 0x0b, 0xc7, 0xb3, 0x45, 0xed, 0x0b, 0x37, 0xb2,
+	0x30, 0x3c, 0x5b, 0xfb, 0x6b, 0x16, 0x54, 0xbd, 0xd3, 0x3e, 0xf0, 0xbd, 0x33, 0x67, 0x38, 0x0e,
+	0x2d, 0xc1, 0xba, 0x47, 0x00, 0x03, 0xdf, 0x63, 0xc2, 0x32, 0xea, 0x38, 0x05, 0xbc, 0x2e, 0x25,
+	0x06, 0x8f, 0x65, 0x8b, 0xc7, 0xef, 0x0c, 0x88, 0x49, 0x89, 0x4b, 0x06, 0xdc, 0x46, 0xa6, 0xad,
+	0x4a, 0x45, 0x37, 0x96, 0xa3, 0x43, 0xc8, 0x5f, 0xf8, 0xd4, 0x0c, 0x42, 0xff, 0xcc, 0x71, 0x89,
+	0x48, 0x33, 0x7f, 0x83, 0x7c, 0xd3, 0xab, 0x57, 0x7f, 0xeb, 0x77, 0x3b, 0x11, 0x1a, 0xc3, 0x85,
+	0x4f, 0xe5, 0x33, 0xfa, 0x25, 0x64, 0xad, 0x51, 0x3f, 0x14, 0xfc, 0xca, 0xd7, 0x3e, 0x4e, 0x7a,
+	0x18, 0x0e, 0x43, 0x32, 0xb4, 0x18, 0xb1, 0x8f, 0xac, 0x4b, 0x67, 0x34, 0x1e, 0xd5, 0x1d, 0x16,
+	0x72, 0xf6, 0x0b, 0x03, 0xf4, 0x05, 0x64, 0x02, 0xdb, 0x13, 0xf9, 0x17, 0x6b, 0x1f, 0x2f, 0x5b,
+	0xb9, 0xd3, 0x68, 0x8b, 0x5e, 0xc9, 0xf1, 0xe5, 0x7f, 0x28, 0x00, 0xd7, 0xa1, 0x70, 0x8a, 0x0f,
+	0x5c, 0x8b, 0xd2, 0xb8, 0x22, 0x2b, 0x78, 0x55, 0xbc, 0x1b, 0x36, 0x7a, 0x0c, 0xc5, 0x20, 0x74,
+	0xfc, 0xd0, 0x61, 0x57, 0xa6, 0x4b, 0xde, 0x12, 0x57, 0x14, 0xa3, 0x80, 0x0b, 0xb1, 0xb4, 0xc5,
+	0x85, 0xe8, 0x29, 0xdc, 0x0b, 0x42, 0x42, 0x46, 0x82, 0xc3, 0xe6, 0xc0, 0x0a, 0xac, 0xbe, 0xe3,
+	0x3a, 0xec, 0x4a, 0x6e, 0xfd, 0xf6, 0xb5, 0xf2, 0x60, 0xa2, 0x43, 0xbf, 0x82, 0x52, 0xc2, 0xe8,
+	0xed, 0xd8, 0xf5, 0x48, 0x18, 0xdb, 0x65, 0x23, 0xca, 0x5c, 0xeb, 0x4f, 0x93, 0x6a, 0xed, 0x2b,
+	0x58, 0x95, 0x09, 0x89, 0x66, 0xdf, 0x39, 0xfd, 0x45, 0x44, 0x7f, 0xa3, 0x73, 0xfa, 0x4c, 0x55,
+	0xf8, 0x89, 0xe1, 0xb2, 0xd3, 0x67, 0x6a, 0x1a, 0xa9, 0xb0, 0xc1, 0x9f, 0xcd, 0x63, 0x6c, 0x0a,
+	0x6d, 0x46, 0xf3, 0xa0, 0xb4, 0xa8, 0xac, 0x68, 0x0f, 0xd4, 0x91, 0x75, 0x69, 0xf6, 0x2d, 0xcf,
+	0x7e, 0xe7, 0xd8, 0xec, 0x8d, 0x39, 0x76, 0x25, 0x49, 0x8a, 0x23, 0xeb, 0xb2, 0x1e, 0x8b, 0x4f,
+	0xdc, 0x59, 0xa4, 0x1d, 0xd7, 0xe6, 0x06, 0xb2, 0xe1, 0x6a, 0xff, 0xcc, 0x02, 0x6a, 0xfb, 0xde,
+	0xd3, 0xc3, 0x4e, 0xe7, 0x84, 0x92, 0x30, 0xae, 0xfa, 0x7d, 0xc8, 0x8d, 0xa8, 0x43, 0x6d, 0x4f,
+	0xde, 0x7b, 0xf2, 0x0d, 0x7d, 0x0b, 0xc8, 0xf3, 0x3d, 0xf3, 0x29, 0x3f, 0x4f, 0x4e, 0x60, 0x5a,
+	0x83, 0x01, 0xa1, 0x54, 0x76, 0xb6, 0xcf, 0x13, 0x5b, 0x3c, 0xeb, 0x32, 0x16, 0x19, 0x1d, 0x5d,
+	0x18, 0xe1, 0x4d, 0xcf, 0xf7, 0xb8, 0x1f, 0x23, 0x88, 0x04, 0xc8, 0x86, 0xfb, 0xb3, 0xbe, 0x4d,
+	0x2b, 0xf0, 0xc4, 0x46, 0x15, 0x6b, 0x4f, 0xee, 0xe4, 0x5f, 0xef, 0xb4, 0x31, 0x9a, 0x5a, 0x42,
+	0x0f, 0xbc, 0xf7, 0xa7, 0xf3, 0x97, 0x00, 0x56, 0xe0, 0x99, 0x03, 0xc1, 0x5c, 0xc1, 0xea, 0x7c,
+	0x6d, 0x77, 0x09, 0xab, 0xf1, 0xba, 0x15, 0x78, 0x91, 0x04, 0xbd, 0x80, 0x82, 0x4c, 0xc7, 0x23,
+	0xe2, 0x6c, 0xe7, 0x44, 0x46, 0x5a, 0xd2, 0x5c, 0xe8, 0xdb, 0x84, 0xbd, 0xf3, 0xc3, 0x73, 0xc3,
+	0x26, 0x1e, 0x73, 0xce, 0x1c, 0x12, 0xe2, 0xbc, 0x15, 0x2b, 0x0c, 0x5b, 0x3b, 0x85, 0xcd, 0xa9,
+	0x34, 0xd1, 0x47, 0xf0, 0xa8, 0x7d, 0xdc, 0x36, 0xb9, 0xcc, 0xec, 0x9e, 0xd4, 0xbb, 0x07, 0xd8,
+	0xe8, 0xf4, 0x8c, 0xe3, 0xb6, 0xa9, 0xb7, 0x5a, 0xc7, 0xaf, 0x9b, 0x0d, 0x35, 0x85, 0x2a, 0xf0,
+	0x70, 0x3e, 0xa4, 0xae, 0x63, 0xdc, 0x6c, 0xa8, 0x8a, 0x66, 0x00, 0x9a, 0xf2, 0xab, 0x77, 0xda,
+	0xa8, 0x04, 0xdb, 0x13, 0x3b, 0xbd, 0xd3, 0xee, 0x9a, 0xcd, 0xb6, 0x5e, 0x6f, 0xf1, 0x66, 0xfe,
+	0x00, 0xee, 0xdd, 0xd4, 0x34, 0x8c, 0xae, 0x50, 0x29, 0xda, 0xdf, 0x33, 0x50, 0xbc, 0xee, 0xee,
+	0x0d, 0x8b, 0x59, 0xe8, 0x53, 0xc8, 0x50, 0x79, 0x7a, 0x97, 0x0c, 0x35, 0x1c, 0x83, 0x7e, 0x0e,
+	0x99, 0x21, 0x1d, 0x09, 0x42, 0xe5, 0x6b, 0xe5, 0xc5, 0x23, 0x07, 0xe6, 0x30, 0x8e, 0x76, 0x59,
+	0xdc, 0xdb, 0xca, 0x8b, 0x2f, 0x56, 0xcc, 0x61, 0xe8, 0x0b, 0x00, 0x2f, 0x2a, 0x2f, 0xdf, 0x81,
+	0x68, 0xff, 0xef, 0x4b, 0x23, 0x31, 0x2f, 0x56, 0xe3, 0xea, 0x37, 0xf0, 0xba, 0x17, 0x6f, 0x04,
+	0x7a, 0x12, 0x8f, 0x02, 0x2b, 0x33, 0xcb, 0x4c, 0xdd, 0x62, 0xf1, 0xad, 0xff, 0x21, 0xe4, 0xe9,
+	0xb8, 0x3f, 0x69, 0xbd, 0x39, 0x71, 0x82, 0x80, 0x8e, 0xfb, 0xf1, 0xe9, 0x7a, 0x0e, 0x6b, 0x31,
+	0xd3, 0x4b, 0xab, 0xc2, 0xeb, 0xa3, 0xa5, 0xdc, 0xc6, 0xab, 0x92, 0xc8, 0x08, 0xc3, 0x16, 0xf1,
+	0x06, 0xe1, 0x55, 0xc0, 0x88, 0x6d, 0x52, 0x32, 0x08, 0x09, 0xa3, 0xa5, 0x35, 0xe1, 0xe2, 0x71,
+	0xc2, 0x45, 0x33, 0xc6, 0x24, 0x22, 0x8c, 0xc0, 0x58, 0x9d, 0xd8, 0x4b, 0x89, 0xf6, 0xbd, 0x02,
+	0x5b, 0x33, 0x38, 0x54, 0x81, 0x8d, 0xc9, 0x5d, 0xc6, 0x27, 0x0f, 0x45, 0x8c, 0x10, 0x20, 0xaf,
+	0x31, 0x3e, 0x7c, 0x24, 0x11, 0x7c, 0xc8, 0x48, 0xdf, 0x40, 0x1c, 0x47, 0x63, 0xc6, 0x04, 0x21,
+	0xe6, 0x90, 0x68, 0x7c, 0xc9, 0x4b, 0x88, 0x18, 0x45, 0x2a, 0xb0, 0x31, 0xa4, 0xa3, 0xeb, 0x75,
+	0xa2, 0x31, 0x06, 0x86, 0x74, 0x14, 0xaf, 0xf3, 0x09, 0x6c, 0x4e, 0x10, 0x72, 0x60, 0x5c, 0x11,
+	0x03, 0x63, 0x41, 0x82, 0xe4, 0xcc, 0xf8, 0x27, 0x28, 0x2f, 0xce, 0x1b, 0xdd, 0x83, 0x1c, 0x9f,
+	0x76, 0x26, 0x93, 0xfc, 0xca, 0x39, 0xb9, 0x32, 0x6c, 0xde, 0x29, 0xdf, 0x85, 0x56, 0x10, 0x10,
+	0xdb, 0xb4, 0x2d, 0x66, 0x89, 0x10, 0xa2, 0x44, 0x8a, 0x52, 0xce, 0x29, 0xcc, 0xc3, 0xf8, 0x00,
+	0x60, 0xe0, 0x04, 0x6f, 0x48, 0xc8, 0x6f, 0x63, 0x99, 0x49, 0x42, 0xa2, 0x5d, 0x80, 0x7a, 0xbd,
+	0xea, 0x49, 0x60, 0x73, 0x26, 0x7c, 0x0e, 0x59, 0xee, 0x55, 0x52, 0xff, 0xc1, 0x5c, 0xea, 0x70,
+	0xff, 0x58, 0xc0, 0x50, 0x15, 0xb2, 0xfc, 0x3b, 0x64, 0x42, 0xff, 0xe8, 0x53, 0xa5, 0x1a, 0x7f,
+	0xaa, 0x54, 0x5f, 0xf0, 0x4f, 0x95, 0x23, 0x8b, 0x9e, 0x63, 0x81, 0xd3, 0x58, 0x72, 0xc9, 0x96,
+	0xef, 0x9f, 0x8f, 0x83, 0x29, 0x96, 0x2b, 0xb7, 0x65, 0xb9, 0x3c, 0xa3, 0xe9, 0x1f, 0x3e, 0xa3,
+	0xda, 0x1f, 0xe0, 0xe1, 0x21, 0x61, 0xba, 0xeb, 0x4e, 0xe5, 0x40, 0x68, 0xe0, 0x7b, 0x94, 0xcf,
+	0x5b, 0xf9, 0xeb, 0xaf, 0xb3, 0xf8, 0x5b, 0x66, 0x49, 0xee, 0x49, 0xb4, 0xf6, 0x37, 0x05, 0xee,
+	0xb7, 0x1c, 0xca, 0xae, 0x31, 0x14, 0x93, 0x8b, 0x31, 0xa1, 0xec, 0x7d, 0x33, 0xdb, 0x85, 0x75,
+	0xc7, 0x36, 0x83, 0x90, 0x9c, 0x39, 0x97, 0x72, 0x5a, 0x5a, 0x73, 0xec, 0x8e, 0x78, 0xe7, 0xca,
+	0xc0, 0x1a, 0x12, 0x93, 0x3a, 0xdf, 0x45, 0x7d, 0xa4, 0x80, 0xd7, 0xb8, 0xa0, 0xeb, 0x7c, 0x47,
+	0xf8, 0x38, 0x26, 0x94, 0xcc, 0x3f, 0x27, 0x9e, 0x20, 0xe6, 0x3a, 0x16, 0xf0, 0x1e, 0x17, 0x68,
+	0x1e, 0xec, 0xcc, 0x44, 0x2a, 0x4b, 0x70, 0x97, 0xef, 0x38, 0xce, 0x6f, 0x31, 0x2c, 0x26, 0xd6,
+	0x8a, 0xc2, 0x2c, 0x70, 0x71, 0x67, 0xb2, 0xde, 0x5f, 0x94, 0xe4, 0xdc, 0x5c, 0xb7, 0xd8, 0xe0,
+	0xcd, 0xfb, 0xd6, 0x64, 0x6a, 0x8b, 0xd2, 0x77, 0xda, 0xa2, 0x77, 0xc9, 0x76, 0x61, 0x34, 0x7e,
+	0x54, 0x20, 0x71, 0xa1, 0xd2, 0xb7, 0xf9, 0xe0, 0xfd, 0xb7, 0x02, 0xf7, 0xa6, 0x0a, 0x80, 0x09,
+	0x1d, 0xbb, 0x0c, 0xa9, 0x90, 0x09, 0xfd, 0x77, 0x72, 0x18, 0xe2, 0x8f, 0x77, 0xe0, 0x33, 0xfa,
+	0x1a, 0x72, 0xbc, 0x6f, 0x8f, 0xa9, 0x9c, 0x33, 0x7e, 0x3a, 0x17, 0x9d, 0x58, 0xae, 0xda, 0x15,
+	0x70, 0x2c, 0xcd, 0xd0, 0x36, 0xac, 0x90, 0x30, 0xf4, 0x43, 0x49, 0x91, 0xe8, 0x45, 0x7b, 0x06,
+	0xb9, 0x08, 0x87, 0x72, 0x90, 0x3e, 0x7e, 0xa5, 0xa6, 0x50, 0x1e, 0x56, 0x8d, 0xf6, 0xa9, 0xde,
+	0x32, 0x1a, 0xaa, 0xc2, 0x5f, 0xba, 0xaf, 0x8c, 0x4e, 0xa7, 0xd9, 0x50, 0xd3, 0x7c, 0x26, 0x7c,
+	0xa1, 0x1b, 0xad, 0x66, 0x43, 0xcd, 0x68, 0x27, 0xb0, 0x33, 0xbb, 0x6a, 0x44, 0xab, 0x2f, 0x61,
+	0x35, 0x14, 0x11, 0xc4, 0xcc, 0xaa, 0xfc, 0x50, 0xa8, 0x38, 0x36, 0xd0, 0xbe, 0x81, 0x87, 0x98,
+	0xc8, 0xde, 0x3f, 0x8f, 0xb2, 0x15, 0xc8, 0x87, 0x64, 0x72, 0x37, 0xc8, 0x52, 0x26, 0x45, 0x3f,
+	0x7b, 0x01, 0x3b, 0x0b, 0x86, 0x14, 0x3e, 0xdd, 0xbe, 0xc4, 0x1d, 0x3e, 0x6b, 0xac, 0xc3, 0xca,
+	0x6b, 0xe3, 0x48, 0xff, 0x9d, 0xaa, 0x70, 0xe1, 0xeb, 0x96, 0xde, 0x56, 0xd3, 0xfc, 0x4b, 0xb0,
+	0xd9, 0x7b, 0xd9, 0xc4, 0xed, 0x66, 0x4f, 0xcd, 0xd4, 0xfe, 0x93, 0x4e, 0xfe, 0xc1, 0xd2, 0xa8,
+	0xa3, 0xaf, 0xa1, 0xa0, 0xdb, 0x89, 0x96, 0x8d, 0x16, 0x33, 0xb1, 0xbc, 0x75, 0x83, 0x53, 0xa7,
+	0xbe, 0x63, 0x6b, 0x29, 0xf4, 0x0d, 0xa8, 0x0d, 0xe2, 0x12, 0x46, 0x12, 0x3e, 0x16, 0xed, 0xf9,
+	0x7c, 0x0f, 0x0d, 0x50, 0xa3, 0x96, 0x9d, 0xf0, 0xb0, 0x3b, 0xd7, 0x43, 0x04, 0x9b, 0xef, 0xc5,
+	0x80, 0xad, 0x43, 0xc2, 0xa6, 0xa6, 0x9f, 0x85, 0x81, 0x2c, 0xce, 0x52, 0x4b, 0xa1, 0x3a, 0x6c,
+	0x4e, 0x35, 0x17, 0x34, 0xbb, 0x64, 0xb9, 0xbc, 0xc0, 0x77, 0x97, 0x30, 0x2d, 0x55, 0xfb, 0xd7,
+	0x2a, 0xdc, 0x4f, 0x16, 0xfa, 0xc0, 0xf7, 0x58, 0xe8, 0xbb, 0x2e, 0x09, 0x7f, 0x7c, 0xc9, 0x1b,
+	0x73, 0x4a, 0x3e, 0xbf, 0x60, 0xd1, 0xbd, 0x34, 0xdf, 0x4b, 0x7d, 0x4e, 0xd9, 0xef, 0x1a, 0xc9,
+	0xd1, 0xbc, 0xa2, 0x2f, 0x0d, 0x65, 0x69, 0xe1, 0x0f, 0x67, 0x0b, 0xbf, 0xa0, 0x8f, 0x2d, 0xaf,
+	0x3e, 0xfa, 0x23, 0xfc, 0x64, 0xca, 0x11, 0x6f, 0xe5, 0xe8, 0xa3, 0xe4, 0x98, 0x3a, 0xf7, 0xa2,
+	0x2b, 0x6b, 0xcb, 0x20, 0xd1, 0x71, 0xd5, 0x52, 0xe8, 0xf7, 0xb0, 0x3d, 0xef, 0x1a, 0x5e, 0x18,
+	0x6d, 0xb2, 0xad, 0x2d, 0xbb, 0xbf, 0xb5, 0x14, 0xc2, 0x50, 0xbc, 0xc1, 0x0e, 0x8a, 0xca, 0x8b,
+	0x1b, 0x4d, 0x59, 0x5b, 0xac, 0x4b, 0xf8, 0x3c, 0x81, 0xad, 0xe9, 0xad, 0xfe, 0x7f, 0xb8, 0x7d,
+	0x0d, 0x5b, 0xd3, 0x3c, 0xa4, 0xe8, 0xe1, 0x82, 0x8d, 0xb9, 0x8b, 0xe3, 0xdf, 0xc0, 0x56, 0xf3,
+	0x32, 0xf0, 0xc3, 0x5b, 0x31, 0x61, 0x19, 0xa3, 0x9e, 0x28, 0x7c, 0xab, 0xe6, 0xf5, 0xde, 0x5b,
+	0x6d, 0xd5, 0xb2, 0xa6, 0xad, 0xa5, 0xea, 0xbb, 0xdf, 0x3e, 0x10, 0xd8, 0x7d, 0xfe, 0xdf, 0xf8,
+	0xc0, 0xf5, 0xc7, 0xf6, 0xfe, 0xd0, 0x97, 0x7f, 0x7c, 0xf7, 0x73, 0xe2, 0xf7, 0xe9, 0xff, 0x06,
+	0x00, 0xb5, 0xbe, 0x45, 0x19, 0x39, 0x17, 0x00, 0x00,
 }
@@ -17,9 +17,13 @@ import (
 	"magma/lte/cloud/go/protos/mconfig"
 	cellular_protos "magma/lte/cloud/go/services/cellular/protos"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/config"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 	dnsd_config "magma/orc8r/cloud/go/services/dnsd/config"
 	dsnd_protos "magma/orc8r/cloud/go/services/dnsd/protos"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
 )
@@ -58,7 +62,6 @@ func (builder *CellularBuilder) Build(networkId string, gatewayId string) (map[s
 	if err != nil {
 		return nil, err
 	}
-	enableDNSCaching := shouldEnableDNSCaching(networkDNSconfig)
 
 	if err := validateConfigs(cellularNwConfig, cellularGwConfig); err != nil {
 		return nil, err
@@ -69,6 +72,75 @@ func (builder *CellularBuilder) Build(networkId string, gatewayId string) (map[s
 	if err != nil {
 		enodebConfigsBySerial = map[string]*mconfig.EnodebD_EnodebConfig{}
 	}
+	return buildFromConfigs(cellularNwConfig, cellularGwConfig, networkDNSconfig, enodebConfigsBySerial)
+}
+
+func (builder *CellularBuilder) BuildFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (map[string]proto.Message, error) {
+	emptyRet := map[string]proto.Message{}
+	iCellularNwConfig, err := serde.Deserialize(config.SerdeDomain, CellularNetworkType, network.Configs[CellularNetworkType])
+	if err != nil || iCellularNwConfig == nil {
+		return emptyRet, err
+	}
+	cellularNwConfig, err := castCellularNetworkConfig(iCellularNwConfig)
+	if err != nil {
+		return nil, err
+	}
+	cellularGwConfig, err := getCellularGatewayConfigFromGraph(graph, gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	if cellularGwConfig == nil {
+		return emptyRet, nil
+	}
+
+	networkDNSconfig, err := dnsd_config.GetNetworkDNSConfigFromNetwork(network)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateConfigs(cellularNwConfig, cellularGwConfig); err != nil {
+		return nil, err
+	}
+
+	enodebConfigsBySerial := getEnodebConfigsBySerialFromGraph(graph, cellularGwConfig.GetAttachedEnodebSerials())
+	return buildFromConfigs(cellularNwConfig, cellularGwConfig, networkDNSconfig, enodebConfigsBySerial)
+}
+
+// GetAssociations associates the cellular gateway entities with the magmad
+// gateway entities of the same gateways and with the entities of their
+// attached eNodeBs
+func (builder *CellularBuilder) GetAssociations(configType string, key string, cfg []byte) ([]storage.GraphEdge, error) {
+	if configType != CellularGatewayType {
+		return nil, nil
+	}
+	iCellularGwConfig, err := serde.Deserialize(config.SerdeDomain, CellularGatewayType, cfg)
+	if err != nil {
+		return nil, err
+	}
+	gatewayTK := orc8r_storage.TypeAndKey{Type: CellularGatewayType, Key: key}
+	ret := []storage.GraphEdge{
+		{From: orc8r_storage.TypeAndKey{Type: magmad_config.MagmadGatewayType, Key: key}, To: gatewayTK},
+	}
+	if iCellularGwConfig == nil {
+		return ret, nil
+	}
+	cellularGwConfig, err := castCellularGatewayConfig(iCellularGwConfig)
+	if err != nil {
+		return nil, err
+	}
+	for _, enbSerial := range cellularGwConfig.GetAttachedEnodebSerials() {
+		ret = append(ret, storage.GraphEdge{From: gatewayTK, To: orc8r_storage.TypeAndKey{Type: CellularEnodebType, Key: enbSerial}})
+	}
+	return ret, nil
+}
+
+func buildFromConfigs(
+	cellularNwConfig *cellular_protos.CellularNetworkConfig,
+	cellularGwConfig *cellular_protos.CellularGatewayConfig,
+	networkDNSconfig *dsnd_protos.NetworkDNSConfig,
+	enodebConfigsBySerial map[string]*mconfig.EnodebD_EnodebConfig,
+) (map[string]proto.Message, error) {
+	enableDNSCaching := shouldEnableDNSCaching(networkDNSconfig)
 
 	// All guaranteed non-nil by the above check, except gwNonEpsService
 	gwRan := cellularGwConfig.GetRan()
@@ -197,6 +269,32 @@ func getEnodebConfig(
 		return nil, fmt.Errorf("Missing config for network %s, serial %s", networkID, enbSerialID)
 	}
 	cellularEnbConfig := cellularEnbConfigStruct.(*cellular_protos.CellularEnodebConfig)
+	return toMconfigEnodebConfig(cellularEnbConfig), nil
+}
+
+// getEnodebConfigsBySerialFromGraph returns the configs of the attached
+// eNodeBs in the graph. Like getEnodebConfigsBySerial, eNodeBs without
+// configs are excluded.
+func getEnodebConfigsBySerialFromGraph(graph storage.EntityGraph, enbSerialArr []string) map[string]*mconfig.EnodebD_EnodebConfig {
+	enbConfigMap := make(map[string]*mconfig.EnodebD_EnodebConfig, len(enbSerialArr))
+	for _, enbSerial := range enbSerialArr {
+		enbEnt, found := graph.GetEntity(orc8r_storage.TypeAndKey{Type: CellularEnodebType, Key: enbSerial})
+		if !found {
+			log.Printf("Missing config for eNB serial %s", enbSerial)
+			continue
+		}
+		iEnbConfig, err := serde.Deserialize(config.SerdeDomain, CellularEnodebType, enbEnt.Config)
+		enbConfig, ok := iEnbConfig.(*cellular_protos.CellularEnodebConfig)
+		if err != nil || !ok {
+			log.Printf("Missing config for eNB serial %s", enbSerial)
+			continue
+		}
+		enbConfigMap[enbSerial] = toMconfigEnodebConfig(enbConfig)
+	}
+	return enbConfigMap
+}
+
+func toMconfigEnodebConfig(cellularEnbConfig *cellular_protos.CellularEnodebConfig) *mconfig.EnodebD_EnodebConfig {
 	return &mconfig.EnodebD_EnodebConfig{
 		Earfcndl:               cellularEnbConfig.GetEarfcndl(),
 		SubframeAssignment:     cellularEnbConfig.GetSubframeAssignment(),
@@ -207,7 +305,7 @@ func getEnodebConfig(
 		BandwidthMhz:           cellularEnbConfig.GetBandwidthMhz(),
 		Tac:                    cellularEnbConfig.GetTac(),
 		CellId:                 cellularEnbConfig.GetCellId(),
-	}, nil
+	}
 }
 
 func getCellularNetworkConfig(networkId string) (*cellular_protos.CellularNetworkConfig, error) {
@@ -215,6 +313,10 @@ func getCellularNetworkConfig(networkId string) (*cellular_protos.CellularNetwor
 	if err != nil || iCellularNwConfigs == nil {
 		return nil, err
 	}
+	return castCellularNetworkConfig(iCellularNwConfigs)
+}
+
+func castCellularNetworkConfig(iCellularNwConfigs interface{}) (*cellular_protos.CellularNetworkConfig, error) {
 	cellularNwConfigs, ok := iCellularNwConfigs.(*cellular_protos.CellularNetworkConfig)
 	if !ok {
 		return nil, fmt.Errorf(
@@ -231,6 +333,22 @@ func getCellularGatewayConfig(networkId string, gatewayId string) (*cellular_pro
 	if err != nil || iGatewayConfigs == nil {
 		return nil, err
 	}
+	return castCellularGatewayConfig(iGatewayConfigs)
+}
+
+func getCellularGatewayConfigFromGraph(graph storage.EntityGraph, gatewayId string) (*cellular_protos.CellularGatewayConfig, error) {
+	gatewayEnt, found := graph.GetEntity(orc8r_storage.TypeAndKey{Type: CellularGatewayType, Key: gatewayId})
+	if !found {
+		return nil, nil
+	}
+	iGatewayConfigs, err := serde.Deserialize(config.SerdeDomain, CellularGatewayType, gatewayEnt.Config)
+	if err != nil || iGatewayConfigs == nil {
+		return nil, err
+	}
+	return castCellularGatewayConfig(iGatewayConfigs)
+}
+
+func castCellularGatewayConfig(iGatewayConfigs interface{}) (*cellular_protos.CellularGatewayConfig, error) {
 	gatewayConfigs, ok := iGatewayConfigs.(*cellular_protos.CellularGatewayConfig)
 	if !ok {
 		return nil, fmt.Errorf(
@@ -18,10 +18,14 @@ import (
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/config"
 	config_test_init "magma/orc8r/cloud/go/services/config/test_init"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 	dnsd_config "magma/orc8r/cloud/go/services/dnsd/config"
 	dnsd_protos "magma/orc8r/cloud/go/services/dnsd/protos"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -212,3 +216,80 @@ func TestCellularBuilder_Build_NullDnsdConfig(t *testing.T) {
 	assert.Equal(t, expected, actual)
 
 }
+
+// Building from the graph should give the same mconfig as building from the
+// config service
+func TestCellularBuilder_BuildFromGraph(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &lteplugin.LteOrchestratorPlugin{})
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	config_test_init.StartTestService(t)
+	builder := &cellular_config.CellularBuilder{}
+
+	network := storage.Network{ID: "network", Configs: map[string][]byte{}}
+	actual, err := builder.BuildFromGraph(network, storage.EntityGraph{}, "gw1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]proto.Message{}, actual)
+
+	network.Configs[cellular_config.CellularNetworkType] = serialize(t, cellular_config.CellularNetworkType, test_utils.NewDefaultTDDNetworkConfig())
+	network.Configs[dnsd_config.DnsdNetworkType] = serialize(t, dnsd_config.DnsdNetworkType, &dnsd_protos.NetworkDNSConfig{EnableCaching: true})
+	graph := storage.EntityGraph{
+		Entities: []storage.NetworkEntity{
+			{Type: magmad_config.MagmadGatewayType, Key: "gw1"},
+			{
+				Type:   cellular_config.CellularGatewayType,
+				Key:    "gw1",
+				Config: serialize(t, cellular_config.CellularGatewayType, test_utils.NewDefaultGatewayConfig()),
+			},
+			{
+				Type:   cellular_config.CellularEnodebType,
+				Key:    "enb1",
+				Config: serialize(t, cellular_config.CellularEnodebType, test_utils.NewDefaultEnodebConfig()),
+			},
+		},
+	}
+	actual, err = builder.BuildFromGraph(network, graph, "gw1")
+	assert.NoError(t, err)
+
+	err = config.CreateConfig("network", cellular_config.CellularNetworkType, "network", test_utils.NewDefaultTDDNetworkConfig())
+	assert.NoError(t, err)
+	err = config.CreateConfig("network", dnsd_config.DnsdNetworkType, "network", &dnsd_protos.NetworkDNSConfig{EnableCaching: true})
+	assert.NoError(t, err)
+	err = config.CreateConfig("network", cellular_config.CellularEnodebType, "enb1", test_utils.NewDefaultEnodebConfig())
+	assert.NoError(t, err)
+	err = config.CreateConfig("network", cellular_config.CellularGatewayType, "gw1", test_utils.NewDefaultGatewayConfig())
+	assert.NoError(t, err)
+	expected, err := builder.Build("network", "gw1")
+	assert.NoError(t, err)
+	assert.True(t, expected["mme"].(*mconfig.MME).EnableDnsCaching)
+	assert.Len(t, expected["enodebd"].(*mconfig.EnodebD).EnbConfigsBySerial, 1)
+	assert.Equal(t, len(expected), len(actual))
+	for k, v := range expected {
+		assert.True(t, proto.Equal(v, actual[k]), "mconfig %s: expected %v, got %v", k, v, actual[k])
+	}
+}
+
+func TestCellularBuilder_GetAssociations(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &lteplugin.LteOrchestratorPlugin{})
+	builder := &cellular_config.CellularBuilder{}
+
+	gatewayConfig := serialize(t, cellular_config.CellularGatewayType, test_utils.NewDefaultGatewayConfig())
+	actual, err := builder.GetAssociations(cellular_config.CellularGatewayType, "gw1", gatewayConfig)
+	assert.NoError(t, err)
+	gatewayTK := orc8r_storage.TypeAndKey{Type: cellular_config.CellularGatewayType, Key: "gw1"}
+	expected := []storage.GraphEdge{
+		{From: orc8r_storage.TypeAndKey{Type: magmad_config.MagmadGatewayType, Key: "gw1"}, To: gatewayTK},
+		{From: gatewayTK, To: orc8r_storage.TypeAndKey{Type: cellular_config.CellularEnodebType, Key: "enb1"}},
+	}
+	assert.Equal(t, expected, actual)
+
+	enodebConfig := serialize(t, cellular_config.CellularEnodebType, test_utils.NewDefaultEnodebConfig())
+	actual, err = builder.GetAssociations(cellular_config.CellularEnodebType, "enb1", enodebConfig)
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+func serialize(t *testing.T, configType string, cfg interface{}) []byte {
+	ret, err := serde.Serialize(config.SerdeDomain, configType, cfg)
+	assert.NoError(t, err)
+	return ret
+}
@@ -0,0 +1,23 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+// AuthCipher is an authentication and key agreement algorithm which can
+// generate auth vectors and process re-synchronization tokens.
+// It is implemented by MilenageCipher and TuakCipher.
+type AuthCipher interface {
+	// GenerateEutranVector creates an E-UTRAN key vector.
+	GenerateEutranVector(key []byte, opc []byte, sqn uint64, plmn []byte) (*EutranVector, error)
+
+	// GenerateSIPAuthVector creates a SIP auth vector.
+	GenerateSIPAuthVector(key []byte, opc []byte, sqn uint64) (*SIPAuthVector, error)
+
+	// GenerateResync computes SQN_MS and MAC-S from AUTS for re-synchronization.
+	GenerateResync(auts, key, opc, rand []byte) (uint64, [8]byte, error)
+}
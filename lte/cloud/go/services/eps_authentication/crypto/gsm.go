@@ -0,0 +1,106 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+import (
+	"fmt"
+)
+
+const (
+	// SresBytes is the number of bytes for the GSM signed response.
+	SresBytes = 4
+
+	// KcBytes is the number of bytes for the GSM cipher key.
+	KcBytes = 8
+
+	// GsmTripletBytes is the number of bytes of a precomputed triplet (RAND || SRES || Kc).
+	GsmTripletBytes = RandChallengeBytes + SresBytes + KcBytes
+)
+
+// GsmTriplet represents a GSM authentication triplet.
+type GsmTriplet struct {
+	// Rand is a random challenge
+	Rand [RandChallengeBytes]byte
+
+	// Sres is the signed response
+	Sres [SresBytes]byte
+
+	// Kc is the cipher key
+	Kc [KcBytes]byte
+}
+
+// ParseGsmTriplet parses a precomputed triplet which is stored as RAND || SRES || Kc.
+func ParseGsmTriplet(triplet []byte) (*GsmTriplet, error) {
+	if len(triplet) != GsmTripletBytes {
+		return nil, fmt.Errorf("incorrect auth tuple size. Expected %v bytes, but got %v bytes", GsmTripletBytes, len(triplet))
+	}
+	result := &GsmTriplet{}
+	copy(result.Rand[:], triplet[:RandChallengeBytes])
+	copy(result.Sres[:], triplet[RandChallengeBytes:RandChallengeBytes+SresBytes])
+	copy(result.Kc[:], triplet[RandChallengeBytes+SresBytes:])
+	return result, nil
+}
+
+// GenerateGsmTriplet creates a GSM triplet for a USIM, which is used when
+// the USIM authenticates in a GSM access network.
+// The SRES and Kc are derived from XRES, CK and IK with the conversion
+// functions c2 and c3 (3GPP TS 33.102 section 6.8.1.2).
+// Inputs:
+//   key: 128 bit subscriber key
+//   opc: 128 bit operator variant algorithm configuration field
+// Outputs: A GsmTriplet or an error. The GsmTriplet is not nil if and only if err == nil.
+func (milenage *MilenageCipher) GenerateGsmTriplet(key []byte, opc []byte) (*GsmTriplet, error) {
+	if err := validateGenerateSIPAuthVectorInputs(key, opc, 0); err != nil {
+		return nil, err
+	}
+
+	var randChallenge = make([]byte, RandChallengeBytes)
+	_, err := milenage.rng.Read(randChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	xres, _, err := f2F5(key, randChallenge, opc)
+	if err != nil {
+		return nil, err
+	}
+	ck, err := f3(key, randChallenge, opc)
+	if err != nil {
+		return nil, err
+	}
+	ik, err := f4(key, randChallenge, opc)
+	if err != nil {
+		return nil, err
+	}
+
+	triplet := &GsmTriplet{Sres: c2(xres), Kc: c3(ck, ik)}
+	copy(triplet.Rand[:], randChallenge)
+	return triplet, nil
+}
+
+// c2 converts XRES to SRES according to 3GPP TS 33.102 section 6.8.1.2:
+// SRES = XRES*1 xor XRES*2 xor XRES*3 xor XRES*4, where XRES* is XRES padded
+// with zeros to 128 bits and split into 32 bit blocks.
+func c2(xres []byte) [SresBytes]byte {
+	var sres [SresBytes]byte
+	for i, b := range xres {
+		sres[i%SresBytes] ^= b
+	}
+	return sres
+}
+
+// c3 converts CK and IK to Kc according to 3GPP TS 33.102 section 6.8.1.2:
+// Kc = CK1 xor CK2 xor IK1 xor IK2, where CKi and IKi are the 64 bit halves of CK and IK.
+func c3(ck, ik []byte) [KcBytes]byte {
+	var kc [KcBytes]byte
+	for i := range kc {
+		kc[i] = ck[i] ^ ck[i+KcBytes] ^ ik[i] ^ ik[i+KcBytes]
+	}
+	return kc
+}
@@ -0,0 +1,61 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerateGsmTriplet_Set1 uses 3GPP TS 35.208 Test Set 1.
+func TestGenerateGsmTriplet_Set1(t *testing.T) {
+	key := decodeHex(t, "465b5ce8b199b49faa5f0a2ee238a6bc")
+	opc := decodeHex(t, "cd63cb71954a9f4e48a5994e37a02baf")
+	rand := decodeHex(t, "23553cbe9637a89d218ae64dae47bf35")
+
+	milenage, err := NewMockMilenageCipher([]byte("\x80\x00"), rand)
+	assert.NoError(t, err)
+
+	triplet, err := milenage.GenerateGsmTriplet(key, opc)
+	assert.NoError(t, err)
+	assert.Equal(t, rand, triplet.Rand[:])
+	assert.Equal(t, "46f8416a", hex.EncodeToString(triplet.Sres[:]))
+	assert.Equal(t, "eae4be823af9a08b", hex.EncodeToString(triplet.Kc[:]))
+}
+
+func TestGenerateGsmTriplet_InvalidInput(t *testing.T) {
+	milenage, err := NewMilenageCipher([]byte("\x80\x00"))
+	assert.NoError(t, err)
+
+	_, err = milenage.GenerateGsmTriplet(make([]byte, 15), make([]byte, ExpectedOpcBytes))
+	assert.EqualError(t, err, "incorrect key size. Expected 16 bytes, but got 15 bytes")
+
+	_, err = milenage.GenerateGsmTriplet(make([]byte, ExpectedKeyBytes), nil)
+	assert.EqualError(t, err, "incorrect opc size. Expected 16 bytes, but got 0 bytes")
+}
+
+func TestParseGsmTriplet(t *testing.T) {
+	raw := decodeHex(t, "23553cbe9637a89d218ae64dae47bf3546f8416aeae4be823af9a08b")
+	triplet, err := ParseGsmTriplet(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, raw[:16], triplet.Rand[:])
+	assert.Equal(t, raw[16:20], triplet.Sres[:])
+	assert.Equal(t, raw[20:], triplet.Kc[:])
+
+	_, err = ParseGsmTriplet(raw[1:])
+	assert.EqualError(t, err, "incorrect auth tuple size. Expected 28 bytes, but got 27 bytes")
+}
+
+func TestC2(t *testing.T) {
+	assert.Equal(t, [SresBytes]byte{0x46, 0xf8, 0x41, 0x6a}, c2(decodeHex(t, "a54211d5e3ba50bf")))
+	// 128 bit XRES
+	assert.Equal(t, [SresBytes]byte{0x0f, 0x0f, 0x0f, 0x0f}, c2(decodeHex(t, "01010101020202020404040408080808")))
+}
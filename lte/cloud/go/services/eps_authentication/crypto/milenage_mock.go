@@ -33,3 +33,13 @@ func NewMockMilenageCipher(amf []byte, rand []byte) (*MilenageCipher, error) {
 	milenage.rng = MockRNG{rand: rand}
 	return milenage, nil
 }
+
+// NewMockTuakCipher instantiates the TUAK algo using MockRNG for rng.
+func NewMockTuakCipher(amf []byte, rand []byte) (*TuakCipher, error) {
+	tuak, err := NewTuakCipher(amf)
+	if err != nil {
+		return nil, err
+	}
+	tuak.rng = MockRNG{rand: rand}
+	return tuak, nil
+}
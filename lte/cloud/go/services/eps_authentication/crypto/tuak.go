@@ -0,0 +1,399 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	// ExpectedTuakShortKeyBytes is the number of bytes for a 128 bit TUAK subscriber key.
+	ExpectedTuakShortKeyBytes = 16
+
+	// ExpectedTuakLongKeyBytes is the number of bytes for a 256 bit TUAK subscriber key.
+	ExpectedTuakLongKeyBytes = 32
+
+	// ExpectedTopBytes is the number of bytes for the TUAK operator variant configuration field.
+	ExpectedTopBytes = 32
+
+	// ExpectedTopcBytes is the number of bytes for the TUAK operator variant algorithm configuration field.
+	ExpectedTopcBytes = 32
+
+	// DefaultKeccakIterations is the number of times Keccak is applied
+	// when the operator hasn't customised it (3GPP TS 35.231 6.2).
+	DefaultKeccakIterations = 1
+
+	// Sizes of the Keccak-f[1600] state and of its rate portion in bytes.
+	keccakStateBytes = 200
+	keccakRateBytes  = 136
+
+	// Byte offsets of the TUAK input fields within the Keccak state (3GPP TS 35.231 6.3).
+	tuakTopOffset      = 0
+	tuakInstanceOffset = 32
+	tuakAlgonameOffset = 33
+	tuakRandOffset     = 40
+	tuakAmfOffset      = 56
+	tuakSqnOffset      = 58
+	tuakKeyOffset      = 64
+	tuakPaddingOffset  = 96
+
+	// Byte offsets of the TUAK output fields within the Keccak state (3GPP TS 35.231 6.4, 6.5).
+	tuakMacOffset = 0
+	tuakResOffset = 0
+	tuakCkOffset  = 32
+	tuakIkOffset  = 64
+	tuakAkOffset  = 96
+
+	// Bits of the INSTANCE byte (3GPP TS 35.231 6.3).
+	tuakInstanceLongKey  = 0x01
+	tuakInstanceLongIk   = 0x02
+	tuakInstanceLongCk   = 0x04
+	tuakInstanceF2F5     = 0x40
+	tuakInstanceResync   = 0x80
+	tuakInstanceShortMac = 0x08
+	tuakInstanceRes64    = 0x08
+	tuakInstanceRes128   = 0x10
+	tuakInstanceRes256   = 0x20
+)
+
+// tuakAlgoname is the ALGONAME field of the TUAK input (3GPP TS 35.231 6.3).
+var tuakAlgoname = []byte("TUAK1.0")
+
+// TuakCipher implements the TUAK algorithm (3GPP TS 35.231, .232, .233).
+// The cipher produces 64 bit MACs and responses and 128 bit confidentiality and
+// integrity keys, and accepts either 128 or 256 bit subscriber keys.
+type TuakCipher struct {
+	// rng is a cryptographically secure random number generator
+	rng cryptoRNG
+
+	// amf is a 16 bit authentication management field
+	amf [ExpectedAmfBytes]byte
+
+	// keccakIterations is the number of times the Keccak permutation is applied
+	keccakIterations int
+}
+
+// NewTuakCipher instantiates the TUAK algo using crypto/rand for rng.
+func NewTuakCipher(amf []byte) (*TuakCipher, error) {
+	if len(amf) != ExpectedAmfBytes {
+		return nil, fmt.Errorf("incorrect amf size. Expected 2 bytes, but got %v bytes", len(amf))
+	}
+
+	tuak := &TuakCipher{rng: defaultCryptoRNG{}, keccakIterations: DefaultKeccakIterations}
+	copy(tuak.amf[:], amf)
+	return tuak, nil
+}
+
+// GenerateEutranVector creates an E-UTRAN key vector.
+// Inputs:
+//   key: 128 or 256 bit subscriber key
+//   topc: 256 bit operator variant algorithm configuration field
+//   sqn: 48 bit sequence number
+//   plmn: 24 bit network identifier
+// Outputs: An EutranVector or an error. The EutranVector is not nil if and only if err == nil.
+func (tuak *TuakCipher) GenerateEutranVector(key []byte, topc []byte, sqn uint64, plmn []byte) (*EutranVector, error) {
+	if len(plmn) != ExpectedPlmnBytes {
+		return nil, fmt.Errorf("incorrect plmn size. Expected 3 bytes, but got %v bytes", len(plmn))
+	}
+
+	vector, err := tuak.GenerateSIPAuthVector(key, topc, sqn)
+	if err != nil {
+		return nil, err
+	}
+
+	sqnBytes := getSqnBytes(sqn)
+	kasme, err := generateKasme(vector.ConfidentialityKey[:], vector.IntegrityKey[:], plmn, sqnBytes, vector.AnonymityKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return newEutranVector(vector.Rand[:], vector.Xres[:], vector.Autn[:], kasme), nil
+}
+
+// GenerateSIPAuthVector creates a SIP auth vector.
+// Inputs:
+//   key: 128 or 256 bit subscriber key
+//   topc: 256 bit operator variant algorithm configuration field
+//   sqn: 48 bit sequence number
+// Outputs: A SIP auth vector or an error. The SIP auth vector is not nil if and only if err == nil.
+func (tuak *TuakCipher) GenerateSIPAuthVector(key []byte, topc []byte, sqn uint64) (*SIPAuthVector, error) {
+	if err := validateTuakInputs(key, topc); err != nil {
+		return nil, err
+	}
+	if sqn > maxSqn {
+		return nil, fmt.Errorf("sequence number too large, expected a number which can fit in 48 bits. Got: %v", sqn)
+	}
+
+	var randChallenge = make([]byte, RandChallengeBytes)
+	_, err := tuak.rng.Read(randChallenge)
+	if err != nil {
+		return nil, err
+	}
+	sqnBytes := getSqnBytes(sqn)
+
+	macA := tuakF1(tuak.keccakIterations, key, topc, randChallenge, sqnBytes, tuak.amf[:], false)
+	xres, ck, ik, ak := tuakF2F5(tuak.keccakIterations, key, topc, randChallenge, XresBytes)
+
+	autn := generateAutn(sqnBytes, ak, macA, tuak.amf[:])
+	return newSIPAuthVector(randChallenge, xres, autn, ck, ik, ak), nil
+}
+
+// GenerateResync computes SQN_MS and MAC-S from AUTS for re-synchronization.
+//    AUTS = SQN_MS ^ AK || f1*(SQN_MS || RAND || AMF*)
+// Inputs:
+//    auts: 112 bit authentication token from client key
+//    key: 128 or 256 bit subscriber key
+//    topc: 256 bit operator variant algorithm configuration field
+//    rand: 128 bit random challenge
+// Outputs: (sqnMs, macS) or an error
+//	sqn_ms, 48 bit sequence number from client
+//	mac_s, 64 bit resync authentication code
+func (tuak *TuakCipher) GenerateResync(auts, key, topc, rand []byte) (uint64, [8]byte, error) {
+	var macS [8]byte
+	if len(auts) != ExpectedAutsBytes {
+		return 0, macS, fmt.Errorf("incorrect auts size. Expected %v bytes, but got %v bytes", ExpectedAutsBytes, len(auts))
+	}
+	if err := validateTuakInputs(key, topc); err != nil {
+		return 0, macS, err
+	}
+	if len(rand) != RandChallengeBytes {
+		return 0, macS, fmt.Errorf("incorrect rand size. Expected %v bytes, but got %v bytes", RandChallengeBytes, len(rand))
+	}
+
+	ak := tuakF5Star(tuak.keccakIterations, key, topc, rand)
+	sqnMs := xor(auts[:sqnMaxBytes], ak)
+	sqnMsBytes := make([]byte, 8)
+	copy(sqnMsBytes[8-sqnMaxBytes:], sqnMs)
+
+	copy(macS[:], tuakF1(tuak.keccakIterations, key, topc, rand, sqnMs, tuak.amf[:], true))
+	return binary.BigEndian.Uint64(sqnMsBytes), macS, nil
+}
+
+// GenerateTopc returns the TOP_c according to 3GPP 35.231 6.2
+// Inputs:
+//   key: 128 or 256 bit subscriber key
+//   top: 256 bit operator variant configuration field
+func GenerateTopc(key, top []byte) ([ExpectedTopcBytes]byte, error) {
+	var topc [ExpectedTopcBytes]byte
+	if err := validateTuakKey(key); err != nil {
+		return topc, err
+	}
+	if len(top) != ExpectedTopBytes {
+		return topc, fmt.Errorf("incorrect top size. Expected %v bytes, but got %v bytes", ExpectedTopBytes, len(top))
+	}
+
+	out := tuakKeccak(DefaultKeccakIterations, key, top, getTuakKeyInstance(key), nil, nil, nil)
+	copy(topc[:], pullTuakData(out, tuakTopOffset, ExpectedTopcBytes))
+	return topc, nil
+}
+
+// validateTuakInputs ensures that the key and TOPc have the correct number of bytes.
+// Output: An error if any of the arguments is invalid or nil otherwise.
+func validateTuakInputs(key, topc []byte) error {
+	if err := validateTuakKey(key); err != nil {
+		return err
+	}
+	if len(topc) != ExpectedTopcBytes {
+		return fmt.Errorf("incorrect topc size. Expected %v bytes, but got %v bytes", ExpectedTopcBytes, len(topc))
+	}
+	return nil
+}
+
+// validateTuakKey ensures that the key is either 128 or 256 bits.
+func validateTuakKey(key []byte) error {
+	if len(key) != ExpectedTuakShortKeyBytes && len(key) != ExpectedTuakLongKeyBytes {
+		return fmt.Errorf("incorrect key size. Expected %v or %v bytes, but got %v bytes",
+			ExpectedTuakShortKeyBytes, ExpectedTuakLongKeyBytes, len(key))
+	}
+	return nil
+}
+
+// tuakF1 implements f1 and f1*, the network authentication function and the
+// re-synchronisation message authentication function according to 3GPP 35.231 6.4
+//
+// Inputs:
+//   iterations: number of Keccak iterations
+//   key: 128 or 256 bit subscriber key
+//   topc: 256 bit computed from TOP and subscriber key
+//   rand: 128 bit random challenge
+//   sqn: 48 bit sequence number
+//   amf: 16 bit authentication management field
+//   resync: whether to compute f1* rather than f1
+// Outputs: 64 bit MAC-A (or MAC-S if resync is set)
+func tuakF1(iterations int, key, topc, rand, sqn, amf []byte, resync bool) []byte {
+	const macBytes = 8
+
+	instance := tuakInstanceShortMac | getTuakKeyInstance(key)
+	if resync {
+		instance |= tuakInstanceResync
+	}
+	out := tuakKeccak(iterations, key, topc, instance, rand, amf, sqn)
+	return pullTuakData(out, tuakMacOffset, macBytes)
+}
+
+// tuakF2F5 implements f2, f3, f4 and f5 according to 3GPP 35.231 6.5
+//
+// Inputs:
+//   iterations: number of Keccak iterations
+//   key: 128 or 256 bit subscriber key
+//   topc: 256 bit computed from TOP and subscriber key
+//   rand: 128 bit random challenge
+//   resBytes: size of the response, 4, 8, 16 or 32 bytes
+// Outputs: (response, 128 bit confidentiality key, 128 bit integrity key, 48 bit anonymity key)
+func tuakF2F5(iterations int, key, topc, rand []byte, resBytes int) ([]byte, []byte, []byte, []byte) {
+	instance := tuakInstanceF2F5 | getTuakResInstance(resBytes) | getTuakKeyInstance(key)
+	out := tuakKeccak(iterations, key, topc, instance, rand, nil, nil)
+
+	res := pullTuakData(out, tuakResOffset, resBytes)
+	ck := pullTuakData(out, tuakCkOffset, ConfidentialityKeyBytes)
+	ik := pullTuakData(out, tuakIkOffset, IntegrityKeyBytes)
+	ak := pullTuakData(out, tuakAkOffset, sqnMaxBytes)
+	return res, ck, ik, ak
+}
+
+// tuakF5Star implements f5*, the anonymity key for re-synchronisation
+// according to 3GPP 35.231 6.6
+// Outputs: ak, 48 bit anonymity key
+func tuakF5Star(iterations int, key, topc, rand []byte) []byte {
+	instance := tuakInstanceF2F5 | tuakInstanceResync | getTuakKeyInstance(key)
+	out := tuakKeccak(iterations, key, topc, instance, rand, nil, nil)
+	return pullTuakData(out, tuakAkOffset, sqnMaxBytes)
+}
+
+// getTuakResInstance returns the INSTANCE bits which encode the response size.
+// Sizes other than 64, 128 and 256 bits are encoded as 32 bits.
+func getTuakResInstance(resBytes int) byte {
+	switch resBytes {
+	case 8:
+		return tuakInstanceRes64
+	case 16:
+		return tuakInstanceRes128
+	case 32:
+		return tuakInstanceRes256
+	}
+	return 0
+}
+
+// getTuakKeyInstance returns the INSTANCE bit which encodes the key length.
+func getTuakKeyInstance(key []byte) byte {
+	if len(key) == ExpectedTuakLongKeyBytes {
+		return tuakInstanceLongKey
+	}
+	return 0
+}
+
+// tuakKeccak builds the TUAK input from its fields, applies the Keccak permutation
+// `iterations` times and returns the resulting state (3GPP TS 35.231 6.3).
+// A 128 bit key is placed in the least significant half of the key field
+// and nil fields are left as zero.
+func tuakKeccak(iterations int, key, top []byte, instance byte, rand, amf, sqn []byte) *[keccakStateBytes]byte {
+	var state [keccakStateBytes]byte
+	pushTuakData(&state, tuakTopOffset, top)
+	state[tuakInstanceOffset] = instance
+	pushTuakData(&state, tuakAlgonameOffset, tuakAlgoname)
+	pushTuakData(&state, tuakRandOffset, rand)
+	pushTuakData(&state, tuakAmfOffset, amf)
+	pushTuakData(&state, tuakSqnOffset, sqn)
+	pushTuakData(&state, tuakKeyOffset, key)
+
+	// Keccak padding for a 768 bit message with a 1088 bit rate
+	state[tuakPaddingOffset] = 0x1F
+	state[keccakRateBytes-1] = 0x80
+
+	for i := 0; i < iterations; i++ {
+		keccakF1600(&state)
+	}
+	return &state
+}
+
+// pushTuakData writes data into the state at the given offset with its byte order reversed,
+// as the TUAK fields are big-endian while the Keccak state is little-endian.
+func pushTuakData(state *[keccakStateBytes]byte, offset int, data []byte) {
+	n := len(data)
+	for i := 0; i < n; i++ {
+		state[offset+i] = data[n-1-i]
+	}
+}
+
+// pullTuakData reads n bytes from the state at the given offset with their byte order reversed.
+func pullTuakData(state *[keccakStateBytes]byte, offset, n int) []byte {
+	data := make([]byte, n)
+	for i := 0; i < n; i++ {
+		data[i] = state[offset+n-1-i]
+	}
+	return data
+}
+
+// keccakRoundConstants are the iota step constants of Keccak-f[1600].
+var keccakRoundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// keccakRotations are the rho step offsets of Keccak-f[1600], indexed by x + 5y.
+var keccakRotations = [25]uint{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// keccakF1600 applies the Keccak-f[1600] permutation (FIPS 202 3.3) to the state in place.
+func keccakF1600(state *[keccakStateBytes]byte) {
+	var a, b [25]uint64
+	var c, d [5]uint64
+	for i := range a {
+		a[i] = binary.LittleEndian.Uint64(state[8*i:])
+	}
+
+	for round := 0; round < len(keccakRoundConstants); round++ {
+		// theta
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ rotl64(c[(x+1)%5], 1)
+		}
+		for i := range a {
+			a[i] ^= d[i%5]
+		}
+
+		// rho and pi
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = rotl64(a[x+5*y], keccakRotations[x+5*y])
+			}
+		}
+
+		// chi
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+
+		// iota
+		a[0] ^= keccakRoundConstants[round]
+	}
+
+	for i := range a {
+		binary.LittleEndian.PutUint64(state[8*i:], a[i])
+	}
+}
+
+// rotl64 rotates x left by n bits.
+func rotl64(x uint64, n uint) uint64 {
+	return x<<(n%64) | x>>((64-n)%64)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Inputs of 3GPP TS 35.232 Test Set 1 (128 bit key, 64 bit MAC, one Keccak iteration).
+const (
+	tuakSet1Key  = "abababababababababababababababab"
+	tuakSet1Top  = "5555555555555555555555555555555555555555555555555555555555555555"
+	tuakSet1Topc = "bd04d9530e87513c5d837ac2ad954623a8e2330c115305a73eb45d1f40cccbff"
+	tuakSet1Rand = "42424242424242424242424242424242"
+	tuakSet1Sqn  = "111111111111"
+	tuakSet1Amf  = "ffff"
+)
+
+func TestGenerateTopc_Set1(t *testing.T) {
+	topc, err := GenerateTopc(decodeHex(t, tuakSet1Key), decodeHex(t, tuakSet1Top))
+	assert.NoError(t, err)
+	assert.Equal(t, tuakSet1Topc, hex.EncodeToString(topc[:]))
+}
+
+func TestGenerateTopc_InvalidInput(t *testing.T) {
+	_, err := GenerateTopc(make([]byte, 20), make([]byte, ExpectedTopBytes))
+	assert.EqualError(t, err, "incorrect key size. Expected 16 or 32 bytes, but got 20 bytes")
+
+	_, err = GenerateTopc(make([]byte, ExpectedTuakShortKeyBytes), make([]byte, ExpectedOpBytes))
+	assert.EqualError(t, err, "incorrect top size. Expected 32 bytes, but got 16 bytes")
+}
+
+func TestTuakF1_Set1(t *testing.T) {
+	key := decodeHex(t, tuakSet1Key)
+	topc := decodeHex(t, tuakSet1Topc)
+	rand := decodeHex(t, tuakSet1Rand)
+	sqn := decodeHex(t, tuakSet1Sqn)
+	amf := decodeHex(t, tuakSet1Amf)
+
+	macA := tuakF1(DefaultKeccakIterations, key, topc, rand, sqn, amf, false)
+	assert.Equal(t, "f9a54e6aeaa8618d", hex.EncodeToString(macA))
+
+	macS := tuakF1(DefaultKeccakIterations, key, topc, rand, sqn, amf, true)
+	assert.Equal(t, "e94b4dc6c7297df3", hex.EncodeToString(macS))
+}
+
+// TestTuakF2F5_Set1 checks f2-f5 and f5* against TS 35.232 Test Set 1 with a 32 bit RES.
+func TestTuakF2F5_Set1(t *testing.T) {
+	key := decodeHex(t, tuakSet1Key)
+	topc := decodeHex(t, tuakSet1Topc)
+	rand := decodeHex(t, tuakSet1Rand)
+
+	res, ck, ik, ak := tuakF2F5(DefaultKeccakIterations, key, topc, rand, 4)
+	assert.Equal(t, "657acd64", hex.EncodeToString(res))
+	assert.Equal(t, "d71a1e5c6caffe986a26f783e5c78be1", hex.EncodeToString(ck))
+	assert.Equal(t, "be849fa2564f869aecee6f62d4337e72", hex.EncodeToString(ik))
+	assert.Equal(t, "719f1e9b9054", hex.EncodeToString(ak))
+
+	akStar := tuakF5Star(DefaultKeccakIterations, key, topc, rand)
+	assert.Equal(t, "e7af6b3d0e38", hex.EncodeToString(akStar))
+}
+
+func TestTuakGenerateEutranVector(t *testing.T) {
+	rand := []byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\t\n\x0b\x0c\r\x0e\x0f")
+	key := decodeHex(t, tuakSet1Key)
+	topc := decodeHex(t, tuakSet1Topc)
+	sqn := uint64(7351)
+	amf := []byte("\x80\x00")
+	plmn := []byte("\x02\xf8\x59")
+
+	tuak, err := NewMockTuakCipher(amf, rand)
+	assert.NoError(t, err)
+
+	eutran, err := tuak.GenerateEutranVector(key, topc, sqn, plmn)
+	assert.NoError(t, err)
+	assert.Equal(t, rand, eutran.Rand[:])
+	assert.Equal(t, "954b700ce1cbdb18", hex.EncodeToString(eutran.Xres[:]))
+	assert.Equal(t, "5227e37da9228000cb71683eb0e75abb", hex.EncodeToString(eutran.Autn[:]))
+	assert.Equal(t, "f2a1692803284923026ad882e41a854a8c52742bc5ba7f28b77f5837f3c33de3", hex.EncodeToString(eutran.Kasme[:]))
+}
+
+func TestTuakGenerateSIPAuthVector(t *testing.T) {
+	rand := []byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\t\n\x0b\x0c\r\x0e\x0f")
+	key := decodeHex(t, tuakSet1Key)
+	topc := decodeHex(t, tuakSet1Topc)
+	sqn := uint64(7351)
+	amf := []byte("\x80\x00")
+
+	tuak, err := NewMockTuakCipher(amf, rand)
+	assert.NoError(t, err)
+
+	vector, err := tuak.GenerateSIPAuthVector(key, topc, sqn)
+	assert.NoError(t, err)
+	assert.Equal(t, rand, vector.Rand[:])
+	assert.Equal(t, "954b700ce1cbdb18", hex.EncodeToString(vector.Xres[:]))
+	assert.Equal(t, "5227e37da9228000cb71683eb0e75abb", hex.EncodeToString(vector.Autn[:]))
+	assert.Equal(t, "e0b2fedb59075fc520dea76d4ec8ac7b", hex.EncodeToString(vector.ConfidentialityKey[:]))
+	assert.Equal(t, "296ffb58145446c635c324c7c1370e6a", hex.EncodeToString(vector.IntegrityKey[:]))
+	assert.Equal(t, "5227e37db595", hex.EncodeToString(vector.AnonymityKey[:sqnMaxBytes]))
+}
+
+// The 256 bit key and multiple iteration vectors below were computed with an
+// independent implementation of TS 35.231 which reproduces Test Set 1.
+func TestTuakGenerateSIPAuthVector_LongKey(t *testing.T) {
+	rand := []byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\t\n\x0b\x0c\r\x0e\x0f")
+	key := make([]byte, ExpectedTuakLongKeyBytes)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	topc, err := GenerateTopc(key, decodeHex(t, tuakSet1Top))
+	assert.NoError(t, err)
+	assert.Equal(t, "9576f4692927884118dbd54266bbbba390e50ce0f3b252a988261c14c1b55e2b", hex.EncodeToString(topc[:]))
+
+	tuak, err := NewMockTuakCipher([]byte("\x80\x00"), rand)
+	assert.NoError(t, err)
+
+	vector, err := tuak.GenerateSIPAuthVector(key, topc[:], 7351)
+	assert.NoError(t, err)
+	assert.Equal(t, "09440ec7bb2703da", hex.EncodeToString(vector.Xres[:]))
+	assert.Equal(t, "79f0f0afd0fa8000d9c342f7964bcd1d", hex.EncodeToString(vector.Autn[:]))
+	assert.Equal(t, "6900f7b2e0b57878f49f186e9f9f792f", hex.EncodeToString(vector.ConfidentialityKey[:]))
+	assert.Equal(t, "bac8dbda76a358cf3ade05c35ba6769d", hex.EncodeToString(vector.IntegrityKey[:]))
+	assert.Equal(t, "79f0f0afcc4d", hex.EncodeToString(vector.AnonymityKey[:sqnMaxBytes]))
+}
+
+func TestTuakGenerateEutranVector_LongKeyMultipleIterations(t *testing.T) {
+	rand := []byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\t\n\x0b\x0c\r\x0e\x0f")
+	key := make([]byte, ExpectedTuakLongKeyBytes)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	topc := decodeHex(t, "9576f4692927884118dbd54266bbbba390e50ce0f3b252a988261c14c1b55e2b")
+
+	tuak, err := NewMockTuakCipher([]byte("\x80\x00"), rand)
+	assert.NoError(t, err)
+	tuak.keccakIterations = 3
+
+	vector, err := tuak.GenerateSIPAuthVector(key, topc, 7351)
+	assert.NoError(t, err)
+	assert.Equal(t, "fe53512b8002ddd1", hex.EncodeToString(vector.Xres[:]))
+	assert.Equal(t, "ae5ccf4efc98800001cb88fe193ff3aa", hex.EncodeToString(vector.Autn[:]))
+	assert.Equal(t, "3423f60a80c19c8063d0f3419607bd01", hex.EncodeToString(vector.ConfidentialityKey[:]))
+	assert.Equal(t, "d6339e61c51d2025fe8d32fd8e0faf17", hex.EncodeToString(vector.IntegrityKey[:]))
+
+	eutran, err := tuak.GenerateEutranVector(key, topc, 7351, []byte("\x02\xf8\x59"))
+	assert.NoError(t, err)
+	assert.Equal(t, "f7fa06fb3fc03d8a2acd5f8d7eebb387754b4989d49628a10ff460580d01f483", hex.EncodeToString(eutran.Kasme[:]))
+}
+
+func TestTuakGenerateSIPAuthVector_InvalidInput(t *testing.T) {
+	tuak, err := NewTuakCipher([]byte("\x80\x00"))
+	assert.NoError(t, err)
+
+	key := make([]byte, ExpectedTuakShortKeyBytes)
+	topc := make([]byte, ExpectedTopcBytes)
+
+	_, err = tuak.GenerateSIPAuthVector(make([]byte, 24), topc, 0)
+	assert.EqualError(t, err, "incorrect key size. Expected 16 or 32 bytes, but got 24 bytes")
+
+	_, err = tuak.GenerateSIPAuthVector(key, make([]byte, ExpectedOpcBytes), 0)
+	assert.EqualError(t, err, "incorrect topc size. Expected 32 bytes, but got 16 bytes")
+
+	_, err = tuak.GenerateSIPAuthVector(key, topc, maxSqn+1)
+	assert.Error(t, err)
+
+	_, err = tuak.GenerateEutranVector(key, topc, 0, nil)
+	assert.EqualError(t, err, "incorrect plmn size. Expected 3 bytes, but got 0 bytes")
+}
+
+func TestNewTuakError(t *testing.T) {
+	_, err := NewTuakCipher(nil)
+	assert.EqualError(t, err, "incorrect amf size. Expected 2 bytes, but got 0 bytes")
+}
+
+func TestTuakGenerateResync(t *testing.T) {
+	key := decodeHex(t, tuakSet1Key)
+	topc := decodeHex(t, tuakSet1Topc)
+	rand := decodeHex(t, tuakSet1Rand)
+	auts := decodeHex(t, "e7af6b3d0dd0bfa7f3cd4d9ec7d1")
+
+	tuak, err := NewTuakCipher(make([]byte, ExpectedAmfBytes))
+	assert.NoError(t, err)
+
+	sqnMs, macS, err := tuak.GenerateResync(auts, key, topc, rand)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), sqnMs)
+	assert.Equal(t, auts[sqnMaxBytes:], macS[:])
+}
+
+func TestTuakGenerateResync_InvalidInput(t *testing.T) {
+	tuak, err := NewTuakCipher(make([]byte, ExpectedAmfBytes))
+	assert.NoError(t, err)
+
+	key := make([]byte, ExpectedTuakShortKeyBytes)
+	topc := make([]byte, ExpectedTopcBytes)
+	rand := make([]byte, RandChallengeBytes)
+	auts := make([]byte, ExpectedAutsBytes)
+
+	_, _, err = tuak.GenerateResync(auts[1:], key, topc, rand)
+	assert.EqualError(t, err, "incorrect auts size. Expected 14 bytes, but got 13 bytes")
+
+	_, _, err = tuak.GenerateResync(auts, key[1:], topc, rand)
+	assert.EqualError(t, err, "incorrect key size. Expected 16 or 32 bytes, but got 15 bytes")
+
+	_, _, err = tuak.GenerateResync(auts, key, topc[1:], rand)
+	assert.EqualError(t, err, "incorrect topc size. Expected 32 bytes, but got 31 bytes")
+
+	_, _, err = tuak.GenerateResync(auts, key, topc, rand[1:])
+	assert.EqualError(t, err, "incorrect rand size. Expected 16 bytes, but got 15 bytes")
+}
+
+// TestKeccakF1600 checks the permutation against the SHA3-256 digest of the empty string (FIPS 202).
+func TestKeccakF1600(t *testing.T) {
+	var state [keccakStateBytes]byte
+	state[0] = 0x06
+	state[keccakRateBytes-1] = 0x80
+	keccakF1600(&state)
+	assert.Equal(t, "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a", hex.EncodeToString(state[:32]))
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	assert.NoError(t, err)
+	return b
+}
@@ -15,11 +15,17 @@ import (
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/services/eps_authentication"
 	"magma/lte/cloud/go/services/eps_authentication/servicers"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	"magma/lte/cloud/go/services/subscriberdb/storage"
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/service"
 )
 
+const (
+	lteAuthIndBitsKey  = "lteAuthIndBits"
+	lteAuthAgeLimitKey = "lteAuthAgeLimit"
+)
+
 func main() {
 	// Create the service
 	srv, err := service.NewOrchestratorService(lte.ModuleName, eps_authentication.ServiceName)
@@ -33,13 +39,29 @@ func main() {
 		log.Fatalf("Failed to initialize datastore: %s", err)
 	}
 
-	subscriberDBStore, err := storage.NewSubscriberDBStorage(store)
+	// eps_authentication reads and writes the subscribers directly, so it
+	// needs the same keys as subscriberdb
+	cipher, err := encryption.GetSecretsCipher(srv.Config)
+	if err != nil {
+		log.Fatalf("Failed to initialize subscriber encryption: %s", err)
+	}
+	subscriberDBStore, err := storage.NewSubscriberDBStorageWithCipher(store, storage.DefaultChunkSize, cipher)
 	if err != nil {
 		log.Fatalf("Failed to initialize subscriberdb store: %s", err)
 	}
 
+	sqnConfig := servicers.DefaultSqnConfig
+	if srv.Config != nil {
+		if indBits, err := srv.Config.GetIntParam(lteAuthIndBitsKey); err == nil && indBits >= 0 {
+			sqnConfig.IndBits = uint32(indBits)
+		}
+		if ageLimit, err := srv.Config.GetIntParam(lteAuthAgeLimitKey); err == nil && ageLimit >= 0 {
+			sqnConfig.AgeLimit = uint64(ageLimit)
+		}
+	}
+
 	// Add servicers to the service
-	servicer, err := servicers.NewEPSAuthServer(subscriberDBStore)
+	servicer, err := servicers.NewEPSAuthServer(subscriberDBStore, sqnConfig)
 	if err != nil {
 		log.Fatalf("EPS Auth Servicer Initialization Error: %s", err)
 	}
@@ -57,48 +57,66 @@ func (srv *EPSAuthServer) AuthenticationInformation(ctx context.Context, air *fe
 		subscriber.State = &lteprotos.SubscriberState{}
 	}
 
-	lteAuthNextSeq, err := ResyncLteAuthSeq(subscriber, air.ResyncInfo, config.LteAuthOp)
+	err = ResyncLteAuthSeq(subscriber, air.ResyncInfo, config.LteAuthOp, srv.SqnConfig)
 	if err != nil {
 		glog.V(2).Infof("resync auth request failed: %v", err.Error())
 		metrics.ResyncAuthErrors.Inc()
 		return convertAuthErrorToAuthenticationAnswer(err)
 	}
-	if err = srv.setLteAuthNextSeq(subscriber, lteAuthNextSeq); err != nil {
+	if err = srv.storeSubscriberState(subscriber); err != nil {
 		glog.V(2).Infof("failed to store sequence number after resync: %v", err.Error())
 		metrics.StorageErrors.Inc()
 		return &fegprotos.AuthenticationInformationAnswer{ErrorCode: fegprotos.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE}, err
 	}
 
-	milenage, err := crypto.NewMilenageCipher(config.LteAuthAmf)
+	cipher, err := NewLteAuthCipher(subscriber.Lte, config.LteAuthAmf)
 	if err != nil {
-		glog.V(2).Infof("could not create milenage cipher: %v", err.Error())
+		glog.V(2).Infof("could not create auth cipher: %v", err.Error())
 		metrics.AuthErrors.Inc()
 		return &fegprotos.AuthenticationInformationAnswer{ErrorCode: fegprotos.ErrorCode_AUTHORIZATION_REJECTED},
-			status.Errorf(codes.FailedPrecondition, "Could not create milenage cipher: %s", err.Error())
+			status.Errorf(codes.FailedPrecondition, "Could not create auth cipher: %s", err.Error())
 	}
 
-	vectors, lteAuthNextSeq, err := GenerateLteAuthVectors(
+	vectors, err := GenerateLteAuthVectors(
 		air.NumRequestedEutranVectors,
-		milenage,
+		cipher,
 		subscriber,
 		air.VisitedPlmn,
 		config.LteAuthOp,
-		0,
+		srv.SqnConfig,
 	)
 	if err != nil {
 		glog.V(2).Infof("could not generate lte auth vectors: %v", err.Error())
 		metrics.AuthErrors.Inc()
 		return convertAuthErrorToAuthenticationAnswer(err)
 	}
-	if err = srv.setLteAuthNextSeq(subscriber, lteAuthNextSeq); err != nil {
+	if err = srv.storeSubscriberState(subscriber); err != nil {
 		glog.V(2).Infof("failed to store sequence number after generating auth vectors: %v", err.Error())
 		metrics.StorageErrors.Inc()
 		return &fegprotos.AuthenticationInformationAnswer{ErrorCode: fegprotos.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE}, err
 	}
 
+	var triplets []*crypto.GsmTriplet
+	if air.NumRequestedGeranVectors > 0 {
+		milenage, err := crypto.NewMilenageCipher(config.LteAuthAmf)
+		if err != nil {
+			glog.V(2).Infof("could not create milenage cipher: %v", err.Error())
+			metrics.AuthErrors.Inc()
+			return &fegprotos.AuthenticationInformationAnswer{ErrorCode: fegprotos.ErrorCode_AUTHORIZATION_REJECTED},
+				status.Errorf(codes.FailedPrecondition, "Could not create milenage cipher: %s", err.Error())
+		}
+		triplets, err = GenerateGsmAuthTriplets(air.NumRequestedGeranVectors, milenage, subscriber, config.LteAuthOp)
+		if err != nil {
+			glog.V(2).Infof("could not generate gsm auth triplets: %v", err.Error())
+			metrics.AuthErrors.Inc()
+			return convertAuthErrorToAuthenticationAnswer(err)
+		}
+	}
+
 	return &fegprotos.AuthenticationInformationAnswer{
 		ErrorCode:     fegprotos.ErrorCode_SUCCESS,
 		EutranVectors: convertEutranVectorsToProto(vectors),
+		GeranVectors:  convertGeranVectorsToProto(triplets),
 	}, nil
 }
 
@@ -113,8 +131,8 @@ func validateAIR(air *fegprotos.AuthenticationInformationRequest) error {
 	if len(air.VisitedPlmn) != crypto.ExpectedPlmnBytes {
 		return fmt.Errorf("expected Visited PLMN to be %v bytes, but got %v bytes", crypto.ExpectedPlmnBytes, len(air.VisitedPlmn))
 	}
-	if air.NumRequestedEutranVectors == 0 {
-		return errors.New("0 E-UTRAN vectors were requested")
+	if air.NumRequestedEutranVectors == 0 && air.NumRequestedGeranVectors == 0 {
+		return errors.New("0 E-UTRAN and 0 GERAN vectors were requested")
 	}
 	return nil
 }
@@ -140,12 +158,11 @@ func convertAuthErrorToAuthenticationAnswer(err error) (*fegprotos.Authenticatio
 	return answer, grpcErr
 }
 
-// setLteAuthNextSeq sets the subscriber's LteAuthNextSeq field in the database.
-func (srv *EPSAuthServer) setLteAuthNextSeq(subscriber *lteprotos.SubscriberData, lteAuthNextSeq uint64) error {
+// storeSubscriberState stores the subscriber's sequence number state in the database.
+func (srv *EPSAuthServer) storeSubscriberState(subscriber *lteprotos.SubscriberData) error {
 	if subscriber.GetState() == nil {
 		return NewAuthDataUnavailableError("subscriber state was nil")
 	}
-	subscriber.State.LteAuthNextSeq = lteAuthNextSeq
 	_, err := srv.Store.UpdateSubscriber(subscriber)
 	return err
 }
@@ -163,3 +180,16 @@ func convertEutranVectorsToProto(vectors []*crypto.EutranVector) []*fegprotos.Au
 	}
 	return result
 }
+
+// convertGeranVectorsToProto serialized a list of GSM triplets to proto.
+func convertGeranVectorsToProto(triplets []*crypto.GsmTriplet) []*fegprotos.AuthenticationInformationAnswer_GERANVector {
+	result := make([]*fegprotos.AuthenticationInformationAnswer_GERANVector, len(triplets))
+	for i, triplet := range triplets {
+		result[i] = &fegprotos.AuthenticationInformationAnswer_GERANVector{
+			Rand: triplet.Rand[:],
+			Sres: triplet.Sres[:],
+			Kc:   triplet.Kc[:],
+		}
+	}
+	return result
+}
@@ -47,7 +47,7 @@ func (suite *EpsAuthTestSuite) TestAuthenticationInformation_0RequestedVectors()
 	}
 
 	_, err := suite.AuthenticationInformation(air)
-	suite.EqualError(err, "rpc error: code = InvalidArgument desc = 0 E-UTRAN vectors were requested")
+	suite.EqualError(err, "rpc error: code = InvalidArgument desc = 0 E-UTRAN and 0 GERAN vectors were requested")
 }
 
 func (suite *EpsAuthTestSuite) TestAuthenticationInformation_UnknownGateway() {
@@ -156,6 +156,78 @@ func (suite *EpsAuthTestSuite) TestAuthenticationInformation_ResyncSuccess() {
 	suite.checkAIA(aia, protos.ErrorCode_SUCCESS, 3)
 }
 
+func (suite *EpsAuthTestSuite) TestAuthenticationInformation_TuakSuccess() {
+	air := &protos.AuthenticationInformationRequest{
+		UserName:                  "tuak_sub",
+		VisitedPlmn:               []byte{0, 0, 0},
+		NumRequestedEutranVectors: 3,
+	}
+
+	aia, err := suite.AuthenticationInformation(air)
+	suite.NoError(err)
+	suite.checkAIA(aia, protos.ErrorCode_SUCCESS, 3)
+}
+
+func (suite *EpsAuthTestSuite) TestAuthenticationInformation_TuakResyncSuccess() {
+	resyncInfo := make([]byte, 30)
+	auts := []byte{196, 101, 115, 11, 44, 118, 11, 165, 252, 5, 178, 136, 49, 149}
+	copy(resyncInfo[16:], auts)
+	air := &protos.AuthenticationInformationRequest{
+		UserName:                  "tuak_sub",
+		VisitedPlmn:               []byte{0, 0, 0},
+		NumRequestedEutranVectors: 3,
+		ResyncInfo:                resyncInfo,
+	}
+
+	aia, err := suite.AuthenticationInformation(air)
+	suite.NoError(err)
+	suite.checkAIA(aia, protos.ErrorCode_SUCCESS, 3)
+}
+
+func (suite *EpsAuthTestSuite) TestAuthenticationInformation_GeranSuccess() {
+	air := &protos.AuthenticationInformationRequest{
+		UserName:                 "sub1",
+		VisitedPlmn:              []byte{0, 0, 0},
+		NumRequestedGeranVectors: 2,
+	}
+
+	aia, err := suite.AuthenticationInformation(air)
+	suite.NoError(err)
+	suite.checkAIA(aia, protos.ErrorCode_SUCCESS, 0)
+	suite.checkGeranVectors(aia, 2)
+
+	air.NumRequestedEutranVectors = 3
+	aia, err = suite.AuthenticationInformation(air)
+	suite.NoError(err)
+	suite.checkAIA(aia, protos.ErrorCode_SUCCESS, 3)
+	suite.checkGeranVectors(aia, 2)
+}
+
+func (suite *EpsAuthTestSuite) TestAuthenticationInformation_GeranPrecomputedSuccess() {
+	air := &protos.AuthenticationInformationRequest{
+		UserName:                 "tuak_sub",
+		VisitedPlmn:              []byte{0, 0, 0},
+		NumRequestedGeranVectors: 2,
+	}
+
+	aia, err := suite.AuthenticationInformation(air)
+	suite.NoError(err)
+	suite.checkAIA(aia, protos.ErrorCode_SUCCESS, 0)
+	suite.checkGeranVectors(aia, 1)
+}
+
+func (suite *EpsAuthTestSuite) TestAuthenticationInformation_GeranMissingGsmSubscription() {
+	air := &protos.AuthenticationInformationRequest{
+		UserName:                 "missing_auth_key",
+		VisitedPlmn:              []byte{0, 0, 0},
+		NumRequestedGeranVectors: 1,
+	}
+
+	aia, err := suite.AuthenticationInformation(air)
+	suite.EqualError(err, "rpc error: code = Unauthenticated desc = Authentication rejected: Subscriber data missing GSM subscription")
+	suite.checkAIA(aia, protos.ErrorCode_AUTHORIZATION_REJECTED, 0)
+}
+
 func (suite *EpsAuthTestSuite) checkAIA(aia *protos.AuthenticationInformationAnswer, errorCode protos.ErrorCode, numVectors int) {
 	suite.Equal(errorCode, aia.ErrorCode)
 	suite.Equal(numVectors, len(aia.EutranVectors))
@@ -166,3 +238,12 @@ func (suite *EpsAuthTestSuite) checkAIA(aia *protos.AuthenticationInformationAns
 		suite.Equal(crypto.KasmeBytes, len(vector.Kasme))
 	}
 }
+
+func (suite *EpsAuthTestSuite) checkGeranVectors(aia *protos.AuthenticationInformationAnswer, numVectors int) {
+	suite.Equal(numVectors, len(aia.GeranVectors))
+	for _, vector := range aia.GeranVectors {
+		suite.Equal(crypto.RandChallengeBytes, len(vector.Rand))
+		suite.Equal(crypto.SresBytes, len(vector.Sres))
+		suite.Equal(crypto.KcBytes, len(vector.Kc))
+	}
+}
@@ -22,26 +22,8 @@ var (
 	defaultPlmn       = []byte("\x02\xf8\x59")
 	defaultLteAuthOp  = []byte("\xcd\xc2\x02\xd5\x12> \xf6+mgj\xc7,\xb3\x18")
 	defaultLteAuthAmf = []byte("\x80\x00")
-	defaultAuthSqnInd = uint64(0)
 )
 
-func TestSeqToSqn(t *testing.T) {
-	assert.Equal(t, uint64(0x1FE000), SeqToSqn(0xFF00, 0))
-	assert.Equal(t, uint64(0xFFFFFFFFFA00), SeqToSqn(0xFFFFFFFFFFD0, 0))
-	assert.Equal(t, uint64(0x142), SeqToSqn(0xA, 2))
-	assert.Equal(t, uint64(0xFFFFFFFFF805), SeqToSqn(0xFFFFFFFFFFC0, 5))
-}
-
-func TestSplitSqn(t *testing.T) {
-	sqn, ind := SplitSqn(0x1FE001)
-	assert.Equal(t, uint64(0xFF00), sqn)
-	assert.Equal(t, uint64(0x1), ind)
-
-	sqn, ind = SplitSqn(0xFFFFFFFFFA1F)
-	assert.Equal(t, uint64(0x7FFFFFFFFD0), sqn)
-	assert.Equal(t, uint64(0x1F), ind)
-}
-
 func TestGetOrGenerateOpc(t *testing.T) {
 	lte := &protos.LTESubscription{AuthOpc: []byte("\xcdc\xcbq\x95J\x9fNH\xa5\x99N7\xa0+\xaf")}
 	opc, err := GetOrGenerateOpc(lte, defaultLteAuthOp)
@@ -61,7 +43,7 @@ func TestGenerateLteAuthVector_MissingLTE(t *testing.T) {
 	assert.NoError(t, err)
 
 	subscriber := &protos.SubscriberData{State: &protos.SubscriberState{}}
-	_, _, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
+	_, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, DefaultSqnConfig)
 	assert.Exactly(t, NewAuthRejectedError("Subscriber data missing LTE subscription"), err)
 }
 
@@ -75,7 +57,7 @@ func TestGenerateLteAuthVector_MissingSubscriberState(t *testing.T) {
 			AuthAlgo: protos.LTESubscription_MILENAGE,
 		},
 	}
-	_, _, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
+	_, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, DefaultSqnConfig)
 	assert.Exactly(t, NewAuthRejectedError("Subscriber data missing subscriber state"), err)
 }
 
@@ -90,7 +72,7 @@ func TestGenerateLteAuthVector_InactiveLTESubscription(t *testing.T) {
 		},
 		State: &protos.SubscriberState{},
 	}
-	_, _, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
+	_, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, DefaultSqnConfig)
 	assert.Exactly(t, NewAuthRejectedError("LTE Service not active"), err)
 }
 
@@ -105,7 +87,7 @@ func TestGenerateLteAuthVector_UnknownLTEAuthAlgo(t *testing.T) {
 		},
 		State: &protos.SubscriberState{},
 	}
-	_, _, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
+	_, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, DefaultSqnConfig)
 	assert.Exactly(t, NewAuthRejectedError("Unsupported crypto algorithm: 10"), err)
 }
 
@@ -122,11 +104,13 @@ func TestGenerateLteAuthVector_Success(t *testing.T) {
 			AuthKey:  []byte("\x8b\xafG?/\x8f\xd0\x94\x87\xcc\xcb\xd7\t|hb"),
 			AuthOpc:  []byte("\x8e'\xb6\xaf\x0ei.u\x0f2fz;\x14`]"),
 		},
-		State: &protos.SubscriberState{LteAuthNextSeq: 229},
+		State: &protos.SubscriberState{LteAuthSeqArray: newSeqArray(228), LteAuthNextInd: 23},
 	}
-	vector, lteAuthNextSeq, err := GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, 23)
+	vector, err := GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, DefaultSqnConfig)
 	assert.NoError(t, err)
-	assert.Equal(t, uint64(230), lteAuthNextSeq)
+	assert.Equal(t, uint64(229), subscriber.State.LteAuthSeqArray[23])
+	assert.Equal(t, uint32(24), subscriber.State.LteAuthNextInd)
+	assert.Equal(t, uint64(230), subscriber.State.LteAuthNextSeq)
 
 	assert.Equal(t, rand, vector.Rand[:])
 	assert.Equal(t, []byte("\x2d\xaf\x87\x3d\x73\xf3\x10\xc6"), vector.Xres[:])
@@ -136,42 +120,94 @@ func TestGenerateLteAuthVector_Success(t *testing.T) {
 
 func TestResyncLteAuthSeq(t *testing.T) {
 	subscriber := test_utils.GetTestSubscribers()[0]
-	lteAuthNextSeq, err := ResyncLteAuthSeq(subscriber, nil, defaultLteAuthOp)
+	err := ResyncLteAuthSeq(subscriber, nil, defaultLteAuthOp, DefaultSqnConfig)
 	assert.NoError(t, err)
-	assert.Equal(t, lteAuthNextSeq, subscriber.GetState().GetLteAuthNextSeq())
+	assert.Equal(t, uint64(7350), subscriber.GetState().GetLteAuthNextSeq())
 
-	lteAuthNextSeq, err = ResyncLteAuthSeq(subscriber, make([]byte, 30), defaultLteAuthOp)
+	err = ResyncLteAuthSeq(subscriber, make([]byte, 30), defaultLteAuthOp, DefaultSqnConfig)
 	assert.NoError(t, err)
-	assert.Equal(t, lteAuthNextSeq, subscriber.GetState().GetLteAuthNextSeq())
+	assert.Equal(t, uint64(7350), subscriber.GetState().GetLteAuthNextSeq())
 
 	resyncInfo := make([]byte, 50)
 	resyncInfo[25] = 1
-	_, err = ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp)
+	err = ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp, DefaultSqnConfig)
 	assert.Exactly(t, NewAuthRejectedError("resync info incorrect length. expected 30 bytes, but got 50 bytes"), err)
 
 	resyncInfo = make([]byte, 30)
 	resyncInfo[0] = 0xFF
-	_, err = ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp)
+	err = ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp, DefaultSqnConfig)
 	assert.Exactly(t, NewAuthRejectedError("Invalid resync authentication code"), err)
 
 	macS := []byte{132, 178, 239, 23, 199, 61, 138, 176}
 	copy(resyncInfo[22:], macS)
-	lteAuthNextSeq, err = ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp)
+	err = ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp, DefaultSqnConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0x4204c05f18b), subscriber.GetState().GetLteAuthNextSeq())
+	assert.Equal(t, newSeqArray(0x4204c05f18a), subscriber.GetState().GetLteAuthSeqArray())
+}
+
+func TestGenerateLteAuthVector_Tuak(t *testing.T) {
+	rand := []byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\t\n\x0b\x0c\r\x0e\x0f")
+	tuak, err := crypto.NewMockTuakCipher([]byte("\x80\x00"), rand)
+	assert.NoError(t, err)
+
+	subscriber := test_utils.GetTestSubscribers()[3]
+	subscriber.State = &protos.SubscriberState{LteAuthSeqArray: newSeqArray(228), LteAuthNextInd: 23}
+	vector, err := GenerateLteAuthVector(tuak, subscriber, defaultPlmn, defaultLteAuthOp, DefaultSqnConfig)
 	assert.NoError(t, err)
-	assert.Equal(t, uint64(0x4204c05f18b), lteAuthNextSeq)
+	assert.Equal(t, uint64(230), subscriber.State.LteAuthNextSeq)
+
+	expected, err := tuak.GenerateEutranVector(subscriber.Lte.AuthKey, subscriber.Lte.AuthTopc, DefaultSqnConfig.SeqToSqn(229, 23), defaultPlmn)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, vector)
 }
 
-func TestGetNextLteAuthSqnAfterResync(t *testing.T) {
-	state := &protos.SubscriberState{LteAuthNextSeq: 1 << 30}
-	_, err := GetNextLteAuthSqnAfterResync(state, SeqToSqn(1<<30-1<<10, 2))
-	assert.Exactly(t, NewAuthRejectedError("Re-sync delta in range but UE rejected auth: 1023"), err)
+func TestResyncLteAuthSeq_Tuak(t *testing.T) {
+	subscriber := test_utils.GetTestSubscribers()[3]
+
+	resyncInfo := make([]byte, 30)
+	resyncInfo[0] = 0xFF
+	err := ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp, DefaultSqnConfig)
+	assert.Exactly(t, NewAuthRejectedError("Invalid resync authentication code"), err)
+
+	resyncInfo = make([]byte, 30)
+	auts := []byte{196, 101, 115, 11, 44, 118, 11, 165, 252, 5, 178, 136, 49, 149}
+	copy(resyncInfo[16:], auts)
+	err = ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp, DefaultSqnConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(8001), subscriber.State.LteAuthNextSeq)
+}
+
+func TestNewLteAuthCipher(t *testing.T) {
+	cipher, err := NewLteAuthCipher(&protos.LTESubscription{AuthAlgo: protos.LTESubscription_MILENAGE}, defaultLteAuthAmf)
+	assert.NoError(t, err)
+	assert.IsType(t, &crypto.MilenageCipher{}, cipher)
+
+	cipher, err = NewLteAuthCipher(nil, defaultLteAuthAmf)
+	assert.NoError(t, err)
+	assert.IsType(t, &crypto.MilenageCipher{}, cipher)
 
-	lteAuthNextSeq, err := GetNextLteAuthSqnAfterResync(state, SeqToSqn(1<<30-1, 3))
+	cipher, err = NewLteAuthCipher(&protos.LTESubscription{AuthAlgo: protos.LTESubscription_TUAK}, defaultLteAuthAmf)
 	assert.NoError(t, err)
-	assert.Equal(t, uint64(1<<30), lteAuthNextSeq)
+	assert.IsType(t, &crypto.TuakCipher{}, cipher)
+
+	_, err = NewLteAuthCipher(&protos.LTESubscription{AuthAlgo: protos.LTESubscription_TUAK}, nil)
+	assert.EqualError(t, err, "incorrect amf size. Expected 2 bytes, but got 0 bytes")
 
-	_, err = GetNextLteAuthSqnAfterResync(nil, 0)
-	assert.Exactly(t, NewAuthDataUnavailableError("subscriber state was nil"), err)
+	_, err = NewLteAuthCipher(&protos.LTESubscription{AuthAlgo: 50}, defaultLteAuthAmf)
+	assert.EqualError(t, err, "Unsupported crypto algorithm: 50")
+}
+
+func TestGetLteAuthOperatorKey(t *testing.T) {
+	lte := test_utils.GetTestSubscribers()[3].Lte
+	topc, err := GetLteAuthOperatorKey(lte, defaultLteAuthOp)
+	assert.NoError(t, err)
+	assert.Equal(t, lte.AuthTopc, topc)
+
+	lte = test_utils.GetTestSubscribers()[0].Lte
+	opc, err := GetLteAuthOperatorKey(lte, defaultLteAuthOp)
+	assert.NoError(t, err)
+	assert.Equal(t, lte.AuthOpc, opc)
 }
 
 func TestValidateLteSubscription(t *testing.T) {
@@ -198,6 +234,27 @@ func TestValidateLteSubscription(t *testing.T) {
 	}
 	err = ValidateLteSubscription(lte)
 	assert.NoError(t, err)
+
+	lte.AuthKeyBits = 256
+	err = ValidateLteSubscription(lte)
+	assert.EqualError(t, err, "Unsupported auth key length for MILENAGE: 256 bits")
+
+	lte = &protos.LTESubscription{
+		State:    protos.LTESubscription_ACTIVE,
+		AuthAlgo: protos.LTESubscription_TUAK,
+		AuthKey:  make([]byte, crypto.ExpectedTuakLongKeyBytes),
+	}
+	err = ValidateLteSubscription(lte)
+	assert.EqualError(t, err, "Subscriber data missing TUAK TOPc")
+
+	lte.AuthTopc = make([]byte, crypto.ExpectedTopcBytes)
+	lte.AuthKeyBits = 128
+	err = ValidateLteSubscription(lte)
+	assert.EqualError(t, err, "Auth key length mismatch. Expected 128 bits, but got 256 bits")
+
+	lte.AuthKeyBits = 256
+	err = ValidateLteSubscription(lte)
+	assert.NoError(t, err)
 }
 
 func TestIsAllZero(t *testing.T) {
@@ -55,7 +55,7 @@ func (suite *EpsAuthTestSuite) SetupTest() {
 		suite.NoError(err)
 	}
 
-	server, err := NewEPSAuthServer(store)
+	server, err := NewEPSAuthServer(store, DefaultSqnConfig)
 	suite.NoError(err)
 	suite.Server = server
 }
@@ -0,0 +1,92 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"errors"
+	"fmt"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/eps_authentication/crypto"
+)
+
+// GenerateGsmAuthTriplets returns `numTriplets` GSM auth triplets for the subscriber (or an error).
+// Subscribers with precomputed auth tuples get at most as many triplets as they have tuples.
+// Inputs:
+//   numTriplets: the number of triplets to return
+//   milenage: the cipher used to generate triplets for MILENAGE subscribers
+//   subscriber: the subscriber whose GSM subscription the triplets are for
+//   lteAuthOp: 128 bit operator variant configuration field, used if the subscriber has no OPc
+func GenerateGsmAuthTriplets(numTriplets uint32, milenage *crypto.MilenageCipher, subscriber *protos.SubscriberData, lteAuthOp []byte) ([]*crypto.GsmTriplet, error) {
+	gsm := subscriber.Gsm
+	if err := ValidateGsmSubscription(gsm); err != nil {
+		return nil, NewAuthRejectedError(err.Error())
+	}
+
+	if gsm.AuthAlgo == protos.GSMSubscription_PRECOMPUTED_AUTH_TUPLES {
+		return getPrecomputedGsmAuthTriplets(numTriplets, gsm.AuthTuples)
+	}
+
+	// The USIM's K and OPc are stored in the LTE subscription
+	lte := subscriber.Lte
+	if lte.GetAuthAlgo() != protos.LTESubscription_MILENAGE || len(lte.GetAuthKey()) == 0 {
+		return nil, NewAuthRejectedError("GSM MILENAGE requires the subscriber's MILENAGE LTE auth key")
+	}
+	opc, err := GetOrGenerateOpc(lte, lteAuthOp)
+	if err != nil {
+		return nil, err
+	}
+
+	var triplets = make([]*crypto.GsmTriplet, 0, numTriplets)
+	for i := uint32(0); i < numTriplets; i++ {
+		triplet, err := milenage.GenerateGsmTriplet(lte.AuthKey, opc)
+		if err != nil {
+			return nil, NewAuthRejectedError(err.Error())
+		}
+		triplets = append(triplets, triplet)
+	}
+	return triplets, nil
+}
+
+// ValidateGsmSubscription returns an error if and only if the GSM subscription
+// is nil, not active or uses an unsupported auth algorithm.
+func ValidateGsmSubscription(gsm *protos.GSMSubscription) error {
+	if gsm == nil {
+		return errors.New("Subscriber data missing GSM subscription")
+	}
+	if gsm.State != protos.GSMSubscription_ACTIVE {
+		return errors.New("GSM Service not active")
+	}
+	switch gsm.AuthAlgo {
+	case protos.GSMSubscription_PRECOMPUTED_AUTH_TUPLES, protos.GSMSubscription_MILENAGE:
+		return nil
+	default:
+		return fmt.Errorf("Unsupported GSM crypto algorithm: %v", gsm.AuthAlgo)
+	}
+}
+
+// getPrecomputedGsmAuthTriplets parses up to `numTriplets` of the precomputed auth tuples.
+func getPrecomputedGsmAuthTriplets(numTriplets uint32, tuples [][]byte) ([]*crypto.GsmTriplet, error) {
+	if len(tuples) == 0 {
+		return nil, NewAuthDataUnavailableError("Subscriber data missing precomputed auth tuples")
+	}
+	if uint32(len(tuples)) < numTriplets {
+		numTriplets = uint32(len(tuples))
+	}
+
+	var triplets = make([]*crypto.GsmTriplet, 0, numTriplets)
+	for _, tuple := range tuples[:numTriplets] {
+		triplet, err := crypto.ParseGsmTriplet(tuple)
+		if err != nil {
+			return nil, NewAuthDataUnavailableError(err.Error())
+		}
+		triplets = append(triplets, triplet)
+	}
+	return triplets, nil
+}
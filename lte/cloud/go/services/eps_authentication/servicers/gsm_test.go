@@ -0,0 +1,97 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/eps_authentication/crypto"
+	"magma/lte/cloud/go/services/eps_authentication/servicers/test_utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateGsmAuthTriplets_Milenage(t *testing.T) {
+	rand := []byte("\x23\x55\x3c\xbe\x96\x37\xa8\x9d\x21\x8a\xe6\x4d\xae\x47\xbf\x35")
+	milenage, err := crypto.NewMockMilenageCipher(defaultLteAuthAmf, rand)
+	assert.NoError(t, err)
+
+	subscriber := &protos.SubscriberData{
+		Gsm: &protos.GSMSubscription{State: protos.GSMSubscription_ACTIVE, AuthAlgo: protos.GSMSubscription_MILENAGE},
+		Lte: &protos.LTESubscription{
+			AuthKey: []byte("\x46\x5b\x5c\xe8\xb1\x99\xb4\x9f\xaa\x5f\x0a\x2e\xe2\x38\xa6\xbc"),
+			AuthOpc: []byte("\xcd\x63\xcb\x71\x95\x4a\x9f\x4e\x48\xa5\x99\x4e\x37\xa0\x2b\xaf"),
+		},
+	}
+	triplets, err := GenerateGsmAuthTriplets(2, milenage, subscriber, defaultLteAuthOp)
+	assert.NoError(t, err)
+	assert.Len(t, triplets, 2)
+	for _, triplet := range triplets {
+		assert.Equal(t, rand, triplet.Rand[:])
+		assert.Equal(t, "46f8416a", hex.EncodeToString(triplet.Sres[:]))
+		assert.Equal(t, "eae4be823af9a08b", hex.EncodeToString(triplet.Kc[:]))
+	}
+
+	subscriber.Lte.AuthAlgo = protos.LTESubscription_TUAK
+	_, err = GenerateGsmAuthTriplets(1, milenage, subscriber, defaultLteAuthOp)
+	assert.Exactly(t, NewAuthRejectedError("GSM MILENAGE requires the subscriber's MILENAGE LTE auth key"), err)
+
+	subscriber.Lte = nil
+	_, err = GenerateGsmAuthTriplets(1, milenage, subscriber, defaultLteAuthOp)
+	assert.Exactly(t, NewAuthRejectedError("GSM MILENAGE requires the subscriber's MILENAGE LTE auth key"), err)
+}
+
+func TestGenerateGsmAuthTriplets_Precomputed(t *testing.T) {
+	milenage, err := crypto.NewMilenageCipher(defaultLteAuthAmf)
+	assert.NoError(t, err)
+
+	subscriber := test_utils.GetTestSubscribers()[3]
+	tuple := subscriber.Gsm.AuthTuples[0]
+	subscriber.Gsm.AuthTuples = append(subscriber.Gsm.AuthTuples, tuple)
+
+	triplets, err := GenerateGsmAuthTriplets(1, milenage, subscriber, defaultLteAuthOp)
+	assert.NoError(t, err)
+	assert.Len(t, triplets, 1)
+	assert.Equal(t, tuple[:16], triplets[0].Rand[:])
+	assert.Equal(t, tuple[16:20], triplets[0].Sres[:])
+	assert.Equal(t, tuple[20:], triplets[0].Kc[:])
+
+	triplets, err = GenerateGsmAuthTriplets(5, milenage, subscriber, defaultLteAuthOp)
+	assert.NoError(t, err)
+	assert.Len(t, triplets, 2)
+
+	subscriber.Gsm.AuthTuples = [][]byte{tuple[1:]}
+	_, err = GenerateGsmAuthTriplets(1, milenage, subscriber, defaultLteAuthOp)
+	assert.Exactly(t, NewAuthDataUnavailableError("incorrect auth tuple size. Expected 28 bytes, but got 27 bytes"), err)
+
+	subscriber.Gsm.AuthTuples = nil
+	_, err = GenerateGsmAuthTriplets(1, milenage, subscriber, defaultLteAuthOp)
+	assert.Exactly(t, NewAuthDataUnavailableError("Subscriber data missing precomputed auth tuples"), err)
+}
+
+func TestValidateGsmSubscription(t *testing.T) {
+	err := ValidateGsmSubscription(nil)
+	assert.EqualError(t, err, "Subscriber data missing GSM subscription")
+
+	gsm := &protos.GSMSubscription{State: protos.GSMSubscription_INACTIVE}
+	err = ValidateGsmSubscription(gsm)
+	assert.EqualError(t, err, "GSM Service not active")
+
+	gsm = &protos.GSMSubscription{State: protos.GSMSubscription_ACTIVE, AuthAlgo: 1}
+	err = ValidateGsmSubscription(gsm)
+	assert.EqualError(t, err, "Unsupported GSM crypto algorithm: 1")
+
+	gsm.AuthAlgo = protos.GSMSubscription_MILENAGE
+	assert.NoError(t, ValidateGsmSubscription(gsm))
+
+	gsm.AuthAlgo = protos.GSMSubscription_PRECOMPUTED_AUTH_TUPLES
+	assert.NoError(t, ValidateGsmSubscription(gsm))
+}
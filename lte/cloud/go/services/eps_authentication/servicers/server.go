@@ -22,14 +22,20 @@ import (
 
 type EPSAuthServer struct {
 	Store *storage.SubscriberDBStorage
+
+	// SqnConfig configures how the SQNs of auth vectors are chosen
+	SqnConfig SqnConfig
 }
 
-// NewEPSAuthServer returns a Server with the provided store.
-func NewEPSAuthServer(store *storage.SubscriberDBStorage) (*EPSAuthServer, error) {
+// NewEPSAuthServer returns a Server with the provided store and SQN config.
+func NewEPSAuthServer(store *storage.SubscriberDBStorage, sqnConfig SqnConfig) (*EPSAuthServer, error) {
 	if store == nil {
 		return nil, fmt.Errorf("Cannot initialize eps authentication server with nil store")
 	}
-	return &EPSAuthServer{Store: store}, nil
+	if err := sqnConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("Cannot initialize eps authentication server: %v", err)
+	}
+	return &EPSAuthServer{Store: store, SqnConfig: sqnConfig}, nil
 }
 
 // lookupSubscriber returns a subscriber's data or an error.
@@ -0,0 +1,197 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"math/bits"
+
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	// DefaultIndBits is the default number of bits reserved for IND (one of the two parts of SQN).
+	// See 3GPP TS 33.102 Appendix C.1.1.1 and C.3.
+	DefaultIndBits = 5
+
+	// maxIndBits bounds the size of the SEQ array stored for each subscriber.
+	maxIndBits = 10
+
+	// sqnBits is the length of SQN in bits.
+	sqnBits = 48
+
+	// maxSeqDelta is the maximum allowed increase to SQN.
+	// eg. if x was the last accepted SQN, then the next SQN must
+	// be greater than x and less than (x + maxSeqDelta) to be accepted.
+	// See 3GPP TS 33.102 Appendix C.2.1.
+	maxSeqDelta = 1 << 28
+)
+
+// SqnConfig configures the SQN array scheme of 3GPP TS 33.102 Annex C.3.
+// SQN = SEQ || IND, the IND of consecutive auth vectors cycles through the
+// array and each IND has its own SEQ, so vectors which are consumed out of
+// order don't cause sync failures.
+type SqnConfig struct {
+	// IndBits is the length of IND in bits. It must match the length used by the USIMs.
+	IndBits uint32
+
+	// AgeLimit is the age limit L of 3GPP TS 33.102 Annex C.2.2: the USIM
+	// rejects a SEQ which is more than L below the highest SEQ it accepted.
+	// 0 disables the limit.
+	AgeLimit uint64
+}
+
+// DefaultSqnConfig is the SQN configuration used when the service config doesn't override it.
+var DefaultSqnConfig = SqnConfig{IndBits: DefaultIndBits}
+
+// Validate returns an error if and only if the config is invalid.
+func (cfg SqnConfig) Validate() error {
+	if cfg.IndBits > maxIndBits {
+		return fmt.Errorf("IND length must be at most %v bits, but got %v bits", maxIndBits, cfg.IndBits)
+	}
+	return nil
+}
+
+// SeqToSqn computes the 48 bit SQN given a seq given the formula defined in
+// 3GPP TS 33.102 Annex C.3.2.
+// SQN = SEQ || IND
+// Inputs:
+//    seq: the sequence number
+//    ind: the index of the current vector being generated
+// Output: The 48 bit SQN
+func (cfg SqnConfig) SeqToSqn(seq, ind uint64) uint64 {
+	return (seq << cfg.IndBits & cfg.seqMask()) + (ind & cfg.indMask())
+}
+
+// SplitSqn computes the SEQ and IND given a 48 bit SQN using the formula defined in
+// 3GPP TS 33.102 Annex C.3.2.
+// SQN = SEQ || IND
+// Inputs:
+//    sqn: the 48 bit SQN
+// Outputs: SEQ and IND
+func (cfg SqnConfig) SplitSqn(sqn uint64) (uint64, uint64) {
+	return sqn >> cfg.IndBits, sqn & cfg.indMask()
+}
+
+// indMask is a bit mask where a bit is 1 if and only if it is a part of ind.
+func (cfg SqnConfig) indMask() uint64 {
+	return (1 << cfg.IndBits) - 1
+}
+
+// seqMask is a bit mask where a bit is 1 if and only if it is a part of seq.
+func (cfg SqnConfig) seqMask() uint64 {
+	return (1 << sqnBits) - 1 - cfg.indMask()
+}
+
+// maxSeq is the highest SEQ which fits in SQN.
+func (cfg SqnConfig) maxSeq() uint64 {
+	return cfg.seqMask() >> cfg.IndBits
+}
+
+// arraySize is the number of INDs.
+func (cfg SqnConfig) arraySize() int {
+	return 1 << cfg.IndBits
+}
+
+// MigrateLteAuthSeq converts the subscriber state to the SQN array scheme of cfg.
+// A state with a single LteAuthNextSeq counter (which used a 5 bit IND), or
+// with an array for a different IND length, is converted such that every
+// SQN generated afterwards is higher than any SQN generated before.
+// Returns true if and only if the state was changed.
+func MigrateLteAuthSeq(state *protos.SubscriberState, cfg SqnConfig) bool {
+	size := cfg.arraySize()
+	if state == nil || len(state.LteAuthSeqArray) == size {
+		return false
+	}
+
+	var nextSqn uint64
+	if len(state.LteAuthSeqArray) == 0 {
+		legacy := SqnConfig{IndBits: DefaultIndBits}
+		nextSqn = legacy.SeqToSqn(state.LteAuthNextSeq, 0)
+	} else {
+		previous := SqnConfig{IndBits: uint32(bits.Len(uint(len(state.LteAuthSeqArray) - 1)))}
+		nextSqn = previous.SeqToSqn(getHighestSeq(state)+1, 0)
+	}
+
+	// Round up to the first SQN with IND 0 which isn't lower than nextSqn
+	nextSeq := (nextSqn + cfg.indMask()) >> cfg.IndBits
+	var seq uint64
+	if nextSeq > 0 {
+		seq = nextSeq - 1
+	}
+	state.LteAuthSeqArray = make([]uint64, size)
+	for i := range state.LteAuthSeqArray {
+		state.LteAuthSeqArray[i] = seq
+	}
+	state.LteAuthNextInd = 0
+	state.LteAuthNextSeq = seq + 1
+	return true
+}
+
+// NextLteAuthSqn returns the SQN of the subscriber's next auth vector and
+// advances the subscriber state past it.
+// The IND cycles through the array and the SEQ is one more than the last SEQ
+// of that IND, raised if needed so that it is within the age limit of the
+// highest SEQ generated (3GPP TS 33.102 Annex C.2.2 and C.3.2).
+func NextLteAuthSqn(state *protos.SubscriberState, cfg SqnConfig) (uint64, error) {
+	if state == nil {
+		return 0, NewAuthRejectedError("Subscriber data missing subscriber state")
+	}
+	MigrateLteAuthSeq(state, cfg)
+
+	ind := uint64(state.LteAuthNextInd) % uint64(cfg.arraySize())
+	highestSeq := getHighestSeq(state)
+	seq := state.LteAuthSeqArray[ind] + 1
+	if cfg.AgeLimit > 0 && highestSeq > cfg.AgeLimit && seq < highestSeq-cfg.AgeLimit {
+		seq = highestSeq - cfg.AgeLimit
+	}
+	if seq > cfg.maxSeq() {
+		return 0, NewAuthRejectedError(fmt.Sprintf("SEQ exhausted for IND %v", ind))
+	}
+
+	state.LteAuthSeqArray[ind] = seq
+	state.LteAuthNextInd = uint32((ind + 1) % uint64(cfg.arraySize()))
+	state.LteAuthNextSeq = getHighestSeq(state) + 1
+	return cfg.SeqToSqn(seq, ind), nil
+}
+
+// ResyncLteAuthSqn updates the subscriber state after the USIM reported sqnMs,
+// the highest SQN it has accepted, in a synchronization failure.
+// Every IND is moved past SEQ_MS so that the next vector of each IND is
+// accepted. If the network's SEQ is too far ahead of SEQ_MS for the USIM to
+// accept (3GPP TS 33.102 Annex C.2.1), all INDs restart from SEQ_MS.
+// See 3GPP TS 33.102 section 6.3.5 and Annex C.3.
+func ResyncLteAuthSqn(state *protos.SubscriberState, sqnMs uint64, cfg SqnConfig) error {
+	if state == nil {
+		return NewAuthDataUnavailableError("subscriber state was nil")
+	}
+	MigrateLteAuthSeq(state, cfg)
+
+	seqMs, _ := cfg.SplitSqn(sqnMs)
+	highestSeq := getHighestSeq(state)
+	restart := highestSeq > seqMs && highestSeq-seqMs > maxSeqDelta
+	for i, seq := range state.LteAuthSeqArray {
+		if restart || seq < seqMs {
+			state.LteAuthSeqArray[i] = seqMs
+		}
+	}
+	state.LteAuthNextSeq = getHighestSeq(state) + 1
+	return nil
+}
+
+// getHighestSeq returns the highest SEQ in the subscriber's SEQ array.
+func getHighestSeq(state *protos.SubscriberState) uint64 {
+	var highest uint64
+	for _, seq := range state.LteAuthSeqArray {
+		if seq > highest {
+			highest = seq
+		}
+	}
+	return highest
+}
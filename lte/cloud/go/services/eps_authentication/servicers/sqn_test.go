@@ -0,0 +1,158 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"testing"
+
+	"magma/lte/cloud/go/protos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeqToSqn(t *testing.T) {
+	assert.Equal(t, uint64(0x1FE000), DefaultSqnConfig.SeqToSqn(0xFF00, 0))
+	assert.Equal(t, uint64(0xFFFFFFFFFA00), DefaultSqnConfig.SeqToSqn(0xFFFFFFFFFFD0, 0))
+	assert.Equal(t, uint64(0x142), DefaultSqnConfig.SeqToSqn(0xA, 2))
+	assert.Equal(t, uint64(0xFFFFFFFFF805), DefaultSqnConfig.SeqToSqn(0xFFFFFFFFFFC0, 5))
+
+	cfg := SqnConfig{IndBits: 3}
+	assert.Equal(t, uint64(0x52), cfg.SeqToSqn(0xA, 2))
+	assert.Equal(t, uint64(0x51), cfg.SeqToSqn(0xA, 9))
+}
+
+func TestSplitSqn(t *testing.T) {
+	seq, ind := DefaultSqnConfig.SplitSqn(0x1FE001)
+	assert.Equal(t, uint64(0xFF00), seq)
+	assert.Equal(t, uint64(0x1), ind)
+
+	seq, ind = DefaultSqnConfig.SplitSqn(0xFFFFFFFFFA1F)
+	assert.Equal(t, uint64(0x7FFFFFFFFD0), seq)
+	assert.Equal(t, uint64(0x1F), ind)
+
+	seq, ind = SqnConfig{IndBits: 3}.SplitSqn(0x52)
+	assert.Equal(t, uint64(0xA), seq)
+	assert.Equal(t, uint64(0x2), ind)
+}
+
+func TestSqnConfig_Validate(t *testing.T) {
+	assert.NoError(t, DefaultSqnConfig.Validate())
+	assert.NoError(t, SqnConfig{IndBits: 0}.Validate())
+	assert.NoError(t, SqnConfig{IndBits: 10, AgeLimit: 100}.Validate())
+	assert.EqualError(t, SqnConfig{IndBits: 11}.Validate(), "IND length must be at most 10 bits, but got 11 bits")
+}
+
+func TestMigrateLteAuthSeq(t *testing.T) {
+	assert.False(t, MigrateLteAuthSeq(nil, DefaultSqnConfig))
+
+	// Single counter
+	state := &protos.SubscriberState{LteAuthNextSeq: 7350}
+	assert.True(t, MigrateLteAuthSeq(state, DefaultSqnConfig))
+	assert.Equal(t, newSeqArray(7349), state.LteAuthSeqArray)
+	assert.Equal(t, uint32(0), state.LteAuthNextInd)
+	assert.Equal(t, uint64(7350), state.LteAuthNextSeq)
+
+	// Already migrated
+	assert.False(t, MigrateLteAuthSeq(state, DefaultSqnConfig))
+
+	// Single counter to a shorter IND
+	state = &protos.SubscriberState{LteAuthNextSeq: 7350}
+	assert.True(t, MigrateLteAuthSeq(state, SqnConfig{IndBits: 3}))
+	assert.Equal(t, []uint64{29399, 29399, 29399, 29399, 29399, 29399, 29399, 29399}, state.LteAuthSeqArray)
+	assert.Equal(t, uint64(29400), state.LteAuthNextSeq)
+
+	// Array to a shorter IND
+	state = &protos.SubscriberState{LteAuthSeqArray: newSeqArray(50), LteAuthNextInd: 7}
+	state.LteAuthSeqArray[3] = 100
+	assert.True(t, MigrateLteAuthSeq(state, SqnConfig{IndBits: 3}))
+	assert.Equal(t, []uint64{403, 403, 403, 403, 403, 403, 403, 403}, state.LteAuthSeqArray)
+	assert.Equal(t, uint32(0), state.LteAuthNextInd)
+	assert.Equal(t, uint64(404), state.LteAuthNextSeq)
+
+	// New subscriber
+	state = &protos.SubscriberState{}
+	assert.True(t, MigrateLteAuthSeq(state, SqnConfig{IndBits: 1}))
+	assert.Equal(t, []uint64{0, 0}, state.LteAuthSeqArray)
+	assert.Equal(t, uint64(1), state.LteAuthNextSeq)
+}
+
+func TestNextLteAuthSqn(t *testing.T) {
+	cfg := SqnConfig{IndBits: 2}
+	state := &protos.SubscriberState{}
+	for _, expected := range [][2]uint64{{1, 0}, {1, 1}, {1, 2}, {1, 3}, {2, 0}} {
+		sqn, err := NextLteAuthSqn(state, cfg)
+		assert.NoError(t, err)
+		assert.Equal(t, cfg.SeqToSqn(expected[0], expected[1]), sqn)
+	}
+	assert.Equal(t, []uint64{2, 1, 1, 1}, state.LteAuthSeqArray)
+	assert.Equal(t, uint32(1), state.LteAuthNextInd)
+	assert.Equal(t, uint64(3), state.LteAuthNextSeq)
+
+	// Legacy state is migrated first
+	state = &protos.SubscriberState{LteAuthNextSeq: 7350}
+	sqn, err := NextLteAuthSqn(state, DefaultSqnConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultSqnConfig.SeqToSqn(7350, 0), sqn)
+	assert.Equal(t, uint64(7351), state.LteAuthNextSeq)
+
+	_, err = NextLteAuthSqn(nil, cfg)
+	assert.Exactly(t, NewAuthRejectedError("Subscriber data missing subscriber state"), err)
+}
+
+func TestNextLteAuthSqn_AgeLimit(t *testing.T) {
+	state := &protos.SubscriberState{LteAuthSeqArray: []uint64{100, 5, 100, 100}, LteAuthNextInd: 1}
+
+	cfg := SqnConfig{IndBits: 2, AgeLimit: 10}
+	sqn, err := NextLteAuthSqn(state, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, cfg.SeqToSqn(90, 1), sqn)
+	assert.Equal(t, uint64(101), state.LteAuthNextSeq)
+
+	// The age limit is disabled by default
+	state = &protos.SubscriberState{LteAuthSeqArray: []uint64{100, 5, 100, 100}, LteAuthNextInd: 1}
+	sqn, err = NextLteAuthSqn(state, SqnConfig{IndBits: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, cfg.SeqToSqn(6, 1), sqn)
+}
+
+func TestNextLteAuthSqn_Exhausted(t *testing.T) {
+	cfg := SqnConfig{IndBits: 2}
+	state := &protos.SubscriberState{LteAuthSeqArray: []uint64{1<<46 - 1, 0, 0, 0}}
+	_, err := NextLteAuthSqn(state, cfg)
+	assert.Exactly(t, NewAuthRejectedError("SEQ exhausted for IND 0"), err)
+	assert.Equal(t, uint32(0), state.LteAuthNextInd)
+}
+
+func TestResyncLteAuthSqn(t *testing.T) {
+	cfg := SqnConfig{IndBits: 2}
+	state := &protos.SubscriberState{LteAuthSeqArray: []uint64{10, 20, 30, 5}, LteAuthNextInd: 2}
+	err := ResyncLteAuthSqn(state, cfg.SeqToSqn(25, 1), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{25, 25, 30, 25}, state.LteAuthSeqArray)
+	assert.Equal(t, uint32(2), state.LteAuthNextInd)
+	assert.Equal(t, uint64(31), state.LteAuthNextSeq)
+
+	// SEQ too far ahead of the USIM
+	state = &protos.SubscriberState{LteAuthSeqArray: []uint64{1 << 30, 0, 0, 0}}
+	err = ResyncLteAuthSqn(state, cfg.SeqToSqn(5, 3), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{5, 5, 5, 5}, state.LteAuthSeqArray)
+	assert.Equal(t, uint64(6), state.LteAuthNextSeq)
+
+	err = ResyncLteAuthSqn(nil, 0, cfg)
+	assert.Exactly(t, NewAuthDataUnavailableError("subscriber state was nil"), err)
+}
+
+func newSeqArray(seq uint64) []uint64 {
+	arr := make([]uint64, 1<<DefaultIndBits)
+	for i := range arr {
+		arr[i] = seq
+	}
+	return arr
+}
@@ -27,6 +27,10 @@ func GetTestSubscribers() []*protos.SubscriberData {
 	sub := &protos.SubscriberData{
 		Sid:       &protos.SubscriberID{Id: "sub1"},
 		NetworkId: &orc8rprotos.NetworkID{Id: "test"},
+		Gsm: &protos.GSMSubscription{
+			State:    protos.GSMSubscription_ACTIVE,
+			AuthAlgo: protos.GSMSubscription_MILENAGE,
+		},
 		Lte: &protos.LTESubscription{
 			State:    protos.LTESubscription_ACTIVE,
 			AuthAlgo: protos.LTESubscription_MILENAGE,
@@ -87,5 +91,29 @@ func GetTestSubscribers() []*protos.SubscriberData {
 	}
 	subs = append(subs, sub)
 
+	sub = &protos.SubscriberData{
+		Sid:       &protos.SubscriberID{Id: "tuak_sub"},
+		NetworkId: &orc8rprotos.NetworkID{Id: "test"},
+		Gsm: &protos.GSMSubscription{
+			State:    protos.GSMSubscription_ACTIVE,
+			AuthAlgo: protos.GSMSubscription_PRECOMPUTED_AUTH_TUPLES,
+			AuthTuples: [][]byte{
+				[]byte("\x23\x55\x3c\xbe\x96\x37\xa8\x9d\x21\x8a\xe6\x4d\xae\x47\xbf\x35\x46\xf8\x41\x6a\xea\xe4\xbe\x82\x3a\xf9\xa0\x8b"),
+			},
+		},
+		Lte: &protos.LTESubscription{
+			State:       protos.LTESubscription_ACTIVE,
+			AuthAlgo:    protos.LTESubscription_TUAK,
+			AuthKey:     []byte("\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab"),
+			AuthTopc:    []byte("\xbd\x04\xd9\x53\x0e\x87\x51\x3c\x5d\x83\x7a\xc2\xad\x95\x46\x23\xa8\xe2\x33\x0c\x11\x53\x05\xa7\x3e\xb4\x5d\x1f\x40\xcc\xcb\xff"),
+			AuthKeyBits: 128,
+		},
+		State: &protos.SubscriberState{
+			LteAuthNextSeq:    7350,
+			TgppAaaServerName: defaultServerHost,
+		},
+	}
+	subs = append(subs, sub)
+
 	return subs
 }
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package backup provides the network backup section for policydb
+package backup
+
+import (
+	"encoding/json"
+	"sort"
+
+	lteprotos "magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/policydb"
+	"magma/orc8r/cloud/go/backup"
+	"magma/orc8r/cloud/go/protos"
+)
+
+const SectionName = "policydb"
+
+// PoliciesSection archives the policy rules and charging rule base names of
+// a network
+type PoliciesSection struct{}
+
+type archivedPolicies struct {
+	Rules     []json.RawMessage `json:"rules"`
+	BaseNames []json.RawMessage `json:"base_names"`
+}
+
+func (*PoliciesSection) GetName() string {
+	return SectionName
+}
+
+func (*PoliciesSection) Export(networkID string) (json.RawMessage, error) {
+	rules, err := policydb.GetAllRules(networkID)
+	if err != nil {
+		return nil, err
+	}
+	baseNames, err := policydb.GetAllBaseNames(networkID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Id < rules[j].Id })
+	sort.Slice(baseNames, func(i, j int) bool { return baseNames[i].Name < baseNames[j].Name })
+
+	ret := archivedPolicies{
+		Rules:     make([]json.RawMessage, 0, len(rules)),
+		BaseNames: make([]json.RawMessage, 0, len(baseNames)),
+	}
+	for _, rule := range rules {
+		marshaledRule, err := protos.Marshal(rule)
+		if err != nil {
+			return nil, err
+		}
+		ret.Rules = append(ret.Rules, marshaledRule)
+	}
+	for _, baseName := range baseNames {
+		marshaledBaseName, err := protos.Marshal(baseName)
+		if err != nil {
+			return nil, err
+		}
+		ret.BaseNames = append(ret.BaseNames, marshaledBaseName)
+	}
+	return json.Marshal(ret)
+}
+
+func (*PoliciesSection) Import(ctx backup.ImportContext, data json.RawMessage) error {
+	policies := archivedPolicies{}
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return err
+	}
+	for _, marshaledRule := range policies.Rules {
+		rule := &lteprotos.PolicyRule{}
+		if err := protos.Unmarshal(marshaledRule, rule); err != nil {
+			return err
+		}
+		if err := policydb.AddRule(ctx.NetworkID, rule); err != nil {
+			return err
+		}
+	}
+	for _, marshaledBaseName := range policies.BaseNames {
+		baseName := &lteprotos.ChargingRuleBaseNameRecord{}
+		if err := protos.Unmarshal(marshaledBaseName, baseName); err != nil {
+			return err
+		}
+		_, err := policydb.AddBaseName(ctx.NetworkID, baseName.Name, baseName.GetRuleNamesSet().GetRuleNames())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*PoliciesSection) Clear(networkID string) error {
+	baseNames, err := policydb.GetAllBaseNames(networkID)
+	if err != nil {
+		return err
+	}
+	for _, baseName := range baseNames {
+		if err := policydb.DeleteBaseName(networkID, baseName.Name); err != nil {
+			return err
+		}
+	}
+	ruleIDs, err := policydb.ListRuleIds(networkID)
+	if err != nil {
+		return err
+	}
+	for _, ruleID := range ruleIDs {
+		if err := policydb.DeleteRule(networkID, ruleID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
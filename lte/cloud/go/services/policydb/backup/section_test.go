@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package backup_test
+
+import (
+	"testing"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/policydb"
+	"magma/lte/cloud/go/services/policydb/backup"
+	"magma/lte/cloud/go/services/policydb/test_init"
+	orc8rbackup "magma/orc8r/cloud/go/backup"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoliciesSection(t *testing.T) {
+	test_init.StartTestService(t)
+	section := &backup.PoliciesSection{}
+
+	rule := &protos.PolicyRule{Id: "rule1", Priority: 10}
+	assert.NoError(t, policydb.AddRule("prod", rule))
+	_, err := policydb.AddBaseName("prod", "base1", []string{"rule1"})
+	assert.NoError(t, err)
+
+	data, err := section.Export("prod")
+	assert.NoError(t, err)
+
+	err = section.Import(orc8rbackup.ImportContext{NetworkID: "lab", SourceNetworkID: "prod"}, data)
+	assert.NoError(t, err)
+	actualRule, err := policydb.GetRule("lab", "rule1")
+	assert.NoError(t, err)
+	assert.Equal(t, rule.String(), actualRule.String())
+	ruleNames, err := policydb.GetBaseName("lab", "base1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rule1"}, ruleNames)
+
+	assert.NoError(t, section.Clear("lab"))
+	ruleIDs, err := policydb.ListRuleIds("lab")
+	assert.NoError(t, err)
+	assert.Empty(t, ruleIDs)
+	baseNames, err := policydb.GetAllBaseNames("lab")
+	assert.NoError(t, err)
+	assert.Empty(t, baseNames)
+	ruleIDs, err = policydb.ListRuleIds("prod")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rule1"}, ruleIDs)
+}
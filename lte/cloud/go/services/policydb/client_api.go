@@ -25,6 +25,13 @@ import (
 
 const ServiceName = "POLICYDB"
 
+// Names of the streams which serve policy rules and charging rule base names
+// to gateways
+const (
+	RulesStreamName     = "policydb"
+	BaseNamesStreamName = "base_names"
+)
+
 // Utility function to get a RPC connection to the policydb service
 func getPolicydbClient() (
 	protos.PolicyDBControllerClient, *grpc.ClientConn, error) {
@@ -17,8 +17,10 @@ package servicers
 
 import (
 	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/policydb"
 	"magma/orc8r/cloud/go/datastore"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -76,6 +78,7 @@ func (srv *PolicyDBServer) AddBaseName(
 		glog.Errorf("Error persisting Base Name %s: %s", lookup.GetName(), err)
 		return res, status.Errorf(codes.Aborted, "Error adding Base Name: %s", err)
 	}
+	streamer.NotifyStreamUpdateAsync(policydb.BaseNamesStreamName, lookup.GetNetworkID().GetId())
 	return res, nil
 }
 
@@ -89,6 +92,7 @@ func (srv *PolicyDBServer) DeleteBaseName(
 		glog.Errorf("Error deleting rule %s: %s", lookup.GetName(), err)
 		return &orcprotos.Void{}, status.Errorf(codes.Aborted, "Deletion error!")
 	}
+	streamer.NotifyStreamUpdateAsync(policydb.BaseNamesStreamName, lookup.GetNetworkID().GetId())
 	return &orcprotos.Void{}, nil
 }
 
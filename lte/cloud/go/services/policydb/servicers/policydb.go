@@ -17,8 +17,10 @@ package servicers
 
 import (
 	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/policydb"
 	"magma/orc8r/cloud/go/datastore"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -63,6 +65,7 @@ func (srv *PolicyDBServer) AddRule(
 		return &orcprotos.Void{}, status.Errorf(
 			codes.Aborted, "Error adding rule: %s", err)
 	}
+	streamer.NotifyStreamUpdateAsync(policydb.RulesStreamName, ruleData.NetworkId.Id)
 	return &orcprotos.Void{}, nil
 }
 
@@ -77,6 +80,7 @@ func (srv *PolicyDBServer) DeleteRule(
 		glog.Errorf("Error deleting rule %s: %s", ruleID, err)
 		return &orcprotos.Void{}, status.Errorf(codes.Aborted, "Deletion error!")
 	}
+	streamer.NotifyStreamUpdateAsync(policydb.RulesStreamName, lookup.NetworkId.Id)
 	return &orcprotos.Void{}, nil
 }
 
@@ -99,6 +103,7 @@ func (srv *PolicyDBServer) UpdateRule(
 		glog.Errorf("Error persisting rule %s: %s", ruleId, err)
 		return &orcprotos.Void{}, status.Errorf(codes.Aborted, "Error updating rule")
 	}
+	streamer.NotifyStreamUpdateAsync(policydb.RulesStreamName, ruleData.NetworkId.Id)
 	return &orcprotos.Void{}, nil
 }
 
@@ -12,15 +12,23 @@ import (
 	"magma/lte/cloud/go/services/policydb"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/magmad"
+	"magma/orc8r/cloud/go/services/streamer/providers"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
 )
 
+// Recent snapshots of each network's policydb and base_names streams so
+// gateways can be sent only the rules and base names which changed
+var (
+	policyDeltas   = providers.NewNetworkDeltaCaches(providers.DefaultDeltaCacheSize)
+	baseNameDeltas = providers.NewNetworkDeltaCaches(providers.DefaultDeltaCacheSize)
+)
+
 type PoliciesProvider struct{}
 
 func (provider *PoliciesProvider) GetStreamName() string {
-	return "policydb"
+	return policydb.RulesStreamName
 }
 
 func (provider *PoliciesProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
@@ -48,10 +56,22 @@ func (provider *PoliciesProvider) GetUpdates(gatewayId string, extraArgs *any.An
 	return ret, nil
 }
 
+func (provider *PoliciesProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any) (*protos.DataUpdateBatch, error) {
+	networkId, err := magmad.FindGatewayNetworkId(gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := provider.GetUpdates(gatewayId, extraArgs)
+	if err != nil {
+		return nil, err
+	}
+	return policyDeltas.GetDeltaBatch(networkId, updates, extraArgs)
+}
+
 type BaseNamesProvider struct{}
 
 func (provider *BaseNamesProvider) GetStreamName() string {
-	return "base_names"
+	return policydb.BaseNamesStreamName
 }
 
 func (provider *BaseNamesProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
@@ -78,3 +98,15 @@ func (provider *BaseNamesProvider) GetUpdates(gatewayId string, extraArgs *any.A
 	}
 	return ret, nil
 }
+
+func (provider *BaseNamesProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any) (*protos.DataUpdateBatch, error) {
+	networkId, err := magmad.FindGatewayNetworkId(gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := provider.GetUpdates(gatewayId, extraArgs)
+	if err != nil {
+		return nil, err
+	}
+	return baseNameDeltas.GetDeltaBatch(networkId, updates, extraArgs)
+}
@@ -26,6 +26,7 @@ import (
 	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 )
@@ -98,4 +99,23 @@ func TestPolicydbStreamer(t *testing.T) {
 	p1j, _ := json.Marshal(p1)
 	p2j, _ := json.Marshal(p2)
 	t.Logf("\nReceived Policies:\n\t%s\n\t%s", string(p1j), string(p2j))
+
+	// Delete one entry and stream again with the received digest, which
+	// should only return the deletion
+	assert.True(t, updateBatch.Resync)
+	err = policydb.DeleteRule(testNetworkId, "1")
+	assert.NoError(t, err)
+	extraArgs, err := ptypes.MarshalAny(&orcprotos.StreamDigest{Digest: updateBatch.Digest})
+	assert.NoError(t, err)
+	streamerClient, err = grpcClient.GetUpdates(
+		context.Background(),
+		&orcprotos.StreamRequest{GatewayId: testAgHwId, StreamName: "policydb", ExtraArgs: extraArgs},
+	)
+	assert.NoError(t, err)
+
+	updateBatch, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, updateBatch.Resync)
+	assert.Empty(t, updateBatch.Updates)
+	assert.Equal(t, []string{"1"}, updateBatch.DeletedKeys)
 }
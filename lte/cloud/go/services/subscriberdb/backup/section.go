@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package backup provides the network backup section for subscriberdb
+package backup
+
+import (
+	"encoding/json"
+	"sort"
+
+	lteprotos "magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/orc8r/cloud/go/backup"
+	"magma/orc8r/cloud/go/protos"
+)
+
+const SectionName = "subscribers"
+
+// SubscribersSection archives all subscribers of a network
+type SubscribersSection struct{}
+
+func (*SubscribersSection) GetName() string {
+	return SectionName
+}
+
+func (*SubscribersSection) Export(networkID string) (json.RawMessage, error) {
+	subscribers, err := subscriberdb.GetAllSubscriberData(networkID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(subscribers, func(i, j int) bool {
+		return lteprotos.SidString(subscribers[i].Sid) < lteprotos.SidString(subscribers[j].Sid)
+	})
+	ret := make([]json.RawMessage, 0, len(subscribers))
+	for _, sub := range subscribers {
+		// The network ID is implied by the archive
+		sub.NetworkId = nil
+		marshaledSub, err := protos.Marshal(sub)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, marshaledSub)
+	}
+	return json.Marshal(ret)
+}
+
+func (*SubscribersSection) Import(ctx backup.ImportContext, data json.RawMessage) error {
+	var subscribers []json.RawMessage
+	if err := json.Unmarshal(data, &subscribers); err != nil {
+		return err
+	}
+	for _, marshaledSub := range subscribers {
+		sub := &lteprotos.SubscriberData{}
+		if err := protos.Unmarshal(marshaledSub, sub); err != nil {
+			return err
+		}
+		if err := subscriberdb.AddSubscriber(ctx.NetworkID, sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*SubscribersSection) Clear(networkID string) error {
+	subscriberIDs, err := subscriberdb.ListSubscribers(networkID)
+	if err != nil {
+		return err
+	}
+	for _, subscriberID := range subscriberIDs {
+		if err := subscriberdb.DeleteSubscriber(networkID, subscriberID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package backup_test
+
+import (
+	"testing"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/backup"
+	"magma/lte/cloud/go/services/subscriberdb/test_init"
+	orc8rbackup "magma/orc8r/cloud/go/backup"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribersSection(t *testing.T) {
+	test_init.StartTestService(t)
+	section := &backup.SubscribersSection{}
+
+	for _, imsi := range []string{"00101", "00102"} {
+		sub := &protos.SubscriberData{
+			Sid: &protos.SubscriberID{Id: imsi, Type: protos.SubscriberID_IMSI},
+			Lte: &protos.LTESubscription{State: protos.LTESubscription_ACTIVE, AuthKey: []byte("key")},
+		}
+		assert.NoError(t, subscriberdb.AddSubscriber("prod", sub))
+	}
+
+	data, err := section.Export("prod")
+	assert.NoError(t, err)
+
+	err = section.Import(orc8rbackup.ImportContext{NetworkID: "lab", SourceNetworkID: "prod"}, data)
+	assert.NoError(t, err)
+	subscriberIDs, err := subscriberdb.ListSubscribers("lab")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"IMSI00101", "IMSI00102"}, subscriberIDs)
+	sub, err := subscriberdb.GetSubscriber("lab", "IMSI00101")
+	assert.NoError(t, err)
+	assert.Equal(t, "lab", sub.GetNetworkId().GetId())
+	assert.Equal(t, []byte("key"), sub.GetLte().GetAuthKey())
+
+	assert.NoError(t, section.Clear("lab"))
+	subscriberIDs, err = subscriberdb.ListSubscribers("lab")
+	assert.NoError(t, err)
+	assert.Empty(t, subscriberIDs)
+	subscriberIDs, err = subscriberdb.ListSubscribers("prod")
+	assert.NoError(t, err)
+	assert.Len(t, subscriberIDs, 2)
+}
@@ -13,6 +13,7 @@ package subscriberdb
 
 import (
 	"fmt"
+	"io"
 
 	lteprotos "magma/lte/cloud/go/protos"
 	"magma/orc8r/cloud/go/protos"
@@ -25,6 +26,13 @@ import (
 
 const ServiceName = "SUBSCRIBERDB"
 
+// StreamName is the name of the stream which serves subscribers to gateways
+const StreamName = "subscriberdb"
+
+// MaxBatchMsgBytes is the maximum size of the batch RPC messages, which fits
+// batches of a hundred thousand subscribers
+const MaxBatchMsgBytes = 64 << 20
+
 // Utility function to get a RPC connection to the subscriberdb service
 func getSubscriberdbClient() (
 	lteprotos.SubscriberDBControllerClient, *grpc.ClientConn, error) {
@@ -134,6 +142,36 @@ func ListSubscribers(networkId string) ([]string, error) {
 	return ret, nil
 }
 
+// ListSubscribersPage lists a page of the network's subscriber ids matching
+// the id prefix in ascending order. It returns the token for the next page,
+// which is empty if there are no more subscribers.
+func ListSubscribersPage(networkId string, idPrefix string, pageSize uint32, pageToken string) ([]string, string, error) {
+	client, conn, err := getSubscriberdbClient()
+	if err != nil {
+		return nil, "", err
+	}
+	defer conn.Close()
+
+	res, err := client.ListSubscribersPage(
+		context.Background(),
+		&lteprotos.ListSubscribersRequest{
+			NetworkId: &protos.NetworkID{Id: networkId},
+			IdPrefix:  idPrefix,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		})
+	if err != nil {
+		glog.Errorf("ListSubscribersPage error: %s", err)
+		return nil, "", err
+	}
+	sids := res.GetSids()
+	ret := make([]string, len(sids))
+	for i := range sids {
+		ret[i] = lteprotos.SidString(sids[i])
+	}
+	return ret, res.GetNextPageToken(), nil
+}
+
 func GetAllSubscriberData(networkId string) ([]*lteprotos.SubscriberData, error) {
 	client, conn, err := getSubscriberdbClient()
 	if err != nil {
@@ -147,3 +185,116 @@ func GetAllSubscriberData(networkId string) ([]*lteprotos.SubscriberData, error)
 	}
 	return response.Subscribers, nil
 }
+
+// AddSubscribers adds a batch of new subscribers and returns the result of
+// each row. No subscriber is added if any row is invalid.
+func AddSubscribers(networkId string, subs []*lteprotos.SubscriberData) ([]*lteprotos.SubscriberBatchResult, error) {
+	client, conn, err := getSubscriberdbClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	res, err := client.AddSubscribers(
+		context.Background(),
+		&lteprotos.SubscriberBatch{NetworkId: &protos.NetworkID{Id: networkId}, Subscribers: subs},
+		grpc.MaxCallRecvMsgSize(MaxBatchMsgBytes))
+	if err != nil {
+		glog.Errorf("[Network: %s] AddSubscribers error: %s", networkId, err)
+		return nil, err
+	}
+	return res.GetResults(), nil
+}
+
+// UpdateSubscribers updates a batch of existing subscribers and returns the
+// result of each row. No subscriber is updated if any row is invalid.
+func UpdateSubscribers(networkId string, subs []*lteprotos.SubscriberData) ([]*lteprotos.SubscriberBatchResult, error) {
+	client, conn, err := getSubscriberdbClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	res, err := client.UpdateSubscribers(
+		context.Background(),
+		&lteprotos.SubscriberBatch{NetworkId: &protos.NetworkID{Id: networkId}, Subscribers: subs},
+		grpc.MaxCallRecvMsgSize(MaxBatchMsgBytes))
+	if err != nil {
+		glog.Errorf("[Network: %s] UpdateSubscribers error: %s", networkId, err)
+		return nil, err
+	}
+	return res.GetResults(), nil
+}
+
+// DeleteSubscribers deletes a batch of subscribers and returns the result of
+// each row.
+func DeleteSubscribers(networkId string, subscriberIds []string) ([]*lteprotos.SubscriberBatchResult, error) {
+	client, conn, err := getSubscriberdbClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	sids := make([]*lteprotos.SubscriberID, len(subscriberIds))
+	for i, subscriberId := range subscriberIds {
+		sids[i] = lteprotos.SidFromString(subscriberId)
+	}
+	res, err := client.DeleteSubscribers(
+		context.Background(),
+		&lteprotos.SubscriberIDBatch{NetworkId: &protos.NetworkID{Id: networkId}, Sids: sids},
+		grpc.MaxCallRecvMsgSize(MaxBatchMsgBytes))
+	if err != nil {
+		glog.Errorf("[Network: %s] DeleteSubscribers error: %s", networkId, err)
+		return nil, err
+	}
+	return res.GetResults(), nil
+}
+
+// ExportSubscribers calls send for each subscriber of the network in
+// ascending order of the subscriber IDs, as they are streamed from the
+// service. It stops at the first error returned by send.
+func ExportSubscribers(networkId string, send func(*lteprotos.SubscriberData) error) error {
+	client, conn, err := getSubscriberdbClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.ExportSubscribers(ctx, &protos.NetworkID{Id: networkId})
+	if err != nil {
+		glog.Errorf("[Network: %s] ExportSubscribers error: %s", networkId, err)
+		return err
+	}
+	for {
+		sub, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			glog.Errorf("[Network: %s] ExportSubscribers error: %s", networkId, err)
+			return err
+		}
+		if err = send(sub); err != nil {
+			return err
+		}
+	}
+}
+
+// ReencryptSubscribers re-encrypts the secrets of the network's subscribers
+// with the current key and returns the number of re-encrypted subscribers.
+func ReencryptSubscribers(networkId string) (uint32, error) {
+	client, conn, err := getSubscriberdbClient()
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	res, err := client.ReencryptSubscribers(context.Background(), &protos.NetworkID{Id: networkId})
+	if err != nil {
+		glog.Errorf("[Network: %s] ReencryptSubscribers error: %s", networkId, err)
+		return 0, err
+	}
+	return res.GetReencrypted(), nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package encryption
+
+import (
+	"fmt"
+
+	"magma/orc8r/cloud/go/service/config"
+)
+
+const (
+	// KeyProviderConfigKey selects the key provider of the services reading
+	// subscribers from the store. Encryption is disabled if it is empty.
+	KeyProviderConfigKey = "subscriberKeyProvider"
+	// KeyFileConfigKey is the key file of the local key provider
+	KeyFileConfigKey = "subscriberKeyFile"
+
+	// LocalKeyProvider reads the keys from a local key file
+	LocalKeyProvider = "local"
+)
+
+// GetSecretsCipher returns the SecretsCipher using the key provider of the
+// service config, or nil if subscriber encryption is disabled.
+func GetSecretsCipher(cfg *config.ConfigMap) (*SecretsCipher, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	providerName, err := cfg.GetStringParam(KeyProviderConfigKey)
+	if err != nil || providerName == "" {
+		return nil, nil
+	}
+
+	var provider KeyProvider
+	switch providerName {
+	case LocalKeyProvider:
+		keyFileName, err := cfg.GetStringParam(KeyFileConfigKey)
+		if err != nil || keyFileName == "" {
+			return nil, fmt.Errorf("%s must be set for the %s key provider", KeyFileConfigKey, LocalKeyProvider)
+		}
+		provider, err = NewLocalKeyFileProvider(keyFileName)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported subscriber key provider: %s", providerName)
+	}
+	return NewSecretsCipher(provider)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package encryption provides envelope encryption of the secret key fields of
+// subscribers at rest. Each subscriber's keys are encrypted with a random
+// data key, which is itself encrypted (wrapped) with a key encryption key
+// held by a KeyProvider. The ID of the key encryption key is stored with
+// each subscriber, so that key encryption keys can be rotated while older
+// records remain readable.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"magma/lte/cloud/go/protos"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// DataKeyBytes is the length of the per subscriber data keys (AES-256)
+const DataKeyBytes = 32
+
+// KeyProvider holds the key encryption keys which wrap the data keys. Key
+// providers backed by a KMS never need to expose the key encryption keys.
+type KeyProvider interface {
+	// GetCurrentKeyID returns the ID of the key which wraps new data keys
+	GetCurrentKeyID() string
+
+	// WrapKey encrypts a data key with the key encryption key keyID
+	WrapKey(keyID string, dataKey []byte) ([]byte, error)
+
+	// UnwrapKey decrypts a data key wrapped with the key encryption key keyID
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// SecretsCipher encrypts and decrypts the secret key fields of subscribers
+type SecretsCipher struct {
+	provider KeyProvider
+}
+
+func NewSecretsCipher(provider KeyProvider) (*SecretsCipher, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("Nil SecretsCipher key provider")
+	}
+	return &SecretsCipher{provider: provider}, nil
+}
+
+// GetCurrentKeyID returns the ID of the key new subscribers are encrypted with
+func (c *SecretsCipher) GetCurrentKeyID() string {
+	return c.provider.GetCurrentKeyID()
+}
+
+// Encrypt returns a copy of the subscriber whose secret key fields are
+// replaced by their encryption with the current key. The ciphertext is bound
+// to the subscriber ID.
+func (c *SecretsCipher) Encrypt(sub *protos.SubscriberData) (*protos.SubscriberData, error) {
+	ret := proto.Clone(sub).(*protos.SubscriberData)
+	secrets := takeSecrets(ret)
+
+	plaintext, err := proto.Marshal(secrets)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, DataKeyBytes)
+	if _, err = io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	ciphertext, err := Seal(dataKey, plaintext, []byte(protos.SidString(sub.Sid)))
+	if err != nil {
+		return nil, err
+	}
+	keyID := c.provider.GetCurrentKeyID()
+	wrappedKey, err := c.provider.WrapKey(keyID, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to wrap data key with key %s: %s", keyID, err)
+	}
+	ret.EncryptedSecrets = &protos.EncryptedSubscriberSecrets{
+		KeyId:          keyID,
+		WrappedDataKey: wrappedKey,
+		Ciphertext:     ciphertext,
+	}
+	return ret, nil
+}
+
+// Decrypt restores the secret key fields of a subscriber returned by
+// Encrypt in place. Subscribers without encrypted secrets are left as they
+// are, as they were stored before encryption was enabled.
+func (c *SecretsCipher) Decrypt(sub *protos.SubscriberData) error {
+	encrypted := sub.GetEncryptedSecrets()
+	if encrypted == nil {
+		return nil
+	}
+	dataKey, err := c.provider.UnwrapKey(encrypted.KeyId, encrypted.WrappedDataKey)
+	if err != nil {
+		return fmt.Errorf("Failed to unwrap data key with key %s: %s", encrypted.KeyId, err)
+	}
+	plaintext, err := Open(dataKey, encrypted.Ciphertext, []byte(protos.SidString(sub.Sid)))
+	if err != nil {
+		return err
+	}
+	secrets := &protos.SubscriberSecrets{}
+	if err = proto.Unmarshal(plaintext, secrets); err != nil {
+		return err
+	}
+	restoreSecrets(sub, secrets)
+	sub.EncryptedSecrets = nil
+	return nil
+}
+
+// Seal encrypts and authenticates plaintext and additionalData with
+// AES-GCM. The random nonce is prepended to the returned ciphertext.
+func Seal(key []byte, plaintext []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Open decrypts and authenticates a ciphertext returned by Seal
+func Open(key []byte, ciphertext []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("Ciphertext is too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decrypt: %s", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// takeSecrets moves the secret key fields of the subscriber into a
+// SubscriberSecrets
+func takeSecrets(sub *protos.SubscriberData) *protos.SubscriberSecrets {
+	secrets := &protos.SubscriberSecrets{}
+	if sub.Lte != nil {
+		secrets.LteAuthKey, sub.Lte.AuthKey = sub.Lte.AuthKey, nil
+		secrets.LteAuthOpc, sub.Lte.AuthOpc = sub.Lte.AuthOpc, nil
+		secrets.LteAuthTopc, sub.Lte.AuthTopc = sub.Lte.AuthTopc, nil
+	}
+	if sub.Gsm != nil {
+		secrets.GsmAuthKey, sub.Gsm.AuthKey = sub.Gsm.AuthKey, nil
+		secrets.GsmAuthTuples, sub.Gsm.AuthTuples = sub.Gsm.AuthTuples, nil
+	}
+	sub.EncryptedSecrets = nil
+	return secrets
+}
+
+func restoreSecrets(sub *protos.SubscriberData, secrets *protos.SubscriberSecrets) {
+	if sub.Lte != nil {
+		sub.Lte.AuthKey = secrets.LteAuthKey
+		sub.Lte.AuthOpc = secrets.LteAuthOpc
+		sub.Lte.AuthTopc = secrets.LteAuthTopc
+	}
+	if sub.Gsm != nil {
+		sub.Gsm.AuthKey = secrets.GsmAuthKey
+		sub.Gsm.AuthTuples = secrets.GsmAuthTuples
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package encryption_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
+	"magma/orc8r/cloud/go/service/config"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	key1 = bytes.Repeat([]byte{0x01}, encryption.KeyEncryptionKeyBytes)
+	key2 = bytes.Repeat([]byte{0x02}, encryption.KeyEncryptionKeyBytes)
+)
+
+func TestSecretsCipher(t *testing.T) {
+	provider, err := encryption.NewLocalKeyProvider("k1", map[string][]byte{"k1": key1})
+	assert.NoError(t, err)
+	cipher, err := encryption.NewSecretsCipher(provider)
+	assert.NoError(t, err)
+
+	sub := newTestSubscriber("IMSI001010000000001")
+	encrypted, err := cipher.Encrypt(sub)
+	assert.NoError(t, err)
+
+	// the subscriber itself is left untouched
+	assert.True(t, proto.Equal(newTestSubscriber("IMSI001010000000001"), sub))
+	assert.Empty(t, encrypted.Lte.AuthKey)
+	assert.Empty(t, encrypted.Lte.AuthOpc)
+	assert.Empty(t, encrypted.Lte.AuthTopc)
+	assert.Empty(t, encrypted.Gsm.AuthKey)
+	assert.Equal(t, "k1", encrypted.EncryptedSecrets.KeyId)
+	assert.Equal(t, sub.Lte.State, encrypted.Lte.State)
+	assert.Equal(t, sub.State.LteAuthNextSeq, encrypted.State.LteAuthNextSeq)
+
+	// each encryption uses a fresh data key
+	encryptedAgain, err := cipher.Encrypt(sub)
+	assert.NoError(t, err)
+	assert.NotEqual(t, encrypted.EncryptedSecrets.WrappedDataKey, encryptedAgain.EncryptedSecrets.WrappedDataKey)
+
+	assert.NoError(t, cipher.Decrypt(encrypted))
+	assert.True(t, proto.Equal(sub, encrypted))
+
+	// plaintext subscribers are read as they are
+	plaintext := newTestSubscriber("IMSI001010000000001")
+	assert.NoError(t, cipher.Decrypt(plaintext))
+	assert.True(t, proto.Equal(sub, plaintext))
+
+	// the secrets are bound to the subscriber ID
+	encrypted, err = cipher.Encrypt(sub)
+	assert.NoError(t, err)
+	encrypted.Sid.Id = "001010000000002"
+	assert.Error(t, cipher.Decrypt(encrypted))
+}
+
+func TestSecretsCipher_GsmAuthTuples(t *testing.T) {
+	provider, err := encryption.NewLocalKeyProvider("k1", map[string][]byte{"k1": key1})
+	assert.NoError(t, err)
+	cipher, err := encryption.NewSecretsCipher(provider)
+	assert.NoError(t, err)
+
+	// precomputed tuples carry the Kc session keys, so they're secrets too
+	sub := newTestSubscriber("IMSI001010000000001")
+	sub.Gsm.AuthAlgo = protos.GSMSubscription_PRECOMPUTED_AUTH_TUPLES
+	sub.Gsm.AuthKey = nil
+	sub.Gsm.AuthTuples = [][]byte{bytes.Repeat([]byte{0x01}, 28), bytes.Repeat([]byte{0x02}, 28)}
+	encrypted, err := cipher.Encrypt(sub)
+	assert.NoError(t, err)
+	assert.Empty(t, encrypted.Gsm.AuthTuples)
+	assert.Len(t, sub.Gsm.AuthTuples, 2)
+
+	assert.NoError(t, cipher.Decrypt(encrypted))
+	assert.True(t, proto.Equal(sub, encrypted))
+}
+
+func TestSecretsCipher_KeyRotation(t *testing.T) {
+	oldProvider, err := encryption.NewLocalKeyProvider("k1", map[string][]byte{"k1": key1})
+	assert.NoError(t, err)
+	oldCipher, err := encryption.NewSecretsCipher(oldProvider)
+	assert.NoError(t, err)
+	newProvider, err := encryption.NewLocalKeyProvider("k2", map[string][]byte{"k1": key1, "k2": key2})
+	assert.NoError(t, err)
+	newCipher, err := encryption.NewSecretsCipher(newProvider)
+	assert.NoError(t, err)
+
+	sub := newTestSubscriber("IMSI001010000000001")
+	encryptedWithK1, err := oldCipher.Encrypt(sub)
+	assert.NoError(t, err)
+	encryptedWithK2, err := newCipher.Encrypt(sub)
+	assert.NoError(t, err)
+	assert.Equal(t, "k2", encryptedWithK2.EncryptedSecrets.KeyId)
+
+	// the new cipher reads subscribers encrypted with either key
+	decrypted := proto.Clone(encryptedWithK1).(*protos.SubscriberData)
+	assert.NoError(t, newCipher.Decrypt(decrypted))
+	assert.True(t, proto.Equal(sub, decrypted))
+	decrypted = proto.Clone(encryptedWithK2).(*protos.SubscriberData)
+	assert.NoError(t, newCipher.Decrypt(decrypted))
+	assert.True(t, proto.Equal(sub, decrypted))
+
+	// the old cipher does not know the new key
+	assert.EqualError(t, oldCipher.Decrypt(encryptedWithK2), "Failed to unwrap data key with key k2: Unknown key k2")
+}
+
+func TestNewLocalKeyFileProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subscriber_keys")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	keyFileName := filepath.Join(dir, "keys.json")
+	writeKeyFile := func(contents string) {
+		assert.NoError(t, ioutil.WriteFile(keyFileName, []byte(contents), 0600))
+	}
+
+	writeKeyFile(`{"current_key_id": "k2", "keys": {
+		"k1": "0101010101010101010101010101010101010101010101010101010101010101",
+		"k2": "0202020202020202020202020202020202020202020202020202020202020202"}}`)
+	provider, err := encryption.NewLocalKeyFileProvider(keyFileName)
+	assert.NoError(t, err)
+	assert.Equal(t, "k2", provider.GetCurrentKeyID())
+
+	// keys wrapped by the file's keys can be unwrapped by the same keys
+	expectedProvider, err := encryption.NewLocalKeyProvider("k1", map[string][]byte{"k1": key1})
+	assert.NoError(t, err)
+	wrapped, err := expectedProvider.WrapKey("k1", key2)
+	assert.NoError(t, err)
+	unwrapped, err := provider.UnwrapKey("k1", wrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, key2, unwrapped)
+	_, err = provider.UnwrapKey("k2", wrapped)
+	assert.Error(t, err)
+
+	writeKeyFile(`{"current_key_id": "k3", "keys": {"k1": "0101010101010101010101010101010101010101010101010101010101010101"}}`)
+	_, err = encryption.NewLocalKeyFileProvider(keyFileName)
+	assert.EqualError(t, err, "Current key k3 not found")
+
+	writeKeyFile(`{"current_key_id": "k1", "keys": {"k1": "0101"}}`)
+	_, err = encryption.NewLocalKeyFileProvider(keyFileName)
+	assert.EqualError(t, err, "Expected key k1 to be 32 bytes, but got 2 bytes")
+
+	writeKeyFile(`{"current_key_id": "k1", "keys": {"k1": "xyz"}}`)
+	_, err = encryption.NewLocalKeyFileProvider(keyFileName)
+	assert.Error(t, err)
+
+	_, err = encryption.NewLocalKeyFileProvider(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+
+	// the service config selects the provider
+	cipher, err := encryption.GetSecretsCipher(config.NewConfigMap(map[interface{}]interface{}{
+		encryption.KeyProviderConfigKey: "",
+	}))
+	assert.NoError(t, err)
+	assert.Nil(t, cipher)
+
+	writeKeyFile(`{"current_key_id": "k1", "keys": {"k1": "0101010101010101010101010101010101010101010101010101010101010101"}}`)
+	cipher, err = encryption.GetSecretsCipher(config.NewConfigMap(map[interface{}]interface{}{
+		encryption.KeyProviderConfigKey: encryption.LocalKeyProvider,
+		encryption.KeyFileConfigKey:     keyFileName,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "k1", cipher.GetCurrentKeyID())
+
+	_, err = encryption.GetSecretsCipher(config.NewConfigMap(map[interface{}]interface{}{
+		encryption.KeyProviderConfigKey: encryption.LocalKeyProvider,
+	}))
+	assert.EqualError(t, err, "subscriberKeyFile must be set for the local key provider")
+
+	_, err = encryption.GetSecretsCipher(config.NewConfigMap(map[interface{}]interface{}{
+		encryption.KeyProviderConfigKey: "kms",
+	}))
+	assert.EqualError(t, err, "Unsupported subscriber key provider: kms")
+}
+
+func newTestSubscriber(sid string) *protos.SubscriberData {
+	return &protos.SubscriberData{
+		Sid: protos.SidFromString(sid),
+		Lte: &protos.LTESubscription{
+			State:    protos.LTESubscription_ACTIVE,
+			AuthAlgo: protos.LTESubscription_TUAK,
+			AuthKey:  bytes.Repeat([]byte{0xaa}, 16),
+			AuthOpc:  bytes.Repeat([]byte{0xbb}, 16),
+			AuthTopc: bytes.Repeat([]byte{0xcc}, 32),
+		},
+		Gsm: &protos.GSMSubscription{
+			State:    protos.GSMSubscription_ACTIVE,
+			AuthAlgo: protos.GSMSubscription_MILENAGE,
+			AuthKey:  bytes.Repeat([]byte{0xdd}, 16),
+		},
+		State: &protos.SubscriberState{LteAuthNextSeq: 7},
+	}
+}
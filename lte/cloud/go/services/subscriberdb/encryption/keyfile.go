@@ -0,0 +1,118 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package encryption
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// KeyEncryptionKeyBytes is the length of the key encryption keys (AES-256)
+const KeyEncryptionKeyBytes = 32
+
+// LocalKeyFileProvider is a KeyProvider holding the key encryption keys from
+// a local key file in memory.
+//
+// The key file is a JSON object with the hex encoded keys by ID and the ID
+// of the current key, e.g.
+//   {
+//     "current_key_id": "2019-02",
+//     "keys": {
+//       "2019-01": "000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f",
+//       "2019-02": "101112131415161718191a1b1c1d1e1f101112131415161718191a1b1c1d1e1f"
+//     }
+//   }
+//
+// Every instance of subscriberdb and eps_authentication must be able to
+// decrypt every subscriber, so the key is rotated in phases:
+//  1. Add the new key to the key file without making it the current key and
+//     restart every instance of subscriberdb and eps_authentication.
+//  2. Make the new key the current key and restart every instance again.
+//  3. Run reencrypt_subscribers to re-encrypt the subscribers of every
+//     network with the new key.
+//
+// The old key can be removed once no subscriber uses it anymore, i.e. once
+// reencrypt_subscribers succeeds.
+type LocalKeyFileProvider struct {
+	currentKeyID string
+	keys         map[string][]byte
+}
+
+type keyFile struct {
+	CurrentKeyID string            `json:"current_key_id"`
+	Keys         map[string]string `json:"keys"`
+}
+
+// NewLocalKeyFileProvider loads the key encryption keys from the key file
+func NewLocalKeyFileProvider(keyFileName string) (*LocalKeyFileProvider, error) {
+	contents, err := ioutil.ReadFile(keyFileName)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read key file %s: %s", keyFileName, err)
+	}
+	file := keyFile{}
+	if err = json.Unmarshal(contents, &file); err != nil {
+		return nil, fmt.Errorf("Failed to parse key file %s: %s", keyFileName, err)
+	}
+
+	keys := make(map[string][]byte, len(file.Keys))
+	for keyID, hexKey := range file.Keys {
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid hex in key %s of key file %s: %s", keyID, keyFileName, err)
+		}
+		keys[keyID] = key
+	}
+	return NewLocalKeyProvider(file.CurrentKeyID, keys)
+}
+
+// NewLocalKeyProvider returns a LocalKeyFileProvider holding the given keys
+func NewLocalKeyProvider(currentKeyID string, keys map[string][]byte) (*LocalKeyFileProvider, error) {
+	for keyID, key := range keys {
+		if keyID == "" {
+			return nil, fmt.Errorf("Key IDs must not be empty")
+		}
+		if len(key) != KeyEncryptionKeyBytes {
+			return nil, fmt.Errorf("Expected key %s to be %d bytes, but got %d bytes", keyID, KeyEncryptionKeyBytes, len(key))
+		}
+	}
+	if _, ok := keys[currentKeyID]; !ok {
+		return nil, fmt.Errorf("Current key %s not found", currentKeyID)
+	}
+	return &LocalKeyFileProvider{currentKeyID: currentKeyID, keys: keys}, nil
+}
+
+func (p *LocalKeyFileProvider) GetCurrentKeyID() string {
+	return p.currentKeyID
+}
+
+func (p *LocalKeyFileProvider) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	key, err := p.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return Seal(key, dataKey, []byte(keyID))
+}
+
+func (p *LocalKeyFileProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	key, err := p.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return Open(key, wrappedKey, []byte(keyID))
+}
+
+func (p *LocalKeyFileProvider) getKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("Unknown key %s", keyID)
+	}
+	return key, nil
+}
@@ -0,0 +1,592 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/obsidian/models"
+	"magma/orc8r/cloud/go/obsidian/handlers"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/glog"
+	"github.com/labstack/echo"
+)
+
+const (
+	SubscriberBatchPath  = SubscriberdRootPath + "/bulk"
+	SubscriberExportPath = SubscriberdRootPath + "/export"
+
+	// maxBatchRows is the maximum number of rows of a batch
+	maxBatchRows = 100000
+
+	formatJSON = "json"
+	formatCSV  = "csv"
+
+	csvColumnIMSI       = "imsi"
+	csvColumnAuthKey    = "auth_key"
+	csvColumnAuthOpc    = "auth_opc"
+	csvColumnSubProfile = "sub_profile"
+	csvColumnState      = "state"
+	csvColumnAuthAlgo   = "auth_algo"
+	csvColumnAuthTopc   = "auth_topc"
+)
+
+// csvColumns are the columns of subscriber CSV files. Keys are hex encoded.
+var csvColumns = []string{
+	csvColumnIMSI,
+	csvColumnAuthKey,
+	csvColumnAuthOpc,
+	csvColumnSubProfile,
+	csvColumnState,
+	csvColumnAuthAlgo,
+	csvColumnAuthTopc,
+}
+
+// errBatchTooLarge is returned as soon as a batch payload is found to have
+// more than maxBatchRows rows, so that the rest of it isn't parsed
+var errBatchTooLarge = fmt.Errorf("Batch has more than %d rows", maxBatchRows)
+
+// batchRow is a subscriber of a batch, or the reason it could not be parsed
+type batchRow struct {
+	sub *models.Subscriber
+	err error
+}
+
+// REST handler to add a batch of new subscribers, expects a JSON array of
+// subscribers or a CSV file as payload
+func addSubscribersHandler(c echo.Context) error {
+	return putSubscribers(c, subscriberdb.AddSubscribers, http.StatusCreated)
+}
+
+// REST handler to update a batch of existing subscribers, expects a JSON array
+// of subscribers or a CSV file as payload
+func updateSubscribersHandler(c echo.Context) error {
+	return putSubscribers(c, subscriberdb.UpdateSubscribers, http.StatusOK)
+}
+
+// REST handler to delete a batch of subscribers, expects a JSON array of
+// subscriber IDs or a CSV file with an imsi column as payload
+func deleteSubscribersHandler(c echo.Context) error {
+	networkId, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	format, httpErr := getBatchFormat(c)
+	if httpErr != nil {
+		return httpErr
+	}
+
+	var ids []string
+	var err error
+	body := getBatchBody(c)
+	if format == formatCSV {
+		ids, err = readCSVSubscriberIds(body)
+	} else {
+		ids, err = readJSONSubscriberIds(body)
+	}
+	if err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+
+	results := make([]*models.SubscriberBatchResult, len(ids))
+	for i, id := range ids {
+		results[i] = &models.SubscriberBatchResult{Row: uint32(i), ID: models.SubscriberID(id)}
+		if err := results[i].ID.Verify(); err != nil {
+			setInvalidResult(results[i], err)
+		}
+	}
+	if skipIfInvalidResults(results) {
+		return c.JSON(http.StatusBadRequest, results)
+	}
+
+	protoResults, err := subscriberdb.DeleteSubscribers(networkId, ids)
+	if err != nil {
+		return handlers.HttpError(err)
+	}
+	return sendBatchResults(c, protoResults, http.StatusOK)
+}
+
+// REST handler to export all subscribers of the network as a JSON array or a
+// CSV file. The subscribers are streamed, so the response has no
+// Content-Length.
+func exportSubscribersHandler(c echo.Context) error {
+	networkId, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	format, httpErr := getBatchFormat(c)
+	if httpErr != nil {
+		return httpErr
+	}
+
+	var export subscriberExporter
+	if format == formatCSV {
+		export = &csvSubscriberExporter{writer: csv.NewWriter(c.Response())}
+	} else {
+		export = &jsonSubscriberExporter{writer: c.Response()}
+	}
+
+	started := false
+	err := subscriberdb.ExportSubscribers(networkId, func(sub *protos.SubscriberData) error {
+		if !started {
+			started = true
+			if err := startExport(c, format, export); err != nil {
+				return err
+			}
+		}
+		subModel := &models.Subscriber{}
+		if err := subModel.FromMconfig(sub); err != nil {
+			return fmt.Errorf("Error converting subscriber model: %s", err)
+		}
+		return export.write(subModel)
+	})
+	if err != nil {
+		if !started {
+			return handlers.HttpError(err)
+		}
+		// The status has already been sent, so the truncated body is the
+		// only indication of the error
+		glog.Errorf("Error exporting subscribers of network %s: %s", networkId, err)
+		return nil
+	}
+	if !started {
+		if err = startExport(c, format, export); err != nil {
+			return err
+		}
+	}
+	return export.finish()
+}
+
+func putSubscribers(
+	c echo.Context,
+	put func(string, []*protos.SubscriberData) ([]*protos.SubscriberBatchResult, error),
+	successStatus int,
+) error {
+	networkId, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	format, httpErr := getBatchFormat(c)
+	if httpErr != nil {
+		return httpErr
+	}
+
+	var rows []batchRow
+	var err error
+	body := getBatchBody(c)
+	if format == formatCSV {
+		rows, err = readCSVSubscribers(body)
+	} else {
+		rows, err = readJSONSubscribers(body)
+	}
+	if err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+
+	results := make([]*models.SubscriberBatchResult, len(rows))
+	subs := make([]*protos.SubscriberData, len(rows))
+	for i, row := range rows {
+		results[i] = &models.SubscriberBatchResult{Row: uint32(i)}
+		if row.sub != nil {
+			results[i].ID = row.sub.ID
+		}
+		if row.err != nil {
+			setInvalidResult(results[i], row.err)
+			continue
+		}
+		if err := row.sub.Verify(); err != nil {
+			setInvalidResult(results[i], err)
+			continue
+		}
+		subs[i] = new(protos.SubscriberData)
+		if err := row.sub.ToMconfig(subs[i]); err != nil {
+			setInvalidResult(results[i], err)
+		}
+	}
+	if skipIfInvalidResults(results) {
+		return c.JSON(http.StatusBadRequest, results)
+	}
+
+	protoResults, err := put(networkId, subs)
+	if err != nil {
+		return handlers.HttpError(err)
+	}
+	return sendBatchResults(c, protoResults, successStatus)
+}
+
+// getBatchFormat returns the format of the payload or response, which is set
+// by the format query parameter or otherwise by the Content-Type header.
+func getBatchFormat(c echo.Context) (string, *echo.HTTPError) {
+	switch format := c.QueryParam("format"); format {
+	case formatJSON, formatCSV:
+		return format, nil
+	case "":
+		if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), "text/csv") {
+			return formatCSV, nil
+		}
+		return formatJSON, nil
+	default:
+		return "", handlers.HttpError(
+			fmt.Errorf("Unsupported format %s, expected %s or %s", format, formatJSON, formatCSV),
+			http.StatusBadRequest)
+	}
+}
+
+// getBatchBody returns the request body, which fails to read past the size
+// of the largest batch the subscriberdb service accepts
+func getBatchBody(c echo.Context) io.Reader {
+	return http.MaxBytesReader(c.Response(), c.Request().Body, subscriberdb.MaxBatchMsgBytes)
+}
+
+func readJSONSubscribers(body io.Reader) ([]batchRow, error) {
+	var rows []batchRow
+	err := readJSONArray(body, func(decoder *json.Decoder) error {
+		var sub *models.Subscriber
+		if err := decoder.Decode(&sub); err != nil {
+			return err
+		}
+		row := batchRow{sub: sub}
+		if sub == nil {
+			row.err = fmt.Errorf("No subscriber provided")
+		}
+		rows = append(rows, row)
+		return nil
+	})
+	return rows, err
+}
+
+func readJSONSubscriberIds(body io.Reader) ([]string, error) {
+	var ids []string
+	err := readJSONArray(body, func(decoder *json.Decoder) error {
+		var id string
+		if err := decoder.Decode(&id); err != nil {
+			return err
+		}
+		ids = append(ids, id)
+		return nil
+	})
+	return ids, err
+}
+
+// readJSONArray calls readElement for each element of a JSON array, and
+// stops with errBatchTooLarge once the array has more than maxBatchRows
+// elements. A null payload is an empty array.
+func readJSONArray(body io.Reader, readElement func(decoder *json.Decoder) error) error {
+	decoder := json.NewDecoder(body)
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("Expected a JSON array")
+	}
+	for rows := 0; decoder.More(); rows++ {
+		if rows == maxBatchRows {
+			return errBatchTooLarge
+		}
+		if err := readElement(decoder); err != nil {
+			return err
+		}
+	}
+	_, err = decoder.Token()
+	return err
+}
+
+// readCSVSubscribers parses a CSV file with a header row naming its columns.
+// Only the imsi column is required.
+func readCSVSubscribers(body io.Reader) ([]batchRow, error) {
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+	columns, err := readCSVHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []batchRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == maxBatchRows {
+			return nil, errBatchTooLarge
+		}
+		sub, err := parseCSVSubscriber(record, columns)
+		rows = append(rows, batchRow{sub: sub, err: err})
+	}
+}
+
+func readCSVSubscriberIds(body io.Reader) ([]string, error) {
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+	columns, err := readCSVHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == maxBatchRows {
+			return nil, errBatchTooLarge
+		}
+		ids = append(ids, normalizeIMSI(record[columns[csvColumnIMSI]]))
+	}
+}
+
+// readCSVHeader reads the header row and returns the index of each column.
+func readCSVHeader(reader *csv.Reader) (map[string]int, error) {
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading CSV header: %s", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isCSVColumn(name) {
+			return nil, fmt.Errorf("Unknown CSV column %s, expected columns are %s", name, strings.Join(csvColumns, ", "))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("Duplicate CSV column %s", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns[csvColumnIMSI]; !ok {
+		return nil, fmt.Errorf("Missing CSV column %s", csvColumnIMSI)
+	}
+	return columns, nil
+}
+
+func parseCSVSubscriber(record []string, columns map[string]int) (*models.Subscriber, error) {
+	field := func(column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	sub := &models.Subscriber{
+		ID:         models.SubscriberID(normalizeIMSI(field(csvColumnIMSI))),
+		SubProfile: field(csvColumnSubProfile),
+	}
+	authKey, err := decodeCSVKey(field(csvColumnAuthKey), csvColumnAuthKey)
+	if err != nil {
+		return sub, err
+	}
+	authOpc, err := decodeCSVKey(field(csvColumnAuthOpc), csvColumnAuthOpc)
+	if err != nil {
+		return sub, err
+	}
+	authTopc, err := decodeCSVKey(field(csvColumnAuthTopc), csvColumnAuthTopc)
+	if err != nil {
+		return sub, err
+	}
+	state, authAlgo := strings.ToUpper(field(csvColumnState)), strings.ToUpper(field(csvColumnAuthAlgo))
+	if authKey == nil && authOpc == nil && authTopc == nil && state == "" && authAlgo == "" {
+		return sub, nil
+	}
+
+	sub.Lte = &models.LteSubscription{
+		AuthAlgo: authAlgo,
+		AuthKey:  authKey,
+		AuthOpc:  authOpc,
+		AuthTopc: authTopc,
+		State:    state,
+	}
+	if authKey != nil && len(*authKey) == 32 {
+		sub.Lte.AuthKeyBits = 256
+	}
+	return sub, nil
+}
+
+func decodeCSVKey(field string, column string) (*strfmt.Base64, error) {
+	if field == "" {
+		return nil, nil
+	}
+	key, err := hex.DecodeString(field)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid hex in %s: %s", column, err)
+	}
+	return (*strfmt.Base64)(&key), nil
+}
+
+func encodeCSVKey(key *strfmt.Base64) string {
+	if key == nil {
+		return ""
+	}
+	return hex.EncodeToString(*key)
+}
+
+// normalizeIMSI adds the IMSI prefix to bare IMSIs, as SIM vendors deliver them
+func normalizeIMSI(imsi string) string {
+	imsi = strings.TrimSpace(imsi)
+	if imsi == "" || strings.HasPrefix(imsi, "IMSI") {
+		return imsi
+	}
+	return "IMSI" + imsi
+}
+
+func isCSVColumn(name string) bool {
+	for _, column := range csvColumns {
+		if name == column {
+			return true
+		}
+	}
+	return false
+}
+
+func setInvalidResult(result *models.SubscriberBatchResult, err error) {
+	result.Status = models.SubscriberBatchResultStatusINVALID
+	result.Error = err.Error()
+}
+
+// skipIfInvalidResults marks all valid rows as skipped if any row is invalid,
+// and returns whether it did so.
+func skipIfInvalidResults(results []*models.SubscriberBatchResult) bool {
+	invalid := 0
+	for _, result := range results {
+		if result.Status == models.SubscriberBatchResultStatusINVALID {
+			invalid++
+		}
+	}
+	if invalid == 0 {
+		return false
+	}
+	for _, result := range results {
+		if result.Status != models.SubscriberBatchResultStatusINVALID {
+			result.Status = models.SubscriberBatchResultStatusSKIPPED
+			result.Error = fmt.Sprintf("Batch not written, %d rows are invalid", invalid)
+		}
+	}
+	return true
+}
+
+// sendBatchResults responds with the results of a batch. The status is
+// successStatus if all rows were written, 400 if the batch was rejected and
+// 500 if writing some rows failed.
+func sendBatchResults(c echo.Context, protoResults []*protos.SubscriberBatchResult, successStatus int) error {
+	status := successStatus
+	results := make([]*models.SubscriberBatchResult, len(protoResults))
+	for i, protoResult := range protoResults {
+		results[i] = &models.SubscriberBatchResult{
+			Row:    protoResult.Row,
+			Status: protoResult.Status.String(),
+			Error:  protoResult.Error,
+		}
+		if protoResult.Sid != nil {
+			results[i].ID = models.SubscriberID(protos.SidString(protoResult.Sid))
+		}
+		switch protoResult.Status {
+		case protos.SubscriberBatchResult_INVALID, protos.SubscriberBatchResult_SKIPPED:
+			status = http.StatusBadRequest
+		case protos.SubscriberBatchResult_FAILED:
+			status = http.StatusInternalServerError
+		}
+	}
+	return c.JSON(status, results)
+}
+
+// startExport sends the response status and the start of the payload.
+func startExport(c echo.Context, format string, export subscriberExporter) error {
+	contentType := echo.MIMEApplicationJSONCharsetUTF8
+	if format == formatCSV {
+		contentType = "text/csv; charset=UTF-8"
+		c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="subscribers.csv"`)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, contentType)
+	c.Response().WriteHeader(http.StatusOK)
+	return export.start()
+}
+
+// subscriberExporter writes an exported subscriber payload one subscriber at
+// a time.
+type subscriberExporter interface {
+	start() error
+	write(sub *models.Subscriber) error
+	finish() error
+}
+
+type jsonSubscriberExporter struct {
+	writer  *echo.Response
+	written bool
+}
+
+func (e *jsonSubscriberExporter) start() error {
+	_, err := io.WriteString(e.writer, "[")
+	return err
+}
+
+func (e *jsonSubscriberExporter) write(sub *models.Subscriber) error {
+	if e.written {
+		if _, err := io.WriteString(e.writer, ","); err != nil {
+			return err
+		}
+	}
+	e.written = true
+	return json.NewEncoder(e.writer).Encode(sub)
+}
+
+func (e *jsonSubscriberExporter) finish() error {
+	_, err := io.WriteString(e.writer, "]")
+	e.writer.Flush()
+	return err
+}
+
+type csvSubscriberExporter struct {
+	writer *csv.Writer
+}
+
+func (e *csvSubscriberExporter) start() error {
+	return e.writer.Write(csvColumns)
+}
+
+func (e *csvSubscriberExporter) write(sub *models.Subscriber) error {
+	fields := map[string]string{
+		csvColumnIMSI:       string(sub.ID),
+		csvColumnSubProfile: sub.SubProfile,
+	}
+	if sub.Lte != nil {
+		fields[csvColumnAuthKey] = encodeCSVKey(sub.Lte.AuthKey)
+		fields[csvColumnAuthOpc] = encodeCSVKey(sub.Lte.AuthOpc)
+		fields[csvColumnState] = sub.Lte.State
+		fields[csvColumnAuthAlgo] = sub.Lte.AuthAlgo
+		fields[csvColumnAuthTopc] = encodeCSVKey(sub.Lte.AuthTopc)
+	}
+	record := make([]string, len(csvColumns))
+	for i, column := range csvColumns {
+		record[i] = fields[column]
+	}
+	return e.writer.Write(record)
+}
+
+func (e *csvSubscriberExporter) finish() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
@@ -0,0 +1,213 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	lteplugin "magma/lte/cloud/go/plugin"
+	sdb_test_init "magma/lte/cloud/go/services/subscriberdb/test_init"
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriberdBatch(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &lteplugin.LteOrchestratorPlugin{})
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	magmad_test_init.StartTestService(t)
+	sdb_test_init.StartTestService(t)
+
+	restPort := tests.StartObsidian(t)
+
+	testUrlRoot := fmt.Sprintf(
+		"http://localhost:%d%s/networks", restPort, handlers.REST_ROOT)
+
+	registerNetworkTestCase := tests.Testcase{
+		Name:                      "Register Network",
+		Method:                    "POST",
+		Url:                       fmt.Sprintf("%s?requested_id=subscriberdb_batch_obsidian_test_network", testUrlRoot),
+		Payload:                   `{"name":"This Is A Test Network Name"}`,
+		Skip_payload_verification: true,
+	}
+	_, networkId, _ := tests.RunTest(t, registerNetworkTestCase)
+	json.Unmarshal([]byte(networkId), &networkId)
+	bulkUrl := fmt.Sprintf("%s/%s/subscribers/bulk", testUrlRoot, networkId)
+	exportUrl := fmt.Sprintf("%s/%s/subscribers/export", testUrlRoot, networkId)
+
+	// Test Exporting An Empty Network
+	tests.RunTest(t, tests.Testcase{
+		Name:     "Export No Subscribers",
+		Method:   "GET",
+		Url:      exportUrl,
+		Payload:  "",
+		Expected: "[]",
+	})
+
+	// Test Bulk Add From JSON
+	tests.RunTest(t, tests.Testcase{
+		Name:   "Bulk Add Subscribers",
+		Method: "POST",
+		Url:    bulkUrl,
+		Payload: `[{"id":"IMSI001010000000002", "lte":{"state":"ACTIVE",
+				"auth_algo":"MILENAGE",
+				"auth_key":"ABEiM0RVZneImaq7zN3u/w==",
+				"auth_opc":"AAECAwQFBgcICQoLDA0ODw=="}},
+			{"id":"IMSI001010000000001", "sub_profile":"superfast"}]`,
+		Expected: `[{"row":0, "id":"IMSI001010000000002", "status":"OK"},
+			{"row":1, "id":"IMSI001010000000001", "status":"OK"}]`,
+	})
+
+	// Test Bulk Add From CSV
+	tests.RunTest(t, tests.Testcase{
+		Name:   "Bulk Add Subscribers From CSV",
+		Method: "POST",
+		Url:    bulkUrl + "?format=csv",
+		Payload: "imsi,auth_key,auth_opc,state\n" +
+			"001010000000003,00112233445566778899aabbccddeeff,000102030405060708090a0b0c0d0e0f,active\n",
+		Expected: `[{"row":0, "id":"IMSI001010000000003", "status":"OK"}]`,
+	})
+
+	// Test Bulk Add Rejects The Whole Batch If A Row Is Invalid
+	tests.RunTest(t, tests.Testcase{
+		Name:   "Bulk Add Invalid Subscribers From CSV",
+		Method: "POST",
+		Url:    bulkUrl + "?format=csv",
+		Payload: "imsi,auth_key\n" +
+			"001010000000004,00112233445566778899aabbccddeeff\n" +
+			"001010000000005,xyz\n",
+		Expected: `[{"row":0, "id":"IMSI001010000000004", "status":"SKIPPED", "error":"Batch not written, 1 rows are invalid"},
+			{"row":1, "id":"IMSI001010000000005", "status":"INVALID", "error":"Invalid hex in auth_key: encoding/hex: invalid byte: U+0078 'x'"}]`,
+		Expect_http_error_status: true,
+	})
+	tests.RunTest(t, tests.Testcase{
+		Name:    "Bulk Add Existing Subscriber",
+		Method:  "POST",
+		Url:     bulkUrl,
+		Payload: `[{"id":"IMSI001010000000004"}, {"id":"IMSI001010000000001"}]`,
+		Expected: `[{"row":0, "id":"IMSI001010000000004", "status":"SKIPPED", "error":"Batch not written, 1 rows are invalid"},
+			{"row":1, "id":"IMSI001010000000001", "status":"INVALID", "error":"Subscriber IMSI001010000000001 already exists"}]`,
+		Expect_http_error_status: true,
+	})
+	tests.RunTest(t, tests.Testcase{
+		Name:                      "Bulk Add Subscribers From CSV Without Header",
+		Method:                    "POST",
+		Url:                       bulkUrl + "?format=csv",
+		Payload:                   "001010000000004,00112233445566778899aabbccddeeff\n",
+		Skip_payload_verification: true,
+		Expect_http_error_status:  true,
+	})
+
+	// Test Bulk Update
+	tests.RunTest(t, tests.Testcase{
+		Name:    "Bulk Update Missing Subscriber",
+		Method:  "PUT",
+		Url:     bulkUrl,
+		Payload: `[{"id":"IMSI001010000000001"}, {"id":"IMSI001010000000009"}]`,
+		Expected: `[{"row":0, "id":"IMSI001010000000001", "status":"SKIPPED", "error":"Batch not written, 1 rows are invalid"},
+			{"row":1, "id":"IMSI001010000000009", "status":"INVALID", "error":"Subscriber IMSI001010000000009 does not exist"}]`,
+		Expect_http_error_status: true,
+	})
+	tests.RunTest(t, tests.Testcase{
+		Name:     "Bulk Update Subscribers",
+		Method:   "PUT",
+		Url:      bulkUrl,
+		Payload:  `[{"id":"IMSI001010000000001", "sub_profile":"slow"}]`,
+		Expected: `[{"row":0, "id":"IMSI001010000000001", "status":"OK"}]`,
+	})
+
+	// Test Exporting
+	tests.RunTest(t, tests.Testcase{
+		Name:    "Export Subscribers",
+		Method:  "GET",
+		Url:     exportUrl,
+		Payload: "",
+		Expected: `[{"id":"IMSI001010000000001", "sub_profile":"slow"},
+			{"id":"IMSI001010000000002", "lte":{"state":"ACTIVE",
+				"auth_algo":"MILENAGE",
+				"auth_key":"ABEiM0RVZneImaq7zN3u/w==",
+				"auth_opc":"AAECAwQFBgcICQoLDA0ODw=="}},
+			{"id":"IMSI001010000000003", "lte":{"state":"ACTIVE",
+				"auth_algo":"MILENAGE",
+				"auth_key":"ABEiM0RVZneImaq7zN3u/w==",
+				"auth_opc":"AAECAwQFBgcICQoLDA0ODw=="}}]`,
+	})
+	_, csvExport, _ := tests.RunTest(t, tests.Testcase{
+		Name:                      "Export Subscribers As CSV",
+		Method:                    "GET",
+		Url:                       exportUrl + "?format=csv",
+		Payload:                   "",
+		Skip_payload_verification: true,
+	})
+	assert.Equal(
+		t,
+		"imsi,auth_key,auth_opc,sub_profile,state,auth_algo,auth_topc\n"+
+			"IMSI001010000000001,,,slow,,,\n"+
+			"IMSI001010000000002,00112233445566778899aabbccddeeff,000102030405060708090a0b0c0d0e0f,,ACTIVE,MILENAGE,\n"+
+			"IMSI001010000000003,00112233445566778899aabbccddeeff,000102030405060708090a0b0c0d0e0f,,ACTIVE,MILENAGE,\n",
+		csvExport,
+	)
+
+	// Test Bulk Delete
+	tests.RunTest(t, tests.Testcase{
+		Name:     "Bulk Delete Subscribers",
+		Method:   "DELETE",
+		Url:      bulkUrl,
+		Payload:  `["IMSI001010000000001", "IMSI001010000000003"]`,
+		Expected: `[{"row":0, "id":"IMSI001010000000001", "status":"OK"}, {"row":1, "id":"IMSI001010000000003", "status":"OK"}]`,
+	})
+	tests.RunTest(t, tests.Testcase{
+		Name:     "Bulk Delete Subscribers From CSV",
+		Method:   "DELETE",
+		Url:      bulkUrl + "?format=csv",
+		Payload:  "imsi\n001010000000002\n",
+		Expected: `[{"row":0, "id":"IMSI001010000000002", "status":"OK"}]`,
+	})
+
+	// Test Batches Are Rejected Once They Exceed The Row Limit
+	ids := make([]string, 100001)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("IMSI%015d", i)
+	}
+	idsPayload, err := json.Marshal(ids)
+	assert.NoError(t, err)
+	_, response, _ := tests.RunTest(t, tests.Testcase{
+		Name:                      "Bulk Delete Too Many Subscribers",
+		Method:                    "DELETE",
+		Url:                       bulkUrl,
+		Payload:                   string(idsPayload),
+		Skip_payload_verification: true,
+		Expect_http_error_status:  true,
+	})
+	assert.Contains(t, response, "Batch has more than 100000 rows")
+	_, response, _ = tests.RunTest(t, tests.Testcase{
+		Name:                      "Bulk Add Too Many Subscribers From CSV",
+		Method:                    "POST",
+		Url:                       bulkUrl + "?format=csv",
+		Payload:                   "imsi\n" + strings.Join(ids, "\n"),
+		Skip_payload_verification: true,
+		Expect_http_error_status:  true,
+	})
+	assert.Contains(t, response, "Batch has more than 100000 rows")
+
+	tests.RunTest(t, tests.Testcase{
+		Name:     "List Subscribers After Bulk Delete",
+		Method:   "GET",
+		Url:      fmt.Sprintf("%s/%s/subscribers", testUrlRoot, networkId),
+		Payload:  "",
+		Expected: "[]",
+	})
+}
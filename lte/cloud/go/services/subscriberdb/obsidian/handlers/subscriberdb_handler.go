@@ -37,6 +37,10 @@ func GetObsidianHandlers() []handlers.Handler {
 		{Path: SubscriberdRootPath, Methods: handlers.PUT, HandlerFunc: updateSubscriberHandler},
 		{Path: SubscriberManagePath, Methods: handlers.PUT, HandlerFunc: updateSubscriberHandler},
 		{Path: SubscriberManagePath, Methods: handlers.DELETE, HandlerFunc: deleteSubscriberHandler},
+		{Path: SubscriberBatchPath, Methods: handlers.POST, HandlerFunc: addSubscribersHandler},
+		{Path: SubscriberBatchPath, Methods: handlers.PUT, HandlerFunc: updateSubscribersHandler},
+		{Path: SubscriberBatchPath, Methods: handlers.DELETE, HandlerFunc: deleteSubscribersHandler},
+		{Path: SubscriberExportPath, Methods: handlers.GET, HandlerFunc: exportSubscribersHandler},
 	}
 }
 
@@ -94,10 +98,20 @@ func listSubscribersHandler(c echo.Context) error {
 }
 
 func listSubscriberIdsHandler(c echo.Context, networkId string) error {
-	subs, err := subscriberdb.ListSubscribers(networkId)
+	pagination, httpErr := handlers.GetPaginationParams(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	subs, nextPageToken, err := subscriberdb.ListSubscribersPage(
+		networkId,
+		pagination.IDPrefix,
+		pagination.PageSize,
+		pagination.PageToken,
+	)
 	if err != nil {
 		return handlers.HttpError(err)
 	}
+	handlers.SetNextPageToken(c, nextPageToken)
 	sidset := make([]models.SubscriberID, len(subs))
 	for i := range subs {
 		sidset[i] = models.SubscriberID(subs[i])
@@ -16,11 +16,15 @@ import (
 
 	lteplugin "magma/lte/cloud/go/plugin"
 	sdb_test_init "magma/lte/cloud/go/services/subscriberdb/test_init"
+	"magma/orc8r/cloud/go/obsidian/audit"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/obsidian/tests"
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
 	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
+	"magma/orc8r/cloud/go/test_utils"
+
+	"github.com/stretchr/testify/assert"
 )
 
 // TestSubscriberd is Obsidian Subscriberd Integration Test intended to be run
@@ -175,6 +179,25 @@ func TestSubscriberd(t *testing.T) {
 		t.Fatalf("Unexpected Response: %s, expected: %s",
 			resp, listSubscribersTestCase.Expected)
 	}
+	// Test Listing Subscribers One Page At A Time
+	listSubscribersTestCase = tests.Testcase{
+		Name:     "List First Page Of Subscribers",
+		Method:   "GET",
+		Url:      fmt.Sprintf("%s/%s/subscribers?page_size=1", testUrlRoot, networkId),
+		Payload:  "",
+		Expected: `["IMSI12333333333"]`,
+	}
+	tests.RunTest(t, listSubscribersTestCase)
+
+	listSubscribersTestCase = tests.Testcase{
+		Name:     "List Subscribers By ID Prefix",
+		Method:   "GET",
+		Url:      fmt.Sprintf("%s/%s/subscribers?id_prefix=IMSI123333444", testUrlRoot, networkId),
+		Payload:  "",
+		Expected: `["IMSI12333344444"]`,
+	}
+	tests.RunTest(t, listSubscribersTestCase)
+
 	// Test Getting Subsriber Data
 	getSubscriberTestCase := tests.Testcase{
 		Name:   "Get Subscriber Data",
@@ -220,6 +243,73 @@ func TestSubscriberd(t *testing.T) {
 	}
 	tests.RunTest(t, getAllSubscribersTestCase)
 
+	// Test Add TUAK Subscriber with a 256 bit key
+	addTuakSubTestCase := tests.Testcase{
+		Name:   "Add TUAK Subscriber",
+		Method: "POST",
+		Url:    fmt.Sprintf("%s/%s/subscribers", testUrlRoot, networkId),
+		Payload: `{"id":"IMSI12333355555", "lte":{"state":"ACTIVE",
+				   "auth_algo":"TUAK",
+				   "auth_key":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+				   "auth_key_bits":256,
+				   "auth_topc":"AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="}}`,
+		Expected: `"IMSI12333355555"`,
+	}
+	tests.RunTest(t, addTuakSubTestCase)
+
+	getTuakSubTestCase := tests.Testcase{
+		Name:   "Get TUAK Subscriber",
+		Method: "GET",
+		Url: fmt.Sprintf(
+			"%s/%s/subscribers/IMSI12333355555", testUrlRoot, networkId),
+		Payload: "",
+		Expected: `{"id":"IMSI12333355555", "lte":{"state":"ACTIVE",
+			"auth_algo":"TUAK",
+			"auth_key":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+			"auth_key_bits":256,
+			"auth_topc":"AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="}}`,
+	}
+	tests.RunTest(t, getTuakSubTestCase)
+
+	// TUAK subscribers must have a TOPc
+	updateTuakSubTestCase := tests.Testcase{
+		Name:   "Update TUAK Subscriber, omit TOPc",
+		Method: "PUT",
+		Url: fmt.Sprintf(
+			"%s/%s/subscribers/IMSI12333355555", testUrlRoot, networkId),
+		Payload: `{"lte":{"state":"ACTIVE", "auth_algo":"TUAK",
+			"auth_key":"AAAAAAAAAAAAAAAAAAAAAA=="}}`,
+		Expected:                  `{"message":"lte auth topc is required for TUAK"}`,
+		Skip_payload_verification: true,
+		Expect_http_error_status:  true,
+	}
+	tests.RunTest(t, updateTuakSubTestCase)
+
+	// 256 bit keys are only supported by TUAK
+	updateTuakSubTestCase = tests.Testcase{
+		Name:   "Update TUAK Subscriber to MILENAGE with a 256 bit key",
+		Method: "PUT",
+		Url: fmt.Sprintf(
+			"%s/%s/subscribers/IMSI12333355555", testUrlRoot, networkId),
+		Payload: `{"lte":{"state":"ACTIVE", "auth_algo":"MILENAGE",
+			"auth_key":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+			"auth_key_bits":256}}`,
+		Expected:                  `{"message":"256 bit lte auth keys are only supported by TUAK"}`,
+		Skip_payload_verification: true,
+		Expect_http_error_status:  true,
+	}
+	tests.RunTest(t, updateTuakSubTestCase)
+
+	deleteSubscriberTestCase = tests.Testcase{
+		Name:   "Delete TUAK Subscriber",
+		Method: "DELETE",
+		Url: fmt.Sprintf(
+			"%s/%s/subscribers/IMSI12333355555", testUrlRoot, networkId),
+		Payload:  "",
+		Expected: "",
+	}
+	tests.RunTest(t, deleteSubscriberTestCase)
+
 	// Test Setting (Updating) Subscriber
 	updateSubscriberTestCase = tests.Testcase{
 		Name:   "Update Subscriber Data",
@@ -268,3 +358,69 @@ func TestSubscriberd(t *testing.T) {
 	}
 	tests.RunTest(t, listSubscribersTestCase)
 }
+
+// TestSubscriberd_AuditLog ensures that the secrets of subscribers added
+// through the REST API never make it into the audit log
+func TestSubscriberd_AuditLog(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &lteplugin.LteOrchestratorPlugin{})
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	magmad_test_init.StartTestService(t)
+	sdb_test_init.StartTestService(t)
+	audit.SetStore(test_utils.NewMockDatastore())
+	defer audit.SetStore(nil)
+
+	restPort := tests.StartObsidian(t)
+	testUrlRoot := fmt.Sprintf(
+		"http://localhost:%d%s/networks", restPort, handlers.REST_ROOT)
+
+	_, networkId, _ := tests.RunTest(t, tests.Testcase{
+		Name:                      "Register Network",
+		Method:                    "POST",
+		Url:                       fmt.Sprintf("%s?requested_id=subscriberdb_audit_test_network", testUrlRoot),
+		Payload:                   `{"name":"This Is A Test Network Name"}`,
+		Skip_payload_verification: true,
+	})
+	json.Unmarshal([]byte(networkId), &networkId)
+
+	tests.RunTest(t, tests.Testcase{
+		Name:   "Add MILENAGE Subscriber",
+		Method: "POST",
+		Url:    fmt.Sprintf("%s/%s/subscribers", testUrlRoot, networkId),
+		Payload: `{"id":"IMSI12333333333", "lte":{"state":"ACTIVE",
+				   "auth_algo":"MILENAGE",
+				   "auth_key":"c2VjcmV0X2tleV8xMjM0NQ==",
+				   "auth_opc":"c2VjcmV0X29wY18xMjM0NQ=="},
+				   "sub_profile":"superfast"}`,
+		Expected: `"IMSI12333333333"`,
+	})
+	tests.RunTest(t, tests.Testcase{
+		Name:   "Add TUAK Subscriber",
+		Method: "POST",
+		Url:    fmt.Sprintf("%s/%s/subscribers", testUrlRoot, networkId),
+		Payload: `{"id":"IMSI12333344444", "lte":{"state":"ACTIVE",
+				   "auth_algo":"TUAK",
+				   "auth_key_bits":256,
+				   "auth_key":"c2VjcmV0X3R1YWtfa2V5XzI1Nl9iaXRzXzEyMzQ1Njc=",
+				   "auth_topc":"c2VjcmV0X3R1YWtfdG9wY18yNTZfYml0c18xMjM0NTY="}}`,
+		Expected: `"IMSI12333344444"`,
+	})
+
+	entries, err := audit.Query(audit.Filter{NetworkID: networkId})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.NotContains(t, string(entry.Body), "c2VjcmV0")
+	}
+	assert.JSONEq(
+		t,
+		`{"id":"IMSI12333344444", "lte":{"state":"ACTIVE", "auth_algo":"TUAK", "auth_key_bits":256,
+		  "auth_key":"[REDACTED]", "auth_topc":"[REDACTED]"}}`,
+		string(entries[0].Body),
+	)
+	assert.JSONEq(
+		t,
+		`{"id":"IMSI12333333333", "lte":{"state":"ACTIVE", "auth_algo":"MILENAGE",
+		  "auth_key":"[REDACTED]", "auth_opc":"[REDACTED]"}, "sub_profile":"superfast"}`,
+		string(entries[1].Body),
+	)
+}
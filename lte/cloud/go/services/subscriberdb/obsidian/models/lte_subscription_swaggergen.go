@@ -20,7 +20,7 @@ import (
 type LteSubscription struct {
 
 	// auth algo
-	// Enum: [MILENAGE]
+	// Enum: [MILENAGE TUAK]
 	AuthAlgo string `json:"auth_algo,omitempty"`
 
 	// auth key
@@ -28,10 +28,18 @@ type LteSubscription struct {
 	// Format: byte
 	AuthKey *strfmt.Base64 `json:"auth_key"`
 
+	// Length of auth_key in bits, 0 means 128. 256 bit keys require TUAK
+	// Enum: [0 128 256]
+	AuthKeyBits uint32 `json:"auth_key_bits,omitempty"`
+
 	// auth opc
 	// Format: byte
 	AuthOpc *strfmt.Base64 `json:"auth_opc,omitempty"`
 
+	// TUAK TOPc, required when auth_algo is TUAK
+	// Format: byte
+	AuthTopc *strfmt.Base64 `json:"auth_topc,omitempty"`
+
 	// state
 	// Enum: [INACTIVE ACTIVE]
 	State string `json:"state,omitempty"`
@@ -49,10 +57,18 @@ func (m *LteSubscription) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAuthKeyBits(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateAuthOpc(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateAuthTopc(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -67,7 +83,7 @@ var lteSubscriptionTypeAuthAlgoPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["MILENAGE"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["MILENAGE","TUAK"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -79,6 +95,9 @@ const (
 
 	// LteSubscriptionAuthAlgoMILENAGE captures enum value "MILENAGE"
 	LteSubscriptionAuthAlgoMILENAGE string = "MILENAGE"
+
+	// LteSubscriptionAuthAlgoTUAK captures enum value "TUAK"
+	LteSubscriptionAuthAlgoTUAK string = "TUAK"
 )
 
 // prop value enum
@@ -114,6 +133,40 @@ func (m *LteSubscription) validateAuthKey(formats strfmt.Registry) error {
 	return nil
 }
 
+var lteSubscriptionTypeAuthKeyBitsPropEnum []interface{}
+
+func init() {
+	var res []uint32
+	if err := json.Unmarshal([]byte(`[0,128,256]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		lteSubscriptionTypeAuthKeyBitsPropEnum = append(lteSubscriptionTypeAuthKeyBitsPropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *LteSubscription) validateAuthKeyBitsEnum(path, location string, value uint32) error {
+	if err := validate.Enum(path, location, value, lteSubscriptionTypeAuthKeyBitsPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *LteSubscription) validateAuthKeyBits(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AuthKeyBits) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateAuthKeyBitsEnum("auth_key_bits", "body", m.AuthKeyBits); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *LteSubscription) validateAuthOpc(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.AuthOpc) { // not required
@@ -125,6 +178,17 @@ func (m *LteSubscription) validateAuthOpc(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *LteSubscription) validateAuthTopc(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AuthTopc) { // not required
+		return nil
+	}
+
+	// Format "byte" (base64 string) is already validated when unmarshalled
+
+	return nil
+}
+
 var lteSubscriptionTypeStatePropEnum []interface{}
 
 func init() {
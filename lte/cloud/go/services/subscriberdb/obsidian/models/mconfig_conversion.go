@@ -21,8 +21,10 @@ import (
 )
 
 const (
-	lteAuthKeyBytes = 16
-	lteAuthOpcBytes = 16
+	lteAuthKeyBytes     = 16
+	lteAuthLongKeyBytes = 32
+	lteAuthOpcBytes     = 16
+	lteAuthTopcBytes    = 32
 )
 
 var sharedFormatsRegistry = strfmt.NewFormats()
@@ -71,6 +73,12 @@ func (sub *Subscriber) FromMconfig(psm proto.Message) error {
 				} else {
 					sub.Lte.AuthOpc = nil
 				}
+				if len(psub.Lte.AuthTopc) > 0 {
+					sub.Lte.AuthTopc = (*strfmt.Base64)(&psub.Lte.AuthTopc)
+				} else {
+					sub.Lte.AuthTopc = nil
+				}
+				sub.Lte.AuthKeyBits = psub.Lte.AuthKeyBits
 			}
 			return sub.Verify()
 		}
@@ -115,6 +123,10 @@ func (sub *Subscriber) ToMconfig(psm proto.Message) error {
 			if sub.Lte.AuthOpc != nil {
 				psub.Lte.AuthOpc = []byte(*sub.Lte.AuthOpc)
 			}
+			if sub.Lte.AuthTopc != nil {
+				psub.Lte.AuthTopc = []byte(*sub.Lte.AuthTopc)
+			}
+			psub.Lte.AuthKeyBits = sub.Lte.AuthKeyBits
 		}
 		psub.Sid = t
 	}
@@ -133,10 +145,17 @@ func (sub *Subscriber) Verify() error {
 	if sub.Lte == nil {
 		return nil
 	}
+	expectedAuthKeyBytes := lteAuthKeyBytes
+	if sub.Lte.AuthKeyBits != 0 {
+		expectedAuthKeyBytes = int(sub.Lte.AuthKeyBits) / 8
+	}
+	if expectedAuthKeyBytes == lteAuthLongKeyBytes && sub.Lte.AuthAlgo != LteSubscriptionAuthAlgoTUAK {
+		return models.ValidateErrorf("%d bit lte auth keys are only supported by %s", sub.Lte.AuthKeyBits, LteSubscriptionAuthAlgoTUAK)
+	}
 	if sub.Lte.AuthKey != nil {
 		authKeyLen := len([]byte(*sub.Lte.AuthKey))
-		if authKeyLen != lteAuthKeyBytes {
-			return models.ValidateErrorf("expected lte auth key to be %d bytes but got %d bytes", lteAuthKeyBytes, authKeyLen)
+		if authKeyLen != expectedAuthKeyBytes {
+			return models.ValidateErrorf("expected lte auth key to be %d bytes but got %d bytes", expectedAuthKeyBytes, authKeyLen)
 		}
 	}
 	if sub.Lte.AuthOpc != nil {
@@ -145,6 +164,14 @@ func (sub *Subscriber) Verify() error {
 			return models.ValidateErrorf("expected lte auth opc to be %d bytes but got %d bytes", lteAuthOpcBytes, authOpcLen)
 		}
 	}
+	if sub.Lte.AuthTopc != nil {
+		authTopcLen := len([]byte(*sub.Lte.AuthTopc))
+		if authTopcLen != lteAuthTopcBytes {
+			return models.ValidateErrorf("expected lte auth topc to be %d bytes but got %d bytes", lteAuthTopcBytes, authTopcLen)
+		}
+	} else if sub.Lte.AuthAlgo == LteSubscriptionAuthAlgoTUAK {
+		return models.ValidateErrorf("lte auth topc is required for %s", LteSubscriptionAuthAlgoTUAK)
+	}
 	return nil
 }
 
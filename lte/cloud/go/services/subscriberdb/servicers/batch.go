@@ -0,0 +1,235 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"regexp"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb"
+	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	lteAuthKeyBytes     = 16
+	lteAuthLongKeyBytes = 32
+	lteAuthOpcBytes     = 16
+	lteAuthTopcBytes    = 32
+)
+
+var imsiRegex = regexp.MustCompile(`^\d{10,15}$`)
+
+// AddSubscribers adds a batch of new subscribers. Every row is validated
+// before any is written, so an invalid row leaves the store untouched.
+// Subscribers are only inserted, so a subscriber which is added concurrently
+// is never overwritten. Rows are written in chunks which are not rolled back
+// if a later chunk fails: the result of each row tells whether it was written.
+func (srv *SubscriberDBServer) AddSubscribers(
+	ctx context.Context,
+	batch *protos.SubscriberBatch,
+) (*protos.SubscriberBatchResponse, error) {
+	return srv.putSubscribers(batch, false)
+}
+
+// UpdateSubscribers replaces the data of a batch of existing subscribers.
+// Every row is validated before any is written, so an invalid row leaves the
+// store untouched. A subscriber which is deleted or modified concurrently is
+// not overwritten and its row fails. Rows are written in chunks which are not
+// rolled back if a later chunk fails: the result of each row tells whether it
+// was written.
+func (srv *SubscriberDBServer) UpdateSubscribers(
+	ctx context.Context,
+	batch *protos.SubscriberBatch,
+) (*protos.SubscriberBatchResponse, error) {
+	return srv.putSubscribers(batch, true)
+}
+
+// DeleteSubscribers deletes a batch of subscribers. Subscribers which are not
+// present are ignored.
+func (srv *SubscriberDBServer) DeleteSubscribers(
+	ctx context.Context,
+	batch *protos.SubscriberIDBatch,
+) (*protos.SubscriberBatchResponse, error) {
+	if batch.GetNetworkId() == nil {
+		return nil, fmt.Errorf("No network ID provided")
+	}
+
+	results := make([]*protos.SubscriberBatchResult, len(batch.Sids))
+	sids := make([]string, 0, len(batch.Sids))
+	for i, sid := range batch.Sids {
+		results[i] = &protos.SubscriberBatchResult{Row: uint32(i), Sid: sid}
+		if sid == nil {
+			setInvalid(results[i], fmt.Errorf("No subscriber ID provided"))
+			continue
+		}
+		sids = append(sids, protos.SidString(sid))
+	}
+	if skipIfInvalid(results) {
+		return &protos.SubscriberBatchResponse{Results: results}, nil
+	}
+
+	failed := srv.store.DeleteSubscribers(batch.NetworkId.Id, sids)
+	srv.setWriteResults(batch.NetworkId.Id, results, failed)
+	return &protos.SubscriberBatchResponse{Results: results}, nil
+}
+
+// ExportSubscribers streams all subscriber data of the network in ascending
+// order of the subscriber IDs.
+func (srv *SubscriberDBServer) ExportSubscribers(
+	networkID *orcprotos.NetworkID,
+	stream protos.SubscriberDBController_ExportSubscribersServer,
+) error {
+	if networkID == nil {
+		return fmt.Errorf("No network ID provided")
+	}
+	return srv.store.ExportSubscribers(networkID, stream.Send)
+}
+
+func (srv *SubscriberDBServer) putSubscribers(batch *protos.SubscriberBatch, update bool) (*protos.SubscriberBatchResponse, error) {
+	if batch.GetNetworkId() == nil {
+		return nil, fmt.Errorf("No network ID provided")
+	}
+	networkID := batch.NetworkId.Id
+
+	results := make([]*protos.SubscriberBatchResult, len(batch.Subscribers))
+	rowsBySid := make(map[string]int, len(batch.Subscribers))
+	for i, sub := range batch.Subscribers {
+		results[i] = &protos.SubscriberBatchResult{Row: uint32(i), Sid: sub.GetSid()}
+		if err := validateBatchSubscriber(sub, networkID); err != nil {
+			setInvalid(results[i], err)
+			continue
+		}
+		sid := protos.SidString(sub.Sid)
+		if row, ok := rowsBySid[sid]; ok {
+			setInvalid(results[i], fmt.Errorf("Subscriber %s is already in row %d", sid, row))
+			continue
+		}
+		rowsBySid[sid] = i
+	}
+
+	sids := make([]string, 0, len(rowsBySid))
+	for sid := range rowsBySid {
+		sids = append(sids, sid)
+	}
+	generations, err := srv.store.GetSubscriberGenerations(networkID, sids)
+	if err != nil {
+		return nil, err
+	}
+	for sid, row := range rowsBySid {
+		_, exists := generations[sid]
+		if update && !exists {
+			setInvalid(results[row], fmt.Errorf("Subscriber %s does not exist", sid))
+		} else if !update && exists {
+			setInvalid(results[row], fmt.Errorf("Subscriber %s already exists", sid))
+		}
+	}
+	if skipIfInvalid(results) {
+		return &protos.SubscriberBatchResponse{Results: results}, nil
+	}
+
+	for _, sub := range batch.Subscribers {
+		sub.NetworkId = batch.NetworkId
+	}
+	var failed map[string]error
+	if update {
+		failed = srv.store.UpdateSubscribers(networkID, batch.Subscribers, generations)
+	} else {
+		failed = srv.store.AddSubscribers(networkID, batch.Subscribers)
+	}
+	srv.setWriteResults(networkID, results, failed)
+	return &protos.SubscriberBatchResponse{Results: results}, nil
+}
+
+// setWriteResults marks the rows whose write failed and notifies the
+// subscriber stream if any row was written.
+func (srv *SubscriberDBServer) setWriteResults(networkID string, results []*protos.SubscriberBatchResult, failed map[string]error) {
+	for _, result := range results {
+		if err, ok := failed[protos.SidString(result.Sid)]; ok {
+			result.Status = protos.SubscriberBatchResult_FAILED
+			result.Error = err.Error()
+		}
+	}
+	if len(failed) < len(results) {
+		streamer.NotifyStreamUpdateAsync(subscriberdb.StreamName, networkID)
+	}
+}
+
+// skipIfInvalid marks all valid rows as skipped if any row is invalid, and
+// returns whether it did so.
+func skipIfInvalid(results []*protos.SubscriberBatchResult) bool {
+	invalid := 0
+	for _, result := range results {
+		if result.Status == protos.SubscriberBatchResult_INVALID {
+			invalid++
+		}
+	}
+	if invalid == 0 {
+		return false
+	}
+	for _, result := range results {
+		if result.Status != protos.SubscriberBatchResult_INVALID {
+			result.Status = protos.SubscriberBatchResult_SKIPPED
+			result.Error = fmt.Sprintf("Batch not written, %d rows are invalid", invalid)
+		}
+	}
+	return true
+}
+
+func setInvalid(result *protos.SubscriberBatchResult, err error) {
+	result.Status = protos.SubscriberBatchResult_INVALID
+	result.Error = err.Error()
+}
+
+func validateBatchSubscriber(sub *protos.SubscriberData, networkID string) error {
+	if sub == nil {
+		return fmt.Errorf("No subscriber data provided")
+	}
+	if sub.GetSid() == nil {
+		return fmt.Errorf("No subscriber ID provided")
+	}
+	if sub.Sid.Type != protos.SubscriberID_IMSI || !imsiRegex.MatchString(sub.Sid.Id) {
+		return fmt.Errorf("Invalid IMSI: %s", sub.Sid.Id)
+	}
+	if sub.GetNetworkId() != nil && sub.NetworkId.Id != networkID {
+		return fmt.Errorf("Subscriber network ID %s does not match the batch network ID %s", sub.NetworkId.Id, networkID)
+	}
+	return validateLteSubscription(sub.Lte)
+}
+
+// validateLteSubscription checks the lengths of the subscription's keys.
+func validateLteSubscription(lte *protos.LTESubscription) error {
+	if lte == nil {
+		return nil
+	}
+	expectedAuthKeyBytes := lteAuthKeyBytes
+	switch lte.AuthKeyBits {
+	case 0:
+	case 8 * lteAuthKeyBytes, 8 * lteAuthLongKeyBytes:
+		expectedAuthKeyBytes = int(lte.AuthKeyBits) / 8
+	default:
+		return fmt.Errorf("Unsupported lte auth key length: %d bits", lte.AuthKeyBits)
+	}
+	if expectedAuthKeyBytes == lteAuthLongKeyBytes && lte.AuthAlgo != protos.LTESubscription_TUAK {
+		return fmt.Errorf("%d bit lte auth keys are only supported by TUAK", lte.AuthKeyBits)
+	}
+	if len(lte.AuthKey) != expectedAuthKeyBytes {
+		return fmt.Errorf("Expected lte auth key to be %d bytes, but got %d bytes", expectedAuthKeyBytes, len(lte.AuthKey))
+	}
+	if len(lte.AuthOpc) != 0 && len(lte.AuthOpc) != lteAuthOpcBytes {
+		return fmt.Errorf("Expected lte auth opc to be %d bytes, but got %d bytes", lteAuthOpcBytes, len(lte.AuthOpc))
+	}
+	if lte.AuthAlgo == protos.LTESubscription_TUAK && len(lte.AuthTopc) != lteAuthTopcBytes {
+		return fmt.Errorf("Expected lte auth topc to be %d bytes, but got %d bytes", lteAuthTopcBytes, len(lte.AuthTopc))
+	}
+	return nil
+}
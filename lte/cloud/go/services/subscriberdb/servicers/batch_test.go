@@ -0,0 +1,147 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"testing"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb/servicers"
+	"magma/lte/cloud/go/services/subscriberdb/storage"
+	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/test_utils"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type exportStream struct {
+	grpc.ServerStream
+	sids []string
+}
+
+func (s *exportStream) Send(sub *protos.SubscriberData) error {
+	s.sids = append(s.sids, protos.SidString(sub.Sid))
+	return nil
+}
+
+func TestSubscriberdbBatch(t *testing.T) {
+	store, err := storage.NewSubscriberDBStorageWithChunkSize(test_utils.NewMockDatastore(), 2)
+	assert.NoError(t, err)
+	srv, err := servicers.NewSubscriberDBServer(store)
+	assert.NoError(t, err)
+	ctx := context.Background()
+	networkId := &orcprotos.NetworkID{Id: "test"}
+
+	newSub := func(id string) *protos.SubscriberData {
+		return &protos.SubscriberData{
+			Sid: &protos.SubscriberID{Id: id},
+			Lte: &protos.LTESubscription{
+				State:   protos.LTESubscription_ACTIVE,
+				AuthKey: make([]byte, 16),
+				AuthOpc: make([]byte, 16),
+			},
+		}
+	}
+
+	_, err = srv.AddSubscribers(ctx, &protos.SubscriberBatch{})
+	assert.Error(t, err)
+
+	// Add a batch
+	res, err := srv.AddSubscribers(ctx, &protos.SubscriberBatch{
+		NetworkId:   networkId,
+		Subscribers: []*protos.SubscriberData{newSub("001010000000001"), newSub("001010000000002"), newSub("001010000000003")},
+	})
+	assert.NoError(t, err)
+	assertBatchStatuses(t, res, protos.SubscriberBatchResult_OK, protos.SubscriberBatchResult_OK, protos.SubscriberBatchResult_OK)
+	sids, err := srv.ListSubscribers(ctx, networkId)
+	assert.NoError(t, err)
+	assert.Len(t, sids.Sids, 3)
+
+	// No row is written if any row is invalid
+	badKey := newSub("001010000000005")
+	badKey.Lte.AuthKey = make([]byte, 15)
+	res, err = srv.AddSubscribers(ctx, &protos.SubscriberBatch{
+		NetworkId: networkId,
+		Subscribers: []*protos.SubscriberData{
+			newSub("001010000000004"),
+			newSub("001010000000001"),
+			badKey,
+			newSub("1234"),
+			newSub("001010000000004"),
+		},
+	})
+	assert.NoError(t, err)
+	assertBatchStatuses(
+		t,
+		res,
+		protos.SubscriberBatchResult_SKIPPED,
+		protos.SubscriberBatchResult_INVALID,
+		protos.SubscriberBatchResult_INVALID,
+		protos.SubscriberBatchResult_INVALID,
+		protos.SubscriberBatchResult_INVALID,
+	)
+	assert.Equal(t, "Batch not written, 4 rows are invalid", res.Results[0].Error)
+	assert.Equal(t, "Subscriber IMSI001010000000001 already exists", res.Results[1].Error)
+	assert.Equal(t, "Expected lte auth key to be 16 bytes, but got 15 bytes", res.Results[2].Error)
+	assert.Equal(t, "Invalid IMSI: 1234", res.Results[3].Error)
+	assert.Equal(t, "Subscriber IMSI001010000000004 is already in row 0", res.Results[4].Error)
+	sids, err = srv.ListSubscribers(ctx, networkId)
+	assert.NoError(t, err)
+	assert.Len(t, sids.Sids, 3)
+
+	// Update requires all subscribers to exist
+	res, err = srv.UpdateSubscribers(ctx, &protos.SubscriberBatch{
+		NetworkId:   networkId,
+		Subscribers: []*protos.SubscriberData{newSub("001010000000001"), newSub("001010000000004")},
+	})
+	assert.NoError(t, err)
+	assertBatchStatuses(t, res, protos.SubscriberBatchResult_SKIPPED, protos.SubscriberBatchResult_INVALID)
+	assert.Equal(t, "Subscriber IMSI001010000000004 does not exist", res.Results[1].Error)
+
+	updated := newSub("001010000000002")
+	updated.Lte.State = protos.LTESubscription_INACTIVE
+	res, err = srv.UpdateSubscribers(ctx, &protos.SubscriberBatch{
+		NetworkId:   networkId,
+		Subscribers: []*protos.SubscriberData{newSub("001010000000001"), updated},
+	})
+	assert.NoError(t, err)
+	assertBatchStatuses(t, res, protos.SubscriberBatchResult_OK, protos.SubscriberBatchResult_OK)
+	data, err := srv.GetSubscriberData(ctx, &protos.SubscriberLookup{NetworkId: networkId, Sid: updated.Sid})
+	assert.NoError(t, err)
+	assert.Equal(t, protos.LTESubscription_INACTIVE, data.Lte.State)
+	assert.Equal(t, "test", data.NetworkId.Id)
+
+	// Export streams all subscribers in order
+	stream := &exportStream{}
+	err = srv.ExportSubscribers(networkId, stream)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI001010000000001", "IMSI001010000000002", "IMSI001010000000003"}, stream.sids)
+
+	// Delete a batch
+	res, err = srv.DeleteSubscribers(ctx, &protos.SubscriberIDBatch{
+		NetworkId: networkId,
+		Sids:      []*protos.SubscriberID{{Id: "001010000000001"}, {Id: "001010000000003"}},
+	})
+	assert.NoError(t, err)
+	assertBatchStatuses(t, res, protos.SubscriberBatchResult_OK, protos.SubscriberBatchResult_OK)
+	sids, err = srv.ListSubscribers(ctx, networkId)
+	assert.NoError(t, err)
+	assert.Equal(t, []*protos.SubscriberID{{Id: "001010000000002"}}, sids.Sids)
+}
+
+func assertBatchStatuses(t *testing.T, res *protos.SubscriberBatchResponse, expected ...protos.SubscriberBatchResult_Status) {
+	var actual []protos.SubscriberBatchResult_Status
+	for i, result := range res.GetResults() {
+		assert.Equal(t, uint32(i), result.Row)
+		actual = append(actual, result.Status)
+	}
+	assert.Equal(t, expected, actual)
+}
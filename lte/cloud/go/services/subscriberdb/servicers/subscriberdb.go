@@ -19,8 +19,10 @@ import (
 	"fmt"
 
 	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb"
 	"magma/lte/cloud/go/services/subscriberdb/storage"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer"
 
 	"golang.org/x/net/context"
 )
@@ -43,7 +45,11 @@ func (srv *SubscriberDBServer) AddSubscriber(
 	if err := validateSubscriberData(subs); err != nil {
 		return nil, err
 	}
-	return srv.store.AddSubscriber(subs)
+	ret, err := srv.store.AddSubscriber(subs)
+	if err == nil {
+		streamer.NotifyStreamUpdateAsync(subscriberdb.StreamName, subs.GetNetworkId().GetId())
+	}
+	return ret, err
 }
 
 func (srv *SubscriberDBServer) DeleteSubscriber(
@@ -53,7 +59,11 @@ func (srv *SubscriberDBServer) DeleteSubscriber(
 	if err := validateSubscriberLookup(lookup); err != nil {
 		return nil, err
 	}
-	return srv.store.DeleteSubscriber(lookup)
+	ret, err := srv.store.DeleteSubscriber(lookup)
+	if err == nil {
+		streamer.NotifyStreamUpdateAsync(subscriberdb.StreamName, lookup.GetNetworkId().GetId())
+	}
+	return ret, err
 }
 
 func (srv *SubscriberDBServer) UpdateSubscriber(
@@ -63,7 +73,11 @@ func (srv *SubscriberDBServer) UpdateSubscriber(
 	if err := validateSubscriberData(subs); err != nil {
 		return nil, err
 	}
-	return srv.store.UpdateSubscriber(subs)
+	ret, err := srv.store.UpdateSubscriber(subs)
+	if err == nil {
+		streamer.NotifyStreamUpdateAsync(subscriberdb.StreamName, subs.GetNetworkId().GetId())
+	}
+	return ret, err
 }
 
 func (srv *SubscriberDBServer) GetSubscriberData(
@@ -86,6 +100,16 @@ func (srv *SubscriberDBServer) ListSubscribers(
 	return srv.store.ListSubscribers(networkID)
 }
 
+func (srv *SubscriberDBServer) ListSubscribersPage(
+	ctx context.Context,
+	req *protos.ListSubscribersRequest,
+) (*protos.ListSubscribersResponse, error) {
+	if req.GetNetworkId() == nil {
+		return nil, fmt.Errorf("No network ID provided")
+	}
+	return srv.store.ListSubscribersPage(req)
+}
+
 func (srv *SubscriberDBServer) GetAllSubscriberData(
 	ctx context.Context,
 	networkID *orcprotos.NetworkID,
@@ -96,6 +120,23 @@ func (srv *SubscriberDBServer) GetAllSubscriberData(
 	return srv.store.GetAllSubscriberData(networkID)
 }
 
+// ReencryptSubscribers re-encrypts the network's subscribers with the current
+// key. The subscriber data served to gateways does not change, so the
+// subscriber stream is not notified.
+func (srv *SubscriberDBServer) ReencryptSubscribers(
+	ctx context.Context,
+	networkID *orcprotos.NetworkID,
+) (*protos.ReencryptSubscribersResponse, error) {
+	if networkID == nil {
+		return nil, fmt.Errorf("No network ID provided")
+	}
+	reencrypted, err := srv.store.ReencryptSubscribers(networkID)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.ReencryptSubscribersResponse{Reencrypted: reencrypted}, nil
+}
+
 func validateSubscriberLookup(lookup *protos.SubscriberLookup) error {
 	if lookup == nil {
 		return fmt.Errorf("No subscriber data provided")
@@ -13,6 +13,7 @@ import (
 	"sort"
 
 	lteprotos "magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/protos"
 
@@ -22,10 +23,20 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-const SubscribersTable = "subscriberdb"
+const (
+	SubscribersTable = "subscriberdb"
+
+	// DefaultChunkSize is the default number of subscribers read or written
+	// per datastore call by the batch operations
+	DefaultChunkSize = 1000
+)
 
 type SubscriberDBStorage struct {
-	store datastore.Api
+	store     datastore.Api
+	chunkSize int
+	// cipher encrypts the subscribers' secret key fields at rest. Secrets
+	// are stored in plaintext if it is nil.
+	cipher *encryption.SecretsCipher
 }
 
 func getSubscriberDBTableName(networkID string) string {
@@ -33,10 +44,28 @@ func getSubscriberDBTableName(networkID string) string {
 }
 
 func NewSubscriberDBStorage(ds datastore.Api) (*SubscriberDBStorage, error) {
+	return NewSubscriberDBStorageWithChunkSize(ds, DefaultChunkSize)
+}
+
+// NewSubscriberDBStorageWithChunkSize returns a SubscriberDBStorage which
+// reads and writes at most chunkSize subscribers per datastore call in its
+// batch operations.
+func NewSubscriberDBStorageWithChunkSize(ds datastore.Api, chunkSize int) (*SubscriberDBStorage, error) {
+	return NewSubscriberDBStorageWithCipher(ds, chunkSize, nil)
+}
+
+// NewSubscriberDBStorageWithCipher returns a SubscriberDBStorage which
+// encrypts the subscribers' secret key fields with cipher when writing them
+// and decrypts them when reading them. Subscribers written before encryption
+// was enabled are read as they are. A nil cipher disables encryption.
+func NewSubscriberDBStorageWithCipher(ds datastore.Api, chunkSize int, cipher *encryption.SecretsCipher) (*SubscriberDBStorage, error) {
 	if ds == nil {
 		return nil, fmt.Errorf("Nil SubscriberDBStorage datastore")
 	}
-	s := &SubscriberDBStorage{ds}
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("Chunk size must be positive, but got %d", chunkSize)
+	}
+	s := &SubscriberDBStorage{store: ds, chunkSize: chunkSize, cipher: cipher}
 	return s, nil
 }
 
@@ -52,7 +81,7 @@ func (s *SubscriberDBStorage) AddSubscriber(subs *lteprotos.SubscriberData) (*pr
 	}
 
 	// Marshal the protobuf and store the byte stream in the Datastore
-	value, err := proto.Marshal(subs)
+	value, err := s.marshalSubscriber(subs)
 	if err != nil {
 		errMsg := fmt.Sprintf("Marshalling error on sid: %s, %s", sid, err)
 		glog.Error(errMsg)
@@ -87,7 +116,7 @@ func (s *SubscriberDBStorage) UpdateSubscriber(subs *lteprotos.SubscriberData) (
 	glog.V(2).Info("Updating subscriber ", sid, " in ", table)
 
 	// Marshal the protobuf and store the byte stream in the Datastore
-	value, err := proto.Marshal(subs)
+	value, err := s.marshalSubscriber(subs)
 	if err != nil {
 		errMsg := fmt.Sprintf("Marshalling error on sid: %s, %s", sid, err)
 		glog.Error(errMsg)
@@ -118,7 +147,7 @@ func (s *SubscriberDBStorage) GetSubscriberData(lookup *lteprotos.SubscriberLook
 		}
 		return nil, status.Error(codes.Aborted, errMsg)
 	}
-	if err = proto.Unmarshal(value, &subs); err != nil {
+	if err = s.unmarshalSubscriber(value, &subs); err != nil {
 		errMsg := fmt.Sprintf("Unmarshalling error on sid: %s, %s", sid, err)
 		glog.Error(errMsg)
 		return nil, status.Error(codes.Aborted, errMsg)
@@ -146,6 +175,31 @@ func (s *SubscriberDBStorage) ListSubscribers(networkID *protos.NetworkID) (*lte
 	return &lteprotos.SubscriberIDSet{Sids: sids}, nil
 }
 
+// ListSubscribersPage lists a page of the network's subscriber IDs matching
+// the request's ID prefix in ascending order
+func (s *SubscriberDBStorage) ListSubscribersPage(req *lteprotos.ListSubscribersRequest) (*lteprotos.ListSubscribersResponse, error) {
+	networkID := req.GetNetworkId().GetId()
+	keys, nextPageToken, err := s.store.ListKeysPage(
+		getSubscriberDBTableName(networkID),
+		datastore.ListKeysCriteria{KeyPrefix: req.IdPrefix, PageSize: req.PageSize, PageToken: req.PageToken},
+	)
+	if err != nil {
+		errMsg := fmt.Sprintf("Error listing Subscribers %s, for network: %s", err, networkID)
+		glog.Error(errMsg)
+		return nil, status.Error(codes.Aborted, errMsg)
+	}
+
+	sids := make([]*lteprotos.SubscriberID, 0, len(keys))
+	for _, key := range keys {
+		if sid, err := lteprotos.SidProto(key); err == nil {
+			sids = append(sids, sid)
+		} else {
+			glog.Warningf("Unable to convert sid %s string to proto struct", key)
+		}
+	}
+	return &lteprotos.ListSubscribersResponse{Sids: sids, NextPageToken: nextPageToken}, nil
+}
+
 func (s *SubscriberDBStorage) GetAllSubscriberData(networkID *protos.NetworkID) (*lteprotos.GetAllSubscriberDataResponse, error) {
 	table := getSubscriberDBTableName(networkID.Id)
 	sids, err := s.store.ListKeys(table)
@@ -166,7 +220,7 @@ func (s *SubscriberDBStorage) GetAllSubscriberData(networkID *protos.NetworkID)
 	for _, sid := range *getSortedKeys(&subscribersBySid) {
 		marshaledDatum := subscribersBySid[sid].Value
 		sub := &lteprotos.SubscriberData{}
-		if err = proto.Unmarshal(marshaledDatum, sub); err != nil {
+		if err = s.unmarshalSubscriber(marshaledDatum, sub); err != nil {
 			msg := fmt.Sprintf("Could not unmarshal subscriber data: %s", err)
 			glog.Error(msg)
 			return nil, status.Error(codes.Aborted, msg)
@@ -176,6 +230,308 @@ func (s *SubscriberDBStorage) GetAllSubscriberData(networkID *protos.NetworkID)
 	return &lteprotos.GetAllSubscriberDataResponse{Subscribers: allSubProtos}, nil
 }
 
+// GetSubscriberGenerations returns the datastore generations of the given
+// subscriber ID strings (e.g. IMSI001010000000001) which are present in the
+// network, keyed by subscriber ID string.
+func (s *SubscriberDBStorage) GetSubscriberGenerations(networkID string, sids []string) (map[string]uint64, error) {
+	table := getSubscriberDBTableName(networkID)
+	generations := make(map[string]uint64)
+	for _, chunk := range chunkKeys(sids, s.chunkSize) {
+		valuesBySid, err := s.store.GetMany(table, chunk)
+		if err != nil {
+			errMsg := fmt.Sprintf("Error fetching subscribers: %s", err)
+			glog.Error(errMsg)
+			return nil, status.Error(codes.Aborted, errMsg)
+		}
+		for sid, wrapper := range valuesBySid {
+			generations[sid] = wrapper.Generation
+		}
+	}
+	return generations, nil
+}
+
+// AddSubscribers adds new subscribers to the network in chunks. Subscribers
+// which already exist are left untouched. It returns the errors of the
+// subscribers which could not be added, keyed by subscriber ID string.
+// Chunks are not written atomically, so subscribers added before a failure
+// stay added.
+func (s *SubscriberDBStorage) AddSubscribers(networkID string, subs []*lteprotos.SubscriberData) map[string]error {
+	table := getSubscriberDBTableName(networkID)
+	glog.V(2).Infof("Adding %d subscribers to %s", len(subs), table)
+
+	return s.writeSubscribers(subs, func(values map[string][]byte) (map[string]error, error) {
+		errs, err := s.store.InsertMany(table, values)
+		for sid, err := range errs {
+			if datastore.IsErrAlreadyExists(err) {
+				errs[sid] = fmt.Errorf("Subscriber %s already exists", sid)
+			}
+		}
+		return errs, err
+	})
+}
+
+// UpdateSubscribers replaces the data of existing subscribers of the network
+// in chunks. Each subscriber is only written if its generation is still the
+// one in generations, so subscribers which were deleted or modified since
+// their generation was read are left untouched. It returns the errors of the
+// subscribers which could not be updated, keyed by subscriber ID string.
+// Chunks are not written atomically, so subscribers updated before a failure
+// stay updated.
+func (s *SubscriberDBStorage) UpdateSubscribers(networkID string, subs []*lteprotos.SubscriberData, generations map[string]uint64) map[string]error {
+	table := getSubscriberDBTableName(networkID)
+	glog.V(2).Infof("Updating %d subscribers in %s", len(subs), table)
+
+	return s.writeSubscribers(subs, func(values map[string][]byte) (map[string]error, error) {
+		wrappers := make(map[string]datastore.ValueWrapper, len(values))
+		for sid, value := range values {
+			wrappers[sid] = datastore.ValueWrapper{Value: value, Generation: generations[sid]}
+		}
+		errs, err := s.store.PutManyIfGeneration(table, wrappers)
+		for sid, err := range errs {
+			switch {
+			case datastore.IsErrNotFound(err):
+				errs[sid] = fmt.Errorf("Subscriber %s does not exist", sid)
+			case datastore.IsErrGenerationConflict(err):
+				errs[sid] = fmt.Errorf("Subscriber %s was modified concurrently", sid)
+			}
+		}
+		return errs, err
+	})
+}
+
+// writeSubscribers marshals the subscribers and writes them one chunk at a
+// time with write. It returns the errors of the subscribers which could not
+// be written, keyed by subscriber ID string.
+func (s *SubscriberDBStorage) writeSubscribers(
+	subs []*lteprotos.SubscriberData,
+	write func(values map[string][]byte) (map[string]error, error),
+) map[string]error {
+	failed := make(map[string]error)
+	for start := 0; start < len(subs); start += s.chunkSize {
+		end := start + s.chunkSize
+		if end > len(subs) {
+			end = len(subs)
+		}
+		values := make(map[string][]byte, end-start)
+		for _, sub := range subs[start:end] {
+			sid := lteprotos.SidString(sub.Sid)
+			value, err := s.marshalSubscriber(sub)
+			if err != nil {
+				failed[sid] = fmt.Errorf("Marshalling error on sid: %s, %s", sid, err)
+				continue
+			}
+			values[sid] = value
+		}
+
+		errs, err := write(values)
+		if err != nil {
+			glog.Errorf("Error writing subscribers: %s", err)
+			for sid := range values {
+				failed[sid] = err
+			}
+			continue
+		}
+		for sid, err := range errs {
+			failed[sid] = err
+		}
+	}
+	return failed
+}
+
+// DeleteSubscribers deletes the subscribers of the network in chunks. It
+// returns the errors of the subscribers which could not be deleted, keyed by
+// subscriber ID string.
+func (s *SubscriberDBStorage) DeleteSubscribers(networkID string, sids []string) map[string]error {
+	table := getSubscriberDBTableName(networkID)
+	glog.V(2).Infof("Deleting %d subscribers from %s", len(sids), table)
+
+	failed := make(map[string]error)
+	for _, chunk := range chunkKeys(sids, s.chunkSize) {
+		errs, err := s.store.DeleteMany(table, chunk)
+		if err != nil {
+			glog.Errorf("Error deleting subscribers from %s: %s", table, err)
+			for _, sid := range chunk {
+				failed[sid] = err
+			}
+			continue
+		}
+		for sid, err := range errs {
+			failed[sid] = err
+		}
+	}
+	return failed
+}
+
+// ExportSubscribers calls send for each subscriber of the network in
+// ascending order of the subscriber IDs. Subscribers are read one chunk at a
+// time, so the network's subscribers are never all held in memory.
+func (s *SubscriberDBStorage) ExportSubscribers(networkID *protos.NetworkID, send func(*lteprotos.SubscriberData) error) error {
+	table := getSubscriberDBTableName(networkID.Id)
+	criteria := datastore.ListKeysCriteria{PageSize: uint32(s.chunkSize)}
+	for {
+		sids, nextPageToken, err := s.store.ListKeysPage(table, criteria)
+		if err != nil {
+			msg := fmt.Sprintf("Error listing subscriber IDs: %s", err)
+			glog.Error(msg)
+			return status.Error(codes.Aborted, msg)
+		}
+		subscribersBySid, err := s.store.GetMany(table, sids)
+		if err != nil {
+			msg := fmt.Sprintf("Error getting subscribers: %s", err)
+			glog.Error(msg)
+			return status.Error(codes.Aborted, msg)
+		}
+		for _, sid := range *getSortedKeys(&subscribersBySid) {
+			sub := &lteprotos.SubscriberData{}
+			if err = s.unmarshalSubscriber(subscribersBySid[sid].Value, sub); err != nil {
+				msg := fmt.Sprintf("Could not unmarshal subscriber data: %s", err)
+				glog.Error(msg)
+				return status.Error(codes.Aborted, msg)
+			}
+			if err = send(sub); err != nil {
+				return err
+			}
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		criteria.PageToken = nextPageToken
+	}
+}
+
+// ReencryptSubscribers encrypts the secrets of the network's subscribers
+// which are not encrypted with the cipher's current key, e.g. after a key
+// rotation or for subscribers written before encryption was enabled. It runs
+// alongside other writes: a subscriber which is written concurrently is
+// already encrypted with the current key, so it is left as is. It returns
+// the number of re-encrypted subscribers.
+func (s *SubscriberDBStorage) ReencryptSubscribers(networkID *protos.NetworkID) (uint32, error) {
+	if s.cipher == nil {
+		return 0, status.Error(codes.FailedPrecondition, "Subscriber encryption is not enabled")
+	}
+	table := getSubscriberDBTableName(networkID.Id)
+	currentKeyID := s.cipher.GetCurrentKeyID()
+	glog.Infof("Re-encrypting subscribers in %s with key %s", table, currentKeyID)
+
+	reencrypted, failed := uint32(0), 0
+	criteria := datastore.ListKeysCriteria{PageSize: uint32(s.chunkSize)}
+	for {
+		sids, nextPageToken, err := s.store.ListKeysPage(table, criteria)
+		if err != nil {
+			msg := fmt.Sprintf("Error listing subscriber IDs: %s", err)
+			glog.Error(msg)
+			return reencrypted, status.Error(codes.Aborted, msg)
+		}
+		subscribersBySid, err := s.store.GetMany(table, sids)
+		if err != nil {
+			msg := fmt.Sprintf("Error getting subscribers: %s", err)
+			glog.Error(msg)
+			return reencrypted, status.Error(codes.Aborted, msg)
+		}
+
+		values := make(map[string]datastore.ValueWrapper, len(subscribersBySid))
+		for sid, wrapper := range subscribersBySid {
+			sub := &lteprotos.SubscriberData{}
+			if err = proto.Unmarshal(wrapper.Value, sub); err != nil {
+				glog.Errorf("Could not unmarshal subscriber %s: %s", sid, err)
+				failed++
+				continue
+			}
+			if sub.GetEncryptedSecrets().GetKeyId() == currentKeyID {
+				continue
+			}
+			if err = s.cipher.Decrypt(sub); err != nil {
+				glog.Errorf("Could not decrypt subscriber %s: %s", sid, err)
+				failed++
+				continue
+			}
+			value, err := s.marshalSubscriber(sub)
+			if err != nil {
+				glog.Errorf("Could not encrypt subscriber %s: %s", sid, err)
+				failed++
+				continue
+			}
+			values[sid] = datastore.ValueWrapper{Value: value, Generation: wrapper.Generation}
+		}
+
+		errs, err := s.store.PutManyIfGeneration(table, values)
+		if err != nil {
+			msg := fmt.Sprintf("Error writing subscribers: %s", err)
+			glog.Error(msg)
+			return reencrypted, status.Error(codes.Aborted, msg)
+		}
+		for sid := range values {
+			err, ok := errs[sid]
+			switch {
+			case !ok:
+				reencrypted++
+			case datastore.IsErrGenerationConflict(err) || datastore.IsErrNotFound(err):
+				glog.V(2).Infof("Subscriber %s changed while re-encrypting it: %s", sid, err)
+			default:
+				glog.Errorf("Error writing subscriber %s: %s", sid, err)
+				failed++
+			}
+		}
+
+		if nextPageToken == "" {
+			break
+		}
+		criteria.PageToken = nextPageToken
+	}
+
+	glog.Infof("Re-encrypted %d subscribers in %s", reencrypted, table)
+	if failed > 0 {
+		msg := fmt.Sprintf("Failed to re-encrypt %d subscribers in network %s", failed, networkID.Id)
+		return reencrypted, status.Error(codes.Aborted, msg)
+	}
+	return reencrypted, nil
+}
+
+// marshalSubscriber marshals the subscriber for the datastore, encrypting
+// its secret key fields if encryption is enabled.
+func (s *SubscriberDBStorage) marshalSubscriber(sub *lteprotos.SubscriberData) ([]byte, error) {
+	if s.cipher == nil {
+		if sub.EncryptedSecrets != nil {
+			sub = proto.Clone(sub).(*lteprotos.SubscriberData)
+			sub.EncryptedSecrets = nil
+		}
+		return proto.Marshal(sub)
+	}
+	encrypted, err := s.cipher.Encrypt(sub)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(encrypted)
+}
+
+// unmarshalSubscriber unmarshals a subscriber from the datastore, decrypting
+// its secret key fields if they are encrypted.
+func (s *SubscriberDBStorage) unmarshalSubscriber(value []byte, sub *lteprotos.SubscriberData) error {
+	if err := proto.Unmarshal(value, sub); err != nil {
+		return err
+	}
+	if sub.EncryptedSecrets == nil {
+		return nil
+	}
+	if s.cipher == nil {
+		return fmt.Errorf("Subscriber secrets are encrypted, but encryption is not enabled")
+	}
+	return s.cipher.Decrypt(sub)
+}
+
+// chunkKeys splits keys into chunks of at most chunkSize keys.
+func chunkKeys(keys []string, chunkSize int) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(keys); start += chunkSize {
+		end := start + chunkSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		chunks = append(chunks, keys[start:end])
+	}
+	return chunks
+}
+
 func getSortedKeys(in *map[string]datastore.ValueWrapper) *[]string {
 	ret := make([]string, 0, len(*in))
 	for k := range *in {
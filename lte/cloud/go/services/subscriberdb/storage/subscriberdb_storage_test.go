@@ -10,13 +10,17 @@ LICENSE file in the root directory of this source tree.
 package storage_test
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 
 	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	"magma/lte/cloud/go/services/subscriberdb/storage"
 	orcprotos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/test_utils"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -168,6 +172,40 @@ func TestSubscriberDBStorageListSubscribers(t *testing.T) {
 	assert.ElementsMatch(t, expectedSids, sids.GetSids())
 }
 
+func TestSubscriberDBStorageListSubscribersPage(t *testing.T) {
+	store, subs := initTestDB(t)
+
+	networkID := subs.GetNetworkId()
+	for _, id := range []string{"54321", "55555"} {
+		_, err := store.AddSubscriber(&protos.SubscriberData{Sid: &protos.SubscriberID{Id: id}, NetworkId: networkID})
+		assert.NoError(t, err)
+	}
+
+	// page through all subscribers
+	res, err := store.ListSubscribersPage(&protos.ListSubscribersRequest{NetworkId: networkID, PageSize: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []*protos.SubscriberID{{Id: "12345"}, {Id: "54321"}}, res.GetSids())
+	assert.NotEmpty(t, res.GetNextPageToken())
+
+	res, err = store.ListSubscribersPage(&protos.ListSubscribersRequest{
+		NetworkId: networkID,
+		PageSize:  2,
+		PageToken: res.GetNextPageToken(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*protos.SubscriberID{{Id: "55555"}}, res.GetSids())
+	assert.Empty(t, res.GetNextPageToken())
+
+	// filter by ID prefix
+	res, err = store.ListSubscribersPage(&protos.ListSubscribersRequest{NetworkId: networkID, IdPrefix: "IMSI5"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*protos.SubscriberID{{Id: "54321"}, {Id: "55555"}}, res.GetSids())
+	assert.Empty(t, res.GetNextPageToken())
+
+	_, err = store.ListSubscribersPage(&protos.ListSubscribersRequest{NetworkId: networkID, PageToken: "?"})
+	assert.Error(t, err)
+}
+
 func TestSubscriberDBStorageGetAllSubscriberData(t *testing.T) {
 	store, subs := initTestDB(t)
 
@@ -195,3 +233,170 @@ func TestSubscriberDBStorageGetAllSubscriberData(t *testing.T) {
 	},
 		allSubs.GetSubscribers())
 }
+
+func TestSubscriberDBStorageBatch(t *testing.T) {
+	_, err := storage.NewSubscriberDBStorageWithChunkSize(test_utils.NewMockDatastore(), 0)
+	assert.Error(t, err)
+
+	// use a small chunk size so that every batch spans several chunks
+	store, err := storage.NewSubscriberDBStorageWithChunkSize(test_utils.NewMockDatastore(), 2)
+	assert.NoError(t, err)
+
+	networkID := &orcprotos.NetworkID{Id: testNetworkID}
+	var subs []*protos.SubscriberData
+	for _, id := range []string{"55555", "12345", "54321", "67890", "11111"} {
+		subs = append(subs, &protos.SubscriberData{Sid: &protos.SubscriberID{Id: id}, NetworkId: networkID})
+	}
+	assert.Empty(t, store.AddSubscribers(testNetworkID, subs))
+
+	generations, err := store.GetSubscriberGenerations(testNetworkID, []string{"IMSI12345", "IMSI00000", "IMSI11111"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"IMSI12345": 0, "IMSI11111": 0}, generations)
+
+	// adding never overwrites existing subscribers
+	changed := &protos.SubscriberData{Sid: &protos.SubscriberID{Id: "12345"}, NetworkId: networkID, Lte: &protos.LTESubscription{AuthKey: []byte("changed")}}
+	failed := store.AddSubscribers(testNetworkID, []*protos.SubscriberData{changed, {Sid: &protos.SubscriberID{Id: "22222"}, NetworkId: networkID}})
+	assert.Equal(t, map[string]error{"IMSI12345": fmt.Errorf("Subscriber IMSI12345 already exists")}, failed)
+	sub, err := store.GetSubscriberData(&protos.SubscriberLookup{NetworkId: networkID, Sid: &protos.SubscriberID{Id: "12345"}})
+	assert.NoError(t, err)
+	assert.Nil(t, sub.Lte)
+
+	// updating only writes subscribers which are still at the read generation
+	failed = store.UpdateSubscribers(
+		testNetworkID,
+		[]*protos.SubscriberData{changed, {Sid: &protos.SubscriberID{Id: "11111"}, NetworkId: networkID}, {Sid: &protos.SubscriberID{Id: "00000"}, NetworkId: networkID}},
+		map[string]uint64{"IMSI12345": 0, "IMSI11111": 1},
+	)
+	assert.Equal(t, map[string]error{
+		"IMSI11111": fmt.Errorf("Subscriber IMSI11111 was modified concurrently"),
+		"IMSI00000": fmt.Errorf("Subscriber IMSI00000 does not exist"),
+	}, failed)
+	sub, err = store.GetSubscriberData(&protos.SubscriberLookup{NetworkId: networkID, Sid: &protos.SubscriberID{Id: "12345"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("changed"), sub.Lte.AuthKey)
+
+	// a second update from the same read is a lost update and fails
+	failed = store.UpdateSubscribers(testNetworkID, []*protos.SubscriberData{changed}, map[string]uint64{"IMSI12345": 0})
+	assert.Equal(t, map[string]error{"IMSI12345": fmt.Errorf("Subscriber IMSI12345 was modified concurrently")}, failed)
+	assert.Empty(t, store.DeleteSubscribers(testNetworkID, []string{"IMSI22222"}))
+
+	var exported []string
+	err = store.ExportSubscribers(networkID, func(sub *protos.SubscriberData) error {
+		exported = append(exported, sub.Sid.Id)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"11111", "12345", "54321", "55555", "67890"}, exported)
+
+	assert.Empty(t, store.DeleteSubscribers(testNetworkID, []string{"IMSI12345", "IMSI54321", "IMSI67890", "IMSI00000"}))
+	sids, err := store.ListSubscribers(networkID)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []*protos.SubscriberID{{Id: "11111"}, {Id: "55555"}}, sids.GetSids())
+
+	// export stops at the first send error
+	exported = nil
+	err = store.ExportSubscribers(networkID, func(sub *protos.SubscriberData) error {
+		exported = append(exported, sub.Sid.Id)
+		return fmt.Errorf("send error")
+	})
+	assert.EqualError(t, err, "send error")
+	assert.Equal(t, []string{"11111"}, exported)
+}
+
+func TestSubscriberDBStorageEncryption(t *testing.T) {
+	ds := test_utils.NewMockDatastore()
+	table := fmt.Sprintf("%s_%s", testNetworkID, storage.SubscribersTable)
+	networkID := &orcprotos.NetworkID{Id: testNetworkID}
+	authKey := bytes.Repeat([]byte{0xaa}, 16)
+	newSub := func(id string) *protos.SubscriberData {
+		return &protos.SubscriberData{
+			Sid:       &protos.SubscriberID{Id: id},
+			NetworkId: networkID,
+			Lte:       &protos.LTESubscription{AuthKey: authKey},
+		}
+	}
+
+	// a subscriber written before encryption was enabled
+	plainStore, err := storage.NewSubscriberDBStorage(ds)
+	assert.NoError(t, err)
+	_, err = plainStore.AddSubscriber(newSub("11111"))
+	assert.NoError(t, err)
+
+	k1Store := newEncryptedStorage(t, ds, "k1", "k1")
+	_, err = k1Store.AddSubscriber(newSub("22222"))
+	assert.NoError(t, err)
+	assert.Empty(t, k1Store.AddSubscribers(testNetworkID, []*protos.SubscriberData{newSub("33333")}))
+
+	// secrets are not stored in plaintext
+	assert.Equal(t, "", getStoredKeyID(t, ds, table, "IMSI11111"))
+	assert.Equal(t, "k1", getStoredKeyID(t, ds, table, "IMSI22222"))
+	assert.Equal(t, "k1", getStoredKeyID(t, ds, table, "IMSI33333"))
+	value, _, err := ds.Get(table, "IMSI22222")
+	assert.NoError(t, err)
+	assert.False(t, bytes.Contains(value, authKey))
+
+	// all subscribers are read decrypted
+	for _, id := range []string{"11111", "22222", "33333"} {
+		sub, err := k1Store.GetSubscriberData(&protos.SubscriberLookup{NetworkId: networkID, Sid: &protos.SubscriberID{Id: id}})
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(newSub(id), sub))
+	}
+	allSubs, err := k1Store.GetAllSubscriberData(networkID)
+	assert.NoError(t, err)
+	assert.Len(t, allSubs.Subscribers, 3)
+	for _, sub := range allSubs.Subscribers {
+		assert.Equal(t, authKey, sub.Lte.AuthKey)
+		assert.Nil(t, sub.EncryptedSecrets)
+	}
+	_, err = plainStore.GetSubscriberData(&protos.SubscriberLookup{NetworkId: networkID, Sid: &protos.SubscriberID{Id: "22222"}})
+	assert.Error(t, err)
+
+	// rotate the key
+	_, err = plainStore.ReencryptSubscribers(networkID)
+	assert.Error(t, err)
+	k2Store := newEncryptedStorage(t, ds, "k2", "k1", "k2")
+	reencrypted, err := k2Store.ReencryptSubscribers(networkID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), reencrypted)
+	for _, sid := range []string{"IMSI11111", "IMSI22222", "IMSI33333"} {
+		assert.Equal(t, "k2", getStoredKeyID(t, ds, table, sid))
+	}
+	reencrypted, err = k2Store.ReencryptSubscribers(networkID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), reencrypted)
+
+	// the old key is no longer needed
+	k2OnlyStore := newEncryptedStorage(t, ds, "k2", "k2")
+	var exported []*protos.SubscriberData
+	err = k2OnlyStore.ExportSubscribers(networkID, func(sub *protos.SubscriberData) error {
+		exported = append(exported, sub)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, exported, 3)
+	for _, sub := range exported {
+		assert.Equal(t, authKey, sub.Lte.AuthKey)
+	}
+}
+
+func newEncryptedStorage(t *testing.T, ds *test_utils.MockDatastore, currentKeyID string, keyIDs ...string) *storage.SubscriberDBStorage {
+	keys := map[string][]byte{}
+	for _, keyID := range keyIDs {
+		keys[keyID] = bytes.Repeat([]byte(keyID), encryption.KeyEncryptionKeyBytes/len(keyID))
+	}
+	provider, err := encryption.NewLocalKeyProvider(currentKeyID, keys)
+	assert.NoError(t, err)
+	cipher, err := encryption.NewSecretsCipher(provider)
+	assert.NoError(t, err)
+	store, err := storage.NewSubscriberDBStorageWithCipher(ds, 2, cipher)
+	assert.NoError(t, err)
+	return store
+}
+
+func getStoredKeyID(t *testing.T, ds *test_utils.MockDatastore, table string, sid string) string {
+	value, _, err := ds.Get(table, sid)
+	assert.NoError(t, err)
+	sub := &protos.SubscriberData{}
+	assert.NoError(t, proto.Unmarshal(value, sub))
+	return sub.GetEncryptedSecrets().GetKeyId()
+}
@@ -12,15 +12,20 @@ import (
 	"magma/lte/cloud/go/services/subscriberdb"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/magmad"
+	"magma/orc8r/cloud/go/services/streamer/providers"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
 )
 
+// subscriberDeltas holds recent snapshots of each network's subscriberdb
+// stream so gateways can be sent only the subscribers which changed
+var subscriberDeltas = providers.NewNetworkDeltaCaches(providers.DefaultDeltaCacheSize)
+
 type SubscribersProvider struct{}
 
 func (provider *SubscribersProvider) GetStreamName() string {
-	return "subscriberdb"
+	return subscriberdb.StreamName
 }
 
 func (provider *SubscribersProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
@@ -51,3 +56,15 @@ func (provider *SubscribersProvider) GetUpdates(gatewayId string, extraArgs *any
 	}
 	return ret, nil
 }
+
+func (provider *SubscribersProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any) (*protos.DataUpdateBatch, error) {
+	networkId, err := magmad.FindGatewayNetworkId(gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := provider.GetUpdates(gatewayId, extraArgs)
+	if err != nil {
+		return nil, err
+	}
+	return subscriberDeltas.GetDeltaBatch(networkId, updates, extraArgs)
+}
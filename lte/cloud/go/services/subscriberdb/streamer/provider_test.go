@@ -26,6 +26,7 @@ import (
 	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 )
@@ -85,4 +86,23 @@ func TestSubscriberdbStreamer(t *testing.T) {
 	s1j, _ := json.Marshal(s1)
 	s2j, _ := json.Marshal(s2)
 	t.Logf("\nReceived Subscribers:\n\t%s\n\t%s", string(s1j), string(s2j))
+
+	// Delete one entry and stream again with the received digest, which
+	// should only return the deletion
+	assert.True(t, updateBatch.Resync)
+	err = sdb.DeleteSubscriber(testNetworkId, "IMSI12345")
+	assert.NoError(t, err)
+	extraArgs, err := ptypes.MarshalAny(&orcprotos.StreamDigest{Digest: updateBatch.Digest})
+	assert.NoError(t, err)
+	streamerClient, err = grpcClient.GetUpdates(
+		context.Background(),
+		&orcprotos.StreamRequest{GatewayId: testAgHwId, StreamName: "subscriberdb", ExtraArgs: extraArgs},
+	)
+	assert.NoError(t, err)
+
+	updateBatch, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, updateBatch.Resync)
+	assert.Empty(t, updateBatch.Updates)
+	assert.Equal(t, []string{"IMSI12345"}, updateBatch.DeletedKeys)
 }
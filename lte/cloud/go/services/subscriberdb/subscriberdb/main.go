@@ -14,15 +14,28 @@ import (
 	"magma/lte/cloud/go/lte"
 	"magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/lte/cloud/go/services/subscriberdb/encryption"
 	"magma/lte/cloud/go/services/subscriberdb/servicers"
 	"magma/lte/cloud/go/services/subscriberdb/storage"
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/service"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// Number of subscribers read or written per datastore call by the batch
+	// RPCs
+	bulkChunkSizeKey = "bulkChunkSize"
 )
 
 func main() {
 	// Create the service
-	srv, err := service.NewOrchestratorService(lte.ModuleName, subscriberdb.ServiceName)
+	srv, err := service.NewOrchestratorServiceWithOptions(
+		lte.ModuleName,
+		subscriberdb.ServiceName,
+		grpc.MaxRecvMsgSize(subscriberdb.MaxBatchMsgBytes),
+	)
 	if err != nil {
 		log.Fatalf("Error creating service: %s", err)
 	}
@@ -34,7 +47,17 @@ func main() {
 		log.Fatalf("Failed to initialize datastore: %s", err)
 	}
 
-	subscriberDBStore, err := storage.NewSubscriberDBStorage(store)
+	chunkSize := storage.DefaultChunkSize
+	if srv.Config != nil {
+		if size, err := srv.Config.GetIntParam(bulkChunkSizeKey); err == nil && size > 0 {
+			chunkSize = size
+		}
+	}
+	cipher, err := encryption.GetSecretsCipher(srv.Config)
+	if err != nil {
+		log.Fatalf("Failed to initialize subscriber encryption: %s", err)
+	}
+	subscriberDBStore, err := storage.NewSubscriberDBStorageWithCipher(store, chunkSize, cipher)
 	if err != nil {
 		log.Fatalf("Failed to initialize subscriberdb store: %s", err)
 	}
@@ -0,0 +1,31 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package main
+
+import (
+	"flag"
+	"log"
+
+	"magma/lte/cloud/go/services/eps_authentication/servicers"
+	"magma/lte/cloud/go/tools/migrations/m004_lte_auth_sqn_array/migration"
+	"magma/orc8r/cloud/go/plugin"
+)
+
+// eps_authentication and all other writers of subscriber data must be stopped
+// while this migration runs, see migration.Migrate.
+func main() {
+	indBits := flag.Uint("indBits", servicers.DefaultIndBits, "Length in bits of IND. Must match lteAuthIndBits of eps_authentication.")
+	flag.Parse()
+
+	plugin.LoadAllPluginsFatalOnError(&plugin.DefaultOrchestratorPluginLoader{})
+	err := migration.Migrate(servicers.SqnConfig{IndBits: uint32(*indBits)})
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package migration
+
+import (
+	"fmt"
+
+	lteprotos "magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/eps_authentication/servicers"
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/orc8r/cloud/go/services/magmad"
+)
+
+/**
+Performs the migration of subscriber states from the single LteAuthNextSeq
+counter to the SQN array scheme of 3GPP TS 33.102 Annex C.3.
+
+After this migration is complete every subscriber state has an
+LteAuthSeqArray of 2^IndBits SEQs, and every SQN generated afterwards is
+higher than any SQN generated before.
+
+Subscribers are read and written back without any concurrency control, so an
+SQN generated or a subscriber edited between the read and the write would be
+overwritten with the stale state. eps_authentication and every other writer
+of subscriber data (including the REST API) must therefore be stopped while
+this migration runs. Until the migration is run eps_authentication migrates
+states lazily, so the migration may be scheduled for any maintenance window.
+*/
+func Migrate(cfg servicers.SqnConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	networks, err := magmad.ListNetworks()
+	if err != nil {
+		return err
+	}
+
+	for _, network := range networks {
+		subscribers, err := subscriberdb.GetAllSubscriberData(network)
+		if err != nil {
+			return err
+		}
+
+		for _, subscriber := range subscribers {
+			if !servicers.MigrateLteAuthSeq(subscriber.GetState(), cfg) {
+				continue
+			}
+			err = subscriberdb.UpdateSubscriber(network, subscriber)
+			if err != nil {
+				return fmt.Errorf("Failed to migrate subscriber %s: %v", lteprotos.SidString(subscriber.GetSid()), err)
+			}
+		}
+	}
+
+	return nil
+}
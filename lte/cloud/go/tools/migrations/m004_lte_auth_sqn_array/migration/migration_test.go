@@ -0,0 +1,74 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package migration_test
+
+import (
+	"testing"
+
+	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/eps_authentication/servicers"
+	"magma/lte/cloud/go/services/subscriberdb"
+	sdb_test_init "magma/lte/cloud/go/services/subscriberdb/test_init"
+	"magma/lte/cloud/go/tools/migrations/m004_lte_auth_sqn_array/migration"
+	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/magmad"
+	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
+	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNetwork = "sqn_test_network"
+
+func TestMigrateLteAuthSqnArray(t *testing.T) {
+	magmad_test_init.StartTestService(t)
+	sdb_test_init.StartTestService(t)
+
+	_, err := magmad.RegisterNetwork(&magmad_protos.MagmadNetworkRecord{Name: "SQN Test Network"}, testNetwork)
+	require.NoError(t, err)
+
+	netID := &orcprotos.NetworkID{Id: testNetwork}
+	legacy := &protos.SubscriberData{
+		Sid:       &protos.SubscriberID{Id: "1"},
+		NetworkId: netID,
+		State:     &protos.SubscriberState{LteAuthNextSeq: 7350},
+	}
+	migrated := &protos.SubscriberData{
+		Sid:       &protos.SubscriberID{Id: "2"},
+		NetworkId: netID,
+		State:     &protos.SubscriberState{LteAuthSeqArray: []uint64{3, 9}, LteAuthNextInd: 1, LteAuthNextSeq: 10},
+	}
+	noState := &protos.SubscriberData{Sid: &protos.SubscriberID{Id: "3"}, NetworkId: netID}
+	for _, subscriber := range []*protos.SubscriberData{legacy, migrated, noState} {
+		require.NoError(t, subscriberdb.AddSubscriber(testNetwork, subscriber))
+	}
+
+	err = migration.Migrate(servicers.SqnConfig{IndBits: 11})
+	assert.EqualError(t, err, "IND length must be at most 10 bits, but got 11 bits")
+
+	err = migration.Migrate(servicers.SqnConfig{IndBits: 1})
+	require.NoError(t, err)
+
+	actual, err := subscriberdb.GetSubscriber(testNetwork, "IMSI1")
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{117599, 117599}, actual.State.LteAuthSeqArray)
+	assert.Equal(t, uint32(0), actual.State.LteAuthNextInd)
+	assert.Equal(t, uint64(117600), actual.State.LteAuthNextSeq)
+
+	actual, err = subscriberdb.GetSubscriber(testNetwork, "IMSI2")
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 9}, actual.State.LteAuthSeqArray)
+	assert.Equal(t, uint32(1), actual.State.LteAuthNextInd)
+	assert.Equal(t, uint64(10), actual.State.LteAuthNextSeq)
+
+	actual, err = subscriberdb.GetSubscriber(testNetwork, "IMSI3")
+	require.NoError(t, err)
+	assert.Nil(t, actual.State)
+}
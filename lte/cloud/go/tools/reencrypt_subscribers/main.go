@@ -0,0 +1,46 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// reencrypt_subscribers re-encrypts the secret keys of subscribers with the
+// current key of subscriberdb, e.g. after rotating the key. Run it only once
+// every instance of subscriberdb and eps_authentication has been restarted
+// with the new key as the current key, see encryption.LocalKeyFileProvider
+// for the rotation steps.
+package main
+
+import (
+	"flag"
+	"log"
+
+	"magma/lte/cloud/go/services/subscriberdb"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/services/magmad"
+)
+
+func main() {
+	networkID := flag.String("network", "", "Network to re-encrypt. All networks are re-encrypted if empty.")
+	flag.Parse()
+
+	plugin.LoadAllPluginsFatalOnError(&plugin.DefaultOrchestratorPluginLoader{})
+	networks := []string{*networkID}
+	if *networkID == "" {
+		var err error
+		networks, err = magmad.ListNetworks()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, network := range networks {
+		reencrypted, err := subscriberdb.ReencryptSubscribers(network)
+		if err != nil {
+			log.Fatalf("Failed to re-encrypt subscribers of network %s: %s", network, err)
+		}
+		log.Printf("Re-encrypted %d subscribers of network %s", reencrypted, network)
+	}
+}
@@ -46,6 +46,27 @@ class DirectoryServiceRpcServicer(DirectoryServiceServicer):
         """
         self._get_grpc_client().UpdateLocation(request)
 
+    @return_void
+    def UpdateLocations(self, request, context):
+        """ Update the location records of many objects of a table
+
+        Args:
+            request (UpdateDirectoryLocationsRequest): update locations
+            request
+        """
+        self._get_grpc_client().UpdateLocations(request)
+
+    def GetIDsByLocation(self, request, context):
+        """ Get the IDs of all objects at a location
+
+        Args:
+            request (GetIDsByLocationRequest): get IDs by location request
+
+        Returns:
+            LocationIDs: IDs of the objects at the location
+        """
+        return self._get_grpc_client().GetIDsByLocation(request)
+
     @return_void
     def DeleteLocation(self, request, context):
         """ Delete the location record of an object
@@ -8,7 +8,6 @@ of patent rights can be found in the PATENTS file in the same directory.
 """
 
 import logging
-from typing import Any
 
 from lte.protos.policydb_pb2 import PolicyRule
 
@@ -16,21 +15,20 @@ from magma.common.streamer import StreamerClient
 from .rule_store import PolicyRuleDict
 
 
-class PolicyDBStreamerCallback(StreamerClient.Callback):
+class PolicyDBStreamerCallback(StreamerClient.DeltaCallback):
     """
     Callback implementation for the PolicyDB StreamerClient instance.
     """
 
     def __init__(self, loop):
+        super().__init__()
         self._policy_dict = PolicyRuleDict()
         self._loop = loop
 
-    def get_request_args(self, stream_name: str) -> Any:
-        return None
-
-    def process_update(self, stream_name, updates, resync):
-        logging.info("Processing %d policy updates (resync=%s)",
-                     len(updates), resync)
+    def process_delta_update(self, stream_name, updates, deleted_keys,
+                             resync):
+        logging.info("Processing %d policy updates and %d deletions "
+                     "(resync=%s)", len(updates), len(deleted_keys), resync)
         if resync:
             policy_ids = set()
             for update in updates:
@@ -42,7 +40,14 @@ class PolicyDBStreamerCallback(StreamerClient.Callback):
             self._remove_old_policies(policy_ids)
             self._policy_dict.send_update_notification()
         else:
-            pass
+            for update in updates:
+                policy = PolicyRule()
+                policy.ParseFromString(update.value)
+                self._store_policy_rule(policy)
+            for rule_id in deleted_keys:
+                if rule_id in self._policy_dict:
+                    del self._policy_dict[rule_id]
+            self._policy_dict.send_update_notification()
 
     def _store_policy_rule(self, policy):
         self._policy_dict[policy.id] = policy
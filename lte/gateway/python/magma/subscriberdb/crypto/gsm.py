@@ -9,6 +9,7 @@ of patent rights can be found in the PATENTS file in the same directory.
 
 import abc
 
+from .milenage import Milenage, xor
 from .utils import CryptoError
 
 
@@ -55,3 +56,41 @@ class UnsafePreComputedA3A8(GSMA3A8Algo):
         sres = key[16:20]
         cipher_key = key[20:]
         return (rand, sres, cipher_key)
+
+
+class MilenageA3A8(GSMA3A8Algo):
+    """
+    A3/A8 for USIM subscribers authenticating in GSM. The auth tuple is
+    derived from the Milenage outputs with the conversion functions c2 and c3
+    (3GPP TS 33.102 6.8.1.2).
+    """
+
+    def __init__(self, opc):
+        """
+        Args:
+            opc - 128 bit operator variant algorithm configuration field
+        """
+        self._opc = opc
+
+    def generate_auth_tuple(self, key):
+        """
+        Args:
+            key - 128 bit subscriber key
+        Returns:
+            (rand, sres, cipher_key) tuple
+        Raises:
+            CryptoError if the key or opc is not 16 byte long
+        """
+        if len(key) != 16:
+            raise CryptoError('Invalid subscriber key: %s' % key)
+        if len(self._opc) != 16:
+            raise CryptoError('Invalid OPc: %s' % self._opc)
+        rand = Milenage.generate_rand()
+        xres, _ = Milenage.f2_f5(key, rand, self._opc)
+        ck = Milenage.f3(key, rand, self._opc)
+        ik = Milenage.f4(key, rand, self._opc)
+        # c2: SRES = XRES1 xor XRES2 for a 64 bit XRES
+        sres = xor(xres[:4], xres[4:])
+        # c3: Kc = CK1 xor CK2 xor IK1 xor IK2
+        cipher_key = xor(xor(ck[:8], ck[8:]), xor(ik[:8], ik[8:]))
+        return (rand, sres, cipher_key)
@@ -13,7 +13,7 @@ from lte.protos.subscriberdb_pb2 import GSMSubscription, LTESubscription, \
     SubscriberID
 
 from magma.subscriberdb.sid import SIDUtils
-from .crypto.gsm import UnsafePreComputedA3A8
+from .crypto.gsm import MilenageA3A8, UnsafePreComputedA3A8
 from .crypto.milenage import Milenage
 from .crypto.utils import CryptoError
 
@@ -148,7 +148,14 @@ class Processor(GSMProcessor, LTEProcessor):
         if subs.gsm.state != GSMSubscription.ACTIVE:
             raise CryptoError("GSM service not active for %s" % sid)
 
-        # The only GSM crypto algo we support now
+        if subs.gsm.auth_algo == GSMSubscription.MILENAGE:
+            # The USIM's K and OPc are stored in the LTE subscription
+            if subs.lte.auth_algo != LTESubscription.MILENAGE:
+                raise CryptoError("Unknown crypto (%s) for %s" %
+                                  (subs.lte.auth_algo, sid))
+            key, opc = self._get_milenage_key_opc(subs, sid)
+            return MilenageA3A8(opc).generate_auth_tuple(key)
+
         if subs.gsm.auth_algo != GSMSubscription.PRECOMPUTED_AUTH_TUPLES:
             raise CryptoError("Unknown crypto (%s) for %s" %
                               (subs.gsm.auth_algo, sid))
@@ -174,6 +181,16 @@ class Processor(GSMProcessor, LTEProcessor):
             raise CryptoError("Unknown crypto (%s) for %s" %
                               (subs.lte.auth_algo, sid))
 
+        key, opc = self._get_milenage_key_opc(subs, sid)
+        sqn = self.seq_to_sqn(self.get_next_lte_auth_seq(imsi))
+        milenage = Milenage(self._amf)
+        return milenage.generate_eutran_vector(key, opc, sqn, plmn)
+
+    def _get_milenage_key_opc(self, subs, sid):
+        """
+        Returns the subscriber's Milenage key and OPc from the LTE
+        subscription, generating the OPc from the OP if it is not stored.
+        """
         if len(subs.lte.auth_key) != 16:
             raise CryptoError("Subscriber key not valid for %s" % sid)
 
@@ -183,11 +200,7 @@ class Processor(GSMProcessor, LTEProcessor):
             raise CryptoError("Subscriber OPc is invalid length for %s" % sid)
         else:
             opc = subs.lte.auth_opc
-
-        sqn = self.seq_to_sqn(self.get_next_lte_auth_seq(imsi))
-        milenage = Milenage(self._amf)
-        return milenage.generate_eutran_vector(subs.lte.auth_key,
-                                               opc, sqn, plmn)
+        return subs.lte.auth_key, opc
 
     def resync_lte_auth_seq(self, imsi, rand, auts):
         """
@@ -8,32 +8,32 @@ of patent rights can be found in the PATENTS file in the same directory.
 """
 
 import logging
-from typing import Any
 
 from lte.protos.s6a_service_pb2 import DeleteSubscriberRequest
 from lte.protos.s6a_service_pb2_grpc import S6aServiceStub
-from lte.protos.subscriberdb_pb2 import SubscriberData
+from lte.protos.subscriberdb_pb2 import SubscriberData, SubscriberState
 
 from magma.common.service_registry import ServiceRegistry
 from magma.common.streamer import StreamerClient
+from magma.subscriberdb.sid import SIDUtils
+from magma.subscriberdb.store.base import SubscriberNotFoundError
 
 
-class SubscriberDBStreamerCallback(StreamerClient.Callback):
+class SubscriberDBStreamerCallback(StreamerClient.DeltaCallback):
     """
     Callback implementation for the SubscriberDB StreamerClient instance.
     """
 
     def __init__(self, store, loop):
+        super().__init__()
         self._store = store
         self._loop = loop
 
-    def get_request_args(self, stream_name: str) -> Any:
-        return None
+    def process_delta_update(self, stream_name, updates, deleted_keys,
+                             resync):
 
-    def process_update(self, stream_name, updates, resync):
-
-        logging.info("Processing %d subscriber updates (resync=%s)",
-                     len(updates), resync)
+        logging.info("Processing %d subscriber updates and %d deletions "
+                     "(resync=%s)", len(updates), len(deleted_keys), resync)
 
         if resync:
             # TODO:
@@ -50,8 +50,28 @@ class SubscriberDBStreamerCallback(StreamerClient.Callback):
             logging.debug("Resync with subscribers: %s", ','.join(keys))
             self._store.resync(subscribers)
         else:
-            # TODO: implement updates
-            pass
+            for update in updates:
+                sub = SubscriberData()
+                sub.ParseFromString(update.value)
+                self._upsert_subscriber(sub)
+            for sid in deleted_keys:
+                self._store.delete_subscriber(sid)
+            self.detach_deleted_subscribers(deleted_keys, [])
+
+    def _upsert_subscriber(self, sub):
+        """
+        Updates the subscriber's data, leaving its current state intact, or
+        adds the subscriber if it is not in the store yet.
+        """
+        sid = SIDUtils.to_str(sub.sid)
+        try:
+            with self._store.edit_subscriber(sid) as subs:
+                state = SubscriberState()
+                state.CopyFrom(subs.state)
+                subs.CopyFrom(sub)
+                subs.state.CopyFrom(state)
+        except SubscriberNotFoundError:
+            self._store.add_subscriber(sub)
 
     def detach_deleted_subscribers(self, old_sub_ids, new_sub_ids):
         """
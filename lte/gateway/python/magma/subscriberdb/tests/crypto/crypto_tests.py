@@ -9,7 +9,9 @@ of patent rights can be found in the PATENTS file in the same directory.
 
 import unittest
 
-from magma.subscriberdb.crypto.gsm import UnsafePreComputedA3A8
+from magma.subscriberdb.crypto.gsm import MilenageA3A8, \
+    UnsafePreComputedA3A8
+from magma.subscriberdb.crypto.milenage import Milenage
 from magma.subscriberdb.crypto.utils import CryptoError
 
 
@@ -38,6 +40,30 @@ class CryptoTests(unittest.TestCase):
         with self.assertRaises(CryptoError):
             crypto.generate_auth_tuple(input_k)
 
+    def test_milenage_a3a8(self):
+        """
+        Test if the MilenageA3A8 algo works as expected.
+        This is test set 1 from 3GPP 35.208 4.3
+        """
+        rand = b'#U<\xbe\x967\xa8\x9d!\x8a\xe6M\xaeG\xbf5'
+        key = b'\x46\x5b\x5c\xe8\xb1\x99\xb4\x9f\xaa\x5f\x0a\x2e\xe2\x38\xa6\xbc'
+        opc = b'\xcd\x63\xcb\x71\x95\x4a\x9f\x4e\x48\xa5\x99\x4e\x37\xa0\x2b\xaf'
+
+        old_rand = Milenage.generate_rand
+        Milenage.generate_rand = lambda: rand
+        try:
+            crypto = MilenageA3A8(opc)
+            self.assertEqual(
+                crypto.generate_auth_tuple(key),
+                (rand, b'\x46\xf8\x41\x6a',
+                 b'\xea\xe4\xbe\x82\x3a\xf9\xa0\x8b'))
+        finally:
+            Milenage.generate_rand = old_rand
+
+        # If the key is not 16 bytes, CryptoError will be thrown
+        with self.assertRaises(CryptoError):
+            MilenageA3A8(opc).generate_auth_tuple(key[1:])
+
 
 if __name__ == "__main__":
     unittest.main()
@@ -117,7 +117,13 @@ class ProcessorTests(unittest.TestCase):
         store.add_subscriber(sub2)
         store.add_subscriber(sub3)
         store.add_subscriber(sub4)
+        sub6 = SubscriberData(sid=SIDUtils.to_pb('IMSI66666'), lte=lte_opc,
+                              gsm=GSMSubscription(
+                                  state=GSMSubscription.ACTIVE,
+                                  auth_algo=GSMSubscription.MILENAGE),
+                              state=state)
         store.add_subscriber(sub5)
+        store.add_subscriber(sub6)
 
     def test_gsm_auth_success(self):
         """
@@ -126,6 +132,16 @@ class ProcessorTests(unittest.TestCase):
         self.assertEqual(self._processor.get_gsm_auth_vector('11111'),
                          _dummy_auth_tuple())
 
+    def test_gsm_auth_milenage(self):
+        """
+        Test if we get an auth tuple derived from the LTE key for
+        GSM Milenage subscribers
+        """
+        (rand, sres, cipher_key) = self._processor.get_gsm_auth_vector('66666')
+        self.assertEqual(len(rand), 16)
+        self.assertEqual(len(sres), 4)
+        self.assertEqual(len(cipher_key), 8)
+
     def test_gsm_auth_imsi_unknown(self):
         """
         Test if we get SubscriberNotFoundError exception
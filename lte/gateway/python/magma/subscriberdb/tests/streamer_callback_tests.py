@@ -14,10 +14,14 @@ import unittest
 import unittest.mock
 
 from lte.protos.s6a_service_pb2 import DeleteSubscriberRequest
+from lte.protos.subscriberdb_pb2 import SubscriberData
+from magma.subscriberdb.sid import SIDUtils
 from magma.subscriberdb.store.sqlite import SqliteStore
 from magma.subscriberdb.streamer_callback import SubscriberDBStreamerCallback
 
 from magma.common.service_registry import ServiceRegistry
+from orc8r.protos.streamer_pb2 import DataUpdate, DataUpdateBatch, \
+    StreamDigest
 
 
 class MockFuture(object):
@@ -85,6 +89,50 @@ class SubscriberDBStreamerCallbackTests(unittest.TestCase):
                 imsi_list=["IMSI101", "IMSI303"]
             ))
 
+    @unittest.mock.patch('magma.subscriberdb.streamer_callback.S6aServiceStub')
+    def test_process_delta_update(self, s6a_service_mock_stub):
+        """
+        Test that incremental batches update, add and delete subscribers and
+        that the digest of the last batch is sent with the next request.
+        """
+        mock = unittest.mock.Mock()
+        s6a_service_mock_stub.side_effect = [mock]
+        store = self._streamer_callback._store
+
+        def update(sid, auth_key):
+            sub = SubscriberData(sid=SIDUtils.to_pb(sid))
+            sub.lte.auth_key = auth_key
+            return DataUpdate(key=sid, value=sub.SerializeToString())
+
+        self.assertIsNone(
+            self._streamer_callback.get_request_args('subscriberdb'))
+        self._streamer_callback.process_batch('subscriberdb', DataUpdateBatch(
+            updates=[update('IMSI101', b'\x01'), update('IMSI202', b'\x02')],
+            resync=True,
+            digest='digest1',
+        ))
+        self.assertEqual(
+            self._streamer_callback.get_request_args('subscriberdb'),
+            StreamDigest(digest='digest1'))
+
+        # Subscriber state is kept when a subscriber's data is updated
+        with store.edit_subscriber('IMSI101') as subs:
+            subs.state.lte_auth_next_seq = 7
+        self._streamer_callback.process_batch('subscriberdb', DataUpdateBatch(
+            updates=[update('IMSI101', b'\x03'), update('IMSI303', b'\x04')],
+            deleted_keys=['IMSI202'],
+            digest='digest2',
+        ))
+        self.assertEqual(store.list_subscribers(), ['IMSI101', 'IMSI303'])
+        sub = store.get_subscriber_data('IMSI101')
+        self.assertEqual(sub.lte.auth_key, b'\x03')
+        self.assertEqual(sub.state.lte_auth_next_seq, 7)
+        mock.DeleteSubscriber.future.assert_called_once_with(
+            DeleteSubscriberRequest(imsi_list=['IMSI202']))
+        self.assertEqual(
+            self._streamer_callback.get_request_args('subscriberdb'),
+            StreamDigest(digest='digest2'))
+
 
 if __name__ == "__main__":
     unittest.main()
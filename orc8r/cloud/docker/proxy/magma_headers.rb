@@ -9,13 +9,32 @@
 # See: https://nghttp2.org/documentation/nghttpx.1.html?highlight=mruby-file#mruby-scripting
 
 class App
+  def initialize
+    # The cloud proxy prepends REVOKED_SERIALS, the serial numbers of the
+    # revoked client certs listed in the CRL published by the certifier,
+    # since nghttpx can't check CRLs itself
+    @revoked_serials = Object.const_defined?(:REVOKED_SERIALS) ? REVOKED_SERIALS : {}
+  end
+
   def on_req(env)
+    if revoked?(env.tls_client_serial.upcase)
+      env.resp.status = 403
+      env.resp.return("Client certificate is revoked")
+      return
+    end
+
     # Inject Magma headers to inform the backend services about the client cert.
     # The headers would be present for all requests, and a empty string as
     # value indicate invalid cert.
     env.req.set_header("x-magma-client-cert-cn", env.tls_client_subject_name)
     env.req.set_header("x-magma-client-cert-serial", env.tls_client_serial.upcase)
   end
+
+  def revoked?(serial)
+    # Serials are compared without leading zeros, like certifier does
+    serial = serial[1..-1] while serial[0] == "0"
+    @revoked_serials.key?(serial)
+  end
 end
 
 App.new
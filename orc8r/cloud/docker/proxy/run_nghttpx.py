@@ -10,15 +10,24 @@
 import argparse
 import jinja2
 import os
+import signal
 import subprocess
 import yaml
-from typing import Any, Dict
+from typing import Any, Dict, List, Optional
 
 CONFIGS_DIR = "/etc/magma/configs"
 TEMPLATES_DIR = "/etc/magma/templates"
+SCRIPTS_DIR = "/etc/magma"
 OUTPUT_DIR = "/etc/nghttpx"
 OBSIDIAN_PORT = 9081
 
+CERTS_DIR = "/var/opt/magma/certs"
+# CRL of the gateway certs, published by the certifier
+CRL_FILE = os.path.join(CERTS_DIR, "gateway.crl")
+CA_FILE = os.path.join(CERTS_DIR, "certifier.pem")
+CRL_POLL_INTERVAL = 60
+HEADERS_SCRIPT = "magma_headers.rb"
+
 
 def _load_services() -> Dict[Any, Any]:
     """ Return the services from the registry configs of all modules """
@@ -46,19 +55,104 @@ def _generate_config(proxy_type: str, context: Dict[str, Any]) -> str:
     return outfile
 
 
+def _load_revoked_serials() -> Optional[List[str]]:
+    """
+    Return the serial numbers of the revoked gateway certs listed in the CRL,
+    or None if the CRL can't be loaded or isn't signed by the certifier
+    """
+    try:
+        result = subprocess.run([
+            "openssl", "crl",
+            "-in", CRL_FILE,
+            "-CAfile", CA_FILE,
+            "-noout", "-text",
+        ], stdout=subprocess.PIPE, stderr=subprocess.PIPE,
+            universal_newlines=True, check=True)
+    except (OSError, subprocess.CalledProcessError) as err:
+        print("Failed to load CRL %s: %s" % (CRL_FILE, err))
+        return None
+    if "verify OK" not in result.stderr:
+        print("Failed to verify CRL %s: %s" % (CRL_FILE, result.stderr))
+        return None
+
+    serials = []
+    for line in result.stdout.splitlines():
+        line = line.strip()
+        if line.startswith("Serial Number:"):
+            # Serials are compared without leading zeros, like certifier does
+            serial = line[len("Serial Number:"):].strip().upper().lstrip("0")
+            serials.append(serial)
+    return serials
+
+
+def _get_crl_mtime() -> Optional[float]:
+    try:
+        return os.stat(CRL_FILE).st_mtime
+    except OSError:
+        return None
+
+
+def _generate_headers_script(revoked_serials: List[str]) -> None:
+    """
+    Generate the mruby script injecting the client cert headers, prepended
+    with the revoked serial numbers it rejects
+    """
+    with open(os.path.join(SCRIPTS_DIR, HEADERS_SCRIPT)) as file:
+        script = file.read()
+    revoked = "".join('  "%s" => true,\n' % serial for serial in revoked_serials)
+    outfile = os.path.join(OUTPUT_DIR, HEADERS_SCRIPT)
+    with open(outfile, "w") as file:
+        file.write("REVOKED_SERIALS = {\n%s}\n\n" % revoked)
+        file.write(script)
+
+
+def _get_nghttpx_cmd(conf: str) -> List[str]:
+    return [
+        "/usr/local/bin/nghttpx",
+        "--conf=%s" % conf,
+        "/var/opt/magma/certs/controller.key",
+        "/var/opt/magma/certs/controller.crt",
+    ]
+
+
 def _run_nghttpx(conf: str) -> None:
     """ Runs the nghttpx process given the config file """
     try:
-        subprocess.run([
-            "/usr/local/bin/nghttpx",
-            "--conf=%s" % conf,
-            "/var/opt/magma/certs/controller.key",
-            "/var/opt/magma/certs/controller.crt",
-        ], check=True)
+        subprocess.run(_get_nghttpx_cmd(conf), check=True)
     except subprocess.CalledProcessError as err:
         exit(err.returncode)
 
 
+def _run_nghttpx_with_crl(conf: str) -> None:
+    """
+    Runs the nghttpx process given the config file, and reloads it with the
+    newly revoked serial numbers whenever the certifier publishes a new CRL
+    """
+    crl_mtime = _get_crl_mtime()
+    _generate_headers_script(_load_revoked_serials() or [])
+    proc = subprocess.Popen(_get_nghttpx_cmd(conf))
+    while True:
+        try:
+            returncode = proc.wait(timeout=CRL_POLL_INTERVAL)
+            if returncode != 0:
+                exit(returncode)
+            return
+        except subprocess.TimeoutExpired:
+            pass
+
+        mtime = _get_crl_mtime()
+        if mtime == crl_mtime:
+            continue
+        revoked_serials = _load_revoked_serials()
+        if revoked_serials is None:
+            continue
+        crl_mtime = mtime
+        print("Reloading with %d revoked certs" % len(revoked_serials))
+        _generate_headers_script(revoked_serials)
+        # nghttpx reloads its config, including the mruby script, on SIGHUP
+        proc.send_signal(signal.SIGHUP)
+
+
 def main() -> None:
     parser = argparse.ArgumentParser(description="Nghttpx runner")
     parser.add_argument("proxy_type", choices=["open", "clientcert"])
@@ -75,7 +169,10 @@ def main() -> None:
     conf = _generate_config(args.proxy_type, context)
 
     # Run the nghttpx process
-    _run_nghttpx(conf)
+    if args.proxy_type == "clientcert":
+        _run_nghttpx_with_crl(conf)
+    else:
+        _run_nghttpx(conf)
 
 
 if __name__ == '__main__':
@@ -0,0 +1,308 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package backup snapshots everything belonging to a network into a single
+// versioned archive and recreates networks from such archives.
+//
+// The network and gateway records owned by magmad are always part of an
+// archive. Everything else (configs, upgrade tiers, subscribers, policies...)
+// is contributed by Sections which orchestrator plugins register. Each section
+// serializes its own slice of the network to JSON and is responsible for
+// recreating it on import.
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/magmad"
+	magmadprotos "magma/orc8r/cloud/go/services/magmad/protos"
+
+	"github.com/golang/glog"
+)
+
+// ArchiveVersion is the version of the archive format written by Export.
+// Import rejects archives of any other version.
+const ArchiveVersion = 1
+
+// Archive is a snapshot of everything belonging to a network
+type Archive struct {
+	Version int `json:"version"`
+	// NetworkID is the ID of the network the archive was exported from
+	NetworkID string `json:"network_id"`
+	// ExportedAt is the unix time in seconds at which the archive was created
+	ExportedAt int64 `json:"exported_at"`
+
+	// Network is the JSON representation of the magmad network record
+	Network json.RawMessage `json:"network"`
+	// Gateways holds the magmad records of all gateways in the network
+	Gateways []Gateway `json:"gateways"`
+	// Sections maps section names to the data exported by each Section
+	Sections map[string]json.RawMessage `json:"sections"`
+}
+
+// Gateway is an archived magmad gateway record
+type Gateway struct {
+	// ID is the logical ID of the gateway
+	ID string `json:"id"`
+	// Record is the JSON representation of the magmad gateway record
+	Record json.RawMessage `json:"record"`
+}
+
+// ImportOptions controls how an archive is imported
+type ImportOptions struct {
+	// NetworkID is the ID of the network to create. If empty, the network is
+	// recreated under the ID it was exported from.
+	NetworkID string
+
+	// SkipGateways excludes gateways and everything keyed by their logical
+	// IDs from the import. Hardware IDs are unique across networks, so this
+	// must be set when cloning a network whose gateways are still registered.
+	SkipGateways bool
+}
+
+// ImportContext is handed to each Section when an archive is imported
+type ImportContext struct {
+	// NetworkID is the ID of the network being created
+	NetworkID string
+	// SourceNetworkID is the ID of the network the archive was exported from
+	SourceNetworkID string
+	// SkippedGatewayIDs holds the logical IDs of archived gateways which are
+	// not imported
+	SkippedGatewayIDs map[string]bool
+}
+
+// RewriteKey maps a key used in the source network to the key to use in the
+// imported network. Keys which are the source network's ID (e.g. network
+// config keys) are replaced with the new network ID, other keys are returned
+// unchanged.
+func (ctx ImportContext) RewriteKey(key string) string {
+	if key == ctx.SourceNetworkID {
+		return ctx.NetworkID
+	}
+	return key
+}
+
+// IsSkippedGateway returns true if key is the logical ID of an archived
+// gateway which is not imported. Sections should not import data keyed by
+// such gateways.
+func (ctx ImportContext) IsSkippedGateway(key string) bool {
+	return ctx.SkippedGatewayIDs[key]
+}
+
+// Section exports and imports one slice of the data belonging to a network,
+// typically everything a single service stores for the network.
+type Section interface {
+	// GetName returns the unique name of the section in archives
+	GetName() string
+
+	// Export returns a JSON snapshot of the section's data in a network
+	Export(networkID string) (json.RawMessage, error)
+
+	// Import recreates a snapshot returned by Export in ctx.NetworkID
+	Import(ctx ImportContext, data json.RawMessage) error
+
+	// Clear removes all of the section's data in a network. It is used to
+	// roll back imports which failed part-way through.
+	Clear(networkID string) error
+}
+
+type sectionRegistry struct {
+	sync.RWMutex
+	sectionsByName map[string]Section
+}
+
+var registry = &sectionRegistry{sectionsByName: map[string]Section{}}
+
+// RegisterSections registers Sections to include in network archives.
+// Section names must be unique. This function is thread-safe.
+func RegisterSections(sections ...Section) error {
+	registry.Lock()
+	defer registry.Unlock()
+	for _, s := range sections {
+		if _, exists := registry.sectionsByName[s.GetName()]; exists {
+			return fmt.Errorf("Backup section %s is already registered", s.GetName())
+		}
+	}
+	for _, s := range sections {
+		registry.sectionsByName[s.GetName()] = s
+	}
+	return nil
+}
+
+// UnregisterAllSections should only be used in test code!!!!!
+func UnregisterAllSections(t *testing.T) {
+	if t == nil {
+		panic("Nice try")
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	registry.sectionsByName = map[string]Section{}
+}
+
+// getSections returns all registered sections sorted by name
+func getSections() []Section {
+	registry.RLock()
+	defer registry.RUnlock()
+	ret := make([]Section, 0, len(registry.sectionsByName))
+	for _, s := range registry.sectionsByName {
+		ret = append(ret, s)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].GetName() < ret[j].GetName() })
+	return ret
+}
+
+// Export creates an archive of everything belonging to a network
+func Export(networkID string) (*Archive, error) {
+	networkRecord, err := magmad.GetNetwork(networkID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get network %s: %s", networkID, err)
+	}
+	marshaledNetwork, err := protos.Marshal(networkRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayIDs, err := magmad.ListGateways(networkID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list gateways of network %s: %s", networkID, err)
+	}
+	sort.Strings(gatewayIDs)
+	gateways := make([]Gateway, 0, len(gatewayIDs))
+	for _, gatewayID := range gatewayIDs {
+		record, err := magmad.FindGatewayRecord(networkID, gatewayID)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get record of gateway %s: %s", gatewayID, err)
+		}
+		marshaledRecord, err := protos.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		gateways = append(gateways, Gateway{ID: gatewayID, Record: marshaledRecord})
+	}
+
+	sections := map[string]json.RawMessage{}
+	for _, section := range getSections() {
+		data, err := section.Export(networkID)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to export %s: %s", section.GetName(), err)
+		}
+		sections[section.GetName()] = data
+	}
+
+	return &Archive{
+		Version:    ArchiveVersion,
+		NetworkID:  networkID,
+		ExportedAt: time.Now().Unix(),
+		Network:    marshaledNetwork,
+		Gateways:   gateways,
+		Sections:   sections,
+	}, nil
+}
+
+// Import recreates the network in an archive and returns its ID. The target
+// network must not exist. Services do not share a transaction, so if any
+// part of the import fails, everything imported so far is removed again
+// before the error is returned.
+func Import(archive *Archive, opts ImportOptions) (string, error) {
+	if archive == nil {
+		return "", fmt.Errorf("Archive must be non-nil")
+	}
+	if archive.Version != ArchiveVersion {
+		return "", fmt.Errorf("Unsupported archive version %d, expected %d", archive.Version, ArchiveVersion)
+	}
+	networkID := opts.NetworkID
+	if len(networkID) == 0 {
+		networkID = archive.NetworkID
+	}
+	if len(networkID) == 0 {
+		return "", fmt.Errorf("Network ID must be provided")
+	}
+
+	// Validate everything we can before writing anything
+	sectionsByName := map[string]Section{}
+	for _, section := range getSections() {
+		sectionsByName[section.GetName()] = section
+	}
+	for name := range archive.Sections {
+		if _, ok := sectionsByName[name]; !ok {
+			return "", fmt.Errorf("Archive contains unknown section %s", name)
+		}
+	}
+	networkRecord := &magmadprotos.MagmadNetworkRecord{}
+	if err := protos.Unmarshal(archive.Network, networkRecord); err != nil {
+		return "", fmt.Errorf("Failed to unmarshal network record: %s", err)
+	}
+	gatewayRecords := map[string]*magmadprotos.AccessGatewayRecord{}
+	skippedGatewayIDs := map[string]bool{}
+	for _, gateway := range archive.Gateways {
+		if opts.SkipGateways {
+			skippedGatewayIDs[gateway.ID] = true
+			continue
+		}
+		record := &magmadprotos.AccessGatewayRecord{}
+		if err := protos.Unmarshal(gateway.Record, record); err != nil {
+			return "", fmt.Errorf("Failed to unmarshal record of gateway %s: %s", gateway.ID, err)
+		}
+		gatewayRecords[gateway.ID] = record
+	}
+	existingNetworks, err := magmad.ListNetworks()
+	if err != nil {
+		return "", err
+	}
+	for _, existing := range existingNetworks {
+		if existing == networkID {
+			return "", fmt.Errorf("Network %s already exists", networkID)
+		}
+	}
+
+	if _, err := magmad.RegisterNetwork(networkRecord, networkID); err != nil {
+		return "", fmt.Errorf("Failed to register network %s: %s", networkID, err)
+	}
+	var imported []Section
+	rollback := func(cause error) error {
+		for i := len(imported) - 1; i >= 0; i-- {
+			if err := imported[i].Clear(networkID); err != nil {
+				glog.Errorf("Failed to roll back %s import into network %s: %s", imported[i].GetName(), networkID, err)
+			}
+		}
+		if err := magmad.ForceRemoveNetwork(networkID); err != nil {
+			glog.Errorf("Failed to roll back registration of network %s: %s", networkID, err)
+		}
+		return cause
+	}
+
+	for _, gateway := range archive.Gateways {
+		record, ok := gatewayRecords[gateway.ID]
+		if !ok {
+			continue
+		}
+		if _, err := magmad.RegisterGatewayWithId(networkID, record, gateway.ID); err != nil {
+			return "", rollback(fmt.Errorf("Failed to register gateway %s: %s", gateway.ID, err))
+		}
+	}
+
+	ctx := ImportContext{NetworkID: networkID, SourceNetworkID: archive.NetworkID, SkippedGatewayIDs: skippedGatewayIDs}
+	for _, section := range getSections() {
+		data, ok := archive.Sections[section.GetName()]
+		if !ok {
+			continue
+		}
+		// Sections may have written part of their data before failing
+		imported = append(imported, section)
+		if err := section.Import(ctx, data); err != nil {
+			return "", rollback(fmt.Errorf("Failed to import %s: %s", section.GetName(), err))
+		}
+	}
+	return networkID, nil
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package backup_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"magma/orc8r/cloud/go/backup"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/config"
+	dnsdconfig "magma/orc8r/cloud/go/services/dnsd/config"
+	dnsdprotos "magma/orc8r/cloud/go/services/dnsd/protos"
+	"magma/orc8r/cloud/go/services/magmad"
+	magmadconfig "magma/orc8r/cloud/go/services/magmad/config"
+	magmadprotos "magma/orc8r/cloud/go/services/magmad/protos"
+	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
+	"magma/orc8r/cloud/go/services/upgrade"
+	upgradeprotos "magma/orc8r/cloud/go/services/upgrade/protos"
+	upgrade_test_init "magma/orc8r/cloud/go/services/upgrade/test_init"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// failingSection fails every import
+type failingSection struct{}
+
+func (*failingSection) GetName() string { return "zzz_failing" }
+
+func (*failingSection) Export(networkID string) (json.RawMessage, error) {
+	return json.RawMessage("{}"), nil
+}
+
+func (*failingSection) Import(ctx backup.ImportContext, data json.RawMessage) error {
+	return errors.New("import failed")
+}
+
+func (*failingSection) Clear(networkID string) error { return nil }
+
+func TestExportImport(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	magmad_test_init.StartTestService(t)
+	upgrade_test_init.StartTestService(t)
+
+	_, err := magmad.RegisterNetwork(&magmadprotos.MagmadNetworkRecord{Name: "Production"}, "prod")
+	assert.NoError(t, err)
+	gwRecord := &magmadprotos.AccessGatewayRecord{HwId: &protos.AccessGatewayID{Id: "hw1"}, Name: "gw 1"}
+	_, err = magmad.RegisterGatewayWithId("prod", gwRecord, "gw1")
+	assert.NoError(t, err)
+	dnsConfig := &dnsdprotos.NetworkDNSConfig{EnableCaching: true, LocalTTL: 42}
+	assert.NoError(t, config.CreateConfig("prod", dnsdconfig.DnsdNetworkType, "prod", dnsConfig))
+	gwConfig := &magmadprotos.MagmadGatewayConfig{CheckinInterval: 15, Tier: "default"}
+	assert.NoError(t, config.CreateConfig("prod", magmadconfig.MagmadGatewayType, "gw1", gwConfig))
+	tier := &upgradeprotos.TierInfo{Name: "default", Version: "1.0.0", Images: []*upgradeprotos.ImageSpec{{Name: "img", Order: 1}}}
+	assert.NoError(t, upgrade.CreateTier("prod", "default", tier))
+
+	archive, err := backup.Export("prod")
+	assert.NoError(t, err)
+	assert.Equal(t, backup.ArchiveVersion, archive.Version)
+	assert.Equal(t, "prod", archive.NetworkID)
+	assert.Len(t, archive.Gateways, 1)
+	assert.Equal(t, "gw1", archive.Gateways[0].ID)
+	assert.Contains(t, archive.Sections, "configs")
+	assert.Contains(t, archive.Sections, "upgrade_tiers")
+
+	// Archives survive a JSON round trip
+	marshaledArchive, err := json.Marshal(archive)
+	assert.NoError(t, err)
+	archive = &backup.Archive{}
+	assert.NoError(t, json.Unmarshal(marshaledArchive, archive))
+
+	// Importing into an existing network fails
+	_, err = backup.Import(archive, backup.ImportOptions{})
+	assert.EqualError(t, err, "Network prod already exists")
+
+	// Clone without gateways: the network config key is rewritten and the
+	// gateway config is left out
+	networkID, err := backup.Import(archive, backup.ImportOptions{NetworkID: "lab", SkipGateways: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "lab", networkID)
+	network, err := magmad.GetNetwork("lab")
+	assert.NoError(t, err)
+	assert.Equal(t, "Production", network.Name)
+	gateways, err := magmad.ListGateways("lab")
+	assert.NoError(t, err)
+	assert.Empty(t, gateways)
+	actualDNS, err := config.GetConfig("lab", dnsdconfig.DnsdNetworkType, "lab")
+	assert.NoError(t, err)
+	assert.Equal(t, dnsConfig.String(), actualDNS.(*dnsdprotos.NetworkDNSConfig).String())
+	actualGwConfig, err := config.GetConfig("lab", magmadconfig.MagmadGatewayType, "gw1")
+	assert.NoError(t, err)
+	assert.Nil(t, actualGwConfig)
+	tiers, err := upgrade.GetTiers("lab", []string{})
+	assert.NoError(t, err)
+	assert.Equal(t, tier.String(), tiers["default"].String())
+
+	// Disaster recovery: recreate the original network with its gateways
+	assert.NoError(t, magmad.ForceRemoveNetwork("prod"))
+	assert.NoError(t, config.DeleteAllNetworkConfigs("prod"))
+	assert.NoError(t, upgrade.DeleteTier("prod", "default"))
+	networkID, err = backup.Import(archive, backup.ImportOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", networkID)
+	gateways, err = magmad.ListGateways("prod")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gw1"}, gateways)
+	actualRecord, err := magmad.FindGatewayRecord("prod", "gw1")
+	assert.NoError(t, err)
+	assert.Equal(t, gwRecord.String(), actualRecord.String())
+	actualGwConfig, err = config.GetConfig("prod", magmadconfig.MagmadGatewayType, "gw1")
+	assert.NoError(t, err)
+	assert.Equal(t, gwConfig.String(), actualGwConfig.(*magmadprotos.MagmadGatewayConfig).String())
+
+	// Unknown sections and versions are rejected before anything is written
+	archive.Sections["unknown"] = json.RawMessage("{}")
+	_, err = backup.Import(archive, backup.ImportOptions{NetworkID: "other"})
+	assert.EqualError(t, err, "Archive contains unknown section unknown")
+	delete(archive.Sections, "unknown")
+	archive.Version = 2
+	_, err = backup.Import(archive, backup.ImportOptions{NetworkID: "other"})
+	assert.EqualError(t, err, "Unsupported archive version 2, expected 1")
+	archive.Version = backup.ArchiveVersion
+
+	// A failed import is rolled back
+	assert.NoError(t, backup.RegisterSections(&failingSection{}))
+	archive.Sections["zzz_failing"] = json.RawMessage("{}")
+	_, err = backup.Import(archive, backup.ImportOptions{NetworkID: "other", SkipGateways: true})
+	assert.EqualError(t, err, "Failed to import zzz_failing: import failed")
+	networks, err := magmad.ListNetworks()
+	assert.NoError(t, err)
+	assert.NotContains(t, networks, "other")
+	actualDNS, err = config.GetConfig("other", dnsdconfig.DnsdNetworkType, "other")
+	assert.NoError(t, err)
+	assert.Nil(t, actualDNS)
+	tiers, err = upgrade.GetTiers("other", []string{})
+	assert.NoError(t, err)
+	assert.Empty(t, tiers)
+
+	// Section names are unique
+	assert.EqualError(t, backup.RegisterSections(&failingSection{}), "Backup section zzz_failing is already registered")
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package backup
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	ExportNetworkPath = handlers.NETWORKS_ROOT + "/:network_id/export"
+	ImportNetworkPath = handlers.NETWORKS_ROOT + "/import"
+
+	ParamRequestedID  = "requested_id"
+	ParamSkipGateways = "skip_gateways"
+)
+
+var networkIDRegex = regexp.MustCompile("^[a-z_][0-9a-z_]+$")
+
+// GetObsidianHandlers returns all obsidian handlers for network backups
+func GetObsidianHandlers() []handlers.Handler {
+	return []handlers.Handler{
+		{Path: ExportNetworkPath, Methods: handlers.GET, HandlerFunc: ExportNetworkHandler},
+		{Path: ImportNetworkPath, Methods: handlers.POST, HandlerFunc: ImportNetworkHandler},
+	}
+}
+
+// ExportNetworkHandler returns an archive of everything belonging to a network
+func ExportNetworkHandler(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	archive, err := Export(networkID)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, archive)
+}
+
+// ImportNetworkHandler creates a network from the archive in the request
+// body. The network is created under the requested_id query parameter if
+// provided, and under the ID it was exported from otherwise. Gateways are left
+// out if skip_gateways is true.
+func ImportNetworkHandler(c echo.Context) error {
+	// Creating networks requires wildcard network access
+	nerr := handlers.CheckNetworkAccess(c, handlers.NETWORK_WILDCARD)
+	if nerr != nil {
+		return nerr
+	}
+
+	opts := ImportOptions{NetworkID: c.QueryParam(ParamRequestedID)}
+	if len(opts.NetworkID) > 0 && !networkIDRegex.MatchString(opts.NetworkID) {
+		return handlers.HttpError(
+			fmt.Errorf("Network ID '%s' is not allowed. Network ID can only contain "+
+				"lowercase alphanumeric characters and underscore, and should start with a letter or underscore.", opts.NetworkID),
+			http.StatusBadRequest,
+		)
+	}
+	if skip := c.QueryParam(ParamSkipGateways); len(skip) > 0 {
+		var err error
+		if opts.SkipGateways, err = strconv.ParseBool(skip); err != nil {
+			return handlers.HttpError(fmt.Errorf("Invalid %s: %s", ParamSkipGateways, skip), http.StatusBadRequest)
+		}
+	}
+
+	archive := &Archive{}
+	if err := c.Bind(archive); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if archive.Version != ArchiveVersion {
+		return handlers.HttpError(
+			fmt.Errorf("Unsupported archive version %d, expected %d", archive.Version, ArchiveVersion),
+			http.StatusBadRequest,
+		)
+	}
+
+	networkID, err := Import(archive, opts)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusConflict)
+	}
+	return c.JSON(http.StatusCreated, networkID)
+}
@@ -9,6 +9,7 @@
 package blobstore_test
 
 import (
+	"errors"
 	"sort"
 	"testing"
 
@@ -199,4 +200,80 @@ func integration(t *testing.T, fact blobstore.BlobStorageFactory) {
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, []blobstore.Blob{{Type: "t3", Key: "k3", Value: []byte("v5"), Version: 0}}, getManyActual)
+	assert.NoError(t, store.Commit())
+
+	// Search across networks, by network, type and key prefix
+	store, err = fact.StartTransaction()
+	assert.NoError(t, err)
+
+	searchActual, err := store.Search(blobstore.SearchFilter{})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		map[string][]blobstore.Blob{
+			"network1": {
+				{Type: "t1", Key: "k2", Value: []byte("v2"), Version: 0},
+				{Type: "t2", Key: "k1", Value: []byte("v3"), Version: 0},
+				{Type: "t9", Key: "k9", Value: []byte("world"), Version: 0},
+			},
+			"network2": {
+				{Type: "t3", Key: "k3", Value: []byte("v5"), Version: 0},
+				{Type: "t3", Key: "k4", Value: []byte("v6"), Version: 0},
+			},
+		},
+		searchActual,
+	)
+
+	network2, prefix := "network2", "k4"
+	searchActual, err = store.Search(blobstore.SearchFilter{NetworkID: &network2, KeyPrefix: &prefix})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		map[string][]blobstore.Blob{
+			"network2": {{Type: "t3", Key: "k4", Value: []byte("v6"), Version: 0}},
+		},
+		searchActual,
+	)
+
+	// Local changes within the tx are included
+	err = store.Delete("network1", []storage.TypeAndKey{{Type: "t2", Key: "k1"}})
+	assert.NoError(t, err)
+	err = store.CreateOrUpdate("network2", []blobstore.Blob{{Type: "t1", Key: "k5", Value: []byte("v7")}})
+	assert.NoError(t, err)
+	searchActual, err = store.Search(blobstore.SearchFilter{Types: []string{"t1", "t2"}})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		map[string][]blobstore.Blob{
+			"network1": {{Type: "t1", Key: "k2", Value: []byte("v2"), Version: 0}},
+			"network2": {{Type: "t1", Key: "k5", Value: []byte("v7"), Version: 0}},
+		},
+		searchActual,
+	)
+	assert.NoError(t, store.Rollback())
+
+	// Conditional update only succeeds at the current version
+	store, err = fact.StartTransaction()
+	assert.NoError(t, err)
+	err = store.UpdateManyIfVersion("network2", []blobstore.Blob{
+		{Type: "t3", Key: "k3", Value: []byte("v8"), Version: 0},
+		{Type: "t3", Key: "k4", Value: []byte("v9"), Version: 0},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	store, err = fact.StartTransaction()
+	assert.NoError(t, err)
+	err = store.UpdateManyIfVersion("network2", []blobstore.Blob{{Type: "t3", Key: "k3", Value: []byte("v10"), Version: 0}})
+	assert.True(t, errors.Is(err, blobstore.ErrVersionConflict))
+	err = store.UpdateManyIfVersion("network2", []blobstore.Blob{{Type: "t3", Key: "k7", Value: []byte("v10"), Version: 0}})
+	assert.True(t, errors.Is(err, blobstore.ErrVersionConflict))
+	assert.NoError(t, store.Rollback())
+
+	store, err = fact.StartTransaction()
+	assert.NoError(t, err)
+	getActual, err = store.Get("network2", storage.TypeAndKey{Type: "t3", Key: "k3"})
+	assert.NoError(t, err)
+	assert.Equal(t, blobstore.Blob{Type: "t3", Key: "k3", Value: []byte("v8"), Version: 1}, getActual)
+	assert.NoError(t, store.Commit())
 }
@@ -187,6 +187,96 @@ func (store *memoryBlobStorage) CreateOrUpdate(networkID string, blobs []Blob) e
 	return nil
 }
 
+// UpdateManyIfVersion compares the versions with the shared map updated with
+// changes from the ongoing transaction. Unlike the SQL implementation, the
+// check isn't repeated on commit.
+func (store *memoryBlobStorage) UpdateManyIfVersion(networkID string, blobs []Blob) error {
+	store.Lock()
+	defer store.Unlock()
+
+	if err := store.validateTx(); err != nil {
+		return err
+	}
+
+	ids := blobsToIDs(blobs)
+	store.shared.RLock()
+	sharedBlobSet := store.getManyFromShared(networkID, ids)
+	store.shared.RUnlock()
+	existingBlobs, err := store.updateBlobsWithLocalChangesUnsafe(networkID, ids, sharedBlobSet)
+	if err != nil {
+		return err
+	}
+	existingByID := GetBlobsByTypeAndKey(existingBlobs)
+	for _, blob := range blobs {
+		existing, ok := existingByID[blob.toID()]
+		if !ok || existing.Version != blob.Version {
+			return fmt.Errorf("Blob (%s, %s, %s) is not at version %d: %w", networkID, blob.Type, blob.Key, blob.Version, ErrVersionConflict)
+		}
+	}
+
+	store.changes.initializeNetworkTable(networkID)
+	for _, blob := range blobs {
+		blob.Version++
+		store.changes[networkID][blob.toID()] = change{cType: CreateOrUpdate, blob: blob}
+	}
+	return nil
+}
+
+// Search grabs matching blobs from the shared map, then updates them with
+// changes from the ongoing transaction
+func (store *memoryBlobStorage) Search(filter SearchFilter) (map[string][]Blob, error) {
+	store.RLock()
+	defer store.RUnlock()
+
+	if err := store.validateTx(); err != nil {
+		return nil, err
+	}
+
+	matches := blobTable{}
+	store.shared.RLock()
+	for networkID, blobs := range store.shared.table {
+		for id, blob := range blobs {
+			if filter.Matches(networkID, blob) {
+				matches.initializeNetworkTable(networkID)
+				matches[networkID][id] = blob
+			}
+		}
+	}
+	store.shared.RUnlock()
+
+	for networkID, changes := range store.changes {
+		for id, change := range changes {
+			switch change.cType {
+			case Delete:
+				delete(matches[networkID], id)
+			case CreateOrUpdate:
+				if filter.Matches(networkID, change.blob) {
+					matches.initializeNetworkTable(networkID)
+					matches[networkID][id] = change.blob
+				}
+			default:
+				return nil, fmt.Errorf("This transcaction contains ill-formatted changes.")
+			}
+		}
+	}
+
+	ret := map[string][]Blob{}
+	for networkID, blobs := range matches {
+		if len(blobs) == 0 {
+			continue
+		}
+		blobList := blobs.toBlobList()
+		sort.Slice(blobList, func(i, j int) bool {
+			if blobList[i].Type != blobList[j].Type {
+				return blobList[i].Type < blobList[j].Type
+			}
+			return blobList[i].Key < blobList[j].Key
+		})
+		ret[networkID] = blobList
+	}
+	return ret, nil
+}
+
 func (store *memoryBlobStorage) Delete(networkID string, ids []storage.TypeAndKey) error {
 	store.Lock()
 	defer store.Unlock()
@@ -141,3 +141,40 @@ func (_m *TransactionalBlobStorage) Rollback() error {
 
 	return r0
 }
+
+// Search provides a mock function with given fields: filter
+func (_m *TransactionalBlobStorage) Search(filter blobstore.SearchFilter) (map[string][]blobstore.Blob, error) {
+	ret := _m.Called(filter)
+
+	var r0 map[string][]blobstore.Blob
+	if rf, ok := ret.Get(0).(func(blobstore.SearchFilter) map[string][]blobstore.Blob); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]blobstore.Blob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(blobstore.SearchFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateManyIfVersion provides a mock function with given fields: networkID, blobs
+func (_m *TransactionalBlobStorage) UpdateManyIfVersion(networkID string, blobs []blobstore.Blob) error {
+	ret := _m.Called(networkID, blobs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []blobstore.Blob) error); ok {
+		r0 = rf(networkID, blobs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -14,8 +14,10 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	magmaerrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/sql_utils"
 	"magma/orc8r/cloud/go/storage"
 )
 
@@ -178,6 +180,89 @@ func (store *sqlBlobStorage) CreateOrUpdate(networkID string, blobs []Blob) erro
 	return nil
 }
 
+func (store *sqlBlobStorage) UpdateManyIfVersion(networkID string, blobs []Blob) error {
+	if err := store.validateTx(); err != nil {
+		return err
+	}
+
+	// The version check and the update happen in a single statement so
+	// that concurrent transactions can't both succeed
+	queryFormat := "UPDATE %s SET value = $1, version = $2 WHERE network_id = $3 AND type = $4 AND key = $5 AND version = $6"
+	updateStmt, err := store.tx.Prepare(fmt.Sprintf(queryFormat, store.tableName))
+	if err != nil {
+		return fmt.Errorf("Error preparing update statement: %s", err)
+	}
+	defer updateStmt.Close()
+	for _, blob := range blobs {
+		result, err := updateStmt.Exec(blob.Value, blob.Version+1, networkID, blob.Type, blob.Key, blob.Version)
+		if err != nil {
+			return fmt.Errorf("Error updating blob (%s, %s, %s): %s", networkID, blob.Type, blob.Key, err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("Error updating blob (%s, %s, %s): %s", networkID, blob.Type, blob.Key, err)
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("Blob (%s, %s, %s) is not at version %d: %w", networkID, blob.Type, blob.Key, blob.Version, ErrVersionConflict)
+		}
+	}
+	return nil
+}
+
+func (store *sqlBlobStorage) Search(filter SearchFilter) (map[string][]Blob, error) {
+	if err := store.validateTx(); err != nil {
+		return nil, err
+	}
+
+	whereClauses := []string{}
+	args := []interface{}{}
+	if filter.NetworkID != nil {
+		args = append(args, *filter.NetworkID)
+		whereClauses = append(whereClauses, fmt.Sprintf("network_id = $%d", len(args)))
+	}
+	if len(filter.Types) > 0 {
+		inList := sql_utils.GetPlaceholderArgList(len(args)+1, len(filter.Types))
+		for _, t := range filter.Types {
+			args = append(args, t)
+		}
+		whereClauses = append(whereClauses, fmt.Sprintf("type IN %s", inList))
+	}
+	if filter.KeyPrefix != nil {
+		// substr instead of LIKE so we don't have to escape wildcards, and
+		// because LIKE is case-insensitive in sqlite
+		args = append(args, utf8.RuneCountInString(*filter.KeyPrefix), *filter.KeyPrefix)
+		whereClauses = append(whereClauses, fmt.Sprintf("substr(key, 1, $%d) = $%d", len(args)-1, len(args)))
+	}
+
+	where := ""
+	if len(whereClauses) > 0 {
+		where = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+	query := fmt.Sprintf(
+		"SELECT network_id, type, key, value, version FROM %s %s ORDER BY network_id, type, key",
+		store.tableName, where,
+	)
+	rows, err := store.tx.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := map[string][]Blob{}
+	for rows.Next() {
+		var networkID, t, k string
+		var val []byte
+		var version uint64
+
+		err = rows.Scan(&networkID, &t, &k, &val, &version)
+		if err != nil {
+			return nil, err
+		}
+		ret[networkID] = append(ret[networkID], Blob{Type: t, Key: k, Value: val, Version: version})
+	}
+	return ret, rows.Err()
+}
+
 func (store *sqlBlobStorage) Delete(networkID string, ids []storage.TypeAndKey) error {
 	if err := store.validateTx(); err != nil {
 		return err
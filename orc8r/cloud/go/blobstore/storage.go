@@ -9,9 +9,16 @@
 package blobstore
 
 import (
+	"errors"
+	"strings"
+
 	"magma/orc8r/cloud/go/storage"
 )
 
+// ErrVersionConflict is returned by UpdateManyIfVersion if a blob does not
+// exist or is no longer at the expected version.
+var ErrVersionConflict = errors.New("Blob version does not match expected version")
+
 // Blob encapsulates a blob for storage
 type Blob struct {
 	Type    string
@@ -60,10 +67,53 @@ type TransactionalBlobStorage interface {
 	// storage implementation.
 	CreateOrUpdate(networkID string, blobs []Blob) error
 
+	// UpdateManyIfVersion updates existing blobs only if they are still at
+	// the Version of the Blobs passed in, and increments their version.
+	// ErrVersionConflict is returned if any of the blobs doesn't match, in
+	// which case the transaction should be rolled back.
+	UpdateManyIfVersion(networkID string, blobs []Blob) error
+
+	// Search returns all blobs which match the filter, keyed by the network
+	// they belong to. Networks without any matching blobs are left out.
+	Search(filter SearchFilter) (map[string][]Blob, error)
+
 	// Delete deletes specified blobs from storage.
 	Delete(networkID string, ids []storage.TypeAndKey) error
 }
 
+// SearchFilter specifies which blobs to return from a search. Fields which
+// are nil or empty don't filter anything.
+type SearchFilter struct {
+	// If NetworkID is provided, only blobs on that network are returned.
+	NetworkID *string
+
+	// If Types is provided, only blobs of one of the given types are returned.
+	Types []string
+
+	// If KeyPrefix is provided, only blobs whose key starts with the prefix
+	// are returned.
+	KeyPrefix *string
+}
+
+// Matches returns true if the blob on the given network passes the filter.
+func (sf SearchFilter) Matches(networkID string, blob Blob) bool {
+	if sf.NetworkID != nil && *sf.NetworkID != networkID {
+		return false
+	}
+	if sf.KeyPrefix != nil && !strings.HasPrefix(blob.Key, *sf.KeyPrefix) {
+		return false
+	}
+	if len(sf.Types) == 0 {
+		return true
+	}
+	for _, t := range sf.Types {
+		if t == blob.Type {
+			return true
+		}
+	}
+	return false
+}
+
 // GetBlobsByTypeAndKey returns a computed view of a list of blobs as a map of
 // blobs keyed by blob TypeAndKey.
 func GetBlobsByTypeAndKey(blobs []Blob) map[storage.TypeAndKey]Blob {
@@ -29,6 +29,25 @@ func IsErrNotFound(err error) bool {
 	return false
 }
 
+// ErrGenerationConflict is returned by conditional writes when the record
+// was modified since the caller read it, i.e. its generation no longer
+// matches the expected one. handlers.HttpError maps it to HTTP 409.
+var ErrGenerationConflict = errors.New("Record generation does not match expected generation")
+
+// IsErrGenerationConflict returns true if err is or wraps
+// ErrGenerationConflict. Wrap it with %w to keep it detectable.
+func IsErrGenerationConflict(err error) bool {
+	return errors.Is(err, ErrGenerationConflict)
+}
+
+// ErrAlreadyExists is returned by inserts when a record for the key exists.
+var ErrAlreadyExists = errors.New("Record already exists")
+
+// IsErrAlreadyExists returns true if err is or wraps ErrAlreadyExists.
+func IsErrAlreadyExists(err error) bool {
+	return errors.Is(err, ErrAlreadyExists)
+}
+
 type Api interface {
 	Put(table string, key string, value []byte) error
 	PutMany(table string, valuesToPut map[string][]byte) (map[string]error, error)
@@ -36,7 +55,31 @@ type Api interface {
 	GetMany(table string, keys []string) (map[string]ValueWrapper, error)
 	Delete(table string, key string) error
 	DeleteMany(table string, keys []string) (map[string]error, error)
+
+	// PutIfGeneration updates an existing record only if its generation is
+	// still the given one. It returns ErrNotFound if the record does not
+	// exist and ErrGenerationConflict if the generation does not match.
+	PutIfGeneration(table string, key string, value []byte, generation uint64) error
+	// PutManyIfGeneration is the batch equivalent of PutIfGeneration, with
+	// the expected generation of each key held in its ValueWrapper.
+	// Errors for individual keys are returned in the map.
+	PutManyIfGeneration(table string, valuesToPut map[string]ValueWrapper) (map[string]error, error)
+	// InsertMany adds records which don't exist yet. Existing records are
+	// left untouched and get ErrAlreadyExists.
+	// Errors for individual keys are returned in the map.
+	InsertMany(table string, valuesToPut map[string][]byte) (map[string]error, error)
+	// DeleteIfGeneration deletes a record only if its generation is still the
+	// given one, with the same errors as PutIfGeneration.
+	DeleteIfGeneration(table string, key string, generation uint64) error
+	// DeleteManyIfGeneration is the batch equivalent of DeleteIfGeneration.
+	// Errors for individual keys are returned in the map.
+	DeleteManyIfGeneration(table string, generationsByKey map[string]uint64) (map[string]error, error)
+
 	ListKeys(table string) ([]string, error)
+	// ListKeysPage returns a page of the table's keys matching the criteria
+	// in ascending order, along with the token for the next page. The token is
+	// empty if there are no more keys.
+	ListKeysPage(table string, criteria ListKeysCriteria) ([]string, string, error)
 	DeleteTable(table string) error
 	DoesKeyExist(table string, key string) (bool, error)
 }
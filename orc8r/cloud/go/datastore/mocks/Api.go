@@ -37,6 +37,20 @@ func (_m *Api) Delete(table string, key string) error {
 	return r0
 }
 
+// DeleteIfGeneration provides a mock function with given fields: table, key, generation
+func (_m *Api) DeleteIfGeneration(table string, key string, generation uint64) error {
+	ret := _m.Called(table, key, generation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, uint64) error); ok {
+		r0 = rf(table, key, generation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteMany provides a mock function with given fields: table, keys
 func (_m *Api) DeleteMany(table string, keys []string) (map[string]error, error) {
 	ret := _m.Called(table, keys)
@@ -60,6 +74,29 @@ func (_m *Api) DeleteMany(table string, keys []string) (map[string]error, error)
 	return r0, r1
 }
 
+// DeleteManyIfGeneration provides a mock function with given fields: table, generationsByKey
+func (_m *Api) DeleteManyIfGeneration(table string, generationsByKey map[string]uint64) (map[string]error, error) {
+	ret := _m.Called(table, generationsByKey)
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func(string, map[string]uint64) map[string]error); ok {
+		r0 = rf(table, generationsByKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]uint64) error); ok {
+		r1 = rf(table, generationsByKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteTable provides a mock function with given fields: table
 func (_m *Api) DeleteTable(table string) error {
 	ret := _m.Called(table)
@@ -171,6 +208,36 @@ func (_m *Api) ListKeys(table string) ([]string, error) {
 	return r0, r1
 }
 
+// ListKeysPage provides a mock function with given fields: table, criteria
+func (_m *Api) ListKeysPage(table string, criteria datastore.ListKeysCriteria) ([]string, string, error) {
+	ret := _m.Called(table, criteria)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, datastore.ListKeysCriteria) []string); ok {
+		r0 = rf(table, criteria)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string, datastore.ListKeysCriteria) string); ok {
+		r1 = rf(table, criteria)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, datastore.ListKeysCriteria) error); ok {
+		r2 = rf(table, criteria)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Put provides a mock function with given fields: table, key, value
 func (_m *Api) Put(table string, key string, value []byte) error {
 	ret := _m.Called(table, key, value)
@@ -185,6 +252,20 @@ func (_m *Api) Put(table string, key string, value []byte) error {
 	return r0
 }
 
+// PutIfGeneration provides a mock function with given fields: table, key, value, generation
+func (_m *Api) PutIfGeneration(table string, key string, value []byte, generation uint64) error {
+	ret := _m.Called(table, key, value, generation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, []byte, uint64) error); ok {
+		r0 = rf(table, key, value, generation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutMany provides a mock function with given fields: table, valuesToPut
 func (_m *Api) PutMany(table string, valuesToPut map[string][]byte) (map[string]error, error) {
 	ret := _m.Called(table, valuesToPut)
@@ -207,3 +288,49 @@ func (_m *Api) PutMany(table string, valuesToPut map[string][]byte) (map[string]
 
 	return r0, r1
 }
+
+// InsertMany provides a mock function with given fields: table, valuesToPut
+func (_m *Api) InsertMany(table string, valuesToPut map[string][]byte) (map[string]error, error) {
+	ret := _m.Called(table, valuesToPut)
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func(string, map[string][]byte) map[string]error); ok {
+		r0 = rf(table, valuesToPut)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string][]byte) error); ok {
+		r1 = rf(table, valuesToPut)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutManyIfGeneration provides a mock function with given fields: table, valuesToPut
+func (_m *Api) PutManyIfGeneration(table string, valuesToPut map[string]datastore.ValueWrapper) (map[string]error, error) {
+	ret := _m.Called(table, valuesToPut)
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func(string, map[string]datastore.ValueWrapper) map[string]error); ok {
+		r0 = rf(table, valuesToPut)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]datastore.ValueWrapper) error); ok {
+		r1 = rf(table, valuesToPut)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package datastore
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ListKeysCriteria specifies which keys ListKeysPage returns
+type ListKeysCriteria struct {
+	// KeyPrefix restricts the listed keys to keys starting with the prefix.
+	KeyPrefix string
+
+	// PageSize is the maximum number of keys to return. 0 returns all keys.
+	PageSize uint32
+
+	// PageToken is the opaque token returned with the previous page. Leave it
+	// empty to get the first page.
+	PageToken string
+}
+
+// EncodePageToken returns the opaque page token for a page which ends with
+// lastKey
+func EncodePageToken(lastKey string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+}
+
+// DecodePageToken returns the last key of the page which the token was
+// returned with. An empty token decodes to an empty key.
+func DecodePageToken(token string) (string, error) {
+	lastKey, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("Invalid page token: %s", token)
+	}
+	return string(lastKey), nil
+}
+
+// PageKeys applies the criteria to a complete list of keys. It is meant for
+// Api implementations which cannot push paging down to their storage.
+// The keys are returned in ascending order, with the token for the next page
+// which is empty if there are no more keys.
+func PageKeys(keys []string, criteria ListKeysCriteria) ([]string, string, error) {
+	lastKey, err := DecodePageToken(criteria.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if strings.HasPrefix(key, criteria.KeyPrefix) && (criteria.PageToken == "" || key > lastKey) {
+			sortedKeys = append(sortedKeys, key)
+		}
+	}
+	sort.Strings(sortedKeys)
+	if criteria.PageSize == 0 || uint32(len(sortedKeys)) <= criteria.PageSize {
+		return sortedKeys, "", nil
+	}
+	page := sortedKeys[:criteria.PageSize]
+	return page, EncodePageToken(page[len(page)-1]), nil
+}
@@ -11,6 +11,7 @@ package datastore
 import (
 	"database/sql"
 	"fmt"
+	"unicode/utf8"
 
 	"magma/orc8r/cloud/go/sql_utils"
 
@@ -132,6 +133,72 @@ func (store *SqlDb) PutMany(table string, valuesToPut map[string][]byte) (map[st
 	return errorMap, nil
 }
 
+func (store *SqlDb) PutIfGeneration(table string, key string, value []byte, generation uint64) error {
+	if err := initTable(store.db, table); err != nil {
+		return err
+	}
+	// The generation check and the update happen in a single statement so
+	// that concurrent writers can't both succeed
+	result, err := store.db.Exec(fmt.Sprintf(
+		"UPDATE %s SET value = $1, generation_number = $2 WHERE key = $3 AND generation_number = $4",
+		table), value, generation+1, key, generation)
+	if err != nil {
+		return err
+	}
+	return store.checkConditionalWrite(table, key, result)
+}
+
+func (store *SqlDb) PutManyIfGeneration(table string, valuesToPut map[string]ValueWrapper) (map[string]error, error) {
+	if err := initTable(store.db, table); err != nil {
+		return map[string]error{}, err
+	}
+
+	stmt, err := store.db.Prepare(fmt.Sprintf(
+		"UPDATE %s SET value = $1, generation_number = $2 WHERE key = $3 AND generation_number = $4",
+		table))
+	if err != nil {
+		return map[string]error{}, err
+	}
+	defer stmt.Close()
+
+	errorMap := make(map[string]error)
+	for key, newValue := range valuesToPut {
+		result, err := stmt.Exec(newValue.Value, newValue.Generation+1, key, newValue.Generation)
+		if err == nil {
+			err = store.checkConditionalWrite(table, key, result)
+		}
+		if err != nil {
+			errorMap[key] = err
+		}
+	}
+	return errorMap, nil
+}
+
+func (store *SqlDb) InsertMany(table string, valuesToPut map[string][]byte) (map[string]error, error) {
+	if err := initTable(store.db, table); err != nil {
+		return map[string]error{}, err
+	}
+
+	stmt, err := store.db.Prepare(fmt.Sprintf("INSERT INTO %s (key, value) VALUES($1, $2)", table))
+	if err != nil {
+		return map[string]error{}, err
+	}
+	defer stmt.Close()
+
+	errorMap := make(map[string]error)
+	for key, value := range valuesToPut {
+		// The primary key makes the insert fail if the record exists
+		if _, err = stmt.Exec(key, value); err == nil {
+			continue
+		}
+		if exists, existsErr := store.DoesKeyExist(table, key); existsErr == nil && exists {
+			err = ErrAlreadyExists
+		}
+		errorMap[key] = err
+	}
+	return errorMap, nil
+}
+
 func (store *SqlDb) Get(table string, key string) ([]byte, uint64, error) {
 	var value []byte
 	var generationNumber uint64
@@ -227,6 +294,64 @@ func (store *SqlDb) DeleteMany(table string, keys []string) (map[string]error, e
 	return errMap, nil
 }
 
+func (store *SqlDb) DeleteIfGeneration(table string, key string, generation uint64) error {
+	if err := initTable(store.db, table); err != nil {
+		return err
+	}
+	result, err := store.db.Exec(fmt.Sprintf(
+		"DELETE FROM %s WHERE key = $1 AND generation_number = $2", table),
+		key, generation)
+	if err != nil {
+		return err
+	}
+	return store.checkConditionalWrite(table, key, result)
+}
+
+func (store *SqlDb) DeleteManyIfGeneration(table string, generationsByKey map[string]uint64) (map[string]error, error) {
+	if err := initTable(store.db, table); err != nil {
+		return map[string]error{}, err
+	}
+
+	stmt, err := store.db.Prepare(fmt.Sprintf(
+		"DELETE FROM %s WHERE key = $1 AND generation_number = $2", table))
+	if err != nil {
+		return map[string]error{}, err
+	}
+	defer stmt.Close()
+
+	errMap := make(map[string]error)
+	for key, generation := range generationsByKey {
+		result, err := stmt.Exec(key, generation)
+		if err == nil {
+			err = store.checkConditionalWrite(table, key, result)
+		}
+		if err != nil {
+			errMap[key] = err
+		}
+	}
+	return errMap, nil
+}
+
+// checkConditionalWrite returns nil if a conditional write on key affected
+// a row. Otherwise it tells apart a missing record from a generation mismatch.
+func (store *SqlDb) checkConditionalWrite(table string, key string, result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+	exists, err := store.DoesKeyExist(table, key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return ErrGenerationConflict
+}
+
 func (store *SqlDb) ListKeys(table string) ([]string, error) {
 	if err := initTable(store.db, table); err != nil {
 		return nil, err
@@ -249,6 +374,51 @@ func (store *SqlDb) ListKeys(table string) ([]string, error) {
 	return keys, rows.Err()
 }
 
+func (store *SqlDb) ListKeysPage(table string, criteria ListKeysCriteria) ([]string, string, error) {
+	if err := initTable(store.db, table); err != nil {
+		return nil, "", err
+	}
+	lastKey, err := DecodePageToken(criteria.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// substr is used rather than LIKE since LIKE is case-insensitive in
+	// sqlite and would require escaping wildcards in the prefix
+	query := fmt.Sprintf(
+		"SELECT key FROM %s WHERE key > $1 AND substr(key, 1, $2) = $3 ORDER BY key",
+		table,
+	)
+	args := []interface{}{lastKey, utf8.RuneCountInString(criteria.KeyPrefix), criteria.KeyPrefix}
+	if criteria.PageSize > 0 {
+		// Fetch one extra key to find out whether there is a next page
+		query += " LIMIT $4"
+		args = append(args, criteria.PageSize+1)
+	}
+	rows, err := store.db.Query(query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	keys := make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err = rows.Scan(&key); err != nil {
+			return nil, "", err
+		}
+		keys = append(keys, key)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+	if criteria.PageSize == 0 || uint32(len(keys)) <= criteria.PageSize {
+		return keys, "", nil
+	}
+	keys = keys[:criteria.PageSize]
+	return keys, EncodePageToken(keys[len(keys)-1]), nil
+}
+
 func (store *SqlDb) DeleteTable(table string) error {
 	_, err := store.db.Exec("DROP TABLE IF EXISTS " + table)
 	return err
@@ -9,6 +9,8 @@ LICENSE file in the root directory of this source tree.
 package datastore_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"magma/orc8r/cloud/go/datastore"
@@ -147,3 +149,162 @@ func TestDatastoreBulkOperations(t *testing.T) {
 	assert.Equal(t, expectedDbRows, dbRows)
 
 }
+
+func TestDatastoreConditionalOperations(t *testing.T) {
+	table := "test"
+	ds, err := datastore.NewSqlDb("sqlite3", ":memory:")
+	assert.NoError(t, err)
+
+	// Conditional writes don't create records
+	err = ds.PutIfGeneration(table, "key1", []byte("value1"), 0)
+	assert.Equal(t, datastore.ErrNotFound, err)
+	err = ds.DeleteIfGeneration(table, "key1", 0)
+	assert.Equal(t, datastore.ErrNotFound, err)
+
+	err = ds.Put(table, "key1", []byte("value1"))
+	assert.NoError(t, err)
+
+	// Matching generation succeeds and bumps the generation
+	err = ds.PutIfGeneration(table, "key1", []byte("value2"), 0)
+	assert.NoError(t, err)
+	value, generation, err := ds.Get(table, "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value2"), value)
+	assert.Equal(t, uint64(1), generation)
+
+	// Stale generation is a conflict and leaves the record alone
+	err = ds.PutIfGeneration(table, "key1", []byte("value3"), 0)
+	assert.Equal(t, datastore.ErrGenerationConflict, err)
+	assert.True(t, datastore.IsErrGenerationConflict(err))
+	assert.True(t, datastore.IsErrGenerationConflict(fmt.Errorf("Error updating key1: %w", err)))
+	assert.False(t, datastore.IsErrGenerationConflict(errors.New(err.Error())))
+	err = ds.DeleteIfGeneration(table, "key1", 0)
+	assert.True(t, datastore.IsErrGenerationConflict(err))
+	value, generation, err = ds.Get(table, "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value2"), value)
+	assert.Equal(t, uint64(1), generation)
+
+	err = ds.DeleteIfGeneration(table, "key1", 1)
+	assert.NoError(t, err)
+	_, _, err = ds.Get(table, "key1")
+	assert.Equal(t, datastore.ErrNotFound, err)
+
+	// Batch operations report failures per key
+	_, err = ds.PutMany(table, map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+	})
+	assert.NoError(t, err)
+	failedKeys, err := ds.PutManyIfGeneration(table, map[string]datastore.ValueWrapper{
+		"key1": {Value: []byte("newvalue1"), Generation: 0},
+		"key2": {Value: []byte("newvalue2"), Generation: 1},
+		"key3": {Value: []byte("newvalue3"), Generation: 0},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]error{
+		"key2": datastore.ErrGenerationConflict,
+		"key3": datastore.ErrNotFound,
+	}, failedKeys)
+	dbRows, err := ds.GetMany(table, []string{"key1", "key2", "key3"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]datastore.ValueWrapper{
+		"key1": {Value: []byte("newvalue1"), Generation: 1},
+		"key2": {Value: []byte("value2"), Generation: 0},
+	}, dbRows)
+
+	failedKeys, err = ds.DeleteManyIfGeneration(table, map[string]uint64{
+		"key1": 0,
+		"key2": 0,
+		"key3": 0,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]error{
+		"key1": datastore.ErrGenerationConflict,
+		"key3": datastore.ErrNotFound,
+	}, failedKeys)
+	keys, err := ds.ListKeys(table)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key1"}, keys)
+}
+
+func TestDatastoreInsertMany(t *testing.T) {
+	table := "test"
+	ds, err := datastore.NewSqlDb("sqlite3", ":memory:")
+	assert.NoError(t, err)
+
+	err = ds.Put(table, "key1", []byte("value1"))
+	assert.NoError(t, err)
+
+	// Existing records are left untouched
+	failedKeys, err := ds.InsertMany(table, map[string][]byte{
+		"key1": []byte("newvalue1"),
+		"key2": []byte("value2"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]error{"key1": datastore.ErrAlreadyExists}, failedKeys)
+	assert.True(t, datastore.IsErrAlreadyExists(fmt.Errorf("Error adding key1: %w", failedKeys["key1"])))
+	dbRows, err := ds.GetMany(table, []string{"key1", "key2"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]datastore.ValueWrapper{
+		"key1": {Value: []byte("value1"), Generation: 0},
+		"key2": {Value: []byte("value2"), Generation: 0},
+	}, dbRows)
+}
+
+func TestDatastoreListKeysPage(t *testing.T) {
+	table := "test_table"
+	ds, err := datastore.NewSqlDb("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	_, err = ds.PutMany(table, map[string][]byte{
+		"IMSI001": []byte("a"),
+		"IMSI002": []byte("b"),
+		"IMSI003": []byte("c"),
+		"imsi004": []byte("d"),
+		"IMSI1_%": []byte("e"),
+	})
+	assert.NoError(t, err)
+
+	// Page through all keys
+	keys, token, err := ds.ListKeysPage(table, datastore.ListKeysCriteria{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI001", "IMSI002"}, keys)
+	assert.NotEmpty(t, token)
+	keys, token, err = ds.ListKeysPage(table, datastore.ListKeysCriteria{PageSize: 2, PageToken: token})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI003", "IMSI1_%"}, keys)
+	assert.NotEmpty(t, token)
+	keys, token, err = ds.ListKeysPage(table, datastore.ListKeysCriteria{PageSize: 2, PageToken: token})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"imsi004"}, keys)
+	assert.Empty(t, token)
+
+	// The prefix is case-sensitive and has no wildcards
+	keys, token, err = ds.ListKeysPage(table, datastore.ListKeysCriteria{KeyPrefix: "IMSI00"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI001", "IMSI002", "IMSI003"}, keys)
+	assert.Empty(t, token)
+	keys, _, err = ds.ListKeysPage(table, datastore.ListKeysCriteria{KeyPrefix: "IMSI_"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, keys)
+
+	// A page which exactly fits the remaining keys has no next page
+	keys, token, err = ds.ListKeysPage(table, datastore.ListKeysCriteria{KeyPrefix: "IMSI00", PageSize: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI001", "IMSI002", "IMSI003"}, keys)
+	assert.Empty(t, token)
+
+	// The in-memory implementation agrees
+	allKeys, err := ds.ListKeys(table)
+	assert.NoError(t, err)
+	keys, token, err = datastore.PageKeys(allKeys, datastore.ListKeysCriteria{KeyPrefix: "IMSI", PageSize: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI001", "IMSI002"}, keys)
+	keys, token, err = datastore.PageKeys(allKeys, datastore.ListKeysCriteria{KeyPrefix: "IMSI", PageSize: 2, PageToken: token})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IMSI003", "IMSI1_%"}, keys)
+	assert.Empty(t, token)
+
+	_, _, err = ds.ListKeysPage(table, datastore.ListKeysCriteria{PageToken: "!!!"})
+	assert.Error(t, err)
+}
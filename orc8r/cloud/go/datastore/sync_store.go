@@ -55,14 +55,56 @@ func (s *SyncStore) DeleteMany(table string, keys []string) (map[string]error, e
 	return s.store.DeleteMany(table, keys)
 }
 
+func (s *SyncStore) PutIfGeneration(table string, key string, value []byte, generation uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.PutIfGeneration(table, key, value, generation)
+}
+
+func (s *SyncStore) PutManyIfGeneration(table string, valuesToPut map[string]ValueWrapper) (map[string]error, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.PutManyIfGeneration(table, valuesToPut)
+}
+
+func (s *SyncStore) InsertMany(table string, valuesToPut map[string][]byte) (map[string]error, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.InsertMany(table, valuesToPut)
+}
+
+func (s *SyncStore) DeleteIfGeneration(table string, key string, generation uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.DeleteIfGeneration(table, key, generation)
+}
+
+func (s *SyncStore) DeleteManyIfGeneration(table string, generationsByKey map[string]uint64) (map[string]error, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.DeleteManyIfGeneration(table, generationsByKey)
+}
+
 func (s *SyncStore) ListKeys(table string) ([]string, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	return s.store.ListKeys(table)
 }
 
+func (s *SyncStore) ListKeysPage(table string, criteria ListKeysCriteria) ([]string, string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.ListKeysPage(table, criteria)
+}
+
 func (s *SyncStore) DeleteTable(table string) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	return s.store.DeleteTable(table)
 }
+
+func (s *SyncStore) DoesKeyExist(table string, key string) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.store.DoesKeyExist(table, key)
+}
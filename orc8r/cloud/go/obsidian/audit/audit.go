@@ -0,0 +1,251 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package audit records every mutating (POST/PUT/DELETE) REST API call made
+// through obsidian, persists the records to the datastore and serves them
+// back through a query endpoint.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// AuditTableName is the datastore table which holds the audit log
+	AuditTableName = "obsidian_audit_log"
+
+	// DefaultQueryLimit is the number of entries returned by a query which
+	// does not specify a limit
+	DefaultQueryLimit = 100
+
+	// DefaultRetention is how long audit entries are kept by default
+	DefaultRetention = 90 * 24 * time.Hour
+
+	// pageSize is the number of entries loaded from the datastore at once
+	// by queries and retention sweeps, keeping the number of bind
+	// parameters per statement far below the database's limit
+	pageSize = 500
+)
+
+// Entry is a single audit log record of a mutating REST API call
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	// OperatorID is the operator identified by the request's client
+	// certificate. It is empty if the certificate could not be resolved.
+	OperatorID    string `json:"operator_id,omitempty"`
+	CertificateSN string `json:"certificate_sn,omitempty"`
+	NetworkID     string `json:"network_id,omitempty"`
+	Method        string `json:"method"`
+	Path          string `json:"path"`
+	RemoteAddr    string `json:"remote_addr,omitempty"`
+	// Body is the request body with all secret fields redacted
+	Body       json.RawMessage `json:"body,omitempty"`
+	StatusCode int             `json:"status_code"`
+}
+
+// Filter specifies which entries a Query returns. Empty fields match all
+// entries.
+type Filter struct {
+	NetworkID  string
+	OperatorID string
+	// Start and End bound the entry timestamps (inclusive); zero values
+	// leave the range open.
+	Start time.Time
+	End   time.Time
+	// Limit is the maximum number of entries returned, DefaultQueryLimit
+	// if not positive.
+	Limit int
+}
+
+var (
+	storeLock sync.RWMutex
+	store     datastore.Api
+
+	keySequence uint32
+)
+
+// SetStore sets the datastore which audit entries are persisted to. Auditing
+// is disabled until a store is set.
+func SetStore(ds datastore.Api) {
+	storeLock.Lock()
+	defer storeLock.Unlock()
+	store = ds
+}
+
+func getStore() datastore.Api {
+	storeLock.RLock()
+	defer storeLock.RUnlock()
+	return store
+}
+
+// Record persists an audit entry
+func Record(entry *Entry) error {
+	ds := getStore()
+	if ds == nil {
+		return fmt.Errorf("Audit store is not initialized")
+	}
+	marshaled, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return ds.Put(AuditTableName, newEntryKey(entry.Timestamp), marshaled)
+}
+
+// Query returns the audit entries matching the filter, newest first. Entries
+// are loaded a page at a time starting at the end of the time range, so only
+// as many entries as needed to fill the limit are read.
+func Query(filter Filter) ([]*Entry, error) {
+	ds := getStore()
+	if ds == nil {
+		return nil, fmt.Errorf("Audit store is not initialized")
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	ret := make([]*Entry, 0, limit)
+
+	// Keys sort newest first, so the query starts right before the first
+	// key of the end of the time range
+	criteria := datastore.ListKeysCriteria{PageSize: pageSize}
+	if !filter.End.IsZero() {
+		criteria.PageToken = datastore.EncodePageToken(getKeyTimestamp(filter.End))
+	}
+	var startKey string
+	if !filter.Start.IsZero() {
+		startKey = getKeyTimestamp(filter.Start)
+	}
+	for {
+		keys, nextPageToken, err := ds.ListKeysPage(AuditTableName, criteria)
+		if err != nil {
+			return nil, err
+		}
+		pastStart := false
+		for i, key := range keys {
+			if startKey != "" && getKeyTimestampPart(key) > startKey {
+				keys, pastStart = keys[:i], true
+				break
+			}
+		}
+		if len(keys) > 0 {
+			marshaledEntries, err := ds.GetMany(AuditTableName, keys)
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range keys {
+				marshaled, ok := marshaledEntries[key]
+				if !ok {
+					continue
+				}
+				entry := &Entry{}
+				if err := json.Unmarshal(marshaled.Value, entry); err != nil {
+					return nil, fmt.Errorf("Failed to unmarshal audit entry %s: %s", key, err)
+				}
+				if filter.NetworkID != "" && entry.NetworkID != filter.NetworkID {
+					continue
+				}
+				if filter.OperatorID != "" && entry.OperatorID != filter.OperatorID {
+					continue
+				}
+				ret = append(ret, entry)
+				if len(ret) >= limit {
+					return ret, nil
+				}
+			}
+		}
+		if pastStart || nextPageToken == "" {
+			return ret, nil
+		}
+		criteria.PageToken = nextPageToken
+	}
+}
+
+// DeleteEntriesBefore deletes all audit entries recorded before the given
+// time and returns how many were deleted
+func DeleteEntriesBefore(before time.Time) (int, error) {
+	ds := getStore()
+	if ds == nil {
+		return 0, fmt.Errorf("Audit store is not initialized")
+	}
+	// Keys sort newest first, so the entries to delete are all the keys
+	// after the last possible key of the given time
+	criteria := datastore.ListKeysCriteria{
+		PageSize:  pageSize,
+		PageToken: datastore.EncodePageToken(fmt.Sprintf("%s.%06d", getKeyTimestamp(before), 999999)),
+	}
+	deleted := 0
+	for {
+		keys, nextPageToken, err := ds.ListKeysPage(AuditTableName, criteria)
+		if err != nil {
+			return deleted, err
+		}
+		if len(keys) > 0 {
+			if _, err := ds.DeleteMany(AuditTableName, keys); err != nil {
+				return deleted, err
+			}
+			deleted += len(keys)
+		}
+		if nextPageToken == "" {
+			return deleted, nil
+		}
+		criteria.PageToken = nextPageToken
+	}
+}
+
+// EnforceRetention deletes the audit entries older than retention every
+// interval, until done is closed. Errors are logged and retried at the next
+// interval.
+func EnforceRetention(retention time.Duration, interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		deleted, err := DeleteEntriesBefore(time.Now().Add(-retention))
+		if err != nil {
+			glog.Errorf("Failed to delete expired audit entries: %s", err)
+		} else if deleted > 0 {
+			glog.Infof("Deleted %d expired audit entries", deleted)
+		}
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newEntryKey returns a datastore key which sorts newest first: the
+// zero-padded difference between the maximum and the entry's Unix nanosecond
+// timestamp, followed by a sequence number which keeps keys of entries
+// recorded within the same nanosecond unique.
+func newEntryKey(timestamp time.Time) string {
+	seq := atomic.AddUint32(&keySequence, 1) % 1000000
+	return fmt.Sprintf("%s.%06d", getKeyTimestamp(timestamp), 999999-seq)
+}
+
+// getKeyTimestamp returns the timestamp part of the keys of entries recorded
+// at the given time
+func getKeyTimestamp(timestamp time.Time) string {
+	return fmt.Sprintf("%019d", math.MaxInt64-timestamp.UnixNano())
+}
+
+func getKeyTimestampPart(key string) string {
+	if idx := strings.Index(key, "."); idx >= 0 {
+		return key[:idx]
+	}
+	return key
+}
@@ -0,0 +1,262 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/obsidian/access"
+	access_tests "magma/orc8r/cloud/go/obsidian/access/tests"
+	"magma/orc8r/cloud/go/obsidian/audit"
+	"magma/orc8r/cloud/go/test_utils"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	redacted := audit.RedactBody([]byte(`{
+		"id": "IMSI001010000000001",
+		"lte": {"state": "ACTIVE", "auth_algo": "MILENAGE", "lte_auth_key": "AAAAAAAAAAAAAAAAAAAAAA==", "LTE_AUTH_OPC": "BBBB"},
+		"receivers": [{"slack_configs": [{"api_url": "https://hooks.slack.com/x"}]}]
+	}`))
+	assert.JSONEq(
+		t,
+		`{
+			"id": "IMSI001010000000001",
+			"lte": {"state": "ACTIVE", "auth_algo": "MILENAGE", "lte_auth_key": "[REDACTED]", "LTE_AUTH_OPC": "[REDACTED]"},
+			"receivers": [{"slack_configs": [{"api_url": "[REDACTED]"}]}]
+		}`,
+		string(redacted),
+	)
+
+	// Fields are also matched by their suffix
+	redacted = audit.RedactBody([]byte(`{
+		"auth_topc": "a", "auth_secret": "b", "new_root_key": "c", "Encrypted_OPC": "d",
+		"admin_password": "e", "auth_key_bits": 256, "key": "f"
+	}`))
+	assert.JSONEq(
+		t,
+		`{
+			"auth_topc": "[REDACTED]", "auth_secret": "[REDACTED]", "new_root_key": "[REDACTED]",
+			"Encrypted_OPC": "[REDACTED]", "admin_password": "[REDACTED]", "auth_key_bits": 256, "key": "f"
+		}`,
+		string(redacted),
+	)
+
+	assert.Nil(t, audit.RedactBody(nil))
+	assert.Equal(t, `"(7 bytes of non-JSON content omitted)"`, string(audit.RedactBody([]byte("foo bar"))))
+	tooLarge := []byte(`"` + strings.Repeat("a", audit.MaxBodySize) + `"`)
+	assert.NotContains(t, string(audit.RedactBody(tooLarge)), "aaaa")
+}
+
+func TestRecordAndQuery(t *testing.T) {
+	audit.SetStore(test_utils.NewMockDatastore())
+	defer audit.SetStore(nil)
+
+	base := time.Unix(1500000000, 0)
+	entries := []*audit.Entry{
+		{Timestamp: base, OperatorID: "alice", NetworkID: "net1", Method: "POST", Path: "/a", StatusCode: 201},
+		{Timestamp: base.Add(time.Minute), OperatorID: "bob", NetworkID: "net1", Method: "PUT", Path: "/b", StatusCode: 200},
+		{Timestamp: base.Add(2 * time.Minute), OperatorID: "alice", NetworkID: "net2", Method: "DELETE", Path: "/c", StatusCode: 204},
+		{Timestamp: base.Add(3 * time.Minute), OperatorID: "alice", NetworkID: "net1", Method: "PUT", Path: "/d", StatusCode: 403},
+	}
+	for _, entry := range entries {
+		assert.NoError(t, audit.Record(entry))
+	}
+
+	actual, err := audit.Query(audit.Filter{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/d", "/c", "/b", "/a"}, getPaths(actual))
+
+	actual, err = audit.Query(audit.Filter{NetworkID: "net1", OperatorID: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/d", "/a"}, getPaths(actual))
+
+	actual, err = audit.Query(audit.Filter{Start: base.Add(time.Minute), End: base.Add(2 * time.Minute)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/c", "/b"}, getPaths(actual))
+
+	actual, err = audit.Query(audit.Filter{NetworkID: "net1", Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/d", "/b"}, getPaths(actual))
+
+	actual, err = audit.Query(audit.Filter{Start: base.Add(time.Hour)})
+	assert.NoError(t, err)
+	assert.Equal(t, []*audit.Entry{}, actual)
+}
+
+func TestQuery_Paging(t *testing.T) {
+	store, err := datastore.NewSqlDb("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	audit.SetStore(store)
+	defer audit.SetStore(nil)
+
+	// Spread entries over several pages
+	base := time.Unix(1500000000, 0)
+	for i := 0; i < 1200; i++ {
+		networkID := "net1"
+		if i%2 == 1 {
+			networkID = "net2"
+		}
+		entry := &audit.Entry{Timestamp: base.Add(time.Duration(i) * time.Second), NetworkID: networkID, Method: "POST", Path: fmt.Sprintf("/%d", i)}
+		assert.NoError(t, audit.Record(entry))
+	}
+
+	actual, err := audit.Query(audit.Filter{Limit: 1000})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1000)
+	assert.Equal(t, "/1199", actual[0].Path)
+	assert.Equal(t, "/200", actual[999].Path)
+
+	actual, err = audit.Query(audit.Filter{
+		NetworkID: "net1",
+		Start:     base.Add(100 * time.Second),
+		End:       base.Add(1100 * time.Second),
+		Limit:     1000,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 501)
+	assert.Equal(t, "/1100", actual[0].Path)
+	assert.Equal(t, "/100", actual[500].Path)
+}
+
+func TestDeleteEntriesBefore(t *testing.T) {
+	audit.SetStore(test_utils.NewMockDatastore())
+	defer audit.SetStore(nil)
+
+	base := time.Unix(1500000000, 0)
+	for i, path := range []string{"/a", "/b", "/c", "/d"} {
+		assert.NoError(t, audit.Record(&audit.Entry{Timestamp: base.Add(time.Duration(i) * time.Minute), Path: path}))
+	}
+
+	deleted, err := audit.DeleteEntriesBefore(base.Add(2 * time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	actual, err := audit.Query(audit.Filter{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/d", "/c"}, getPaths(actual))
+
+	deleted, err = audit.DeleteEntriesBefore(base)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+}
+
+func TestMiddleware(t *testing.T) {
+	audit.SetStore(test_utils.NewMockDatastore())
+	defer audit.SetStore(nil)
+	operatorSN := access_tests.StartMockAccessControl(t, "audit_admin")
+
+	e := echo.New()
+	e.Use(audit.Middleware)
+	var handledBody string
+	e.POST("/magma/networks/:network_id/subscribers", func(c echo.Context) error {
+		// The handler still sees the full, unredacted body
+		body, err := ioutil.ReadAll(c.Request().Body)
+		assert.NoError(t, err)
+		handledBody = string(body)
+		return c.NoContent(http.StatusCreated)
+	})
+	e.DELETE("/magma/networks/:network_id/subscribers/:subscriber_id", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusNotFound, "not found")
+	})
+	e.GET("/magma/networks/:network_id/subscribers", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	payload := `{"id":"IMSI1","lte":{"lte_auth_key":"c2VjcmV0"}}`
+	req := httptest.NewRequest(echo.POST, "/magma/networks/net1/subscribers", strings.NewReader(payload))
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, operatorSN)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, payload, handledBody)
+
+	req = httptest.NewRequest(echo.DELETE, "/magma/networks/net2/subscribers/IMSI1", nil)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, "unknown")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Reads are not audited
+	req = httptest.NewRequest(echo.GET, "/magma/networks/net1/subscribers", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	entries, err := audit.Query(audit.Filter{})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	assert.Equal(t, "DELETE", entries[0].Method)
+	assert.Equal(t, "net2", entries[0].NetworkID)
+	assert.Equal(t, "/magma/networks/net2/subscribers/IMSI1", entries[0].Path)
+	assert.Equal(t, "", entries[0].OperatorID)
+	assert.Equal(t, "unknown", entries[0].CertificateSN)
+	assert.Equal(t, http.StatusNotFound, entries[0].StatusCode)
+	assert.Nil(t, entries[0].Body)
+
+	assert.Equal(t, "POST", entries[1].Method)
+	assert.Equal(t, "net1", entries[1].NetworkID)
+	assert.Equal(t, "audit_admin", entries[1].OperatorID)
+	assert.Equal(t, http.StatusCreated, entries[1].StatusCode)
+	assert.JSONEq(t, `{"id":"IMSI1","lte":{"lte_auth_key":"[REDACTED]"}}`, string(entries[1].Body))
+
+	// Bodies too large to record are still passed to the handler in full
+	payload = `"` + strings.Repeat("a", audit.MaxBodySize) + `"`
+	req = httptest.NewRequest(echo.POST, "/magma/networks/net1/subscribers", strings.NewReader(payload))
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, payload, handledBody)
+	entries, err = audit.Query(audit.Filter{Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`"(more than %d bytes omitted)"`, audit.MaxBodySize), string(entries[0].Body))
+}
+
+func TestGetAuditLogHandler(t *testing.T) {
+	audit.SetStore(test_utils.NewMockDatastore())
+	defer audit.SetStore(nil)
+	base := time.Unix(1500000000, 0)
+	assert.NoError(t, audit.Record(&audit.Entry{Timestamp: base, OperatorID: "alice", NetworkID: "net1", Method: "POST", Path: "/a"}))
+	assert.NoError(t, audit.Record(&audit.Entry{Timestamp: base.Add(time.Hour), OperatorID: "bob", NetworkID: "net1", Method: "POST", Path: "/b"}))
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/magma/audit?network_id=net1&start=1500000000&end=2017-07-14T02:50:00Z", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := audit.GetAuditLogHandler(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var actual []*audit.Entry
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+	assert.Equal(t, []string{"/a"}, getPaths(actual))
+
+	req = httptest.NewRequest(echo.GET, "/magma/audit?limit=foo", nil)
+	c = e.NewContext(req, httptest.NewRecorder())
+	err = audit.GetAuditLogHandler(c)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+}
+
+func getPaths(entries []*audit.Entry) []string {
+	ret := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ret = append(ret, entry.Path)
+	}
+	return ret
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	AuditRootPath = handlers.REST_ROOT + handlers.URL_SEP + "audit"
+
+	ParamNetworkID  = "network_id"
+	ParamOperatorID = "operator_id"
+	ParamStart      = "start"
+	ParamEnd        = "end"
+	ParamLimit      = "limit"
+)
+
+// GetObsidianHandlers returns all obsidian handlers for the audit log
+func GetObsidianHandlers() []handlers.Handler {
+	return []handlers.Handler{
+		{Path: AuditRootPath, Methods: handlers.GET, HandlerFunc: GetAuditLogHandler},
+	}
+}
+
+// GetAuditLogHandler returns the audit entries matching the network_id,
+// operator_id, start and end (Unix seconds or RFC3339) query parameters,
+// newest first
+func GetAuditLogHandler(c echo.Context) error {
+	filter := Filter{
+		NetworkID:  c.QueryParam(ParamNetworkID),
+		OperatorID: c.QueryParam(ParamOperatorID),
+	}
+	var err error
+	if filter.Start, err = parseTime(c.QueryParam(ParamStart)); err != nil {
+		return handlers.HttpError(fmt.Errorf("Invalid %s: %s", ParamStart, err), http.StatusBadRequest)
+	}
+	if filter.End, err = parseTime(c.QueryParam(ParamEnd)); err != nil {
+		return handlers.HttpError(fmt.Errorf("Invalid %s: %s", ParamEnd, err), http.StatusBadRequest)
+	}
+	if limit := c.QueryParam(ParamLimit); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil || filter.Limit <= 0 {
+			return handlers.HttpError(fmt.Errorf("Invalid %s: %s", ParamLimit, limit), http.StatusBadRequest)
+		}
+	}
+
+	entries, err := Query(filter)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, entries)
+}
+
+// parseTime parses Unix seconds or an RFC3339 time, an empty string yields
+// the zero time
+func parseTime(timeString string) (time.Time, error) {
+	if timeString == "" {
+		return time.Time{}, nil
+	}
+	if secs, err := strconv.ParseFloat(timeString, 64); err == nil {
+		return time.Unix(int64(secs), 0), nil
+	}
+	return time.Parse(time.RFC3339, timeString)
+}
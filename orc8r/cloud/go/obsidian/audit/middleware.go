@@ -0,0 +1,100 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"magma/orc8r/cloud/go/obsidian/access"
+
+	"github.com/golang/glog"
+	"github.com/labstack/echo"
+)
+
+// Middleware records an audit entry for every POST, PUT and DELETE request
+// once it has been handled. Failing to record an entry is logged and does not
+// affect the response.
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		if req == nil || !isMutating(req.Method) || getStore() == nil {
+			return next(c)
+		}
+
+		var body []byte
+		if req.Body != nil {
+			// Only buffer as much of the body as can be recorded, the rest is
+			// streamed through to the handler
+			var err error
+			body, err = ioutil.ReadAll(io.LimitReader(req.Body, MaxBodySize+1))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Failed to read request body")
+			}
+			req.Body = &replayedBody{
+				Reader: io.MultiReader(bytes.NewReader(body), req.Body),
+				Closer: req.Body,
+			}
+		}
+
+		handlerErr := next(c)
+
+		entry := &Entry{
+			Timestamp:     time.Now(),
+			CertificateSN: req.Header.Get(access.CLIENT_CERT_SN_KEY),
+			NetworkID:     c.Param("network_id"),
+			Method:        req.Method,
+			Path:          req.URL.Path,
+			RemoteAddr:    req.RemoteAddr,
+			Body:          RedactBody(body),
+			StatusCode:    getStatusCode(c, handlerErr),
+		}
+		if entry.CertificateSN != "" {
+			if operator, err := access.RequestOperator(c); err == nil {
+				entry.OperatorID = operator.GetOperator()
+			}
+		}
+		if err := Record(entry); err != nil {
+			glog.Error(access.LogDecorator(c)("Failed to record audit entry: %s", err))
+		}
+		return handlerErr
+	}
+}
+
+// replayedBody is a request body whose start was already read for the audit
+// entry. It replays the buffered start before reading the rest of the body.
+type replayedBody struct {
+	io.Reader
+	io.Closer
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// getStatusCode returns the status code the request is answered with.
+// Errors returned by handlers are only written to the response by echo's
+// error handler after all middlewares ran, so the code is derived from the
+// error if there is one.
+func getStatusCode(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+	if httpErr, ok := err.(*echo.HTTPError); ok {
+		return httpErr.Code
+	}
+	return http.StatusInternalServerError
+}
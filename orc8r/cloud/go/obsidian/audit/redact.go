@@ -0,0 +1,117 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// RedactedValue replaces the values of secret fields in recorded bodies
+	RedactedValue = "[REDACTED]"
+
+	// MaxBodySize is the maximum size of a request body stored in an audit
+	// entry, larger bodies are replaced with a placeholder
+	MaxBodySize = 64 * 1024
+)
+
+// SecretFields holds the JSON field names whose values are never written to
+// the audit log, in addition to the fields matching SecretFieldSuffixes.
+// Field names are matched case-insensitively at any depth of the request body.
+var SecretFields = map[string]struct{}{
+	"lte_auth_key":  {},
+	"lte_auth_opc":  {},
+	"auth_key":      {},
+	"auth_opc":      {},
+	"auth_topc":     {},
+	"auth_secret":   {},
+	"opc":           {},
+	"password":      {},
+	"secret":        {},
+	"token":         {},
+	"api_url":       {},
+	"private_key":   {},
+	"service_key":   {},
+	"routing_key":   {},
+	"auth_password": {},
+}
+
+// SecretFieldSuffixes holds the suffixes of JSON field names whose values are
+// never written to the audit log, so that secret fields added to the API
+// later are redacted without being listed in SecretFields
+var SecretFieldSuffixes = []string{
+	"_key",
+	"_opc",
+	"_topc",
+	"_secret",
+	"password",
+}
+
+// RedactBody returns the JSON representation of a request body to store in
+// an audit entry. Values of SecretFields are replaced with RedactedValue.
+// Bodies which are not JSON or are too large are replaced with a JSON string
+// describing them, since their content can't be redacted.
+func RedactBody(body []byte) json.RawMessage {
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return nil
+	}
+	if len(body) > MaxBodySize {
+		return placeholderBody(fmt.Sprintf("(more than %d bytes omitted)", MaxBodySize))
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return placeholderBody(fmt.Sprintf("(%d bytes of non-JSON content omitted)", len(body)))
+	}
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return placeholderBody(fmt.Sprintf("(%d bytes omitted)", len(body)))
+	}
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if isSecretField(field) {
+				v[field] = RedactedValue
+			} else {
+				v[field] = redactValue(fieldValue)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = redactValue(elem)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSecretField(field string) bool {
+	field = strings.ToLower(field)
+	if _, isSecret := SecretFields[field]; isSecret {
+		return true
+	}
+	for _, suffix := range SecretFieldSuffixes {
+		if strings.HasSuffix(field, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func placeholderBody(description string) json.RawMessage {
+	ret, _ := json.Marshal(description)
+	return ret
+}
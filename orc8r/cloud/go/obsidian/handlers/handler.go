@@ -16,7 +16,9 @@ import (
 	"log"
 	"net/http"
 
+	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/obsidian/config"
+	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/util"
 
 	"github.com/labstack/echo"
@@ -163,6 +165,13 @@ func HttpError(err error, code ...int) *echo.HTTPError {
 		code[0] <= http.StatusNetworkAuthenticationRequired {
 		status = code[0]
 	}
+	// A conditional write lost a race with another writer, whatever the
+	// handler expected to go wrong. Services report conflicts across gRPC
+	// with protos.NewGrpcConflictError, since the datastore error itself does
+	// not survive the hop.
+	if datastore.IsErrGenerationConflict(err) || protos.IsGrpcConflictError(err) {
+		status = http.StatusConflict
+	}
 	log.Printf("REST HTTP Error: %s, Status: %d", err, status)
 	return echo.NewHTTPError(status, grpc.ErrorDesc(err))
 }
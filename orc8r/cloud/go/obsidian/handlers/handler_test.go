@@ -0,0 +1,44 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHttpError(t *testing.T) {
+	err := handlers.HttpError(errors.New("foo"))
+	assert.Equal(t, http.StatusInternalServerError, err.Code)
+	err = handlers.HttpError(errors.New("foo"), http.StatusBadRequest)
+	assert.Equal(t, http.StatusBadRequest, err.Code)
+
+	// Generation conflicts are always conflicts
+	err = handlers.HttpError(datastore.ErrGenerationConflict, http.StatusInternalServerError)
+	assert.Equal(t, http.StatusConflict, err.Code)
+	err = handlers.HttpError(fmt.Errorf("Error updating gateway: %w", datastore.ErrGenerationConflict))
+	assert.Equal(t, http.StatusConflict, err.Code)
+
+	// So are the conflicts reported by services across gRPC
+	conflict := protos.NewGrpcConflictError("Gateway %s was modified concurrently", "gw1")
+	err = handlers.HttpError(status.ErrorProto(status.Convert(conflict).Proto()), http.StatusInternalServerError)
+	assert.Equal(t, http.StatusConflict, err.Code)
+	err = handlers.HttpError(status.Error(codes.Aborted, "Error updating gateway"))
+	assert.Equal(t, http.StatusInternalServerError, err.Code)
+}
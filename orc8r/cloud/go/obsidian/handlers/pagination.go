@@ -0,0 +1,79 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"magma/orc8r/cloud/go/datastore"
+
+	"github.com/labstack/echo"
+)
+
+// Query parameters and response header shared by all paginated list
+// endpoints. Paginated endpoints keep returning a JSON list of IDs, the token
+// for the next page is returned in the NextPageTokenHeader header and is
+// omitted on the last page.
+const (
+	PageSizeParam       = "page_size"
+	PageTokenParam      = "page_token"
+	IDPrefixParam       = "id_prefix"
+	NextPageTokenHeader = "X-Next-Page-Token"
+
+	// DefaultPageSize is used if a page token is given without a page size
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+// PaginationParams holds the pagination and filter query parameters of a
+// list request
+type PaginationParams struct {
+	// PageSize is 0 if the request is not paginated
+	PageSize  uint32
+	PageToken string
+	IDPrefix  string
+}
+
+// GetPaginationParams parses the page_size, page_token and id_prefix query
+// parameters of the request
+func GetPaginationParams(c echo.Context) (PaginationParams, *echo.HTTPError) {
+	ret := PaginationParams{
+		PageToken: c.QueryParam(PageTokenParam),
+		IDPrefix:  c.QueryParam(IDPrefixParam),
+	}
+	if _, err := datastore.DecodePageToken(ret.PageToken); err != nil {
+		return ret, HttpError(err, http.StatusBadRequest)
+	}
+	pageSize := c.QueryParam(PageSizeParam)
+	if pageSize == "" {
+		if ret.PageToken != "" {
+			ret.PageSize = DefaultPageSize
+		}
+		return ret, nil
+	}
+	size, err := strconv.ParseUint(pageSize, 10, 32)
+	if err != nil || size == 0 || size > MaxPageSize {
+		return ret, HttpError(
+			fmt.Errorf("Invalid %s %s, must be between 1 and %d", PageSizeParam, pageSize, MaxPageSize),
+			http.StatusBadRequest,
+		)
+	}
+	ret.PageSize = uint32(size)
+	return ret, nil
+}
+
+// SetNextPageToken sets the next page token header of the response if there
+// is a next page
+func SetNextPageToken(c echo.Context, nextPageToken string) {
+	if nextPageToken != "" {
+		c.Response().Header().Set(NextPageTokenHeader, nextPageToken)
+	}
+}
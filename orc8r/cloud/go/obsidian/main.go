@@ -11,8 +11,10 @@ package main
 import (
 	"flag"
 	"log"
+	"time"
 
 	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/obsidian/audit"
 	"magma/orc8r/cloud/go/obsidian/config"
 	"magma/orc8r/cloud/go/obsidian/server"
 	"magma/orc8r/cloud/go/orc8r"
@@ -51,6 +53,11 @@ func main() {
 		"Folder containing the static files served",
 	)
 
+	auditRetention := flag.Duration(
+		"audit_retention", audit.DefaultRetention,
+		"How long audit log entries are kept, 0 to keep them forever",
+	)
+
 	srv, err := service.NewOrchestratorService(orc8r.ModuleName, config.ServiceName)
 	if err != nil {
 		log.Fatalf("Error creating service: %s", err)
@@ -63,6 +70,15 @@ func main() {
 		}
 	}
 
+	auditStore, err := datastore.NewSqlDb(config.MagmadDBDriver, config.MagmadDBSource)
+	if err != nil {
+		log.Fatalf("Failed to connect to audit log database: %s", err)
+	}
+	audit.SetStore(auditStore)
+	if *auditRetention > 0 {
+		go audit.EnforceRetention(*auditRetention, time.Hour, nil)
+	}
+
 	go srv.Run()
 	server.Start()
 }
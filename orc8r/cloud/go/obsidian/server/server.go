@@ -19,6 +19,7 @@ import (
 	"github.com/labstack/echo"
 
 	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/obsidian/audit"
 	"magma/orc8r/cloud/go/obsidian/config"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/obsidian/metrics"
@@ -30,6 +31,8 @@ func Start() {
 	handlers.AttachAll(e)
 	// metrics middleware is used before all other middlewares
 	e.Use(metrics.CollectStats)
+	// audit middleware records mutating requests after they were handled
+	e.Use(audit.Middleware)
 	// Serve static pages for the API docs
 	e.Static(config.StaticURLPrefix, config.StaticFolder+"/apidocs")
 	e.Static(config.StaticURLPrefix+"/swagger-ui/dist",
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	"magma/orc8r/cloud/go/backup"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	goregistry "magma/orc8r/cloud/go/registry"
 	"magma/orc8r/cloud/go/serde"
@@ -19,6 +20,22 @@ type OrchestratorPlugin struct {
 	mock.Mock
 }
 
+// GetBackupSections provides a mock function with given fields:
+func (_m *OrchestratorPlugin) GetBackupSections() []backup.Section {
+	ret := _m.Called()
+
+	var r0 []backup.Section
+	if rf, ok := ret.Get(0).(func() []backup.Section); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]backup.Section)
+		}
+	}
+
+	return r0
+}
+
 // GetMconfigBuilders provides a mock function with given fields:
 func (_m *OrchestratorPlugin) GetMconfigBuilders() []factory.MconfigBuilder {
 	ret := _m.Called()
@@ -16,6 +16,7 @@ import (
 	"reflect"
 	"strings"
 
+	"magma/orc8r/cloud/go/backup"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/registry"
@@ -68,6 +69,11 @@ type OrchestratorPlugin interface {
 	// These stream providers are the primary mechanism by which gateways
 	// receive data from the orchestrator (e.g. configuration).
 	GetStreamerProviders() []providers.StreamProvider
+
+	// GetBackupSections returns the sections that this plugin contributes to
+	// network archives. Each section exports and imports the data that a
+	// service of this plugin stores for a network.
+	GetBackupSections() []backup.Section
 }
 
 // LoadAllPluginsFatalOnError loads and registers all orchestrator plugins
@@ -178,6 +184,9 @@ func registerPlugin(orc8rPlugin OrchestratorPlugin, metricsConfig *config.Config
 	if err := providers.RegisterStreamProviders(orc8rPlugin.GetStreamerProviders()...); err != nil {
 		return err
 	}
+	if err := backup.RegisterSections(orc8rPlugin.GetBackupSections()...); err != nil {
+		return err
+	}
 
 	return nil
 }
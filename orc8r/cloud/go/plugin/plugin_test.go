@@ -12,6 +12,7 @@ import (
 	"errors"
 	"testing"
 
+	"magma/orc8r/cloud/go/backup"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/plugin/mocks"
@@ -47,6 +48,7 @@ func TestLoadAllPlugins(t *testing.T) {
 	mockPlugin.On("GetMetricsProfiles").Times(1).Return([]metricsd.MetricsProfile{})
 	mockPlugin.On("GetObsidianHandlers").Return([]handlers.Handler{})
 	mockPlugin.On("GetStreamerProviders").Return([]providers.StreamProvider{})
+	mockPlugin.On("GetBackupSections").Return([]backup.Section{})
 	err := plugin.LoadAllPlugins(mockLoader{ret: mockPlugin})
 	assert.NoError(t, err)
 	mockPlugin.AssertNumberOfCalls(t, "GetServices", 1)
@@ -55,6 +57,7 @@ func TestLoadAllPlugins(t *testing.T) {
 	mockPlugin.AssertNumberOfCalls(t, "GetMetricsProfiles", 1)
 	mockPlugin.AssertNumberOfCalls(t, "GetObsidianHandlers", 1)
 	mockPlugin.AssertNumberOfCalls(t, "GetStreamerProviders", 1)
+	mockPlugin.AssertNumberOfCalls(t, "GetBackupSections", 1)
 	mockPlugin.AssertExpectations(t)
 
 	// Error in the middle of registration - duplicate metrics profile
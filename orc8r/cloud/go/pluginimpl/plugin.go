@@ -9,6 +9,10 @@ LICENSE file in the root directory of this source tree.
 package pluginimpl
 
 import (
+	"time"
+
+	"magma/orc8r/cloud/go/backup"
+	"magma/orc8r/cloud/go/obsidian/audit"
 	obsidianh "magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/obsidian/handlers/hello"
 	"magma/orc8r/cloud/go/orc8r"
@@ -20,6 +24,8 @@ import (
 	accessdh "magma/orc8r/cloud/go/services/accessd/obsidian/handlers"
 	checkinh "magma/orc8r/cloud/go/services/checkind/obsidian/handlers"
 	checkindserde "magma/orc8r/cloud/go/services/checkind/serde"
+	configbackup "magma/orc8r/cloud/go/services/config/backup"
+	deviceh "magma/orc8r/cloud/go/services/device/obsidian/handlers"
 	dnsdconfig "magma/orc8r/cloud/go/services/dnsd/config"
 	dnsdh "magma/orc8r/cloud/go/services/dnsd/obsidian/handlers"
 	magmadconfig "magma/orc8r/cloud/go/services/magmad/config"
@@ -29,13 +35,17 @@ import (
 	"magma/orc8r/cloud/go/services/metricsd/confignames"
 	"magma/orc8r/cloud/go/services/metricsd/exporters"
 	graphite_exp "magma/orc8r/cloud/go/services/metricsd/graphite/exporters"
+	influxdb_exp "magma/orc8r/cloud/go/services/metricsd/influxdb/exporters"
 	metricsdh "magma/orc8r/cloud/go/services/metricsd/obsidian/handlers"
 	promo_exp "magma/orc8r/cloud/go/services/metricsd/prometheus/exporters"
 	stateh "magma/orc8r/cloud/go/services/state/obsidian/handlers"
 	"magma/orc8r/cloud/go/services/streamer/mconfig"
 	"magma/orc8r/cloud/go/services/streamer/mconfig/factory"
 	"magma/orc8r/cloud/go/services/streamer/providers"
+	upgradebackup "magma/orc8r/cloud/go/services/upgrade/backup"
 	upgradeh "magma/orc8r/cloud/go/services/upgrade/obsidian/handlers"
+
+	"github.com/golang/glog"
 )
 
 // BaseOrchestratorPlugin is the OrchestratorPlugin for the orc8r module
@@ -65,6 +75,7 @@ func (*BaseOrchestratorPlugin) GetSerdes() []serde.Serde {
 		// Config manager serdes
 		&magmadconfig.MagmadGatewayConfigManager{},
 		&dnsdconfig.DnsNetworkConfigManager{},
+		&dnsdconfig.DnsGatewayConfigManager{},
 	}
 }
 
@@ -84,7 +95,10 @@ func (*BaseOrchestratorPlugin) GetMetricsProfiles(metricsConfig *config.ConfigMa
 func (*BaseOrchestratorPlugin) GetObsidianHandlers(metricsConfig *config.ConfigMap) []obsidianh.Handler {
 	return plugin.FlattenHandlerLists(
 		accessdh.GetObsidianHandlers(),
+		audit.GetObsidianHandlers(),
+		backup.GetObsidianHandlers(),
 		checkinh.GetObsidianHandlers(),
+		deviceh.GetObsidianHandlers(),
 		dnsdh.GetObsidianHandlers(),
 		magmadh.GetObsidianHandlers(),
 		metricsdh.GetObsidianHandlers(metricsConfig),
@@ -101,9 +115,17 @@ func (*BaseOrchestratorPlugin) GetStreamerProviders() []providers.StreamProvider
 	}
 }
 
+func (*BaseOrchestratorPlugin) GetBackupSections() []backup.Section {
+	return []backup.Section{
+		&configbackup.ConfigsSection{},
+		&upgradebackup.TiersSection{},
+	}
+}
+
 const (
 	ProfileNamePrometheus = "prometheus"
 	ProfileNameGraphite   = "graphite"
+	ProfileNameInfluxDB   = "influxdb"
 	ProfileNameDefault    = "default"
 )
 
@@ -141,9 +163,40 @@ func getMetricsProfiles(metricsConfig *config.ConfigMap) []metricsd.MetricsProfi
 		Exporters:  []exporters.Exporter{prometheusCustomPushExporter, graphiteExporter},
 	}
 
-	return []metricsd.MetricsProfile{
+	profiles := []metricsd.MetricsProfile{
 		prometheusProfile,
 		graphiteProfile,
 		defaultProfile,
 	}
+
+	// InfluxDB profile - Exports all service metrics to InfluxDB. Only
+	// available if an InfluxDB address is configured.
+	influxDBExporter, err := getInfluxDBExporter(metricsConfig)
+	if err != nil {
+		glog.Errorf("InfluxDB metrics profile is unavailable: %s", err)
+	} else if influxDBExporter != nil {
+		profiles = append(profiles, metricsd.MetricsProfile{
+			Name:       ProfileNameInfluxDB,
+			Collectors: controllerCollectors,
+			Exporters:  []exporters.Exporter{influxDBExporter},
+		})
+	}
+	return profiles
+}
+
+// getInfluxDBExporter returns nil if no InfluxDB address is configured
+func getInfluxDBExporter(metricsConfig *config.ConfigMap) (exporters.Exporter, error) {
+	address, err := metricsConfig.GetStringParam(confignames.InfluxDBAddress)
+	if err != nil || address == "" {
+		return nil, nil
+	}
+	influxDBConfig := influxdb_exp.InfluxDBConfig{Address: address}
+	influxDBConfig.Database, _ = metricsConfig.GetStringParam(confignames.InfluxDBDatabase)
+	influxDBConfig.RetentionPolicy, _ = metricsConfig.GetStringParam(confignames.InfluxDBRetentionPolicy)
+	influxDBConfig.Username, _ = metricsConfig.GetStringParam(confignames.InfluxDBUsername)
+	influxDBConfig.Password, _ = metricsConfig.GetStringParam(confignames.InfluxDBPassword)
+	influxDBConfig.BatchSize, _ = metricsConfig.GetIntParam(confignames.InfluxDBBatchSize)
+	flushIntervalSecs, _ := metricsConfig.GetIntParam(confignames.InfluxDBFlushIntervalSecs)
+	influxDBConfig.FlushInterval = time.Duration(flushIntervalSecs) * time.Second
+	return influxdb_exp.NewInfluxDBExporter(influxDBConfig)
 }
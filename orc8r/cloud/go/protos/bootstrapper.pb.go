@@ -46,7 +46,7 @@ func (x ChallengeKey_KeyType) String() string {
 	return proto.EnumName(ChallengeKey_KeyType_name, int32(x))
 }
 func (ChallengeKey_KeyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{1, 0}
+	return fileDescriptor_bootstrapper_6f998867bb92b451, []int{1, 0}
 }
 
 type Challenge struct {
@@ -61,7 +61,7 @@ func (m *Challenge) Reset()         { *m = Challenge{} }
 func (m *Challenge) String() string { return proto.CompactTextString(m) }
 func (*Challenge) ProtoMessage()    {}
 func (*Challenge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{0}
+	return fileDescriptor_bootstrapper_6f998867bb92b451, []int{0}
 }
 func (m *Challenge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Challenge.Unmarshal(m, b)
@@ -111,7 +111,7 @@ func (m *ChallengeKey) Reset()         { *m = ChallengeKey{} }
 func (m *ChallengeKey) String() string { return proto.CompactTextString(m) }
 func (*ChallengeKey) ProtoMessage()    {}
 func (*ChallengeKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{1}
+	return fileDescriptor_bootstrapper_6f998867bb92b451, []int{1}
 }
 func (m *ChallengeKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChallengeKey.Unmarshal(m, b)
@@ -163,7 +163,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{2}
+	return fileDescriptor_bootstrapper_6f998867bb92b451, []int{2}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -358,7 +358,7 @@ func (m *Response_Echo) Reset()         { *m = Response_Echo{} }
 func (m *Response_Echo) String() string { return proto.CompactTextString(m) }
 func (*Response_Echo) ProtoMessage()    {}
 func (*Response_Echo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{2, 0}
+	return fileDescriptor_bootstrapper_6f998867bb92b451, []int{2, 0}
 }
 func (m *Response_Echo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response_Echo.Unmarshal(m, b)
@@ -396,7 +396,7 @@ func (m *Response_RSA) Reset()         { *m = Response_RSA{} }
 func (m *Response_RSA) String() string { return proto.CompactTextString(m) }
 func (*Response_RSA) ProtoMessage()    {}
 func (*Response_RSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{2, 1}
+	return fileDescriptor_bootstrapper_6f998867bb92b451, []int{2, 1}
 }
 func (m *Response_RSA) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response_RSA.Unmarshal(m, b)
@@ -435,7 +435,7 @@ func (m *Response_ECDSA) Reset()         { *m = Response_ECDSA{} }
 func (m *Response_ECDSA) String() string { return proto.CompactTextString(m) }
 func (*Response_ECDSA) ProtoMessage()    {}
 func (*Response_ECDSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{2, 2}
+	return fileDescriptor_bootstrapper_6f998867bb92b451, []int{2, 2}
 }
 func (m *Response_ECDSA) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response_ECDSA.Unmarshal(m, b)
@@ -496,6 +496,10 @@ type BootstrapperClient interface {
 	// send back response and csr for signing
 	// Returns signed certificate.
 	RequestSign(ctx context.Context, in *Response, opts ...grpc.CallOption) (*Certificate, error)
+	// renew the certificate of the calling gateway, which has to call through
+	// the client cert proxy with its current, still valid certificate.
+	// Returns the new signed certificate.
+	RenewCertificate(ctx context.Context, in *CSR, opts ...grpc.CallOption) (*Certificate, error)
 }
 
 type bootstrapperClient struct {
@@ -524,6 +528,15 @@ func (c *bootstrapperClient) RequestSign(ctx context.Context, in *Response, opts
 	return out, nil
 }
 
+func (c *bootstrapperClient) RenewCertificate(ctx context.Context, in *CSR, opts ...grpc.CallOption) (*Certificate, error) {
+	out := new(Certificate)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.Bootstrapper/RenewCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BootstrapperServer is the server API for Bootstrapper service.
 type BootstrapperServer interface {
 	// get the challange for gateway specified in hw_id (AccessGatewayID)
@@ -531,6 +544,10 @@ type BootstrapperServer interface {
 	// send back response and csr for signing
 	// Returns signed certificate.
 	RequestSign(context.Context, *Response) (*Certificate, error)
+	// renew the certificate of the calling gateway, which has to call through
+	// the client cert proxy with its current, still valid certificate.
+	// Returns the new signed certificate.
+	RenewCertificate(context.Context, *CSR) (*Certificate, error)
 }
 
 func RegisterBootstrapperServer(s *grpc.Server, srv BootstrapperServer) {
@@ -573,6 +590,24 @@ func _Bootstrapper_RequestSign_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bootstrapper_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CSR)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapperServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.Bootstrapper/RenewCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapperServer).RenewCertificate(ctx, req.(*CSR))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Bootstrapper_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.Bootstrapper",
 	HandlerType: (*BootstrapperServer)(nil),
@@ -585,47 +620,52 @@ var _Bootstrapper_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestSign",
 			Handler:    _Bootstrapper_RequestSign_Handler,
 		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _Bootstrapper_RenewCertificate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orc8r/protos/bootstrapper.proto",
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/bootstrapper.proto", fileDescriptor_bootstrapper_6370f262c5f2dbf0)
-}
-
-var fileDescriptor_bootstrapper_6370f262c5f2dbf0 = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x5d, 0x6f, 0xda, 0x30,
-	0x14, 0x4d, 0x0a, 0x6d, 0xe9, 0x25, 0xad, 0x90, 0xa7, 0x6e, 0x34, 0x30, 0xad, 0x4b, 0x5f, 0xfa,
-	0x14, 0x34, 0xa6, 0x4d, 0x7b, 0x98, 0xa6, 0x85, 0x8f, 0x42, 0xd5, 0x87, 0x4a, 0x4e, 0xa5, 0x49,
-	0x7b, 0x41, 0xa9, 0xb9, 0x0b, 0x11, 0x14, 0x67, 0xb6, 0x11, 0xca, 0x3f, 0xd9, 0x3f, 0xd8, 0xff,
-	0xd8, 0x2f, 0x9b, 0x62, 0x42, 0x42, 0x24, 0xd6, 0x97, 0x3d, 0xc5, 0xf6, 0x39, 0xf7, 0x9c, 0x9b,
-	0x63, 0x5f, 0x78, 0xc3, 0x05, 0xfb, 0x24, 0x3a, 0xb1, 0xe0, 0x8a, 0xcb, 0xce, 0x23, 0xe7, 0x4a,
-	0x2a, 0x11, 0xc4, 0x31, 0x0a, 0x57, 0x9f, 0x91, 0xfa, 0x53, 0x10, 0x3e, 0x05, 0xae, 0xa6, 0xd9,
-	0xed, 0x12, 0x9b, 0xa1, 0x50, 0xd1, 0x8f, 0x68, 0x4b, 0xb5, 0x5b, 0x25, 0x34, 0x9a, 0xe2, 0x52,
-	0x45, 0x2a, 0xd9, 0x80, 0x4e, 0x08, 0x27, 0xfd, 0x59, 0xb0, 0x58, 0xe0, 0x32, 0x44, 0xf2, 0x19,
-	0x6a, 0x73, 0x4c, 0x26, 0x2a, 0x89, 0xb1, 0x69, 0x5e, 0x9a, 0xd7, 0x67, 0xdd, 0xb7, 0xee, 0x8e,
-	0x8f, 0x9b, 0x33, 0xef, 0x30, 0x71, 0xef, 0x30, 0x79, 0x48, 0x62, 0xa4, 0xc7, 0xf3, 0xcd, 0x82,
-	0xb4, 0xe1, 0x84, 0x6d, 0x09, 0xcd, 0x83, 0x4b, 0xf3, 0xda, 0xa2, 0xc5, 0x81, 0xf3, 0xdb, 0x04,
-	0x6b, 0xb7, 0xfe, 0x3f, 0xcd, 0x1a, 0x50, 0x99, 0x63, 0x92, 0xd9, 0xa4, 0x4b, 0x67, 0x04, 0xc7,
-	0x19, 0x8b, 0xd4, 0xa0, 0x3a, 0xec, 0x8f, 0xef, 0x1b, 0x06, 0x79, 0x05, 0x2f, 0xfc, 0xfb, 0x9b,
-	0x87, 0x6f, 0x1e, 0x1d, 0x4e, 0xa8, 0xef, 0x4d, 0xfc, 0xb1, 0xd7, 0xfd, 0xf0, 0xb1, 0x61, 0x92,
-	0x0b, 0x38, 0xcf, 0x81, 0x61, 0x7f, 0x50, 0x40, 0x07, 0xce, 0x9f, 0x0a, 0xd4, 0x28, 0xca, 0x98,
-	0x2f, 0x25, 0x92, 0x77, 0x70, 0x38, 0x5b, 0x4f, 0xa2, 0xa9, 0x6e, 0xb1, 0xde, 0x6d, 0x97, 0x5a,
-	0xf4, 0x18, 0x43, 0x29, 0x47, 0x81, 0xc2, 0x75, 0x90, 0xdc, 0x0e, 0x68, 0x75, 0xb6, 0xbe, 0x9d,
-	0x3e, 0x9f, 0x03, 0xf1, 0xe0, 0x14, 0xd9, 0x8c, 0x4f, 0x44, 0xe6, 0xd0, 0xac, 0x68, 0x61, 0xbb,
-	0x24, 0xbc, 0xb5, 0x77, 0x87, 0x6c, 0xc6, 0xc7, 0x06, 0xb5, 0xd2, 0x92, 0xbc, 0xa7, 0x2f, 0x60,
-	0x09, 0x19, 0x14, 0x0a, 0x55, 0xad, 0x70, 0xb1, 0x5f, 0x81, 0xfa, 0xde, 0xd8, 0xa0, 0x75, 0x21,
-	0x83, 0xbc, 0x7e, 0x00, 0x67, 0xc8, 0xa6, 0xbb, 0x0a, 0x87, 0x5a, 0xa1, 0xf5, 0x8f, 0x1e, 0xd2,
-	0x78, 0xc6, 0x06, 0x3d, 0xd5, 0x45, 0xb9, 0x8a, 0x03, 0x15, 0x26, 0x45, 0xf3, 0x48, 0x97, 0x36,
-	0xca, 0x57, 0xe7, 0x53, 0x9a, 0x82, 0xb6, 0x03, 0xd5, 0xf4, 0x0f, 0x88, 0x0d, 0xb5, 0xdc, 0xcb,
-	0xd4, 0x89, 0xe4, 0x7b, 0xfb, 0x0a, 0x2a, 0xd4, 0xf7, 0xd2, 0xd4, 0x64, 0x14, 0x2e, 0x03, 0xb5,
-	0x12, 0x5b, 0x4e, 0x71, 0x60, 0x5f, 0xc1, 0xa1, 0x6e, 0x83, 0x58, 0x60, 0x8a, 0x0c, 0x36, 0x45,
-	0xba, 0x93, 0x59, 0xc4, 0xa6, 0xec, 0x41, 0xe1, 0xd2, 0xfd, 0x65, 0x82, 0xd5, 0xdb, 0x19, 0x1b,
-	0x72, 0x03, 0xd6, 0x08, 0x55, 0xf1, 0xd6, 0x9f, 0xbd, 0x49, 0xfb, 0xe5, 0xfe, 0xa7, 0xe8, 0x18,
-	0xe4, 0x2b, 0xd4, 0x29, 0xfe, 0x5c, 0xa1, 0x54, 0x7e, 0x14, 0x2e, 0xc9, 0xf9, 0xde, 0xcc, 0xec,
-	0x66, 0xb9, 0x7e, 0x33, 0x91, 0x2c, 0x50, 0xe8, 0x18, 0xbd, 0xd7, 0xdf, 0x5b, 0x1a, 0xec, 0x6c,
-	0xe6, 0x92, 0x2d, 0xf8, 0x6a, 0xda, 0x09, 0x79, 0x36, 0xa0, 0x8f, 0x47, 0xfa, 0xfb, 0xfe, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x97, 0xaa, 0x0d, 0x91, 0x03, 0x04, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/bootstrapper.proto", fileDescriptor_bootstrapper_6f998867bb92b451)
+}
+
+var fileDescriptor_bootstrapper_6f998867bb92b451 = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0xc5, 0x01, 0x12, 0x72, 0x71, 0x22, 0x34, 0x9f, 0xf2, 0x95, 0x18, 0xaa, 0xa6, 0xce, 0x26,
+	0x2b, 0xa3, 0x52, 0xb5, 0xea, 0xa2, 0xaa, 0x6a, 0x7e, 0x02, 0x51, 0x16, 0x91, 0xc6, 0x91, 0x2a,
+	0x75, 0x83, 0x9c, 0xe1, 0xd6, 0x58, 0x10, 0xdb, 0x9d, 0x19, 0x84, 0xfc, 0x44, 0x7d, 0x8f, 0x3e,
+	0x46, 0x9f, 0xa6, 0xf2, 0x60, 0xfc, 0xa3, 0x52, 0x36, 0x5d, 0xe1, 0xb9, 0xe7, 0xdc, 0x73, 0x2e,
+	0x67, 0xe6, 0xc2, 0xab, 0x90, 0xb3, 0x0f, 0xbc, 0x17, 0xf1, 0x50, 0x86, 0xa2, 0xf7, 0x14, 0x86,
+	0x52, 0x48, 0xee, 0x46, 0x11, 0x72, 0x4b, 0xd5, 0x48, 0xf3, 0xd9, 0xf5, 0x9e, 0x5d, 0x4b, 0xd1,
+	0x8c, 0x6e, 0x89, 0xcd, 0x90, 0x4b, 0xff, 0x9b, 0xbf, 0xa3, 0x1a, 0x9d, 0x12, 0xea, 0xcf, 0x31,
+	0x90, 0xbe, 0x8c, 0xb7, 0xa0, 0xe9, 0xc1, 0xe9, 0x70, 0xe1, 0xae, 0x56, 0x18, 0x78, 0x48, 0x3e,
+	0x42, 0x63, 0x89, 0xf1, 0x4c, 0xc6, 0x11, 0xb6, 0xb5, 0x2b, 0xed, 0xe6, 0xbc, 0xff, 0xda, 0x2a,
+	0xf8, 0x58, 0x19, 0xf3, 0x1e, 0x63, 0xeb, 0x1e, 0xe3, 0xc7, 0x38, 0x42, 0x7a, 0xb2, 0xdc, 0x7e,
+	0x90, 0x2e, 0x9c, 0xb2, 0x1d, 0xa1, 0x7d, 0x74, 0xa5, 0xdd, 0xe8, 0x34, 0x2f, 0x98, 0x3f, 0x34,
+	0xd0, 0x8b, 0xfd, 0xff, 0x68, 0xd6, 0x82, 0xea, 0x12, 0xe3, 0xd4, 0x26, 0xf9, 0x34, 0x27, 0x70,
+	0x92, 0xb2, 0x48, 0x03, 0x6a, 0xe3, 0xe1, 0xf4, 0xa1, 0x55, 0x21, 0x2f, 0xe0, 0x3f, 0xe7, 0xe1,
+	0xf6, 0xf1, 0x8b, 0x4d, 0xc7, 0x33, 0xea, 0xd8, 0x33, 0x67, 0x6a, 0xf7, 0xdf, 0xbd, 0x6f, 0x69,
+	0xe4, 0x12, 0x2e, 0x32, 0x60, 0x3c, 0x1c, 0xe5, 0xd0, 0x91, 0xf9, 0xb3, 0x0a, 0x0d, 0x8a, 0x22,
+	0x0a, 0x03, 0x81, 0xe4, 0x0d, 0xd4, 0x17, 0x9b, 0x99, 0x3f, 0x57, 0x23, 0x36, 0xfb, 0xdd, 0xd2,
+	0x88, 0x36, 0x63, 0x28, 0xc4, 0xc4, 0x95, 0xb8, 0x71, 0xe3, 0xbb, 0x11, 0xad, 0x2d, 0x36, 0x77,
+	0xf3, 0xc3, 0x39, 0x10, 0x1b, 0xce, 0x90, 0x2d, 0xc2, 0x19, 0x4f, 0x1d, 0xda, 0x55, 0x25, 0x6c,
+	0x94, 0x84, 0x77, 0xf6, 0xd6, 0x98, 0x2d, 0xc2, 0x69, 0x85, 0xea, 0x49, 0x4b, 0x36, 0xd3, 0x27,
+	0xd0, 0xb9, 0x70, 0x73, 0x85, 0x9a, 0x52, 0xb8, 0xdc, 0xaf, 0x40, 0x1d, 0x7b, 0x5a, 0xa1, 0x4d,
+	0x2e, 0xdc, 0xac, 0x7f, 0x04, 0xe7, 0xc8, 0xe6, 0x45, 0x85, 0xba, 0x52, 0xe8, 0xfc, 0x65, 0x86,
+	0x24, 0x9e, 0x69, 0x85, 0x9e, 0xa9, 0xa6, 0x4c, 0xc5, 0x84, 0x2a, 0x13, 0xbc, 0x7d, 0xac, 0x5a,
+	0x5b, 0xe5, 0xab, 0x73, 0x28, 0x4d, 0x40, 0xc3, 0x84, 0x5a, 0xf2, 0x0f, 0x88, 0x01, 0x8d, 0xcc,
+	0x4b, 0x53, 0x89, 0x64, 0x67, 0xe3, 0x1a, 0xaa, 0xd4, 0xb1, 0x93, 0xd4, 0x84, 0xef, 0x05, 0xae,
+	0x5c, 0xf3, 0x1d, 0x27, 0x2f, 0x18, 0xd7, 0x50, 0x57, 0x63, 0x10, 0x1d, 0x34, 0x9e, 0xc2, 0x1a,
+	0x4f, 0x4e, 0x22, 0x8d, 0x58, 0x13, 0x03, 0xc8, 0x5d, 0xfa, 0xbf, 0x34, 0xd0, 0x07, 0x85, 0xb5,
+	0x21, 0xb7, 0xa0, 0x4f, 0x50, 0xe6, 0x6f, 0xfd, 0xe0, 0x4d, 0x1a, 0xff, 0xef, 0x7f, 0x8a, 0x66,
+	0x85, 0x7c, 0x86, 0x26, 0xc5, 0xef, 0x6b, 0x14, 0xd2, 0xf1, 0xbd, 0x80, 0x5c, 0xec, 0xcd, 0xcc,
+	0x68, 0x97, 0xfb, 0xb7, 0x1b, 0xc9, 0x5c, 0xb9, 0x55, 0x68, 0x51, 0x0c, 0x70, 0x53, 0xa8, 0x92,
+	0x3f, 0xf2, 0x3b, 0xa4, 0x30, 0x78, 0xf9, 0xb5, 0xa3, 0xc0, 0xde, 0x76, 0xb3, 0xd9, 0x2a, 0x5c,
+	0xcf, 0x7b, 0x5e, 0x98, 0xae, 0xf8, 0xd3, 0xb1, 0xfa, 0x7d, 0xfb, 0x7b, 0x00, 0x18, 0xc6, 0x24,
+	0xdd, 0x45, 0x04, 0x00, 0x00,
 }
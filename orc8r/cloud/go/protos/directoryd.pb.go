@@ -28,22 +28,25 @@ type TableID int32
 const (
 	TableID_IMSI_TO_HWID     TableID = 0
 	TableID_HWID_TO_HOSTNAME TableID = 1
+	TableID_UE_IP_TO_IMSI    TableID = 2
 )
 
 var TableID_name = map[int32]string{
 	0: "IMSI_TO_HWID",
 	1: "HWID_TO_HOSTNAME",
+	2: "UE_IP_TO_IMSI",
 }
 var TableID_value = map[string]int32{
 	"IMSI_TO_HWID":     0,
 	"HWID_TO_HOSTNAME": 1,
+	"UE_IP_TO_IMSI":    2,
 }
 
 func (x TableID) String() string {
 	return proto.EnumName(TableID_name, int32(x))
 }
 func (TableID) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{0}
+	return fileDescriptor_directoryd_001d167951069d47, []int{0}
 }
 
 type GetLocationRequest struct {
@@ -58,7 +61,7 @@ func (m *GetLocationRequest) Reset()         { *m = GetLocationRequest{} }
 func (m *GetLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLocationRequest) ProtoMessage()    {}
 func (*GetLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{0}
+	return fileDescriptor_directoryd_001d167951069d47, []int{0}
 }
 func (m *GetLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLocationRequest.Unmarshal(m, b)
@@ -104,7 +107,7 @@ func (m *DeleteLocationRequest) Reset()         { *m = DeleteLocationRequest{} }
 func (m *DeleteLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteLocationRequest) ProtoMessage()    {}
 func (*DeleteLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{1}
+	return fileDescriptor_directoryd_001d167951069d47, []int{1}
 }
 func (m *DeleteLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteLocationRequest.Unmarshal(m, b)
@@ -140,7 +143,10 @@ func (m *DeleteLocationRequest) GetTable() TableID {
 
 type LocationRecord struct {
 	// Object location. Client is responsible for location encoding and decoding
-	Location             string   `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// Unix time in seconds after which the record is no longer returned. 0 if
+	// the record never expires. Set by the directory service on updates.
+	ExpirationTime       int64    `protobuf:"varint,2,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -150,7 +156,7 @@ func (m *LocationRecord) Reset()         { *m = LocationRecord{} }
 func (m *LocationRecord) String() string { return proto.CompactTextString(m) }
 func (*LocationRecord) ProtoMessage()    {}
 func (*LocationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{2}
+	return fileDescriptor_directoryd_001d167951069d47, []int{2}
 }
 func (m *LocationRecord) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocationRecord.Unmarshal(m, b)
@@ -177,20 +183,30 @@ func (m *LocationRecord) GetLocation() string {
 	return ""
 }
 
+func (m *LocationRecord) GetExpirationTime() int64 {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return 0
+}
+
 type UpdateDirectoryLocationRequest struct {
-	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Record               *LocationRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
-	Table                TableID         `protobuf:"varint,3,opt,name=table,proto3,enum=magma.orc8r.TableID" json:"table,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Id     string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Record *LocationRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	Table  TableID         `protobuf:"varint,3,opt,name=table,proto3,enum=magma.orc8r.TableID" json:"table,omitempty"`
+	// Seconds until the record expires. The default TTL of the table is used
+	// if this is 0.
+	TtlSecs              uint32   `protobuf:"varint,4,opt,name=ttl_secs,json=ttlSecs,proto3" json:"ttl_secs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdateDirectoryLocationRequest) Reset()         { *m = UpdateDirectoryLocationRequest{} }
 func (m *UpdateDirectoryLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDirectoryLocationRequest) ProtoMessage()    {}
 func (*UpdateDirectoryLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{3}
+	return fileDescriptor_directoryd_001d167951069d47, []int{3}
 }
 func (m *UpdateDirectoryLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDirectoryLocationRequest.Unmarshal(m, b)
@@ -231,11 +247,175 @@ func (m *UpdateDirectoryLocationRequest) GetTable() TableID {
 	return TableID_IMSI_TO_HWID
 }
 
+func (m *UpdateDirectoryLocationRequest) GetTtlSecs() uint32 {
+	if m != nil {
+		return m.TtlSecs
+	}
+	return 0
+}
+
+type UpdateDirectoryLocationsRequest struct {
+	Table TableID `protobuf:"varint,1,opt,name=table,proto3,enum=magma.orc8r.TableID" json:"table,omitempty"`
+	// Location records keyed by object ID
+	Records map[string]*LocationRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Seconds until the records expire. The default TTL of the table is used
+	// if this is 0.
+	TtlSecs uint32 `protobuf:"varint,3,opt,name=ttl_secs,json=ttlSecs,proto3" json:"ttl_secs,omitempty"`
+	// If set, records located at the calling gateway which are not in this
+	// request are deleted, so a gateway can report the full set of IMSIs
+	// attached to it. Only supported for the IMSI_TO_HWID table.
+	Replace              bool     `protobuf:"varint,4,opt,name=replace,proto3" json:"replace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateDirectoryLocationsRequest) Reset()         { *m = UpdateDirectoryLocationsRequest{} }
+func (m *UpdateDirectoryLocationsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDirectoryLocationsRequest) ProtoMessage()    {}
+func (*UpdateDirectoryLocationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_directoryd_001d167951069d47, []int{4}
+}
+func (m *UpdateDirectoryLocationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDirectoryLocationsRequest.Unmarshal(m, b)
+}
+func (m *UpdateDirectoryLocationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDirectoryLocationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDirectoryLocationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDirectoryLocationsRequest.Merge(dst, src)
+}
+func (m *UpdateDirectoryLocationsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDirectoryLocationsRequest.Size(m)
+}
+func (m *UpdateDirectoryLocationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDirectoryLocationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDirectoryLocationsRequest proto.InternalMessageInfo
+
+func (m *UpdateDirectoryLocationsRequest) GetTable() TableID {
+	if m != nil {
+		return m.Table
+	}
+	return TableID_IMSI_TO_HWID
+}
+
+func (m *UpdateDirectoryLocationsRequest) GetRecords() map[string]*LocationRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *UpdateDirectoryLocationsRequest) GetTtlSecs() uint32 {
+	if m != nil {
+		return m.TtlSecs
+	}
+	return 0
+}
+
+func (m *UpdateDirectoryLocationsRequest) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
+type GetIDsByLocationRequest struct {
+	Table                TableID  `protobuf:"varint,1,opt,name=table,proto3,enum=magma.orc8r.TableID" json:"table,omitempty"`
+	Location             string   `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIDsByLocationRequest) Reset()         { *m = GetIDsByLocationRequest{} }
+func (m *GetIDsByLocationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIDsByLocationRequest) ProtoMessage()    {}
+func (*GetIDsByLocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_directoryd_001d167951069d47, []int{5}
+}
+func (m *GetIDsByLocationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIDsByLocationRequest.Unmarshal(m, b)
+}
+func (m *GetIDsByLocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIDsByLocationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetIDsByLocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIDsByLocationRequest.Merge(dst, src)
+}
+func (m *GetIDsByLocationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIDsByLocationRequest.Size(m)
+}
+func (m *GetIDsByLocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIDsByLocationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIDsByLocationRequest proto.InternalMessageInfo
+
+func (m *GetIDsByLocationRequest) GetTable() TableID {
+	if m != nil {
+		return m.Table
+	}
+	return TableID_IMSI_TO_HWID
+}
+
+func (m *GetIDsByLocationRequest) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+type LocationIDs struct {
+	// IDs of the objects at the location, sorted
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocationIDs) Reset()         { *m = LocationIDs{} }
+func (m *LocationIDs) String() string { return proto.CompactTextString(m) }
+func (*LocationIDs) ProtoMessage()    {}
+func (*LocationIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_directoryd_001d167951069d47, []int{6}
+}
+func (m *LocationIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocationIDs.Unmarshal(m, b)
+}
+func (m *LocationIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocationIDs.Marshal(b, m, deterministic)
+}
+func (dst *LocationIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocationIDs.Merge(dst, src)
+}
+func (m *LocationIDs) XXX_Size() int {
+	return xxx_messageInfo_LocationIDs.Size(m)
+}
+func (m *LocationIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocationIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocationIDs proto.InternalMessageInfo
+
+func (m *LocationIDs) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetLocationRequest)(nil), "magma.orc8r.GetLocationRequest")
 	proto.RegisterType((*DeleteLocationRequest)(nil), "magma.orc8r.DeleteLocationRequest")
 	proto.RegisterType((*LocationRecord)(nil), "magma.orc8r.LocationRecord")
 	proto.RegisterType((*UpdateDirectoryLocationRequest)(nil), "magma.orc8r.UpdateDirectoryLocationRequest")
+	proto.RegisterType((*UpdateDirectoryLocationsRequest)(nil), "magma.orc8r.UpdateDirectoryLocationsRequest")
+	proto.RegisterMapType((map[string]*LocationRecord)(nil), "magma.orc8r.UpdateDirectoryLocationsRequest.RecordsEntry")
+	proto.RegisterType((*GetIDsByLocationRequest)(nil), "magma.orc8r.GetIDsByLocationRequest")
+	proto.RegisterType((*LocationIDs)(nil), "magma.orc8r.LocationIDs")
 	proto.RegisterEnum("magma.orc8r.TableID", TableID_name, TableID_value)
 }
 
@@ -256,6 +436,11 @@ type DirectoryServiceClient interface {
 	GetLocation(ctx context.Context, in *GetLocationRequest, opts ...grpc.CallOption) (*LocationRecord, error)
 	// Update the location record of an object in the directory service
 	UpdateLocation(ctx context.Context, in *UpdateDirectoryLocationRequest, opts ...grpc.CallOption) (*Void, error)
+	// Update the location records of many objects of a table at once
+	UpdateLocations(ctx context.Context, in *UpdateDirectoryLocationsRequest, opts ...grpc.CallOption) (*Void, error)
+	// Get the IDs of all objects at a location, e.g. all IMSIs attached to a
+	// gateway
+	GetIDsByLocation(ctx context.Context, in *GetIDsByLocationRequest, opts ...grpc.CallOption) (*LocationIDs, error)
 	// Delete location record of an object from the directory service
 	// Throws UNKNOWN if object ID does not exist
 	DeleteLocation(ctx context.Context, in *DeleteLocationRequest, opts ...grpc.CallOption) (*Void, error)
@@ -287,6 +472,24 @@ func (c *directoryServiceClient) UpdateLocation(ctx context.Context, in *UpdateD
 	return out, nil
 }
 
+func (c *directoryServiceClient) UpdateLocations(ctx context.Context, in *UpdateDirectoryLocationsRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.DirectoryService/UpdateLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *directoryServiceClient) GetIDsByLocation(ctx context.Context, in *GetIDsByLocationRequest, opts ...grpc.CallOption) (*LocationIDs, error) {
+	out := new(LocationIDs)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.DirectoryService/GetIDsByLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *directoryServiceClient) DeleteLocation(ctx context.Context, in *DeleteLocationRequest, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.DirectoryService/DeleteLocation", in, out, opts...)
@@ -303,6 +506,11 @@ type DirectoryServiceServer interface {
 	GetLocation(context.Context, *GetLocationRequest) (*LocationRecord, error)
 	// Update the location record of an object in the directory service
 	UpdateLocation(context.Context, *UpdateDirectoryLocationRequest) (*Void, error)
+	// Update the location records of many objects of a table at once
+	UpdateLocations(context.Context, *UpdateDirectoryLocationsRequest) (*Void, error)
+	// Get the IDs of all objects at a location, e.g. all IMSIs attached to a
+	// gateway
+	GetIDsByLocation(context.Context, *GetIDsByLocationRequest) (*LocationIDs, error)
 	// Delete location record of an object from the directory service
 	// Throws UNKNOWN if object ID does not exist
 	DeleteLocation(context.Context, *DeleteLocationRequest) (*Void, error)
@@ -348,6 +556,42 @@ func _DirectoryService_UpdateLocation_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DirectoryService_UpdateLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDirectoryLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectoryServiceServer).UpdateLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.DirectoryService/UpdateLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectoryServiceServer).UpdateLocations(ctx, req.(*UpdateDirectoryLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DirectoryService_GetIDsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIDsByLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DirectoryServiceServer).GetIDsByLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.DirectoryService/GetIDsByLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DirectoryServiceServer).GetIDsByLocation(ctx, req.(*GetIDsByLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DirectoryService_DeleteLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteLocationRequest)
 	if err := dec(in); err != nil {
@@ -378,6 +622,14 @@ var _DirectoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLocation",
 			Handler:    _DirectoryService_UpdateLocation_Handler,
 		},
+		{
+			MethodName: "UpdateLocations",
+			Handler:    _DirectoryService_UpdateLocations_Handler,
+		},
+		{
+			MethodName: "GetIDsByLocation",
+			Handler:    _DirectoryService_GetIDsByLocation_Handler,
+		},
 		{
 			MethodName: "DeleteLocation",
 			Handler:    _DirectoryService_DeleteLocation_Handler,
@@ -388,31 +640,45 @@ var _DirectoryService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/directoryd.proto", fileDescriptor_directoryd_f529f21e91423ef9)
-}
-
-var fileDescriptor_directoryd_f529f21e91423ef9 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x4f, 0x4f, 0xc2, 0x30,
-	0x18, 0xc6, 0xd9, 0x8c, 0xa8, 0x2f, 0x66, 0x99, 0x0d, 0x26, 0x38, 0x82, 0x92, 0x9d, 0x08, 0x9a,
-	0x2d, 0xc2, 0xc5, 0xab, 0x66, 0x46, 0x97, 0x88, 0x98, 0x0d, 0x35, 0xf1, 0x42, 0x46, 0xdb, 0x90,
-	0x25, 0x1b, 0xc5, 0x52, 0x4c, 0xbc, 0xf9, 0x15, 0xfc, 0xc6, 0x86, 0x6e, 0x0e, 0x1a, 0x09, 0x5c,
-	0x3c, 0x6d, 0x7b, 0xf6, 0xf4, 0xd7, 0xf7, 0xcf, 0x03, 0x0d, 0xc6, 0xf1, 0x15, 0x77, 0xa7, 0x9c,
-	0x09, 0x36, 0x73, 0x49, 0xcc, 0x29, 0x16, 0x8c, 0x7f, 0x12, 0x47, 0x2a, 0xa8, 0x92, 0x46, 0xe3,
-	0x34, 0x72, 0xa4, 0xc9, 0x3a, 0x51, 0xbc, 0x98, 0xa5, 0x29, 0x9b, 0x64, 0x3e, 0xfb, 0x09, 0xd0,
-	0x1d, 0x15, 0x0f, 0x0c, 0x47, 0x22, 0x66, 0x93, 0x80, 0xbe, 0xcf, 0xe9, 0x4c, 0x20, 0x03, 0xf4,
-	0x98, 0xd4, 0xb4, 0xa6, 0xd6, 0x3a, 0x08, 0xf4, 0x98, 0xa0, 0x36, 0xec, 0x8a, 0x68, 0x94, 0xd0,
-	0x9a, 0xde, 0xd4, 0x5a, 0x46, 0xa7, 0xea, 0xac, 0xd0, 0x9d, 0xc1, 0xe2, 0x8f, 0xef, 0x05, 0x99,
-	0xc5, 0x0e, 0xe1, 0xd8, 0xa3, 0x09, 0x15, 0xf4, 0x3f, 0xa1, 0x17, 0x60, 0x2c, 0x71, 0x98, 0x71,
-	0x82, 0x2c, 0xd8, 0x4f, 0x72, 0x25, 0x67, 0x16, 0xdf, 0xf6, 0xb7, 0x06, 0xa7, 0xcf, 0x53, 0x12,
-	0x09, 0xea, 0xfd, 0xce, 0x65, 0x5b, 0x31, 0x5d, 0x28, 0x73, 0x09, 0x96, 0xd5, 0x54, 0x3a, 0x75,
-	0xa5, 0x1a, 0xf5, 0xee, 0x20, 0xb7, 0x2e, 0x3b, 0xd8, 0xd9, 0xda, 0x41, 0xfb, 0x12, 0xf6, 0x72,
-	0x05, 0x99, 0x70, 0xe8, 0xf7, 0x42, 0x7f, 0x38, 0xe8, 0x0f, 0xef, 0x5f, 0x7d, 0xcf, 0x2c, 0xa1,
-	0x2a, 0x98, 0x8b, 0x37, 0xa9, 0xf4, 0xc3, 0xc1, 0xe3, 0x75, 0xef, 0xd6, 0xd4, 0x3a, 0x5f, 0x3a,
-	0x98, 0x45, 0x03, 0x21, 0xe5, 0x1f, 0x31, 0xa6, 0xa8, 0x07, 0x95, 0x95, 0x85, 0xa1, 0x33, 0xe5,
-	0xce, 0xbf, 0xab, 0xb4, 0x36, 0x35, 0x62, 0x97, 0x50, 0x00, 0x46, 0x36, 0xa9, 0x82, 0x78, 0xae,
-	0x1c, 0xd8, 0x3c, 0x46, 0xeb, 0x48, 0x31, 0xbf, 0xb0, 0x78, 0xc1, 0xf4, 0xc1, 0x50, 0x13, 0x80,
-	0x6c, 0xc5, 0xb6, 0x36, 0x1e, 0x6b, 0x51, 0x37, 0x8d, 0xb7, 0xba, 0x54, 0xdd, 0x2c, 0xc1, 0x38,
-	0x61, 0x73, 0xe2, 0x8e, 0x59, 0x1e, 0xe5, 0x51, 0x59, 0x3e, 0xbb, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x94, 0x33, 0x66, 0x34, 0x0d, 0x03, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/directoryd.proto", fileDescriptor_directoryd_001d167951069d47)
+}
+
+var fileDescriptor_directoryd_001d167951069d47 = []byte{
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4e, 0xdb, 0x40,
+	0x10, 0xc6, 0x63, 0xbb, 0x90, 0x74, 0x02, 0xc6, 0xac, 0xa8, 0x6a, 0x82, 0x68, 0x22, 0xab, 0x52,
+	0x23, 0x5a, 0x25, 0x6a, 0xb8, 0xd0, 0xde, 0x8a, 0x1c, 0x51, 0x4b, 0x4d, 0x41, 0x76, 0x52, 0xa4,
+	0x5e, 0x2c, 0x63, 0xaf, 0xd0, 0xaa, 0x76, 0x36, 0xdd, 0xdd, 0xa0, 0xe6, 0x9d, 0x38, 0xf4, 0x11,
+	0x2b, 0xff, 0x4b, 0xb2, 0x10, 0x1a, 0x90, 0x38, 0xc5, 0x3b, 0xf9, 0xfc, 0x9b, 0x6f, 0x66, 0xd6,
+	0x03, 0x87, 0x94, 0x85, 0x27, 0xac, 0x3b, 0x61, 0x54, 0x50, 0xde, 0x8d, 0x08, 0xc3, 0xa1, 0xa0,
+	0x6c, 0x16, 0x75, 0xb2, 0x08, 0xaa, 0x27, 0xc1, 0x75, 0x12, 0x74, 0x32, 0x51, 0x63, 0x5f, 0xd2,
+	0x86, 0x34, 0x49, 0xe8, 0x38, 0xd7, 0x59, 0x17, 0x80, 0xce, 0xb0, 0xf8, 0x46, 0xc3, 0x40, 0x10,
+	0x3a, 0x76, 0xf1, 0xef, 0x29, 0xe6, 0x02, 0xe9, 0xa0, 0x92, 0xc8, 0x54, 0x5a, 0x4a, 0xfb, 0xa5,
+	0xab, 0x92, 0x08, 0x1d, 0xc1, 0x86, 0x08, 0xae, 0x62, 0x6c, 0xaa, 0x2d, 0xa5, 0xad, 0xf7, 0xf6,
+	0x3a, 0x4b, 0xf4, 0xce, 0x30, 0xfd, 0xc7, 0xb1, 0xdd, 0x5c, 0x62, 0x79, 0xf0, 0xca, 0xc6, 0x31,
+	0x16, 0xf8, 0x39, 0xa1, 0x23, 0xd0, 0x17, 0xb8, 0x90, 0xb2, 0x08, 0x35, 0xa0, 0x16, 0x17, 0x91,
+	0x82, 0x39, 0x3f, 0xa3, 0x77, 0xb0, 0x83, 0xff, 0x4c, 0x08, 0xcb, 0x4e, 0xbe, 0x20, 0x49, 0x9e,
+	0x43, 0x73, 0xf5, 0x45, 0x78, 0x48, 0x12, 0x6c, 0xdd, 0x2a, 0xf0, 0x66, 0x34, 0x89, 0x02, 0x81,
+	0xed, 0xb2, 0x81, 0xeb, 0x5c, 0x1f, 0xc3, 0x26, 0xcb, 0x1c, 0x64, 0xc8, 0x7a, 0xef, 0x40, 0xb2,
+	0x2d, 0x9b, 0x74, 0x0b, 0xe9, 0xa2, 0x54, 0x6d, 0x6d, 0xa9, 0x68, 0x1f, 0x6a, 0x42, 0xc4, 0x3e,
+	0xc7, 0x21, 0x37, 0x5f, 0xb4, 0x94, 0xf6, 0xb6, 0x5b, 0x15, 0x22, 0xf6, 0x70, 0xc8, 0xad, 0xbf,
+	0x2a, 0x34, 0x1f, 0xb0, 0xcb, 0x4b, 0xbf, 0xf3, 0x54, 0xca, 0xfa, 0x54, 0x1e, 0x54, 0x73, 0x83,
+	0xdc, 0x54, 0x5b, 0x5a, 0xbb, 0xde, 0xfb, 0x24, 0xa9, 0xd7, 0xa4, 0xea, 0xe4, 0x45, 0xf2, 0xfe,
+	0x58, 0xb0, 0x99, 0x5b, 0x92, 0x24, 0xff, 0x9a, 0xe4, 0x1f, 0x99, 0x69, 0xbe, 0x49, 0x1c, 0x84,
+	0x38, 0xab, 0xac, 0xe6, 0x96, 0xc7, 0xc6, 0x25, 0x6c, 0x2d, 0xd3, 0x90, 0x01, 0xda, 0x2f, 0x3c,
+	0x2b, 0xda, 0x9e, 0x3e, 0xa2, 0x8f, 0xb0, 0x71, 0x13, 0xc4, 0x53, 0xfc, 0x98, 0xb6, 0xe7, 0xca,
+	0xcf, 0xea, 0x89, 0x62, 0x05, 0xf0, 0xfa, 0x0c, 0x0b, 0xc7, 0xe6, 0xa7, 0xf7, 0x26, 0xfb, 0x94,
+	0x4e, 0x2d, 0xdf, 0x36, 0x55, 0xbe, 0x6d, 0x56, 0x13, 0xea, 0x25, 0xda, 0xb1, 0x79, 0x6a, 0x9d,
+	0x44, 0xdc, 0x54, 0x5a, 0x5a, 0x6a, 0x9d, 0x44, 0xfc, 0xc8, 0x86, 0x6a, 0x81, 0x43, 0x06, 0x6c,
+	0x39, 0x03, 0xcf, 0xf1, 0x87, 0xe7, 0xfe, 0xd7, 0x4b, 0xc7, 0x36, 0x2a, 0x68, 0x0f, 0x8c, 0xf4,
+	0x29, 0x8b, 0x9c, 0x7b, 0xc3, 0xef, 0x5f, 0x06, 0x7d, 0x43, 0x41, 0xbb, 0xb0, 0x3d, 0xea, 0xfb,
+	0xce, 0x45, 0x1a, 0x4e, 0x5f, 0x30, 0xd4, 0xde, 0xad, 0x06, 0xc6, 0x7c, 0x16, 0x1e, 0x66, 0x37,
+	0x24, 0xc4, 0x68, 0x00, 0xf5, 0xa5, 0xcf, 0x17, 0x35, 0xa5, 0x1a, 0xee, 0x7f, 0xd8, 0x8d, 0xff,
+	0xb5, 0xcd, 0xaa, 0x20, 0x17, 0xf4, 0x7c, 0xe8, 0x73, 0xe2, 0xfb, 0xc7, 0xdc, 0x88, 0x92, 0xbe,
+	0x2b, 0x89, 0x7f, 0x50, 0x92, 0x32, 0x87, 0xb0, 0x23, 0x33, 0x39, 0xfa, 0xf0, 0x94, 0x6b, 0xf6,
+	0x10, 0xd5, 0xb8, 0x3b, 0x57, 0xf4, 0xf6, 0x6e, 0xf5, 0xab, 0xc6, 0xde, 0x30, 0x57, 0xb6, 0xc0,
+	0xb1, 0xb9, 0x55, 0x41, 0x0e, 0xe8, 0xf2, 0xee, 0x42, 0x96, 0xa4, 0x5e, 0xb9, 0xd8, 0x56, 0x1a,
+	0x3c, 0x3d, 0xfc, 0x79, 0x90, 0x45, 0xbb, 0xf9, 0xee, 0x0d, 0x63, 0x3a, 0x8d, 0xba, 0xd7, 0xb4,
+	0x58, 0xc2, 0x57, 0x9b, 0xd9, 0xef, 0xf1, 0xbf, 0x01, 0x00, 0xed, 0xcb, 0x6f, 0x00, 0xc7, 0x05,
+	0x00, 0x00,
 }
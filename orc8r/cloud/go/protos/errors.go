@@ -10,6 +10,7 @@ package protos
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/golang/glog"
 	"google.golang.org/grpc/codes"
@@ -26,3 +27,24 @@ func NewGrpcValidationError(err error) error {
 	glog.Error(err)
 	return status.Errorf(codes.FailedPrecondition, "%s", err)
 }
+
+// grpcConflictPrefix starts the message of the statuses returned by
+// NewGrpcConflictError, telling them apart from other Aborted statuses
+const grpcConflictPrefix = "Conflict: "
+
+// NewGrpcConflictError returns an Aborted status for a write which lost a
+// race with a concurrent writer, e.g. a conditional datastore write which
+// failed with datastore.ErrGenerationConflict. Unlike the datastore error,
+// the status survives the gRPC hop to obsidian, which maps it to HTTP 409.
+func NewGrpcConflictError(format string, a ...interface{}) error {
+	msg := grpcConflictPrefix + fmt.Sprintf(format, a...)
+	glog.Error(msg)
+	return status.Error(codes.Aborted, msg)
+}
+
+// IsGrpcConflictError returns true if err is a status returned by
+// NewGrpcConflictError, on either side of the gRPC hop.
+func IsGrpcConflictError(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() == codes.Aborted && strings.HasPrefix(st.Message(), grpcConflictPrefix)
+}
@@ -47,7 +47,7 @@ func (x NetworkInterface_Status) String() string {
 	return proto.EnumName(NetworkInterface_Status_name, int32(x))
 }
 func (NetworkInterface_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{19, 0}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{19, 0}
 }
 
 // RequestedAction is an emergency/last resort operation request for an
@@ -76,7 +76,30 @@ func (x CheckinResponse_RequestedAction) String() string {
 	return proto.EnumName(CheckinResponse_RequestedAction_name, int32(x))
 }
 func (CheckinResponse_RequestedAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{25, 0}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{25, 0}
+}
+
+type ConnectivityEvent_Type int32
+
+const (
+	ConnectivityEvent_ONLINE  ConnectivityEvent_Type = 0
+	ConnectivityEvent_OFFLINE ConnectivityEvent_Type = 1
+)
+
+var ConnectivityEvent_Type_name = map[int32]string{
+	0: "ONLINE",
+	1: "OFFLINE",
+}
+var ConnectivityEvent_Type_value = map[string]int32{
+	"ONLINE":  0,
+	"OFFLINE": 1,
+}
+
+func (x ConnectivityEvent_Type) String() string {
+	return proto.EnumName(ConnectivityEvent_Type_name, int32(x))
+}
+func (ConnectivityEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{29, 0}
 }
 
 type PingParams struct {
@@ -91,7 +114,7 @@ func (m *PingParams) Reset()         { *m = PingParams{} }
 func (m *PingParams) String() string { return proto.CompactTextString(m) }
 func (*PingParams) ProtoMessage()    {}
 func (*PingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{0}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{0}
 }
 func (m *PingParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingParams.Unmarshal(m, b)
@@ -138,7 +161,7 @@ func (m *TracerouteParams) Reset()         { *m = TracerouteParams{} }
 func (m *TracerouteParams) String() string { return proto.CompactTextString(m) }
 func (*TracerouteParams) ProtoMessage()    {}
 func (*TracerouteParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{1}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{1}
 }
 func (m *TracerouteParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TracerouteParams.Unmarshal(m, b)
@@ -191,7 +214,7 @@ func (m *NetworkTestRequest) Reset()         { *m = NetworkTestRequest{} }
 func (m *NetworkTestRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkTestRequest) ProtoMessage()    {}
 func (*NetworkTestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{2}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{2}
 }
 func (m *NetworkTestRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkTestRequest.Unmarshal(m, b)
@@ -241,7 +264,7 @@ func (m *PingResult) Reset()         { *m = PingResult{} }
 func (m *PingResult) String() string { return proto.CompactTextString(m) }
 func (*PingResult) ProtoMessage()    {}
 func (*PingResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{3}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{3}
 }
 func (m *PingResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingResult.Unmarshal(m, b)
@@ -316,7 +339,7 @@ func (m *TracerouteProbe) Reset()         { *m = TracerouteProbe{} }
 func (m *TracerouteProbe) String() string { return proto.CompactTextString(m) }
 func (*TracerouteProbe) ProtoMessage()    {}
 func (*TracerouteProbe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{4}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{4}
 }
 func (m *TracerouteProbe) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TracerouteProbe.Unmarshal(m, b)
@@ -369,7 +392,7 @@ func (m *TracerouteHop) Reset()         { *m = TracerouteHop{} }
 func (m *TracerouteHop) String() string { return proto.CompactTextString(m) }
 func (*TracerouteHop) ProtoMessage()    {}
 func (*TracerouteHop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{5}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{5}
 }
 func (m *TracerouteHop) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TracerouteHop.Unmarshal(m, b)
@@ -416,7 +439,7 @@ func (m *TracerouteResult) Reset()         { *m = TracerouteResult{} }
 func (m *TracerouteResult) String() string { return proto.CompactTextString(m) }
 func (*TracerouteResult) ProtoMessage()    {}
 func (*TracerouteResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{6}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{6}
 }
 func (m *TracerouteResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TracerouteResult.Unmarshal(m, b)
@@ -469,7 +492,7 @@ func (m *NetworkTestResponse) Reset()         { *m = NetworkTestResponse{} }
 func (m *NetworkTestResponse) String() string { return proto.CompactTextString(m) }
 func (*NetworkTestResponse) ProtoMessage()    {}
 func (*NetworkTestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{7}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{7}
 }
 func (m *NetworkTestResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkTestResponse.Unmarshal(m, b)
@@ -514,7 +537,7 @@ func (m *GetGatewayIdResponse) Reset()         { *m = GetGatewayIdResponse{} }
 func (m *GetGatewayIdResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayIdResponse) ProtoMessage()    {}
 func (*GetGatewayIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{8}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{8}
 }
 func (m *GetGatewayIdResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayIdResponse.Unmarshal(m, b)
@@ -552,7 +575,7 @@ func (m *RestartServicesRequest) Reset()         { *m = RestartServicesRequest{}
 func (m *RestartServicesRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartServicesRequest) ProtoMessage()    {}
 func (*RestartServicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{9}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{9}
 }
 func (m *RestartServicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestartServicesRequest.Unmarshal(m, b)
@@ -591,7 +614,7 @@ func (m *GenericCommandParams) Reset()         { *m = GenericCommandParams{} }
 func (m *GenericCommandParams) String() string { return proto.CompactTextString(m) }
 func (*GenericCommandParams) ProtoMessage()    {}
 func (*GenericCommandParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{10}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{10}
 }
 func (m *GenericCommandParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenericCommandParams.Unmarshal(m, b)
@@ -636,7 +659,7 @@ func (m *GenericCommandResponse) Reset()         { *m = GenericCommandResponse{}
 func (m *GenericCommandResponse) String() string { return proto.CompactTextString(m) }
 func (*GenericCommandResponse) ProtoMessage()    {}
 func (*GenericCommandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{11}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{11}
 }
 func (m *GenericCommandResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenericCommandResponse.Unmarshal(m, b)
@@ -674,7 +697,7 @@ func (m *TailLogsRequest) Reset()         { *m = TailLogsRequest{} }
 func (m *TailLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*TailLogsRequest) ProtoMessage()    {}
 func (*TailLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{12}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{12}
 }
 func (m *TailLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TailLogsRequest.Unmarshal(m, b)
@@ -712,7 +735,7 @@ func (m *LogLine) Reset()         { *m = LogLine{} }
 func (m *LogLine) String() string { return proto.CompactTextString(m) }
 func (*LogLine) ProtoMessage()    {}
 func (*LogLine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{13}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{13}
 }
 func (m *LogLine) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLine.Unmarshal(m, b)
@@ -755,7 +778,7 @@ func (m *DiskPartition) Reset()         { *m = DiskPartition{} }
 func (m *DiskPartition) String() string { return proto.CompactTextString(m) }
 func (*DiskPartition) ProtoMessage()    {}
 func (*DiskPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{14}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{14}
 }
 func (m *DiskPartition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiskPartition.Unmarshal(m, b)
@@ -834,7 +857,7 @@ func (m *SystemStatus) Reset()         { *m = SystemStatus{} }
 func (m *SystemStatus) String() string { return proto.CompactTextString(m) }
 func (*SystemStatus) ProtoMessage()    {}
 func (*SystemStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{15}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{15}
 }
 func (m *SystemStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SystemStatus.Unmarshal(m, b)
@@ -959,7 +982,7 @@ func (m *Package) Reset()         { *m = Package{} }
 func (m *Package) String() string { return proto.CompactTextString(m) }
 func (*Package) ProtoMessage()    {}
 func (*Package) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{16}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{16}
 }
 func (m *Package) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Package.Unmarshal(m, b)
@@ -1005,7 +1028,7 @@ func (m *ConfigInfo) Reset()         { *m = ConfigInfo{} }
 func (m *ConfigInfo) String() string { return proto.CompactTextString(m) }
 func (*ConfigInfo) ProtoMessage()    {}
 func (*ConfigInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{17}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{17}
 }
 func (m *ConfigInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigInfo.Unmarshal(m, b)
@@ -1052,7 +1075,7 @@ func (m *PlatformInfo) Reset()         { *m = PlatformInfo{} }
 func (m *PlatformInfo) String() string { return proto.CompactTextString(m) }
 func (*PlatformInfo) ProtoMessage()    {}
 func (*PlatformInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{18}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{18}
 }
 func (m *PlatformInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlatformInfo.Unmarshal(m, b)
@@ -1127,7 +1150,7 @@ func (m *NetworkInterface) Reset()         { *m = NetworkInterface{} }
 func (m *NetworkInterface) String() string { return proto.CompactTextString(m) }
 func (*NetworkInterface) ProtoMessage()    {}
 func (*NetworkInterface) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{19}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{19}
 }
 func (m *NetworkInterface) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInterface.Unmarshal(m, b)
@@ -1200,7 +1223,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{20}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{20}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -1262,7 +1285,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{21}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{21}
 }
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
@@ -1314,7 +1337,7 @@ func (m *CPUInfo) Reset()         { *m = CPUInfo{} }
 func (m *CPUInfo) String() string { return proto.CompactTextString(m) }
 func (*CPUInfo) ProtoMessage()    {}
 func (*CPUInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{22}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{22}
 }
 func (m *CPUInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CPUInfo.Unmarshal(m, b)
@@ -1376,7 +1399,7 @@ func (m *MachineInfo) Reset()         { *m = MachineInfo{} }
 func (m *MachineInfo) String() string { return proto.CompactTextString(m) }
 func (*MachineInfo) ProtoMessage()    {}
 func (*MachineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{23}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{23}
 }
 func (m *MachineInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MachineInfo.Unmarshal(m, b)
@@ -1442,7 +1465,7 @@ func (m *CheckinRequest) Reset()         { *m = CheckinRequest{} }
 func (m *CheckinRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckinRequest) ProtoMessage()    {}
 func (*CheckinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{24}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{24}
 }
 func (m *CheckinRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckinRequest.Unmarshal(m, b)
@@ -1541,7 +1564,7 @@ func (m *CheckinResponse) Reset()         { *m = CheckinResponse{} }
 func (m *CheckinResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckinResponse) ProtoMessage()    {}
 func (*CheckinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{25}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{25}
 }
 func (m *CheckinResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckinResponse.Unmarshal(m, b)
@@ -1591,7 +1614,7 @@ func (m *GatewayStatus) Reset()         { *m = GatewayStatus{} }
 func (m *GatewayStatus) String() string { return proto.CompactTextString(m) }
 func (*GatewayStatus) ProtoMessage()    {}
 func (*GatewayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{26}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{26}
 }
 func (m *GatewayStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayStatus.Unmarshal(m, b)
@@ -1632,6 +1655,250 @@ func (m *GatewayStatus) GetCertExpirationTime() int64 {
 	return 0
 }
 
+// CheckinRecord is the summary of a single gateway checkin kept in the
+// gateway's checkin history
+type CheckinRecord struct {
+	// Unix time in milliseconds the checkin was received at
+	Time               uint64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	UptimeSecs         uint64 `protobuf:"varint,2,opt,name=uptime_secs,json=uptimeSecs,proto3" json:"uptime_secs,omitempty"`
+	CertExpirationTime int64  `protobuf:"varint,3,opt,name=cert_expiration_time,json=certExpirationTime,proto3" json:"cert_expiration_time,omitempty"`
+	// Version of the magma package the gateway runs
+	Version              string   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	CpuUser              uint64   `protobuf:"varint,5,opt,name=cpu_user,json=cpuUser,proto3" json:"cpu_user,omitempty"`
+	CpuSystem            uint64   `protobuf:"varint,6,opt,name=cpu_system,json=cpuSystem,proto3" json:"cpu_system,omitempty"`
+	CpuIdle              uint64   `protobuf:"varint,7,opt,name=cpu_idle,json=cpuIdle,proto3" json:"cpu_idle,omitempty"`
+	MemTotal             uint64   `protobuf:"varint,8,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"`
+	MemAvailable         uint64   `protobuf:"varint,9,opt,name=mem_available,json=memAvailable,proto3" json:"mem_available,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckinRecord) Reset()         { *m = CheckinRecord{} }
+func (m *CheckinRecord) String() string { return proto.CompactTextString(m) }
+func (*CheckinRecord) ProtoMessage()    {}
+func (*CheckinRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{27}
+}
+func (m *CheckinRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckinRecord.Unmarshal(m, b)
+}
+func (m *CheckinRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckinRecord.Marshal(b, m, deterministic)
+}
+func (dst *CheckinRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckinRecord.Merge(dst, src)
+}
+func (m *CheckinRecord) XXX_Size() int {
+	return xxx_messageInfo_CheckinRecord.Size(m)
+}
+func (m *CheckinRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckinRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckinRecord proto.InternalMessageInfo
+
+func (m *CheckinRecord) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *CheckinRecord) GetUptimeSecs() uint64 {
+	if m != nil {
+		return m.UptimeSecs
+	}
+	return 0
+}
+
+func (m *CheckinRecord) GetCertExpirationTime() int64 {
+	if m != nil {
+		return m.CertExpirationTime
+	}
+	return 0
+}
+
+func (m *CheckinRecord) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CheckinRecord) GetCpuUser() uint64 {
+	if m != nil {
+		return m.CpuUser
+	}
+	return 0
+}
+
+func (m *CheckinRecord) GetCpuSystem() uint64 {
+	if m != nil {
+		return m.CpuSystem
+	}
+	return 0
+}
+
+func (m *CheckinRecord) GetCpuIdle() uint64 {
+	if m != nil {
+		return m.CpuIdle
+	}
+	return 0
+}
+
+func (m *CheckinRecord) GetMemTotal() uint64 {
+	if m != nil {
+		return m.MemTotal
+	}
+	return 0
+}
+
+func (m *CheckinRecord) GetMemAvailable() uint64 {
+	if m != nil {
+		return m.MemAvailable
+	}
+	return 0
+}
+
+// CheckinHistory holds the most recent checkins of a gateway, oldest first
+type CheckinHistory struct {
+	Records              []*CheckinRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CheckinHistory) Reset()         { *m = CheckinHistory{} }
+func (m *CheckinHistory) String() string { return proto.CompactTextString(m) }
+func (*CheckinHistory) ProtoMessage()    {}
+func (*CheckinHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{28}
+}
+func (m *CheckinHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckinHistory.Unmarshal(m, b)
+}
+func (m *CheckinHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckinHistory.Marshal(b, m, deterministic)
+}
+func (dst *CheckinHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckinHistory.Merge(dst, src)
+}
+func (m *CheckinHistory) XXX_Size() int {
+	return xxx_messageInfo_CheckinHistory.Size(m)
+}
+func (m *CheckinHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckinHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckinHistory proto.InternalMessageInfo
+
+func (m *CheckinHistory) GetRecords() []*CheckinRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// ConnectivityEvent marks the start of a period in which a gateway was
+// online (checking in regularly) or offline (missing its checkins)
+type ConnectivityEvent struct {
+	Type ConnectivityEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=magma.orc8r.ConnectivityEvent_Type" json:"type,omitempty"`
+	// Unix time in milliseconds of the transition. Offline periods start at the
+	// last checkin before the gateway went silent.
+	Time uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Length of the period in milliseconds, up to the next event or, for the
+	// last event, up to the time of the request
+	DurationMs           uint64   `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConnectivityEvent) Reset()         { *m = ConnectivityEvent{} }
+func (m *ConnectivityEvent) String() string { return proto.CompactTextString(m) }
+func (*ConnectivityEvent) ProtoMessage()    {}
+func (*ConnectivityEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{29}
+}
+func (m *ConnectivityEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectivityEvent.Unmarshal(m, b)
+}
+func (m *ConnectivityEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectivityEvent.Marshal(b, m, deterministic)
+}
+func (dst *ConnectivityEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectivityEvent.Merge(dst, src)
+}
+func (m *ConnectivityEvent) XXX_Size() int {
+	return xxx_messageInfo_ConnectivityEvent.Size(m)
+}
+func (m *ConnectivityEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectivityEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectivityEvent proto.InternalMessageInfo
+
+func (m *ConnectivityEvent) GetType() ConnectivityEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ConnectivityEvent_ONLINE
+}
+
+func (m *ConnectivityEvent) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *ConnectivityEvent) GetDurationMs() uint64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+type ConnectivityEvents struct {
+	// Events in chronological order
+	Events               []*ConnectivityEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ConnectivityEvents) Reset()         { *m = ConnectivityEvents{} }
+func (m *ConnectivityEvents) String() string { return proto.CompactTextString(m) }
+func (*ConnectivityEvents) ProtoMessage()    {}
+func (*ConnectivityEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{30}
+}
+func (m *ConnectivityEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConnectivityEvents.Unmarshal(m, b)
+}
+func (m *ConnectivityEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConnectivityEvents.Marshal(b, m, deterministic)
+}
+func (dst *ConnectivityEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectivityEvents.Merge(dst, src)
+}
+func (m *ConnectivityEvents) XXX_Size() int {
+	return xxx_messageInfo_ConnectivityEvents.Size(m)
+}
+func (m *ConnectivityEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectivityEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectivityEvents proto.InternalMessageInfo
+
+func (m *ConnectivityEvents) GetEvents() []*ConnectivityEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type GatewayStatusRequest struct {
 	// Gateway's network id
 	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
@@ -1646,7 +1913,7 @@ func (m *GatewayStatusRequest) Reset()         { *m = GatewayStatusRequest{} }
 func (m *GatewayStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GatewayStatusRequest) ProtoMessage()    {}
 func (*GatewayStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{27}
+	return fileDescriptor_magmad_ef896ca4cb858b3d, []int{31}
 }
 func (m *GatewayStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayStatusRequest.Unmarshal(m, b)
@@ -1708,9 +1975,14 @@ func init() {
 	proto.RegisterType((*CheckinRequest)(nil), "magma.orc8r.CheckinRequest")
 	proto.RegisterType((*CheckinResponse)(nil), "magma.orc8r.CheckinResponse")
 	proto.RegisterType((*GatewayStatus)(nil), "magma.orc8r.GatewayStatus")
+	proto.RegisterType((*CheckinRecord)(nil), "magma.orc8r.CheckinRecord")
+	proto.RegisterType((*CheckinHistory)(nil), "magma.orc8r.CheckinHistory")
+	proto.RegisterType((*ConnectivityEvent)(nil), "magma.orc8r.ConnectivityEvent")
+	proto.RegisterType((*ConnectivityEvents)(nil), "magma.orc8r.ConnectivityEvents")
 	proto.RegisterType((*GatewayStatusRequest)(nil), "magma.orc8r.GatewayStatusRequest")
 	proto.RegisterEnum("magma.orc8r.NetworkInterface_Status", NetworkInterface_Status_name, NetworkInterface_Status_value)
 	proto.RegisterEnum("magma.orc8r.CheckinResponse_RequestedAction", CheckinResponse_RequestedAction_name, CheckinResponse_RequestedAction_value)
+	proto.RegisterEnum("magma.orc8r.ConnectivityEvent_Type", ConnectivityEvent_Type_name, ConnectivityEvent_Type_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2145,6 +2417,11 @@ type CheckindClient interface {
 	// Returns a list of all logical gateway IDs for the given network which have
 	// status stored in the service DB
 	List(ctx context.Context, in *NetworkID, opts ...grpc.CallOption) (*IDList, error)
+	// Returns the bounded checkin history of the gateway
+	GetCheckinHistory(ctx context.Context, in *GatewayStatusRequest, opts ...grpc.CallOption) (*CheckinHistory, error)
+	// Returns the online/offline transitions of the gateway derived from gaps
+	// in its checkin history
+	GetConnectivityEvents(ctx context.Context, in *GatewayStatusRequest, opts ...grpc.CallOption) (*ConnectivityEvents, error)
 }
 
 type checkindClient struct {
@@ -2200,6 +2477,24 @@ func (c *checkindClient) List(ctx context.Context, in *NetworkID, opts ...grpc.C
 	return out, nil
 }
 
+func (c *checkindClient) GetCheckinHistory(ctx context.Context, in *GatewayStatusRequest, opts ...grpc.CallOption) (*CheckinHistory, error) {
+	out := new(CheckinHistory)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.Checkind/GetCheckinHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkindClient) GetConnectivityEvents(ctx context.Context, in *GatewayStatusRequest, opts ...grpc.CallOption) (*ConnectivityEvents, error) {
+	out := new(ConnectivityEvents)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.Checkind/GetConnectivityEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CheckindServer is the server API for Checkind service.
 type CheckindServer interface {
 	// Gateway periodic checkin - records given GW status to the GW's network table
@@ -2213,6 +2508,11 @@ type CheckindServer interface {
 	// Returns a list of all logical gateway IDs for the given network which have
 	// status stored in the service DB
 	List(context.Context, *NetworkID) (*IDList, error)
+	// Returns the bounded checkin history of the gateway
+	GetCheckinHistory(context.Context, *GatewayStatusRequest) (*CheckinHistory, error)
+	// Returns the online/offline transitions of the gateway derived from gaps
+	// in its checkin history
+	GetConnectivityEvents(context.Context, *GatewayStatusRequest) (*ConnectivityEvents, error)
 }
 
 func RegisterCheckindServer(s *grpc.Server, srv CheckindServer) {
@@ -2309,6 +2609,42 @@ func _Checkind_List_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Checkind_GetCheckinHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckindServer).GetCheckinHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.Checkind/GetCheckinHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckindServer).GetCheckinHistory(ctx, req.(*GatewayStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Checkind_GetConnectivityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckindServer).GetConnectivityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.Checkind/GetConnectivityEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckindServer).GetConnectivityEvents(ctx, req.(*GatewayStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Checkind_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.Checkind",
 	HandlerType: (*CheckindServer)(nil),
@@ -2333,141 +2669,161 @@ var _Checkind_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Checkind_List_Handler,
 		},
+		{
+			MethodName: "GetCheckinHistory",
+			Handler:    _Checkind_GetCheckinHistory_Handler,
+		},
+		{
+			MethodName: "GetConnectivityEvents",
+			Handler:    _Checkind_GetConnectivityEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orc8r/protos/magmad.proto",
 }
 
-func init() { proto.RegisterFile("orc8r/protos/magmad.proto", fileDescriptor_magmad_2d8fc06d9226d13d) }
-
-var fileDescriptor_magmad_2d8fc06d9226d13d = []byte{
-	// 2046 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0xd6, 0x50, 0xfc, 0x11, 0x8b, 0x3f, 0xa2, 0xdb, 0x5a, 0x2f, 0x4d, 0x5b, 0xb0, 0x3d, 0xce,
-	0x8f, 0x17, 0xd9, 0x48, 0x86, 0xbc, 0xde, 0x5d, 0xec, 0x2e, 0x1c, 0xc8, 0x92, 0x6c, 0x13, 0x96,
-	0x64, 0xa2, 0x49, 0x7b, 0x91, 0xbd, 0x0c, 0x5a, 0x33, 0x4d, 0x6a, 0x20, 0xce, 0xf4, 0xa4, 0xbb,
-	0x49, 0xdb, 0xc8, 0x35, 0xc9, 0x29, 0xc7, 0x24, 0x40, 0x5e, 0x22, 0x87, 0x9c, 0xf2, 0x24, 0x79,
-	0x82, 0xe4, 0x0d, 0xf2, 0x00, 0x41, 0xff, 0xcc, 0x70, 0x86, 0xa6, 0x14, 0x27, 0x39, 0x71, 0xba,
-	0xfe, 0xba, 0xaa, 0xba, 0xea, 0xab, 0x6e, 0xc2, 0x4d, 0xc6, 0xfd, 0xaf, 0xf9, 0x6e, 0xc2, 0x99,
-	0x64, 0x62, 0x37, 0x22, 0x93, 0x88, 0x04, 0x3b, 0x7a, 0x85, 0x1a, 0x7a, 0xb5, 0xa3, 0x05, 0x7a,
-	0x45, 0x39, 0x9f, 0x45, 0x11, 0x8b, 0x8d, 0x5c, 0xaf, 0x57, 0x34, 0xe1, 0xb3, 0x78, 0x1c, 0x4e,
-	0x2c, 0x6f, 0xbb, 0xc0, 0x13, 0x94, 0xcf, 0x43, 0x9f, 0x3e, 0x7a, 0xf8, 0xc8, 0xb2, 0x6f, 0x4f,
-	0x18, 0x9b, 0x4c, 0xa9, 0xe1, 0x9f, 0xcd, 0xc6, 0xbb, 0x42, 0xf2, 0x99, 0x2f, 0x0d, 0xd7, 0x7d,
-	0x09, 0x30, 0x08, 0xe3, 0xc9, 0x80, 0x70, 0x12, 0x09, 0x74, 0x1b, 0xe0, 0x9c, 0x09, 0xe9, 0x31,
-	0xee, 0x85, 0x49, 0xd7, 0xb9, 0xeb, 0x3c, 0xa8, 0xe3, 0x0d, 0x45, 0x79, 0xc5, 0xfb, 0x09, 0xba,
-	0x03, 0x8d, 0x78, 0x16, 0x79, 0x09, 0xf1, 0x2f, 0xa8, 0x14, 0xdd, 0xd2, 0x5d, 0xe7, 0x41, 0x05,
-	0x43, 0x3c, 0x8b, 0x06, 0x86, 0xe2, 0xce, 0xa0, 0x33, 0xe2, 0xc4, 0xa7, 0x9c, 0xcd, 0x24, 0xfd,
-	0x28, 0x93, 0x37, 0x61, 0x23, 0x22, 0xef, 0xbc, 0x73, 0x96, 0xa4, 0xf6, 0x6a, 0x11, 0x79, 0xf7,
-	0x82, 0x25, 0x02, 0x3d, 0x80, 0xce, 0xd9, 0x7b, 0x49, 0x85, 0x97, 0x50, 0x6e, 0xf7, 0xec, 0xae,
-	0x6b, 0x91, 0xb6, 0xa6, 0x0f, 0x28, 0x37, 0xfb, 0xba, 0xbf, 0x71, 0x00, 0x9d, 0x52, 0xf9, 0x96,
-	0xf1, 0x8b, 0x11, 0x15, 0x12, 0xd3, 0x5f, 0xcd, 0xa8, 0x90, 0xe8, 0xe7, 0x50, 0x49, 0xc2, 0x78,
-	0x22, 0xba, 0xce, 0xdd, 0xf5, 0x07, 0x8d, 0xbd, 0x4f, 0x77, 0x72, 0xb9, 0xde, 0x59, 0x04, 0x8d,
-	0x8d, 0x14, 0xfa, 0x05, 0x34, 0x64, 0xe6, 0xbc, 0xf2, 0x46, 0x29, 0x6d, 0x17, 0x94, 0x96, 0x83,
-	0xc3, 0x79, 0x0d, 0xf7, 0x9f, 0x8e, 0xc9, 0x25, 0xa6, 0x62, 0x36, 0x95, 0xff, 0x67, 0x2e, 0xd1,
-	0x16, 0x54, 0x28, 0xe7, 0x8c, 0xeb, 0x98, 0xeb, 0xd8, 0x2c, 0xd0, 0x2e, 0x5c, 0xb7, 0x2a, 0x9e,
-	0xe4, 0x24, 0x16, 0x51, 0x28, 0x25, 0x0d, 0xba, 0x65, 0xad, 0x8e, 0x2c, 0x6b, 0xb4, 0xe0, 0xa0,
-	0xcf, 0xa0, 0x93, 0x2a, 0x70, 0xea, 0xd3, 0x70, 0x4e, 0x83, 0x6e, 0x45, 0x4b, 0x6f, 0x5a, 0x3a,
-	0xb6, 0x64, 0xf4, 0x13, 0xd8, 0x24, 0xf3, 0x89, 0xc7, 0xa9, 0x48, 0x58, 0x2c, 0xa8, 0x17, 0x89,
-	0x6e, 0xf5, 0xae, 0xf3, 0xa0, 0x84, 0x5b, 0x64, 0xae, 0x82, 0xd2, 0xd4, 0x13, 0xe1, 0x8e, 0x60,
-	0x33, 0x97, 0x08, 0xce, 0xce, 0x28, 0xea, 0x81, 0x8e, 0x2c, 0x26, 0x11, 0xcd, 0x47, 0xaa, 0xd6,
-	0xa8, 0x0d, 0xa5, 0x30, 0xd1, 0x01, 0xd6, 0x71, 0x29, 0x4c, 0xd0, 0x27, 0x50, 0xe5, 0x52, 0x2a,
-	0xeb, 0xeb, 0xda, 0x7a, 0x85, 0x4b, 0x79, 0x22, 0xdc, 0xef, 0xa1, 0xb5, 0xb0, 0xfa, 0x82, 0x25,
-	0xa8, 0x03, 0xeb, 0x61, 0xf0, 0x4e, 0x9b, 0xab, 0x60, 0xf5, 0x89, 0xbe, 0x80, 0x6a, 0xa2, 0xb6,
-	0x4b, 0x0f, 0xe7, 0xf6, 0x65, 0x87, 0xa3, 0x84, 0xb0, 0x95, 0x75, 0xe7, 0xf9, 0xa2, 0xb4, 0x67,
-	0x93, 0x25, 0xd7, 0xc9, 0x27, 0xb7, 0x78, 0x62, 0xa5, 0xa5, 0x13, 0xdb, 0x81, 0xb2, 0x2e, 0xd3,
-	0x75, 0xbd, 0x77, 0xef, 0x92, 0xbd, 0x5f, 0xb0, 0x04, 0x6b, 0x39, 0xf7, 0xb7, 0x0e, 0x5c, 0x2f,
-	0x54, 0xa5, 0x49, 0xe0, 0x7f, 0x2e, 0x4b, 0xe3, 0xe3, 0xff, 0x54, 0x96, 0x56, 0xb5, 0x50, 0x96,
-	0x8f, 0x61, 0xeb, 0x39, 0x95, 0xcf, 0x89, 0xa4, 0x6f, 0xc9, 0xfb, 0x7e, 0x90, 0xf9, 0xb1, 0x0d,
-	0x30, 0x31, 0x44, 0x2f, 0x0c, 0x6c, 0x22, 0xea, 0x93, 0x54, 0xcc, 0xfd, 0x02, 0x6e, 0x60, 0x2a,
-	0x24, 0xe1, 0x72, 0x68, 0x10, 0x45, 0xa4, 0x7d, 0xd5, 0x83, 0x0d, 0x0b, 0x32, 0x26, 0x86, 0x3a,
-	0xce, 0xd6, 0x2e, 0x51, 0x9b, 0xc5, 0x94, 0x87, 0xfe, 0x01, 0x8b, 0x22, 0x12, 0x07, 0x16, 0x05,
-	0xba, 0x50, 0xf3, 0x0d, 0xc1, 0xee, 0x94, 0x2e, 0xd1, 0x2e, 0x54, 0x13, 0x2d, 0xa3, 0x13, 0xae,
-	0xf2, 0x61, 0xf0, 0x6a, 0x27, 0xc5, 0xab, 0x9d, 0xa1, 0xc6, 0x2b, 0x6c, 0xc5, 0xdc, 0x13, 0xb8,
-	0x51, 0xdc, 0x22, 0x8b, 0xe8, 0x11, 0x6c, 0xa4, 0xc5, 0xab, 0x77, 0xb9, 0xc2, 0x58, 0x26, 0xe8,
-	0xfe, 0x0c, 0x36, 0x47, 0x24, 0x9c, 0x1e, 0xb3, 0x49, 0x16, 0x60, 0x17, 0x6a, 0x36, 0xa0, 0xd4,
-	0x59, 0xbb, 0x74, 0xb7, 0xa1, 0x76, 0xcc, 0x26, 0xc7, 0x61, 0x4c, 0x11, 0x82, 0xf2, 0x34, 0x8c,
-	0x53, 0x09, 0xfd, 0xed, 0xfe, 0xce, 0x81, 0xd6, 0x61, 0x28, 0x2e, 0x06, 0x84, 0xcb, 0x50, 0x86,
-	0x2c, 0x46, 0x37, 0xa0, 0x1a, 0xd0, 0x9c, 0x25, 0xbb, 0x52, 0xed, 0x1f, 0xb1, 0x59, 0x2c, 0xbd,
-	0x84, 0x85, 0xb1, 0xb4, 0xb5, 0x06, 0x9a, 0x34, 0x50, 0x14, 0x55, 0xa1, 0x92, 0x49, 0x32, 0xd5,
-	0x4d, 0x52, 0xc6, 0x66, 0xa1, 0x36, 0x9d, 0x09, 0xdb, 0xef, 0x65, 0xac, 0xbf, 0x15, 0x6d, 0xcc,
-	0x29, 0xd5, 0x5d, 0x5d, 0xc6, 0xfa, 0xdb, 0xfd, 0xcb, 0x3a, 0x34, 0x87, 0xef, 0x85, 0xa4, 0xd1,
-	0x50, 0x12, 0x39, 0x13, 0x4a, 0x48, 0x86, 0xb6, 0x39, 0xcb, 0x58, 0x7f, 0x2b, 0xec, 0xf5, 0x93,
-	0x99, 0x37, 0x13, 0x94, 0x5b, 0xe5, 0x9a, 0x9f, 0xcc, 0x5e, 0x0b, 0xca, 0x55, 0x6d, 0x28, 0x96,
-	0xd0, 0x26, 0x34, 0x0a, 0x94, 0x71, 0xdd, 0x4f, 0x66, 0xc6, 0x66, 0xaa, 0x19, 0x06, 0x53, 0xda,
-	0xad, 0x65, 0x9a, 0xfd, 0x60, 0x4a, 0xd1, 0x2d, 0xa8, 0x47, 0x34, 0xf2, 0x8c, 0xef, 0xa0, 0x79,
-	0x1b, 0x11, 0x8d, 0x46, 0xda, 0xfd, 0xfb, 0xd0, 0x52, 0x4c, 0x32, 0x27, 0xe1, 0x94, 0x9c, 0x4d,
-	0x69, 0xb7, 0xa1, 0x05, 0x9a, 0x11, 0x8d, 0xf6, 0x53, 0x9a, 0x1e, 0x09, 0x34, 0xf2, 0x74, 0x9c,
-	0x4d, 0x63, 0x3c, 0xa2, 0xd1, 0x6b, 0x15, 0xaa, 0x65, 0xe9, 0x70, 0x5b, 0x19, 0xeb, 0x19, 0xa7,
-	0xba, 0x9a, 0xc5, 0x5b, 0x92, 0xd8, 0x8d, 0x37, 0x8d, 0xc7, 0x8a, 0x62, 0x76, 0xbe, 0x05, 0x7a,
-	0x61, 0xac, 0x76, 0x8c, 0x5b, 0x8a, 0xa0, 0xcd, 0xa6, 0x4c, 0x6d, 0xf7, 0xda, 0x82, 0xa9, 0x0d,
-	0xdf, 0x81, 0xc6, 0x2c, 0x51, 0xf9, 0xf2, 0x04, 0xf5, 0x45, 0xb7, 0xad, 0xd9, 0x60, 0x48, 0x43,
-	0xea, 0x0b, 0x74, 0x00, 0x9b, 0x41, 0x28, 0x2e, 0xbc, 0x24, 0x3d, 0x74, 0xd1, 0x45, 0x2b, 0x20,
-	0xa2, 0x50, 0x17, 0xb8, 0x1d, 0xe4, 0x97, 0xc2, 0xfd, 0x0a, 0x6a, 0x0a, 0xf8, 0xc9, 0x44, 0x17,
-	0x56, 0x0e, 0x47, 0xf5, 0xb7, 0xaa, 0xc8, 0x39, 0xe5, 0x22, 0x64, 0xb1, 0x2d, 0x95, 0x74, 0xe9,
-	0x7e, 0x03, 0x70, 0xa0, 0x2f, 0x03, 0xfd, 0x78, 0xcc, 0xd0, 0xe7, 0x80, 0xec, 0xdd, 0xc0, 0xf3,
-	0x39, 0x25, 0x92, 0x06, 0x1e, 0x91, 0xf6, 0xd0, 0x3b, 0x96, 0x73, 0x60, 0x18, 0xfb, 0xd2, 0xfd,
-	0x97, 0x03, 0xcd, 0xc1, 0x94, 0xc8, 0x31, 0xe3, 0x91, 0x56, 0xff, 0x04, 0xaa, 0xf3, 0x24, 0x5e,
-	0x8c, 0xab, 0xca, 0x3c, 0x89, 0xfb, 0x09, 0x7a, 0x08, 0x1b, 0x89, 0x71, 0x2e, 0xc5, 0x9f, 0xad,
-	0x22, 0x68, 0x19, 0x26, 0xce, 0xa4, 0xd0, 0x8f, 0xa1, 0x7d, 0x41, 0x79, 0x4c, 0xa7, 0x5e, 0xea,
-	0xb6, 0x99, 0x62, 0x2d, 0x43, 0x7d, 0x63, 0x88, 0xe8, 0x1b, 0xb8, 0x59, 0x14, 0x13, 0x5e, 0x18,
-	0x0b, 0x49, 0xa6, 0x53, 0x5d, 0xe3, 0x0a, 0x5a, 0x3e, 0x2d, 0x68, 0x88, 0x7e, 0xca, 0x46, 0x5f,
-	0x43, 0xc3, 0x46, 0x1a, 0xc6, 0x63, 0xa6, 0x0b, 0x78, 0x19, 0x4c, 0x17, 0x89, 0xc1, 0xe0, 0x67,
-	0xdf, 0xee, 0x9f, 0x4a, 0xd0, 0xb1, 0xc0, 0xdc, 0x8f, 0x25, 0xe5, 0x63, 0xe2, 0x53, 0xf4, 0x10,
-	0xb6, 0x62, 0x43, 0xf3, 0xc2, 0x94, 0xb8, 0xc0, 0x45, 0x14, 0x2f, 0xc9, 0xf7, 0x03, 0xf4, 0x1d,
-	0x54, 0x85, 0x6e, 0x2e, 0x7d, 0x24, 0xed, 0xbd, 0x1f, 0x15, 0xf6, 0x5e, 0xde, 0x60, 0xc7, 0x34,
-	0x22, 0xb6, 0x3a, 0x1a, 0x00, 0x88, 0xef, 0x91, 0x20, 0xe0, 0x54, 0x08, 0x9b, 0x1e, 0x88, 0x88,
-	0xbf, 0x6f, 0x28, 0xe8, 0x1e, 0x34, 0xc3, 0x24, 0xe5, 0x53, 0x61, 0xd3, 0xd1, 0x08, 0x93, 0xfd,
-	0x94, 0xa4, 0xb2, 0x1c, 0x26, 0xf3, 0x2f, 0x73, 0x42, 0x15, 0x2d, 0xd4, 0x52, 0xd4, 0x4c, 0xcc,
-	0xfd, 0x29, 0x54, 0x2d, 0x0a, 0x34, 0xa0, 0xf6, 0xfa, 0xf4, 0xe5, 0xe9, 0xab, 0xef, 0x4f, 0x3b,
-	0x6b, 0xa8, 0x0a, 0xa5, 0xd7, 0x83, 0x8e, 0x83, 0x36, 0xa0, 0x7c, 0xa8, 0x28, 0x25, 0xf7, 0xcf,
-	0x0e, 0x54, 0xb0, 0x1a, 0x1a, 0xca, 0x72, 0x40, 0x85, 0x0c, 0x63, 0xa2, 0xca, 0x73, 0x51, 0x10,
-	0xad, 0x1c, 0xb5, 0x9f, 0x14, 0x46, 0x48, 0x3a, 0x30, 0xb3, 0x11, 0x92, 0xa8, 0xaa, 0x9d, 0xd0,
-	0x38, 0x22, 0xe2, 0xc2, 0xc6, 0x97, 0x2e, 0x2f, 0xcd, 0x76, 0xf9, 0xb2, 0x6c, 0xbb, 0x7f, 0x70,
-	0xa0, 0x91, 0xe5, 0x74, 0xcc, 0xd0, 0x31, 0xa0, 0x0f, 0x2c, 0xa4, 0x23, 0x75, 0xfb, 0xca, 0x93,
-	0xc0, 0xd7, 0x96, 0xcd, 0x0b, 0xf4, 0x15, 0xb4, 0xd4, 0xb4, 0x0c, 0xe3, 0x89, 0x27, 0x35, 0x30,
-	0x99, 0x32, 0x47, 0x05, 0x43, 0x3a, 0x35, 0xb8, 0x69, 0x05, 0x47, 0x4a, 0xce, 0xfd, 0xa3, 0x03,
-	0xb5, 0x83, 0xc1, 0x6b, 0xed, 0x92, 0x02, 0x4d, 0xc6, 0xa9, 0xe7, 0x2b, 0x14, 0xb7, 0x4d, 0x57,
-	0x57, 0x94, 0x03, 0x45, 0x50, 0xf7, 0x59, 0x79, 0xce, 0x29, 0x09, 0xcc, 0x8d, 0x56, 0x31, 0x74,
-	0xca, 0xca, 0xb8, 0x6d, 0xe9, 0x03, 0xca, 0x0f, 0x18, 0xa7, 0xc8, 0x85, 0x26, 0xe1, 0xfe, 0x79,
-	0x28, 0xa9, 0x2f, 0x67, 0x9c, 0xda, 0xe4, 0x15, 0x68, 0x6a, 0xb3, 0x88, 0x05, 0x74, 0xea, 0x69,
-	0xac, 0x30, 0x79, 0xab, 0x6b, 0xca, 0x29, 0x89, 0xa8, 0xfb, 0x6b, 0x68, 0x9c, 0x10, 0xff, 0x3c,
-	0x8c, 0xa9, 0x76, 0x6d, 0xd7, 0x02, 0xb6, 0xea, 0x14, 0x33, 0x19, 0x8b, 0x1d, 0x6c, 0x43, 0x30,
-	0x30, 0xae, 0x14, 0xbe, 0x85, 0xe6, 0x22, 0xbd, 0x63, 0x66, 0x67, 0x73, 0x77, 0x75, 0x62, 0xc7,
-	0x0c, 0x37, 0xe2, 0xc5, 0xc2, 0xfd, 0xfb, 0x3a, 0xb4, 0x0f, 0xce, 0xa9, 0x7f, 0x11, 0xc6, 0xe9,
-	0x48, 0xbd, 0xfa, 0xb2, 0x81, 0xf6, 0xb2, 0x5e, 0x5a, 0xd7, 0x1b, 0x15, 0xa1, 0xd3, 0x5e, 0x40,
-	0x96, 0x3a, 0xe8, 0x09, 0xb4, 0xcc, 0x7c, 0xf2, 0xac, 0x6a, 0x59, 0xab, 0xde, 0x2c, 0xaa, 0xe6,
-	0x86, 0x20, 0x6e, 0x8a, 0xfc, 0x48, 0x7c, 0x02, 0xad, 0xc4, 0x82, 0x9f, 0x89, 0x71, 0x63, 0x85,
-	0x7e, 0x1e, 0x1e, 0x71, 0x33, 0xc9, 0x83, 0xe5, 0xb7, 0xd0, 0x8c, 0x4c, 0x8a, 0x8d, 0x7a, 0x7d,
-	0x45, 0x8a, 0x72, 0x67, 0x80, 0x1b, 0x51, 0xee, 0x40, 0x76, 0xe0, 0x9a, 0x96, 0xf3, 0x92, 0x8b,
-	0x89, 0x57, 0x80, 0xf6, 0xa7, 0xa5, 0xae, 0x83, 0x37, 0x35, 0x73, 0x70, 0x31, 0x49, 0x91, 0xf2,
-	0x66, 0x86, 0xcc, 0x95, 0x4c, 0xc8, 0xa2, 0xf3, 0x67, 0x1f, 0x60, 0x6d, 0x35, 0x13, 0x59, 0xc2,
-	0xdb, 0x27, 0x57, 0xe1, 0x6d, 0x4d, 0x61, 0x87, 0xd6, 0xba, 0x0c, 0x73, 0xdd, 0xbf, 0x3a, 0xb0,
-	0x99, 0x1d, 0xac, 0xbd, 0x74, 0x1d, 0x42, 0x95, 0xf8, 0x0a, 0x0f, 0xf4, 0xa9, 0xb6, 0xf7, 0x3e,
-	0x2f, 0x16, 0x56, 0x51, 0x7a, 0xc7, 0xd6, 0x03, 0x0d, 0xf6, 0xb5, 0x0e, 0xb6, 0xba, 0xd9, 0xfd,
-	0xa4, 0xb4, 0xb8, 0x9f, 0xb8, 0x47, 0xb0, 0xb9, 0x24, 0xae, 0xb0, 0xea, 0xf4, 0xd5, 0xe9, 0x51,
-	0x67, 0x0d, 0x6d, 0x41, 0x07, 0x1f, 0x0d, 0x47, 0xfb, 0x78, 0xe4, 0x0d, 0x8f, 0xf0, 0x9b, 0xfe,
-	0xc1, 0xd1, 0xb0, 0xe3, 0x20, 0x04, 0xed, 0x8c, 0xfa, 0xcb, 0xe1, 0xe8, 0xe8, 0xa4, 0x53, 0x72,
-	0x7f, 0xef, 0x40, 0xcb, 0xde, 0x7e, 0xaf, 0xb8, 0x0c, 0x3d, 0x86, 0x9a, 0x6f, 0x7c, 0xb5, 0xb5,
-	0x7e, 0x6b, 0x75, 0x1c, 0xda, 0x1f, 0x9c, 0xca, 0x2a, 0x20, 0xf3, 0x29, 0x97, 0x1e, 0x7d, 0x97,
-	0x84, 0xdc, 0x80, 0xa5, 0x36, 0xad, 0xca, 0x78, 0x1d, 0x23, 0xc5, 0x3b, 0xca, 0x58, 0x23, 0x15,
-	0xd5, 0x08, 0xb6, 0x0a, 0xde, 0xe4, 0x3a, 0x24, 0xeb, 0xb8, 0xac, 0x43, 0xd2, 0xae, 0x0a, 0x14,
-	0x7b, 0xca, 0x26, 0xa1, 0x4f, 0xa6, 0x8a, 0x6d, 0xa1, 0xd6, 0x52, 0xfa, 0xc1, 0xde, 0xdf, 0x2a,
-	0x50, 0x3d, 0xd1, 0x7f, 0x2c, 0x28, 0x2c, 0x1b, 0xe6, 0xaf, 0xed, 0xe8, 0x5a, 0x21, 0x92, 0x37,
-	0x2c, 0x0c, 0x7a, 0x1f, 0x92, 0xdc, 0x35, 0xf4, 0x25, 0x34, 0x87, 0x92, 0x25, 0xff, 0xb5, 0xde,
-	0x43, 0xa8, 0x62, 0x7a, 0xc6, 0x98, 0xfc, 0x68, 0x8d, 0x97, 0xea, 0x64, 0x0b, 0x6f, 0x0b, 0x74,
-	0xbf, 0x08, 0xb5, 0x2b, 0x5f, 0x1e, 0xab, 0x8d, 0x3d, 0x01, 0x18, 0x52, 0x69, 0x66, 0xbd, 0x40,
-	0xc5, 0x63, 0xb3, 0x99, 0xb6, 0xcc, 0x4b, 0xf5, 0x9f, 0x2f, 0xf4, 0x57, 0x84, 0x70, 0x95, 0x49,
-	0x77, 0x0d, 0xbd, 0x81, 0x4d, 0x3c, 0x8b, 0x73, 0x2f, 0x3d, 0x81, 0xee, 0xac, 0xc2, 0xc9, 0xdc,
-	0x5f, 0x13, 0xbd, 0xbb, 0x97, 0x0b, 0xd8, 0x67, 0xc9, 0x1a, 0x7a, 0x06, 0xcd, 0xfc, 0xbb, 0x6d,
-	0x95, 0x67, 0xf7, 0x8a, 0x9e, 0xad, 0x78, 0xe5, 0xb9, 0x6b, 0xe8, 0x07, 0x68, 0x17, 0xdf, 0x4b,
-	0x68, 0x59, 0xed, 0xc3, 0xf7, 0x5a, 0xef, 0xfe, 0x15, 0x22, 0x39, 0xdb, 0x4f, 0x61, 0x23, 0x7d,
-	0x3c, 0xa1, 0xa5, 0xd7, 0x78, 0xf1, 0x4d, 0xd5, 0x2b, 0xce, 0x1b, 0xfb, 0x88, 0x72, 0xd7, 0x1e,
-	0x3a, 0x7b, 0xff, 0x28, 0xc1, 0x86, 0x6d, 0xaf, 0x00, 0x3d, 0x83, 0x9a, 0xfd, 0x46, 0x57, 0x35,
-	0x60, 0xef, 0xf6, 0x55, 0x28, 0xe3, 0xae, 0xa1, 0x63, 0xa8, 0x3f, 0xa7, 0xd2, 0xf6, 0xfb, 0xbd,
-	0x55, 0x07, 0x58, 0xe8, 0xbe, 0x5e, 0xef, 0x72, 0x11, 0x77, 0x0d, 0x9d, 0xc0, 0xf5, 0x43, 0x3a,
-	0xa5, 0x92, 0x16, 0x71, 0xe4, 0x23, 0xec, 0xae, 0xac, 0xb8, 0xef, 0xa0, 0x65, 0xcc, 0xd9, 0x83,
-	0x47, 0x37, 0x56, 0xce, 0xd5, 0xc3, 0xd5, 0xda, 0x8f, 0xa1, 0x7c, 0x1c, 0x0a, 0x79, 0xa9, 0xd2,
-	0xf5, 0x02, 0xbd, 0x7f, 0xa8, 0x84, 0xdd, 0xb5, 0xa7, 0xdb, 0x3f, 0xdc, 0xd2, 0xf4, 0x5d, 0xf3,
-	0x6f, 0xa1, 0x3f, 0x65, 0xb3, 0x60, 0x77, 0xc2, 0xec, 0xdf, 0x86, 0x67, 0x55, 0xfd, 0xfb, 0xe8,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xa5, 0x1f, 0x1e, 0xac, 0x14, 0x00, 0x00,
+func init() { proto.RegisterFile("orc8r/protos/magmad.proto", fileDescriptor_magmad_ef896ca4cb858b3d) }
+
+var fileDescriptor_magmad_ef896ca4cb858b3d = []byte{
+	// 2239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5b, 0x6f, 0xdb, 0xc8,
+	0x15, 0x16, 0x65, 0x5d, 0xac, 0x23, 0xc9, 0x96, 0x27, 0xde, 0xac, 0xa2, 0xc4, 0x8d, 0x97, 0xdb,
+	0x4b, 0x16, 0xdd, 0xda, 0x81, 0x73, 0x5b, 0x6c, 0x17, 0x29, 0x1c, 0x5f, 0x12, 0x21, 0xb6, 0x23,
+	0x8c, 0xe4, 0x2c, 0xba, 0x40, 0x41, 0xd0, 0xe4, 0x58, 0x26, 0x2c, 0x72, 0xd8, 0x99, 0x91, 0x12,
+	0xa3, 0xaf, 0x6d, 0x9f, 0xfa, 0xd8, 0x16, 0x68, 0x1f, 0xfb, 0xd2, 0xb7, 0x3e, 0xf4, 0xa9, 0xbf,
+	0xa4, 0xff, 0xa0, 0xff, 0xa0, 0x3f, 0xa0, 0x98, 0x0b, 0x29, 0x52, 0x96, 0xb5, 0xd9, 0x16, 0xe8,
+	0x13, 0x39, 0xe7, 0x36, 0xe7, 0x9c, 0x39, 0xf3, 0x9d, 0x43, 0xc2, 0x1d, 0xca, 0xbc, 0x2f, 0xd8,
+	0x76, 0xcc, 0xa8, 0xa0, 0x7c, 0x3b, 0x74, 0x87, 0xa1, 0xeb, 0x6f, 0xa9, 0x15, 0xaa, 0xab, 0xd5,
+	0x96, 0x12, 0xe8, 0xe4, 0xe5, 0x3c, 0x1a, 0x86, 0x34, 0xd2, 0x72, 0x9d, 0x4e, 0xde, 0x84, 0x47,
+	0xa3, 0xf3, 0x60, 0x68, 0x78, 0x1b, 0x39, 0x1e, 0x27, 0x6c, 0x12, 0x78, 0xe4, 0xd1, 0xc3, 0x47,
+	0x86, 0x7d, 0x6f, 0x48, 0xe9, 0x70, 0x44, 0x34, 0xff, 0x6c, 0x7c, 0xbe, 0xcd, 0x05, 0x1b, 0x7b,
+	0x42, 0x73, 0xed, 0xd7, 0x00, 0xbd, 0x20, 0x1a, 0xf6, 0x5c, 0xe6, 0x86, 0x1c, 0xdd, 0x03, 0xb8,
+	0xa0, 0x5c, 0x38, 0x94, 0x39, 0x41, 0xdc, 0xb6, 0x36, 0xad, 0x07, 0x35, 0xbc, 0x2c, 0x29, 0x6f,
+	0x58, 0x37, 0x46, 0xf7, 0xa1, 0x1e, 0x8d, 0x43, 0x27, 0x76, 0xbd, 0x4b, 0x22, 0x78, 0xbb, 0xb8,
+	0x69, 0x3d, 0x28, 0x63, 0x88, 0xc6, 0x61, 0x4f, 0x53, 0xec, 0x31, 0xb4, 0x06, 0xcc, 0xf5, 0x08,
+	0xa3, 0x63, 0x41, 0x3e, 0xc8, 0xe4, 0x1d, 0x58, 0x0e, 0xdd, 0xf7, 0xce, 0x05, 0x8d, 0x13, 0x7b,
+	0xd5, 0xd0, 0x7d, 0xff, 0x8a, 0xc6, 0x1c, 0x3d, 0x80, 0xd6, 0xd9, 0x95, 0x20, 0xdc, 0x89, 0x09,
+	0x33, 0x7b, 0xb6, 0x97, 0x94, 0xc8, 0x8a, 0xa2, 0xf7, 0x08, 0xd3, 0xfb, 0xda, 0xbf, 0xb6, 0x00,
+	0x9d, 0x10, 0xf1, 0x8e, 0xb2, 0xcb, 0x01, 0xe1, 0x02, 0x93, 0x5f, 0x8e, 0x09, 0x17, 0xe8, 0x27,
+	0x50, 0x8e, 0x83, 0x68, 0xc8, 0xdb, 0xd6, 0xe6, 0xd2, 0x83, 0xfa, 0xce, 0xc7, 0x5b, 0x99, 0x5c,
+	0x6f, 0x4d, 0x83, 0xc6, 0x5a, 0x0a, 0xfd, 0x0c, 0xea, 0x22, 0x75, 0x5e, 0x7a, 0x23, 0x95, 0x36,
+	0x72, 0x4a, 0xb3, 0xc1, 0xe1, 0xac, 0x86, 0xfd, 0x2f, 0x4b, 0xe7, 0x12, 0x13, 0x3e, 0x1e, 0x89,
+	0xff, 0x31, 0x97, 0x68, 0x1d, 0xca, 0x84, 0x31, 0xca, 0x54, 0xcc, 0x35, 0xac, 0x17, 0x68, 0x1b,
+	0x6e, 0x19, 0x15, 0x47, 0x30, 0x37, 0xe2, 0x61, 0x20, 0x04, 0xf1, 0xdb, 0x25, 0xa5, 0x8e, 0x0c,
+	0x6b, 0x30, 0xe5, 0xa0, 0xcf, 0xa0, 0x95, 0x28, 0x30, 0xe2, 0x91, 0x60, 0x42, 0xfc, 0x76, 0x59,
+	0x49, 0xaf, 0x1a, 0x3a, 0x36, 0x64, 0xf4, 0x43, 0x58, 0x75, 0x27, 0x43, 0x87, 0x11, 0x1e, 0xd3,
+	0x88, 0x13, 0x27, 0xe4, 0xed, 0xca, 0xa6, 0xf5, 0xa0, 0x88, 0x9b, 0xee, 0x64, 0x88, 0x0d, 0xf5,
+	0x98, 0xdb, 0x03, 0x58, 0xcd, 0x24, 0x82, 0xd1, 0x33, 0x82, 0x3a, 0xa0, 0x22, 0x8b, 0xdc, 0x90,
+	0x64, 0x23, 0x95, 0x6b, 0xb4, 0x02, 0xc5, 0x20, 0x56, 0x01, 0xd6, 0x70, 0x31, 0x88, 0xd1, 0x47,
+	0x50, 0x61, 0x42, 0x48, 0xeb, 0x4b, 0xca, 0x7a, 0x99, 0x09, 0x71, 0xcc, 0xed, 0xaf, 0xa1, 0x39,
+	0xb5, 0xfa, 0x8a, 0xc6, 0xa8, 0x05, 0x4b, 0x81, 0xff, 0x5e, 0x99, 0x2b, 0x63, 0xf9, 0x8a, 0x1e,
+	0x43, 0x25, 0x96, 0xdb, 0x25, 0x87, 0x73, 0xef, 0xa6, 0xc3, 0x91, 0x42, 0xd8, 0xc8, 0xda, 0x93,
+	0x6c, 0x51, 0x9a, 0xb3, 0x49, 0x93, 0x6b, 0x65, 0x93, 0x9b, 0x3f, 0xb1, 0xe2, 0xcc, 0x89, 0x6d,
+	0x41, 0x49, 0x95, 0xe9, 0x92, 0xda, 0xbb, 0x73, 0xc3, 0xde, 0xaf, 0x68, 0x8c, 0x95, 0x9c, 0xfd,
+	0x1b, 0x0b, 0x6e, 0xe5, 0xaa, 0x52, 0x27, 0xf0, 0xdb, 0xcb, 0x52, 0xfb, 0xf8, 0x5f, 0x95, 0xa5,
+	0x51, 0xcd, 0x95, 0xe5, 0x13, 0x58, 0x7f, 0x49, 0xc4, 0x4b, 0x57, 0x90, 0x77, 0xee, 0x55, 0xd7,
+	0x4f, 0xfd, 0xd8, 0x00, 0x18, 0x6a, 0xa2, 0x13, 0xf8, 0x26, 0x11, 0xb5, 0x61, 0x22, 0x66, 0x3f,
+	0x86, 0xdb, 0x98, 0x70, 0xe1, 0x32, 0xd1, 0xd7, 0x88, 0xc2, 0x93, 0x7b, 0xd5, 0x81, 0x65, 0x03,
+	0x32, 0x3a, 0x86, 0x1a, 0x4e, 0xd7, 0xb6, 0x2b, 0x37, 0x8b, 0x08, 0x0b, 0xbc, 0x3d, 0x1a, 0x86,
+	0x6e, 0xe4, 0x1b, 0x14, 0x68, 0x43, 0xd5, 0xd3, 0x04, 0xb3, 0x53, 0xb2, 0x44, 0xdb, 0x50, 0x89,
+	0x95, 0x8c, 0x4a, 0xb8, 0xcc, 0x87, 0xc6, 0xab, 0xad, 0x04, 0xaf, 0xb6, 0xfa, 0x0a, 0xaf, 0xb0,
+	0x11, 0xb3, 0x8f, 0xe1, 0x76, 0x7e, 0x8b, 0x34, 0xa2, 0x47, 0xb0, 0x9c, 0x14, 0x6f, 0xdb, 0x5a,
+	0x6c, 0x2c, 0x15, 0xb4, 0x7f, 0x0c, 0xab, 0x03, 0x37, 0x18, 0x1d, 0xd1, 0x61, 0x1a, 0x60, 0x1b,
+	0xaa, 0x26, 0xa0, 0xc4, 0x59, 0xb3, 0xb4, 0x37, 0xa0, 0x7a, 0x44, 0x87, 0x47, 0x41, 0x44, 0x10,
+	0x82, 0xd2, 0x28, 0x88, 0x12, 0x09, 0xf5, 0x6e, 0xff, 0xd6, 0x82, 0xe6, 0x7e, 0xc0, 0x2f, 0x7b,
+	0x2e, 0x13, 0x81, 0x08, 0x68, 0x84, 0x6e, 0x43, 0xc5, 0x27, 0x19, 0x4b, 0x66, 0x25, 0xaf, 0x7f,
+	0x48, 0xc7, 0x91, 0x70, 0x62, 0x1a, 0x44, 0xc2, 0xd4, 0x1a, 0x28, 0x52, 0x4f, 0x52, 0x64, 0x85,
+	0x0a, 0x2a, 0xdc, 0x91, 0xba, 0x24, 0x25, 0xac, 0x17, 0x72, 0xd3, 0x31, 0x37, 0xf7, 0xbd, 0x84,
+	0xd5, 0xbb, 0xa4, 0x9d, 0x33, 0x42, 0xd4, 0xad, 0x2e, 0x61, 0xf5, 0x6e, 0xff, 0x6d, 0x09, 0x1a,
+	0xfd, 0x2b, 0x2e, 0x48, 0xd8, 0x17, 0xae, 0x18, 0x73, 0x29, 0x24, 0x02, 0x73, 0x39, 0x4b, 0x58,
+	0xbd, 0x4b, 0xec, 0xf5, 0xe2, 0xb1, 0x33, 0xe6, 0x84, 0x19, 0xe5, 0xaa, 0x17, 0x8f, 0x4f, 0x39,
+	0x61, 0xb2, 0x36, 0x24, 0x8b, 0x2b, 0x13, 0x0a, 0x05, 0x4a, 0xb8, 0xe6, 0xc5, 0x63, 0x6d, 0x33,
+	0xd1, 0x0c, 0xfc, 0x11, 0x69, 0x57, 0x53, 0xcd, 0xae, 0x3f, 0x22, 0xe8, 0x2e, 0xd4, 0x42, 0x12,
+	0x3a, 0xda, 0x77, 0x50, 0xbc, 0xe5, 0x90, 0x84, 0x03, 0xe5, 0xfe, 0xa7, 0xd0, 0x94, 0x4c, 0x77,
+	0xe2, 0x06, 0x23, 0xf7, 0x6c, 0x44, 0xda, 0x75, 0x25, 0xd0, 0x08, 0x49, 0xb8, 0x9b, 0xd0, 0x54,
+	0x4b, 0x20, 0xa1, 0xa3, 0xe2, 0x6c, 0x68, 0xe3, 0x21, 0x09, 0x4f, 0x65, 0xa8, 0x86, 0xa5, 0xc2,
+	0x6d, 0xa6, 0xac, 0x43, 0x46, 0x54, 0x35, 0xf3, 0x77, 0x6e, 0x6c, 0x36, 0x5e, 0xd5, 0x1e, 0x4b,
+	0x8a, 0xde, 0xf9, 0x2e, 0xa8, 0x85, 0xb6, 0xda, 0xd2, 0x6e, 0x49, 0x82, 0x32, 0x9b, 0x30, 0x95,
+	0xdd, 0xb5, 0x29, 0x53, 0x19, 0xbe, 0x0f, 0xf5, 0x71, 0x2c, 0xf3, 0xe5, 0x70, 0xe2, 0xf1, 0xf6,
+	0x8a, 0x62, 0x83, 0x26, 0xf5, 0x89, 0xc7, 0xd1, 0x1e, 0xac, 0xfa, 0x01, 0xbf, 0x74, 0xe2, 0xe4,
+	0xd0, 0x79, 0x1b, 0xcd, 0x81, 0x88, 0x5c, 0x5d, 0xe0, 0x15, 0x3f, 0xbb, 0xe4, 0xf6, 0x33, 0xa8,
+	0x4a, 0xe0, 0x77, 0x87, 0xaa, 0xb0, 0x32, 0x38, 0xaa, 0xde, 0x65, 0x45, 0x4e, 0x08, 0xe3, 0x01,
+	0x8d, 0x4c, 0xa9, 0x24, 0x4b, 0xfb, 0x4b, 0x80, 0x3d, 0x35, 0x0c, 0x74, 0xa3, 0x73, 0x8a, 0x3e,
+	0x07, 0x64, 0x66, 0x03, 0xc7, 0x63, 0xc4, 0x15, 0xc4, 0x77, 0x5c, 0x61, 0x0e, 0xbd, 0x65, 0x38,
+	0x7b, 0x9a, 0xb1, 0x2b, 0xec, 0x7f, 0x5b, 0xd0, 0xe8, 0x8d, 0x5c, 0x71, 0x4e, 0x59, 0xa8, 0xd4,
+	0x3f, 0x82, 0xca, 0x24, 0x8e, 0xa6, 0xed, 0xaa, 0x3c, 0x89, 0xa3, 0x6e, 0x8c, 0x1e, 0xc2, 0x72,
+	0xac, 0x9d, 0x4b, 0xf0, 0x67, 0x3d, 0x0f, 0x5a, 0x9a, 0x89, 0x53, 0x29, 0xf4, 0x03, 0x58, 0xb9,
+	0x24, 0x2c, 0x22, 0x23, 0x27, 0x71, 0x5b, 0x77, 0xb1, 0xa6, 0xa6, 0xbe, 0xd5, 0x44, 0xf4, 0x25,
+	0xdc, 0xc9, 0x8b, 0x71, 0x27, 0x88, 0xb8, 0x70, 0x47, 0x23, 0x55, 0xe3, 0x12, 0x5a, 0x3e, 0xce,
+	0x69, 0xf0, 0x6e, 0xc2, 0x46, 0x5f, 0x40, 0xdd, 0x44, 0x1a, 0x44, 0xe7, 0xb4, 0x5d, 0x36, 0xf7,
+	0x3d, 0xeb, 0xd7, 0x34, 0x31, 0x18, 0xbc, 0xf4, 0xdd, 0xfe, 0x63, 0x11, 0x5a, 0x06, 0x98, 0xbb,
+	0x91, 0x20, 0xec, 0xdc, 0xf5, 0x08, 0x7a, 0x08, 0xeb, 0x91, 0xa6, 0x39, 0x41, 0x42, 0x9c, 0xe2,
+	0x22, 0x8a, 0x66, 0xe4, 0xbb, 0x3e, 0xfa, 0x0a, 0x2a, 0x5c, 0x5d, 0x2e, 0x75, 0x24, 0x2b, 0x3b,
+	0xdf, 0xcf, 0xed, 0x3d, 0xbb, 0xc1, 0x96, 0xbe, 0x88, 0xd8, 0xe8, 0x28, 0x00, 0x70, 0x3d, 0xc7,
+	0xf5, 0x7d, 0x46, 0x38, 0x37, 0xe9, 0x81, 0xd0, 0xf5, 0x76, 0x35, 0x05, 0x7d, 0x02, 0x8d, 0x20,
+	0x4e, 0xf8, 0x84, 0x9b, 0x74, 0xd4, 0x83, 0x78, 0x37, 0x21, 0xc9, 0x2c, 0x07, 0xf1, 0xe4, 0x69,
+	0x46, 0xa8, 0xac, 0x84, 0x9a, 0x92, 0x9a, 0x8a, 0xd9, 0x3f, 0x82, 0x8a, 0x41, 0x81, 0x3a, 0x54,
+	0x4f, 0x4f, 0x5e, 0x9f, 0xbc, 0xf9, 0xfa, 0xa4, 0x55, 0x40, 0x15, 0x28, 0x9e, 0xf6, 0x5a, 0x16,
+	0x5a, 0x86, 0xd2, 0xbe, 0xa4, 0x14, 0xed, 0x3f, 0x59, 0x50, 0xc6, 0xb2, 0x69, 0x48, 0xcb, 0x3e,
+	0xe1, 0x22, 0x88, 0x5c, 0x59, 0x9e, 0xd3, 0x82, 0x68, 0x66, 0xa8, 0xdd, 0x38, 0xd7, 0x42, 0x92,
+	0x86, 0x99, 0xb6, 0x90, 0x58, 0x56, 0xed, 0x90, 0x44, 0xa1, 0xcb, 0x2f, 0x4d, 0x7c, 0xc9, 0xf2,
+	0xc6, 0x6c, 0x97, 0x6e, 0xca, 0xb6, 0xfd, 0x7b, 0x0b, 0xea, 0x69, 0x4e, 0xcf, 0x29, 0x3a, 0x02,
+	0x74, 0xcd, 0x42, 0xd2, 0x52, 0x37, 0x16, 0x9e, 0x04, 0x5e, 0x9b, 0x35, 0xcf, 0xd1, 0x33, 0x68,
+	0xca, 0x6e, 0x19, 0x44, 0x43, 0x47, 0x28, 0x60, 0xd2, 0x65, 0x8e, 0x72, 0x86, 0x54, 0x6a, 0x70,
+	0xc3, 0x08, 0x0e, 0xa4, 0x9c, 0xfd, 0x07, 0x0b, 0xaa, 0x7b, 0xbd, 0x53, 0xe5, 0x92, 0x04, 0x4d,
+	0xca, 0x88, 0xe3, 0x49, 0x14, 0x37, 0x97, 0xae, 0x26, 0x29, 0x7b, 0x92, 0x20, 0xe7, 0x59, 0x71,
+	0xc1, 0x88, 0xeb, 0xeb, 0x89, 0x56, 0x32, 0x54, 0xca, 0x4a, 0x78, 0xc5, 0xd0, 0x7b, 0x84, 0xed,
+	0x51, 0x46, 0x90, 0x0d, 0x0d, 0x97, 0x79, 0x17, 0x81, 0x20, 0x9e, 0x18, 0x33, 0x62, 0x92, 0x97,
+	0xa3, 0xc9, 0xcd, 0x42, 0xea, 0x93, 0x91, 0xa3, 0xb0, 0x42, 0xe7, 0xad, 0xa6, 0x28, 0x27, 0x6e,
+	0x48, 0xec, 0x5f, 0x41, 0xfd, 0xd8, 0xf5, 0x2e, 0x82, 0x88, 0x28, 0xd7, 0xb6, 0x0d, 0x60, 0xcb,
+	0x9b, 0xa2, 0x3b, 0x63, 0xfe, 0x06, 0x9b, 0x10, 0x34, 0x8c, 0x4b, 0x85, 0x9f, 0x42, 0x63, 0x9a,
+	0xde, 0x73, 0x6a, 0x7a, 0x73, 0x7b, 0x7e, 0x62, 0xcf, 0x29, 0xae, 0x47, 0xd3, 0x85, 0xfd, 0xcf,
+	0x25, 0x58, 0xd9, 0xbb, 0x20, 0xde, 0x65, 0x10, 0x25, 0x2d, 0x75, 0xf1, 0xb0, 0x81, 0x76, 0xd2,
+	0xbb, 0xb4, 0xb4, 0x69, 0x5d, 0x83, 0x4e, 0x33, 0x80, 0xcc, 0xdc, 0xa0, 0xe7, 0xd0, 0xd4, 0xfd,
+	0xc9, 0x31, 0xaa, 0x25, 0xa5, 0x7a, 0x27, 0xaf, 0x9a, 0x69, 0x82, 0xb8, 0xc1, 0x33, 0x2b, 0xa9,
+	0x1f, 0x1b, 0xf0, 0xd3, 0x31, 0x2e, 0xcf, 0xd1, 0xcf, 0xc2, 0x23, 0x6e, 0xc4, 0x99, 0x95, 0x4c,
+	0x51, 0xa8, 0x53, 0xac, 0xd5, 0x6b, 0x73, 0x52, 0x94, 0x39, 0x03, 0x5c, 0x0f, 0xa7, 0x0b, 0xb4,
+	0x05, 0x6b, 0x4a, 0xce, 0x89, 0x2f, 0x87, 0x4e, 0x0e, 0xda, 0x5f, 0x14, 0xdb, 0x16, 0x5e, 0x55,
+	0xcc, 0xde, 0xe5, 0x30, 0x41, 0xca, 0x3b, 0x29, 0x32, 0x97, 0x53, 0x21, 0x83, 0xce, 0x9f, 0x5d,
+	0xc3, 0xda, 0x4a, 0x2a, 0x32, 0x83, 0xb7, 0xcf, 0x17, 0xe1, 0x6d, 0x75, 0x73, 0xc9, 0x68, 0xdd,
+	0x84, 0xb9, 0xf6, 0xdf, 0x2d, 0x58, 0x4d, 0x0f, 0xd6, 0x0c, 0x5d, 0xfb, 0x50, 0x71, 0x3d, 0x89,
+	0x07, 0xea, 0x54, 0x57, 0x76, 0x3e, 0xcf, 0x17, 0x56, 0x5e, 0x7a, 0xcb, 0xd4, 0x03, 0xf1, 0x77,
+	0x95, 0x0e, 0x36, 0xba, 0xe9, 0x7c, 0x52, 0x9c, 0xce, 0x27, 0xf6, 0x01, 0xac, 0xce, 0x88, 0x4b,
+	0xac, 0x3a, 0x79, 0x73, 0x72, 0xd0, 0x2a, 0xa0, 0x75, 0x68, 0xe1, 0x83, 0xfe, 0x60, 0x17, 0x0f,
+	0x9c, 0xfe, 0x01, 0x7e, 0xdb, 0xdd, 0x3b, 0xe8, 0xb7, 0x2c, 0x84, 0x60, 0x25, 0xa5, 0xfe, 0xbc,
+	0x3f, 0x38, 0x38, 0x6e, 0x15, 0xed, 0xdf, 0x59, 0xd0, 0x34, 0xd3, 0xef, 0x82, 0x61, 0xe8, 0x09,
+	0x54, 0x3d, 0xed, 0xab, 0xa9, 0xf5, 0xbb, 0xf3, 0xe3, 0x50, 0xfe, 0xe0, 0x44, 0x56, 0x02, 0x99,
+	0x47, 0x98, 0x70, 0xc8, 0xfb, 0x38, 0x60, 0x1a, 0x2c, 0x95, 0x69, 0x59, 0xc6, 0x4b, 0x18, 0x49,
+	0xde, 0x41, 0xca, 0x1a, 0xc8, 0xa8, 0xfe, 0x5a, 0x84, 0x66, 0x6a, 0xcd, 0xa3, 0xcc, 0x9f, 0xeb,
+	0xce, 0xcc, 0xd4, 0x51, 0xbc, 0x36, 0x75, 0x7c, 0xe7, 0x8d, 0xb3, 0x33, 0x44, 0x29, 0x37, 0x43,
+	0xfc, 0x1f, 0x06, 0xc1, 0xe5, 0x6f, 0x1b, 0x04, 0x6b, 0xd7, 0x07, 0x41, 0xfb, 0x30, 0x45, 0x91,
+	0x57, 0x01, 0x17, 0x94, 0x5d, 0xa1, 0xc7, 0x50, 0x65, 0x2a, 0x67, 0x09, 0xd2, 0x77, 0xe6, 0x1f,
+	0x92, 0x14, 0xc1, 0x89, 0xa8, 0xfd, 0x17, 0x0b, 0xd6, 0xf6, 0x68, 0x14, 0x11, 0x4f, 0x04, 0x93,
+	0x40, 0x5c, 0x1d, 0x4c, 0x48, 0x24, 0xd0, 0x33, 0x28, 0x89, 0xab, 0x98, 0x98, 0xaa, 0xfd, 0x74,
+	0x76, 0x70, 0xc8, 0x4b, 0x6f, 0x0d, 0xae, 0x62, 0x82, 0x95, 0xc2, 0xbc, 0x52, 0x95, 0xc7, 0xe5,
+	0x8f, 0xcd, 0x31, 0x98, 0x0f, 0xdb, 0x12, 0x86, 0x84, 0x74, 0xcc, 0xed, 0xfb, 0x50, 0x92, 0x26,
+	0x10, 0x40, 0xe5, 0xcd, 0xc9, 0x51, 0x57, 0x95, 0x70, 0x1d, 0xaa, 0x6f, 0x0e, 0x0f, 0xd5, 0xc2,
+	0xb2, 0x8f, 0x00, 0x5d, 0xdb, 0x95, 0xa3, 0xa7, 0x50, 0x21, 0xea, 0xcd, 0xc4, 0xfb, 0xbd, 0xc5,
+	0x6e, 0x62, 0x23, 0x6d, 0x0f, 0x60, 0x3d, 0x57, 0xf2, 0x19, 0x18, 0x4e, 0x61, 0x3d, 0x85, 0xe1,
+	0x04, 0xba, 0x7d, 0xc9, 0x1e, 0xd1, 0x61, 0xe0, 0xb9, 0x23, 0xc9, 0x36, 0xfd, 0xdc, 0x50, 0xba,
+	0xfe, 0xce, 0x3f, 0xca, 0x50, 0x39, 0x56, 0x7f, 0xaf, 0x64, 0xc3, 0xec, 0x67, 0xbf, 0x0d, 0xd1,
+	0x5a, 0xce, 0xb3, 0xb7, 0x34, 0xf0, 0x3b, 0xd7, 0x49, 0x76, 0x01, 0x3d, 0x85, 0x46, 0x5f, 0xd0,
+	0xf8, 0x3b, 0xeb, 0x3d, 0x84, 0x0a, 0x26, 0x67, 0x94, 0x8a, 0x0f, 0xd6, 0x78, 0x0d, 0xab, 0x33,
+	0x1f, 0xb0, 0x28, 0x7f, 0xca, 0xf3, 0x3f, 0x6f, 0xe7, 0x1b, 0x7b, 0x0e, 0xd0, 0x27, 0x42, 0x0f,
+	0x94, 0x1c, 0xe5, 0xb1, 0xc1, 0x64, 0xda, 0x30, 0x6f, 0xd4, 0x7f, 0x39, 0xd5, 0x9f, 0x13, 0xc2,
+	0x22, 0x93, 0x76, 0x01, 0xbd, 0x85, 0x55, 0x3c, 0x8e, 0x32, 0xbf, 0x13, 0x38, 0xba, 0x3f, 0xaf,
+	0x19, 0x67, 0xfe, 0x7f, 0x75, 0x36, 0x6f, 0x16, 0x30, 0xdf, 0xbe, 0x05, 0x74, 0x08, 0x8d, 0xec,
+	0xcf, 0x81, 0x79, 0x9e, 0x7d, 0x92, 0xf7, 0x6c, 0xce, 0xaf, 0x04, 0xbb, 0x80, 0xbe, 0x81, 0x95,
+	0xfc, 0x47, 0x39, 0x9a, 0x55, 0xbb, 0xfe, 0x53, 0xa0, 0xf3, 0xe9, 0x02, 0x91, 0x8c, 0xed, 0x17,
+	0xb0, 0x9c, 0x7c, 0xa1, 0xa3, 0x99, 0x5f, 0x3e, 0xf9, 0x0f, 0xf7, 0x4e, 0x7e, 0xa8, 0x31, 0x5f,
+	0xea, 0x76, 0xe1, 0xa1, 0xb5, 0xf3, 0xe7, 0x12, 0x2c, 0x1b, 0x78, 0xf0, 0xd1, 0x21, 0x54, 0xcd,
+	0x3b, 0x5a, 0x84, 0xf2, 0x9d, 0x7b, 0x8b, 0x5a, 0x99, 0x5d, 0x40, 0x47, 0x50, 0x7b, 0x49, 0x84,
+	0x69, 0x2a, 0x9f, 0xcc, 0x3b, 0xc0, 0xdc, 0xed, 0xeb, 0x74, 0x6e, 0x16, 0xb1, 0x0b, 0xe8, 0x18,
+	0x6e, 0xed, 0x93, 0x11, 0x11, 0x24, 0xc7, 0xf8, 0x10, 0xbb, 0x73, 0x2b, 0xee, 0x2b, 0x68, 0x6a,
+	0x73, 0xe6, 0xe0, 0xd1, 0xed, 0xb9, 0xc3, 0xdb, 0xfe, 0x7c, 0xed, 0x27, 0x50, 0x3a, 0x0a, 0xb8,
+	0xb8, 0x51, 0xe9, 0x56, 0x8e, 0xde, 0xdd, 0x97, 0xc2, 0x76, 0x01, 0x9d, 0xc2, 0x9a, 0x2c, 0xf3,
+	0x3c, 0x6a, 0x7f, 0x40, 0x04, 0x73, 0x8f, 0xc1, 0xe8, 0xdb, 0x05, 0xf4, 0x0b, 0xf8, 0x48, 0xdf,
+	0x9e, 0x59, 0x7c, 0xfc, 0x00, 0xd3, 0xf7, 0x17, 0x43, 0x26, 0xb7, 0x0b, 0x2f, 0x36, 0xbe, 0xb9,
+	0xab, 0x64, 0xb6, 0xf5, 0x8f, 0x74, 0x6f, 0x44, 0xc7, 0xfe, 0xf6, 0x90, 0x9a, 0x3f, 0xea, 0x67,
+	0x15, 0xf5, 0x7c, 0xf4, 0x9f, 0x01, 0x00, 0xd6, 0xe2, 0x62, 0xdb, 0xc7, 0x17, 0x00, 0x00,
 }
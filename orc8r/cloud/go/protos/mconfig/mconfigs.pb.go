@@ -33,7 +33,7 @@ func (m *ControlProxy) Reset()         { *m = ControlProxy{} }
 func (m *ControlProxy) String() string { return proto.CompactTextString(m) }
 func (*ControlProxy) ProtoMessage()    {}
 func (*ControlProxy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_59383aefe4effbc1, []int{0}
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{0}
 }
 func (m *ControlProxy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ControlProxy.Unmarshal(m, b)
@@ -77,7 +77,7 @@ func (m *DnsD) Reset()         { *m = DnsD{} }
 func (m *DnsD) String() string { return proto.CompactTextString(m) }
 func (*DnsD) ProtoMessage()    {}
 func (*DnsD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_59383aefe4effbc1, []int{1}
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{1}
 }
 func (m *DnsD) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DnsD.Unmarshal(m, b)
@@ -126,20 +126,24 @@ func (m *DnsD) GetRecords() []*NetworkDNSConfigRecordsItems {
 }
 
 type NetworkDNSConfigRecordsItems struct {
-	ARecord              []string `protobuf:"bytes,1,rep,name=a_record,json=aRecord,proto3" json:"a_record,omitempty"`
-	AaaaRecord           []string `protobuf:"bytes,2,rep,name=aaaa_record,json=aaaaRecord,proto3" json:"aaaa_record,omitempty"`
-	CnameRecord          []string `protobuf:"bytes,3,rep,name=cname_record,json=cnameRecord,proto3" json:"cname_record,omitempty"`
-	Domain               string   `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ARecord              []string                                  `protobuf:"bytes,1,rep,name=a_record,json=aRecord,proto3" json:"a_record,omitempty"`
+	AaaaRecord           []string                                  `protobuf:"bytes,2,rep,name=aaaa_record,json=aaaaRecord,proto3" json:"aaaa_record,omitempty"`
+	CnameRecord          []string                                  `protobuf:"bytes,3,rep,name=cname_record,json=cnameRecord,proto3" json:"cname_record,omitempty"`
+	Domain               string                                    `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	SrvRecord            []*NetworkDNSConfigRecordsItems_SrvRecord `protobuf:"bytes,5,rep,name=srv_record,json=srvRecord,proto3" json:"srv_record,omitempty"`
+	PtrRecord            []string                                  `protobuf:"bytes,6,rep,name=ptr_record,json=ptrRecord,proto3" json:"ptr_record,omitempty"`
+	TxtRecord            []string                                  `protobuf:"bytes,7,rep,name=txt_record,json=txtRecord,proto3" json:"txt_record,omitempty"`
+	MxRecord             []*NetworkDNSConfigRecordsItems_MxRecord  `protobuf:"bytes,8,rep,name=mx_record,json=mxRecord,proto3" json:"mx_record,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
 func (m *NetworkDNSConfigRecordsItems) Reset()         { *m = NetworkDNSConfigRecordsItems{} }
 func (m *NetworkDNSConfigRecordsItems) String() string { return proto.CompactTextString(m) }
 func (*NetworkDNSConfigRecordsItems) ProtoMessage()    {}
 func (*NetworkDNSConfigRecordsItems) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_59383aefe4effbc1, []int{2}
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{2}
 }
 func (m *NetworkDNSConfigRecordsItems) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkDNSConfigRecordsItems.Unmarshal(m, b)
@@ -187,6 +191,144 @@ func (m *NetworkDNSConfigRecordsItems) GetDomain() string {
 	return ""
 }
 
+func (m *NetworkDNSConfigRecordsItems) GetSrvRecord() []*NetworkDNSConfigRecordsItems_SrvRecord {
+	if m != nil {
+		return m.SrvRecord
+	}
+	return nil
+}
+
+func (m *NetworkDNSConfigRecordsItems) GetPtrRecord() []string {
+	if m != nil {
+		return m.PtrRecord
+	}
+	return nil
+}
+
+func (m *NetworkDNSConfigRecordsItems) GetTxtRecord() []string {
+	if m != nil {
+		return m.TxtRecord
+	}
+	return nil
+}
+
+func (m *NetworkDNSConfigRecordsItems) GetMxRecord() []*NetworkDNSConfigRecordsItems_MxRecord {
+	if m != nil {
+		return m.MxRecord
+	}
+	return nil
+}
+
+type NetworkDNSConfigRecordsItems_SrvRecord struct {
+	Priority             int32    `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Weight               int32    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Port                 int32    `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Target               string   `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) Reset() {
+	*m = NetworkDNSConfigRecordsItems_SrvRecord{}
+}
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) String() string { return proto.CompactTextString(m) }
+func (*NetworkDNSConfigRecordsItems_SrvRecord) ProtoMessage()    {}
+func (*NetworkDNSConfigRecordsItems_SrvRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{2, 0}
+}
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkDNSConfigRecordsItems_SrvRecord.Unmarshal(m, b)
+}
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkDNSConfigRecordsItems_SrvRecord.Marshal(b, m, deterministic)
+}
+func (dst *NetworkDNSConfigRecordsItems_SrvRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkDNSConfigRecordsItems_SrvRecord.Merge(dst, src)
+}
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) XXX_Size() int {
+	return xxx_messageInfo_NetworkDNSConfigRecordsItems_SrvRecord.Size(m)
+}
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkDNSConfigRecordsItems_SrvRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkDNSConfigRecordsItems_SrvRecord proto.InternalMessageInfo
+
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *NetworkDNSConfigRecordsItems_SrvRecord) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type NetworkDNSConfigRecordsItems_MxRecord struct {
+	Preference           int32    `protobuf:"varint,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	Exchange             string   `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkDNSConfigRecordsItems_MxRecord) Reset()         { *m = NetworkDNSConfigRecordsItems_MxRecord{} }
+func (m *NetworkDNSConfigRecordsItems_MxRecord) String() string { return proto.CompactTextString(m) }
+func (*NetworkDNSConfigRecordsItems_MxRecord) ProtoMessage()    {}
+func (*NetworkDNSConfigRecordsItems_MxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{2, 1}
+}
+func (m *NetworkDNSConfigRecordsItems_MxRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkDNSConfigRecordsItems_MxRecord.Unmarshal(m, b)
+}
+func (m *NetworkDNSConfigRecordsItems_MxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkDNSConfigRecordsItems_MxRecord.Marshal(b, m, deterministic)
+}
+func (dst *NetworkDNSConfigRecordsItems_MxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkDNSConfigRecordsItems_MxRecord.Merge(dst, src)
+}
+func (m *NetworkDNSConfigRecordsItems_MxRecord) XXX_Size() int {
+	return xxx_messageInfo_NetworkDNSConfigRecordsItems_MxRecord.Size(m)
+}
+func (m *NetworkDNSConfigRecordsItems_MxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkDNSConfigRecordsItems_MxRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkDNSConfigRecordsItems_MxRecord proto.InternalMessageInfo
+
+func (m *NetworkDNSConfigRecordsItems_MxRecord) GetPreference() int32 {
+	if m != nil {
+		return m.Preference
+	}
+	return 0
+}
+
+func (m *NetworkDNSConfigRecordsItems_MxRecord) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
 type ImageSpec struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Order                int64    `protobuf:"varint,2,opt,name=order,proto3" json:"order,omitempty"`
@@ -199,7 +341,7 @@ func (m *ImageSpec) Reset()         { *m = ImageSpec{} }
 func (m *ImageSpec) String() string { return proto.CompactTextString(m) }
 func (*ImageSpec) ProtoMessage()    {}
 func (*ImageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_59383aefe4effbc1, []int{3}
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{3}
 }
 func (m *ImageSpec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageSpec.Unmarshal(m, b)
@@ -261,7 +403,7 @@ func (m *MagmaD) Reset()         { *m = MagmaD{} }
 func (m *MagmaD) String() string { return proto.CompactTextString(m) }
 func (*MagmaD) ProtoMessage()    {}
 func (*MagmaD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_59383aefe4effbc1, []int{4}
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{4}
 }
 func (m *MagmaD) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MagmaD.Unmarshal(m, b)
@@ -362,7 +504,7 @@ func (m *DirectoryD) Reset()         { *m = DirectoryD{} }
 func (m *DirectoryD) String() string { return proto.CompactTextString(m) }
 func (*DirectoryD) ProtoMessage()    {}
 func (*DirectoryD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_59383aefe4effbc1, []int{5}
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{5}
 }
 func (m *DirectoryD) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DirectoryD.Unmarshal(m, b)
@@ -403,7 +545,7 @@ func (m *MetricsD) Reset()         { *m = MetricsD{} }
 func (m *MetricsD) String() string { return proto.CompactTextString(m) }
 func (*MetricsD) ProtoMessage()    {}
 func (*MetricsD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_59383aefe4effbc1, []int{6}
+	return fileDescriptor_mconfigs_1a46c5e84066f0d8, []int{6}
 }
 func (m *MetricsD) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MetricsD.Unmarshal(m, b)
@@ -434,6 +576,8 @@ func init() {
 	proto.RegisterType((*ControlProxy)(nil), "magma.mconfig.ControlProxy")
 	proto.RegisterType((*DnsD)(nil), "magma.mconfig.DnsD")
 	proto.RegisterType((*NetworkDNSConfigRecordsItems)(nil), "magma.mconfig.NetworkDNSConfigRecordsItems")
+	proto.RegisterType((*NetworkDNSConfigRecordsItems_SrvRecord)(nil), "magma.mconfig.NetworkDNSConfigRecordsItems.SrvRecord")
+	proto.RegisterType((*NetworkDNSConfigRecordsItems_MxRecord)(nil), "magma.mconfig.NetworkDNSConfigRecordsItems.MxRecord")
 	proto.RegisterType((*ImageSpec)(nil), "magma.mconfig.ImageSpec")
 	proto.RegisterType((*MagmaD)(nil), "magma.mconfig.MagmaD")
 	proto.RegisterMapType((map[string]bool)(nil), "magma.mconfig.MagmaD.FeatureFlagsEntry")
@@ -442,49 +586,58 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/mconfig/mconfigs.proto", fileDescriptor_mconfigs_59383aefe4effbc1)
-}
-
-var fileDescriptor_mconfigs_59383aefe4effbc1 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x6f, 0x4b, 0x1b, 0x4f,
-	0x10, 0xc7, 0x39, 0xf3, 0x7f, 0xd4, 0xe8, 0x6f, 0x7f, 0x6d, 0x3d, 0xa5, 0xd0, 0xf4, 0x44, 0x4c,
-	0x29, 0x5c, 0x8a, 0xa5, 0x20, 0x3e, 0x68, 0x8b, 0x46, 0x21, 0x10, 0x45, 0x4e, 0xe9, 0x83, 0x3e,
-	0x39, 0xd6, 0xbd, 0xc9, 0xb9, 0x64, 0xef, 0x36, 0xec, 0x6d, 0xd2, 0xe6, 0x85, 0xf4, 0x55, 0xf4,
-	0x6d, 0xf4, 0x85, 0x95, 0xdb, 0xdd, 0xd8, 0x68, 0xa1, 0x0f, 0xf2, 0x28, 0x3b, 0x9f, 0xf9, 0xce,
-	0x64, 0x77, 0x66, 0x6e, 0x60, 0x5f, 0x2a, 0x76, 0xac, 0x7a, 0x13, 0x25, 0xb5, 0x2c, 0x7a, 0x19,
-	0x93, 0xf9, 0x88, 0xa7, 0x8b, 0xdf, 0x22, 0x34, 0x9c, 0x6c, 0x66, 0x34, 0xcd, 0x68, 0xe8, 0xe8,
-	0xde, 0xee, 0xa3, 0x18, 0x26, 0xb3, 0x4c, 0xe6, 0x56, 0x19, 0x9c, 0xc2, 0xc6, 0x99, 0xcc, 0xb5,
-	0x92, 0xe2, 0x5a, 0xc9, 0xef, 0x73, 0x72, 0x04, 0x2d, 0x21, 0xd3, 0x58, 0xe0, 0x0c, 0x85, 0xef,
-	0x75, 0xbc, 0x6e, 0xfb, 0xe8, 0x79, 0x68, 0xb3, 0x99, 0x24, 0xe1, 0x50, 0xa6, 0xc3, 0xd2, 0x19,
-	0x35, 0x85, 0x3b, 0x05, 0xbf, 0x3c, 0xa8, 0xf6, 0xf3, 0xa2, 0xbf, 0x4a, 0x30, 0x39, 0x80, 0x36,
-	0xe6, 0xf4, 0x4e, 0x60, 0xcc, 0x28, 0xbb, 0xe7, 0x79, 0xea, 0xaf, 0x75, 0xbc, 0x6e, 0x33, 0xda,
-	0xb4, 0xf4, 0xcc, 0x42, 0xb2, 0x07, 0x4d, 0x21, 0x19, 0x15, 0xb7, 0xb7, 0x43, 0xbf, 0xd2, 0xf1,
-	0xba, 0xb5, 0xe8, 0xc1, 0x26, 0xe7, 0xd0, 0x50, 0xc8, 0xa4, 0x4a, 0x0a, 0xbf, 0xda, 0xa9, 0x74,
-	0xd7, 0x8f, 0xde, 0x86, 0x8f, 0xde, 0x1f, 0x5e, 0xa1, 0xfe, 0x26, 0xd5, 0xb8, 0x7f, 0x75, 0x73,
-	0x66, 0x40, 0x64, 0xd5, 0x03, 0x8d, 0x59, 0x11, 0x2d, 0x62, 0x83, 0x1f, 0x1e, 0xbc, 0xfc, 0x97,
-	0x92, 0xec, 0x42, 0x93, 0xc6, 0x56, 0xed, 0x7b, 0x9d, 0x4a, 0xb7, 0x15, 0x35, 0xa8, 0x15, 0x90,
-	0x57, 0xb0, 0x4e, 0x29, 0x7d, 0xf0, 0xae, 0x19, 0x2f, 0x94, 0xc8, 0x09, 0x5e, 0xc3, 0x06, 0xcb,
-	0x69, 0x86, 0x0b, 0x45, 0xc5, 0x28, 0xd6, 0x0d, 0x73, 0x92, 0x17, 0x50, 0x4f, 0x64, 0x46, 0x79,
-	0xee, 0x57, 0x3b, 0x5e, 0xb7, 0x15, 0x39, 0x2b, 0xf8, 0x00, 0xad, 0x41, 0x46, 0x53, 0xbc, 0x99,
-	0x20, 0x23, 0x04, 0xaa, 0x65, 0x88, 0xa9, 0x6e, 0x2b, 0x32, 0x67, 0xf2, 0x0c, 0x6a, 0x52, 0x25,
-	0xa8, 0x4c, 0xe5, 0x2a, 0x91, 0x35, 0x82, 0x9f, 0x55, 0xa8, 0x5f, 0x96, 0x65, 0x58, 0xad, 0x2f,
-	0x6f, 0x60, 0x9b, 0xdd, 0x23, 0x1b, 0xf3, 0x3c, 0xe6, 0xb9, 0x46, 0x35, 0xa3, 0xc2, 0xe4, 0xaf,
-	0x45, 0x5b, 0x8e, 0x0f, 0x1c, 0x26, 0x87, 0xb0, 0x40, 0xb1, 0xe6, 0x19, 0xca, 0xa9, 0x76, 0x2d,
-	0x6a, 0x3b, 0x7c, 0x6b, 0x29, 0xe9, 0xc1, 0xff, 0x74, 0xaa, 0xe5, 0x74, 0x92, 0x2a, 0x9a, 0x60,
-	0x6c, 0x3b, 0x9c, 0x98, 0xe7, 0x36, 0x23, 0xb2, 0xe4, 0x3a, 0xb7, 0x1e, 0x72, 0x02, 0xbb, 0xcb,
-	0x01, 0x13, 0x29, 0xc4, 0x9f, 0xdb, 0xd4, 0xcc, 0x7f, 0xec, 0x2c, 0x09, 0xae, 0xa5, 0x10, 0xcb,
-	0xb7, 0x9a, 0x50, 0x36, 0xa6, 0x29, 0xc6, 0x33, 0x54, 0x05, 0x97, 0xb9, 0x5f, 0x37, 0x45, 0x6b,
-	0x3b, 0xfc, 0xc5, 0x52, 0xf2, 0x0e, 0xea, 0xbc, 0xac, 0x6f, 0xe1, 0x37, 0xcc, 0xf4, 0xf8, 0x4f,
-	0xa6, 0xe7, 0xa1, 0xf8, 0x91, 0xd3, 0x91, 0x1d, 0x68, 0x68, 0x8e, 0x2a, 0xe6, 0x89, 0xdf, 0xb4,
-	0xad, 0x2a, 0xcd, 0x41, 0x42, 0x86, 0xb0, 0x39, 0x42, 0xaa, 0xa7, 0x0a, 0xe3, 0x91, 0xa0, 0x69,
-	0xe1, 0xb7, 0x4c, 0xc6, 0xc3, 0x27, 0x19, 0x6d, 0x5b, 0xc2, 0x0b, 0x2b, 0xbd, 0x28, 0x95, 0xe7,
-	0xb9, 0x56, 0xf3, 0x68, 0x63, 0xb4, 0x84, 0xca, 0x16, 0x24, 0xf3, 0x9c, 0x66, 0x9c, 0xc5, 0x05,
-	0xaa, 0x19, 0x67, 0x58, 0xf8, 0x60, 0xe6, 0x66, 0xcb, 0xf1, 0x1b, 0x87, 0xf7, 0x3e, 0xc1, 0x7f,
-	0x7f, 0x65, 0x23, 0xdb, 0x50, 0x19, 0xe3, 0xdc, 0x8d, 0x4a, 0x79, 0x2c, 0x27, 0x65, 0x46, 0xc5,
-	0x14, 0xdd, 0x37, 0x66, 0x8d, 0x93, 0xb5, 0x63, 0x2f, 0xf8, 0x0c, 0xd0, 0xe7, 0x0a, 0x99, 0x96,
-	0x6a, 0xbe, 0xd2, 0xc0, 0x04, 0x1f, 0xa1, 0x79, 0x89, 0x5a, 0x71, 0xb6, 0xda, 0x22, 0x38, 0x3d,
-	0xf8, 0xba, 0x6f, 0x14, 0x3d, 0xbb, 0xac, 0x98, 0x90, 0xd3, 0xa4, 0x97, 0xca, 0x27, 0x9b, 0xee,
-	0xae, 0x6e, 0xec, 0xf7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xed, 0x72, 0x8c, 0xd8, 0x08, 0x05,
+	proto.RegisterFile("orc8r/protos/mconfig/mconfigs.proto", fileDescriptor_mconfigs_1a46c5e84066f0d8)
+}
+
+var fileDescriptor_mconfigs_1a46c5e84066f0d8 = []byte{
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdb, 0x8e, 0xdb, 0x36,
+	0x10, 0x85, 0xd6, 0x97, 0x95, 0x66, 0x2f, 0x49, 0xd9, 0x4b, 0xb4, 0x46, 0x2f, 0xae, 0x83, 0x20,
+	0x2e, 0x0a, 0xc8, 0xc5, 0xb6, 0x01, 0x82, 0x3c, 0xb4, 0x45, 0xf6, 0x02, 0x2c, 0xb0, 0x1b, 0xa4,
+	0xdc, 0x45, 0x1f, 0xfa, 0x22, 0x30, 0xd4, 0x58, 0x26, 0x4c, 0x89, 0x02, 0x45, 0x3b, 0xf6, 0x37,
+	0xf5, 0xa9, 0xff, 0xd0, 0x0f, 0x2b, 0x44, 0x52, 0x8e, 0xb3, 0x05, 0x02, 0xac, 0x9f, 0x3c, 0x73,
+	0xe6, 0xcc, 0xf0, 0x70, 0x66, 0x68, 0xc1, 0x53, 0xa5, 0xf9, 0x4b, 0x3d, 0xa9, 0xb4, 0x32, 0xaa,
+	0x9e, 0x14, 0x5c, 0x95, 0x53, 0x91, 0xb7, 0xbf, 0x75, 0x62, 0x71, 0x72, 0x54, 0xb0, 0xbc, 0x60,
+	0x89, 0x47, 0x07, 0x27, 0x1f, 0xe5, 0x70, 0x55, 0x14, 0xaa, 0x74, 0xcc, 0xd1, 0x6b, 0x38, 0x3c,
+	0x53, 0xa5, 0xd1, 0x4a, 0xbe, 0xd5, 0x6a, 0xb5, 0x26, 0xa7, 0x10, 0x49, 0x95, 0xa7, 0x12, 0x97,
+	0x28, 0xe3, 0x60, 0x18, 0x8c, 0x8f, 0x4f, 0xbf, 0x4c, 0x5c, 0x35, 0x5b, 0x24, 0xb9, 0x56, 0xf9,
+	0x75, 0x13, 0xa4, 0xa1, 0xf4, 0xd6, 0xe8, 0xdf, 0x00, 0xba, 0xe7, 0x65, 0x7d, 0xbe, 0x4b, 0x32,
+	0x79, 0x06, 0xc7, 0x58, 0xb2, 0x77, 0x12, 0x53, 0xce, 0xf8, 0x4c, 0x94, 0x79, 0xbc, 0x37, 0x0c,
+	0xc6, 0x21, 0x3d, 0x72, 0xe8, 0x99, 0x03, 0xc9, 0x00, 0x42, 0xa9, 0x38, 0x93, 0x77, 0x77, 0xd7,
+	0x71, 0x67, 0x18, 0x8c, 0x7b, 0x74, 0xe3, 0x93, 0x0b, 0xd8, 0xd7, 0xc8, 0x95, 0xce, 0xea, 0xb8,
+	0x3b, 0xec, 0x8c, 0x0f, 0x4e, 0x7f, 0x4c, 0x3e, 0xba, 0x7f, 0xf2, 0x06, 0xcd, 0x7b, 0xa5, 0xe7,
+	0xe7, 0x6f, 0x6e, 0xcf, 0x2c, 0x40, 0x1d, 0xfb, 0xca, 0x60, 0x51, 0xd3, 0x36, 0x77, 0xf4, 0x4f,
+	0x17, 0xbe, 0xfe, 0x14, 0x93, 0x9c, 0x40, 0xc8, 0x52, 0xc7, 0x8e, 0x83, 0x61, 0x67, 0x1c, 0xd1,
+	0x7d, 0xe6, 0x08, 0xe4, 0x3b, 0x38, 0x60, 0x8c, 0x6d, 0xa2, 0x7b, 0x36, 0x0a, 0x0d, 0xe4, 0x09,
+	0xdf, 0xc3, 0x21, 0x2f, 0x59, 0x81, 0x2d, 0xa3, 0x63, 0x19, 0x07, 0x16, 0xf3, 0x94, 0xaf, 0xa0,
+	0x9f, 0xa9, 0x82, 0x89, 0x32, 0xee, 0x0e, 0x83, 0x71, 0x44, 0xbd, 0x47, 0xee, 0x00, 0x6a, 0xbd,
+	0x6c, 0x13, 0x7b, 0xf6, 0x86, 0x2f, 0x1e, 0x70, 0xc3, 0xe4, 0x56, 0x2f, 0x9d, 0x4f, 0xa3, 0xba,
+	0x35, 0xc9, 0x37, 0x00, 0x95, 0xd1, 0x6d, 0xd5, 0xbe, 0x95, 0x13, 0x55, 0x46, 0x7f, 0x08, 0x9b,
+	0x95, 0x69, 0xc3, 0xfb, 0x2e, 0x6c, 0x56, 0xc6, 0x87, 0xff, 0x80, 0xa8, 0x58, 0xb5, 0xd1, 0xd0,
+	0x4a, 0xfa, 0xe5, 0x21, 0x92, 0x6e, 0x56, 0x5e, 0x51, 0x58, 0x78, 0x6b, 0x30, 0x87, 0x68, 0x23,
+	0xb4, 0x19, 0x77, 0xa5, 0x85, 0xd2, 0xc2, 0xac, 0xed, 0x22, 0xf5, 0xe8, 0xc6, 0x6f, 0xfa, 0xf4,
+	0x1e, 0x45, 0x3e, 0x33, 0x76, 0x53, 0x7a, 0xd4, 0x7b, 0x84, 0x40, 0xb7, 0x52, 0xda, 0xf8, 0xf5,
+	0xb0, 0x76, 0xc3, 0x35, 0x4c, 0xe7, 0x68, 0xda, 0x9e, 0x3a, 0x6f, 0x70, 0x09, 0x61, 0x2b, 0x81,
+	0x7c, 0x0b, 0x50, 0x69, 0x9c, 0xa2, 0xc6, 0x92, 0xa3, 0x3f, 0x6d, 0x0b, 0x69, 0xb4, 0xe0, 0x8a,
+	0xcf, 0x58, 0x99, 0xa3, 0x3d, 0x31, 0xa2, 0x1b, 0x7f, 0xf4, 0x02, 0xa2, 0xab, 0x82, 0xe5, 0x78,
+	0x5b, 0x21, 0x6f, 0x04, 0x34, 0xe3, 0xb4, 0x25, 0x22, 0x6a, 0x6d, 0xf2, 0x05, 0xf4, 0x94, 0xce,
+	0x50, 0xdb, 0xcc, 0x0e, 0x75, 0xce, 0xe8, 0xef, 0x2e, 0xf4, 0x6f, 0x9a, 0x6e, 0xed, 0xf6, 0x66,
+	0x7e, 0x80, 0xc7, 0x7c, 0x86, 0x7c, 0x2e, 0xca, 0x54, 0x94, 0x06, 0xf5, 0x92, 0x49, 0xdf, 0x8b,
+	0x47, 0x1e, 0xbf, 0xf2, 0x30, 0x79, 0x0e, 0x2d, 0x94, 0x1a, 0x51, 0xa0, 0x5a, 0xb4, 0xfd, 0x39,
+	0xf6, 0xf0, 0x9d, 0x43, 0xc9, 0x04, 0x3e, 0x67, 0x0b, 0xa3, 0x16, 0x55, 0xae, 0x59, 0x86, 0xa9,
+	0x7b, 0x7d, 0x99, 0x6d, 0x5b, 0x48, 0xc9, 0x56, 0xe8, 0xc2, 0x45, 0xc8, 0x2b, 0x38, 0xd9, 0x4e,
+	0xa8, 0x94, 0x94, 0x1f, 0xd4, 0xf4, 0xec, 0x19, 0x4f, 0xb6, 0x08, 0x6f, 0x95, 0x94, 0xdb, 0xaa,
+	0x2a, 0xc6, 0xe7, 0x2c, 0xc7, 0x74, 0x89, 0xba, 0x16, 0xaa, 0x8c, 0xfb, 0xb6, 0x69, 0xc7, 0x1e,
+	0xfe, 0xd3, 0xa1, 0xe4, 0x27, 0xe8, 0x8b, 0xa6, 0xbf, 0xb5, 0x5d, 0xc1, 0x83, 0xd3, 0xf8, 0xde,
+	0x92, 0x6d, 0x9a, 0x4f, 0x3d, 0x8f, 0x3c, 0x81, 0x7d, 0x23, 0x50, 0xa7, 0xa2, 0xd9, 0x4b, 0x37,
+	0x72, 0x81, 0xfa, 0x2a, 0x23, 0xd7, 0x70, 0x34, 0x45, 0x66, 0x16, 0x1a, 0xd3, 0xa9, 0x64, 0x79,
+	0x1d, 0x47, 0xb6, 0xe2, 0xf3, 0x7b, 0x15, 0xdd, 0x58, 0x92, 0x4b, 0x47, 0xbd, 0x6c, 0x98, 0x17,
+	0xa5, 0xd1, 0x6b, 0x7a, 0x38, 0xdd, 0x82, 0x9a, 0x11, 0x64, 0xeb, 0x92, 0x15, 0x82, 0xa7, 0x35,
+	0xea, 0xa5, 0xe0, 0x58, 0xc7, 0x60, 0x5f, 0xc9, 0x23, 0x8f, 0xdf, 0x7a, 0x78, 0xf0, 0x1b, 0x7c,
+	0xf6, 0xbf, 0x6a, 0xe4, 0x31, 0x74, 0xe6, 0xb8, 0xf6, 0xab, 0xd2, 0x98, 0xcd, 0xa6, 0x2c, 0x99,
+	0x5c, 0xa0, 0xff, 0xff, 0x73, 0xce, 0xab, 0xbd, 0x97, 0xc1, 0xe8, 0x77, 0x80, 0x73, 0xa1, 0x91,
+	0x1b, 0xa5, 0xd7, 0x3b, 0x2d, 0xcc, 0xe8, 0x57, 0x08, 0x6f, 0xd0, 0x68, 0xc1, 0x77, 0xfb, 0x93,
+	0x7e, 0xfd, 0xec, 0xaf, 0xa7, 0x96, 0x31, 0x71, 0x1f, 0x12, 0x2e, 0xd5, 0x22, 0x9b, 0xe4, 0xea,
+	0xde, 0x57, 0xe8, 0x5d, 0xdf, 0xfa, 0x3f, 0xff, 0x37, 0x00, 0x95, 0xfe, 0x45, 0x5c, 0xa4, 0x06,
 	0x00, 0x00,
 }
@@ -35,7 +35,7 @@ func (m *StateID) Reset()         { *m = StateID{} }
 func (m *StateID) String() string { return proto.CompactTextString(m) }
 func (*StateID) ProtoMessage()    {}
 func (*StateID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{0}
+	return fileDescriptor_state_d7660c4d1f8a5ca5, []int{0}
 }
 func (m *StateID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateID.Unmarshal(m, b)
@@ -81,7 +81,7 @@ func (m *GetStatesRequest) Reset()         { *m = GetStatesRequest{} }
 func (m *GetStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatesRequest) ProtoMessage()    {}
 func (*GetStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{1}
+	return fileDescriptor_state_d7660c4d1f8a5ca5, []int{1}
 }
 func (m *GetStatesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatesRequest.Unmarshal(m, b)
@@ -126,7 +126,7 @@ func (m *GetStatesResponse) Reset()         { *m = GetStatesResponse{} }
 func (m *GetStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatesResponse) ProtoMessage()    {}
 func (*GetStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{2}
+	return fileDescriptor_state_d7660c4d1f8a5ca5, []int{2}
 }
 func (m *GetStatesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatesResponse.Unmarshal(m, b)
@@ -164,7 +164,7 @@ func (m *ReportStatesRequest) Reset()         { *m = ReportStatesRequest{} }
 func (m *ReportStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportStatesRequest) ProtoMessage()    {}
 func (*ReportStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{3}
+	return fileDescriptor_state_d7660c4d1f8a5ca5, []int{3}
 }
 func (m *ReportStatesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportStatesRequest.Unmarshal(m, b)
@@ -203,7 +203,7 @@ func (m *DeleteStatesRequest) Reset()         { *m = DeleteStatesRequest{} }
 func (m *DeleteStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteStatesRequest) ProtoMessage()    {}
 func (*DeleteStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{4}
+	return fileDescriptor_state_d7660c4d1f8a5ca5, []int{4}
 }
 func (m *DeleteStatesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteStatesRequest.Unmarshal(m, b)
@@ -237,12 +237,70 @@ func (m *DeleteStatesRequest) GetIds() []*StateID {
 	return nil
 }
 
+type SearchStatesRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// Only return states of these types. States of all types are returned
+	// if this is empty.
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// Only return states whose deviceID starts with this prefix
+	KeyPrefix            string   `protobuf:"bytes,3,opt,name=keyPrefix,proto3" json:"keyPrefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchStatesRequest) Reset()         { *m = SearchStatesRequest{} }
+func (m *SearchStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchStatesRequest) ProtoMessage()    {}
+func (*SearchStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_state_d7660c4d1f8a5ca5, []int{5}
+}
+func (m *SearchStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchStatesRequest.Unmarshal(m, b)
+}
+func (m *SearchStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchStatesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SearchStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchStatesRequest.Merge(dst, src)
+}
+func (m *SearchStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchStatesRequest.Size(m)
+}
+func (m *SearchStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchStatesRequest proto.InternalMessageInfo
+
+func (m *SearchStatesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *SearchStatesRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *SearchStatesRequest) GetKeyPrefix() string {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StateID)(nil), "magma.orc8r.StateID")
 	proto.RegisterType((*GetStatesRequest)(nil), "magma.orc8r.GetStatesRequest")
 	proto.RegisterType((*GetStatesResponse)(nil), "magma.orc8r.GetStatesResponse")
 	proto.RegisterType((*ReportStatesRequest)(nil), "magma.orc8r.ReportStatesRequest")
 	proto.RegisterType((*DeleteStatesRequest)(nil), "magma.orc8r.DeleteStatesRequest")
+	proto.RegisterType((*SearchStatesRequest)(nil), "magma.orc8r.SearchStatesRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -260,6 +318,8 @@ type StateServiceClient interface {
 	GetStates(ctx context.Context, in *GetStatesRequest, opts ...grpc.CallOption) (*GetStatesResponse, error)
 	ReportStates(ctx context.Context, in *ReportStatesRequest, opts ...grpc.CallOption) (*Void, error)
 	DeleteStates(ctx context.Context, in *DeleteStatesRequest, opts ...grpc.CallOption) (*Void, error)
+	// SearchStates returns all states on a network which match the request
+	SearchStates(ctx context.Context, in *SearchStatesRequest, opts ...grpc.CallOption) (*GetStatesResponse, error)
 }
 
 type stateServiceClient struct {
@@ -297,11 +357,22 @@ func (c *stateServiceClient) DeleteStates(ctx context.Context, in *DeleteStatesR
 	return out, nil
 }
 
+func (c *stateServiceClient) SearchStates(ctx context.Context, in *SearchStatesRequest, opts ...grpc.CallOption) (*GetStatesResponse, error) {
+	out := new(GetStatesResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.StateService/SearchStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StateServiceServer is the server API for StateService service.
 type StateServiceServer interface {
 	GetStates(context.Context, *GetStatesRequest) (*GetStatesResponse, error)
 	ReportStates(context.Context, *ReportStatesRequest) (*Void, error)
 	DeleteStates(context.Context, *DeleteStatesRequest) (*Void, error)
+	// SearchStates returns all states on a network which match the request
+	SearchStates(context.Context, *SearchStatesRequest) (*GetStatesResponse, error)
 }
 
 func RegisterStateServiceServer(s *grpc.Server, srv StateServiceServer) {
@@ -362,6 +433,24 @@ func _StateService_DeleteStates_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_SearchStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).SearchStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.StateService/SearchStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).SearchStates(ctx, req.(*SearchStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _StateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.StateService",
 	HandlerType: (*StateServiceServer)(nil),
@@ -378,34 +467,40 @@ var _StateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStates",
 			Handler:    _StateService_DeleteStates_Handler,
 		},
+		{
+			MethodName: "SearchStates",
+			Handler:    _StateService_SearchStates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orc8r/protos/state.proto",
 }
 
-func init() { proto.RegisterFile("orc8r/protos/state.proto", fileDescriptor_state_40967c0a9a3f0140) }
-
-var fileDescriptor_state_40967c0a9a3f0140 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x4e, 0x83, 0x40,
-	0x14, 0x94, 0xd6, 0x54, 0xfb, 0xda, 0x83, 0x7d, 0xf5, 0x80, 0x68, 0x4d, 0xc3, 0xc1, 0x34, 0x1e,
-	0xc0, 0xb4, 0x17, 0x3d, 0x19, 0x0d, 0xc6, 0x34, 0xf1, 0x44, 0x13, 0x63, 0xf4, 0x84, 0xf0, 0xd2,
-	0x10, 0x0b, 0x8b, 0xbb, 0x5b, 0x8d, 0xbf, 0xec, 0x57, 0x18, 0x76, 0x49, 0x05, 0xc4, 0x83, 0x07,
-	0x4f, 0xb0, 0xf3, 0x66, 0x26, 0x3b, 0xb3, 0x0f, 0x4c, 0xc6, 0xc3, 0x73, 0xee, 0x66, 0x9c, 0x49,
-	0x26, 0x5c, 0x21, 0x03, 0x49, 0x8e, 0x3a, 0x60, 0x2f, 0x09, 0x96, 0x49, 0xe0, 0xa8, 0xb9, 0x75,
-	0x50, 0xa1, 0x85, 0x2c, 0x49, 0x58, 0xaa, 0x79, 0xd6, 0xa8, 0xea, 0x40, 0xfc, 0x2d, 0x0e, 0x69,
-	0x76, 0x36, 0xd3, 0x63, 0xfb, 0x02, 0x76, 0x16, 0xb9, 0xeb, 0xdc, 0x43, 0x84, 0x6d, 0xf9, 0x91,
-	0x91, 0x69, 0x8c, 0x8d, 0x49, 0xd7, 0x57, 0xff, 0x68, 0xc1, 0x6e, 0x44, 0xb9, 0x62, 0xee, 0x99,
-	0x2d, 0x85, 0x6f, 0xce, 0xf6, 0x03, 0xec, 0xdd, 0x92, 0x54, 0x6a, 0xe1, 0xd3, 0xeb, 0x9a, 0x84,
-	0xc4, 0x23, 0xe8, 0xa6, 0x24, 0xdf, 0x19, 0x7f, 0x99, 0x7b, 0x85, 0xd1, 0x37, 0x80, 0x27, 0xd0,
-	0x8e, 0x23, 0x61, 0xb6, 0xc6, 0xed, 0x49, 0x6f, 0xba, 0xef, 0x94, 0x12, 0x38, 0xc5, 0x25, 0xfc,
-	0x9c, 0x60, 0x5f, 0xc2, 0xa0, 0xe4, 0x2c, 0x32, 0x96, 0x0a, 0xc2, 0x53, 0xe8, 0xa8, 0xfc, 0xc2,
-	0x34, 0x94, 0x1e, 0x7f, 0xea, 0xfd, 0x82, 0x61, 0x5f, 0xc1, 0xd0, 0xa7, 0x8c, 0xf1, 0xda, 0xed,
-	0xfe, 0x62, 0xf1, 0x04, 0x43, 0x8f, 0x56, 0x24, 0xe9, 0x1f, 0x02, 0x4e, 0x3f, 0x0d, 0xe8, 0x2b,
-	0x60, 0xa1, 0xdf, 0x03, 0xef, 0xa0, 0xbb, 0x49, 0x8c, 0xa3, 0x8a, 0xb0, 0xde, 0xb1, 0x75, 0xfc,
-	0xdb, 0x58, 0x17, 0x65, 0x6f, 0xe1, 0x0d, 0xf4, 0xcb, 0xf1, 0x71, 0x5c, 0x51, 0x34, 0x34, 0x63,
-	0x0d, 0x2a, 0x8c, 0x7b, 0x16, 0x47, 0xda, 0xa6, 0x5c, 0x41, 0xcd, 0xa6, 0xa1, 0x9d, 0x46, 0x9b,
-	0xeb, 0xd1, 0xe3, 0xa1, 0x42, 0x5d, 0xbd, 0x89, 0xe1, 0x8a, 0xad, 0x23, 0x77, 0xc9, 0x8a, 0x95,
-	0x7c, 0xee, 0xa8, 0xef, 0xec, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xd7, 0xb9, 0x90, 0xeb, 0x02,
-	0x00, 0x00,
+func init() { proto.RegisterFile("orc8r/protos/state.proto", fileDescriptor_state_d7660c4d1f8a5ca5) }
+
+var fileDescriptor_state_d7660c4d1f8a5ca5 = []byte{
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x41, 0x4f, 0xc2, 0x40,
+	0x10, 0x85, 0x05, 0x14, 0xed, 0xc0, 0x41, 0x16, 0x0e, 0xb5, 0x8a, 0x21, 0x3d, 0x18, 0xe2, 0xa1,
+	0x35, 0x70, 0xd1, 0x93, 0xd1, 0xd4, 0x18, 0x12, 0x0f, 0xa6, 0x24, 0xc6, 0xe8, 0xa9, 0xb6, 0x23,
+	0x36, 0x50, 0xb6, 0xee, 0x2e, 0x2a, 0x7f, 0xce, 0xdf, 0x66, 0xba, 0xdb, 0x60, 0x5b, 0x6b, 0x0c,
+	0x07, 0x4f, 0xb0, 0x3b, 0xef, 0x7d, 0x9d, 0x99, 0x97, 0x05, 0x9d, 0x32, 0xff, 0x94, 0xd9, 0x31,
+	0xa3, 0x82, 0x72, 0x9b, 0x0b, 0x4f, 0xa0, 0x25, 0x0f, 0xa4, 0x11, 0x79, 0x93, 0xc8, 0xb3, 0x64,
+	0xdd, 0xd8, 0xcb, 0xc9, 0x7c, 0x1a, 0x45, 0x74, 0xae, 0x74, 0x46, 0x37, 0x4f, 0x40, 0xf6, 0x16,
+	0xfa, 0x38, 0x3c, 0x19, 0xaa, 0xb2, 0x79, 0x06, 0xdb, 0xe3, 0x84, 0x3a, 0x72, 0x08, 0x81, 0x4d,
+	0xb1, 0x8c, 0x51, 0xaf, 0xf4, 0x2a, 0x7d, 0xcd, 0x95, 0xff, 0x89, 0x01, 0x3b, 0x01, 0x26, 0x8e,
+	0x91, 0xa3, 0x57, 0xe5, 0xfd, 0xea, 0x6c, 0xde, 0xc3, 0xee, 0x35, 0x0a, 0xe9, 0xe6, 0x2e, 0xbe,
+	0x2e, 0x90, 0x0b, 0x72, 0x00, 0xda, 0x1c, 0xc5, 0x3b, 0x65, 0xd3, 0x91, 0x93, 0x82, 0xbe, 0x2f,
+	0xc8, 0x11, 0xd4, 0xc2, 0x80, 0xeb, 0xd5, 0x5e, 0xad, 0xdf, 0x18, 0x74, 0xac, 0xcc, 0x04, 0x56,
+	0xda, 0x84, 0x9b, 0x08, 0xcc, 0x73, 0x68, 0x65, 0xc8, 0x3c, 0xa6, 0x73, 0x8e, 0xe4, 0x18, 0xea,
+	0x72, 0x7e, 0xae, 0x57, 0xa4, 0x9f, 0xfc, 0xf4, 0xbb, 0xa9, 0xc2, 0xbc, 0x80, 0xb6, 0x8b, 0x31,
+	0x65, 0x85, 0xee, 0xd6, 0x41, 0x3c, 0x42, 0xdb, 0xc1, 0x19, 0x0a, 0xfc, 0x8f, 0x01, 0x27, 0xd0,
+	0x1e, 0xa3, 0xc7, 0xfc, 0x97, 0x75, 0xe0, 0x1d, 0xd8, 0x4a, 0x32, 0x51, 0x78, 0xcd, 0x55, 0x87,
+	0xc4, 0x33, 0xc5, 0xe5, 0x2d, 0xc3, 0xe7, 0xf0, 0x43, 0xaf, 0x29, 0xcf, 0xea, 0x62, 0xf0, 0x59,
+	0x85, 0xa6, 0xfc, 0xc6, 0x58, 0x05, 0x4f, 0x6e, 0x40, 0x5b, 0xad, 0x96, 0x74, 0x73, 0x1d, 0x16,
+	0xc3, 0x34, 0x0e, 0x7f, 0x2b, 0xab, 0x44, 0xcc, 0x0d, 0x72, 0x05, 0xcd, 0xec, 0x9e, 0x49, 0x2f,
+	0xe7, 0x28, 0x89, 0xc0, 0x68, 0xe5, 0x14, 0x77, 0x34, 0x0c, 0x14, 0x26, 0xbb, 0xeb, 0x02, 0xa6,
+	0x24, 0x86, 0x72, 0x8c, 0x0b, 0xcd, 0xec, 0x56, 0x0b, 0x98, 0x92, 0x85, 0xff, 0x3d, 0xe1, 0x65,
+	0xf7, 0x61, 0x5f, 0x4a, 0x6c, 0xf5, 0x8c, 0xfc, 0x19, 0x5d, 0x04, 0xf6, 0x84, 0xa6, 0xef, 0xe9,
+	0xa9, 0x2e, 0x7f, 0x87, 0x5f, 0x03, 0x00, 0xa7, 0x2c, 0x1b, 0x46, 0xa8, 0x03, 0x00, 0x00,
 }
@@ -32,14 +32,22 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 // between the cloud and the gateway while abstracting the details of how
 // its implemented in the cloud and what the gateway does with the updates.
 //
-// - The gateways call the GetUpdates() streaming API with a StreamRequest
-//   indicating the stream name and the offset to continue streaming from.
-// - The cloud sends a stream of DataUpdateBatch containing a batch of updates.
-// - If resync is true, then the gateway can cleanup all its data and add
-//   all the keys (the batch is guaranteed to contain only unique keys).
-// - If resync is false, then the gateway can update the keys, or add new
-//   ones if the key is not already present.
-// - Key deletions are not yet supported (#15109350)
+//   - The gateways call the GetUpdates() streaming API with a StreamRequest
+//     indicating the stream name and the offset to continue streaming from.
+//   - The cloud sends a stream of DataUpdateBatch containing a batch of updates.
+//   - If resync is true, then the gateway can cleanup all its data and add
+//     all the keys (the batch is guaranteed to contain only unique keys).
+//   - If resync is false, then the gateway can update the keys, or add new
+//     ones if the key is not already present, and remove the deleted keys.
+//   - Gateways which support incremental streaming send the digest of the last
+//     batch they applied as a StreamDigest in extra_args. Providers which
+//     support incremental streaming will then only send the keys that changed
+//     since that digest, or a full resync if the digest is unknown.
+//   - If the gateway sets persistent in its StreamRequest, the stream stays
+//     open and the cloud pushes a new batch whenever the data behind the
+//     stream changes. Batches with no updates and no deleted keys are
+//     keepalives which the gateway can ignore.
+//
 // --------------------------------------------------------------------------
 type StreamRequest struct {
 	GatewayId string `protobuf:"bytes,1,opt,name=gatewayId,proto3" json:"gatewayId,omitempty"`
@@ -47,7 +55,10 @@ type StreamRequest struct {
 	StreamName string `protobuf:"bytes,2,opt,name=stream_name,json=streamName,proto3" json:"stream_name,omitempty"`
 	// Any extra data to send up with the stream request. This value will be
 	// different per stream provider.
-	ExtraArgs            *any.Any `protobuf:"bytes,3,opt,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	ExtraArgs *any.Any `protobuf:"bytes,3,opt,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	// Keep the stream open and push new batches as the stream's data changes
+	// instead of closing it after the first batch.
+	Persistent           bool     `protobuf:"varint,4,opt,name=persistent,proto3" json:"persistent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -57,7 +68,7 @@ func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
 func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamRequest) ProtoMessage()    {}
 func (*StreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streamer_875df42a7991f84c, []int{0}
+	return fileDescriptor_streamer_4a00d285d71be1f3, []int{0}
 }
 func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
@@ -98,6 +109,13 @@ func (m *StreamRequest) GetExtraArgs() *any.Any {
 	return nil
 }
 
+func (m *StreamRequest) GetPersistent() bool {
+	if m != nil {
+		return m.Persistent
+	}
+	return false
+}
+
 type DataUpdate struct {
 	// Unique key for each item
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -113,7 +131,7 @@ func (m *DataUpdate) Reset()         { *m = DataUpdate{} }
 func (m *DataUpdate) String() string { return proto.CompactTextString(m) }
 func (*DataUpdate) ProtoMessage()    {}
 func (*DataUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streamer_875df42a7991f84c, []int{1}
+	return fileDescriptor_streamer_4a00d285d71be1f3, []int{1}
 }
 func (m *DataUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataUpdate.Unmarshal(m, b)
@@ -151,7 +169,13 @@ type DataUpdateBatch struct {
 	Updates []*DataUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
 	// If resync is true, the updates would be a snapshot of all the
 	// contents in the cloud.
-	Resync               bool     `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`
+	Resync bool `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`
+	// Keys which were deleted since the digest sent by the gateway. This is
+	// only populated when resync is false.
+	DeletedKeys []string `protobuf:"bytes,3,rep,name=deleted_keys,json=deletedKeys,proto3" json:"deleted_keys,omitempty"`
+	// Digest of the full contents of the stream after applying this batch.
+	// Empty if the stream provider does not support incremental streaming.
+	Digest               string   `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -161,7 +185,7 @@ func (m *DataUpdateBatch) Reset()         { *m = DataUpdateBatch{} }
 func (m *DataUpdateBatch) String() string { return proto.CompactTextString(m) }
 func (*DataUpdateBatch) ProtoMessage()    {}
 func (*DataUpdateBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streamer_875df42a7991f84c, []int{2}
+	return fileDescriptor_streamer_4a00d285d71be1f3, []int{2}
 }
 func (m *DataUpdateBatch) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataUpdateBatch.Unmarshal(m, b)
@@ -195,10 +219,115 @@ func (m *DataUpdateBatch) GetResync() bool {
 	return false
 }
 
+func (m *DataUpdateBatch) GetDeletedKeys() []string {
+	if m != nil {
+		return m.DeletedKeys
+	}
+	return nil
+}
+
+func (m *DataUpdateBatch) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+// StreamDigest is sent in StreamRequest.extra_args by gateways which support
+// incremental streaming.
+type StreamDigest struct {
+	// Digest of the last DataUpdateBatch the gateway applied
+	Digest               string   `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamDigest) Reset()         { *m = StreamDigest{} }
+func (m *StreamDigest) String() string { return proto.CompactTextString(m) }
+func (*StreamDigest) ProtoMessage()    {}
+func (*StreamDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_streamer_4a00d285d71be1f3, []int{3}
+}
+func (m *StreamDigest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamDigest.Unmarshal(m, b)
+}
+func (m *StreamDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamDigest.Marshal(b, m, deterministic)
+}
+func (dst *StreamDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamDigest.Merge(dst, src)
+}
+func (m *StreamDigest) XXX_Size() int {
+	return xxx_messageInfo_StreamDigest.Size(m)
+}
+func (m *StreamDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamDigest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamDigest proto.InternalMessageInfo
+
+func (m *StreamDigest) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+type StreamUpdateNotification struct {
+	// Name of the stream whose data changed
+	StreamName string `protobuf:"bytes,1,opt,name=stream_name,json=streamName,proto3" json:"stream_name,omitempty"`
+	// Network in which the data changed. Empty notifies all networks.
+	NetworkId            string   `protobuf:"bytes,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamUpdateNotification) Reset()         { *m = StreamUpdateNotification{} }
+func (m *StreamUpdateNotification) String() string { return proto.CompactTextString(m) }
+func (*StreamUpdateNotification) ProtoMessage()    {}
+func (*StreamUpdateNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_streamer_4a00d285d71be1f3, []int{4}
+}
+func (m *StreamUpdateNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamUpdateNotification.Unmarshal(m, b)
+}
+func (m *StreamUpdateNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamUpdateNotification.Marshal(b, m, deterministic)
+}
+func (dst *StreamUpdateNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamUpdateNotification.Merge(dst, src)
+}
+func (m *StreamUpdateNotification) XXX_Size() int {
+	return xxx_messageInfo_StreamUpdateNotification.Size(m)
+}
+func (m *StreamUpdateNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamUpdateNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamUpdateNotification proto.InternalMessageInfo
+
+func (m *StreamUpdateNotification) GetStreamName() string {
+	if m != nil {
+		return m.StreamName
+	}
+	return ""
+}
+
+func (m *StreamUpdateNotification) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StreamRequest)(nil), "magma.orc8r.StreamRequest")
 	proto.RegisterType((*DataUpdate)(nil), "magma.orc8r.DataUpdate")
 	proto.RegisterType((*DataUpdateBatch)(nil), "magma.orc8r.DataUpdateBatch")
+	proto.RegisterType((*StreamDigest)(nil), "magma.orc8r.StreamDigest")
+	proto.RegisterType((*StreamUpdateNotification)(nil), "magma.orc8r.StreamUpdateNotification")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -304,30 +433,103 @@ var _Streamer_serviceDesc = grpc.ServiceDesc{
 	Metadata: "orc8r/protos/streamer.proto",
 }
 
+// StreamNotifierClient is the client API for StreamNotifier service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamNotifierClient interface {
+	NotifyStreamUpdate(ctx context.Context, in *StreamUpdateNotification, opts ...grpc.CallOption) (*Void, error)
+}
+
+type streamNotifierClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStreamNotifierClient(cc *grpc.ClientConn) StreamNotifierClient {
+	return &streamNotifierClient{cc}
+}
+
+func (c *streamNotifierClient) NotifyStreamUpdate(ctx context.Context, in *StreamUpdateNotification, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.StreamNotifier/NotifyStreamUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StreamNotifierServer is the server API for StreamNotifier service.
+type StreamNotifierServer interface {
+	NotifyStreamUpdate(context.Context, *StreamUpdateNotification) (*Void, error)
+}
+
+func RegisterStreamNotifierServer(s *grpc.Server, srv StreamNotifierServer) {
+	s.RegisterService(&_StreamNotifier_serviceDesc, srv)
+}
+
+func _StreamNotifier_NotifyStreamUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamUpdateNotification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamNotifierServer).NotifyStreamUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.StreamNotifier/NotifyStreamUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamNotifierServer).NotifyStreamUpdate(ctx, req.(*StreamUpdateNotification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StreamNotifier_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.orc8r.StreamNotifier",
+	HandlerType: (*StreamNotifierServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NotifyStreamUpdate",
+			Handler:    _StreamNotifier_NotifyStreamUpdate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "orc8r/protos/streamer.proto",
+}
+
 func init() {
-	proto.RegisterFile("orc8r/protos/streamer.proto", fileDescriptor_streamer_875df42a7991f84c)
-}
-
-var fileDescriptor_streamer_875df42a7991f84c = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4f, 0xf2, 0x40,
-	0x10, 0xc5, 0xbf, 0x7e, 0x44, 0x84, 0xa9, 0x46, 0xb3, 0x21, 0x5a, 0x01, 0x23, 0xe9, 0x89, 0xd3,
-	0x56, 0xc1, 0x83, 0x57, 0x88, 0x89, 0xd1, 0x83, 0x87, 0x25, 0x78, 0x30, 0x26, 0x64, 0x28, 0xe3,
-	0x9a, 0x48, 0xbb, 0xb8, 0xbb, 0x55, 0x7b, 0xf6, 0x1f, 0x37, 0xee, 0x96, 0x20, 0x07, 0x4f, 0xed,
-	0x9b, 0xfd, 0xcd, 0xbc, 0xc9, 0x1b, 0xe8, 0x28, 0x9d, 0x5e, 0xe9, 0x64, 0xa5, 0x95, 0x55, 0x26,
-	0x31, 0x56, 0x13, 0x66, 0xa4, 0xb9, 0xd3, 0x2c, 0xcc, 0x50, 0x66, 0xc8, 0x1d, 0xd2, 0x3e, 0x91,
-	0x4a, 0xc9, 0x25, 0x79, 0x74, 0x5e, 0x3c, 0x27, 0x98, 0x97, 0x9e, 0x8b, 0xbf, 0x02, 0xd8, 0x9f,
-	0xb8, 0x56, 0x41, 0x6f, 0x05, 0x19, 0xcb, 0xba, 0xd0, 0x94, 0x68, 0xe9, 0x03, 0xcb, 0xdb, 0x45,
-	0x14, 0xf4, 0x82, 0x7e, 0x53, 0x6c, 0x0a, 0xec, 0x0c, 0x42, 0xef, 0x34, 0xcb, 0x31, 0xa3, 0xe8,
-	0xbf, 0x7b, 0x07, 0x5f, 0xba, 0xc7, 0x8c, 0xd8, 0x10, 0x80, 0x3e, 0xad, 0xc6, 0x19, 0x6a, 0x69,
-	0xa2, 0x5a, 0x2f, 0xe8, 0x87, 0x83, 0x16, 0xf7, 0x0b, 0xf0, 0xf5, 0x02, 0x7c, 0x94, 0x97, 0xa2,
-	0xe9, 0xb8, 0x91, 0x96, 0x26, 0xbe, 0x04, 0xb8, 0x46, 0x8b, 0xd3, 0xd5, 0x02, 0x2d, 0xb1, 0x43,
-	0xa8, 0xbd, 0x52, 0x59, 0x79, 0xff, 0xfc, 0xb2, 0x16, 0xec, 0xbc, 0xe3, 0xb2, 0xf0, 0x7e, 0x7b,
-	0xc2, 0x8b, 0xf8, 0x09, 0x0e, 0x36, 0x5d, 0x63, 0xb4, 0xe9, 0x0b, 0xbb, 0x80, 0xdd, 0xc2, 0x49,
-	0x13, 0x05, 0xbd, 0x5a, 0x3f, 0x1c, 0x1c, 0xf3, 0x5f, 0x41, 0xf0, 0x0d, 0x2e, 0xd6, 0x1c, 0x3b,
-	0x82, 0xba, 0x26, 0x53, 0xe6, 0xa9, 0x1b, 0xde, 0x10, 0x95, 0x1a, 0x3c, 0x40, 0x63, 0x52, 0x65,
-	0xca, 0xee, 0x00, 0x6e, 0xc8, 0x4e, 0xab, 0x8e, 0xf6, 0xd6, 0xcc, 0xad, 0xf4, 0xda, 0xdd, 0x3f,
-	0xfc, 0xdc, 0x7a, 0xf1, 0xbf, 0xf3, 0x60, 0x7c, 0xfa, 0xd8, 0x71, 0x48, 0xe2, 0xcf, 0x97, 0x2e,
-	0x55, 0xb1, 0x48, 0xa4, 0xaa, 0xee, 0x38, 0xaf, 0xbb, 0xef, 0xf0, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x75, 0x62, 0xfb, 0xc6, 0xde, 0x01, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/streamer.proto", fileDescriptor_streamer_4a00d285d71be1f3)
+}
+
+var fileDescriptor_streamer_4a00d285d71be1f3 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0x9d, 0x29, 0x8c, 0xe6, 0xa6, 0x7c, 0x59, 0x13, 0x64, 0xd9, 0x06, 0x25, 0x12, 0x28, 0x4f,
+	0x09, 0x74, 0x3c, 0xf0, 0xba, 0x69, 0x12, 0x1a, 0x48, 0x13, 0xf2, 0xb4, 0x3d, 0xec, 0xa5, 0x72,
+	0xe3, 0xbb, 0x10, 0xb5, 0x89, 0x8b, 0xed, 0x30, 0xf2, 0x4b, 0xf8, 0x01, 0xfc, 0x51, 0x34, 0xdb,
+	0x53, 0x1b, 0x0a, 0x4f, 0xf1, 0x39, 0x3e, 0xbe, 0x39, 0xf7, 0xdc, 0x0b, 0x7b, 0x52, 0x15, 0x1f,
+	0x55, 0xbe, 0x54, 0xd2, 0x48, 0x9d, 0x6b, 0xa3, 0x90, 0xd7, 0xa8, 0x32, 0x8b, 0x69, 0x58, 0xf3,
+	0xb2, 0xe6, 0x99, 0x95, 0xc4, 0xbb, 0xa5, 0x94, 0xe5, 0x02, 0x9d, 0x74, 0xd6, 0x5e, 0xe7, 0xbc,
+	0xe9, 0x9c, 0x2e, 0xde, 0xed, 0x15, 0x29, 0x64, 0x5d, 0xcb, 0xc6, 0x5d, 0x25, 0xbf, 0x09, 0x3c,
+	0x3a, 0xb7, 0x55, 0x19, 0x7e, 0x6f, 0x51, 0x1b, 0xba, 0x0f, 0x41, 0xc9, 0x0d, 0xde, 0xf0, 0xee,
+	0x54, 0x44, 0x64, 0x4c, 0xd2, 0x80, 0xad, 0x08, 0xfa, 0x0a, 0x42, 0x67, 0x62, 0xda, 0xf0, 0x1a,
+	0xa3, 0x7b, 0xf6, 0x1e, 0x1c, 0x75, 0xc6, 0x6b, 0xa4, 0x87, 0x00, 0xf8, 0xd3, 0x28, 0x3e, 0xe5,
+	0xaa, 0xd4, 0xd1, 0x60, 0x4c, 0xd2, 0x70, 0xb2, 0x93, 0x39, 0x6f, 0xd9, 0x9d, 0xb7, 0xec, 0xa8,
+	0xe9, 0x58, 0x60, 0x75, 0x47, 0xaa, 0xd4, 0xf4, 0x25, 0xc0, 0x12, 0x95, 0xae, 0xb4, 0xc1, 0xc6,
+	0x44, 0xf7, 0xc7, 0x24, 0x1d, 0xb2, 0x35, 0x26, 0xf9, 0x00, 0x70, 0xc2, 0x0d, 0xbf, 0x58, 0x0a,
+	0x6e, 0x90, 0x3e, 0x85, 0xc1, 0x1c, 0x3b, 0xef, 0xed, 0xf6, 0x48, 0x77, 0xe0, 0xc1, 0x0f, 0xbe,
+	0x68, 0x9d, 0x9f, 0x11, 0x73, 0x20, 0xf9, 0x45, 0xe0, 0xc9, 0xea, 0xd9, 0x31, 0x37, 0xc5, 0x37,
+	0xfa, 0x1e, 0x1e, 0xb6, 0x16, 0xea, 0x88, 0x8c, 0x07, 0x69, 0x38, 0x79, 0x91, 0xad, 0x85, 0x98,
+	0xad, 0xe4, 0xec, 0x4e, 0x47, 0x9f, 0xc3, 0xb6, 0x42, 0xdd, 0x35, 0x85, 0xad, 0x3e, 0x64, 0x1e,
+	0xd1, 0xd7, 0x30, 0x12, 0xb8, 0x40, 0x83, 0x62, 0x3a, 0xc7, 0xee, 0xb6, 0xd7, 0x41, 0x1a, 0xb0,
+	0xd0, 0x73, 0x5f, 0xb0, 0xb3, 0x4f, 0x45, 0x55, 0xa2, 0x76, 0x3d, 0x05, 0xcc, 0xa3, 0xe4, 0x2d,
+	0x8c, 0x5c, 0xe8, 0x27, 0x16, 0xaf, 0xe9, 0x48, 0x4f, 0x77, 0x05, 0x91, 0xd3, 0x39, 0x4f, 0x67,
+	0xd2, 0x54, 0xd7, 0x55, 0xc1, 0x4d, 0x25, 0x9b, 0xbf, 0x27, 0x41, 0x36, 0x26, 0x71, 0x00, 0xd0,
+	0xa0, 0xb9, 0x91, 0x6a, 0x3e, 0xad, 0x84, 0x9f, 0x54, 0xe0, 0x99, 0x53, 0x31, 0xb9, 0x84, 0xe1,
+	0xb9, 0x5f, 0x27, 0xfa, 0x19, 0xe0, 0x13, 0x9a, 0x0b, 0xdf, 0x70, 0xdc, 0x8b, 0xa4, 0xb7, 0x1d,
+	0xf1, 0xfe, 0x7f, 0xe2, 0xb2, 0xe9, 0x26, 0x5b, 0xef, 0xc8, 0x64, 0x06, 0x8f, 0xdd, 0x13, 0xe7,
+	0x16, 0x15, 0xfd, 0x0a, 0xd4, 0x9e, 0xbb, 0xf5, 0x5e, 0xe8, 0x9b, 0x7f, 0xfc, 0x65, 0xb3, 0xcd,
+	0xf8, 0x59, 0x4f, 0x76, 0x29, 0x2b, 0x91, 0x6c, 0x1d, 0x1f, 0x5c, 0xed, 0x59, 0x36, 0x77, 0x8b,
+	0x5d, 0x2c, 0x64, 0x2b, 0xf2, 0x52, 0xfa, 0x0d, 0x9f, 0x6d, 0xdb, 0xef, 0xe1, 0x9f, 0x01, 0x00,
+	0x38, 0x69, 0xc1, 0x70, 0x3d, 0x03, 0x00, 0x00,
 }
@@ -95,6 +95,26 @@ func Deserialize(domain string, typeVal string, data []byte) (interface{}, error
 	return subregistry.deserialize(typeVal, data)
 }
 
+// GetRegisteredTypes returns the sorted types of all Serdes registered in a
+// domain. This function is thread-safe.
+func GetRegisteredTypes(domain string) []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	subregistry, ok := registry.serdeRegistriesByDomain[domain]
+	if !ok {
+		return []string{}
+	}
+
+	subregistry.RLock()
+	defer subregistry.RUnlock()
+	ret := make([]string, 0, len(subregistry.serdesByKey))
+	for t := range subregistry.serdesByKey {
+		ret = append(ret, t)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 func getSerdesByDomain(serdesToGroup []Serde) map[string][]Serde {
 	ret := map[string][]Serde{}
 	for _, s := range serdesToGroup {
@@ -66,3 +66,27 @@ func TestDeserialize(t *testing.T) {
 	assert.EqualError(t, err, "No Serde found for type baz")
 
 }
+
+func TestGetRegisteredTypes(t *testing.T) {
+	serde.UnregisterAllSerdes(t)
+	defer func() {
+		serde.UnregisterAllSerdes(t)
+	}()
+
+	assert.Equal(t, []string{}, serde.GetRegisteredTypes("foo"))
+
+	mockSerde1 := &mocks.Serde{}
+	mockSerde1.On("GetDomain").Return("foo")
+	mockSerde1.On("GetType").Return("baz")
+	mockSerde2 := &mocks.Serde{}
+	mockSerde2.On("GetDomain").Return("foo")
+	mockSerde2.On("GetType").Return("bar")
+	mockSerde3 := &mocks.Serde{}
+	mockSerde3.On("GetDomain").Return("other")
+	mockSerde3.On("GetType").Return("qux")
+
+	err := serde.RegisterSerdes(mockSerde1, mockSerde2, mockSerde3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bar", "baz"}, serde.GetRegisteredTypes("foo"))
+	assert.Equal(t, []string{"qux"}, serde.GetRegisteredTypes("other"))
+}
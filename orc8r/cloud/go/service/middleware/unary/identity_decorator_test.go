@@ -89,6 +89,26 @@ func (srv *testCheckindServer) List(
 	return new(protos.IDList), nil
 }
 
+// Returns the checkin history of a gateway
+func (srv *testCheckindServer) GetCheckinHistory(
+	ctx context.Context,
+	req *protos.GatewayStatusRequest) (*protos.CheckinHistory, error) {
+
+	srv.lastClientIdentity =
+		proto.Clone(protos.GetClientIdentity(ctx)).(*protos.Identity)
+	return new(protos.CheckinHistory), nil
+}
+
+// Returns the connectivity events of a gateway
+func (srv *testCheckindServer) GetConnectivityEvents(
+	ctx context.Context,
+	req *protos.GatewayStatusRequest) (*protos.ConnectivityEvents, error) {
+
+	srv.lastClientIdentity =
+		proto.Clone(protos.GetClientIdentity(ctx)).(*protos.Identity)
+	return new(protos.ConnectivityEvents), nil
+}
+
 func TestIdentityInjector(t *testing.T) {
 	magmad_test_init.StartTestService(t)
 	// Make sure to "share" in memory magmad DBs with interceptors
@@ -173,3 +173,90 @@ func TestAccessManager(t *testing.T) {
 		assert.Equal(t, "Id_Operator_operator2", opers[0].HashString())
 	}
 }
+
+func TestAccessManagerRoles(t *testing.T) {
+	accessd_test_service.StartTestService(t)
+
+	op := identity.NewOperator("noc_engineer")
+	labNet := identity.NewNetwork("lab_network1")
+	prodNet := identity.NewNetwork("prod_network1")
+
+	readOnly := &accessprotos.AccessControl_Role{
+		Name: "read-only",
+		Entities: []*accessprotos.AccessControl_Entity{
+			{Id: identity.NewOperatorWildcard(), Permissions: accessprotos.AccessControl_READ},
+		},
+		NetworkScopes: []*accessprotos.AccessControl_NetworkScope{
+			{Pattern: "*", Permissions: accessprotos.AccessControl_READ},
+		},
+	}
+	labAdmin := &accessprotos.AccessControl_Role{
+		Name: "lab-admin",
+		NetworkScopes: []*accessprotos.AccessControl_NetworkScope{
+			{Pattern: "lab_*", Permissions: accessprotos.AccessControl_READ | accessprotos.AccessControl_WRITE},
+		},
+	}
+	assert.NoError(t, accessd.SetRole(readOnly))
+	assert.NoError(t, accessd.SetRole(labAdmin))
+	assert.Error(t, accessd.SetRole(&accessprotos.AccessControl_Role{Name: "bad name"}))
+	assert.Error(t, accessd.SetRole(&accessprotos.AccessControl_Role{
+		Name:          "bad_scope",
+		NetworkScopes: []*accessprotos.AccessControl_NetworkScope{{Pattern: "[lab"}},
+	}))
+
+	roles, err := accessd.ListRoles()
+	assert.NoError(t, err)
+	assert.Len(t, roles, 2)
+	if len(roles) == 2 {
+		assert.Equal(t, "lab-admin", roles[0].Name)
+		assert.Equal(t, "read-only", roles[1].Name)
+	}
+	role, err := accessd.GetRole("lab-admin")
+	assert.NoError(t, err)
+	assert.Equal(t, "lab_*", role.NetworkScopes[0].Pattern)
+	_, err = accessd.GetRole("no-such-role")
+	assert.Error(t, err)
+
+	// Unknown roles can't be assigned, assigning roles creates the operator
+	assert.Error(t, accessd.SetOperatorRoles(op, []string{"read-only", "no-such-role"}))
+	assert.NoError(t, accessd.SetOperatorRoles(op, []string{"read-only", "lab-admin", "read-only"}))
+	assignedRoles, err := accessd.GetOperatorRoles(op)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lab-admin", "read-only"}, assignedRoles)
+
+	assert.NoError(t, accessd.CheckReadPermission(op, labNet, prodNet, identity.NewNetworkWildcard()))
+	assert.NoError(t, accessd.CheckReadPermission(op, identity.NewOperator("someone")))
+	assert.NoError(t, accessd.CheckWritePermission(op, labNet))
+	assert.Error(t, accessd.CheckWritePermission(op, prodNet))
+	assert.Error(t, accessd.CheckWritePermission(op, identity.NewNetworkWildcard()))
+	perm, err := accessd.GetPermissions(op, labNet)
+	assert.NoError(t, err)
+	assert.Equal(t, accessprotos.AccessControl_READ|accessprotos.AccessControl_WRITE, perm)
+
+	// ACL entities are combined with roles and overwriting them keeps roles
+	assert.NoError(t, accessd.SetOperator(op, []*accessprotos.AccessControl_Entity{
+		{Id: prodNet, Permissions: accessprotos.AccessControl_WRITE},
+	}))
+	assert.NoError(t, accessd.CheckWritePermission(op, labNet, prodNet))
+	assert.NoError(t, accessd.CheckReadPermission(op, prodNet))
+
+	// Updating a role applies to all operators it's assigned to
+	labAdmin.NetworkScopes[0].Permissions = accessprotos.AccessControl_READ
+	assert.NoError(t, accessd.SetRole(labAdmin))
+	assert.Error(t, accessd.CheckWritePermission(op, labNet))
+
+	// Deleting a role unassigns it
+	assert.NoError(t, accessd.DeleteRole("read-only"))
+	assert.Error(t, accessd.DeleteRole("read-only"))
+	assignedRoles, err = accessd.GetOperatorRoles(op)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lab-admin"}, assignedRoles)
+	assert.Error(t, accessd.CheckReadPermission(op, identity.NewOperator("someone")))
+	assert.NoError(t, accessd.CheckReadPermission(op, labNet))
+
+	assert.NoError(t, accessd.SetOperatorRoles(op, nil))
+	assert.Error(t, accessd.CheckReadPermission(op, labNet))
+
+	assert.NoError(t, accessd.DeleteRole("lab-admin"))
+	assert.NoError(t, accessd.DeleteOperator(op))
+}
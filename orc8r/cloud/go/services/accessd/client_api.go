@@ -197,3 +197,95 @@ func ListOperators() ([]*protos.Identity, error) {
 	}
 	return opslist.List, nil
 }
+
+// SetRole creates a new role or overwrites an existing one
+func SetRole(role *accessprotos.AccessControl_Role) error {
+	client, conn, err := getAccessdClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = client.SetRole(context.Background(), role)
+	if err != nil {
+		errMsg := fmt.Sprintf("Set Role %s error: %s", role.GetName(), err)
+		glog.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	return nil
+}
+
+// DeleteRole deletes a role and unassigns it from all operators
+func DeleteRole(name string) error {
+	client, conn, err := getAccessdClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = client.DeleteRole(context.Background(), &accessprotos.AccessControl_RoleName{Name: name})
+	if err != nil {
+		glog.Errorf("Delete Role %s error: %s", name, err)
+	}
+	return err
+}
+
+// GetRole returns the role with the given name
+func GetRole(name string) (*accessprotos.AccessControl_Role, error) {
+	client, conn, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	role, err := client.GetRole(context.Background(), &accessprotos.AccessControl_RoleName{Name: name})
+	if err != nil {
+		glog.Errorf("Get Role %s error: %s", name, err)
+		return nil, err
+	}
+	return role, nil
+}
+
+// ListRoles returns all roles sorted by name
+func ListRoles() ([]*accessprotos.AccessControl_Role, error) {
+	client, conn, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	roles, err := client.ListRoles(context.Background(), &protos.Void{})
+	if err != nil || roles == nil {
+		return []*accessprotos.AccessControl_Role{}, err
+	}
+	return roles.Roles, nil
+}
+
+// SetOperatorRoles overwrites the names of roles assigned to the operator
+func SetOperatorRoles(operator *protos.Identity, roles []string) error {
+	client, conn, err := getAccessdClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = client.SetOperatorRoles(
+		context.Background(),
+		&accessprotos.AccessControl_RoleAssignment{Operator: operator, Roles: roles})
+	if err != nil {
+		glog.Errorf("Set Roles for Operator %s error: %s", operator.HashString(), err)
+	}
+	return err
+}
+
+// GetOperatorRoles returns the names of roles assigned to the operator
+func GetOperatorRoles(operator *protos.Identity) ([]string, error) {
+	client, conn, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	resp, err := client.GetOperatorACL(context.Background(), operator)
+	if err != nil {
+		errMsg := fmt.Sprintf("Get Roles for Operator %s error: %s",
+			operator.HashString(), err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return resp.Roles, nil
+}
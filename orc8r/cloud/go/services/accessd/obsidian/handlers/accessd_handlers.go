@@ -19,6 +19,9 @@ const (
 	operatorNetworkPath     = operatorEntitiesPath + "/network/:network_id"
 	operatorPermissionsPath = operatorNetworkPath + "/permissions"
 	operatorCertificatePath = operatorsDetailPath + "/certificate"
+	operatorRolesPath       = operatorsDetailPath + "/roles"
+	rolesRootPath           = operatorsRootPath + "/roles"
+	rolesDetailPath         = rolesRootPath + "/:role_name"
 )
 
 // GetObsidianHandlers returns all the handlers for accessd
@@ -68,6 +71,43 @@ func GetObsidianHandlers() []handlers.Handler {
 			HandlerFunc: PutOperatorPermissionsHandler,
 		},
 
+		// role_handlers.go
+		{
+			Path:        rolesRootPath,
+			Methods:     handlers.GET,
+			HandlerFunc: GetRolesRootHandler,
+		},
+		{
+			Path:        rolesRootPath,
+			Methods:     handlers.POST,
+			HandlerFunc: PostRolesRootHandler,
+		},
+		{
+			Path:        rolesDetailPath,
+			Methods:     handlers.GET,
+			HandlerFunc: GetRoleHandler,
+		},
+		{
+			Path:        rolesDetailPath,
+			Methods:     handlers.PUT,
+			HandlerFunc: PutRoleHandler,
+		},
+		{
+			Path:        rolesDetailPath,
+			Methods:     handlers.DELETE,
+			HandlerFunc: DeleteRoleHandler,
+		},
+		{
+			Path:        operatorRolesPath,
+			Methods:     handlers.GET,
+			HandlerFunc: GetOperatorRolesHandler,
+		},
+		{
+			Path:        operatorRolesPath,
+			Methods:     handlers.PUT,
+			HandlerFunc: PutOperatorRolesHandler,
+		},
+
 		// certificate_handlers.go
 		{
 			Path:        operatorCertificatePath,
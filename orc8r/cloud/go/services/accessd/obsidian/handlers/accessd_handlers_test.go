@@ -17,14 +17,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/swag"
 	"github.com/labstack/echo"
 	"github.com/stretchr/testify/assert"
 
+	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/obsidian/access"
 	access_tests "magma/orc8r/cloud/go/obsidian/access/tests"
 	security_cert "magma/orc8r/cloud/go/security/cert"
+	"magma/orc8r/cloud/go/services/accessd"
 	"magma/orc8r/cloud/go/services/accessd/obsidian/handlers"
 	"magma/orc8r/cloud/go/services/accessd/obsidian/models"
+	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
 	"magma/orc8r/cloud/go/services/accessd/test_init"
 	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
 	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
@@ -56,6 +60,8 @@ func cleanup(t *testing.T) {
 	assert.NoError(t, err)
 	err = test_utils.GetMockDatastoreInstance().DeleteTable("certificate_info_db")
 	assert.NoError(t, err)
+	err = test_utils.GetMockDatastoreInstance().DeleteTable("access_control_roles")
+	assert.NoError(t, err)
 }
 
 func TestListOperators(t *testing.T) {
@@ -393,6 +399,184 @@ func TestPostDeleteCertificate(t *testing.T) {
 	assert.Equal(t, "[]", rec.Body.String())
 }
 
+func TestRoles(t *testing.T) {
+	defer cleanup(t)
+	testOperatorSN, certificates, _ := testInit(t)
+	e := echo.New()
+
+	// Create a role granting read access to all net* networks
+	role := models.Role{
+		Name:        "net-reader",
+		Description: "Read access to net networks",
+		NetworkScopes: []*models.NetworkScope{
+			{
+				Pattern:     swag.String("net*"),
+				Permissions: models.PermissionsMask{models.PermissionTypeREAD},
+			},
+		},
+	}
+	roleBytes, err := role.MarshalBinary()
+	assert.NoError(t, err)
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(string(roleBytes)))
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err = handlers.PostRolesRootHandler(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	// Creating it again fails
+	req = httptest.NewRequest(echo.POST, "/", strings.NewReader(string(roleBytes)))
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c = e.NewContext(req, httptest.NewRecorder())
+	err = handlers.PostRolesRootHandler(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=400")
+
+	// An operator without operator wildcard permissions can't manage roles
+	op1SN := string(certToSerialNumber(t, certificates[operator1ID]))
+	req = httptest.NewRequest(echo.GET, "/", nil)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, op1SN)
+	c = e.NewContext(req, httptest.NewRecorder())
+	err = handlers.GetRolesRootHandler(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=403")
+
+	req = httptest.NewRequest(echo.GET, "/", nil)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	err = handlers.GetRolesRootHandler(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `["net-reader"]`, strings.TrimSpace(rec.Body.String()))
+
+	req = httptest.NewRequest(echo.GET, "/", nil)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("role_name")
+	c.SetParamValues("net-reader")
+	err = handlers.GetRoleHandler(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	actualRole := models.Role{}
+	err = actualRole.UnmarshalBinary(rec.Body.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, role.Name, actualRole.Name)
+	assert.Equal(t, role.Description, actualRole.Description)
+	assert.Len(t, actualRole.NetworkScopes, 1)
+	assert.Equal(t, "net*", swag.StringValue(actualRole.NetworkScopes[0].Pattern))
+	assert.Contains(t, actualRole.NetworkScopes[0].Permissions, models.PermissionTypeREAD)
+	assert.NotContains(t, actualRole.NetworkScopes[0].Permissions, models.PermissionTypeWRITE)
+
+	// Operator 1 can't read network 3 before the role is assigned
+	net3Entity := &accessprotos.AccessControl_Entity{
+		Id:          identity.NewNetwork(string(network3ID)),
+		Permissions: accessprotos.AccessControl_READ,
+	}
+	err = accessd.CheckPermissions(identity.NewOperator(string(operator1ID)), net3Entity)
+	assert.Error(t, err)
+
+	// Operator 1 can't assign roles to itself
+	rolesBytes, err := json.Marshal(models.RoleNames{"net-reader"})
+	assert.NoError(t, err)
+	req = httptest.NewRequest(echo.PUT, "/", strings.NewReader(string(rolesBytes)))
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, op1SN)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c = e.NewContext(req, httptest.NewRecorder())
+	c.SetParamNames("operator_id")
+	c.SetParamValues(string(operator1ID))
+	err = handlers.PutOperatorRolesHandler(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=403")
+
+	req = httptest.NewRequest(echo.PUT, "/", strings.NewReader(string(rolesBytes)))
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("operator_id")
+	c.SetParamValues(string(operator1ID))
+	err = handlers.PutOperatorRolesHandler(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	err = accessd.CheckPermissions(identity.NewOperator(string(operator1ID)), net3Entity)
+	assert.NoError(t, err)
+
+	// Unknown roles can't be assigned
+	req = httptest.NewRequest(echo.PUT, "/", strings.NewReader(`["missing"]`))
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c = e.NewContext(req, httptest.NewRecorder())
+	c.SetParamNames("operator_id")
+	c.SetParamValues(string(operator1ID))
+	err = handlers.PutOperatorRolesHandler(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=400")
+
+	req = httptest.NewRequest(echo.GET, "/", nil)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("operator_id")
+	c.SetParamValues(string(operator1ID))
+	err = handlers.GetOperatorsDetailHandler(c)
+	assert.NoError(t, err)
+	actualRecord := models.OperatorRecord{}
+	err = actualRecord.UnmarshalBinary(rec.Body.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, models.RoleNames{"net-reader"}, actualRecord.Roles)
+
+	// Narrow the role down to net1 only
+	role.NetworkScopes[0].Pattern = swag.String(string(network1ID))
+	roleBytes, err = role.MarshalBinary()
+	assert.NoError(t, err)
+	req = httptest.NewRequest(echo.PUT, "/", strings.NewReader(string(roleBytes)))
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("role_name")
+	c.SetParamValues("net-reader")
+	err = handlers.PutRoleHandler(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	err = accessd.CheckPermissions(identity.NewOperator(string(operator1ID)), net3Entity)
+	assert.Error(t, err)
+
+	req = httptest.NewRequest(echo.DELETE, "/", nil)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("role_name")
+	c.SetParamValues("net-reader")
+	err = handlers.DeleteRoleHandler(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(echo.GET, "/", nil)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("operator_id")
+	c.SetParamValues(string(operator1ID))
+	err = handlers.GetOperatorRolesHandler(c)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", strings.TrimSpace(rec.Body.String()))
+
+	req = httptest.NewRequest(echo.GET, "/", nil)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, testOperatorSN)
+	c = e.NewContext(req, httptest.NewRecorder())
+	c.SetParamNames("role_name")
+	c.SetParamValues("net-reader")
+	err = handlers.GetRoleHandler(c)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=404")
+}
+
 // Helpers
 
 func assertOperatorRecordResponse(t *testing.T, expectedRecord *models.OperatorRecord, response string) {
@@ -9,15 +9,18 @@ LICENSE file in the root directory of this source tree.
 package handlers
 
 import (
-	"github.com/labstack/echo"
+	"fmt"
 	"net/http"
 
+	"github.com/labstack/echo"
+
 	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/accessd"
 	"magma/orc8r/cloud/go/services/accessd/obsidian/models"
+	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
 	"magma/orc8r/cloud/go/services/certifier"
 )
 
@@ -51,6 +54,22 @@ func getOperatorForWrite(c echo.Context) (*protos.Identity, *echo.HTTPError) {
 	return operator, nil
 }
 
+// checkOperatorsPermissions verifies that the caller has the given permissions
+// for all operators, which is required to manage roles
+func checkOperatorsPermissions(c echo.Context, permissions accessprotos.AccessControl_Permission) *echo.HTTPError {
+	caller, err := access.RequestOperator(c)
+	if err != nil {
+		return handlers.HttpError(err)
+	}
+	err = accessd.CheckPermissions(
+		caller,
+		&accessprotos.AccessControl_Entity{Id: identity.NewOperatorWildcard(), Permissions: permissions})
+	if err != nil {
+		return handlers.HttpError(err, http.StatusForbidden)
+	}
+	return nil
+}
+
 func getOperator(c echo.Context) (*protos.Identity, *echo.HTTPError) {
 	operatorID, httpErr := handlers.GetOperatorId(c)
 	if httpErr != nil {
@@ -67,6 +86,16 @@ func getNetwork(c echo.Context) (*protos.Identity, *echo.HTTPError) {
 	return identity.NewNetwork(networkID), nil
 }
 
+func getRoleName(c echo.Context) (string, *echo.HTTPError) {
+	roleName := c.Param("role_name")
+	if roleName == "" {
+		return roleName, handlers.HttpError(
+			fmt.Errorf("Invalid/Missing Role Name"),
+			http.StatusBadRequest)
+	}
+	return roleName, nil
+}
+
 func getCertificateSNs(operator *protos.Identity) ([]models.CertificateSn, error) {
 	certificates, err := certifier.FindCertificates(operator)
 	if err != nil {
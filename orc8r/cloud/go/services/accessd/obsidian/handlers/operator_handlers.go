@@ -54,6 +54,13 @@ func PostOperatorsRootHandler(c echo.Context) error {
 		return handlers.HttpError(fmt.Errorf("Failed to create operator %s: %s",
 			operator.String(), err.Error()))
 	}
+	if len(createOpRecord.Roles) > 0 {
+		err := accessd.SetOperatorRoles(operator, models.RoleNamesToProto(createOpRecord.Roles))
+		if err != nil {
+			return handlers.HttpError(fmt.Errorf("Failed to set roles for operator %s: %s",
+				operator.String(), err.Error()))
+		}
+	}
 	csr := models.CSRToProto(createOpRecord.Csr, operator)
 	certificate, err := certifier.SignCSR(csr)
 	if err != nil {
@@ -78,9 +85,14 @@ func GetOperatorsDetailHandler(c echo.Context) error {
 		accessControlList = append(accessControlList, entity)
 	}
 	acl := models.ACLFromProto(accessControlList)
+	roles, err := accessd.GetOperatorRoles(operator)
+	if err != nil {
+		roles = nil
+	}
 	operatorRecord := &models.OperatorRecord{
 		CertificateSns: certificateSNs,
 		Entities:       acl,
+		Roles:          models.RoleNamesFromProto(roles),
 	}
 	return c.JSON(http.StatusOK, operatorRecord)
 }
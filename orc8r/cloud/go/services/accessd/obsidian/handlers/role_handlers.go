@@ -0,0 +1,170 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/accessd"
+	"magma/orc8r/cloud/go/services/accessd/obsidian/models"
+	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
+)
+
+func GetRolesRootHandler(c echo.Context) error {
+	if httpErr := checkOperatorsPermissions(c, accessprotos.AccessControl_READ); httpErr != nil {
+		return httpErr
+	}
+	roles, err := accessd.ListRoles()
+	if err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to list roles: %s", err.Error()))
+	}
+	roleNames := make(models.RoleNames, len(roles))
+	for i, role := range roles {
+		roleNames[i] = models.RoleName(role.Name)
+	}
+	return c.JSON(http.StatusOK, roleNames)
+}
+
+func PostRolesRootHandler(c echo.Context) error {
+	if httpErr := checkOperatorsPermissions(c, accessprotos.AccessControl_WRITE); httpErr != nil {
+		return httpErr
+	}
+	role, httpErr := bindRole(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	if _, err := accessd.GetRole(string(role.Name)); err == nil {
+		return handlers.HttpError(fmt.Errorf("Role already exists"), http.StatusBadRequest)
+	}
+	if err := accessd.SetRole(models.RoleToProto(role)); err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to create role %s: %s",
+			role.Name, err.Error()))
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func GetRoleHandler(c echo.Context) error {
+	if httpErr := checkOperatorsPermissions(c, accessprotos.AccessControl_READ); httpErr != nil {
+		return httpErr
+	}
+	roleName, httpErr := getRoleName(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	role, err := accessd.GetRole(roleName)
+	if err != nil {
+		return roleHttpError(roleName, err)
+	}
+	return c.JSON(http.StatusOK, models.RoleFromProto(role))
+}
+
+func PutRoleHandler(c echo.Context) error {
+	if httpErr := checkOperatorsPermissions(c, accessprotos.AccessControl_WRITE); httpErr != nil {
+		return httpErr
+	}
+	roleName, httpErr := getRoleName(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	role, httpErr := bindRole(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	if string(role.Name) != roleName {
+		return handlers.HttpError(fmt.Errorf("Role name %s does not match URL role name %s",
+			role.Name, roleName), http.StatusBadRequest)
+	}
+	if _, err := accessd.GetRole(roleName); err != nil {
+		return roleHttpError(roleName, err)
+	}
+	if err := accessd.SetRole(models.RoleToProto(role)); err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to update role %s: %s",
+			roleName, err.Error()))
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func DeleteRoleHandler(c echo.Context) error {
+	if httpErr := checkOperatorsPermissions(c, accessprotos.AccessControl_WRITE); httpErr != nil {
+		return httpErr
+	}
+	roleName, httpErr := getRoleName(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	if err := accessd.DeleteRole(roleName); err != nil {
+		return roleHttpError(roleName, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func GetOperatorRolesHandler(c echo.Context) error {
+	operator, httpErr := getOperatorForRead(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	roles, err := accessd.GetOperatorRoles(operator)
+	if err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to get roles for %s: %s",
+			operator.String(), err.Error()))
+	}
+	return c.JSON(http.StatusOK, models.RoleNamesFromProto(roles))
+}
+
+func PutOperatorRolesHandler(c echo.Context) error {
+	operator, httpErr := getOperatorForWrite(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	// Roles may grant more than the caller's own permissions on the operator,
+	// so only callers who can manage all operators may assign them
+	if httpErr := checkOperatorsPermissions(c, accessprotos.AccessControl_WRITE); httpErr != nil {
+		return httpErr
+	}
+	roleNames := models.RoleNames{}
+	if err := c.Bind(&roleNames); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := roleNames.Validate(strfmt.Default); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := accessd.SetOperatorRoles(operator, models.RoleNamesToProto(roleNames)); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return handlers.HttpError(err, http.StatusBadRequest)
+		}
+		return handlers.HttpError(fmt.Errorf("Failed to set roles for %s: %s",
+			operator.String(), err.Error()))
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func bindRole(c echo.Context) (*models.Role, *echo.HTTPError) {
+	role := &models.Role{}
+	if err := c.Bind(role); err != nil {
+		return nil, handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := role.Validate(strfmt.Default); err != nil {
+		return nil, handlers.HttpError(err, http.StatusBadRequest)
+	}
+	return role, nil
+}
+
+func roleHttpError(roleName string, err error) *echo.HTTPError {
+	if status.Code(err) == codes.NotFound {
+		return handlers.HttpError(fmt.Errorf("Role %s not found", roleName), http.StatusNotFound)
+	}
+	return handlers.HttpError(fmt.Errorf("Failed to get role %s: %s", roleName, err.Error()))
+}
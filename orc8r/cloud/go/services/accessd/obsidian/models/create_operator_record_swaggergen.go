@@ -27,6 +27,9 @@ type CreateOperatorRecord struct {
 	// operator
 	// Required: true
 	Operator OperatorID `json:"operator"`
+
+	// roles
+	Roles RoleNames `json:"roles,omitempty"`
 }
 
 // Validate validates this create operator record
@@ -45,6 +48,10 @@ func (m *CreateOperatorRecord) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRoles(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -97,6 +104,22 @@ func (m *CreateOperatorRecord) validateOperator(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CreateOperatorRecord) validateRoles(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Roles) { // not required
+		return nil
+	}
+
+	if err := m.Roles.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *CreateOperatorRecord) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -14,14 +14,17 @@ import (
 	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/golang/protobuf/ptypes/duration"
 )
 
 var formatsRegistry = strfmt.NewFormats()
 
 func PermissionsMaskToProto(mask PermissionsMask) accessprotos.AccessControl_Permission {
-	permissions := accessprotos.AccessControl_Permission_value[string(mask[0])]
-	permissions |= accessprotos.AccessControl_Permission_value[string(mask[1])]
+	var permissions int32
+	for _, permission := range mask {
+		permissions |= accessprotos.AccessControl_Permission_value[string(permission)]
+	}
 	return accessprotos.AccessControl_Permission(permissions)
 }
 
@@ -80,6 +83,64 @@ func ACLFromProto(accessControlList []*accessprotos.AccessControl_Entity) ACLTyp
 	return ACLType(aclEntities)
 }
 
+func NetworkScopeToProto(scope *NetworkScope) *accessprotos.AccessControl_NetworkScope {
+	return &accessprotos.AccessControl_NetworkScope{
+		Pattern:     swag.StringValue(scope.Pattern),
+		Permissions: PermissionsMaskToProto(scope.Permissions),
+	}
+}
+
+func NetworkScopeFromProto(scope *accessprotos.AccessControl_NetworkScope) *NetworkScope {
+	return &NetworkScope{
+		Pattern:     swag.String(scope.Pattern),
+		Permissions: PermissionsMaskFromProto(scope.Permissions),
+	}
+}
+
+func RoleToProto(role *Role) *accessprotos.AccessControl_Role {
+	networkScopes := make([]*accessprotos.AccessControl_NetworkScope, 0, len(role.NetworkScopes))
+	for _, scope := range role.NetworkScopes {
+		if scope != nil {
+			networkScopes = append(networkScopes, NetworkScopeToProto(scope))
+		}
+	}
+	return &accessprotos.AccessControl_Role{
+		Name:          string(role.Name),
+		Description:   role.Description,
+		Entities:      ACLToProto(role.Entities),
+		NetworkScopes: networkScopes,
+	}
+}
+
+func RoleFromProto(role *accessprotos.AccessControl_Role) *Role {
+	networkScopes := make([]*NetworkScope, len(role.NetworkScopes))
+	for i, scope := range role.NetworkScopes {
+		networkScopes[i] = NetworkScopeFromProto(scope)
+	}
+	return &Role{
+		Name:          RoleName(role.Name),
+		Description:   role.Description,
+		Entities:      ACLFromProto(role.Entities),
+		NetworkScopes: networkScopes,
+	}
+}
+
+func RoleNamesToProto(names RoleNames) []string {
+	res := make([]string, len(names))
+	for i, name := range names {
+		res[i] = string(name)
+	}
+	return res
+}
+
+func RoleNamesFromProto(names []string) RoleNames {
+	res := make(RoleNames, len(names))
+	for i, name := range names {
+		res[i] = RoleName(name)
+	}
+	return res
+}
+
 func CSRToProto(csr *CsrType, operator *protos.Identity) *protos.CSR {
 	return &protos.CSR{
 		Id: operator,
@@ -48,7 +48,7 @@ func (x AccessControl_Permission) String() string {
 	return proto.EnumName(AccessControl_Permission_name, int32(x))
 }
 func (AccessControl_Permission) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 0}
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 0}
 }
 
 // Access Control Data Structures & Definitions
@@ -62,7 +62,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0}
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl.Unmarshal(m, b)
@@ -95,7 +95,7 @@ func (m *AccessControl_Entity) Reset()         { *m = AccessControl_Entity{} }
 func (m *AccessControl_Entity) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_Entity) ProtoMessage()    {}
 func (*AccessControl_Entity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 0}
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 0}
 }
 func (m *AccessControl_Entity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_Entity.Unmarshal(m, b)
@@ -131,18 +131,21 @@ func (m *AccessControl_Entity) GetPermissions() AccessControl_Permission {
 
 // Operator's Access Control List (map)
 type AccessControl_List struct {
-	Operator             *protos.Identity                 `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
-	Entities             map[string]*AccessControl_Entity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+	Operator *protos.Identity                 `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Entities map[string]*AccessControl_Entity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Names of roles assigned to the operator. The operator is granted
+	// permissions of all its roles in addition to its own entities
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AccessControl_List) Reset()         { *m = AccessControl_List{} }
 func (m *AccessControl_List) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_List) ProtoMessage()    {}
 func (*AccessControl_List) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 1}
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 1}
 }
 func (m *AccessControl_List) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_List.Unmarshal(m, b)
@@ -176,6 +179,13 @@ func (m *AccessControl_List) GetEntities() map[string]*AccessControl_Entity {
 	return nil
 }
 
+func (m *AccessControl_List) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 // RPC Request/Responce used to 1) manage AND 2) check permissions
 // 1. When Adding or Modifying permissions entities will represent managed
 // entities Operator's permissions
@@ -193,7 +203,7 @@ func (m *AccessControl_ListRequest) Reset()         { *m = AccessControl_ListReq
 func (m *AccessControl_ListRequest) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_ListRequest) ProtoMessage()    {}
 func (*AccessControl_ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 2}
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 2}
 }
 func (m *AccessControl_ListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_ListRequest.Unmarshal(m, b)
@@ -240,7 +250,7 @@ func (m *AccessControl_PermissionsRequest) Reset()         { *m = AccessControl_
 func (m *AccessControl_PermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_PermissionsRequest) ProtoMessage()    {}
 func (*AccessControl_PermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 3}
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 3}
 }
 func (m *AccessControl_PermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_PermissionsRequest.Unmarshal(m, b)
@@ -285,7 +295,7 @@ func (m *AccessControl_Lists) Reset()         { *m = AccessControl_Lists{} }
 func (m *AccessControl_Lists) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_Lists) ProtoMessage()    {}
 func (*AccessControl_Lists) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 4}
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 4}
 }
 func (m *AccessControl_Lists) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_Lists.Unmarshal(m, b)
@@ -312,6 +322,241 @@ func (m *AccessControl_Lists) GetAcls() []*AccessControl_List {
 	return nil
 }
 
+// Permissions for all networks with IDs matching the pattern.
+// '*' in the pattern matches any sequence of characters, so "lab_*"
+// matches all networks with the "lab_" prefix. The "*" pattern also
+// matches the network wildcard, i.e. grants access to all networks.
+type AccessControl_NetworkScope struct {
+	Pattern              string                   `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Permissions          AccessControl_Permission `protobuf:"varint,2,opt,name=permissions,proto3,enum=magma.orc8r.accessd.AccessControl_Permission" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AccessControl_NetworkScope) Reset()         { *m = AccessControl_NetworkScope{} }
+func (m *AccessControl_NetworkScope) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_NetworkScope) ProtoMessage()    {}
+func (*AccessControl_NetworkScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 5}
+}
+func (m *AccessControl_NetworkScope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_NetworkScope.Unmarshal(m, b)
+}
+func (m *AccessControl_NetworkScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_NetworkScope.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_NetworkScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_NetworkScope.Merge(dst, src)
+}
+func (m *AccessControl_NetworkScope) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_NetworkScope.Size(m)
+}
+func (m *AccessControl_NetworkScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_NetworkScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_NetworkScope proto.InternalMessageInfo
+
+func (m *AccessControl_NetworkScope) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *AccessControl_NetworkScope) GetPermissions() AccessControl_Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return AccessControl_NONE
+}
+
+// Named set of permissions which can be assigned to operators
+type AccessControl_Role struct {
+	Name                 string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Entities             []*AccessControl_Entity       `protobuf:"bytes,3,rep,name=entities,proto3" json:"entities,omitempty"`
+	NetworkScopes        []*AccessControl_NetworkScope `protobuf:"bytes,4,rep,name=network_scopes,json=networkScopes,proto3" json:"network_scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *AccessControl_Role) Reset()         { *m = AccessControl_Role{} }
+func (m *AccessControl_Role) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_Role) ProtoMessage()    {}
+func (*AccessControl_Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 6}
+}
+func (m *AccessControl_Role) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_Role.Unmarshal(m, b)
+}
+func (m *AccessControl_Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_Role.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_Role.Merge(dst, src)
+}
+func (m *AccessControl_Role) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_Role.Size(m)
+}
+func (m *AccessControl_Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_Role proto.InternalMessageInfo
+
+func (m *AccessControl_Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AccessControl_Role) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AccessControl_Role) GetEntities() []*AccessControl_Entity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+func (m *AccessControl_Role) GetNetworkScopes() []*AccessControl_NetworkScope {
+	if m != nil {
+		return m.NetworkScopes
+	}
+	return nil
+}
+
+type AccessControl_RoleName struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessControl_RoleName) Reset()         { *m = AccessControl_RoleName{} }
+func (m *AccessControl_RoleName) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_RoleName) ProtoMessage()    {}
+func (*AccessControl_RoleName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 7}
+}
+func (m *AccessControl_RoleName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_RoleName.Unmarshal(m, b)
+}
+func (m *AccessControl_RoleName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_RoleName.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_RoleName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_RoleName.Merge(dst, src)
+}
+func (m *AccessControl_RoleName) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_RoleName.Size(m)
+}
+func (m *AccessControl_RoleName) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_RoleName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_RoleName proto.InternalMessageInfo
+
+func (m *AccessControl_RoleName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type AccessControl_Roles struct {
+	Roles                []*AccessControl_Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AccessControl_Roles) Reset()         { *m = AccessControl_Roles{} }
+func (m *AccessControl_Roles) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_Roles) ProtoMessage()    {}
+func (*AccessControl_Roles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 8}
+}
+func (m *AccessControl_Roles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_Roles.Unmarshal(m, b)
+}
+func (m *AccessControl_Roles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_Roles.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_Roles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_Roles.Merge(dst, src)
+}
+func (m *AccessControl_Roles) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_Roles.Size(m)
+}
+func (m *AccessControl_Roles) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_Roles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_Roles proto.InternalMessageInfo
+
+func (m *AccessControl_Roles) GetRoles() []*AccessControl_Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type AccessControl_RoleAssignment struct {
+	Operator             *protos.Identity `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Roles                []string         `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AccessControl_RoleAssignment) Reset()         { *m = AccessControl_RoleAssignment{} }
+func (m *AccessControl_RoleAssignment) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_RoleAssignment) ProtoMessage()    {}
+func (*AccessControl_RoleAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_acdc45f2c03ac34c, []int{0, 9}
+}
+func (m *AccessControl_RoleAssignment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_RoleAssignment.Unmarshal(m, b)
+}
+func (m *AccessControl_RoleAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_RoleAssignment.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_RoleAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_RoleAssignment.Merge(dst, src)
+}
+func (m *AccessControl_RoleAssignment) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_RoleAssignment.Size(m)
+}
+func (m *AccessControl_RoleAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_RoleAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_RoleAssignment proto.InternalMessageInfo
+
+func (m *AccessControl_RoleAssignment) GetOperator() *protos.Identity {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *AccessControl_RoleAssignment) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AccessControl)(nil), "magma.orc8r.accessd.AccessControl")
 	proto.RegisterType((*AccessControl_Entity)(nil), "magma.orc8r.accessd.AccessControl.Entity")
@@ -320,6 +565,11 @@ func init() {
 	proto.RegisterType((*AccessControl_ListRequest)(nil), "magma.orc8r.accessd.AccessControl.ListRequest")
 	proto.RegisterType((*AccessControl_PermissionsRequest)(nil), "magma.orc8r.accessd.AccessControl.PermissionsRequest")
 	proto.RegisterType((*AccessControl_Lists)(nil), "magma.orc8r.accessd.AccessControl.Lists")
+	proto.RegisterType((*AccessControl_NetworkScope)(nil), "magma.orc8r.accessd.AccessControl.NetworkScope")
+	proto.RegisterType((*AccessControl_Role)(nil), "magma.orc8r.accessd.AccessControl.Role")
+	proto.RegisterType((*AccessControl_RoleName)(nil), "magma.orc8r.accessd.AccessControl.RoleName")
+	proto.RegisterType((*AccessControl_Roles)(nil), "magma.orc8r.accessd.AccessControl.Roles")
+	proto.RegisterType((*AccessControl_RoleAssignment)(nil), "magma.orc8r.accessd.AccessControl.RoleAssignment")
 	proto.RegisterEnum("magma.orc8r.accessd.AccessControl_Permission", AccessControl_Permission_name, AccessControl_Permission_value)
 }
 
@@ -352,7 +602,7 @@ type AccessControlManagerClient interface {
 	// NOTE: Takes into account wildcards for the entity's type in the ACL
 	GetPermissions(ctx context.Context, in *AccessControl_PermissionsRequest, opts ...grpc.CallOption) (*AccessControl_Entity, error)
 	// CheckPermissions verifies Operator permissions for a list of given
-	// Identities. AccessControl.ListRequest.entities is a list of
+	// Identities, including the permissions of the Operator's roles. AccessControl.ListRequest.entities is a list of
 	// Identities and their corresponding permissions requested by the operator
 	// CheckPermissions will return success only if all requested permissions
 	// are satisfied (AND logic)
@@ -363,6 +613,17 @@ type AccessControlManagerClient interface {
 	ListOperators(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Identity_List, error)
 	// Cleanup a given entity from all Operators' ACLs
 	DeleteEntity(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*protos.Void, error)
+	// Creates a new role or overwrites an existing one
+	SetRole(ctx context.Context, in *AccessControl_Role, opts ...grpc.CallOption) (*protos.Void, error)
+	// Deletes a role and unassigns it from all operators
+	DeleteRole(ctx context.Context, in *AccessControl_RoleName, opts ...grpc.CallOption) (*protos.Void, error)
+	// Returns the role with the given name
+	GetRole(ctx context.Context, in *AccessControl_RoleName, opts ...grpc.CallOption) (*AccessControl_Role, error)
+	// Lists all roles
+	ListRoles(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*AccessControl_Roles, error)
+	// Overwrites the roles assigned to the operator, all roles must exist
+	// If the Operator doesn't exist - creates a new operator with an empty ACL
+	SetOperatorRoles(ctx context.Context, in *AccessControl_RoleAssignment, opts ...grpc.CallOption) (*protos.Void, error)
 }
 
 type accessControlManagerClient struct {
@@ -454,6 +715,51 @@ func (c *accessControlManagerClient) DeleteEntity(ctx context.Context, in *proto
 	return out, nil
 }
 
+func (c *accessControlManagerClient) SetRole(ctx context.Context, in *AccessControl_Role, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/SetRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) DeleteRole(ctx context.Context, in *AccessControl_RoleName, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/DeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) GetRole(ctx context.Context, in *AccessControl_RoleName, opts ...grpc.CallOption) (*AccessControl_Role, error) {
+	out := new(AccessControl_Role)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/GetRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) ListRoles(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*AccessControl_Roles, error) {
+	out := new(AccessControl_Roles)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) SetOperatorRoles(ctx context.Context, in *AccessControl_RoleAssignment, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/SetOperatorRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessControlManagerServer is the server API for AccessControlManager service.
 type AccessControlManagerServer interface {
 	// Overwrites Permissions for operator Identity to manage others
@@ -473,7 +779,7 @@ type AccessControlManagerServer interface {
 	// NOTE: Takes into account wildcards for the entity's type in the ACL
 	GetPermissions(context.Context, *AccessControl_PermissionsRequest) (*AccessControl_Entity, error)
 	// CheckPermissions verifies Operator permissions for a list of given
-	// Identities. AccessControl.ListRequest.entities is a list of
+	// Identities, including the permissions of the Operator's roles. AccessControl.ListRequest.entities is a list of
 	// Identities and their corresponding permissions requested by the operator
 	// CheckPermissions will return success only if all requested permissions
 	// are satisfied (AND logic)
@@ -484,6 +790,17 @@ type AccessControlManagerServer interface {
 	ListOperators(context.Context, *protos.Void) (*protos.Identity_List, error)
 	// Cleanup a given entity from all Operators' ACLs
 	DeleteEntity(context.Context, *protos.Identity) (*protos.Void, error)
+	// Creates a new role or overwrites an existing one
+	SetRole(context.Context, *AccessControl_Role) (*protos.Void, error)
+	// Deletes a role and unassigns it from all operators
+	DeleteRole(context.Context, *AccessControl_RoleName) (*protos.Void, error)
+	// Returns the role with the given name
+	GetRole(context.Context, *AccessControl_RoleName) (*AccessControl_Role, error)
+	// Lists all roles
+	ListRoles(context.Context, *protos.Void) (*AccessControl_Roles, error)
+	// Overwrites the roles assigned to the operator, all roles must exist
+	// If the Operator doesn't exist - creates a new operator with an empty ACL
+	SetOperatorRoles(context.Context, *AccessControl_RoleAssignment) (*protos.Void, error)
 }
 
 func RegisterAccessControlManagerServer(s *grpc.Server, srv AccessControlManagerServer) {
@@ -652,6 +969,96 @@ func _AccessControlManager_DeleteEntity_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessControlManager_SetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_Role)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).SetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/SetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).SetRole(ctx, req.(*AccessControl_Role))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_RoleName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/DeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).DeleteRole(ctx, req.(*AccessControl_RoleName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_RoleName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/GetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).GetRole(ctx, req.(*AccessControl_RoleName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).ListRoles(ctx, req.(*protos.Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_SetOperatorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_RoleAssignment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).SetOperatorRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/SetOperatorRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).SetOperatorRoles(ctx, req.(*AccessControl_RoleAssignment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccessControlManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.accessd.AccessControlManager",
 	HandlerType: (*AccessControlManagerServer)(nil),
@@ -692,47 +1099,80 @@ var _AccessControlManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEntity",
 			Handler:    _AccessControlManager_DeleteEntity_Handler,
 		},
+		{
+			MethodName: "SetRole",
+			Handler:    _AccessControlManager_SetRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _AccessControlManager_DeleteRole_Handler,
+		},
+		{
+			MethodName: "GetRole",
+			Handler:    _AccessControlManager_GetRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _AccessControlManager_ListRoles_Handler,
+		},
+		{
+			MethodName: "SetOperatorRoles",
+			Handler:    _AccessControlManager_SetOperatorRoles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "access.proto",
 }
 
-func init() { proto.RegisterFile("access.proto", fileDescriptor_access_599443aad1afe218) }
-
-var fileDescriptor_access_599443aad1afe218 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xe1, 0x6b, 0xd3, 0x40,
-	0x14, 0x4f, 0xba, 0xb5, 0x74, 0x2f, 0x6b, 0x89, 0x4f, 0x85, 0x7a, 0x7e, 0x19, 0x01, 0xb1, 0x22,
-	0xcb, 0xb0, 0x32, 0x18, 0x53, 0xd0, 0xda, 0x06, 0x19, 0xd4, 0x55, 0x4f, 0xe7, 0x60, 0xdf, 0x62,
-	0x72, 0xce, 0xb0, 0x26, 0x57, 0x73, 0xb7, 0x41, 0xbf, 0xf9, 0xcd, 0x7f, 0xd8, 0x8f, 0x22, 0x92,
-	0xbb, 0xac, 0x4d, 0x58, 0x37, 0x52, 0xdd, 0xa7, 0x5c, 0xee, 0xfd, 0x7e, 0xbf, 0xf7, 0x7b, 0xef,
-	0x5d, 0x2e, 0xb0, 0xe9, 0x07, 0x01, 0x13, 0xc2, 0x9d, 0xa6, 0x5c, 0x72, 0xbc, 0x1b, 0xfb, 0xa7,
-	0xb1, 0xef, 0xf2, 0x34, 0xd8, 0x4b, 0x5d, 0x1d, 0x09, 0xc9, 0x03, 0xf5, 0xba, 0xa3, 0x10, 0x62,
-	0x27, 0xe0, 0x71, 0xcc, 0x13, 0x8d, 0x27, 0x0f, 0x4b, 0xa1, 0x28, 0x64, 0x89, 0x8c, 0xe4, 0x4c,
-	0x07, 0x9d, 0x3f, 0x75, 0x68, 0xf5, 0x95, 0xc6, 0x80, 0x27, 0x32, 0xe5, 0x13, 0xf2, 0xc3, 0x84,
-	0x86, 0xa7, 0x20, 0xf8, 0x08, 0x6a, 0x51, 0xd8, 0x31, 0xb7, 0xcc, 0xae, 0xd5, 0xbb, 0xef, 0x16,
-	0xd3, 0x1e, 0xe4, 0x2a, 0xb4, 0x16, 0x85, 0x38, 0x06, 0x6b, 0xca, 0xd2, 0x38, 0x12, 0x22, 0xe2,
-	0x89, 0xe8, 0xd4, 0xb6, 0xcc, 0x6e, 0xbb, 0xb7, 0xed, 0x2e, 0xb1, 0xe9, 0x96, 0x52, 0xb9, 0xef,
-	0xe7, 0x2c, 0x5a, 0x54, 0x20, 0xbf, 0x4c, 0x58, 0x1f, 0x45, 0x42, 0xe2, 0x33, 0x68, 0xf2, 0x29,
-	0x4b, 0x7d, 0xc9, 0xd3, 0x9b, 0x6d, 0xcc, 0x61, 0xf8, 0x01, 0x9a, 0x6a, 0x2f, 0x62, 0x99, 0x93,
-	0xb5, 0xae, 0xd5, 0xdb, 0xad, 0xe0, 0x24, 0xcb, 0xe6, 0x7a, 0x39, 0xcf, 0x4b, 0x64, 0x3a, 0xa3,
-	0x73, 0x19, 0xf2, 0x15, 0x5a, 0xa5, 0x10, 0xda, 0xb0, 0x76, 0xc6, 0x66, 0xca, 0xd1, 0x06, 0xcd,
-	0x96, 0xf8, 0x0a, 0xea, 0x17, 0xfe, 0xe4, 0x9c, 0xa9, 0xe2, 0xad, 0xde, 0x93, 0x0a, 0x29, 0x75,
-	0x8f, 0xa9, 0xe6, 0xed, 0xd7, 0xf6, 0x4c, 0xf2, 0xd3, 0x04, 0x2b, 0x33, 0x42, 0xd9, 0xf7, 0x73,
-	0xf6, 0x6f, 0xd5, 0x7b, 0x57, 0xaa, 0x5f, 0xc1, 0xca, 0xa2, 0xe2, 0x0b, 0xc0, 0xc5, 0x6c, 0xc4,
-	0x7f, 0xf8, 0xd9, 0x86, 0x86, 0xde, 0xcb, 0x1b, 0x73, 0x0d, 0x21, 0x07, 0x91, 0x21, 0xd4, 0xb3,
-	0x06, 0x08, 0x7c, 0x01, 0xeb, 0x7e, 0x30, 0x11, 0x1d, 0x53, 0xd5, 0xf0, 0xb8, 0xe2, 0x04, 0xa9,
-	0x22, 0x39, 0x4f, 0x01, 0x16, 0xee, 0xb1, 0x09, 0xeb, 0x87, 0xe3, 0x43, 0xcf, 0x36, 0xb2, 0x15,
-	0xf5, 0xfa, 0x43, 0xdb, 0xc4, 0x0d, 0xa8, 0x1f, 0xd3, 0x83, 0x4f, 0x9e, 0x5d, 0xeb, 0xfd, 0xae,
-	0xc3, 0xbd, 0x92, 0xd2, 0x3b, 0x3f, 0xf1, 0x4f, 0x59, 0x8a, 0x14, 0xac, 0x8f, 0x4c, 0x8e, 0x2f,
-	0x2b, 0x71, 0xab, 0x7a, 0xd0, 0xcd, 0x22, 0x77, 0x4a, 0xf8, 0xcf, 0x3c, 0x0a, 0x1d, 0x03, 0x8f,
-	0xa0, 0x7d, 0x34, 0x0d, 0x7d, 0xc9, 0x6e, 0x57, 0xf6, 0x25, 0xb4, 0x87, 0x6c, 0xc2, 0x0a, 0xb2,
-	0xcb, 0xfb, 0xbc, 0x9c, 0x4d, 0xa1, 0xfd, 0x76, 0x51, 0x68, 0x7f, 0x30, 0xba, 0x8e, 0x5d, 0x75,
-	0x0c, 0x8e, 0x81, 0x27, 0x60, 0x17, 0x34, 0x45, 0x7f, 0x30, 0x12, 0x48, 0x96, 0xaa, 0x2a, 0x06,
-	0xe9, 0x56, 0x94, 0x16, 0x8e, 0x81, 0x52, 0xf9, 0x2d, 0x9c, 0x4f, 0xdc, 0x5d, 0xe9, 0xae, 0xb9,
-	0x3c, 0xcf, 0xa4, 0xfa, 0xa7, 0xe1, 0x18, 0x78, 0x0c, 0xf6, 0xe0, 0x1b, 0x0b, 0xce, 0x8a, 0x79,
-	0x6f, 0x65, 0x78, 0xaf, 0xa1, 0x95, 0x61, 0xe6, 0xbd, 0xc2, 0xab, 0x28, 0x72, 0x43, 0xeb, 0x1c,
-	0x03, 0xf7, 0x61, 0x53, 0x8f, 0x3f, 0xbf, 0xb6, 0x57, 0x18, 0xfe, 0x9b, 0xe6, 0x49, 0x43, 0xff,
-	0x18, 0xbe, 0xe8, 0xe7, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x04, 0xce, 0x33, 0x6d,
-	0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("access.proto", fileDescriptor_access_acdc45f2c03ac34c) }
+
+var fileDescriptor_access_acdc45f2c03ac34c = []byte{
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x4e, 0x13, 0x41,
+	0x14, 0xee, 0x6e, 0x5b, 0x68, 0x4f, 0x69, 0x53, 0x8f, 0x98, 0xd4, 0x31, 0x31, 0xcd, 0x26, 0xc6,
+	0x1a, 0xc2, 0x12, 0x6a, 0x48, 0x08, 0x6a, 0xb4, 0x96, 0x4a, 0x30, 0x58, 0x74, 0x10, 0x88, 0xdc,
+	0x98, 0x75, 0x3b, 0xe2, 0x86, 0xee, 0x4e, 0xdd, 0x19, 0x30, 0xbd, 0xf3, 0xce, 0xc4, 0xa7, 0xf0,
+	0x11, 0x7c, 0x1f, 0x5f, 0xc6, 0xec, 0x4c, 0x7f, 0xb6, 0x61, 0x25, 0x5b, 0xe4, 0x8a, 0x9d, 0x99,
+	0xf3, 0x7d, 0xe7, 0x9b, 0xef, 0xcc, 0x39, 0x14, 0x96, 0x1c, 0xd7, 0x65, 0x42, 0xd8, 0x83, 0x90,
+	0x4b, 0x8e, 0xb7, 0x7d, 0xe7, 0xd4, 0x77, 0x6c, 0x1e, 0xba, 0x9b, 0xa1, 0xad, 0x4f, 0x7a, 0xe4,
+	0xae, 0x5a, 0xae, 0xa9, 0x08, 0xb1, 0xe6, 0x72, 0xdf, 0xe7, 0x81, 0x8e, 0x27, 0xf7, 0x66, 0x8e,
+	0xbc, 0x1e, 0x0b, 0xa4, 0x27, 0x87, 0xfa, 0xd0, 0xfa, 0x0d, 0x50, 0x6e, 0x29, 0x8e, 0x36, 0x0f,
+	0x64, 0xc8, 0xfb, 0xe4, 0xbb, 0x01, 0x0b, 0x1d, 0x15, 0x82, 0x0f, 0xc0, 0xf4, 0x7a, 0x35, 0xa3,
+	0x6e, 0x34, 0x4a, 0xcd, 0x3b, 0x76, 0x3c, 0xed, 0xee, 0x88, 0x85, 0x9a, 0x5e, 0x0f, 0xf7, 0xa1,
+	0x34, 0x60, 0xa1, 0xef, 0x09, 0xe1, 0xf1, 0x40, 0xd4, 0xcc, 0xba, 0xd1, 0xa8, 0x34, 0x57, 0xed,
+	0x04, 0x99, 0xf6, 0x4c, 0x2a, 0xfb, 0xed, 0x04, 0x45, 0xe3, 0x0c, 0xe4, 0xa7, 0x09, 0xb9, 0x3d,
+	0x4f, 0x48, 0x5c, 0x87, 0x02, 0x1f, 0xb0, 0xd0, 0x91, 0x3c, 0xbc, 0x5a, 0xc6, 0x24, 0x0c, 0xdf,
+	0x41, 0x41, 0xed, 0x79, 0x2c, 0x52, 0x92, 0x6d, 0x94, 0x9a, 0x1b, 0x29, 0x94, 0x44, 0xd9, 0xec,
+	0xce, 0x08, 0xd7, 0x09, 0x64, 0x38, 0xa4, 0x13, 0x1a, 0x5c, 0x86, 0x7c, 0xc8, 0xfb, 0x4c, 0xd4,
+	0xb2, 0xf5, 0x6c, 0xa3, 0x48, 0xf5, 0x82, 0x7c, 0x86, 0xf2, 0x0c, 0x00, 0xab, 0x90, 0x3d, 0x63,
+	0x43, 0xa5, 0xb3, 0x48, 0xa3, 0x4f, 0x7c, 0x0e, 0xf9, 0x0b, 0xa7, 0x7f, 0xce, 0x94, 0x25, 0xa5,
+	0xe6, 0xa3, 0x14, 0x42, 0xb4, 0xf3, 0x54, 0xe3, 0xb6, 0xcc, 0x4d, 0x83, 0xfc, 0x30, 0xa0, 0x14,
+	0xc9, 0xa3, 0xec, 0xeb, 0x39, 0xbb, 0x9e, 0x27, 0x9d, 0x4b, 0x9e, 0xcc, 0x21, 0x65, 0x02, 0x25,
+	0x17, 0x80, 0xd3, 0x8a, 0x89, 0xff, 0xd0, 0xb3, 0x0a, 0x0b, 0x7a, 0xaf, 0x66, 0x5e, 0x05, 0x18,
+	0x05, 0x91, 0x6d, 0xc8, 0x47, 0x06, 0x08, 0x7c, 0x02, 0x39, 0xc7, 0xed, 0x8b, 0x9a, 0xa1, 0xee,
+	0xf0, 0x30, 0x65, 0x5d, 0xa9, 0x02, 0x91, 0x21, 0x2c, 0x75, 0x99, 0xfc, 0xc6, 0xc3, 0xb3, 0x03,
+	0x97, 0x0f, 0x18, 0xd6, 0x60, 0x71, 0xe0, 0x48, 0xc9, 0xc2, 0x60, 0x54, 0xb2, 0xf1, 0xf2, 0xe6,
+	0xdf, 0xf3, 0x1f, 0x03, 0x72, 0x94, 0xf7, 0x19, 0x22, 0xe4, 0x02, 0xc7, 0x67, 0xa3, 0x84, 0xea,
+	0x1b, 0xeb, 0x50, 0xea, 0x31, 0xe1, 0x86, 0xde, 0x40, 0x7a, 0x3c, 0x50, 0xd9, 0x8a, 0x34, 0xbe,
+	0x35, 0x53, 0xbe, 0xec, 0xb5, 0xcb, 0x87, 0x47, 0x50, 0x09, 0xb4, 0x01, 0x1f, 0x45, 0xe4, 0x80,
+	0xa8, 0xe5, 0x14, 0xd9, 0x5a, 0x0a, 0xb2, 0xb8, 0x73, 0xb4, 0x1c, 0xc4, 0x56, 0x82, 0xdc, 0x87,
+	0x42, 0x74, 0xb9, 0xae, 0xe3, 0x27, 0x5e, 0x90, 0xbc, 0x82, 0x7c, 0x74, 0x2e, 0xf0, 0xd9, 0xb8,
+	0x8f, 0xd2, 0xd7, 0x2f, 0x02, 0x8e, 0x1b, 0xee, 0x03, 0x54, 0xa2, 0x65, 0x4b, 0x08, 0xef, 0x34,
+	0xf0, 0x59, 0x70, 0xad, 0xa7, 0x37, 0xe9, 0x65, 0x33, 0xd6, 0xcb, 0xd6, 0x0a, 0xc0, 0xb4, 0x76,
+	0x58, 0x80, 0x5c, 0x77, 0xbf, 0xdb, 0xa9, 0x66, 0xa2, 0x2f, 0xda, 0x69, 0x6d, 0x57, 0x0d, 0x2c,
+	0x42, 0xfe, 0x98, 0xee, 0xbe, 0xef, 0x54, 0xcd, 0xe6, 0xaf, 0x22, 0x2c, 0xcf, 0xa8, 0x7c, 0xe3,
+	0x04, 0xce, 0x29, 0x0b, 0x91, 0x42, 0xe9, 0x80, 0xc9, 0xfd, 0x71, 0x2a, 0x3b, 0xed, 0xfb, 0xd4,
+	0x8d, 0x44, 0x6e, 0xcd, 0xc4, 0x1f, 0x71, 0xaf, 0x67, 0x65, 0xf0, 0x10, 0x2a, 0x87, 0x83, 0x9e,
+	0x23, 0xd9, 0xcd, 0xd2, 0x3e, 0x85, 0xca, 0x36, 0xeb, 0xb3, 0x18, 0x6d, 0xb2, 0x73, 0xc9, 0x68,
+	0x0a, 0x95, 0x9d, 0xe9, 0x45, 0x5b, 0xed, 0xbd, 0x7f, 0xa1, 0xd3, 0xb6, 0xa8, 0x95, 0xc1, 0x13,
+	0xa8, 0xc6, 0x38, 0x45, 0xab, 0xbd, 0x27, 0x90, 0x24, 0xb2, 0x2a, 0x04, 0x69, 0xa4, 0xa4, 0x16,
+	0x56, 0x06, 0xa5, 0xd2, 0x1b, 0x9b, 0x5d, 0xb8, 0x31, 0x57, 0x37, 0x8f, 0x67, 0x1d, 0x49, 0xdf,
+	0x77, 0x56, 0x06, 0x8f, 0xa1, 0xda, 0xfe, 0xc2, 0xdc, 0xb3, 0x78, 0xde, 0x1b, 0x29, 0xde, 0x0b,
+	0x28, 0x47, 0x31, 0x13, 0xaf, 0xf0, 0x72, 0x14, 0xb9, 0xc2, 0x3a, 0x2b, 0x83, 0x5b, 0xb0, 0xa4,
+	0xcb, 0x3f, 0xfa, 0x47, 0x3f, 0x4f, 0xf1, 0x77, 0x60, 0xf1, 0x80, 0x49, 0x35, 0xce, 0xd2, 0x76,
+	0x70, 0x32, 0x51, 0x17, 0x40, 0x8b, 0x50, 0x5c, 0x2b, 0x29, 0xb9, 0xa2, 0x31, 0x93, 0xcc, 0xe7,
+	0xc0, 0xe2, 0x0e, 0x93, 0xf3, 0x93, 0xa5, 0xbd, 0x85, 0x95, 0xc1, 0xd7, 0x50, 0x54, 0xd5, 0x51,
+	0xe3, 0x2c, 0xc1, 0xf5, 0x46, 0x4a, 0x2a, 0xa1, 0x1f, 0x7c, 0x6c, 0x5a, 0x68, 0xca, 0xf5, 0x94,
+	0xf8, 0xe9, 0x0c, 0x4c, 0xb4, 0xe2, 0x65, 0xe1, 0x64, 0x41, 0xff, 0xdc, 0xfb, 0xa4, 0xff, 0x3e,
+	0xfe, 0x3b, 0x00, 0xe3, 0xcd, 0x86, 0xd8, 0x43, 0x0a, 0x00, 0x00,
 }
@@ -24,6 +24,7 @@ import (
 
 const (
 	ACCESS_TABLE = "access_control"
+	ROLE_TABLE   = "access_control_roles"
 )
 
 type AccessControlServer struct {
@@ -45,6 +46,10 @@ func (srv *AccessControlServer) SetOperator(ctx context.Context, req *accessprot
 
 	acl := &accessprotos.AccessControl_List{Operator: req.Operator,
 		Entities: map[string]*accessprotos.AccessControl_Entity{}}
+	// Roles are managed by SetOperatorRoles, keep roles of existing operator
+	if existingACL, err := srv.getACL(req.Operator); err == nil {
+		acl.Roles = existingACL.Roles
+	}
 	err = addToACL(req.Operator, acl, req.Entities)
 	if err != nil {
 		return &protos.Void{}, err
@@ -116,8 +121,10 @@ func (srv *AccessControlServer) GetPermissions(
 	return srv.getACLEntity(req)
 }
 
-// Returns the managing Identity's permissions for a given entity
-// NOTE: Takes into account wildcards for the entity's type in the ACL
+// Verifies that the managing Identity has all requested permissions for the
+// given entities
+// NOTE: Takes into account wildcards for the entity's type in the ACL and
+// permissions granted by the Identity's roles
 func (srv *AccessControlServer) CheckPermissions(
 	ctx context.Context,
 	req *accessprotos.AccessControl_ListRequest,
@@ -132,7 +139,11 @@ func (srv *AccessControlServer) CheckPermissions(
 	if err != nil {
 		return voidRes, err
 	}
-	return voidRes, checkEntitiesPermissions(acl, req.Entities)
+	roles, err := srv.getRoles(acl.Roles)
+	if err != nil {
+		return voidRes, err
+	}
+	return voidRes, checkEntitiesPermissions(acl, roles, req.Entities)
 }
 
 // Lists all globally registered operators on the cloud
@@ -160,3 +171,83 @@ func (srv *AccessControlServer) ListOperators(ctx context.Context, _ *protos.Voi
 func (srv *AccessControlServer) DeleteEntity(ctx context.Context, ent *protos.Identity) (*protos.Void, error) {
 	return &protos.Void{}, status.Errorf(codes.Unimplemented, "Not Implemented")
 }
+
+// SetRole creates a new role or overwrites an existing one
+func (srv *AccessControlServer) SetRole(ctx context.Context, role *accessprotos.AccessControl_Role) (*protos.Void, error) {
+	err := verifyRole(role)
+	if err != nil {
+		return &protos.Void{}, err
+	}
+	return &protos.Void{}, srv.putRole(role)
+}
+
+// DeleteRole deletes a role and unassigns it from all operators
+func (srv *AccessControlServer) DeleteRole(ctx context.Context, req *accessprotos.AccessControl_RoleName) (*protos.Void, error) {
+	role, err := srv.GetRole(ctx, req)
+	if err != nil {
+		return &protos.Void{}, err
+	}
+	// Unassign the role first, so no operator is left with a dangling role
+	err = srv.unassignRole(role.Name)
+	if err != nil {
+		return &protos.Void{}, err
+	}
+	err = srv.store.Delete(ROLE_TABLE, role.Name)
+	if err != nil {
+		return &protos.Void{}, status.Errorf(codes.Internal, "Role %s Delete from table %s error: %s", role.Name, ROLE_TABLE, err)
+	}
+	return &protos.Void{}, nil
+}
+
+// GetRole returns the role with the given name
+func (srv *AccessControlServer) GetRole(ctx context.Context, req *accessprotos.AccessControl_RoleName) (*accessprotos.AccessControl_Role, error) {
+	if req == nil || len(req.Name) == 0 {
+		return &accessprotos.AccessControl_Role{}, status.Errorf(codes.InvalidArgument, "Empty Role Name")
+	}
+	roles, err := srv.getRoles([]string{req.Name})
+	if err != nil {
+		return &accessprotos.AccessControl_Role{}, err
+	}
+	if len(roles) == 0 {
+		return &accessprotos.AccessControl_Role{}, status.Errorf(codes.NotFound, "Role %s not found", req.Name)
+	}
+	return roles[0], nil
+}
+
+// ListRoles returns all roles sorted by name
+func (srv *AccessControlServer) ListRoles(ctx context.Context, _ *protos.Void) (*accessprotos.AccessControl_Roles, error) {
+	res := &accessprotos.AccessControl_Roles{}
+	names, err := srv.store.ListKeys(ROLE_TABLE)
+	if err != nil {
+		return res, status.Errorf(codes.Internal, "Error %s listing table %s keys", err, ROLE_TABLE)
+	}
+	res.Roles, err = srv.getRoles(names)
+	return res, err
+}
+
+// SetOperatorRoles overwrites the roles assigned to the operator
+// If the Operator doesn't exist - creates a new operator with an empty ACL
+func (srv *AccessControlServer) SetOperatorRoles(ctx context.Context, req *accessprotos.AccessControl_RoleAssignment) (*protos.Void, error) {
+	if req == nil || req.Operator == nil {
+		return &protos.Void{}, status.Errorf(codes.InvalidArgument, "Nil Operator")
+	}
+	names := uniqueRoleNames(req.Roles)
+	roles, err := srv.getRoles(names)
+	if err != nil {
+		return &protos.Void{}, err
+	}
+	if len(roles) != len(names) {
+		return &protos.Void{}, status.Errorf(codes.NotFound, "Roles %v not found", missingRoleNames(names, roles))
+	}
+
+	acl, err := srv.getACL(req.Operator)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return &protos.Void{}, err
+		}
+		acl = &accessprotos.AccessControl_List{Operator: req.Operator,
+			Entities: map[string]*accessprotos.AccessControl_Entity{}}
+	}
+	acl.Roles = names
+	return &protos.Void{}, srv.putACL(req.Operator, acl)
+}
@@ -10,6 +10,10 @@ package servicers
 
 // Internal accessd related utility functions
 import (
+	"path"
+	"regexp"
+	"sort"
+
 	"magma/orc8r/cloud/go/protos"
 	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
 
@@ -17,6 +21,9 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
+// Role names are used in REST API URLs, restrict them to a URL friendly subset
+var roleNameRegex = regexp.MustCompile(`^[a-zA-Z_][\da-zA-Z_-]*$`)
+
 // storeParamsPair returns a pair of strings to aid querying Access Control
 // related datastore tables - (key, table)
 // key: id's Identity Hash String to be used as the query key and
@@ -212,8 +219,12 @@ func (srv *AccessControlServer) getACLEntity(
 	if err != nil {
 		return res, err
 	}
+	roles, err := srv.getRoles(acl.Roles)
+	if err != nil {
+		return res, err
+	}
 	res.Id = req.Entity
-	res.Permissions = getEntityPermissions(acl, res.Id) // Aggregated entity permissions
+	res.Permissions = getOperatorPermissions(acl, roles, res.Id) // Aggregated entity permissions
 	return res, nil
 }
 
@@ -230,18 +241,28 @@ func (srv *AccessControlServer) getACLEntity(
 func getEntityPermissions(
 	acl *accessprotos.AccessControl_List,
 	entity *protos.Identity,
+) accessprotos.AccessControl_Permission {
+	if acl == nil {
+		return accessprotos.AccessControl_NONE
+	}
+	return getEntitiesMapPermissions(acl.Entities, entity)
+}
+
+func getEntitiesMapPermissions(
+	entities map[string]*accessprotos.AccessControl_Entity,
+	entity *protos.Identity,
 ) accessprotos.AccessControl_Permission {
 	res := accessprotos.AccessControl_NONE
-	if acl != nil && entity != nil {
+	if entities != nil && entity != nil {
 		if wc := entity.GetWildcardForIdentity(); wc != nil {
 			hash := wc.HashString()
-			ent, ok := acl.Entities[hash]
+			ent, ok := entities[hash]
 			if ok && ent.Id.Match(entity) {
 				res = ent.Permissions
 			}
 		}
 		hash := entity.HashString()
-		ent, ok := acl.Entities[hash]
+		ent, ok := entities[hash]
 		if ok {
 			res |= ent.Permissions
 		}
@@ -249,19 +270,75 @@ func getEntityPermissions(
 	return res
 }
 
+// getRolePermissions returns the role's aggregated permissions for a given
+// entity: permissions of the role's entities (with the same wildcard rules as
+// ACLs) ORed with permissions of all the role's network scopes which match
+// the entity.
+func getRolePermissions(
+	role *accessprotos.AccessControl_Role,
+	entity *protos.Identity,
+) accessprotos.AccessControl_Permission {
+	if role == nil || entity == nil {
+		return accessprotos.AccessControl_NONE
+	}
+	entities := make(map[string]*accessprotos.AccessControl_Entity, len(role.Entities))
+	for _, ent := range role.Entities {
+		if ent != nil && ent.Id != nil {
+			entities[ent.Id.HashString()] = ent
+		}
+	}
+	res := getEntitiesMapPermissions(entities, entity)
+	for _, scope := range role.NetworkScopes {
+		if scope != nil && networkScopeMatches(scope.Pattern, entity) {
+			res |= scope.Permissions
+		}
+	}
+	return res
+}
+
+// networkScopeMatches returns true if the entity is a network matching the
+// scope pattern or the entity is the network wildcard and the pattern matches
+// all networks
+func networkScopeMatches(pattern string, entity *protos.Identity) bool {
+	if wc := entity.GetWildcard(); wc != nil {
+		return wc.Type == protos.Identity_Wildcard_Network && pattern == "*"
+	}
+	if networkID := entity.GetNetwork(); len(networkID) > 0 {
+		matched, err := path.Match(pattern, networkID)
+		return err == nil && matched
+	}
+	return false
+}
+
+// getOperatorPermissions returns the aggregated permissions of an operator
+// for a given entity, granted either by the operator's ACL or by any of its
+// roles
+func getOperatorPermissions(
+	acl *accessprotos.AccessControl_List,
+	roles []*accessprotos.AccessControl_Role,
+	entity *protos.Identity,
+) accessprotos.AccessControl_Permission {
+	res := getEntityPermissions(acl, entity)
+	for _, role := range roles {
+		res |= getRolePermissions(role, entity)
+	}
+	return res
+}
+
 // checkEntitiesPermissions verifies permissions for given entList with given
-// ACL.
+// ACL and roles.
 // Returns nil if all entities from entList have at least requested permissions
-// in the ACL, error otherwise
+// in the ACL or roles, error otherwise
 func checkEntitiesPermissions(
 	acl *accessprotos.AccessControl_List,
+	roles []*accessprotos.AccessControl_Role,
 	entList []*accessprotos.AccessControl_Entity,
 ) error {
 	if entList != nil {
 		for _, ent := range entList {
 			if ent != nil {
 				reqPerm := ent.Permissions // Requested permissions for entity
-				aclPerm := getEntityPermissions(acl, ent.Id)
+				aclPerm := getOperatorPermissions(acl, roles, ent.Id)
 				if reqPerm&aclPerm != reqPerm {
 					return protos.Errorf(
 						codes.PermissionDenied,
@@ -299,3 +376,127 @@ func verifyACLRequest(req *accessprotos.AccessControl_ListRequest) error {
 	}
 	return nil
 }
+
+// getRoles fetches roles with given names from srv store and returns them
+// sorted by name. Roles which don't exist are omitted from the result.
+func (srv *AccessControlServer) getRoles(names []string) ([]*accessprotos.AccessControl_Role, error) {
+	if len(names) == 0 {
+		return []*accessprotos.AccessControl_Role{}, nil
+	}
+	marshaledRoleValues, err := srv.store.GetMany(ROLE_TABLE, names)
+	if err != nil {
+		return nil, protos.Errorf(codes.Unknown,
+			"Get Roles error '%s' for Roles %v, table %s", err, names, ROLE_TABLE)
+	}
+	roles := make([]*accessprotos.AccessControl_Role, 0, len(marshaledRoleValues))
+	for name, marshaledRoleVal := range marshaledRoleValues {
+		role := &accessprotos.AccessControl_Role{}
+		err = proto.Unmarshal(marshaledRoleVal.Value, role)
+		if err != nil {
+			return nil, protos.Errorf(codes.Unknown,
+				"Role Unmarshal error '%s' for Role %s from table %s", err, name, ROLE_TABLE)
+		}
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles, nil
+}
+
+// putRole writes the role to srv store
+func (srv *AccessControlServer) putRole(role *accessprotos.AccessControl_Role) error {
+	marshaledRole, err := proto.Marshal(role)
+	if err != nil {
+		return protos.Errorf(codes.Unknown, "Role Marshal error '%s' for Role %s", err, role.Name)
+	}
+	err = srv.store.Put(ROLE_TABLE, role.Name, marshaledRole)
+	if err != nil {
+		return protos.Errorf(codes.Unknown,
+			"Role PUT error '%s' for Role %s, table %s", err, role.Name, ROLE_TABLE)
+	}
+	return nil
+}
+
+// unassignRole removes the role from all operators it's assigned to
+func (srv *AccessControlServer) unassignRole(name string) error {
+	opkeys, err := srv.store.ListKeys(ACCESS_TABLE)
+	if err != nil {
+		return protos.Errorf(codes.Unknown, "Error %s listing table %s keys", err, ACCESS_TABLE)
+	}
+	acls, err := srv.getACLsForKeys(ACCESS_TABLE, opkeys)
+	if err != nil {
+		return err
+	}
+	for _, acl := range acls {
+		roles := make([]string, 0, len(acl.Roles))
+		for _, role := range acl.Roles {
+			if role != name {
+				roles = append(roles, role)
+			}
+		}
+		if len(roles) == len(acl.Roles) {
+			continue
+		}
+		acl.Roles = roles
+		err = srv.putACL(acl.Operator, acl)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uniqueRoleNames returns sorted role names without duplicates
+func uniqueRoleNames(names []string) []string {
+	set := make(map[string]struct{}, len(names))
+	res := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, ok := set[name]; !ok {
+			set[name] = struct{}{}
+			res = append(res, name)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// missingRoleNames returns names which are not in roles
+func missingRoleNames(names []string, roles []*accessprotos.AccessControl_Role) []string {
+	found := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		found[role.Name] = struct{}{}
+	}
+	missing := []string{}
+	for _, name := range names {
+		if _, ok := found[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// verifyRole is a helper function which checks validity of a Role
+func verifyRole(role *accessprotos.AccessControl_Role) error {
+	if role == nil {
+		return protos.Errorf(codes.InvalidArgument, "Nil Role")
+	}
+	if !roleNameRegex.MatchString(role.Name) {
+		return protos.Errorf(codes.InvalidArgument, "Invalid Role Name '%s'", role.Name)
+	}
+	for i, ent := range role.Entities {
+		if ent == nil || ent.Id == nil {
+			return protos.Errorf(
+				codes.InvalidArgument, "Invalid Role Entity @ index: %d ", i)
+		}
+	}
+	for i, scope := range role.NetworkScopes {
+		if scope == nil || len(scope.Pattern) == 0 {
+			return protos.Errorf(
+				codes.InvalidArgument, "Invalid Role Network Scope @ index: %d ", i)
+		}
+		if _, err := path.Match(scope.Pattern, ""); err != nil {
+			return protos.Errorf(
+				codes.InvalidArgument, "Invalid Role Network Scope Pattern '%s': %s", scope.Pattern, err)
+		}
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"math/big"
 	"time"
 
+	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/certifier"
 	"magma/orc8r/cloud/go/services/magmad"
@@ -29,6 +30,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -132,6 +134,35 @@ func (srv *BootstrapperServer) RequestSign(
 	return cert, nil
 }
 
+// RenewCertificate signs a new certificate for a registered gateway which
+// calls through the client cert proxy with its current, still valid
+// certificate. Unlike RequestSign, no challenge is needed since the proxy
+// already verified the gateway's certificate.
+func (srv *BootstrapperServer) RenewCertificate(ctx context.Context, csr *protos.CSR) (*protos.Certificate, error) {
+	if csr == nil {
+		return nil, errorLogger(status.Errorf(codes.InvalidArgument, "Missing CSR"))
+	}
+	gw := protos.GetClientGateway(ctx)
+	if gw == nil {
+		return nil, errorLogger(status.Errorf(codes.PermissionDenied, "Missing Gateway Identity"))
+	}
+	if !gw.Registered() {
+		return nil, errorLogger(status.Errorf(codes.PermissionDenied, "Gateway is not registered"))
+	}
+	ctxMetadata, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(ctxMetadata.Get(identity.CLIENT_CERT_SN_KEY)) != 1 {
+		return nil, errorLogger(status.Errorf(codes.Unauthenticated, "Missing Certificate SN"))
+	}
+
+	// Ignore requested cert duration & overwrite it with our own
+	csr.ValidTime = ptypes.DurationProto(GatewayCertificateDuration)
+	cert, err := certifier.RenewCertificate(ctxMetadata.Get(identity.CLIENT_CERT_SN_KEY)[0], csr)
+	if err != nil {
+		return nil, errorLogger(err)
+	}
+	return cert, nil
+}
+
 // return the length of signature (number of bytes)
 func (srv *BootstrapperServer) signatureLength() int {
 	keyLength := srv.privKey.N.BitLen()
@@ -18,9 +18,11 @@ import (
 	"testing"
 	"time"
 
+	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/key"
 	"magma/orc8r/cloud/go/services/bootstrapper/servicers"
+	"magma/orc8r/cloud/go/services/certifier"
 	certifier_test_init "magma/orc8r/cloud/go/services/certifier/test_init"
 	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/services/magmad"
@@ -290,3 +292,56 @@ func TestBootstrapperServer(t *testing.T) {
 		metadata.Pairs("x-magma-client-cert-cn", "bla"))
 	testNegative(t, testNetworkId, srv, ctx)
 }
+
+func TestBootstrapperServer_RenewCertificate(t *testing.T) {
+	magmad_test_init.StartTestService(t)
+	certifier_test_init.StartTestService(t)
+	networkID, err := magmad.RegisterNetwork(
+		&magmad_protos.MagmadNetworkRecord{Name: "Test Network Name"},
+		"bootstrapper_renewal_test_network")
+	assert.NoError(t, err)
+	gatewayID, err := magmad.RegisterGateway(
+		networkID,
+		&magmad_protos.AccessGatewayRecord{
+			HwId: &protos.AccessGatewayID{Id: "test_ag_renew"},
+			Name: "Test GW renewal",
+			Key:  &protos.ChallengeKey{KeyType: protos.ChallengeKey_ECHO},
+		})
+	assert.NoError(t, err)
+
+	privateKey, err := key.GenerateKey("", 2048)
+	assert.NoError(t, err)
+	srv, err := servicers.NewBootstrapperServer(privateKey.(*rsa.PrivateKey))
+	assert.NoError(t, err)
+
+	gwIdentity := protos.NewGatewayIdentity("test_ag_renew", networkID, gatewayID)
+	csr, err := certifier_test_utils.CreateCSRForId(time.Hour*24, gwIdentity)
+	assert.NoError(t, err)
+	cert, err := certifier.SignCSR(csr)
+	assert.NoError(t, err)
+	renewalCSR, err := certifier_test_utils.CreateCSRForId(time.Hour*24, gwIdentity)
+	assert.NoError(t, err)
+	snCtx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(identity.CLIENT_CERT_SN_KEY, cert.Sn.Sn))
+
+	// missing gateway identity
+	_, err = srv.RenewCertificate(snCtx, renewalCSR)
+	assert.Error(t, err)
+
+	// unregistered gateway
+	unregisteredIdentity := protos.NewGatewayIdentity("test_ag_renew", "", "")
+	_, err = srv.RenewCertificate(unregisteredIdentity.NewContextWithIdentity(snCtx), renewalCSR)
+	assert.Error(t, err)
+
+	// missing certificate serial number
+	_, err = srv.RenewCertificate(gwIdentity.NewContextWithIdentity(context.Background()), renewalCSR)
+	assert.Error(t, err)
+
+	renewed, err := srv.RenewCertificate(gwIdentity.NewContextWithIdentity(snCtx), renewalCSR)
+	assert.NoError(t, err)
+	assert.NotEqual(t, cert.Sn.Sn, renewed.Sn.Sn)
+	certInfo, err := certifier.GetCertificateIdentity(renewed.Sn.Sn)
+	assert.NoError(t, err)
+	assert.Equal(t, "test_ag_renew", certInfo.Id.GetGateway().GetHardwareId())
+}
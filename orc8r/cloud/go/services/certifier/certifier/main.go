@@ -9,8 +9,12 @@ LICENSE file in the root directory of this source tree.
 package main
 
 import (
+	"encoding/pem"
 	"flag"
+	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
 	"time"
 
 	"magma/orc8r/cloud/go/datastore"
@@ -25,6 +29,15 @@ import (
 	"golang.org/x/net/context"
 )
 
+const (
+	renewalWindowKey = "renewalWindowSecs"
+	crlValidityKey   = "crlValiditySecs"
+	crlFileKey       = "crlFile"
+	crlRefreshKey    = "crlRefreshSecs"
+
+	defaultCRLRefresh = time.Minute * 5
+)
+
 var (
 	bootstrapCACertFile = flag.String("cac", "server_cert.pem", "Signer CA's Certificate file")
 	bootstrapCAKeyFile  = flag.String("cak", "server_cert.key.pem", "Signer CA's Private Key file")
@@ -73,6 +86,26 @@ func main() {
 	}
 	certprotos.RegisterCertifierServer(srv.GrpcServer, servicer)
 
+	if srv.Config != nil {
+		if renewalWindowSecs, err := srv.Config.GetIntParam(renewalWindowKey); err == nil && renewalWindowSecs > 0 {
+			servicers.RenewalWindow = time.Second * time.Duration(renewalWindowSecs)
+		}
+		if crlValiditySecs, err := srv.Config.GetIntParam(crlValidityKey); err == nil && crlValiditySecs > 0 {
+			servicers.CRLValidity = time.Second * time.Duration(crlValiditySecs)
+		}
+	}
+
+	// Publish the CRL of gateway certificates for the client cert proxy
+	if srv.Config != nil {
+		if crlFile, err := srv.Config.GetStringParam(crlFileKey); err == nil && crlFile != "" {
+			crlRefresh := defaultCRLRefresh
+			if crlRefreshSecs, err := srv.Config.GetIntParam(crlRefreshKey); err == nil && crlRefreshSecs > 0 {
+				crlRefresh = time.Second * time.Duration(crlRefreshSecs)
+			}
+			go writeCRLPeriodically(servicer, crlFile, crlRefresh)
+		}
+	}
+
 	// Start Garbage Collector Ticker
 	gc := time.Tick(time.Hour * time.Duration(*gcHours))
 	go func() {
@@ -88,3 +121,40 @@ func main() {
 		log.Fatalf("Error running service: %s", err)
 	}
 }
+
+// writeCRLPeriodically writes the CRL of gateway certificates to crlFile
+// every interval, so revoked certificates are rejected by the proxy before
+// the CRL written last expires
+func writeCRLPeriodically(servicer *servicers.CertifierServer, crlFile string, interval time.Duration) {
+	for {
+		if err := writeCRL(servicer, crlFile); err != nil {
+			log.Printf("ERROR: Failed to write CRL to %s: %s", crlFile, err)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// writeCRL atomically replaces crlFile with the PEM encoded CRL of gateway
+// certificates
+func writeCRL(servicer *servicers.CertifierServer, crlFile string) error {
+	crl, err := servicer.GetCRL(context.Background(), &certprotos.GetCRLRequest{CertType: protos.CertType_DEFAULT})
+	if err != nil {
+		return err
+	}
+	tmpFile, err := ioutil.TempFile(filepath.Dir(crlFile), filepath.Base(crlFile))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	err = pem.Encode(tmpFile, &pem.Block{Type: "X509 CRL", Bytes: crl.CrlDer})
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Chmod(tmpFile.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), crlFile)
+}
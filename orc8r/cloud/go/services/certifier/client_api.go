@@ -23,10 +23,16 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 const ServiceName = "CERTIFIER"
 
+// clientCertSNKey is the metadata key carrying the serial number of the
+// caller's certificate. It matches identity.CLIENT_CERT_SN_KEY, which can't
+// be imported here since the identity package depends on this one.
+const clientCertSNKey = "x-magma-client-cert-serial"
+
 // Utility function to get a RPC connection to the certifier service
 func getCertifierClient() (certifierprotos.CertifierClient, *grpc.ClientConn, error) {
 	conn, err := registry.GetConnection(ServiceName)
@@ -70,6 +76,25 @@ func SignCSR(csr *protos.CSR) (*protos.Certificate, error) {
 	return cert, nil
 }
 
+// RenewCertificate returns a new certificate signed for the owner of the
+// still valid certificate with the given serial number. The identity and type
+// of the new certificate are those of the certificate being renewed.
+func RenewCertificate(clientCertSN string, csr *protos.CSR) (*protos.Certificate, error) {
+	client, conn, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(clientCertSNKey, clientCertSN))
+	cert, err := client.RenewCertificate(ctx, csr)
+	if err != nil {
+		glog.Errorf("Failed to renew certificate %s: %s", clientCertSN, err)
+		return nil, err
+	}
+	return cert, nil
+}
+
 // Add an existing Certificate & associate it with operator
 func AddCertificate(oper *protos.Identity, certDer []byte) error {
 	client, conn, err := getCertifierClient()
@@ -197,6 +222,23 @@ func RevokeCertificateSN(sn string) error {
 	return RevokeCertificate(&protos.Certificate_SN{Sn: sn})
 }
 
+// GetCRL returns the DER encoded revocation list of all revoked, unexpired
+// certificates of the given type, signed by the CA of that type
+func GetCRL(certType protos.CertType) ([]byte, error) {
+	client, conn, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	crl, err := client.GetCRL(context.Background(), &certifierprotos.GetCRLRequest{CertType: certType})
+	if err != nil {
+		glog.Errorf("Failed to get CRL for cert type %s: %s", certType, err)
+		return nil, err
+	}
+	return crl.CrlDer, nil
+}
+
 // Let certifier to remove expired certificates
 func CollectGarbage() error {
 	client, conn, err := getCertifierClient()
@@ -58,6 +58,15 @@ func TestCertifier(t *testing.T) {
 	_, err = certifier.GetIdentity(snMsg)
 	assert.Error(t, err, "Error: no error getting revoked identity")
 
+	// test revoked cert is published in the CRL
+	crlDer, err := certifier.GetCRL(protos.CertType_DEFAULT)
+	assert.NoError(t, err, "Failed to get CRL")
+	crl, err := x509.ParseCRL(crlDer)
+	assert.NoError(t, err, "Failed to parse CRL")
+	if assert.Len(t, crl.TBSCertList.RevokedCertificates, 1) {
+		assert.Equal(t, firstCertSN, crl.TBSCertList.RevokedCertificates[0].SerialNumber)
+	}
+
 	// test collect garbage
 	servicers.CollectGarbageAfter = time.Duration(0)
 
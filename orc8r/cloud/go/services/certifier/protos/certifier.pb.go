@@ -39,7 +39,7 @@ func (m *CertificateInfo) Reset()         { *m = CertificateInfo{} }
 func (m *CertificateInfo) String() string { return proto.CompactTextString(m) }
 func (*CertificateInfo) ProtoMessage()    {}
 func (*CertificateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{0}
+	return fileDescriptor_certifier_2c7075c0da7ed350, []int{0}
 }
 func (m *CertificateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CertificateInfo.Unmarshal(m, b)
@@ -98,7 +98,7 @@ func (m *CertificateInfoMap) Reset()         { *m = CertificateInfoMap{} }
 func (m *CertificateInfoMap) String() string { return proto.CompactTextString(m) }
 func (*CertificateInfoMap) ProtoMessage()    {}
 func (*CertificateInfoMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{1}
+	return fileDescriptor_certifier_2c7075c0da7ed350, []int{1}
 }
 func (m *CertificateInfoMap) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CertificateInfoMap.Unmarshal(m, b)
@@ -138,7 +138,7 @@ func (m *AddCertRequest) Reset()         { *m = AddCertRequest{} }
 func (m *AddCertRequest) String() string { return proto.CompactTextString(m) }
 func (*AddCertRequest) ProtoMessage()    {}
 func (*AddCertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{2}
+	return fileDescriptor_certifier_2c7075c0da7ed350, []int{2}
 }
 func (m *AddCertRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddCertRequest.Unmarshal(m, b)
@@ -190,7 +190,7 @@ func (m *SerialNumbers) Reset()         { *m = SerialNumbers{} }
 func (m *SerialNumbers) String() string { return proto.CompactTextString(m) }
 func (*SerialNumbers) ProtoMessage()    {}
 func (*SerialNumbers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{3}
+	return fileDescriptor_certifier_2c7075c0da7ed350, []int{3}
 }
 func (m *SerialNumbers) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SerialNumbers.Unmarshal(m, b)
@@ -228,7 +228,7 @@ func (m *GetCARequest) Reset()         { *m = GetCARequest{} }
 func (m *GetCARequest) String() string { return proto.CompactTextString(m) }
 func (*GetCARequest) ProtoMessage()    {}
 func (*GetCARequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{4}
+	return fileDescriptor_certifier_2c7075c0da7ed350, []int{4}
 }
 func (m *GetCARequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCARequest.Unmarshal(m, b)
@@ -255,6 +255,153 @@ func (m *GetCARequest) GetCertType() protos.CertType {
 	return protos.CertType_DEFAULT
 }
 
+type RevokedCertificate struct {
+	CertType  protos.CertType      `protobuf:"varint,1,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
+	RevokedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	// revoked certificates are dropped from the CRL once they expire
+	NotAfter             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RevokedCertificate) Reset()         { *m = RevokedCertificate{} }
+func (m *RevokedCertificate) String() string { return proto.CompactTextString(m) }
+func (*RevokedCertificate) ProtoMessage()    {}
+func (*RevokedCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certifier_2c7075c0da7ed350, []int{5}
+}
+func (m *RevokedCertificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokedCertificate.Unmarshal(m, b)
+}
+func (m *RevokedCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokedCertificate.Marshal(b, m, deterministic)
+}
+func (dst *RevokedCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokedCertificate.Merge(dst, src)
+}
+func (m *RevokedCertificate) XXX_Size() int {
+	return xxx_messageInfo_RevokedCertificate.Size(m)
+}
+func (m *RevokedCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokedCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokedCertificate proto.InternalMessageInfo
+
+func (m *RevokedCertificate) GetCertType() protos.CertType {
+	if m != nil {
+		return m.CertType
+	}
+	return protos.CertType_DEFAULT
+}
+
+func (m *RevokedCertificate) GetRevokedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return nil
+}
+
+func (m *RevokedCertificate) GetNotAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
+}
+
+type GetCRLRequest struct {
+	CertType             protos.CertType `protobuf:"varint,1,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCRLRequest) Reset()         { *m = GetCRLRequest{} }
+func (m *GetCRLRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCRLRequest) ProtoMessage()    {}
+func (*GetCRLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certifier_2c7075c0da7ed350, []int{6}
+}
+func (m *GetCRLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCRLRequest.Unmarshal(m, b)
+}
+func (m *GetCRLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCRLRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCRLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCRLRequest.Merge(dst, src)
+}
+func (m *GetCRLRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCRLRequest.Size(m)
+}
+func (m *GetCRLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCRLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCRLRequest proto.InternalMessageInfo
+
+func (m *GetCRLRequest) GetCertType() protos.CertType {
+	if m != nil {
+		return m.CertType
+	}
+	return protos.CertType_DEFAULT
+}
+
+type CRL struct {
+	CrlDer               []byte               `protobuf:"bytes,1,opt,name=crl_der,json=crlDer,proto3" json:"crl_der,omitempty"`
+	ThisUpdate           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=this_update,json=thisUpdate,proto3" json:"this_update,omitempty"`
+	NextUpdate           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=next_update,json=nextUpdate,proto3" json:"next_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CRL) Reset()         { *m = CRL{} }
+func (m *CRL) String() string { return proto.CompactTextString(m) }
+func (*CRL) ProtoMessage()    {}
+func (*CRL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certifier_2c7075c0da7ed350, []int{7}
+}
+func (m *CRL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CRL.Unmarshal(m, b)
+}
+func (m *CRL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CRL.Marshal(b, m, deterministic)
+}
+func (dst *CRL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CRL.Merge(dst, src)
+}
+func (m *CRL) XXX_Size() int {
+	return xxx_messageInfo_CRL.Size(m)
+}
+func (m *CRL) XXX_DiscardUnknown() {
+	xxx_messageInfo_CRL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CRL proto.InternalMessageInfo
+
+func (m *CRL) GetCrlDer() []byte {
+	if m != nil {
+		return m.CrlDer
+	}
+	return nil
+}
+
+func (m *CRL) GetThisUpdate() *timestamp.Timestamp {
+	if m != nil {
+		return m.ThisUpdate
+	}
+	return nil
+}
+
+func (m *CRL) GetNextUpdate() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextUpdate
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CertificateInfo)(nil), "magma.orc8r.certifier.CertificateInfo")
 	proto.RegisterType((*CertificateInfoMap)(nil), "magma.orc8r.certifier.CertificateInfoMap")
@@ -262,6 +409,9 @@ func init() {
 	proto.RegisterType((*AddCertRequest)(nil), "magma.orc8r.certifier.AddCertRequest")
 	proto.RegisterType((*SerialNumbers)(nil), "magma.orc8r.certifier.SerialNumbers")
 	proto.RegisterType((*GetCARequest)(nil), "magma.orc8r.certifier.GetCARequest")
+	proto.RegisterType((*RevokedCertificate)(nil), "magma.orc8r.certifier.RevokedCertificate")
+	proto.RegisterType((*GetCRLRequest)(nil), "magma.orc8r.certifier.GetCRLRequest")
+	proto.RegisterType((*CRL)(nil), "magma.orc8r.certifier.CRL")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -286,6 +436,13 @@ type CertifierClient interface {
 	// Throws NOT_FOUND if the certificate is missing.
 	//
 	GetIdentity(ctx context.Context, in *protos.Certificate_SN, opts ...grpc.CallOption) (*CertificateInfo, error)
+	// Signs and adds a new certificate for the caller. The caller must present
+	// a still valid certificate (via the x-magma-client-cert-serial metadata)
+	// which is within its renewal window. The new certificate keeps the
+	// identity and type of the presented one.
+	// Returns signed certificate.
+	//
+	RenewCertificate(ctx context.Context, in *protos.CSR, opts ...grpc.CallOption) (*protos.Certificate, error)
 	// Revoke an existing certificate.
 	// If the certificate does not exist or is expired, this request is ignored.
 	//
@@ -300,6 +457,9 @@ type CertifierClient interface {
 	ListCertificates(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*SerialNumbers, error)
 	// Returns all registered Certificates
 	GetAll(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*CertificateInfoMap, error)
+	// Returns a certificate revocation list of all revoked, unexpired
+	// certificates of the requested type, signed by the CA of that type
+	GetCRL(ctx context.Context, in *GetCRLRequest, opts ...grpc.CallOption) (*CRL, error)
 	// cleanup expired certificates
 	//
 	CollectGarbage(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Void, error)
@@ -340,6 +500,15 @@ func (c *certifierClient) GetIdentity(ctx context.Context, in *protos.Certificat
 	return out, nil
 }
 
+func (c *certifierClient) RenewCertificate(ctx context.Context, in *protos.CSR, opts ...grpc.CallOption) (*protos.Certificate, error) {
+	out := new(protos.Certificate)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/RenewCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certifierClient) RevokeCertificate(ctx context.Context, in *protos.Certificate_SN, opts ...grpc.CallOption) (*protos.Void, error) {
 	out := new(protos.Void)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/RevokeCertificate", in, out, opts...)
@@ -385,6 +554,15 @@ func (c *certifierClient) GetAll(ctx context.Context, in *protos.Void, opts ...g
 	return out, nil
 }
 
+func (c *certifierClient) GetCRL(ctx context.Context, in *GetCRLRequest, opts ...grpc.CallOption) (*CRL, error) {
+	out := new(CRL)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/GetCRL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certifierClient) CollectGarbage(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Void, error) {
 	out := new(protos.Void)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/CollectGarbage", in, out, opts...)
@@ -406,6 +584,13 @@ type CertifierServer interface {
 	// Throws NOT_FOUND if the certificate is missing.
 	//
 	GetIdentity(context.Context, *protos.Certificate_SN) (*CertificateInfo, error)
+	// Signs and adds a new certificate for the caller. The caller must present
+	// a still valid certificate (via the x-magma-client-cert-serial metadata)
+	// which is within its renewal window. The new certificate keeps the
+	// identity and type of the presented one.
+	// Returns signed certificate.
+	//
+	RenewCertificate(context.Context, *protos.CSR) (*protos.Certificate, error)
 	// Revoke an existing certificate.
 	// If the certificate does not exist or is expired, this request is ignored.
 	//
@@ -420,6 +605,9 @@ type CertifierServer interface {
 	ListCertificates(context.Context, *protos.Void) (*SerialNumbers, error)
 	// Returns all registered Certificates
 	GetAll(context.Context, *protos.Void) (*CertificateInfoMap, error)
+	// Returns a certificate revocation list of all revoked, unexpired
+	// certificates of the requested type, signed by the CA of that type
+	GetCRL(context.Context, *GetCRLRequest) (*CRL, error)
 	// cleanup expired certificates
 	//
 	CollectGarbage(context.Context, *protos.Void) (*protos.Void, error)
@@ -483,6 +671,24 @@ func _Certifier_GetIdentity_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Certifier_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.CSR)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/RenewCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).RenewCertificate(ctx, req.(*protos.CSR))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Certifier_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(protos.Certificate_SN)
 	if err := dec(in); err != nil {
@@ -573,6 +779,24 @@ func _Certifier_GetAll_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Certifier_GetCRL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCRLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).GetCRL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/GetCRL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).GetCRL(ctx, req.(*GetCRLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Certifier_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(protos.Void)
 	if err := dec(in); err != nil {
@@ -607,6 +831,10 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetIdentity",
 			Handler:    _Certifier_GetIdentity_Handler,
 		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _Certifier_RenewCertificate_Handler,
+		},
 		{
 			MethodName: "RevokeCertificate",
 			Handler:    _Certifier_RevokeCertificate_Handler,
@@ -627,6 +855,10 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAll",
 			Handler:    _Certifier_GetAll_Handler,
 		},
+		{
+			MethodName: "GetCRL",
+			Handler:    _Certifier_GetCRL_Handler,
+		},
 		{
 			MethodName: "CollectGarbage",
 			Handler:    _Certifier_CollectGarbage_Handler,
@@ -636,45 +868,52 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 	Metadata: "certifier.proto",
 }
 
-func init() { proto.RegisterFile("certifier.proto", fileDescriptor_certifier_ebcdf080e50839d6) }
-
-var fileDescriptor_certifier_ebcdf080e50839d6 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x8e, 0xd2, 0x40,
-	0x14, 0xc6, 0x5b, 0x90, 0x15, 0x0e, 0xc8, 0xc2, 0x98, 0x4d, 0xba, 0x5d, 0x13, 0xb1, 0xba, 0x06,
-	0x6f, 0x4a, 0x82, 0x17, 0xe2, 0x9f, 0x1b, 0xc0, 0x15, 0x37, 0xd9, 0xdd, 0xc4, 0x42, 0xbc, 0xf0,
-	0x86, 0x94, 0xf6, 0xd0, 0x4c, 0xb6, 0xed, 0xe0, 0x74, 0xd8, 0x84, 0x17, 0xf0, 0x4d, 0x7c, 0x2d,
-	0xe3, 0xa3, 0x98, 0xfe, 0x5b, 0x29, 0x14, 0xed, 0x15, 0x33, 0x73, 0xbe, 0xf9, 0x7d, 0x67, 0xbe,
-	0x19, 0x0a, 0xc7, 0x16, 0x72, 0x41, 0x97, 0x14, 0xb9, 0xbe, 0xe2, 0x4c, 0x30, 0x72, 0xe2, 0x99,
-	0x8e, 0x67, 0xea, 0x8c, 0x5b, 0x03, 0xae, 0xdf, 0x17, 0xd5, 0x27, 0xd1, 0x42, 0x2f, 0xd2, 0x04,
-	0xbd, 0x9d, 0x4d, 0xea, 0x69, 0xb6, 0xca, 0x3c, 0x8f, 0xf9, 0x49, 0xe9, 0x2c, 0x53, 0xa2, 0x36,
-	0xfa, 0x82, 0x8a, 0x4d, 0x52, 0x7c, 0xea, 0x30, 0xe6, 0xb8, 0x18, 0x57, 0x17, 0xeb, 0x65, 0x4f,
-	0x50, 0x0f, 0x03, 0x61, 0x7a, 0xab, 0x58, 0xa0, 0xfd, 0x96, 0xe1, 0x78, 0x1c, 0x9b, 0x59, 0xa6,
-	0xc0, 0x4b, 0x7f, 0xc9, 0xc8, 0x39, 0x94, 0xa8, 0xad, 0xc8, 0x1d, 0xb9, 0x5b, 0xef, 0x9f, 0xe8,
-	0xdb, 0xed, 0x5e, 0x26, 0x74, 0xa3, 0x44, 0x6d, 0xf2, 0x16, 0xc0, 0x67, 0x62, 0xbe, 0xc0, 0x25,
-	0xe3, 0xa8, 0x94, 0x22, 0xb9, 0xaa, 0xc7, 0x86, 0x7a, 0x6a, 0xa8, 0xcf, 0x52, 0x43, 0xa3, 0xe6,
-	0x33, 0x31, 0x8a, 0xc4, 0xe4, 0x0d, 0x84, 0x93, 0xb9, 0xb9, 0x14, 0xc8, 0x95, 0xf2, 0x7f, 0x77,
-	0x56, 0x7d, 0x26, 0x86, 0xa1, 0x96, 0xf4, 0xa1, 0x16, 0x46, 0x33, 0x17, 0x9b, 0x15, 0x2a, 0x0f,
-	0x3a, 0x72, 0xb7, 0xb9, 0xd3, 0x61, 0x78, 0x96, 0xd9, 0x66, 0x85, 0x46, 0xd5, 0x4a, 0x46, 0xda,
-	0x2f, 0x19, 0xc8, 0xce, 0x11, 0xaf, 0xcd, 0x15, 0x99, 0x43, 0xc3, 0xfa, 0xbb, 0x1a, 0x28, 0x72,
-	0xa7, 0xdc, 0xad, 0xf7, 0xdf, 0xeb, 0xb9, 0xd7, 0xa3, 0xef, 0x03, 0xb6, 0x97, 0x82, 0x0b, 0x5f,
-	0xf0, 0x8d, 0x91, 0x01, 0xaa, 0x0e, 0xb4, 0xf7, 0x24, 0xa4, 0x05, 0xe5, 0x5b, 0xdc, 0x44, 0xe1,
-	0xd6, 0x8c, 0x70, 0x48, 0x3e, 0x40, 0xe5, 0xce, 0x74, 0xd7, 0x69, 0x82, 0x2f, 0x8b, 0x35, 0x60,
-	0xc4, 0x9b, 0xde, 0x95, 0x06, 0xb2, 0xf6, 0x43, 0x86, 0xe6, 0xd0, 0xb6, 0x43, 0x85, 0x81, 0xdf,
-	0xd7, 0x18, 0x88, 0xa2, 0x57, 0x78, 0x0a, 0x51, 0x4c, 0x73, 0x1b, 0x79, 0x64, 0xdf, 0x30, 0x1e,
-	0x86, 0xf3, 0x8f, 0xbb, 0x49, 0x97, 0x8b, 0x25, 0xfd, 0x0c, 0x1e, 0x4d, 0x91, 0x53, 0xd3, 0xbd,
-	0x59, 0x7b, 0x0b, 0xe4, 0x41, 0x78, 0xda, 0xc0, 0x8f, 0xa3, 0xad, 0x19, 0xe1, 0x50, 0x1b, 0x41,
-	0x63, 0x82, 0x62, 0x3c, 0x4c, 0x1b, 0xcd, 0xd8, 0xc8, 0x85, 0x6c, 0xfa, 0x3f, 0x2b, 0x50, 0x1b,
-	0xa7, 0xc1, 0x90, 0x31, 0x54, 0x22, 0x22, 0x79, 0x7e, 0x20, 0xb9, 0x6d, 0x3f, 0xf5, 0x71, 0x16,
-	0x3e, 0x0c, 0x39, 0x9a, 0x44, 0x46, 0x40, 0xa6, 0xd4, 0xf1, 0x93, 0x14, 0x93, 0x9c, 0x49, 0x2b,
-	0x2b, 0x9e, 0x1a, 0xaa, 0xb2, 0xd7, 0x5b, 0xa2, 0xd5, 0x24, 0x32, 0x83, 0xfa, 0x04, 0x45, 0x9a,
-	0x2f, 0x39, 0x3b, 0x24, 0xd5, 0xa7, 0x37, 0x6a, 0xc1, 0x5b, 0xd6, 0x24, 0x72, 0x01, 0x6d, 0x03,
-	0xef, 0xd8, 0x2d, 0x6e, 0x37, 0xf6, 0x4f, 0x76, 0x3b, 0x53, 0xfc, 0xca, 0xa8, 0xad, 0x49, 0xe4,
-	0xea, 0xfe, 0x89, 0xa4, 0x8c, 0xf3, 0x03, 0x2d, 0x64, 0x5f, 0x52, 0x3e, 0xed, 0x0b, 0xb4, 0x3e,
-	0x51, 0x7f, 0x1b, 0x17, 0x90, 0xfc, 0x67, 0xa6, 0xbe, 0x38, 0x60, 0x93, 0x79, 0x28, 0x9a, 0x44,
-	0xae, 0xa1, 0x75, 0x45, 0x03, 0x91, 0x41, 0xee, 0x7b, 0x17, 0xc6, 0x7d, 0x86, 0xa3, 0x09, 0x8a,
-	0xa1, 0xeb, 0xe6, 0x41, 0x5e, 0x15, 0xfe, 0x93, 0x6b, 0x12, 0x19, 0x40, 0x73, 0xcc, 0x5c, 0x17,
-	0x2d, 0x31, 0x31, 0xf9, 0xc2, 0x74, 0x30, 0x8f, 0x98, 0x97, 0xd2, 0xa8, 0xfa, 0xed, 0x28, 0xfe,
-	0x2a, 0x2f, 0xe2, 0xdf, 0xd7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa9, 0xfc, 0xb5, 0x0d,
-	0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("certifier.proto", fileDescriptor_certifier_2c7075c0da7ed350) }
+
+var fileDescriptor_certifier_2c7075c0da7ed350 = []byte{
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x93, 0x5f, 0xd3, 0x66, 0xd2, 0x3f, 0xe9, 0xfe, 0x54, 0x91, 0xba, 0x48, 0x14, 0xd3,
+	0xa2, 0x72, 0x71, 0xa5, 0x70, 0xa0, 0x50, 0x0e, 0x38, 0xa6, 0x84, 0x4a, 0x69, 0x25, 0x36, 0x85,
+	0x03, 0x17, 0xcb, 0xb1, 0x27, 0xc1, 0xaa, 0xed, 0x0d, 0xeb, 0x4d, 0x21, 0x2f, 0xc0, 0x33, 0xf0,
+	0x22, 0xdc, 0x78, 0x16, 0xc4, 0xa3, 0xa0, 0xb5, 0xe3, 0x12, 0xe7, 0x4f, 0x6b, 0x95, 0x53, 0xd6,
+	0x3b, 0xdf, 0x7c, 0x33, 0xf3, 0xcd, 0xec, 0x04, 0x36, 0x1c, 0xe4, 0xc2, 0xeb, 0x79, 0xc8, 0xf5,
+	0x01, 0x67, 0x82, 0x91, 0xad, 0xc0, 0xee, 0x07, 0xb6, 0xce, 0xb8, 0x73, 0xc4, 0xf5, 0x6b, 0xa3,
+	0x7a, 0x3f, 0xbe, 0x38, 0x8c, 0x31, 0xd1, 0xe1, 0x94, 0x93, 0xba, 0x9d, 0xb5, 0xb2, 0x20, 0x60,
+	0xe1, 0xd8, 0xb4, 0x93, 0x31, 0x79, 0x2e, 0x86, 0xc2, 0x13, 0xa3, 0xb1, 0xf1, 0x41, 0x9f, 0xb1,
+	0xbe, 0x8f, 0x89, 0xb5, 0x3b, 0xec, 0x1d, 0x0a, 0x2f, 0xc0, 0x48, 0xd8, 0xc1, 0x20, 0x01, 0x68,
+	0xbf, 0x15, 0xd8, 0x30, 0x93, 0x60, 0x8e, 0x2d, 0xf0, 0x34, 0xec, 0x31, 0xb2, 0x0f, 0x45, 0xcf,
+	0xad, 0x2b, 0xbb, 0xca, 0x41, 0xb5, 0xb1, 0xa5, 0x4f, 0xa6, 0x7b, 0x3a, 0x66, 0xa7, 0x45, 0xcf,
+	0x25, 0xcf, 0x01, 0x42, 0x26, 0xac, 0x2e, 0xf6, 0x18, 0xc7, 0x7a, 0x31, 0x86, 0xab, 0x7a, 0x12,
+	0x50, 0x4f, 0x03, 0xea, 0x17, 0x69, 0x40, 0x5a, 0x09, 0x99, 0x68, 0xc6, 0x60, 0xf2, 0x0c, 0xe4,
+	0x87, 0x65, 0xf7, 0x04, 0xf2, 0x7a, 0xe9, 0x56, 0xcf, 0x95, 0x90, 0x09, 0x43, 0x62, 0x49, 0x03,
+	0x2a, 0x52, 0x1a, 0x4b, 0x8c, 0x06, 0x58, 0xff, 0x6f, 0x57, 0x39, 0x58, 0x9f, 0xca, 0x50, 0xd6,
+	0x72, 0x31, 0x1a, 0x20, 0x5d, 0x71, 0xc6, 0x27, 0xed, 0x97, 0x02, 0x64, 0xaa, 0xc4, 0x33, 0x7b,
+	0x40, 0x2c, 0x58, 0x75, 0xfe, 0xde, 0x46, 0x75, 0x65, 0xb7, 0x74, 0x50, 0x6d, 0x1c, 0xeb, 0x73,
+	0xdb, 0xa3, 0xcf, 0x12, 0x4c, 0x5e, 0x45, 0x27, 0xa1, 0xe0, 0x23, 0x9a, 0x21, 0x54, 0xfb, 0xb0,
+	0x39, 0x03, 0x21, 0x35, 0x28, 0x5d, 0xe2, 0x28, 0x16, 0xb7, 0x42, 0xe5, 0x91, 0xbc, 0x84, 0xa5,
+	0x2b, 0xdb, 0x1f, 0xa6, 0x0a, 0x3e, 0xce, 0x97, 0x00, 0x4d, 0x9c, 0x5e, 0x14, 0x8f, 0x14, 0xed,
+	0x9b, 0x02, 0xeb, 0x86, 0xeb, 0x4a, 0x04, 0xc5, 0xcf, 0x43, 0x8c, 0x44, 0xde, 0x16, 0x6e, 0x43,
+	0x2c, 0x93, 0xe5, 0x22, 0x8f, 0xc3, 0xaf, 0xd2, 0x65, 0xf9, 0xfd, 0x7a, 0x5a, 0xe9, 0x52, 0x3e,
+	0xa5, 0x1f, 0xc2, 0x5a, 0x07, 0xb9, 0x67, 0xfb, 0xe7, 0xc3, 0xa0, 0x8b, 0x3c, 0x92, 0xd5, 0x46,
+	0x61, 0x22, 0x6d, 0x85, 0xca, 0xa3, 0xd6, 0x84, 0xd5, 0x16, 0x0a, 0xd3, 0x48, 0x13, 0xcd, 0x84,
+	0x51, 0xf2, 0x85, 0xf9, 0xa9, 0x00, 0xa1, 0x78, 0xc5, 0x2e, 0xd1, 0x9d, 0x50, 0xe5, 0x2e, 0x54,
+	0x72, 0x86, 0x79, 0xc2, 0x64, 0xd9, 0x22, 0xcf, 0x0c, 0x8f, 0xd1, 0x86, 0xb8, 0xf3, 0x0c, 0x6b,
+	0x26, 0xac, 0x49, 0x09, 0x68, 0xfb, 0x5f, 0x34, 0xf8, 0xae, 0x40, 0xc9, 0xa4, 0x6d, 0x72, 0x0f,
+	0x96, 0x1d, 0xee, 0xc7, 0x0d, 0x54, 0xe2, 0x06, 0x96, 0x1d, 0xee, 0xcb, 0xfe, 0x1d, 0x43, 0x55,
+	0x7c, 0xf2, 0x22, 0x6b, 0x38, 0x70, 0x6d, 0x91, 0xe7, 0x79, 0x82, 0x84, 0xbf, 0x8f, 0xd1, 0xd2,
+	0x39, 0xc4, 0xaf, 0x22, 0x75, 0xbe, 0xbd, 0x3a, 0x90, 0xf0, 0xc4, 0xb9, 0xf1, 0xa3, 0x0c, 0x15,
+	0x33, 0x9d, 0x5b, 0x62, 0xc2, 0x52, 0xdc, 0x70, 0xf2, 0x68, 0xc1, 0x60, 0x4f, 0x8e, 0x83, 0xfa,
+	0x7f, 0xb6, 0x6e, 0x43, 0xf2, 0x68, 0x05, 0xd2, 0x04, 0xd2, 0xf1, 0xfa, 0xa1, 0xe1, 0x66, 0x1a,
+	0x5e, 0xcb, 0x82, 0x3b, 0x54, 0xad, 0xcf, 0xc8, 0x36, 0xc6, 0x6a, 0x05, 0x72, 0x01, 0xd5, 0x16,
+	0x8a, 0x74, 0xfc, 0xc9, 0xce, 0x22, 0xa8, 0xde, 0x39, 0x57, 0x73, 0x3e, 0x42, 0xad, 0x40, 0x5e,
+	0x41, 0x8d, 0x62, 0x88, 0x5f, 0xee, 0x9e, 0xd7, 0x09, 0x6c, 0x26, 0xc3, 0x3c, 0x49, 0x71, 0x63,
+	0x76, 0x9b, 0x19, 0xe3, 0x07, 0xe6, 0xb9, 0x5a, 0x81, 0xb4, 0xaf, 0x77, 0x40, 0xca, 0xb1, 0xbf,
+	0xa0, 0x88, 0xec, 0xaa, 0x98, 0xcf, 0xf6, 0x0e, 0x6a, 0x6f, 0xbc, 0x70, 0x92, 0x2e, 0x22, 0xf3,
+	0xf7, 0x88, 0xba, 0xb7, 0x20, 0x4c, 0x66, 0x13, 0x68, 0x05, 0x72, 0x06, 0xb5, 0xb6, 0x17, 0x89,
+	0x0c, 0xe5, 0x6c, 0xec, 0xdc, 0x74, 0x6f, 0xa1, 0xdc, 0x42, 0x61, 0xf8, 0xfe, 0x3c, 0x92, 0x27,
+	0xb9, 0xb7, 0x78, 0xac, 0x5c, 0x39, 0x79, 0x8f, 0x64, 0xef, 0x86, 0x11, 0xbd, 0x7e, 0xae, 0xaa,
+	0xba, 0x88, 0x9c, 0xb6, 0xb5, 0x02, 0x39, 0x82, 0x75, 0x93, 0xf9, 0x3e, 0x3a, 0xa2, 0x65, 0xf3,
+	0xae, 0xdd, 0xc7, 0x79, 0xf9, 0xcd, 0xd3, 0xbc, 0xb9, 0xf2, 0xb1, 0x9c, 0xfc, 0x89, 0x77, 0x93,
+	0xdf, 0xa7, 0x7f, 0x06, 0x00, 0xa0, 0xd0, 0xbf, 0xb1, 0x3c, 0x08, 0x00, 0x00,
 }
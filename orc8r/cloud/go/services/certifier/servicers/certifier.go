@@ -9,10 +9,13 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 
@@ -27,17 +30,22 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 var (
 	NumTrialsForSn      int
 	CollectGarbageAfter time.Duration // remove cert if expired for certain amount of time
+	RenewalWindow       time.Duration // allow renewal of cert if it expires within this amount of time
+	CRLValidity         time.Duration // how long a published CRL is valid for
 )
 
 func init() {
 	NumTrialsForSn = 1
 	CollectGarbageAfter = time.Duration(time.Hour * 24)
+	RenewalWindow = time.Duration(time.Hour * 24 * 7)
+	CRLValidity = time.Duration(time.Hour)
 }
 
 type CAInfo struct {
@@ -219,21 +227,81 @@ func (srv *CertifierServer) GetCA(ctx context.Context, getCAReqMsg *certprotos.G
 }
 
 func (srv *CertifierServer) SignAddCertificate(ctx context.Context, csrMsg *protos.CSR) (*protos.Certificate, error) {
+	csr, err := parseAndCheckCSR(csrMsg.CsrDer)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "Error parsing CSR: %s", err)
+	}
 
-	sn, err := generateSerialNumber(srv.store, CERTIFICATE_INFO_TABLE)
+	err = checkOrOverwriteCN(csr, csrMsg)
 	if err != nil {
-		return nil, status.Errorf(codes.Aborted, "Error generating serial number: %s", err)
+		return nil, err
+	}
+	return srv.signAddCSR(csr, csrMsg)
+}
+
+// RenewCertificate signs a new certificate for a caller which presents a
+// still valid certificate within its renewal window. The identity and type
+// of the new certificate are taken from the presented certificate rather
+// than from the request.
+func (srv *CertifierServer) RenewCertificate(ctx context.Context, csrMsg *protos.CSR) (*protos.Certificate, error) {
+	if csrMsg == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid renewal request")
+	}
+	currentSN, err := getClientCertSN(ctx)
+	if err != nil {
+		return nil, err
+	}
+	certInfo, err := srv.getCertInfo(currentSN)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.PermissionDenied, "Certificate with serial number '%s' is not registered", currentSN)
+	}
+
+	notBefore, _ := ptypes.Timestamp(certInfo.NotBefore)
+	notAfter, _ := ptypes.Timestamp(certInfo.NotAfter)
+	now := time.Now().UTC()
+	if now.After(notAfter) || now.Before(notBefore) {
+		return nil, status.Errorf(codes.PermissionDenied,
+			"Certificate with serial number '%s' is not valid", currentSN)
+	}
+	renewableAfter := notAfter.Add(-RenewalWindow)
+	if now.Before(renewableAfter) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Certificate with serial number '%s' cannot be renewed before %s", currentSN, renewableAfter)
 	}
 
 	csr, err := parseAndCheckCSR(csrMsg.CsrDer)
 	if err != nil {
-		return nil, status.Errorf(codes.Aborted, "Error parsing CSR: %s", err)
+		return nil, status.Errorf(codes.InvalidArgument, "Error parsing CSR: %s", err)
+	}
+	renewalMsg := &protos.CSR{
+		Id:        certInfo.Id,
+		ValidTime: csrMsg.ValidTime,
+		CsrDer:    csrMsg.CsrDer,
+		CertType:  certInfo.CertType,
+	}
+	// Default to the lifetime of the certificate being renewed
+	if renewalMsg.ValidTime == nil {
+		renewalMsg.ValidTime = ptypes.DurationProto(notAfter.Sub(notBefore))
+	}
+	err = checkOrOverwriteCN(csr, renewalMsg)
+	if err != nil {
+		return nil, err
 	}
 
-	err = checkOrOverwriteCN(csr, csrMsg)
+	certMsg, err := srv.signAddCSR(csr, renewalMsg)
 	if err != nil {
 		return nil, err
 	}
+	glog.V(2).Infof("Renewed certificate %s with %s", currentSN, certMsg.Sn.Sn)
+	return certMsg, nil
+}
+
+func (srv *CertifierServer) signAddCSR(csr *x509.CertificateRequest, csrMsg *protos.CSR) (*protos.Certificate, error) {
+	sn, err := generateSerialNumber(srv.store, CERTIFICATE_INFO_TABLE)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "Error generating serial number: %s", err)
+	}
 
 	validTime, err := ptypes.Duration(csrMsg.ValidTime)
 	if err != nil {
@@ -278,6 +346,20 @@ func (srv *CertifierServer) SignAddCertificate(ctx context.Context, csrMsg *prot
 	return &certMsg, nil
 }
 
+// getClientCertSN returns the serial number of the certificate which the
+// caller presented, as forwarded by the proxy in the RPC metadata
+func getClientCertSN(ctx context.Context) (string, error) {
+	ctxMetadata, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "Missing CTX Metadata")
+	}
+	snList := ctxMetadata.Get(identity.CLIENT_CERT_SN_KEY)
+	if len(snList) == 0 || len(strings.TrimLeft(snList[0], "0")) == 0 {
+		return "", status.Errorf(codes.Unauthenticated, "Missing Certificate SN")
+	}
+	return strings.TrimLeft(snList[0], "0"), nil
+}
+
 func (srv *CertifierServer) GetIdentity(
 	ctx context.Context, snMsg *protos.Certificate_SN) (*certprotos.CertificateInfo, error) {
 
@@ -315,10 +397,29 @@ func (srv *CertifierServer) RevokeCertificate(
 	if snMsg != nil {
 		certSN = strings.TrimLeft(snMsg.Sn, "0")
 	}
-	_, _, err := srv.store.Get(CERTIFICATE_INFO_TABLE, certSN)
+	certInfo, err := srv.getCertInfo(certSN)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "Cannot find certificate with SN: %s", certSN)
 	}
+	// Keep track of revoked certificates until they expire, so they can be
+	// published in the CRL
+	notAfter, _ := ptypes.Timestamp(certInfo.NotAfter)
+	if time.Now().Before(notAfter) {
+		revoked := &certprotos.RevokedCertificate{
+			CertType:  certInfo.CertType,
+			RevokedAt: ptypes.TimestampNow(),
+			NotAfter:  certInfo.NotAfter,
+		}
+		marshaledRevoked, err := proto.Marshal(revoked)
+		if err != nil {
+			return nil, status.Errorf(codes.Aborted,
+				"Marshalling error in RevokedCertificate: %s", err)
+		}
+		err = srv.store.Put(CERTIFICATE_REVOKED_TABLE, certSN, marshaledRevoked)
+		if err != nil {
+			return nil, status.Errorf(codes.Aborted, "Failed to record certificate revocation: %s", err)
+		}
+	}
 	err = srv.store.Delete(CERTIFICATE_INFO_TABLE, certSN)
 	if err != nil {
 		return nil, status.Errorf(codes.Aborted, "Failed to delete certificate: %s", err)
@@ -434,6 +535,71 @@ func (srv *CertifierServer) GetAll(context.Context, *protos.Void) (*certprotos.C
 	return res, nil
 }
 
+// GetCRL returns a revocation list of all revoked certificates of the
+// requested type which haven't expired yet, signed by the CA of that type
+func (srv *CertifierServer) GetCRL(ctx context.Context, req *certprotos.GetCRLRequest) (*certprotos.CRL, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid CRL request")
+	}
+	ca, ok := srv.CAs[req.CertType]
+	if !ok {
+		return nil, status.Errorf(
+			codes.NotFound, "No CA found for given cert type: %s", req.CertType.String())
+	}
+	signer, ok := ca.PrivKey.(crypto.Signer)
+	if !ok {
+		return nil, status.Errorf(
+			codes.Internal, "CA private key for cert type %s cannot sign", req.CertType.String())
+	}
+
+	revokedSNs, err := srv.store.ListKeys(CERTIFICATE_REVOKED_TABLE)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal, "Failed to get revoked certificate serial numbers: %s", err)
+	}
+	revokedValues, err := srv.store.GetMany(CERTIFICATE_REVOKED_TABLE, revokedSNs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to load revoked certificates: %s", err)
+	}
+
+	now := time.Now().UTC()
+	revokedCerts := []pkix.RevokedCertificate{}
+	for sn, val := range revokedValues {
+		revoked := &certprotos.RevokedCertificate{}
+		err = proto.Unmarshal(val.Value, revoked)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Failed to unmarshal revoked certificate with serial number %s: %s", sn, err)
+		}
+		notAfter, _ := ptypes.Timestamp(revoked.NotAfter)
+		if revoked.CertType != req.CertType || now.After(notAfter) {
+			continue
+		}
+		serialNumber, ok := new(big.Int).SetString(sn, 16)
+		if !ok {
+			glog.Errorf("Skipping revoked certificate with invalid serial number %s", sn)
+			continue
+		}
+		revokedAt, _ := ptypes.Timestamp(revoked.RevokedAt)
+		revokedCerts = append(revokedCerts, pkix.RevokedCertificate{
+			SerialNumber:   serialNumber,
+			RevocationTime: revokedAt,
+		})
+	}
+	sort.Slice(revokedCerts, func(i, j int) bool {
+		return revokedCerts[i].SerialNumber.Cmp(revokedCerts[j].SerialNumber) < 0
+	})
+
+	nextUpdate := now.Add(CRLValidity)
+	crlDER, err := ca.Cert.CreateCRL(rand.Reader, signer, revokedCerts, now, nextUpdate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create CRL: %s", err)
+	}
+	thisUpdateProto, _ := ptypes.TimestampProto(now)
+	nextUpdateProto, _ := ptypes.TimestampProto(nextUpdate)
+	return &certprotos.CRL{CrlDer: crlDER, ThisUpdate: thisUpdateProto, NextUpdate: nextUpdateProto}, nil
+}
+
 func (srv *CertifierServer) CollectGarbage(ctx context.Context, void *protos.Void) (*protos.Void, error) {
 	res := &protos.Void{}
 	snList, err := srv.ListCertificates(ctx, void)
@@ -464,6 +630,36 @@ func (srv *CertifierServer) CollectGarbage(ctx context.Context, void *protos.Voi
 			}
 		}
 	}
+	// Revoked certificates are published in the CRL only until they expire
+	revokedSNs, err := srv.store.ListKeys(CERTIFICATE_REVOKED_TABLE)
+	if err != nil {
+		return res, status.Errorf(
+			codes.Internal, "Failed to get revoked certificate serial numbers: %s", err)
+	}
+	revokedValues, err := srv.store.GetMany(CERTIFICATE_REVOKED_TABLE, revokedSNs)
+	if err != nil {
+		return res, status.Errorf(codes.Internal, "Failed to load revoked certificates: %s", err)
+	}
+	for sn, val := range revokedValues {
+		revoked := &certprotos.RevokedCertificate{}
+		if err = proto.Unmarshal(val.Value, revoked); err != nil {
+			glog.Errorf("Failed to unmarshal revoked certificate with serial number %s: %v", sn, err)
+			continue
+		}
+		notAfter, _ := ptypes.Timestamp(revoked.NotAfter)
+		notAfter = notAfter.Add(CollectGarbageAfter)
+		if time.Now().UTC().After(notAfter) {
+			err = srv.store.Delete(CERTIFICATE_REVOKED_TABLE, sn)
+			if err != nil {
+				errorList = append(errorList, struct {
+					sn  string
+					err error
+				}{sn, err})
+			} else {
+				count += 1
+			}
+		}
+	}
 	if count > 0 {
 		glog.V(2).Infof("Removed %d stale certificates", count)
 	}
@@ -13,7 +13,10 @@ import (
 	"testing"
 	"time"
 
+	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/cert"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	"magma/orc8r/cloud/go/services/certifier/servicers"
 	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/test_utils"
@@ -22,6 +25,9 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestCertifier(t *testing.T) {
@@ -116,3 +122,142 @@ func TestCertifier(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, cert.Subject.CommonName, *csrMsg.Id.ToCommonName())
 }
+
+func TestCertifier_RenewCertificate(t *testing.T) {
+	ds := test_utils.NewMockDatastore()
+
+	caCert, caKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 100))
+	assert.NoError(t, err)
+	caMap := map[protos.CertType]*servicers.CAInfo{
+		protos.CertType_DEFAULT: {caCert, caKey},
+	}
+	srv, err := servicers.NewCertifierServer(ds, caMap)
+	assert.NoError(t, err)
+
+	defaultRenewalWindow := servicers.RenewalWindow
+	defer func() { servicers.RenewalWindow = defaultRenewalWindow }()
+	servicers.RenewalWindow = time.Duration(time.Hour * 24)
+
+	csrMsg, err := certifier_test_utils.CreateCSR(time.Duration(time.Hour*24*10), "cn", "cn")
+	assert.NoError(t, err)
+	certMsg, err := srv.SignAddCertificate(context.Background(), csrMsg)
+	assert.NoError(t, err)
+	ctx := metadata.NewIncomingContext(
+		context.Background(),
+		metadata.Pairs(identity.CLIENT_CERT_SN_KEY, "00"+certMsg.Sn.Sn))
+
+	// The identity in the renewal request is ignored
+	renewalMsg, err := certifier_test_utils.CreateCSR(time.Duration(time.Hour*24*10), "", "someone_else")
+	assert.NoError(t, err)
+
+	// missing or unknown caller certificate
+	_, err = srv.RenewCertificate(context.Background(), renewalMsg)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = srv.RenewCertificate(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(identity.CLIENT_CERT_SN_KEY, "ABC")),
+		renewalMsg)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// outside of the renewal window
+	_, err = srv.RenewCertificate(ctx, renewalMsg)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	servicers.RenewalWindow = time.Duration(time.Hour * 24 * 30)
+	renewedMsg, err := srv.RenewCertificate(ctx, renewalMsg)
+	assert.NoError(t, err)
+	assert.NotEqual(t, certMsg.Sn.Sn, renewedMsg.Sn.Sn)
+	renewedInfo, err := srv.GetIdentity(context.Background(), renewedMsg.Sn)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(csrMsg.Id, renewedInfo.Id))
+	renewedCert, err := x509.ParseCertificate(renewedMsg.CertDer)
+	assert.NoError(t, err)
+	assert.Equal(t, *csrMsg.Id.ToCommonName(), renewedCert.Subject.CommonName)
+
+	// the current certificate stays valid until it expires or is revoked
+	_, err = srv.GetIdentity(context.Background(), certMsg.Sn)
+	assert.NoError(t, err)
+	_, err = srv.RevokeCertificate(context.Background(), certMsg.Sn)
+	assert.NoError(t, err)
+	_, err = srv.RenewCertificate(ctx, renewalMsg)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestCertifier_GetCRL(t *testing.T) {
+	ds := test_utils.NewMockDatastore()
+	ctx := context.Background()
+
+	caCert, caKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+	caMap := map[protos.CertType]*servicers.CAInfo{
+		protos.CertType_DEFAULT: {caCert, caKey},
+	}
+	srv, err := servicers.NewCertifierServer(ds, caMap)
+	assert.NoError(t, err)
+
+	// no revoked certificates
+	crlMsg, err := srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType_DEFAULT})
+	assert.NoError(t, err)
+	crl, err := x509.ParseCRL(crlMsg.CrlDer)
+	assert.NoError(t, err)
+	assert.NoError(t, caCert.CheckCRLSignature(crl))
+	assert.Empty(t, crl.TBSCertList.RevokedCertificates)
+
+	// revoke one of two certificates
+	var sns []*protos.Certificate_SN
+	for i := 0; i < 2; i++ {
+		csrMsg, err := certifier_test_utils.CreateCSR(time.Duration(time.Hour*24), "cn", "cn")
+		assert.NoError(t, err)
+		certMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+		assert.NoError(t, err)
+		sns = append(sns, certMsg.Sn)
+	}
+	_, err = srv.RevokeCertificate(ctx, sns[0])
+	assert.NoError(t, err)
+
+	crlMsg, err = srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType_DEFAULT})
+	assert.NoError(t, err)
+	crl, err = x509.ParseCRL(crlMsg.CrlDer)
+	assert.NoError(t, err)
+	assert.NoError(t, caCert.CheckCRLSignature(crl))
+	assert.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+	assert.Equal(t, sns[0].Sn, cert.SerialToString(crl.TBSCertList.RevokedCertificates[0].SerialNumber))
+
+	// unknown CA
+	_, err = srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType_VPN})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// expired revoked certificates are dropped from the CRL and collected
+	csrMsg, err := certifier_test_utils.CreateCSR(time.Duration(time.Hour*2), "cn", "cn")
+	assert.NoError(t, err)
+	certMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	_, err = srv.RevokeCertificate(ctx, certMsg.Sn)
+	assert.NoError(t, err)
+	revokedSNs, _ := ds.ListKeys(servicers.CERTIFICATE_REVOKED_TABLE)
+	assert.Len(t, revokedSNs, 2)
+
+	revoked := &certprotos.RevokedCertificate{}
+	marshaledRevoked, _, err := ds.Get(servicers.CERTIFICATE_REVOKED_TABLE, certMsg.Sn.Sn)
+	assert.NoError(t, err)
+	assert.NoError(t, proto.Unmarshal(marshaledRevoked, revoked))
+	revoked.NotAfter, _ = ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	marshaledRevoked, err = proto.Marshal(revoked)
+	assert.NoError(t, err)
+	assert.NoError(t, ds.Put(servicers.CERTIFICATE_REVOKED_TABLE, certMsg.Sn.Sn, marshaledRevoked))
+
+	crlMsg, err = srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType_DEFAULT})
+	assert.NoError(t, err)
+	crl, err = x509.ParseCRL(crlMsg.CrlDer)
+	assert.NoError(t, err)
+	assert.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+
+	defaultCollectGarbageAfter := servicers.CollectGarbageAfter
+	defer func() { servicers.CollectGarbageAfter = defaultCollectGarbageAfter }()
+	servicers.CollectGarbageAfter = time.Duration(0)
+	_, err = srv.CollectGarbage(ctx, &protos.Void{})
+	assert.NoError(t, err)
+	revokedSNs, _ = ds.ListKeys(servicers.CERTIFICATE_REVOKED_TABLE)
+	assert.Equal(t, []string{sns[0].Sn}, revokedSNs)
+}
@@ -32,6 +32,8 @@ import (
 const (
 	// how often to report checkin status
 	GATEWAY_CHECKIN_STATUS_REPORT_INTERVAL = time.Second * 60
+
+	checkinHistoryRetentionKey = "checkinHistoryRetentionSecs"
 )
 
 func main() {
@@ -48,7 +50,13 @@ func main() {
 		log.Fatalf("Failed to initialize datastore: %s", err)
 	}
 
-	checkinStore, err := store.NewCheckinStore(ds)
+	historyRetention := store.DefaultHistoryRetention
+	if srv.Config != nil {
+		if secs, err := srv.Config.GetIntParam(checkinHistoryRetentionKey); err == nil && secs > 0 {
+			historyRetention = time.Duration(secs) * time.Second
+		}
+	}
+	checkinStore, err := store.NewCheckinStoreWithHistoryRetention(ds, historyRetention)
 	if err != nil {
 		log.Fatalf("Failed to initialize checkin store: %s", err)
 	}
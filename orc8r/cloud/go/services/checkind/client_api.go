@@ -92,3 +92,45 @@ func List(networkID string) (*protos.IDList, error) {
 	defer conn.Close()
 	return client.List(context.Background(), &protos.NetworkID{Id: networkID})
 }
+
+// GetCheckinHistory returns the checkin history of the gateway with logicalID
+// in the network specified by networkID, oldest checkin first
+func GetCheckinHistory(networkID string, logicalID string) (*protos.CheckinHistory, error) {
+	client, conn, err := getCheckindClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ret, err := client.GetCheckinHistory(context.Background(), &protos.GatewayStatusRequest{
+		NetworkId: networkID,
+		LogicalId: logicalID,
+	})
+	switch status.Code(err) {
+	case codes.NotFound:
+		return nil, errors.ErrNotFound
+	default:
+		return ret, err
+	}
+}
+
+// GetConnectivityEvents returns the online/offline transitions of the gateway
+// with logicalID in the network specified by networkID in chronological order
+func GetConnectivityEvents(networkID string, logicalID string) (*protos.ConnectivityEvents, error) {
+	client, conn, err := getCheckindClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ret, err := client.GetConnectivityEvents(context.Background(), &protos.GatewayStatusRequest{
+		NetworkId: networkID,
+		LogicalId: logicalID,
+	})
+	switch status.Code(err) {
+	case codes.NotFound:
+		return nil, errors.ErrNotFound
+	default:
+		return ret, err
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package history derives gateway connectivity events from the checkin
+// history kept by checkind.
+package history
+
+import (
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+)
+
+const (
+	// DefaultCheckinInterval is the checkin interval assumed for gateways
+	// without a configured one
+	DefaultCheckinInterval = time.Second * 60
+
+	// MissedCheckinsUntilOffline is the number of checkin intervals without a
+	// checkin after which a gateway is considered offline
+	MissedCheckinsUntilOffline = 3
+)
+
+// GetOfflineThreshold returns the time without checkins after which a gateway
+// with the given checkin interval is considered offline
+func GetOfflineThreshold(checkinInterval time.Duration) time.Duration {
+	if checkinInterval <= 0 {
+		checkinInterval = DefaultCheckinInterval
+	}
+	return checkinInterval * MissedCheckinsUntilOffline
+}
+
+// GetConnectivityEvents derives the online/offline transitions of a gateway
+// from its checkin records. A gap of more than offlineThreshold between two
+// checkins is an offline period starting at the earlier checkin. If the last
+// checkin is more than offlineThreshold before now, the gateway is still
+// offline.
+func GetConnectivityEvents(
+	records []*protos.CheckinRecord,
+	offlineThreshold time.Duration,
+	now time.Time,
+) []*protos.ConnectivityEvent {
+	if len(records) == 0 {
+		return []*protos.ConnectivityEvent{}
+	}
+	records = append([]*protos.CheckinRecord{}, records...)
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Time < records[j].Time })
+
+	thresholdMs := uint64(offlineThreshold / time.Millisecond)
+	nowMs := uint64(now.UnixNano() / int64(time.Millisecond))
+
+	events := []*protos.ConnectivityEvent{
+		{Type: protos.ConnectivityEvent_ONLINE, Time: records[0].Time},
+	}
+	for i := 1; i < len(records); i++ {
+		previous, current := records[i-1].Time, records[i].Time
+		if current-previous > thresholdMs {
+			events = append(
+				events,
+				&protos.ConnectivityEvent{Type: protos.ConnectivityEvent_OFFLINE, Time: previous},
+				&protos.ConnectivityEvent{Type: protos.ConnectivityEvent_ONLINE, Time: current},
+			)
+		}
+	}
+	lastCheckin := records[len(records)-1].Time
+	if nowMs > lastCheckin && nowMs-lastCheckin > thresholdMs {
+		events = append(events, &protos.ConnectivityEvent{Type: protos.ConnectivityEvent_OFFLINE, Time: lastCheckin})
+	}
+
+	for i, event := range events {
+		end := nowMs
+		if i+1 < len(events) {
+			end = events[i+1].Time
+		}
+		if end > event.Time {
+			event.DurationMs = end - event.Time
+		}
+	}
+	return events
+}
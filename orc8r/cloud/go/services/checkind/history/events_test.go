@@ -0,0 +1,72 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package history_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/checkind/history"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOfflineThreshold(t *testing.T) {
+	assert.Equal(t, 3*time.Minute, history.GetOfflineThreshold(0))
+	assert.Equal(t, 30*time.Second, history.GetOfflineThreshold(10*time.Second))
+}
+
+func TestGetConnectivityEvents(t *testing.T) {
+	threshold := 3 * time.Minute
+	minute := uint64(time.Minute / time.Millisecond)
+	start := uint64(1000000000000)
+	records := func(minutes ...uint64) []*protos.CheckinRecord {
+		ret := []*protos.CheckinRecord{}
+		for _, m := range minutes {
+			ret = append(ret, &protos.CheckinRecord{Time: start + m*minute})
+		}
+		return ret
+	}
+	at := func(minutes uint64) time.Time {
+		return time.Unix(0, int64(start+minutes*minute)*int64(time.Millisecond))
+	}
+	online := protos.ConnectivityEvent_ONLINE
+	offline := protos.ConnectivityEvent_OFFLINE
+
+	// No checkins
+	assert.Empty(t, history.GetConnectivityEvents(nil, threshold, at(0)))
+
+	// Regular checkins, gateway still online
+	events := history.GetConnectivityEvents(records(0, 1, 2, 3), threshold, at(4))
+	assert.Equal(t, []*protos.ConnectivityEvent{
+		{Type: online, Time: start, DurationMs: 4 * minute},
+	}, events)
+
+	// Missed checkins within the threshold don't make the gateway offline
+	events = history.GetConnectivityEvents(records(0, 3, 4), threshold, at(5))
+	assert.Equal(t, []*protos.ConnectivityEvent{
+		{Type: online, Time: start, DurationMs: 5 * minute},
+	}, events)
+
+	// Outage between checkins 2 and 10, out of order records are sorted
+	events = history.GetConnectivityEvents(records(10, 0, 1, 2, 11), threshold, at(12))
+	assert.Equal(t, []*protos.ConnectivityEvent{
+		{Type: online, Time: start, DurationMs: 2 * minute},
+		{Type: offline, Time: start + 2*minute, DurationMs: 8 * minute},
+		{Type: online, Time: start + 10*minute, DurationMs: 2 * minute},
+	}, events)
+
+	// Gateway still offline
+	events = history.GetConnectivityEvents(records(0, 1), threshold, at(10))
+	assert.Equal(t, []*protos.ConnectivityEvent{
+		{Type: online, Time: start, DurationMs: minute},
+		{Type: offline, Time: start + minute, DurationMs: 9 * minute},
+	}, events)
+}
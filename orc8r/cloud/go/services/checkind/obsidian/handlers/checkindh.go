@@ -11,6 +11,9 @@ package handlers
 import (
 	"net/http"
 
+	"magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/services/checkind"
+	"magma/orc8r/cloud/go/services/checkind/obsidian/models"
 	"magma/orc8r/cloud/go/services/magmad"
 	stateh "magma/orc8r/cloud/go/services/state/obsidian/handlers"
 
@@ -19,7 +22,11 @@ import (
 	"magma/orc8r/cloud/go/obsidian/handlers"
 )
 
-const AgStatusUrl = handlers.NETWORKS_ROOT + "/:network_id/gateways/:logical_ag_id/status"
+const (
+	AgStatusUrl             = handlers.NETWORKS_ROOT + "/:network_id/gateways/:logical_ag_id/status"
+	AgCheckinHistoryUrl     = AgStatusUrl + "/history"
+	AgConnectivityEventsUrl = AgStatusUrl + "/connectivity_events"
+)
 
 // GetObsidianHandlers returns all handlers for checkind
 func GetObsidianHandlers() []handlers.Handler {
@@ -47,5 +54,45 @@ func GetObsidianHandlers() []handlers.Handler {
 				return c.JSON(http.StatusOK, &gwStatus)
 			},
 		},
+		{
+			Path:        AgCheckinHistoryUrl,
+			Methods:     handlers.GET,
+			HandlerFunc: getCheckinHistory,
+		},
+		{
+			Path:        AgConnectivityEventsUrl,
+			Methods:     handlers.GET,
+			HandlerFunc: getConnectivityEvents,
+		},
+	}
+}
+
+func getCheckinHistory(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	history, err := checkind.GetCheckinHistory(networkID, c.Param("logical_ag_id"))
+	if err == errors.ErrNotFound {
+		return handlers.HttpError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, models.CheckinRecordsFromProto(history))
+}
+
+func getConnectivityEvents(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	events, err := checkind.GetConnectivityEvents(networkID, c.Param("logical_ag_id"))
+	if err == errors.ErrNotFound {
+		return handlers.HttpError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
 	}
+	return c.JSON(http.StatusOK, models.ConnectivityEventsFromProto(events))
 }
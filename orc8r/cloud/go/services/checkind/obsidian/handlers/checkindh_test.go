@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package handlers_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -17,6 +18,7 @@ import (
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/checkind/obsidian/models"
 	checkindTestInit "magma/orc8r/cloud/go/services/checkind/test_init"
 	"magma/orc8r/cloud/go/services/checkind/test_utils"
 	"magma/orc8r/cloud/go/services/magmad"
@@ -61,6 +63,12 @@ func TestCheckind(t *testing.T) {
 	getGWStatusNoError(t, restPort, testNetworkID, logicalID)
 	getGWStatusNotFoundError(t, restPort, testNetworkID)
 
+	// check in twice through checkind to record a checkin history
+	test_utils.Checkin(t, test_utils.GetCheckinRequestProtoFixture(testAgHwId))
+	test_utils.Checkin(t, test_utils.GetCheckinRequestProtoFixture(testAgHwId))
+	getGWCheckinHistory(t, restPort, testNetworkID, logicalID)
+	getGWConnectivityEvents(t, restPort, testNetworkID, logicalID)
+
 	magmad.ForceRemoveNetwork(testNetworkID)
 }
 
@@ -84,3 +92,39 @@ func getGWStatusNotFoundError(t *testing.T, restPort int, networkID string) {
 	url := getURL(restPort, networkID, "should-not-exist")
 	stateTestUtils.GetGWStatusExpectNotFound(t, url)
 }
+
+func getGWCheckinHistory(t *testing.T, restPort int, networkID string, logicalID string) {
+	status, body, err := tests.RunTest(t, tests.Testcase{
+		Name:                      "Get Gateway Checkin History",
+		Method:                    "GET",
+		Url:                       getURL(restPort, networkID, logicalID) + "/history",
+		Skip_payload_verification: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	var records []*models.CheckinRecord
+	assert.NoError(t, json.Unmarshal([]byte(body), &records))
+	assert.Len(t, records, 2)
+	assert.Equal(t, "0.0.0.0", records[1].Version)
+	assert.Equal(t, uint64(1234), records[1].UptimeSecs)
+	assert.True(t, records[0].CheckinTime <= records[1].CheckinTime)
+
+	status, _, err = tests.SendHttpRequest("GET", getURL(restPort, networkID, "should-not-exist")+"/history", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 404, status)
+}
+
+func getGWConnectivityEvents(t *testing.T, restPort int, networkID string, logicalID string) {
+	status, body, err := tests.RunTest(t, tests.Testcase{
+		Name:                      "Get Gateway Connectivity Events",
+		Method:                    "GET",
+		Url:                       getURL(restPort, networkID, logicalID) + "/connectivity_events",
+		Skip_payload_verification: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	var events []*models.ConnectivityEvent
+	assert.NoError(t, json.Unmarshal([]byte(body), &events))
+	assert.Len(t, events, 1)
+	assert.Equal(t, models.ConnectivityEventTypeONLINE, events[0].Type)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package models
+
+import (
+	"magma/orc8r/cloud/go/protos"
+)
+
+// CheckinRecordsFromProto converts a gateway's checkin history to its REST
+// representation
+func CheckinRecordsFromProto(history *protos.CheckinHistory) []*CheckinRecord {
+	ret := make([]*CheckinRecord, 0, len(history.GetRecords()))
+	for _, record := range history.GetRecords() {
+		ret = append(ret, &CheckinRecord{
+			CheckinTime:        record.Time,
+			UptimeSecs:         record.UptimeSecs,
+			CertExpirationTime: record.CertExpirationTime,
+			Version:            record.Version,
+			CPUUser:            record.CpuUser,
+			CPUSystem:          record.CpuSystem,
+			CPUIdle:            record.CpuIdle,
+			MemTotal:           record.MemTotal,
+			MemAvailable:       record.MemAvailable,
+		})
+	}
+	return ret
+}
+
+// ConnectivityEventsFromProto converts a gateway's connectivity events to
+// their REST representation
+func ConnectivityEventsFromProto(events *protos.ConnectivityEvents) []*ConnectivityEvent {
+	ret := make([]*ConnectivityEvent, 0, len(events.GetEvents()))
+	for _, event := range events.GetEvents() {
+		ret = append(ret, &ConnectivityEvent{
+			Type:       event.Type.String(),
+			Time:       event.Time,
+			DurationMs: event.DurationMs,
+		})
+	}
+	return ret
+}
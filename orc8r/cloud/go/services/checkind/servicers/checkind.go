@@ -22,12 +22,17 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/glog"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/checkind/history"
 	"magma/orc8r/cloud/go/services/checkind/store"
+	"magma/orc8r/cloud/go/services/config"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
 )
 
 type checkindServer struct {
@@ -128,3 +133,44 @@ func (srv *checkindServer) List(ctx context.Context, networkId *protos.NetworkID
 	}
 	return list, err
 }
+
+// Returns the bounded checkin history of the Gateway, oldest checkin first
+func (srv *checkindServer) GetCheckinHistory(ctx context.Context, req *protos.GatewayStatusRequest) (*protos.CheckinHistory, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Nil GatewayStatusRequest")
+	}
+	ret, err := srv.Store.GetCheckinHistory(req)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "No checkin history found")
+	}
+	return ret, err
+}
+
+// Returns the online/offline transitions of the Gateway derived from gaps in
+// its checkin history. A Gateway is considered offline once it missed
+// history.MissedCheckinsUntilOffline of its configured checkin intervals.
+func (srv *checkindServer) GetConnectivityEvents(ctx context.Context, req *protos.GatewayStatusRequest) (*protos.ConnectivityEvents, error) {
+	checkinHistory, err := srv.GetCheckinHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	threshold := history.GetOfflineThreshold(getCheckinInterval(req.NetworkId, req.LogicalId))
+	return &protos.ConnectivityEvents{
+		Events: history.GetConnectivityEvents(checkinHistory.Records, threshold, time.Now()),
+	}, nil
+}
+
+// getCheckinInterval returns the checkin interval of the Gateway's magmad
+// config or 0 if it can't be determined
+func getCheckinInterval(networkId, logicalId string) time.Duration {
+	iConfig, err := config.GetConfig(networkId, magmad_config.MagmadGatewayType, logicalId)
+	if err != nil {
+		glog.V(2).Infof("Failed to get magmad config of gateway %s: %v", logicalId, err)
+		return 0
+	}
+	gatewayConfig, ok := iConfig.(*magmad_protos.MagmadGatewayConfig)
+	if !ok {
+		return 0
+	}
+	return time.Duration(gatewayConfig.GetCheckinInterval()) * time.Second
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const testAgHwId = "Test-AGW-Hw-Id"
@@ -132,4 +134,26 @@ func TestCheckind(t *testing.T) {
 	assert.Equal(t, len(lids.Ids), 2)
 	assert.True(t, (lids.Ids[0] == logicalId || lids.Ids[1] == logicalId))
 	assert.True(t, (lids.Ids[0] == logicalId2 || lids.Ids[1] == logicalId2))
+
+	statusReq := &protos.GatewayStatusRequest{NetworkId: testNetworkId, LogicalId: logicalId}
+	history, err := magmaCheckindClient.GetCheckinHistory(context.Background(), statusReq)
+	assert.NoError(t, err)
+	assert.Len(t, history.Records, 2)
+	assert.Equal(t, readStatus.Time, history.Records[0].Time)
+	assert.Equal(t, repeatStatus.Time, history.Records[1].Time)
+	assert.Equal(t, "1.2.3", history.Records[1].Version)
+	assert.Equal(t, uint64(9876), history.Records[1].CpuSystem)
+
+	// Checkins were a moment apart, so the gateway has been online since
+	// its first checkin
+	events, err := magmaCheckindClient.GetConnectivityEvents(context.Background(), statusReq)
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 1)
+	assert.Equal(t, protos.ConnectivityEvent_ONLINE, events.Events[0].Type)
+	assert.Equal(t, readStatus.Time, events.Events[0].Time)
+
+	_, err = magmaCheckindClient.GetConnectivityEvents(
+		context.Background(),
+		&protos.GatewayStatusRequest{NetworkId: testNetworkId, LogicalId: "should-not-exist"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
 }
@@ -11,19 +11,42 @@ package store
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/checkind/scribe"
 	"magma/orc8r/cloud/go/services/magmad"
+
+	"github.com/golang/glog"
 )
 
-const GatewaysStatusTableName string = "gwstatus"
+const (
+	GatewaysStatusTableName string = "gwstatus"
+	// GatewaysHistoryTableName holds one CheckinRecord per checkin, keyed by
+	// the gateway's logical ID and the checkin time (see historyKey)
+	GatewaysHistoryTableName string = "gwcheckins"
+
+	// DefaultHistoryRetention is how long checkins are kept per gateway
+	DefaultHistoryRetention = 24 * time.Hour
+
+	// historyPageSize is the number of checkin records read or deleted at once.
+	// Pruning lists a page on every checkin, so it is kept small.
+	historyPageSize = 100
+
+	// magmaPackageName is the name of the gateway package whose version is
+	// recorded in the checkin history
+	magmaPackageName = "magma"
+)
 
 var ErrNotFound = errors.New("Status not found")
 
 type CheckinStore struct {
 	store datastore.Api
+	// historyRetention is how long checkins are kept in the gateways' history
+	historyRetention time.Duration
 }
 
 // Validate checks if the store is properly initialized
@@ -34,12 +57,21 @@ func (s *CheckinStore) Validate() error {
 	if s.store == nil {
 		return fmt.Errorf("Nil CheckinStore datastore")
 	}
+	if s.historyRetention <= 0 {
+		return fmt.Errorf("Invalid CheckinStore history retention: %s", s.historyRetention)
+	}
 	return nil
 }
 
 // Create a new Checkin Store
 func NewCheckinStore(ds datastore.Api) (*CheckinStore, error) {
-	s := &CheckinStore{ds}
+	return NewCheckinStoreWithHistoryRetention(ds, DefaultHistoryRetention)
+}
+
+// Create a new Checkin Store which keeps the checkins of the last
+// historyRetention in the gateways' history
+func NewCheckinStoreWithHistoryRetention(ds datastore.Api, historyRetention time.Duration) (*CheckinStore, error) {
+	s := &CheckinStore{store: ds, historyRetention: historyRetention}
 	return s, s.Validate()
 }
 
@@ -47,6 +79,27 @@ func statusTable(networkId string) string {
 	return datastore.GetTableName(networkId, GatewaysStatusTableName)
 }
 
+func historyTable(networkId string) string {
+	return datastore.GetTableName(networkId, GatewaysHistoryTableName)
+}
+
+// historyKey returns the key of a gateway's checkin record. Checkin times are
+// zero padded so that a gateway's records sort oldest first.
+func historyKey(logicalId string, checkinTime uint64) string {
+	return fmt.Sprintf("%s%020d", historyKeyPrefix(logicalId), checkinTime)
+}
+
+func historyKeyPrefix(logicalId string) string {
+	return logicalId + "/"
+}
+
+// parseHistoryKey returns the checkin time of a gateway's checkin record key,
+// or false if the key belongs to another gateway whose ID has the same prefix
+func parseHistoryKey(logicalId string, key string) (uint64, bool) {
+	checkinTime, err := strconv.ParseUint(strings.TrimPrefix(key, historyKeyPrefix(logicalId)), 10, 64)
+	return checkinTime, err == nil
+}
+
 // Updates the given gateway status, the gateway is identified by its hardware
 // ID and UpdateGatewayStatus uses Magmad to map the hardware ID to
 // the gateway's network and logical IDs
@@ -90,6 +143,15 @@ func (s *CheckinStore) UpdateRegisteredGatewayStatus(networkId, logicalId string
 			err, status.Checkin.GatewayId, logicalId,
 		)
 	}
+	// The history is informational, failing to update it must not fail the
+	// checkin
+	err = s.appendCheckinRecord(networkId, logicalId, newCheckinRecord(status))
+	if err != nil {
+		glog.Errorf(
+			"Gateway Checkin History Write Error: %s for GW: %s > %s",
+			err, status.Checkin.GatewayId, logicalId,
+		)
+	}
 	// update checkin status successful, log status to Scribe
 	go scribe.LogGatewayStatusToScribe(status, networkId, logicalId)
 	return nil
@@ -125,10 +187,14 @@ func (s *CheckinStore) DeleteGatewayStatus(req *protos.GatewayStatusRequest) err
 	if req == nil {
 		return fmt.Errorf("Nil Gateway Status Request")
 	}
-	return s.store.Delete(statusTable(req.NetworkId), req.LogicalId)
+	err := s.store.Delete(statusTable(req.NetworkId), req.LogicalId)
+	if err != nil {
+		return err
+	}
+	return s.deleteCheckinRecords(req.NetworkId, req.LogicalId, func(uint64) bool { return true })
 }
 
-// DeleteNetworkTable deletes the status table for a given network.
+// DeleteNetworkTable deletes the status and history tables for a given network.
 // The table must be empty prior to call to DeleteNetworkTable
 // DeleteNetworkTable relies only on it's own DB table and does not use any
 // external DBs or services
@@ -140,10 +206,148 @@ func (s *CheckinStore) DeleteNetworkTable(networkId string) error {
 	if len(allKeys) > 0 {
 		return fmt.Errorf("Status table for network %s is not empty", networkId)
 	}
-	return s.store.DeleteTable(statusTable(networkId))
+	err = s.store.DeleteTable(statusTable(networkId))
+	if err != nil {
+		return err
+	}
+	return s.store.DeleteTable(historyTable(networkId))
 }
 
 // List all logical gateway IDs for a given network.
 func (s *CheckinStore) List(networkId string) ([]string, error) {
 	return s.store.ListKeys(statusTable(networkId))
 }
+
+// GetCheckinHistory returns the checkin history of the gateway given its
+// network and logical IDs, oldest checkin first
+// GetCheckinHistory relies only on it's own DB table and does not use any
+// external DBs or services
+func (s *CheckinStore) GetCheckinHistory(req *protos.GatewayStatusRequest) (*protos.CheckinHistory, error) {
+	if req == nil {
+		return nil, fmt.Errorf("Nil Gateway Status Request")
+	}
+	history, err := s.getCheckinHistory(req.NetworkId, req.LogicalId)
+	if err == datastore.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Gateway Checkin History Read Error: %s for network: %s, Gateway: %s",
+			err, req.NetworkId, req.LogicalId,
+		)
+	}
+	return history, nil
+}
+
+func (s *CheckinStore) getCheckinHistory(networkId, logicalId string) (*protos.CheckinHistory, error) {
+	history := new(protos.CheckinHistory)
+	criteria := datastore.ListKeysCriteria{KeyPrefix: historyKeyPrefix(logicalId), PageSize: historyPageSize}
+	for {
+		keys, nextToken, err := s.store.ListKeysPage(historyTable(networkId), criteria)
+		if err != nil {
+			return nil, err
+		}
+		marshaledRecords, err := s.store.GetMany(historyTable(networkId), keys)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if _, isRecord := parseHistoryKey(logicalId, key); !isRecord {
+				continue
+			}
+			marshaledRecord, ok := marshaledRecords[key]
+			if !ok {
+				// Pruned since it was listed
+				continue
+			}
+			record := new(protos.CheckinRecord)
+			if err := protos.Unmarshal(marshaledRecord.Value, record); err != nil {
+				return nil, err
+			}
+			history.Records = append(history.Records, record)
+		}
+		if nextToken == "" {
+			break
+		}
+		criteria.PageToken = nextToken
+	}
+	if len(history.Records) == 0 {
+		return nil, datastore.ErrNotFound
+	}
+	return history, nil
+}
+
+// appendCheckinRecord adds the record to the gateway's history and prunes the
+// records older than the history retention
+func (s *CheckinStore) appendCheckinRecord(networkId, logicalId string, record *protos.CheckinRecord) error {
+	marshaledRecord, err := protos.MarshalIntern(record)
+	if err != nil {
+		return err
+	}
+	err = s.store.Put(historyTable(networkId), historyKey(logicalId, record.GetTime()), marshaledRecord)
+	if err != nil {
+		return err
+	}
+	retentionMs := uint64(s.historyRetention / time.Millisecond)
+	if record.GetTime() <= retentionMs {
+		return nil
+	}
+	cutoff := record.GetTime() - retentionMs
+	return s.deleteCheckinRecords(networkId, logicalId, func(checkinTime uint64) bool { return checkinTime < cutoff })
+}
+
+// deleteCheckinRecords deletes the gateway's checkin records, oldest first,
+// until shouldDelete returns false
+func (s *CheckinStore) deleteCheckinRecords(networkId, logicalId string, shouldDelete func(checkinTime uint64) bool) error {
+	criteria := datastore.ListKeysCriteria{KeyPrefix: historyKeyPrefix(logicalId), PageSize: historyPageSize}
+	for {
+		keys, nextToken, err := s.store.ListKeysPage(historyTable(networkId), criteria)
+		if err != nil {
+			return err
+		}
+		keysToDelete := make([]string, 0, len(keys))
+		done := nextToken == ""
+		for _, key := range keys {
+			checkinTime, isRecord := parseHistoryKey(logicalId, key)
+			if !isRecord {
+				continue
+			}
+			if !shouldDelete(checkinTime) {
+				done = true
+				break
+			}
+			keysToDelete = append(keysToDelete, key)
+		}
+		if len(keysToDelete) > 0 {
+			if _, err := s.store.DeleteMany(historyTable(networkId), keysToDelete); err != nil {
+				return err
+			}
+		}
+		if done {
+			return nil
+		}
+		criteria.PageToken = nextToken
+	}
+}
+
+func newCheckinRecord(status *protos.GatewayStatus) *protos.CheckinRecord {
+	checkin := status.GetCheckin()
+	systemStatus := checkin.GetSystemStatus()
+	record := &protos.CheckinRecord{
+		Time:               status.GetTime(),
+		UptimeSecs:         systemStatus.GetUptimeSecs(),
+		CertExpirationTime: status.GetCertExpirationTime(),
+		Version:            checkin.GetMagmaPkgVersion(),
+		CpuUser:            systemStatus.GetCpuUser(),
+		CpuSystem:          systemStatus.GetCpuSystem(),
+		CpuIdle:            systemStatus.GetCpuIdle(),
+		MemTotal:           systemStatus.GetMemTotal(),
+		MemAvailable:       systemStatus.GetMemAvailable(),
+	}
+	for _, pkg := range checkin.GetPlatformInfo().GetPackages() {
+		if pkg.GetName() == magmaPackageName {
+			record.Version = pkg.GetVersion()
+		}
+	}
+	return record
+}
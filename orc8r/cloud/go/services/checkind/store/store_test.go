@@ -91,3 +91,61 @@ func TestCheckinStore(t *testing.T) {
 	// Error since the network is deleted
 	assert.Error(t, err)
 }
+
+func TestCheckinStore_History(t *testing.T) {
+	magmad_test_init.StartTestService(t)
+	logger_test_init.StartTestService(t)
+	store, err := checkin_store.NewCheckinStoreWithHistoryRetention(test_utils.NewMockDatastore(), 1500*time.Millisecond)
+	assert.NoError(t, err)
+
+	_, err = checkin_store.NewCheckinStoreWithHistoryRetention(test_utils.NewMockDatastore(), 0)
+	assert.Error(t, err)
+
+	testNetworkId, err := magmad.RegisterNetwork(
+		&magmad_protos.MagmadNetworkRecord{Name: "Gateway Checkin History Test Network"},
+		"checkind_store_history_test_network")
+	assert.NoError(t, err)
+	logicalId, err :=
+		magmad.RegisterGateway(testNetworkId, &magmad_protos.AccessGatewayRecord{HwId: &protos.AccessGatewayID{Id: testAgHwId}})
+	assert.NoError(t, err)
+	statusReq := &protos.GatewayStatusRequest{NetworkId: testNetworkId, LogicalId: logicalId}
+
+	_, err = store.GetCheckinHistory(statusReq)
+	assert.Equal(t, checkin_store.ErrNotFound, err)
+
+	// Checkins older than 1.5s are pruned, i.e. only the last 2 are kept
+	for i := uint64(1); i <= 3; i++ {
+		status := checkin_test_utils.GetGatewayStatusProtoFixture(testAgHwId)
+		status.Time = i * 1000
+		status.CertExpirationTime = 5000
+		status.Checkin.SystemStatus.UptimeSecs = i
+		err = store.UpdateGatewayStatus(status)
+		assert.NoError(t, err)
+	}
+	history, err := store.GetCheckinHistory(statusReq)
+	assert.NoError(t, err)
+	expectedRecord := &protos.CheckinRecord{
+		CertExpirationTime: 5000,
+		Version:            "0.0.0.0",
+		CpuUser:            31498,
+		CpuSystem:          8361,
+		CpuIdle:            1869111,
+		MemTotal:           1016084,
+	}
+	expected := &protos.CheckinHistory{}
+	for i := uint64(2); i <= 3; i++ {
+		record := *expectedRecord
+		record.Time = i * 1000
+		record.UptimeSecs = i
+		expected.Records = append(expected.Records, &record)
+	}
+	assert.Equal(t, expected, history)
+
+	// History is deleted with the gateway status
+	err = store.DeleteGatewayStatus(statusReq)
+	assert.NoError(t, err)
+	_, err = store.GetCheckinHistory(statusReq)
+	assert.Equal(t, checkin_store.ErrNotFound, err)
+	err = store.DeleteNetworkTable(testNetworkId)
+	assert.NoError(t, err)
+}
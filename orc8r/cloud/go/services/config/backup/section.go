@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package backup provides the network backup section for the config service
+package backup
+
+import (
+	"encoding/json"
+	"sort"
+
+	"magma/orc8r/cloud/go/backup"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/config"
+)
+
+const SectionName = "configs"
+
+// ConfigsSection archives all configs in a network whose type has a
+// registered config manager. This covers network configs as well as gateway
+// and other entity configs of every module.
+type ConfigsSection struct{}
+
+type archivedConfig struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+	// Value is the config serialized by its config manager
+	Value []byte `json:"value"`
+}
+
+func (*ConfigsSection) GetName() string {
+	return SectionName
+}
+
+func (*ConfigsSection) Export(networkID string) (json.RawMessage, error) {
+	ret := []archivedConfig{}
+	for _, configType := range serde.GetRegisteredTypes(config.SerdeDomain) {
+		configs, err := config.GetConfigsByType(networkID, configType)
+		if err != nil {
+			return nil, err
+		}
+		for tk, value := range configs {
+			marshaledValue, err := serde.Serialize(config.SerdeDomain, tk.Type, value)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, archivedConfig{Type: tk.Type, Key: tk.Key, Value: marshaledValue})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Type != ret[j].Type {
+			return ret[i].Type < ret[j].Type
+		}
+		return ret[i].Key < ret[j].Key
+	})
+	return json.Marshal(ret)
+}
+
+func (*ConfigsSection) Import(ctx backup.ImportContext, data json.RawMessage) error {
+	var configs []archivedConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return err
+	}
+	for _, cfg := range configs {
+		if ctx.IsSkippedGateway(cfg.Key) {
+			continue
+		}
+		value, err := serde.Deserialize(config.SerdeDomain, cfg.Type, cfg.Value)
+		if err != nil {
+			return err
+		}
+		if err := config.CreateConfig(ctx.NetworkID, cfg.Type, ctx.RewriteKey(cfg.Key), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*ConfigsSection) Clear(networkID string) error {
+	return config.DeleteAllNetworkConfigs(networkID)
+}
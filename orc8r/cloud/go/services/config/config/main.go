@@ -18,6 +18,7 @@ import (
 	"magma/orc8r/cloud/go/services/config/protos"
 	"magma/orc8r/cloud/go/services/config/servicers"
 	"magma/orc8r/cloud/go/services/config/storage"
+	configurator_storage "magma/orc8r/cloud/go/services/configurator/storage"
 	"magma/orc8r/cloud/go/sql_utils"
 )
 
@@ -33,7 +34,14 @@ func main() {
 	}
 	store := storage.NewSqlConfigurationStorage(db)
 
-	servicer := servicers.NewConfigService(store)
+	// Mirror all configs to configurator so it can serve gateway mconfigs
+	configuratorFactory := configurator_storage.NewSQLConfiguratorStorageFactory(db, &configurator_storage.DefaultIDGenerator{})
+	err = configuratorFactory.InitializeServiceStorage()
+	if err != nil {
+		log.Fatalf("Failed to initialize configurator storage: %s", err)
+	}
+
+	servicer := servicers.NewConfigServiceWithConfigurator(store, configuratorFactory)
 	protos.RegisterConfigServiceServer(srv.GrpcServer, servicer)
 	err = srv.Run()
 	if err != nil {
@@ -0,0 +1,81 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"sort"
+
+	cfg_storage "magma/orc8r/cloud/go/services/configurator/storage"
+	mstore "magma/orc8r/cloud/go/storage"
+)
+
+// BackfillConfigurator makes configurator's storage match all configs of a
+// network in the config service, keyed by type and key. Configs written
+// before the config service mirrored its writes to configurator, or whose
+// mirroring failed, are written, and configurator entities and network
+// configs which no longer exist in the config service are deleted. It is
+// safe to run again.
+func BackfillConfigurator(configuratorFactory cfg_storage.ConfiguratorStorageFactory, networkID string, configs map[mstore.TypeAndKey][]byte) error {
+	w := &configuratorWriter{factory: configuratorFactory}
+
+	// Write configs in a stable order so that failures are reproducible
+	tks := make([]mstore.TypeAndKey, 0, len(configs))
+	for tk := range configs {
+		tks = append(tks, tk)
+	}
+	sort.Slice(tks, func(i, j int) bool { return tks[i].String() < tks[j].String() })
+	for _, tk := range tks {
+		if err := w.writeConfig(networkID, tk.Type, tk.Key, configs[tk]); err != nil {
+			return err
+		}
+	}
+	return w.deleteConfigsExcept(networkID, configs)
+}
+
+// deleteConfigsExcept deletes the network configs and entities of the
+// configurator network which are not among configs
+func (w *configuratorWriter) deleteConfigsExcept(networkID string, configs map[mstore.TypeAndKey][]byte) error {
+	return w.doInTransaction(func(store cfg_storage.ConfiguratorStorage) error {
+		networks, err := store.LoadNetworks([]string{networkID}, cfg_storage.NetworkLoadCriteria{LoadConfigs: true})
+		if err != nil {
+			return err
+		}
+		if len(networks.Networks) == 0 {
+			return nil
+		}
+
+		configsToDelete := []string{}
+		for configType := range networks.Networks[0].Configs {
+			if _, ok := configs[mstore.TypeAndKey{Type: configType, Key: networkID}]; !ok {
+				configsToDelete = append(configsToDelete, configType)
+			}
+		}
+		if len(configsToDelete) > 0 {
+			err = updateNetwork(store, cfg_storage.NetworkUpdateCriteria{ID: networkID, ConfigsToDelete: configsToDelete})
+			if err != nil {
+				return err
+			}
+		}
+
+		ents, err := store.LoadEntities(networkID, cfg_storage.EntityLoadFilter{}, cfg_storage.EntityLoadCriteria{})
+		if err != nil {
+			return err
+		}
+		for _, ent := range ents.Entities {
+			if _, ok := configs[ent.GetTypeAndKey()]; ok {
+				continue
+			}
+			_, err = store.UpdateEntity(networkID, cfg_storage.EntityUpdateCriteria{Type: ent.Type, Key: ent.Key, DeleteEntity: true})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
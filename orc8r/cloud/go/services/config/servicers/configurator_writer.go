@@ -0,0 +1,271 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+	"fmt"
+
+	"magma/orc8r/cloud/go/services/config/storage"
+	cfg_storage "magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/services/streamer/mconfig/factory"
+	mstore "magma/orc8r/cloud/go/storage"
+)
+
+// configuratorWriter mirrors the writes to the config service into
+// configurator's storage, so that gateways can be served mconfigs built from
+// configurator's entity graph while the config service remains the source of
+// truth.
+//
+// Network configs are stored under the network ID by convention, and are
+// written as configs of the configurator network. All other configs are
+// written as entities of the config's type and key. The edges between those
+// entities are declared by the registered mconfig builders (see
+// factory.EntityAssociator). Edges to entities which do not exist yet are
+// added once they are created.
+type configuratorWriter struct {
+	factory cfg_storage.ConfiguratorStorageFactory
+}
+
+func (w *configuratorWriter) writeConfig(networkID string, configType string, key string, value []byte) error {
+	return w.doInTransaction(func(store cfg_storage.ConfiguratorStorage) error {
+		if err := ensureNetworkExists(store, networkID); err != nil {
+			return err
+		}
+		if key == networkID {
+			update := cfg_storage.NetworkUpdateCriteria{
+				ID:                   networkID,
+				ConfigsToAddOrUpdate: map[string][]byte{configType: value},
+			}
+			return updateNetwork(store, update)
+		}
+		return writeEntity(store, networkID, mstore.TypeAndKey{Type: configType, Key: key}, value)
+	})
+}
+
+func (w *configuratorWriter) deleteConfig(networkID string, configType string, key string) error {
+	return w.deleteConfigs(networkID, &storage.FilterCriteria{Type: configType, Key: key})
+}
+
+func (w *configuratorWriter) deleteConfigs(networkID string, filter *storage.FilterCriteria) error {
+	return w.doInTransaction(func(store cfg_storage.ConfiguratorStorage) error {
+		networks, err := store.LoadNetworks([]string{networkID}, cfg_storage.NetworkLoadCriteria{LoadConfigs: true})
+		if err != nil {
+			return err
+		}
+		if len(networks.Networks) == 0 {
+			return nil
+		}
+
+		if filter.Key == "" || filter.Key == networkID {
+			configsToDelete := []string{}
+			for configType := range networks.Networks[0].Configs {
+				if filter.Type == "" || filter.Type == configType {
+					configsToDelete = append(configsToDelete, configType)
+				}
+			}
+			if len(configsToDelete) > 0 {
+				err = updateNetwork(store, cfg_storage.NetworkUpdateCriteria{ID: networkID, ConfigsToDelete: configsToDelete})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		entFilter := cfg_storage.EntityLoadFilter{}
+		if filter.Type != "" {
+			entFilter.TypeFilter = &filter.Type
+		}
+		if filter.Key != "" {
+			entFilter.KeyFilter = &filter.Key
+		}
+		ents, err := store.LoadEntities(networkID, entFilter, cfg_storage.EntityLoadCriteria{})
+		if err != nil {
+			return err
+		}
+		for _, ent := range ents.Entities {
+			_, err = store.UpdateEntity(networkID, cfg_storage.EntityUpdateCriteria{Type: ent.Type, Key: ent.Key, DeleteEntity: true})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (w *configuratorWriter) deleteNetwork(networkID string) error {
+	return w.doInTransaction(func(store cfg_storage.ConfiguratorStorage) error {
+		return updateNetwork(store, cfg_storage.NetworkUpdateCriteria{ID: networkID, DeleteNetwork: true})
+	})
+}
+
+func (w *configuratorWriter) doInTransaction(f func(store cfg_storage.ConfiguratorStorage) error) error {
+	store, err := w.factory.StartTransaction(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("Failed to start configurator transaction: %s", err)
+	}
+	if err = f(store); err != nil {
+		store.Rollback()
+		return err
+	}
+	return store.Commit()
+}
+
+func ensureNetworkExists(store cfg_storage.ConfiguratorStorage, networkID string) error {
+	networks, err := store.LoadNetworks([]string{networkID}, cfg_storage.NetworkLoadCriteria{})
+	if err != nil {
+		return err
+	}
+	if len(networks.Networks) > 0 {
+		return nil
+	}
+	_, err = store.CreateNetwork(cfg_storage.Network{ID: networkID, Name: networkID})
+	return err
+}
+
+func updateNetwork(store cfg_storage.ConfiguratorStorage, update cfg_storage.NetworkUpdateCriteria) error {
+	failures, err := store.UpdateNetworks([]cfg_storage.NetworkUpdateCriteria{update})
+	if failure, failed := failures[update.ID]; failed {
+		return failure
+	}
+	return err
+}
+
+// writeEntity creates or updates the entity of a config, and then adds the
+// edges to and from it which the mconfig builders declare and whose other
+// entity exists. When the config of an existing entity changes, the edges
+// which only its old config declared are removed.
+func writeEntity(store cfg_storage.ConfiguratorStorage, networkID string, tk mstore.TypeAndKey, value []byte) error {
+	loaded, err := store.LoadEntities(networkID, cfg_storage.EntityLoadFilter{IDs: []mstore.TypeAndKey{tk}}, cfg_storage.EntityLoadCriteria{LoadConfig: true})
+	if err != nil {
+		return err
+	}
+	isNew := len(loaded.Entities) == 0
+
+	edges, err := factory.GetEntityAssociations(tk.Type, tk.Key, value)
+	if err != nil {
+		return err
+	}
+	staleEdges := []cfg_storage.GraphEdge{}
+	if isNew {
+		// Other entities' configs may declare edges to the new entity
+		otherEdges, err := getEdgesDeclaredByOtherEntities(store, networkID, tk)
+		if err != nil {
+			return err
+		}
+		edges = append(edges, otherEdges...)
+
+		_, err = store.CreateEntity(networkID, cfg_storage.NetworkEntity{Type: tk.Type, Key: tk.Key, Config: value})
+		if err != nil {
+			return err
+		}
+	} else {
+		oldEdges, err := factory.GetEntityAssociations(tk.Type, tk.Key, loaded.Entities[0].Config)
+		if err != nil {
+			return err
+		}
+		staleEdges = subtractEdges(oldEdges, edges)
+
+		_, err = store.UpdateEntity(networkID, cfg_storage.EntityUpdateCriteria{Type: tk.Type, Key: tk.Key, NewConfig: &value})
+		if err != nil {
+			return err
+		}
+	}
+
+	edges, err = getEdgesBetweenExistingEntities(store, networkID, edges)
+	if err != nil {
+		return err
+	}
+	for _, edge := range edges {
+		_, err = store.UpdateEntity(networkID, cfg_storage.EntityUpdateCriteria{
+			Type:              edge.From.Type,
+			Key:               edge.From.Key,
+			AssociationsToAdd: []mstore.TypeAndKey{edge.To},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	staleEdges, err = getEdgesBetweenExistingEntities(store, networkID, staleEdges)
+	if err != nil {
+		return err
+	}
+	for _, edge := range staleEdges {
+		_, err = store.UpdateEntity(networkID, cfg_storage.EntityUpdateCriteria{
+			Type:                 edge.From.Type,
+			Key:                  edge.From.Key,
+			AssociationsToDelete: []mstore.TypeAndKey{edge.To},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getEdgesDeclaredByOtherEntities returns the edges to or from tk which the
+// configs of the other entities in the network declare
+func getEdgesDeclaredByOtherEntities(store cfg_storage.ConfiguratorStorage, networkID string, tk mstore.TypeAndKey) ([]cfg_storage.GraphEdge, error) {
+	ents, err := store.LoadEntities(networkID, cfg_storage.EntityLoadFilter{}, cfg_storage.EntityLoadCriteria{LoadConfig: true})
+	if err != nil {
+		return nil, err
+	}
+	ret := []cfg_storage.GraphEdge{}
+	for _, ent := range ents.Entities {
+		edges, err := factory.GetEntityAssociations(ent.Type, ent.Key, ent.Config)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range edges {
+			if edge.From == tk || edge.To == tk {
+				ret = append(ret, edge)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func getEdgesBetweenExistingEntities(store cfg_storage.ConfiguratorStorage, networkID string, edges []cfg_storage.GraphEdge) ([]cfg_storage.GraphEdge, error) {
+	if len(edges) == 0 {
+		return edges, nil
+	}
+	tks := make([]mstore.TypeAndKey, 0, 2*len(edges))
+	for _, edge := range edges {
+		tks = append(tks, edge.From, edge.To)
+	}
+	loaded, err := store.LoadEntities(networkID, cfg_storage.EntityLoadFilter{IDs: tks}, cfg_storage.EntityLoadCriteria{})
+	if err != nil {
+		return nil, err
+	}
+	existing := map[mstore.TypeAndKey]bool{}
+	for _, ent := range loaded.Entities {
+		existing[ent.GetTypeAndKey()] = true
+	}
+	ret := []cfg_storage.GraphEdge{}
+	for _, edge := range edges {
+		if existing[edge.From] && existing[edge.To] {
+			ret = append(ret, edge)
+		}
+	}
+	return ret, nil
+}
+
+func subtractEdges(edges []cfg_storage.GraphEdge, edgesToSubtract []cfg_storage.GraphEdge) []cfg_storage.GraphEdge {
+	toSubtract := map[cfg_storage.GraphEdge]bool{}
+	for _, edge := range edgesToSubtract {
+		toSubtract[edge] = true
+	}
+	ret := []cfg_storage.GraphEdge{}
+	for _, edge := range edges {
+		if !toSubtract[edge] {
+			ret = append(ret, edge)
+		}
+	}
+	return ret
+}
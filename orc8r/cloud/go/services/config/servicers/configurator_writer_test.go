@@ -0,0 +1,219 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"magma/orc8r/cloud/go/services/config/protos"
+	"magma/orc8r/cloud/go/services/config/servicers"
+	"magma/orc8r/cloud/go/services/config/storage/mocks"
+	cfg_storage "magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/services/streamer/mconfig/factory"
+	"magma/orc8r/cloud/go/sql_utils"
+	mstore "magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/protobuf/proto"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockIDGenerator struct {
+	count int
+}
+
+func (g *mockIDGenerator) New() string {
+	g.count++
+	return fmt.Sprintf("%d", g.count)
+}
+
+// mockAssociator associates "child" entities with the "gw" entity of the
+// same key, and "child" entities with the "enb" entities listed in their
+// comma-separated configs
+type mockAssociator struct{}
+
+func (*mockAssociator) Build(networkId string, gatewayId string) (map[string]proto.Message, error) {
+	return map[string]proto.Message{}, nil
+}
+
+func (*mockAssociator) BuildFromGraph(network cfg_storage.Network, graph cfg_storage.EntityGraph, gatewayId string) (map[string]proto.Message, error) {
+	return map[string]proto.Message{}, nil
+}
+
+func (*mockAssociator) GetAssociations(configType string, key string, config []byte) ([]cfg_storage.GraphEdge, error) {
+	if configType != "child" {
+		return nil, nil
+	}
+	child := mstore.TypeAndKey{Type: "child", Key: key}
+	ret := []cfg_storage.GraphEdge{{From: mstore.TypeAndKey{Type: "gw", Key: key}, To: child}}
+	for _, enb := range strings.Split(string(config), ",") {
+		if enb != "" {
+			ret = append(ret, cfg_storage.GraphEdge{From: child, To: mstore.TypeAndKey{Type: "enb", Key: enb}})
+		}
+	}
+	return ret, nil
+}
+
+func TestConfigService_Configurator(t *testing.T) {
+	factory.ClearMconfigBuilders(t)
+	factory.RegisterMconfigBuilder(&mockAssociator{})
+
+	db, err := sql_utils.Open("sqlite3", ":memory:?_foreign_keys=1")
+	assert.NoError(t, err)
+	configuratorFactory := cfg_storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{})
+	assert.NoError(t, configuratorFactory.InitializeServiceStorage())
+
+	store := &mocks.ConfigurationStorage{}
+	store.On("CreateConfig", "network", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	store.On("UpdateConfig", "network", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	store.On("DeleteConfig", "network", mock.Anything, mock.Anything).Return(nil)
+	store.On("DeleteConfigsForNetwork", "network").Return(nil)
+	service := servicers.NewConfigServiceWithConfigurator(store, configuratorFactory)
+
+	ctx := context.Background()
+	createConfig := func(configType string, key string, value string) {
+		req := &protos.CreateOrUpdateConfigRequest{NetworkId: "network", Type: configType, Key: key, Value: []byte(value)}
+		_, err := service.CreateConfig(ctx, req)
+		assert.NoError(t, err)
+	}
+
+	// Network configs are written to the network, the configurator network
+	// is created on the first write
+	createConfig("network_type", "network", "network_config")
+	network := loadNetwork(t, configuratorFactory)
+	assert.Equal(t, map[string][]byte{"network_type": []byte("network_config")}, network.Configs)
+
+	// Edges are added as soon as both entities exist, whichever is created
+	// first
+	createConfig("child", "gw1", "enb1,enb2")
+	createConfig("gw", "gw1", "gw_config")
+	createConfig("enb", "enb1", "enb1_config")
+	assert.Equal(t, []string{"child-gw1", "enb-enb1", "gw-gw1"}, loadGraphEntities(t, configuratorFactory))
+
+	createConfig("enb", "enb2", "enb2_config")
+	assert.Equal(t, []string{"child-gw1", "enb-enb1", "enb-enb2", "gw-gw1"}, loadGraphEntities(t, configuratorFactory))
+
+	// Edges only the old config declared are removed on update
+	req := &protos.CreateOrUpdateConfigRequest{NetworkId: "network", Type: "child", Key: "gw1", Value: []byte("enb2")}
+	_, err = service.UpdateConfig(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"child-gw1", "enb-enb2", "gw-gw1"}, loadGraphEntities(t, configuratorFactory))
+
+	_, err = service.DeleteConfig(ctx, &protos.GetOrDeleteConfigRequest{NetworkId: "network", Type: "child", Key: "gw1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gw-gw1"}, loadGraphEntities(t, configuratorFactory))
+
+	_, err = service.DeleteConfig(ctx, &protos.GetOrDeleteConfigRequest{NetworkId: "network", Type: "network_type", Key: "network"})
+	assert.NoError(t, err)
+	network = loadNetwork(t, configuratorFactory)
+	assert.Empty(t, network.Configs)
+
+	_, err = service.DeleteAllConfigsForNetwork(ctx, &protos.NetworkIdRequest{NetworkId: "network"})
+	assert.NoError(t, err)
+	tx, err := configuratorFactory.StartTransaction(ctx, nil)
+	assert.NoError(t, err)
+	networks, err := tx.LoadNetworks([]string{"network"}, cfg_storage.FullNetworkLoadCriteria)
+	assert.NoError(t, err)
+	assert.Empty(t, networks.Networks)
+	assert.NoError(t, tx.Commit())
+	store.AssertExpectations(t)
+}
+
+func loadNetwork(t *testing.T, configuratorFactory cfg_storage.ConfiguratorStorageFactory) cfg_storage.Network {
+	tx, err := configuratorFactory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	defer tx.Commit()
+	networks, err := tx.LoadNetworks([]string{"network"}, cfg_storage.FullNetworkLoadCriteria)
+	assert.NoError(t, err)
+	assert.Len(t, networks.Networks, 1)
+	return networks.Networks[0]
+}
+
+func loadGraphEntities(t *testing.T, configuratorFactory cfg_storage.ConfiguratorStorageFactory) []string {
+	tx, err := configuratorFactory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	defer tx.Commit()
+	graph, err := tx.LoadGraphForEntity("network", mstore.TypeAndKey{Type: "gw", Key: "gw1"}, cfg_storage.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	ret := []string{}
+	for _, ent := range graph.Entities {
+		ret = append(ret, ent.GetTypeAndKey().String())
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func TestConfigService_ConfiguratorError(t *testing.T) {
+	db, err := sql_utils.Open("sqlite3", ":memory:?_foreign_keys=1")
+	assert.NoError(t, err)
+	configuratorFactory := cfg_storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{})
+	assert.NoError(t, configuratorFactory.InitializeServiceStorage())
+	db.Close()
+
+	store := &mocks.ConfigurationStorage{}
+	store.On("CreateConfig", "network", "gw", "gw1", []byte("gw_config")).Return(nil)
+	service := servicers.NewConfigServiceWithConfigurator(store, configuratorFactory)
+
+	// The config is persisted but the caller learns that configurator is
+	// out of sync
+	req := &protos.CreateOrUpdateConfigRequest{NetworkId: "network", Type: "gw", Key: "gw1", Value: []byte("gw_config")}
+	_, err = service.CreateConfig(context.Background(), req)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Error writing config to configurator")
+	store.AssertExpectations(t)
+}
+
+func TestBackfillConfigurator(t *testing.T) {
+	factory.ClearMconfigBuilders(t)
+	factory.RegisterMconfigBuilder(&mockAssociator{})
+
+	db, err := sql_utils.Open("sqlite3", ":memory:?_foreign_keys=1")
+	assert.NoError(t, err)
+	configuratorFactory := cfg_storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{})
+	assert.NoError(t, configuratorFactory.InitializeServiceStorage())
+
+	// Configs are written with the edges between them regardless of order
+	configs := map[mstore.TypeAndKey][]byte{
+		{Type: "network_type", Key: "network"}: []byte("network_config"),
+		{Type: "child", Key: "gw1"}:            []byte("enb1"),
+		{Type: "gw", Key: "gw1"}:               []byte("gw_config"),
+		{Type: "enb", Key: "enb1"}:             []byte("enb1_config"),
+		{Type: "enb", Key: "enb2"}:             []byte("enb2_config"),
+	}
+	err = servicers.BackfillConfigurator(configuratorFactory, "network", configs)
+	assert.NoError(t, err)
+	network := loadNetwork(t, configuratorFactory)
+	assert.Equal(t, map[string][]byte{"network_type": []byte("network_config")}, network.Configs)
+	assert.Equal(t, []string{"child-gw1", "enb-enb1", "gw-gw1"}, loadGraphEntities(t, configuratorFactory))
+
+	// Running it again updates changed configs and deletes the ones which
+	// are gone
+	configs = map[mstore.TypeAndKey][]byte{
+		{Type: "child", Key: "gw1"}: []byte("enb2"),
+		{Type: "gw", Key: "gw1"}:    []byte("gw_config"),
+		{Type: "enb", Key: "enb2"}:  []byte("enb2_config"),
+	}
+	err = servicers.BackfillConfigurator(configuratorFactory, "network", configs)
+	assert.NoError(t, err)
+	network = loadNetwork(t, configuratorFactory)
+	assert.Empty(t, network.Configs)
+	assert.Equal(t, []string{"child-gw1", "enb-enb2", "gw-gw1"}, loadGraphEntities(t, configuratorFactory))
+
+	tx, err := configuratorFactory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	ents, err := tx.LoadEntities("network", cfg_storage.EntityLoadFilter{}, cfg_storage.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Len(t, ents.Entities, 3)
+	assert.NoError(t, tx.Commit())
+}
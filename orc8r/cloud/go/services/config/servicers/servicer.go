@@ -15,6 +15,8 @@ import (
 	"magma/orc8r/cloud/go/protos"
 	config_protos "magma/orc8r/cloud/go/services/config/protos"
 	"magma/orc8r/cloud/go/services/config/storage"
+	cfg_storage "magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/services/streamer"
 	mstore "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/glog"
@@ -24,13 +26,24 @@ import (
 )
 
 type ConfigService struct {
-	store storage.ConfigurationStorage
+	store        storage.ConfigurationStorage
+	configurator *configuratorWriter
 }
 
 func NewConfigService(store storage.ConfigurationStorage) config_protos.ConfigServiceServer {
 	return &ConfigService{store: store}
 }
 
+// NewConfigServiceWithConfigurator returns a config service which also
+// writes all configs to configurator's storage. Failures to write to
+// configurator fail the request even though the config service's own storage
+// was already updated, so the caller can retry. Configs written before
+// mirroring was enabled, or whose mirroring failed, are copied over by
+// BackfillConfigurator.
+func NewConfigServiceWithConfigurator(store storage.ConfigurationStorage, configuratorFactory cfg_storage.ConfiguratorStorageFactory) config_protos.ConfigServiceServer {
+	return &ConfigService{store: store, configurator: &configuratorWriter{factory: configuratorFactory}}
+}
+
 func (service *ConfigService) GetConfig(context context.Context, req *config_protos.GetOrDeleteConfigRequest) (*config_protos.Config, error) {
 	ret := &config_protos.Config{}
 	if err := config_protos.ValidateGetOrDeleteConfigRequest(req); err != nil {
@@ -91,6 +104,16 @@ func (service *ConfigService) CreateConfig(context context.Context, req *config_
 		glog.Errorf(msgFormat, err)
 		return ret, status.Errorf(codes.Aborted, msgFormat, err)
 	}
+	err = service.mirrorToConfigurator(func(w *configuratorWriter) error {
+		return w.writeConfig(req.GetNetworkId(), req.GetType(), req.GetKey(), req.GetValue())
+	})
+	if err != nil {
+		return ret, err
+	}
+	// Configs feed into gateway mconfigs, so let persistent mconfig streams
+	// know about the change. This happens after the configurator write so the
+	// streams see the change when they rebuild mconfigs from the graph.
+	streamer.NotifyStreamUpdateAsync(streamer.MconfigStreamName, req.GetNetworkId())
 	return ret, nil
 }
 
@@ -106,6 +129,13 @@ func (service *ConfigService) UpdateConfig(context context.Context, req *config_
 		glog.Errorf(msgFormat, err)
 		return ret, status.Errorf(codes.Aborted, msgFormat, err)
 	}
+	err = service.mirrorToConfigurator(func(w *configuratorWriter) error {
+		return w.writeConfig(req.GetNetworkId(), req.GetType(), req.GetKey(), req.GetValue())
+	})
+	if err != nil {
+		return ret, err
+	}
+	streamer.NotifyStreamUpdateAsync(streamer.MconfigStreamName, req.GetNetworkId())
 	return ret, nil
 }
 
@@ -121,6 +151,13 @@ func (service *ConfigService) DeleteConfig(context context.Context, req *config_
 		glog.Errorf(msgFormat, err)
 		return ret, status.Errorf(codes.Aborted, msgFormat, err)
 	}
+	err = service.mirrorToConfigurator(func(w *configuratorWriter) error {
+		return w.deleteConfig(req.GetNetworkId(), req.GetType(), req.GetKey())
+	})
+	if err != nil {
+		return ret, err
+	}
+	streamer.NotifyStreamUpdateAsync(streamer.MconfigStreamName, req.GetNetworkId())
 	return ret, nil
 }
 
@@ -136,6 +173,13 @@ func (service *ConfigService) DeleteConfigs(context context.Context, req *config
 		glog.Errorf(msgFormat, err)
 		return ret, status.Errorf(codes.Aborted, msgFormat, err)
 	}
+	err = service.mirrorToConfigurator(func(w *configuratorWriter) error {
+		return w.deleteConfigs(req.GetNetworkId(), protoFilterToStorageFilter(req.GetFilter()))
+	})
+	if err != nil {
+		return ret, err
+	}
+	streamer.NotifyStreamUpdateAsync(streamer.MconfigStreamName, req.GetNetworkId())
 	return ret, nil
 }
 
@@ -151,9 +195,28 @@ func (service *ConfigService) DeleteAllConfigsForNetwork(context context.Context
 		glog.Errorf(msgFormat, req.GetNetworkId(), err)
 		return ret, status.Errorf(codes.Aborted, msgFormat, req.GetNetworkId(), err)
 	}
+	err = service.mirrorToConfigurator(func(w *configuratorWriter) error {
+		return w.deleteNetwork(req.GetNetworkId())
+	})
+	if err != nil {
+		return ret, err
+	}
+	streamer.NotifyStreamUpdateAsync(streamer.MconfigStreamName, req.GetNetworkId())
 	return ret, nil
 }
 
+func (service *ConfigService) mirrorToConfigurator(write func(w *configuratorWriter) error) error {
+	if service.configurator == nil {
+		return nil
+	}
+	if err := write(service.configurator); err != nil {
+		msgFormat := "Error writing config to configurator: %s"
+		glog.Errorf(msgFormat, err)
+		return status.Errorf(codes.Aborted, msgFormat, err)
+	}
+	return nil
+}
+
 func protoFilterToStorageFilter(in *config_protos.ConfigFilter) *storage.FilterCriteria {
 	return &storage.FilterCriteria{
 		Type: in.GetType(),
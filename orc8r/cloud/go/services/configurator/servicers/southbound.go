@@ -15,6 +15,13 @@ import (
 	commonProtos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/configurator/protos"
 	"magma/orc8r/cloud/go/services/configurator/storage"
+	magmadconfig "magma/orc8r/cloud/go/services/magmad/config"
+	"magma/orc8r/cloud/go/services/streamer/mconfig/factory"
+	orc8rStorage "magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type sbConfiguratorServicer struct {
@@ -28,6 +35,47 @@ func NewSouthboundConfiguratorServicer(factory storage.ConfiguratorStorageFactor
 	return &sbConfiguratorServicer{factory}, nil
 }
 
+// GetMconfig builds the mconfig for the calling gateway. The gateway is
+// identified from the client identity in the context, and its mconfig is
+// built by running all registered mconfig builders against its network's
+// configs and the entity graph which contains the gateway.
 func (srv *sbConfiguratorServicer) GetMconfig(context context.Context, void *commonProtos.Void) (*commonProtos.GatewayConfigs, error) {
-	return &commonProtos.GatewayConfigs{}, nil
+	gw := commonProtos.GetClientGateway(context)
+	if gw == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "Missing Gateway Identity")
+	}
+	if !gw.Registered() {
+		return nil, status.Errorf(codes.PermissionDenied, "Gateway is not registered")
+	}
+
+	store, err := srv.factory.StartTransaction(context, &storage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start transaction: %s", err)
+	}
+	defer func() {
+		if err := store.Commit(); err != nil {
+			glog.Errorf("Error committing transaction while building mconfig: %s", err)
+		}
+	}()
+
+	networkLoadResult, err := store.LoadNetworks([]string{gw.NetworkId}, storage.FullNetworkLoadCriteria)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load network: %s", err)
+	}
+	if len(networkLoadResult.Networks) == 0 {
+		return nil, status.Errorf(codes.NotFound, "network %s not found", gw.NetworkId)
+	}
+	network := networkLoadResult.Networks[0]
+
+	gatewayTK := orc8rStorage.TypeAndKey{Type: magmadconfig.MagmadGatewayType, Key: gw.LogicalId}
+	graph, err := store.LoadGraphForEntity(gw.NetworkId, gatewayTK, storage.FullEntityLoadCriteria)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load entity graph for gateway %s: %s", gw.LogicalId, err)
+	}
+
+	ret, err := factory.CreateMconfigFromGraph(network, graph, gw.LogicalId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build mconfig: %s", err)
+	}
+	return ret, nil
 }
@@ -0,0 +1,118 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator/servicers"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	magmadconfig "magma/orc8r/cloud/go/services/magmad/config"
+	"magma/orc8r/cloud/go/services/streamer/mconfig/factory"
+	"magma/orc8r/cloud/go/services/streamer/mconfig/test_protos"
+	"magma/orc8r/cloud/go/sql_utils"
+	orc8rStorage "magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockIDGenerator struct {
+	count int
+}
+
+func (g *mockIDGenerator) New() string {
+	g.count++
+	return fmt.Sprintf("%d", g.count)
+}
+
+// graphBuilder echoes the network config and the configs of all entities in
+// the graph back as mconfig values
+type graphBuilder struct{}
+
+func (*graphBuilder) Build(networkId string, gatewayId string) (map[string]proto.Message, error) {
+	return nil, fmt.Errorf("legacy build should not be called")
+}
+
+func (*graphBuilder) BuildFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (map[string]proto.Message, error) {
+	ret := map[string]proto.Message{
+		"network": &test_protos.Message1{Field: string(network.Configs["cfg"])},
+	}
+	for _, ent := range graph.Entities {
+		ret[ent.Key] = &test_protos.Message2{Field1: gatewayId, Field2: string(ent.Config)}
+	}
+	return ret, nil
+}
+
+func TestSbConfiguratorServicer_GetMconfig(t *testing.T) {
+	factory.ClearMconfigBuilders(t)
+	factory.RegisterMconfigBuilder(&graphBuilder{})
+
+	db, err := sql_utils.Open("sqlite3", ":memory:?_foreign_keys=1")
+	assert.NoError(t, err)
+	storeFactory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{})
+	assert.NoError(t, storeFactory.InitializeServiceStorage())
+
+	store, err := storeFactory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1", Configs: map[string][]byte{"cfg": []byte("hello")}})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "foo1", Config: []byte("bar")})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{
+		Type:         magmadconfig.MagmadGatewayType,
+		Key:          "gw1",
+		PhysicalID:   "hw1",
+		Config:       []byte("baz"),
+		Associations: []orc8rStorage.TypeAndKey{{Type: "foo", Key: "foo1"}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	srv, err := servicers.NewSouthboundConfiguratorServicer(storeFactory)
+	assert.NoError(t, err)
+
+	// No identity
+	_, err = srv.GetMconfig(context.Background(), &protos.Void{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Unregistered gateway
+	ctx := protos.NewGatewayIdentity("hw1", "", "").NewContextWithIdentity(context.Background())
+	_, err = srv.GetMconfig(ctx, &protos.Void{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Unknown network
+	ctx = protos.NewGatewayIdentity("hw1", "n2", "gw1").NewContextWithIdentity(context.Background())
+	_, err = srv.GetMconfig(ctx, &protos.Void{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Happy path
+	ctx = protos.NewGatewayIdentity("hw1", "n1", "gw1").NewContextWithIdentity(context.Background())
+	actual, err := srv.GetMconfig(ctx, &protos.Void{})
+	assert.NoError(t, err)
+
+	expected := map[string]proto.Message{
+		"network": &test_protos.Message1{Field: "hello"},
+		"foo1":    &test_protos.Message2{Field1: "gw1", Field2: "bar"},
+		"gw1":     &test_protos.Message2{Field1: "gw1", Field2: "baz"},
+	}
+	assert.Equal(t, len(expected), len(actual.ConfigsByKey))
+	for k, v := range expected {
+		expectedAny, err := ptypes.MarshalAny(v)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAny, actual.ConfigsByKey[k])
+	}
+}
@@ -378,6 +378,18 @@ type EntityGraph struct {
 	Edges []GraphEdge
 }
 
+// GetEntity returns the entity in the graph identified by the given
+// TypeAndKey. The second return value is false if the graph does not contain
+// the entity.
+func (eg EntityGraph) GetEntity(id storage.TypeAndKey) (NetworkEntity, bool) {
+	for _, ent := range eg.Entities {
+		if ent.GetTypeAndKey() == id {
+			return ent, true
+		}
+	}
+	return NetworkEntity{}, false
+}
+
 // GraphEdge represents a directed edge within a graph
 type GraphEdge struct {
 	To, From storage.TypeAndKey
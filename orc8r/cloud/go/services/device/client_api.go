@@ -65,3 +65,56 @@ func GetDeviceInfo(networkID string, deviceIDs []*protos.DeviceID) (map[string]*
 	}
 	return res.DeviceMap, nil
 }
+
+// ListDevices returns the devices on a network of the given type whose device
+// IDs start with deviceIDPrefix, sorted by type and device ID. Empty typeVal
+// or deviceIDPrefix don't filter anything.
+func ListDevices(networkID string, typeVal string, deviceIDPrefix string) ([]*protos.PhysicalEntity, error) {
+	client, conn, err := getDeviceClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	req := &protos.ListDevicesRequest{NetworkID: networkID, Type: typeVal, DeviceIDPrefix: deviceIDPrefix}
+	res, err := client.ListDevices(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Entities, nil
+}
+
+// SearchDevices returns the devices of the given types whose device IDs
+// start with deviceIDPrefix, keyed by network. Devices on all networks are
+// searched if networkID is empty. Empty types or deviceIDPrefix don't filter
+// anything.
+func SearchDevices(networkID string, types []string, deviceIDPrefix string) (map[string][]*protos.PhysicalEntity, error) {
+	client, conn, err := getDeviceClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	req := &protos.SearchDevicesRequest{NetworkID: networkID, Types: types, DeviceIDPrefix: deviceIDPrefix}
+	res, err := client.SearchDevices(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string][]*protos.PhysicalEntity{}
+	for network, entities := range res.DevicesByNetwork {
+		ret[network] = entities.GetEntities()
+	}
+	return ret, nil
+}
+
+// UpdateDevices updates existing devices in place. The Version of each entity
+// must be the one it was read at, otherwise no device is updated and an
+// error with code FailedPrecondition is returned.
+func UpdateDevices(networkID string, entities []*protos.PhysicalEntity) error {
+	client, conn, err := getDeviceClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	req := &protos.UpdateDevicesRequest{NetworkID: networkID, Entities: entities}
+	_, err = client.UpdateDevices(context.Background(), req)
+	return err
+}
@@ -19,6 +19,8 @@ import (
 	"magma/orc8r/cloud/go/services/device/test_init"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -77,6 +79,88 @@ func TestDeviceService(t *testing.T) {
 	assertDevicesAreRegistered(t, bundle2)
 }
 
+func TestDeviceService_ListSearchUpdate(t *testing.T) {
+	testSerde := &Serde{}
+	serde.UnregisterSerdesForDomain(t, device.SerdeDomain)
+	err := serde.RegisterSerdes(testSerde)
+	assert.NoError(t, err)
+	test_init.StartTestService(t)
+
+	serialized1, err := testSerde.Serialize(1)
+	assert.NoError(t, err)
+	serialized2, err := testSerde.Serialize(2)
+	assert.NoError(t, err)
+	enb1 := makeIDAndEntity("enb-1", typeVal, serialized1)
+	enb2 := makeIDAndEntity("enb-2", typeVal, serialized2)
+	gw1 := makeIDAndEntity("gw-1", typeVal, serialized1)
+	otherNetworkEnb := makeIDAndEntity("enb-3", typeVal, serialized1)
+	registerDevicesAssertNoError(t, networkID, gw1.entity, enb2.entity, enb1.entity)
+	registerDevicesAssertNoError(t, "network2", otherNetworkEnb.entity)
+
+	// List is sorted and scoped to the network
+	entities, err := device.ListDevices(networkID, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"enb-1", "enb-2", "gw-1"}, getDeviceIDs(entities))
+	entities, err = device.ListDevices(networkID, typeVal, "enb-")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"enb-1", "enb-2"}, getDeviceIDs(entities))
+	entities, err = device.ListDevices(networkID, "unregistered", "")
+	assert.NoError(t, err)
+	assert.Empty(t, entities)
+	_, err = device.ListDevices("", "", "")
+	assert.Error(t, err)
+
+	// Search spans networks unless one is given
+	devicesByNetwork, err := device.SearchDevices("", []string{typeVal}, "enb-")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(devicesByNetwork))
+	assert.Equal(t, []string{"enb-1", "enb-2"}, getDeviceIDs(devicesByNetwork[networkID]))
+	assert.Equal(t, []string{"enb-3"}, getDeviceIDs(devicesByNetwork["network2"]))
+	devicesByNetwork, err = device.SearchDevices("network2", nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(devicesByNetwork))
+
+	// Update at the read version succeeds and bumps the version
+	deviceMap, err := device.GetDeviceInfo(networkID, []*protos.DeviceID{enb1.id})
+	assert.NoError(t, err)
+	read := deviceMap[enb1.id.DeviceID]
+	updated := *read
+	updated.Info = serialized2
+	err = device.UpdateDevices(networkID, []*protos.PhysicalEntity{&updated})
+	assert.NoError(t, err)
+	deviceMap, err = device.GetDeviceInfo(networkID, []*protos.DeviceID{enb1.id})
+	assert.NoError(t, err)
+	assert.Equal(t, serialized2, deviceMap[enb1.id.DeviceID].Info)
+	assert.Equal(t, read.Version+1, deviceMap[enb1.id.DeviceID].Version)
+
+	// Updating with a stale version fails and leaves all devices untouched
+	enb2Update := *enb2.entity
+	enb2Update.Info = serialized1
+	err = device.UpdateDevices(networkID, []*protos.PhysicalEntity{&enb2Update, &updated})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assertDevicesAreRegistered(t, enb2)
+
+	// Updating a device which doesn't exist fails
+	missing := makeIDAndEntity("enb-4", typeVal, serialized1)
+	err = device.UpdateDevices(networkID, []*protos.PhysicalEntity{missing.entity})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assertDevicesNotRegistered(t, missing.id)
+
+	// Updated info must still be deserializable
+	unserializable := *deviceMap[enb1.id.DeviceID]
+	unserializable.Info = []byte("(*_*)")
+	err = device.UpdateDevices(networkID, []*protos.PhysicalEntity{&unserializable})
+	assert.Error(t, err)
+}
+
+func getDeviceIDs(entities []*protos.PhysicalEntity) []string {
+	ret := []string{}
+	for _, entity := range entities {
+		ret = append(ret, entity.DeviceID)
+	}
+	return ret
+}
+
 func assertDevicesAreRegistered(t *testing.T, bundles ...idAndEntity) {
 	deviceIDs := []*protos.DeviceID{}
 	for _, bundle := range bundles {
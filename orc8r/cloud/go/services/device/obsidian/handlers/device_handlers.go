@@ -0,0 +1,207 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/device"
+	"magma/orc8r/cloud/go/services/device/obsidian/models"
+	"magma/orc8r/cloud/go/services/device/protos"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DevicesRootPath   = handlers.NETWORKS_ROOT + "/:network_id/devices"
+	DeviceManagePath  = DevicesRootPath + "/:device_type/:device_id"
+	SearchDevicesPath = handlers.REST_ROOT + handlers.URL_SEP + "devices"
+
+	TypeParam = "type"
+)
+
+// GetObsidianHandlers returns all obsidian handlers for the device inventory
+func GetObsidianHandlers() []handlers.Handler {
+	return []handlers.Handler{
+		{Path: DevicesRootPath, Methods: handlers.GET, HandlerFunc: listDevicesHandler},
+		{Path: DevicesRootPath, Methods: handlers.POST, HandlerFunc: createDeviceHandler},
+		{Path: DeviceManagePath, Methods: handlers.GET, HandlerFunc: getDeviceHandler},
+		{Path: DeviceManagePath, Methods: handlers.PUT, HandlerFunc: updateDeviceHandler},
+		{Path: DeviceManagePath, Methods: handlers.DELETE, HandlerFunc: deleteDeviceHandler},
+		{Path: SearchDevicesPath, Methods: handlers.GET, HandlerFunc: searchDevicesHandler},
+	}
+}
+
+func listDevicesHandler(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	entities, err := device.ListDevices(networkID, c.QueryParam(TypeParam), c.QueryParam(handlers.IDPrefixParam))
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	ret, err := models.PhysicalDevicesFromProto(entities)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// searchDevicesHandler returns the devices on all networks of the types given
+// in the (repeatable) type query parameter, keyed by network
+func searchDevicesHandler(c echo.Context) error {
+	devicesByNetwork, err := device.SearchDevices("", c.QueryParams()[TypeParam], c.QueryParam(handlers.IDPrefixParam))
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	ret := make(map[string][]*models.PhysicalDevice, len(devicesByNetwork))
+	for networkID, entities := range devicesByNetwork {
+		ret[networkID], err = models.PhysicalDevicesFromProto(entities)
+		if err != nil {
+			return handlers.HttpError(err, http.StatusInternalServerError)
+		}
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func createDeviceHandler(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	entity, herr := getEntityFromBody(c)
+	if herr != nil {
+		return herr
+	}
+
+	id := &protos.DeviceID{DeviceID: entity.DeviceID, Type: entity.Type}
+	existing, err := device.GetDeviceInfo(networkID, []*protos.DeviceID{id})
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	if _, exists := existing[entity.DeviceID]; exists {
+		return handlers.HttpError(
+			fmt.Errorf("Device %s of type %s already exists", entity.DeviceID, entity.Type),
+			http.StatusConflict,
+		)
+	}
+	err = device.RegisterDevices(networkID, []*protos.PhysicalEntity{entity})
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func getDeviceHandler(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	entity, herr := getDevice(networkID, getDeviceID(c))
+	if herr != nil {
+		return herr
+	}
+	ret, err := models.PhysicalDeviceFromProto(entity)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func updateDeviceHandler(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	id := getDeviceID(c)
+	entity, herr := getEntityFromBody(c)
+	if herr != nil {
+		return herr
+	}
+	if entity.DeviceID != id.DeviceID || entity.Type != id.Type {
+		return handlers.HttpError(
+			fmt.Errorf("Device ID and type in the body must match the URL"),
+			http.StatusBadRequest,
+		)
+	}
+
+	err := device.UpdateDevices(networkID, []*protos.PhysicalEntity{entity})
+	if err != nil {
+		return deviceHttpError(err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func deleteDeviceHandler(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	id := getDeviceID(c)
+	if _, herr := getDevice(networkID, id); herr != nil {
+		return herr
+	}
+	err := device.DeleteDevices(networkID, []*protos.DeviceID{id})
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func getDeviceID(c echo.Context) *protos.DeviceID {
+	return &protos.DeviceID{DeviceID: c.Param("device_id"), Type: c.Param("device_type")}
+}
+
+func getDevice(networkID string, id *protos.DeviceID) (*protos.PhysicalEntity, *echo.HTTPError) {
+	deviceMap, err := device.GetDeviceInfo(networkID, []*protos.DeviceID{id})
+	if err != nil {
+		return nil, handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	entity, ok := deviceMap[id.DeviceID]
+	if !ok {
+		return nil, handlers.HttpError(
+			fmt.Errorf("Device %s of type %s not found", id.DeviceID, id.Type),
+			http.StatusNotFound,
+		)
+	}
+	return entity, nil
+}
+
+func getEntityFromBody(c echo.Context) (*protos.PhysicalEntity, *echo.HTTPError) {
+	model := new(models.PhysicalDevice)
+	if err := c.Bind(model); err != nil {
+		return nil, handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := model.Validate(strfmt.Default); err != nil {
+		return nil, handlers.HttpError(err, http.StatusBadRequest)
+	}
+	entity, err := model.ToProto()
+	if err != nil {
+		return nil, handlers.HttpError(err, http.StatusBadRequest)
+	}
+	return entity, nil
+}
+
+// deviceHttpError maps the errors of the device RPCs to HTTP statuses
+func deviceHttpError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return handlers.HttpError(err, http.StatusNotFound)
+	case codes.FailedPrecondition:
+		return handlers.HttpError(err, http.StatusConflict)
+	default:
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	device_test_init "magma/orc8r/cloud/go/services/device/test_init"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	gwRecord1 = `{"hw_id": {"id": "hw1"}, "key": {"key_type": "ECHO"}, "name": "gw one"}`
+	gwRecord2 = `{"hw_id": {"id": "hw2"}, "key": {"key_type": "ECHO"}, "name": "gw two"}`
+)
+
+// Obsidian integration test for device inventory API endpoints
+func TestDevices(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	device_test_init.StartTestService(t)
+	restPort := tests.StartObsidian(t)
+	devicesUrl := func(networkID string) string {
+		return fmt.Sprintf("http://localhost:%d%s/networks/%s/devices", restPort, handlers.REST_ROOT, networkID)
+	}
+	deviceUrl := devicesUrl("net1") + "/access_gateway_record/hw1"
+
+	listTestCase := tests.Testcase{
+		Name:     "List Devices",
+		Method:   "GET",
+		Url:      devicesUrl("net1"),
+		Expected: "[]",
+	}
+	tests.RunTest(t, listTestCase)
+
+	// Register devices on 2 networks
+	createTestCase := tests.Testcase{
+		Name:                      "Create Device",
+		Method:                    "POST",
+		Url:                       devicesUrl("net1"),
+		Payload:                   fmt.Sprintf(`{"device_id": "hw1", "type": "access_gateway_record", "info": %s}`, gwRecord1),
+		Skip_payload_verification: true,
+	}
+	tests.RunTest(t, createTestCase)
+	createTestCase.Payload = fmt.Sprintf(`{"device_id": "hw2", "type": "access_gateway_record", "info": %s}`, gwRecord2)
+	tests.RunTest(t, createTestCase)
+	createTestCase.Url = devicesUrl("net2")
+	tests.RunTest(t, createTestCase)
+
+	// Creating an existing device conflicts
+	status, _, err := tests.SendHttpRequest("POST", devicesUrl("net2"), createTestCase.Payload)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, status)
+	// Info must be valid for the device type
+	status, _, err = tests.SendHttpRequest(
+		"POST", devicesUrl("net1"),
+		`{"device_id": "hw3", "type": "access_gateway_record", "info": {"hw_id": "not an object"}}`,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _, err = tests.SendHttpRequest("POST", devicesUrl("net1"), `{"device_id": "hw3", "type": "unknown", "info": {}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	listTestCase.Expected = fmt.Sprintf(
+		`[{"device_id": "hw1", "type": "access_gateway_record", "info": %s}, {"device_id": "hw2", "type": "access_gateway_record", "info": %s}]`,
+		gwRecord1, gwRecord2,
+	)
+	tests.RunTest(t, listTestCase)
+	listTestCase.Url = devicesUrl("net1") + "?type=access_gateway_record&id_prefix=hw2"
+	listTestCase.Expected = fmt.Sprintf(`[{"device_id": "hw2", "type": "access_gateway_record", "info": %s}]`, gwRecord2)
+	tests.RunTest(t, listTestCase)
+
+	// Search across networks
+	searchTestCase := tests.Testcase{
+		Name:   "Search Devices",
+		Method: "GET",
+		Url:    fmt.Sprintf("http://localhost:%d%s/devices?id_prefix=hw2", restPort, handlers.REST_ROOT),
+		Expected: fmt.Sprintf(
+			`{"net1": [{"device_id": "hw2", "type": "access_gateway_record", "info": %s}], "net2": [{"device_id": "hw2", "type": "access_gateway_record", "info": %s}]}`,
+			gwRecord2, gwRecord2,
+		),
+	}
+	tests.RunTest(t, searchTestCase)
+
+	// Update at the read version, then again with the now stale version
+	getTestCase := tests.Testcase{
+		Name:     "Get Device",
+		Method:   "GET",
+		Url:      deviceUrl,
+		Expected: fmt.Sprintf(`{"device_id": "hw1", "type": "access_gateway_record", "info": %s}`, gwRecord1),
+	}
+	tests.RunTest(t, getTestCase)
+	updateTestCase := tests.Testcase{
+		Name:     "Update Device",
+		Method:   "PUT",
+		Url:      deviceUrl,
+		Payload:  fmt.Sprintf(`{"device_id": "hw1", "type": "access_gateway_record", "version": 0, "info": %s}`, gwRecord2),
+		Expected: "",
+	}
+	tests.RunTest(t, updateTestCase)
+	getTestCase.Expected = fmt.Sprintf(`{"device_id": "hw1", "type": "access_gateway_record", "version": 1, "info": %s}`, gwRecord2)
+	tests.RunTest(t, getTestCase)
+	status, _, err = tests.SendHttpRequest("PUT", deviceUrl, updateTestCase.Payload)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, status)
+
+	// The body must match the URL and the device must exist
+	status, _, err = tests.SendHttpRequest(
+		"PUT", deviceUrl,
+		fmt.Sprintf(`{"device_id": "hw2", "type": "access_gateway_record", "version": 0, "info": %s}`, gwRecord2),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _, err = tests.SendHttpRequest(
+		"PUT", devicesUrl("net3")+"/access_gateway_record/hw1",
+		fmt.Sprintf(`{"device_id": "hw1", "type": "access_gateway_record", "info": %s}`, gwRecord1),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	// Delete
+	status, _, err = tests.SendHttpRequest("DELETE", deviceUrl, "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _, err = tests.SendHttpRequest("GET", deviceUrl, "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+	status, _, err = tests.SendHttpRequest("DELETE", deviceUrl, "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+}
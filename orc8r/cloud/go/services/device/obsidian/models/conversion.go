@@ -0,0 +1,83 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/device"
+	"magma/orc8r/cloud/go/services/device/protos"
+)
+
+// Device serdes serialize swagger models, which are written as JSON (see
+// pluginimpl/serdes.go). Info is therefore converted through its JSON
+// representation and checked against the serde of the device type.
+
+// ToProto converts a device to a PhysicalEntity. An error is returned if
+// info can't be deserialized by the serde of the device type.
+func (m *PhysicalDevice) ToProto() (*protos.PhysicalEntity, error) {
+	marshaledInfo, err := json.Marshal(m.Info)
+	if err != nil {
+		return nil, err
+	}
+	deserializedInfo, err := serde.Deserialize(device.SerdeDomain, *m.Type, marshaledInfo)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid info for device type %s: %v", *m.Type, err)
+	}
+	serializedInfo, err := serde.Serialize(device.SerdeDomain, *m.Type, deserializedInfo)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid info for device type %s: %v", *m.Type, err)
+	}
+	return &protos.PhysicalEntity{
+		DeviceID: *m.DeviceID,
+		Type:     *m.Type,
+		Info:     serializedInfo,
+		Version:  m.Version,
+	}, nil
+}
+
+// PhysicalDeviceFromProto converts a PhysicalEntity to its REST
+// representation
+func PhysicalDeviceFromProto(entity *protos.PhysicalEntity) (*PhysicalDevice, error) {
+	deserializedInfo, err := serde.Deserialize(device.SerdeDomain, entity.GetType(), entity.GetInfo())
+	if err != nil {
+		return nil, err
+	}
+	marshaledInfo, err := json.Marshal(deserializedInfo)
+	if err != nil {
+		return nil, err
+	}
+	info := map[string]interface{}{}
+	if err := json.Unmarshal(marshaledInfo, &info); err != nil {
+		return nil, fmt.Errorf("Info of device type %s is not a JSON object: %v", entity.GetType(), err)
+	}
+	deviceID, typeVal := entity.GetDeviceID(), entity.GetType()
+	return &PhysicalDevice{
+		DeviceID: &deviceID,
+		Type:     &typeVal,
+		Info:     info,
+		Version:  entity.GetVersion(),
+	}, nil
+}
+
+// PhysicalDevicesFromProto converts a list of PhysicalEntity to their REST
+// representation
+func PhysicalDevicesFromProto(entities []*protos.PhysicalEntity) ([]*PhysicalDevice, error) {
+	ret := make([]*PhysicalDevice, 0, len(entities))
+	for _, entity := range entities {
+		model, err := PhysicalDeviceFromProto(entity)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, model)
+	}
+	return ret, nil
+}
@@ -30,7 +30,10 @@ type PhysicalEntity struct {
 	// Used to deserialize info
 	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	// Any other information (manufacturer, location, owner, etc)
-	Info                 []byte   `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Info []byte `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	// Incremented on every write of the entity. Returned on reads and
+	// checked against the stored version on updates.
+	Version              uint64   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -40,7 +43,7 @@ func (m *PhysicalEntity) Reset()         { *m = PhysicalEntity{} }
 func (m *PhysicalEntity) String() string { return proto.CompactTextString(m) }
 func (*PhysicalEntity) ProtoMessage()    {}
 func (*PhysicalEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_efe44f9995d3c195, []int{0}
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{0}
 }
 func (m *PhysicalEntity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PhysicalEntity.Unmarshal(m, b)
@@ -81,6 +84,51 @@ func (m *PhysicalEntity) GetInfo() []byte {
 	return nil
 }
 
+func (m *PhysicalEntity) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type PhysicalEntities struct {
+	Entities             []*PhysicalEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PhysicalEntities) Reset()         { *m = PhysicalEntities{} }
+func (m *PhysicalEntities) String() string { return proto.CompactTextString(m) }
+func (*PhysicalEntities) ProtoMessage()    {}
+func (*PhysicalEntities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{1}
+}
+func (m *PhysicalEntities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PhysicalEntities.Unmarshal(m, b)
+}
+func (m *PhysicalEntities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PhysicalEntities.Marshal(b, m, deterministic)
+}
+func (dst *PhysicalEntities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhysicalEntities.Merge(dst, src)
+}
+func (m *PhysicalEntities) XXX_Size() int {
+	return xxx_messageInfo_PhysicalEntities.Size(m)
+}
+func (m *PhysicalEntities) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhysicalEntities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PhysicalEntities proto.InternalMessageInfo
+
+func (m *PhysicalEntities) GetEntities() []*PhysicalEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
 type RegisterDevicesRequest struct {
 	NetworkID            string            `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
 	Entities             []*PhysicalEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
@@ -93,7 +141,7 @@ func (m *RegisterDevicesRequest) Reset()         { *m = RegisterDevicesRequest{}
 func (m *RegisterDevicesRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterDevicesRequest) ProtoMessage()    {}
 func (*RegisterDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_efe44f9995d3c195, []int{1}
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{2}
 }
 func (m *RegisterDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegisterDevicesRequest.Unmarshal(m, b)
@@ -139,7 +187,7 @@ func (m *DeviceID) Reset()         { *m = DeviceID{} }
 func (m *DeviceID) String() string { return proto.CompactTextString(m) }
 func (*DeviceID) ProtoMessage()    {}
 func (*DeviceID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_efe44f9995d3c195, []int{2}
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{3}
 }
 func (m *DeviceID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeviceID.Unmarshal(m, b)
@@ -185,7 +233,7 @@ func (m *GetDeviceInfoRequest) Reset()         { *m = GetDeviceInfoRequest{} }
 func (m *GetDeviceInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceInfoRequest) ProtoMessage()    {}
 func (*GetDeviceInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_efe44f9995d3c195, []int{3}
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{4}
 }
 func (m *GetDeviceInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceInfoRequest.Unmarshal(m, b)
@@ -231,7 +279,7 @@ func (m *GetDeviceInfoResponse) Reset()         { *m = GetDeviceInfoResponse{} }
 func (m *GetDeviceInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDeviceInfoResponse) ProtoMessage()    {}
 func (*GetDeviceInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_efe44f9995d3c195, []int{4}
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{5}
 }
 func (m *GetDeviceInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDeviceInfoResponse.Unmarshal(m, b)
@@ -270,7 +318,7 @@ func (m *DeleteDevicesRequest) Reset()         { *m = DeleteDevicesRequest{} }
 func (m *DeleteDevicesRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteDevicesRequest) ProtoMessage()    {}
 func (*DeleteDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_device_efe44f9995d3c195, []int{5}
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{6}
 }
 func (m *DeleteDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteDevicesRequest.Unmarshal(m, b)
@@ -304,14 +352,263 @@ func (m *DeleteDevicesRequest) GetDeviceIDs() []*DeviceID {
 	return nil
 }
 
+type ListDevicesRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// Only return devices of this type. Devices of all types are returned if
+	// this is empty.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Only return devices whose deviceID starts with this prefix
+	DeviceIDPrefix       string   `protobuf:"bytes,3,opt,name=deviceIDPrefix,proto3" json:"deviceIDPrefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDevicesRequest) Reset()         { *m = ListDevicesRequest{} }
+func (m *ListDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDevicesRequest) ProtoMessage()    {}
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{7}
+}
+func (m *ListDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDevicesRequest.Unmarshal(m, b)
+}
+func (m *ListDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDevicesRequest.Merge(dst, src)
+}
+func (m *ListDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDevicesRequest.Size(m)
+}
+func (m *ListDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDevicesRequest proto.InternalMessageInfo
+
+func (m *ListDevicesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *ListDevicesRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListDevicesRequest) GetDeviceIDPrefix() string {
+	if m != nil {
+		return m.DeviceIDPrefix
+	}
+	return ""
+}
+
+type ListDevicesResponse struct {
+	// Matching devices sorted by type and deviceID
+	Entities             []*PhysicalEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDevicesResponse) Reset()         { *m = ListDevicesResponse{} }
+func (m *ListDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDevicesResponse) ProtoMessage()    {}
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{8}
+}
+func (m *ListDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDevicesResponse.Unmarshal(m, b)
+}
+func (m *ListDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDevicesResponse.Merge(dst, src)
+}
+func (m *ListDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDevicesResponse.Size(m)
+}
+func (m *ListDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDevicesResponse proto.InternalMessageInfo
+
+func (m *ListDevicesResponse) GetEntities() []*PhysicalEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+type SearchDevicesRequest struct {
+	// Only return devices on this network. Devices on all networks are
+	// returned if this is empty.
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// Only return devices of these types. Devices of all types are returned
+	// if this is empty.
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// Only return devices whose deviceID starts with this prefix
+	DeviceIDPrefix       string   `protobuf:"bytes,3,opt,name=deviceIDPrefix,proto3" json:"deviceIDPrefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchDevicesRequest) Reset()         { *m = SearchDevicesRequest{} }
+func (m *SearchDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchDevicesRequest) ProtoMessage()    {}
+func (*SearchDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{9}
+}
+func (m *SearchDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchDevicesRequest.Unmarshal(m, b)
+}
+func (m *SearchDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SearchDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchDevicesRequest.Merge(dst, src)
+}
+func (m *SearchDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchDevicesRequest.Size(m)
+}
+func (m *SearchDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchDevicesRequest proto.InternalMessageInfo
+
+func (m *SearchDevicesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *SearchDevicesRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *SearchDevicesRequest) GetDeviceIDPrefix() string {
+	if m != nil {
+		return m.DeviceIDPrefix
+	}
+	return ""
+}
+
+type SearchDevicesResponse struct {
+	// Matching devices keyed by the network they belong to
+	DevicesByNetwork     map[string]*PhysicalEntities `protobuf:"bytes,1,rep,name=devicesByNetwork,proto3" json:"devicesByNetwork,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SearchDevicesResponse) Reset()         { *m = SearchDevicesResponse{} }
+func (m *SearchDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchDevicesResponse) ProtoMessage()    {}
+func (*SearchDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{10}
+}
+func (m *SearchDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchDevicesResponse.Unmarshal(m, b)
+}
+func (m *SearchDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *SearchDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchDevicesResponse.Merge(dst, src)
+}
+func (m *SearchDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchDevicesResponse.Size(m)
+}
+func (m *SearchDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchDevicesResponse proto.InternalMessageInfo
+
+func (m *SearchDevicesResponse) GetDevicesByNetwork() map[string]*PhysicalEntities {
+	if m != nil {
+		return m.DevicesByNetwork
+	}
+	return nil
+}
+
+type UpdateDevicesRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// All entities must already exist and their version must match the
+	// stored one, otherwise none of them are updated.
+	Entities             []*PhysicalEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateDevicesRequest) Reset()         { *m = UpdateDevicesRequest{} }
+func (m *UpdateDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDevicesRequest) ProtoMessage()    {}
+func (*UpdateDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_device_efb4fe33a174f7c3, []int{11}
+}
+func (m *UpdateDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDevicesRequest.Unmarshal(m, b)
+}
+func (m *UpdateDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDevicesRequest.Merge(dst, src)
+}
+func (m *UpdateDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDevicesRequest.Size(m)
+}
+func (m *UpdateDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDevicesRequest proto.InternalMessageInfo
+
+func (m *UpdateDevicesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *UpdateDevicesRequest) GetEntities() []*PhysicalEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PhysicalEntity)(nil), "magma.orc8r.device.PhysicalEntity")
+	proto.RegisterType((*PhysicalEntities)(nil), "magma.orc8r.device.PhysicalEntities")
 	proto.RegisterType((*RegisterDevicesRequest)(nil), "magma.orc8r.device.RegisterDevicesRequest")
 	proto.RegisterType((*DeviceID)(nil), "magma.orc8r.device.DeviceID")
 	proto.RegisterType((*GetDeviceInfoRequest)(nil), "magma.orc8r.device.GetDeviceInfoRequest")
 	proto.RegisterType((*GetDeviceInfoResponse)(nil), "magma.orc8r.device.GetDeviceInfoResponse")
 	proto.RegisterMapType((map[string]*PhysicalEntity)(nil), "magma.orc8r.device.GetDeviceInfoResponse.DeviceMapEntry")
 	proto.RegisterType((*DeleteDevicesRequest)(nil), "magma.orc8r.device.DeleteDevicesRequest")
+	proto.RegisterType((*ListDevicesRequest)(nil), "magma.orc8r.device.ListDevicesRequest")
+	proto.RegisterType((*ListDevicesResponse)(nil), "magma.orc8r.device.ListDevicesResponse")
+	proto.RegisterType((*SearchDevicesRequest)(nil), "magma.orc8r.device.SearchDevicesRequest")
+	proto.RegisterType((*SearchDevicesResponse)(nil), "magma.orc8r.device.SearchDevicesResponse")
+	proto.RegisterMapType((map[string]*PhysicalEntities)(nil), "magma.orc8r.device.SearchDevicesResponse.DevicesByNetworkEntry")
+	proto.RegisterType((*UpdateDevicesRequest)(nil), "magma.orc8r.device.UpdateDevicesRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +626,14 @@ type DeviceClient interface {
 	RegisterDevices(ctx context.Context, in *RegisterDevicesRequest, opts ...grpc.CallOption) (*protos.Void, error)
 	GetDeviceInfo(ctx context.Context, in *GetDeviceInfoRequest, opts ...grpc.CallOption) (*GetDeviceInfoResponse, error)
 	DeleteDevices(ctx context.Context, in *DeleteDevicesRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// ListDevices returns the devices on a network matching the request
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// SearchDevices returns the devices on all networks matching the request
+	SearchDevices(ctx context.Context, in *SearchDevicesRequest, opts ...grpc.CallOption) (*SearchDevicesResponse, error)
+	// UpdateDevices updates existing devices in place. It fails with
+	// NotFound if a device does not exist and with FailedPrecondition if a
+	// device was modified since it was read.
+	UpdateDevices(ctx context.Context, in *UpdateDevicesRequest, opts ...grpc.CallOption) (*protos.Void, error)
 }
 
 type deviceClient struct {
@@ -366,11 +671,46 @@ func (c *deviceClient) DeleteDevices(ctx context.Context, in *DeleteDevicesReque
 	return out, nil
 }
 
+func (c *deviceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.device.Device/ListDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) SearchDevices(ctx context.Context, in *SearchDevicesRequest, opts ...grpc.CallOption) (*SearchDevicesResponse, error) {
+	out := new(SearchDevicesResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.device.Device/SearchDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) UpdateDevices(ctx context.Context, in *UpdateDevicesRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.device.Device/UpdateDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceServer is the server API for Device service.
 type DeviceServer interface {
 	RegisterDevices(context.Context, *RegisterDevicesRequest) (*protos.Void, error)
 	GetDeviceInfo(context.Context, *GetDeviceInfoRequest) (*GetDeviceInfoResponse, error)
 	DeleteDevices(context.Context, *DeleteDevicesRequest) (*protos.Void, error)
+	// ListDevices returns the devices on a network matching the request
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// SearchDevices returns the devices on all networks matching the request
+	SearchDevices(context.Context, *SearchDevicesRequest) (*SearchDevicesResponse, error)
+	// UpdateDevices updates existing devices in place. It fails with
+	// NotFound if a device does not exist and with FailedPrecondition if a
+	// device was modified since it was read.
+	UpdateDevices(context.Context, *UpdateDevicesRequest) (*protos.Void, error)
 }
 
 func RegisterDeviceServer(s *grpc.Server, srv DeviceServer) {
@@ -431,6 +771,60 @@ func _Device_DeleteDevices_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Device_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.device.Device/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_SearchDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).SearchDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.device.Device/SearchDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).SearchDevices(ctx, req.(*SearchDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_UpdateDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).UpdateDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.device.Device/UpdateDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).UpdateDevices(ctx, req.(*UpdateDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Device_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.device.Device",
 	HandlerType: (*DeviceServer)(nil),
@@ -447,38 +841,62 @@ var _Device_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDevices",
 			Handler:    _Device_DeleteDevices_Handler,
 		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _Device_ListDevices_Handler,
+		},
+		{
+			MethodName: "SearchDevices",
+			Handler:    _Device_SearchDevices_Handler,
+		},
+		{
+			MethodName: "UpdateDevices",
+			Handler:    _Device_UpdateDevices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "device.proto",
 }
 
-func init() { proto.RegisterFile("device.proto", fileDescriptor_device_efe44f9995d3c195) }
-
-var fileDescriptor_device_efe44f9995d3c195 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0xaf, 0xd2, 0x40,
-	0x10, 0xa6, 0x45, 0x49, 0x19, 0x01, 0x75, 0xa3, 0xa6, 0x36, 0x1c, 0xc8, 0x9e, 0xaa, 0x87, 0x92,
-	0xe0, 0xa5, 0xe1, 0xe0, 0xc1, 0x94, 0x18, 0x0f, 0x18, 0xb3, 0x31, 0x1c, 0x3c, 0x59, 0xcb, 0x80,
-	0x0d, 0xb4, 0x5b, 0xbb, 0x0b, 0xa6, 0xff, 0xe3, 0xfd, 0xb1, 0xf7, 0x8f, 0x5e, 0xda, 0xa5, 0x90,
-	0xf2, 0xf6, 0x50, 0xf2, 0x4e, 0x9d, 0x9d, 0x9d, 0xef, 0x9b, 0x6f, 0xbe, 0xd9, 0xc2, 0x60, 0x8d,
-	0xc7, 0x38, 0x42, 0x2f, 0xcb, 0xb9, 0xe4, 0x84, 0x24, 0xe1, 0x36, 0x09, 0x3d, 0x9e, 0x47, 0x7e,
-	0xee, 0xa9, 0x1b, 0xe7, 0x7d, 0x75, 0x9a, 0x56, 0x05, 0x62, 0x1a, 0xf1, 0x24, 0xe1, 0xa9, 0x2a,
-	0xa7, 0x3f, 0x61, 0xf4, 0xe3, 0x6f, 0x21, 0xe2, 0x28, 0xdc, 0x2f, 0x52, 0x19, 0xcb, 0x82, 0x38,
-	0x60, 0x29, 0xd8, 0xb7, 0xc0, 0x36, 0x26, 0x86, 0xdb, 0x67, 0xe7, 0x33, 0x21, 0xf0, 0x4c, 0x16,
-	0x19, 0xda, 0x66, 0x95, 0xaf, 0xe2, 0x32, 0x17, 0xa7, 0x1b, 0x6e, 0x77, 0x27, 0x86, 0x3b, 0x60,
-	0x55, 0x4c, 0x8f, 0xf0, 0x8e, 0xe1, 0x36, 0x16, 0x12, 0xf3, 0xa0, 0xc2, 0x0a, 0x86, 0xff, 0x0e,
-	0x28, 0x24, 0x19, 0x43, 0x3f, 0x45, 0xf9, 0x9f, 0xe7, 0xbb, 0x33, 0xfd, 0x25, 0x41, 0x3e, 0x83,
-	0x85, 0xa5, 0x8a, 0x18, 0x85, 0x6d, 0x4e, 0xba, 0xee, 0x8b, 0x19, 0xf5, 0x1e, 0xcf, 0xe3, 0x35,
-	0x15, 0xb3, 0x33, 0x86, 0xce, 0xc1, 0x0a, 0x6a, 0xad, 0x37, 0xce, 0x41, 0x33, 0x78, 0xf3, 0x15,
-	0xe5, 0x09, 0x9e, 0x6e, 0x78, 0x3b, 0xc5, 0x73, 0xe8, 0xd7, 0xac, 0xb5, 0xe4, 0xb1, 0x4e, 0x72,
-	0x2d, 0x8b, 0x5d, 0xca, 0xe9, 0xbd, 0x01, 0x6f, 0xaf, 0x5a, 0x8a, 0x8c, 0xa7, 0x02, 0xc9, 0xaa,
-	0x66, 0x5d, 0x86, 0x99, 0x6d, 0x54, 0xac, 0xbe, 0x8e, 0x55, 0x8b, 0x3e, 0xf5, 0x5a, 0x86, 0xd9,
-	0x22, 0x95, 0x79, 0xc1, 0x2e, 0x54, 0xce, 0x6f, 0x18, 0x35, 0x2f, 0xc9, 0x2b, 0xe8, 0xee, 0xb0,
-	0x38, 0xcd, 0x55, 0x86, 0xc4, 0x87, 0xe7, 0xc7, 0x70, 0x7f, 0x50, 0xe6, 0xb4, 0x5b, 0x80, 0x02,
-	0xcc, 0x4d, 0xdf, 0x28, 0x5d, 0x0c, 0x70, 0x8f, 0x12, 0x6f, 0xda, 0xfb, 0x13, 0x5c, 0x9c, 0xdd,
-	0x99, 0xd0, 0x53, 0x79, 0xc2, 0xe0, 0xe5, 0xd5, 0xb3, 0x23, 0x1f, 0x75, 0x34, 0xfa, 0xb7, 0xe9,
-	0xbc, 0x6e, 0xd4, 0xae, 0x78, 0xbc, 0xa6, 0x1d, 0xb2, 0x81, 0x61, 0xc3, 0x65, 0xe2, 0xb6, 0x58,
-	0x84, 0xe2, 0xfb, 0xd0, 0x7a, 0x65, 0xb4, 0x43, 0xbe, 0xc3, 0xb0, 0x61, 0x9c, 0xbe, 0x8f, 0xce,
-	0x5b, 0xad, 0xee, 0x2f, 0xd6, 0xaf, 0x9e, 0xfa, 0xdf, 0xff, 0xa8, 0xef, 0xa7, 0x87, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x02, 0xa2, 0x12, 0x18, 0x28, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("device.proto", fileDescriptor_device_efb4fe33a174f7c3) }
+
+var fileDescriptor_device_efb4fe33a174f7c3 = []byte{
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xd1, 0x6e, 0xda, 0x30,
+	0x14, 0x6d, 0x0a, 0xed, 0xe0, 0xb6, 0x30, 0xe6, 0xc1, 0x94, 0x45, 0x7d, 0x40, 0xd1, 0xd4, 0xd1,
+	0x3d, 0xa4, 0x52, 0xf7, 0x82, 0x78, 0xd8, 0xa4, 0x8a, 0x6a, 0x9a, 0xb4, 0x56, 0x95, 0xa7, 0xf6,
+	0x61, 0x4f, 0xcd, 0xe0, 0xd2, 0x5a, 0x40, 0x9c, 0xc5, 0x2e, 0x5b, 0x7e, 0x6a, 0xff, 0xb2, 0x2f,
+	0xd9, 0x2f, 0x4c, 0xc4, 0x09, 0x60, 0xb0, 0xd4, 0x64, 0x3c, 0x61, 0x3b, 0xd7, 0xe7, 0x9c, 0x7b,
+	0x7c, 0xef, 0x15, 0x70, 0x38, 0xc4, 0x19, 0x1b, 0xa0, 0x17, 0x46, 0x5c, 0x72, 0x42, 0xa6, 0xfe,
+	0xfd, 0xd4, 0xf7, 0x78, 0x34, 0xe8, 0x46, 0x9e, 0xfa, 0xe2, 0xbc, 0x4e, 0x76, 0xa7, 0x49, 0x80,
+	0x38, 0x1d, 0xf0, 0xe9, 0x94, 0x07, 0x2a, 0xdc, 0x0d, 0xa0, 0x7e, 0xfd, 0x10, 0x0b, 0x36, 0xf0,
+	0x27, 0x17, 0x81, 0x64, 0x32, 0x26, 0x0e, 0x54, 0xd4, 0xb5, 0xcf, 0x7d, 0xdb, 0x6a, 0x5b, 0x9d,
+	0x2a, 0x5d, 0xec, 0x09, 0x81, 0xb2, 0x8c, 0x43, 0xb4, 0x77, 0x93, 0xf3, 0x64, 0x3d, 0x3f, 0x63,
+	0xc1, 0x88, 0xdb, 0xa5, 0xb6, 0xd5, 0x39, 0xa4, 0xc9, 0x9a, 0xd8, 0xf0, 0x6c, 0x86, 0x91, 0x60,
+	0x3c, 0xb0, 0xcb, 0x6d, 0xab, 0x53, 0xa6, 0xd9, 0xd6, 0xa5, 0xd0, 0xd0, 0xf8, 0x18, 0x0a, 0xf2,
+	0x01, 0x2a, 0x98, 0xae, 0x6d, 0xab, 0x5d, 0xea, 0x1c, 0x9c, 0xb9, 0xde, 0x66, 0x16, 0x9e, 0xae,
+	0x93, 0x2e, 0xee, 0xb8, 0x33, 0x78, 0x45, 0xf1, 0x9e, 0x09, 0x89, 0x51, 0x3f, 0x09, 0x15, 0x14,
+	0x7f, 0x3c, 0xa2, 0x90, 0xe4, 0x08, 0xaa, 0x01, 0xca, 0x9f, 0x3c, 0x1a, 0x2f, 0x92, 0x59, 0x1e,
+	0x68, 0xbc, 0xbb, 0xff, 0xc1, 0xdb, 0x83, 0x4a, 0x3f, 0x73, 0xa6, 0xa0, 0x6b, 0x6e, 0x08, 0xcd,
+	0x4f, 0x28, 0xd3, 0xeb, 0xc1, 0x88, 0xe7, 0x53, 0xdc, 0x83, 0x6a, 0x86, 0x9a, 0x49, 0x3e, 0x32,
+	0x49, 0xce, 0x64, 0xd1, 0x65, 0xb8, 0xfb, 0xc7, 0x82, 0xd6, 0x1a, 0xa5, 0x08, 0x79, 0x20, 0x90,
+	0xdc, 0x66, 0xa8, 0x97, 0x7e, 0x98, 0x3e, 0x40, 0xd7, 0x84, 0x6a, 0xbc, 0x9d, 0x72, 0x5d, 0xfa,
+	0xe1, 0x45, 0x20, 0xa3, 0x98, 0x2e, 0xa1, 0x9c, 0x3b, 0xa8, 0xeb, 0x1f, 0x49, 0x03, 0x4a, 0x63,
+	0x8c, 0xd3, 0xbc, 0xe6, 0x4b, 0xd2, 0x85, 0xbd, 0x99, 0x3f, 0x79, 0x54, 0xe6, 0xe4, 0x7b, 0x00,
+	0x75, 0xa1, 0xb7, 0xdb, 0xb5, 0xe6, 0x2e, 0xf6, 0x71, 0x82, 0x12, 0x0b, 0xbd, 0xfb, 0x36, 0x2e,
+	0x06, 0x40, 0xbe, 0x30, 0x21, 0x0b, 0xf1, 0x99, 0xba, 0xe6, 0x18, 0xea, 0x19, 0xe8, 0x75, 0x84,
+	0x23, 0xf6, 0x2b, 0xe9, 0x9f, 0x2a, 0x5d, 0x3b, 0x75, 0x6f, 0xe0, 0xa5, 0xc6, 0x97, 0x3e, 0xd9,
+	0xb6, 0x2d, 0x13, 0x41, 0xf3, 0x2b, 0xfa, 0xd1, 0xe0, 0xa1, 0x50, 0x22, 0x4d, 0xd8, 0x9b, 0x8b,
+	0x57, 0xa6, 0x55, 0xa9, 0xda, 0xe4, 0x4e, 0xe5, 0xaf, 0x05, 0xad, 0x35, 0xd2, 0x34, 0x9b, 0x31,
+	0x34, 0x54, 0xac, 0x38, 0x8f, 0xaf, 0x14, 0x5b, 0x9a, 0xd5, 0x47, 0x53, 0x56, 0x46, 0x10, 0xaf,
+	0xbf, 0x86, 0xa0, 0xca, 0x71, 0x03, 0xd8, 0x61, 0xd0, 0x32, 0x86, 0x1a, 0x8a, 0xb3, 0xa7, 0x17,
+	0xe7, 0x9b, 0x27, 0x2d, 0x66, 0x28, 0x56, 0xcb, 0x53, 0x42, 0xf3, 0x26, 0x1c, 0xfa, 0x05, 0xcb,
+	0x73, 0xcb, 0xb1, 0x74, 0xf6, 0xbb, 0x0c, 0xfb, 0x8a, 0x90, 0x50, 0x78, 0xbe, 0x36, 0x19, 0xc9,
+	0x3b, 0x13, 0x96, 0x79, 0x7c, 0x3a, 0x2f, 0xb4, 0xd8, 0x5b, 0xce, 0x86, 0xee, 0x0e, 0x19, 0x41,
+	0x4d, 0x1b, 0x04, 0xa4, 0x93, 0x63, 0x56, 0x28, 0xbc, 0x93, 0xdc, 0x53, 0xc5, 0xdd, 0x21, 0x57,
+	0x50, 0xd3, 0x7a, 0xdb, 0xcc, 0x63, 0x6a, 0x7f, 0xb3, 0xee, 0x3b, 0x38, 0x58, 0xe9, 0x24, 0x72,
+	0x6c, 0x42, 0xdb, 0x6c, 0x6d, 0xe7, 0xed, 0x93, 0x71, 0x0b, 0xc5, 0x23, 0xa8, 0x69, 0xa5, 0x69,
+	0x56, 0x6c, 0xea, 0x3b, 0xe7, 0x24, 0x47, 0xe4, 0xaa, 0x33, 0x5a, 0x59, 0x99, 0x79, 0x4c, 0x95,
+	0x67, 0x74, 0xe6, 0xbc, 0xf2, 0x6d, 0x5f, 0xfd, 0x35, 0xf8, 0xae, 0x7e, 0xdf, 0xff, 0x1b, 0x00,
+	0x33, 0x2f, 0x4b, 0x9f, 0x53, 0x08, 0x00, 0x00,
 }
@@ -42,11 +42,32 @@ func BlobsToEntityByDeviceID(entities []blobstore.Blob) map[string]*PhysicalEnti
 	return ret
 }
 
+// BlobsToEntities maps a list of blobstore.Blob to a list of PhysicalEntity
+// in the same order
+func BlobsToEntities(blobs []blobstore.Blob) []*PhysicalEntity {
+	ret := make([]*PhysicalEntity, 0, len(blobs))
+	for _, blob := range blobs {
+		ret = append(ret, blobToEntity(blob))
+	}
+	return ret
+}
+
+// EntitiesToDeviceIDs maps a list of PhysicalEntity to the list of their
+// DeviceIDs
+func EntitiesToDeviceIDs(entities []*PhysicalEntity) []*DeviceID {
+	ret := make([]*DeviceID, 0, len(entities))
+	for _, entity := range entities {
+		ret = append(ret, &DeviceID{DeviceID: entity.GetDeviceID(), Type: entity.GetType()})
+	}
+	return ret
+}
+
 func entityToBlob(entity *PhysicalEntity) blobstore.Blob {
 	return blobstore.Blob{
-		Key:   entity.GetDeviceID(),
-		Type:  entity.GetType(),
-		Value: entity.GetInfo(),
+		Key:     entity.GetDeviceID(),
+		Type:    entity.GetType(),
+		Value:   entity.GetInfo(),
+		Version: entity.GetVersion(),
 	}
 }
 
@@ -55,6 +76,7 @@ func blobToEntity(blob blobstore.Blob) *PhysicalEntity {
 		Type:     blob.Type,
 		DeviceID: blob.Key,
 		Info:     blob.Value,
+		Version:  blob.Version,
 	}
 }
 
@@ -10,11 +10,16 @@ package servicers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"magma/orc8r/cloud/go/blobstore"
 	commonProtos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/device/protos"
+	"magma/orc8r/cloud/go/storage"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type deviceServicer struct {
@@ -58,6 +63,11 @@ func (srv *deviceServicer) GetDeviceInfo(ctx context.Context, req *protos.GetDev
 		return nil, err
 	}
 	blobs, err := store.GetMany(req.NetworkID, ids)
+	if err != nil {
+		store.Rollback()
+		return nil, err
+	}
+	store.Commit()
 	response.DeviceMap = protos.BlobsToEntityByDeviceID(blobs)
 	return response, nil
 }
@@ -80,3 +90,112 @@ func (srv *deviceServicer) DeleteDevices(ctx context.Context, req *protos.Delete
 	}
 	return void, store.Commit()
 }
+
+// ListDevices returns the devices on a network matching the request, sorted
+// by type and device ID
+func (srv *deviceServicer) ListDevices(ctx context.Context, req *protos.ListDevicesRequest) (*protos.ListDevicesResponse, error) {
+	if err := ValidateListDevicesRequest(req); err != nil {
+		return nil, err
+	}
+
+	networkID := req.GetNetworkID()
+	filter := blobstore.SearchFilter{NetworkID: &networkID}
+	if req.GetType() != "" {
+		filter.Types = []string{req.GetType()}
+	}
+	if req.GetDeviceIDPrefix() != "" {
+		prefix := req.GetDeviceIDPrefix()
+		filter.KeyPrefix = &prefix
+	}
+	blobsByNetwork, err := srv.search(filter)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.ListDevicesResponse{Entities: protos.BlobsToEntities(blobsByNetwork[networkID])}, nil
+}
+
+// SearchDevices returns the devices on all networks matching the request,
+// keyed by network
+func (srv *deviceServicer) SearchDevices(ctx context.Context, req *protos.SearchDevicesRequest) (*protos.SearchDevicesResponse, error) {
+	filter := blobstore.SearchFilter{Types: req.GetTypes()}
+	if req.GetNetworkID() != "" {
+		networkID := req.GetNetworkID()
+		filter.NetworkID = &networkID
+	}
+	if req.GetDeviceIDPrefix() != "" {
+		prefix := req.GetDeviceIDPrefix()
+		filter.KeyPrefix = &prefix
+	}
+	blobsByNetwork, err := srv.search(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &protos.SearchDevicesResponse{DevicesByNetwork: map[string]*protos.PhysicalEntities{}}
+	for networkID, blobs := range blobsByNetwork {
+		response.DevicesByNetwork[networkID] = &protos.PhysicalEntities{Entities: protos.BlobsToEntities(blobs)}
+	}
+	return response, nil
+}
+
+// UpdateDevices updates existing devices if none of them were modified since
+// the versions in the request were read
+func (srv *deviceServicer) UpdateDevices(ctx context.Context, req *protos.UpdateDevicesRequest) (*commonProtos.Void, error) {
+	void := &commonProtos.Void{}
+	if err := ValidateUpdateDevicesRequest(req); err != nil {
+		return void, err
+	}
+
+	ids := protos.DeviceIDsToTypeAndKey(protos.EntitiesToDeviceIDs(req.GetEntities()))
+	store, err := srv.factory.StartTransaction()
+	if err != nil {
+		return nil, err
+	}
+	existingBlobs, err := store.GetMany(req.NetworkID, ids)
+	if err != nil {
+		store.Rollback()
+		return void, err
+	}
+	existingByID := blobstore.GetBlobsByTypeAndKey(existingBlobs)
+	for _, entity := range req.GetEntities() {
+		existing, ok := existingByID[storage.TypeAndKey{Type: entity.GetType(), Key: entity.GetDeviceID()}]
+		if !ok {
+			store.Rollback()
+			return void, status.Errorf(codes.NotFound, "Device %s of type %s does not exist", entity.GetDeviceID(), entity.GetType())
+		}
+		if existing.Version != entity.GetVersion() {
+			store.Rollback()
+			return void, status.Errorf(
+				codes.FailedPrecondition,
+				"Device %s of type %s is at version %d, not %d",
+				entity.GetDeviceID(), entity.GetType(), existing.Version, entity.GetVersion(),
+			)
+		}
+	}
+
+	// The versions are checked again by the update itself, since the devices
+	// may be updated concurrently after they were read above
+	err = store.UpdateManyIfVersion(req.NetworkID, protos.EntitiesToBlobs(req.GetEntities()))
+	if errors.Is(err, blobstore.ErrVersionConflict) {
+		store.Rollback()
+		return void, commonProtos.NewGrpcConflictError("Devices were modified concurrently: %s", err)
+	}
+	if err != nil {
+		store.Rollback()
+		return void, err
+	}
+	return void, store.Commit()
+}
+
+func (srv *deviceServicer) search(filter blobstore.SearchFilter) (map[string][]blobstore.Blob, error) {
+	store, err := srv.factory.StartTransaction()
+	if err != nil {
+		return nil, err
+	}
+	blobsByNetwork, err := store.Search(filter)
+	if err != nil {
+		store.Rollback()
+		return nil, err
+	}
+	return blobsByNetwork, store.Commit()
+}
@@ -35,6 +35,21 @@ func ValidateDeleteDevicesRequest(req *protos.DeleteDevicesRequest) error {
 	return nonEmptyNetworkIDAndDeviceIDs(req.GetNetworkID(), req.GetDeviceIDs())
 }
 
+func ValidateListDevicesRequest(req *protos.ListDevicesRequest) error {
+	return nonEmptyNetworkID(req.GetNetworkID())
+}
+
+func ValidateUpdateDevicesRequest(req *protos.UpdateDevicesRequest) error {
+	if err := nonEmptyNetworkID(req.GetNetworkID()); err != nil {
+		return err
+	}
+	entities := req.GetEntities()
+	if err := nonEmptyEntities(entities); err != nil {
+		return err
+	}
+	return deserializableWithSerde(entities)
+}
+
 func deserializableWithSerde(entities []*protos.PhysicalEntity) error {
 	for _, entity := range entities {
 		_, err := serde.Deserialize(device.SerdeDomain, entity.GetType(), entity.GetInfo())
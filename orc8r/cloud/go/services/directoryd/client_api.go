@@ -11,6 +11,8 @@ LICENSE file in the root directory of this source tree.
 package directoryd
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/registry"
@@ -41,6 +43,37 @@ func GetHostNameByIMSI(hwId string) (string, error) {
 	return getLocation(protos.TableID_HWID_TO_HOSTNAME, hwId)
 }
 
+func GetIMSIByUEIP(ueIp string) (string, error) {
+	return getLocation(protos.TableID_UE_IP_TO_IMSI, ueIp)
+}
+
+// GetIMSIsByHardwareId returns the sorted IMSIs of all UEs attached to a
+// gateway
+func GetIMSIsByHardwareId(hwId string) ([]string, error) {
+	return GetIDsByLocation(protos.TableID_IMSI_TO_HWID, hwId)
+}
+
+// GetIDsByLocation returns the sorted IDs of all objects of a table at a
+// location
+func GetIDsByLocation(tableId protos.TableID, location string) ([]string, error) {
+	client, conn, err := GetDirectorydClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	req := &protos.GetIDsByLocationRequest{
+		Table:    tableId,
+		Location: location,
+	}
+	ctx := context.Background()
+	ids, err := client.GetIDsByLocation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return ids.Ids, nil
+}
+
 func getLocation(tableId protos.TableID, recordId string) (string, error) {
 	client, conn, err := GetDirectorydClient()
 	if err != nil {
@@ -68,6 +101,35 @@ func UpdateHostNameByHwId(hwId string, hostName string) error {
 	return updateLocation(protos.TableID_HWID_TO_HOSTNAME, hwId, hostName)
 }
 
+// UpdateIMSIByUEIP records the IMSI of the UE with the given IP. The record
+// expires after ttl, or after the default TTL of the table if ttl is 0.
+func UpdateIMSIByUEIP(ueIp string, imsi string, ttl time.Duration) error {
+	return UpdateLocations(protos.TableID_UE_IP_TO_IMSI, map[string]string{ueIp: imsi}, ttl)
+}
+
+// UpdateLocations updates the locations of many objects of a table, keyed by
+// object ID. The records expire after ttl, or after the default TTL of the
+// table if ttl is 0.
+func UpdateLocations(tableId protos.TableID, locations map[string]string, ttl time.Duration) error {
+	client, conn, err := GetDirectorydClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	req := &protos.UpdateDirectoryLocationsRequest{
+		Table:   tableId,
+		Records: make(map[string]*protos.LocationRecord, len(locations)),
+		TtlSecs: uint32(ttl / time.Second),
+	}
+	for id, location := range locations {
+		req.Records[id] = &protos.LocationRecord{Location: location}
+	}
+	ctx := context.Background()
+	_, err = client.UpdateLocations(ctx, req)
+	return err
+}
+
 func updateLocation(tableId protos.TableID, recordId string, location string) error {
 	client, conn, err := GetDirectorydClient()
 	if err != nil {
@@ -93,6 +155,10 @@ func DeleteHostNameByIMSI(hwId string) error {
 	return deleteLocation(protos.TableID_HWID_TO_HOSTNAME, hwId)
 }
 
+func DeleteIMSIByUEIP(ueIp string) error {
+	return deleteLocation(protos.TableID_UE_IP_TO_IMSI, ueIp)
+}
+
 func deleteLocation(tableId protos.TableID, recordId string) error {
 	client, conn, err := GetDirectorydClient()
 	if err != nil {
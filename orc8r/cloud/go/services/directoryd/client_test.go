@@ -10,7 +10,9 @@ package directoryd_test
 
 import (
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/directoryd"
 	directoryd_test_init "magma/orc8r/cloud/go/services/directoryd/test_init"
 
@@ -67,4 +69,25 @@ func TestDirectorydControllerClientMethods(t *testing.T) {
 	// Delete unknown
 	err = directoryd.DeleteHostNameByIMSI(testSubId3)
 	assert.EqualError(t, err, "rpc error: code = Unknown desc = Error finding location record: No record for query")
+
+	// UE IP table and reverse lookups
+	err = directoryd.UpdateIMSIByUEIP("192.168.128.11", testSubId1, 0)
+	assert.NoError(t, err)
+	err = directoryd.UpdateIMSIByUEIP("192.168.128.12", testSubId1, time.Hour)
+	assert.NoError(t, err)
+	imsi, err := directoryd.GetIMSIByUEIP("192.168.128.12")
+	assert.NoError(t, err)
+	assert.Equal(t, testSubId1, imsi)
+	ueIps, err := directoryd.GetIDsByLocation(protos.TableID_UE_IP_TO_IMSI, testSubId1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.168.128.11", "192.168.128.12"}, ueIps)
+
+	err = directoryd.DeleteIMSIByUEIP("192.168.128.11")
+	assert.NoError(t, err)
+	_, err = directoryd.GetIMSIByUEIP("192.168.128.11")
+	assert.Error(t, err)
+
+	imsis, err := directoryd.GetIMSIsByHardwareId(testGwId1)
+	assert.NoError(t, err)
+	assert.Empty(t, imsis)
 }
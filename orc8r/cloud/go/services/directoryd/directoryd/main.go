@@ -9,10 +9,13 @@ LICENSE file in the root directory of this source tree.
 package main
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/directoryd"
 	"magma/orc8r/cloud/go/services/directoryd/servicers"
 	"magma/orc8r/cloud/go/services/directoryd/storage"
@@ -20,6 +23,12 @@ import (
 	"github.com/golang/glog"
 )
 
+const (
+	recordTTLsKey          = "recordTTLSecs"
+	cleanupIntervalKey     = "expiredRecordCleanupIntervalSecs"
+	defaultCleanupInterval = 10 * time.Minute
+)
+
 func main() {
 	// Create Magma micro-service
 	directoryService, err := service.NewOrchestratorService(orc8r.ModuleName, directoryd.ServiceName)
@@ -36,7 +45,7 @@ func main() {
 	store := storage.GetDirectorydPersistenceService(db)
 
 	// Create directory gRPC servicer
-	directorydServicer, err := servicers.NewDirectoryServicer(store)
+	directorydServicer, err := servicers.NewDirectoryServicer(store, getRecordTTLs(directoryService.Config))
 	if err != nil {
 		glog.Errorf("Error creating directory gRPC servicer: %s", err)
 	}
@@ -45,6 +54,9 @@ func main() {
 	protos.RegisterDirectoryServiceServer(directoryService.GrpcServer, directorydServicer)
 	directoryService.GrpcServer.RegisterService(protos.GetLegacyDirectorydDesc(), directorydServicer)
 
+	go backfillLocationIndexes(store)
+	go deleteExpiredRecordsPeriodically(store, getCleanupInterval(directoryService.Config))
+
 	// Run the service
 	glog.V(2).Info("Starting Directory Service...")
 	err = directoryService.Run()
@@ -52,3 +64,54 @@ func main() {
 		glog.Errorf("Error running directory service: %s", err)
 	}
 }
+
+// backfillLocationIndexes indexes records written by earlier versions of the
+// service. Lookups by location may miss those records until it is done.
+func backfillLocationIndexes(store storage.DirectorydPersistenceService) {
+	if err := servicers.BackfillLocationIndexes(store); err != nil {
+		glog.Errorf("Error backfilling location indexes: %s", err)
+	}
+}
+
+func deleteExpiredRecordsPeriodically(store storage.DirectorydPersistenceService, interval time.Duration) {
+	for range time.Tick(interval) {
+		if err := servicers.DeleteExpiredRecords(store); err != nil {
+			glog.Errorf("Error deleting expired location records: %s", err)
+		}
+	}
+}
+
+// getRecordTTLs reads the per-table default record TTLs from the service
+// config
+func getRecordTTLs(cfg *config.ConfigMap) servicers.RecordTTLs {
+	ttls := servicers.RecordTTLs{}
+	if cfg == nil {
+		return ttls
+	}
+	rawTTLs, ok := cfg.RawMap[recordTTLsKey].(map[interface{}]interface{})
+	if !ok {
+		return ttls
+	}
+	for rawTable, rawTTL := range rawTTLs {
+		tableName, tableOk := rawTable.(string)
+		tableId, tableExists := protos.TableID_value[tableName]
+		ttlSecs, ttlOk := rawTTL.(int)
+		if !tableOk || !tableExists || !ttlOk || ttlSecs <= 0 {
+			glog.Errorf("Ignoring invalid record TTL %v: %v", rawTable, rawTTL)
+			continue
+		}
+		ttls[protos.TableID(tableId)] = time.Duration(ttlSecs) * time.Second
+	}
+	return ttls
+}
+
+func getCleanupInterval(cfg *config.ConfigMap) time.Duration {
+	if cfg == nil {
+		return defaultCleanupInterval
+	}
+	intervalSecs, err := cfg.GetIntParam(cleanupIntervalKey)
+	if err != nil || intervalSecs <= 0 {
+		return defaultCleanupInterval
+	}
+	return time.Duration(intervalSecs) * time.Second
+}
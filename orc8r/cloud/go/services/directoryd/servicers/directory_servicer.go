@@ -12,6 +12,7 @@ package servicers
 
 import (
 	"errors"
+	"time"
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/directoryd/storage"
@@ -24,10 +25,11 @@ import (
 
 type DirectoryServicer struct {
 	storage storage.DirectorydPersistenceService
+	ttls    RecordTTLs
 }
 
-func NewDirectoryServicer(storage storage.DirectorydPersistenceService) (*DirectoryServicer, error) {
-	srv := &DirectoryServicer{storage: storage}
+func NewDirectoryServicer(storage storage.DirectorydPersistenceService, ttls RecordTTLs) (*DirectoryServicer, error) {
+	srv := &DirectoryServicer{storage: storage, ttls: ttls}
 	return srv, nil
 }
 
@@ -47,20 +49,85 @@ func (srv *DirectoryServicer) UpdateLocation(ctx context.Context, request *proto
 	}
 	if request.Table == protos.TableID_IMSI_TO_HWID {
 		glog.V(2).Infof("update location request for IMSI->HWID table: %v\n", request)
-		gw := protos.GetClientGateway(ctx)
-		if gw == nil {
-			return nil, status.Errorf(
-				codes.PermissionDenied, "Missing Gateway Identity")
+		hwId, err := getGatewayHwId(ctx)
+		if err != nil {
+			return nil, err
 		}
-		if !gw.Registered() {
-			return nil, status.Errorf(
-				codes.PermissionDenied, "Gateway is not registered")
+		request.Record = &protos.LocationRecord{Location: hwId}
+	}
+	if request.Record == nil {
+		return ret, errors.New("Empty LocationRecord")
+	}
+
+	record := &protos.LocationRecord{
+		Location:       request.Record.Location,
+		ExpirationTime: srv.ttls.getExpirationTime(request.Table, request.TtlSecs, time.Now()),
+	}
+	err := srv.storage.UpdateOrCreateRecord(request.Table, request.Id, record)
+	return ret, err
+}
+
+// UpdateLocations updates the location records of many objects of a table.
+// Like UpdateLocation, records of the IMSI_TO_HWID table are located at the
+// calling gateway regardless of the locations in the request.
+func (srv *DirectoryServicer) UpdateLocations(ctx context.Context, request *protos.UpdateDirectoryLocationsRequest) (*protos.Void, error) {
+	ret := &protos.Void{}
+	if request == nil {
+		return ret, errors.New("Empty UpdateLocationsRequest")
+	}
+	if request.Replace && request.Table != protos.TableID_IMSI_TO_HWID {
+		return ret, status.Errorf(
+			codes.InvalidArgument, "Replacing records is only supported for the %s table", protos.TableID_IMSI_TO_HWID)
+	}
+	glog.V(2).Infof("update locations request for %s table with %d records\n", request.Table, len(request.Records))
+
+	var hwId string
+	if request.Table == protos.TableID_IMSI_TO_HWID {
+		var err error
+		hwId, err = getGatewayHwId(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	expirationTime := srv.ttls.getExpirationTime(request.Table, request.TtlSecs, time.Now())
+	records := make(map[string]*protos.LocationRecord, len(request.Records))
+	for id, requestRecord := range request.Records {
+		record := &protos.LocationRecord{Location: hwId, ExpirationTime: expirationTime}
+		if request.Table != protos.TableID_IMSI_TO_HWID {
+			if requestRecord == nil {
+				return ret, status.Errorf(codes.InvalidArgument, "Empty LocationRecord for %s", id)
+			}
+			record.Location = requestRecord.Location
 		}
-		request.Record = &protos.LocationRecord{Location: gw.HardwareId}
+		records[id] = record
 	}
 
-	srv.storage.UpdateOrCreateRecord(request.Table, request.Id, request.Record)
-	return ret, nil
+	if request.Replace {
+		keptIds := make([]string, 0, len(records))
+		for id := range records {
+			keptIds = append(keptIds, id)
+		}
+		if _, err := srv.storage.DeleteRecordsAtLocationExcept(request.Table, hwId, keptIds); err != nil {
+			return ret, err
+		}
+	}
+	err := srv.storage.UpdateOrCreateRecords(request.Table, records)
+	return ret, err
+}
+
+// GetIDsByLocation returns the IDs of all objects of a table at a location
+func (srv *DirectoryServicer) GetIDsByLocation(ctx context.Context, request *protos.GetIDsByLocationRequest) (*protos.LocationIDs, error) {
+	if request == nil {
+		return nil, errors.New("Empty GetIDsByLocationRequest")
+	}
+	if request.Location == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Location must be non-empty")
+	}
+	ids, err := srv.storage.GetRecordIDsByLocation(request.Table, request.Location)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.LocationIDs{Ids: ids}, nil
 }
 
 func (srv *DirectoryServicer) DeleteLocation(ctx context.Context, request *protos.DeleteLocationRequest) (*protos.Void, error) {
@@ -72,3 +139,17 @@ func (srv *DirectoryServicer) DeleteLocation(ctx context.Context, request *proto
 	err := srv.storage.DeleteRecord(request.Table, request.Id)
 	return ret, err
 }
+
+// getGatewayHwId returns the hardware ID of the calling gateway
+func getGatewayHwId(ctx context.Context) (string, error) {
+	gw := protos.GetClientGateway(ctx)
+	if gw == nil {
+		return "", status.Errorf(
+			codes.PermissionDenied, "Missing Gateway Identity")
+	}
+	if !gw.Registered() {
+		return "", status.Errorf(
+			codes.PermissionDenied, "Gateway is not registered")
+	}
+	return gw.HardwareId, nil
+}
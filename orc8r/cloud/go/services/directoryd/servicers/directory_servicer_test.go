@@ -10,6 +10,7 @@ package servicers_test
 
 import (
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/directoryd/servicers"
@@ -18,6 +19,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -34,7 +37,7 @@ const (
 
 func createTestDirectorydServicer(t *testing.T) *servicers.DirectoryServicer {
 	mockDB := test_utils.NewMockDatastore()
-	srv, _ := servicers.NewDirectoryServicer(storage.GetDirectorydPersistenceService(mockDB))
+	srv, _ := servicers.NewDirectoryServicer(storage.GetDirectorydPersistenceService(mockDB), servicers.RecordTTLs{})
 	return srv
 }
 
@@ -175,3 +178,134 @@ func TestDirectorydDeleteLocation(t *testing.T) {
 	_, err = srv.GetLocation(ctx, &getRequest)
 	assert.Error(t, err)
 }
+
+func TestDirectorydRecordTTLs(t *testing.T) {
+	mockDB := test_utils.NewMockDatastore()
+	srv, err := servicers.NewDirectoryServicer(
+		storage.GetDirectorydPersistenceService(mockDB),
+		servicers.RecordTTLs{protos.TableID_IMSI_TO_HWID: time.Hour},
+	)
+	assert.NoError(t, err)
+	ctx := getGatewayContext(testGwHwId1, testGwLogicalId1)
+
+	// Records of tables with a TTL expire after the TTL
+	_, err = srv.UpdateLocation(ctx, &protos.UpdateDirectoryLocationRequest{Id: testSubId1})
+	assert.NoError(t, err)
+	record, err := srv.GetLocation(ctx, &protos.GetLocationRequest{Id: testSubId1})
+	assert.NoError(t, err)
+	assert.Equal(t, testGwHwId1, record.Location)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), record.ExpirationTime, 5)
+
+	// The TTL of the request takes precedence
+	_, err = srv.UpdateLocation(ctx, &protos.UpdateDirectoryLocationRequest{Id: testSubId1, TtlSecs: 60})
+	assert.NoError(t, err)
+	record, err = srv.GetLocation(ctx, &protos.GetLocationRequest{Id: testSubId1})
+	assert.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(time.Minute).Unix(), record.ExpirationTime, 5)
+
+	// Records of tables without a TTL never expire
+	_, err = srv.UpdateLocation(ctx, &protos.UpdateDirectoryLocationRequest{
+		Id:     testGwHwId1,
+		Record: &protos.LocationRecord{Location: "host1"},
+		Table:  protos.TableID_HWID_TO_HOSTNAME,
+	})
+	assert.NoError(t, err)
+	record, err = srv.GetLocation(ctx, &protos.GetLocationRequest{Id: testGwHwId1, Table: protos.TableID_HWID_TO_HOSTNAME})
+	assert.NoError(t, err)
+	assert.Equal(t, &protos.LocationRecord{Location: "host1"}, record)
+
+	// Records past their expiration time are not returned
+	expired, err := protos.MarshalIntern(&protos.LocationRecord{Location: testGwHwId1, ExpirationTime: time.Now().Unix() - 1})
+	assert.NoError(t, err)
+	assert.NoError(t, mockDB.Put(protos.TableID_IMSI_TO_HWID.String(), testSubId2, expired))
+	_, err = srv.GetLocation(ctx, &protos.GetLocationRequest{Id: testSubId2})
+	assert.Error(t, err)
+}
+
+func TestDirectorydUpdateLocations(t *testing.T) {
+	srv := createTestDirectorydServicer(t)
+	ctx1 := getGatewayContext(testGwHwId1, testGwLogicalId1)
+	ctx2 := getGatewayContext(testGwHwId2, testGwLogicalId2)
+
+	// IMSI records are located at the calling gateway
+	request := &protos.UpdateDirectoryLocationsRequest{
+		Records: map[string]*protos.LocationRecord{
+			testSubId1: {Location: testGwHwId3},
+			testSubId2: nil,
+		},
+	}
+	_, err := srv.UpdateLocations(ctx1, request)
+	assert.NoError(t, err)
+	_, err = srv.UpdateLocations(ctx2, &protos.UpdateDirectoryLocationsRequest{
+		Records: map[string]*protos.LocationRecord{testSubId3: {}},
+	})
+	assert.NoError(t, err)
+	assertIDsByLocation(t, srv, protos.TableID_IMSI_TO_HWID, testGwHwId1, testSubId1, testSubId2)
+	assertIDsByLocation(t, srv, protos.TableID_IMSI_TO_HWID, testGwHwId2, testSubId3)
+
+	// Replacing drops the gateway's records which aren't reported anymore,
+	// and moves UEs which attached to the gateway
+	_, err = srv.UpdateLocations(ctx1, &protos.UpdateDirectoryLocationsRequest{
+		Records: map[string]*protos.LocationRecord{testSubId2: {}, testSubId3: {}},
+		Replace: true,
+	})
+	assert.NoError(t, err)
+	assertIDsByLocation(t, srv, protos.TableID_IMSI_TO_HWID, testGwHwId1, testSubId2, testSubId3)
+	assertIDsByLocation(t, srv, protos.TableID_IMSI_TO_HWID, testGwHwId2)
+	_, err = srv.GetLocation(ctx1, &protos.GetLocationRequest{Id: testSubId1})
+	assert.Error(t, err)
+
+	// Replacing with no records clears the gateway
+	_, err = srv.UpdateLocations(ctx1, &protos.UpdateDirectoryLocationsRequest{Replace: true})
+	assert.NoError(t, err)
+	assertIDsByLocation(t, srv, protos.TableID_IMSI_TO_HWID, testGwHwId1)
+
+	// Other tables take the locations from the request, but can't be replaced
+	_, err = srv.UpdateLocations(ctx1, &protos.UpdateDirectoryLocationsRequest{
+		Table: protos.TableID_UE_IP_TO_IMSI,
+		Records: map[string]*protos.LocationRecord{
+			"192.168.128.11": {Location: testSubId1},
+			"192.168.128.12": {Location: testSubId1},
+			"192.168.128.13": {Location: testSubId2},
+		},
+	})
+	assert.NoError(t, err)
+	record, err := srv.GetLocation(ctx1, &protos.GetLocationRequest{Id: "192.168.128.13", Table: protos.TableID_UE_IP_TO_IMSI})
+	assert.NoError(t, err)
+	assert.Equal(t, testSubId2, record.Location)
+	assertIDsByLocation(t, srv, protos.TableID_UE_IP_TO_IMSI, testSubId1, "192.168.128.11", "192.168.128.12")
+
+	_, err = srv.UpdateLocations(ctx1, &protos.UpdateDirectoryLocationsRequest{
+		Table:   protos.TableID_UE_IP_TO_IMSI,
+		Replace: true,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.UpdateLocations(ctx1, &protos.UpdateDirectoryLocationsRequest{
+		Table:   protos.TableID_UE_IP_TO_IMSI,
+		Records: map[string]*protos.LocationRecord{"192.168.128.14": nil},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// IMSI records can only be reported by gateways
+	_, err = srv.UpdateLocations(context.Background(), request)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = srv.GetIDsByLocation(ctx1, &protos.GetIDsByLocationRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func getGatewayContext(hwId string, logicalId string) context.Context {
+	id := protos.Identity{}
+	idgw := protos.Identity_Gateway{HardwareId: hwId, NetworkId: testNetworkId, LogicalId: logicalId}
+	id.SetGateway(&idgw)
+	return id.NewContextWithIdentity(context.Background())
+}
+
+func assertIDsByLocation(t *testing.T, srv *servicers.DirectoryServicer, table protos.TableID, location string, expected ...string) {
+	ids, err := srv.GetIDsByLocation(context.Background(), &protos.GetIDsByLocationRequest{Table: table, Location: location})
+	assert.NoError(t, err)
+	if expected == nil {
+		expected = []string{}
+	}
+	assert.Equal(t, expected, ids.Ids)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/directoryd/storage"
+
+	"github.com/golang/glog"
+)
+
+// RecordTTLs maps tables to how long their records are kept after they were
+// last updated, if the update doesn't set a TTL of its own. Records of tables
+// without a TTL never expire unless the update sets one.
+type RecordTTLs map[protos.TableID]time.Duration
+
+// getExpirationTime returns the expiration time of a record of the table
+// updated at now with the given TTL, or 0 if the record never expires.
+func (ttls RecordTTLs) getExpirationTime(tableId protos.TableID, ttlSecs uint32, now time.Time) int64 {
+	ttl := time.Duration(ttlSecs) * time.Second
+	if ttlSecs == 0 {
+		ttl = ttls[tableId]
+	}
+	if ttl <= 0 {
+		return 0
+	}
+	return now.Add(ttl).Unix()
+}
+
+// DeleteExpiredRecords deletes the expired records of all tables. Services
+// should call this periodically so expired records don't pile up in storage.
+func DeleteExpiredRecords(store storage.DirectorydPersistenceService) error {
+	for _, tableId := range getSortedTableIDs() {
+		numDeleted, err := store.DeleteExpiredRecords(tableId)
+		if err != nil {
+			return err
+		}
+		if numDeleted > 0 {
+			glog.V(2).Infof("Deleted %d expired records from %s", numDeleted, tableId)
+		}
+	}
+	return nil
+}
+
+// BackfillLocationIndexes indexes the records of all tables written before
+// the location index existed. It only needs to run once, but is idempotent.
+func BackfillLocationIndexes(store storage.DirectorydPersistenceService) error {
+	for _, tableId := range getSortedTableIDs() {
+		numIndexed, err := store.BackfillLocationIndex(tableId)
+		if err != nil {
+			return err
+		}
+		if numIndexed > 0 {
+			glog.Infof("Indexed %d location records of %s", numIndexed, tableId)
+		}
+	}
+	return nil
+}
+
+func getSortedTableIDs() []protos.TableID {
+	tableIds := make([]int, 0, len(protos.TableID_name))
+	for tableId := range protos.TableID_name {
+		tableIds = append(tableIds, int(tableId))
+	}
+	sort.Ints(tableIds)
+	ret := make([]protos.TableID, 0, len(tableIds))
+	for _, tableId := range tableIds {
+		ret = append(ret, protos.TableID(tableId))
+	}
+	return ret
+}
@@ -10,11 +10,28 @@ package storage
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/protos"
 )
 
+// Each table has a location index table, whose keys are
+// <location><locationIndexSep><record ID> and whose values are empty. Index
+// keys are written before and deleted after the records they point to, and
+// reverse lookups check every indexed record, so stale index keys are never
+// returned.
+const (
+	locationIndexTableSuffix = "_BY_LOCATION"
+	locationIndexSep         = "/"
+)
+
+// recordPageSize is the number of records loaded at once by operations over
+// a whole table
+const recordPageSize = 1000
+
 type DirectorydPersistenceServiceImpl struct {
 	db datastore.Api
 }
@@ -34,20 +51,57 @@ func (store *DirectorydPersistenceServiceImpl) GetRecord(tableId protos.TableID,
 	if err := protos.Unmarshal(marshaledRecord, ret); err != nil {
 		return nil, fmt.Errorf("Error unmarshalling location record: %s", err)
 	}
+	if isExpired(ret, time.Now()) {
+		return nil, fmt.Errorf("Error getting location record: %s", datastore.ErrNotFound)
+	}
 	return ret, nil
 }
 
 func (store *DirectorydPersistenceServiceImpl) UpdateOrCreateRecord(tableId protos.TableID, recordId string, record *protos.LocationRecord) error {
-	recordTbl := tableId.String()
+	return store.UpdateOrCreateRecords(tableId, map[string]*protos.LocationRecord{recordId: record})
+}
 
-	value, err := protos.MarshalIntern(record)
+func (store *DirectorydPersistenceServiceImpl) UpdateOrCreateRecords(tableId protos.TableID, records map[string]*protos.LocationRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	recordTbl := tableId.String()
+	recordIds := make([]string, 0, len(records))
+	for recordId := range records {
+		recordIds = append(recordIds, recordId)
+	}
+	existingRecords, _, err := store.getRecords(tableId, recordIds)
 	if err != nil {
-		return fmt.Errorf("Error marshaling location record: %s", err)
+		return err
+	}
+
+	values := make(map[string][]byte, len(records))
+	newIndexKeys := map[string][]byte{}
+	staleIndexKeys := []string{}
+	for recordId, record := range records {
+		value, err := protos.MarshalIntern(record)
+		if err != nil {
+			return fmt.Errorf("Error marshaling location record: %s", err)
+		}
+		values[recordId] = value
+		existing, exists := existingRecords[recordId]
+		if !exists || existing.Location != record.Location {
+			newIndexKeys[getLocationIndexKey(record.Location, recordId)] = []byte{}
+		}
+		if exists && existing.Location != record.Location {
+			staleIndexKeys = append(staleIndexKeys, getLocationIndexKey(existing.Location, recordId))
+		}
 	}
 
-	if err := store.db.Put(recordTbl, recordId, value); err != nil {
+	if err := store.putMany(getLocationIndexTable(tableId), newIndexKeys); err != nil {
+		return fmt.Errorf("Error updating location index: %s", err)
+	}
+	if err := store.putMany(recordTbl, values); err != nil {
 		return fmt.Errorf("Error updating new location record: %s", err)
 	}
+	if err := store.deleteMany(getLocationIndexTable(tableId), staleIndexKeys); err != nil {
+		return fmt.Errorf("Error updating location index: %s", err)
+	}
 	return nil
 }
 
@@ -59,9 +113,254 @@ func (store *DirectorydPersistenceServiceImpl) DeleteRecord(tableId protos.Table
 		return fmt.Errorf("Error finding location record: %s", err)
 	}
 
-	err = store.db.Delete(recordTbl, recordId)
+	err = store.DeleteRecords(tableId, []string{recordId})
 	if err != nil {
 		return fmt.Errorf("Error deleting location record: %s", err)
 	}
 	return err
 }
+
+func (store *DirectorydPersistenceServiceImpl) DeleteRecords(tableId protos.TableID, recordIds []string) error {
+	if len(recordIds) == 0 {
+		return nil
+	}
+	existingRecords, _, err := store.getRecords(tableId, recordIds)
+	if err != nil {
+		return err
+	}
+	if err := store.deleteMany(tableId.String(), recordIds); err != nil {
+		return err
+	}
+	indexKeys := make([]string, 0, len(existingRecords))
+	for recordId, record := range existingRecords {
+		indexKeys = append(indexKeys, getLocationIndexKey(record.Location, recordId))
+	}
+	return store.deleteMany(getLocationIndexTable(tableId), indexKeys)
+}
+
+func (store *DirectorydPersistenceServiceImpl) GetRecordIDsByLocation(tableId protos.TableID, location string) ([]string, error) {
+	records, _, err := store.getRecordsAtLocation(tableId, location)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	ret := []string{}
+	for recordId, record := range records {
+		if !isExpired(record, now) {
+			ret = append(ret, recordId)
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (store *DirectorydPersistenceServiceImpl) DeleteRecordsAtLocationExcept(tableId protos.TableID, location string, keptIds []string) (int, error) {
+	records, generations, err := store.getRecordsAtLocation(tableId, location)
+	if err != nil {
+		return 0, err
+	}
+	for _, recordId := range keptIds {
+		delete(records, recordId)
+	}
+	numDeleted, err := store.deleteUnchangedRecords(tableId, records, generations)
+	if err != nil {
+		return numDeleted, fmt.Errorf("Error deleting location records: %s", err)
+	}
+	return numDeleted, nil
+}
+
+func (store *DirectorydPersistenceServiceImpl) DeleteExpiredRecords(tableId protos.TableID) (int, error) {
+	numDeleted := 0
+	now := time.Now()
+	err := store.forEachRecordPage(tableId, func(records map[string]*protos.LocationRecord, generations map[string]uint64) error {
+		expiredRecords := map[string]*protos.LocationRecord{}
+		for recordId, record := range records {
+			if isExpired(record, now) {
+				expiredRecords[recordId] = record
+			}
+		}
+		n, err := store.deleteUnchangedRecords(tableId, expiredRecords, generations)
+		numDeleted += n
+		if err != nil {
+			return fmt.Errorf("Error deleting expired location records: %s", err)
+		}
+		return nil
+	})
+	return numDeleted, err
+}
+
+func (store *DirectorydPersistenceServiceImpl) BackfillLocationIndex(tableId protos.TableID) (int, error) {
+	numIndexed := 0
+	indexTbl := getLocationIndexTable(tableId)
+	err := store.forEachRecordPage(tableId, func(records map[string]*protos.LocationRecord, _ map[string]uint64) error {
+		indexKeys := make([]string, 0, len(records))
+		for recordId, record := range records {
+			indexKeys = append(indexKeys, getLocationIndexKey(record.Location, recordId))
+		}
+		existingIndexKeys, err := store.db.GetMany(indexTbl, indexKeys)
+		if err != nil {
+			return fmt.Errorf("Error getting location index: %s", err)
+		}
+		missingIndexKeys := map[string][]byte{}
+		for _, indexKey := range indexKeys {
+			if _, exists := existingIndexKeys[indexKey]; !exists {
+				missingIndexKeys[indexKey] = []byte{}
+			}
+		}
+		if err := store.putMany(indexTbl, missingIndexKeys); err != nil {
+			return fmt.Errorf("Error updating location index: %s", err)
+		}
+		numIndexed += len(missingIndexKeys)
+		return nil
+	})
+	return numIndexed, err
+}
+
+// forEachRecordPage calls f with each page of the table's records and their
+// generations, keyed by ID, until f returns an error
+func (store *DirectorydPersistenceServiceImpl) forEachRecordPage(
+	tableId protos.TableID,
+	f func(records map[string]*protos.LocationRecord, generations map[string]uint64) error,
+) error {
+	criteria := datastore.ListKeysCriteria{PageSize: recordPageSize}
+	for {
+		recordIds, nextToken, err := store.db.ListKeysPage(tableId.String(), criteria)
+		if err != nil {
+			return fmt.Errorf("Error listing location records: %s", err)
+		}
+		records, generations, err := store.getRecords(tableId, recordIds)
+		if err != nil {
+			return err
+		}
+		if err := f(records, generations); err != nil {
+			return err
+		}
+		if nextToken == "" {
+			return nil
+		}
+		criteria.PageToken = nextToken
+	}
+}
+
+// getRecordsAtLocation returns the records of the table at a location and
+// their generations keyed by ID, including expired ones
+func (store *DirectorydPersistenceServiceImpl) getRecordsAtLocation(tableId protos.TableID, location string) (map[string]*protos.LocationRecord, map[string]uint64, error) {
+	keyPrefix := location + locationIndexSep
+	indexKeys, _, err := store.db.ListKeysPage(getLocationIndexTable(tableId), datastore.ListKeysCriteria{KeyPrefix: keyPrefix})
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error listing location index: %s", err)
+	}
+	candidateIds := make([]string, 0, len(indexKeys))
+	for _, indexKey := range indexKeys {
+		candidateIds = append(candidateIds, strings.TrimPrefix(indexKey, keyPrefix))
+	}
+	records, generations, err := store.getRecords(tableId, candidateIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	for recordId, record := range records {
+		if record.Location != location {
+			delete(records, recordId)
+		}
+	}
+	return records, generations, nil
+}
+
+// getRecords returns the existing records of the table and their generations
+// keyed by ID, including expired ones
+func (store *DirectorydPersistenceServiceImpl) getRecords(tableId protos.TableID, recordIds []string) (map[string]*protos.LocationRecord, map[string]uint64, error) {
+	ret := map[string]*protos.LocationRecord{}
+	generations := map[string]uint64{}
+	if len(recordIds) == 0 {
+		return ret, generations, nil
+	}
+	marshaledRecords, err := store.db.GetMany(tableId.String(), recordIds)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error getting location records: %s", err)
+	}
+	for recordId, marshaledRecord := range marshaledRecords {
+		record := &protos.LocationRecord{}
+		if err := protos.Unmarshal(marshaledRecord.Value, record); err != nil {
+			return nil, nil, fmt.Errorf("Error unmarshalling location record: %s", err)
+		}
+		ret[recordId] = record
+		generations[recordId] = marshaledRecord.Generation
+	}
+	return ret, generations, nil
+}
+
+// deleteUnchangedRecords deletes the given records, read at the given
+// generations, together with their location index keys. Records updated or
+// deleted since they were read are left alone, so a concurrent write is never
+// lost. Returns how many records were deleted.
+func (store *DirectorydPersistenceServiceImpl) deleteUnchangedRecords(
+	tableId protos.TableID,
+	records map[string]*protos.LocationRecord,
+	generations map[string]uint64,
+) (int, error) {
+	if len(records) == 0 {
+		return 0, nil
+	}
+	generationsByKey := make(map[string]uint64, len(records))
+	for recordId := range records {
+		generationsByKey[recordId] = generations[recordId]
+	}
+	failedDeletes, err := store.db.DeleteManyIfGeneration(tableId.String(), generationsByKey)
+	if err != nil {
+		return 0, err
+	}
+	indexKeys := make([]string, 0, len(records))
+	for recordId, record := range records {
+		if err, failed := failedDeletes[recordId]; failed {
+			if datastore.IsErrGenerationConflict(err) || datastore.IsErrNotFound(err) {
+				continue
+			}
+			return 0, fmt.Errorf("%s: %s", recordId, err)
+		}
+		indexKeys = append(indexKeys, getLocationIndexKey(record.Location, recordId))
+	}
+	if err := store.deleteMany(getLocationIndexTable(tableId), indexKeys); err != nil {
+		return len(indexKeys), fmt.Errorf("Error updating location index: %s", err)
+	}
+	return len(indexKeys), nil
+}
+
+func (store *DirectorydPersistenceServiceImpl) putMany(table string, values map[string][]byte) error {
+	if len(values) == 0 {
+		return nil
+	}
+	failedPuts, err := store.db.PutMany(table, values)
+	if err != nil {
+		return err
+	}
+	for key, err := range failedPuts {
+		return fmt.Errorf("%s: %s", key, err)
+	}
+	return nil
+}
+
+func (store *DirectorydPersistenceServiceImpl) deleteMany(table string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	failedDeletes, err := store.db.DeleteMany(table, keys)
+	if err != nil {
+		return err
+	}
+	for key, err := range failedDeletes {
+		return fmt.Errorf("%s: %s", key, err)
+	}
+	return nil
+}
+
+func isExpired(record *protos.LocationRecord, now time.Time) bool {
+	return record.ExpirationTime != 0 && now.Unix() >= record.ExpirationTime
+}
+
+func getLocationIndexTable(tableId protos.TableID) string {
+	return tableId.String() + locationIndexTableSuffix
+}
+
+func getLocationIndexKey(location string, recordId string) string {
+	return location + locationIndexSep + recordId
+}
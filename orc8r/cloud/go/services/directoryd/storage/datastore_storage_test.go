@@ -10,7 +10,9 @@ package storage_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/directoryd/storage"
@@ -132,6 +134,161 @@ func TestPersistenceService_UpdateOrCreateRecord(t *testing.T) {
 	assertDatastoreWritesSucceeded(t, db, location_map)
 }
 
+func TestPersistenceService_LocationIndexAndExpiry(t *testing.T) {
+	db := test_utils.NewMockDatastore()
+	store := storage.GetDirectorydPersistenceService(db)
+	expired := time.Now().Unix() - 1
+	valid := time.Now().Unix() + 3600
+
+	err := store.UpdateOrCreateRecords(protos.TableID_IMSI_TO_HWID, map[string]*protos.LocationRecord{
+		"sid1": {Location: "gw1"},
+		"sid2": {Location: "gw1", ExpirationTime: valid},
+		"sid3": {Location: "gw1", ExpirationTime: expired},
+		"sid4": {Location: "gw2"},
+	})
+	assert.NoError(t, err)
+	ids, err := store.GetRecordIDsByLocation(protos.TableID_IMSI_TO_HWID, "gw1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sid1", "sid2"}, ids)
+	_, err = store.GetRecord(protos.TableID_IMSI_TO_HWID, "sid3")
+	assert.Error(t, err)
+
+	// Moving a record updates the index of both locations
+	err = store.UpdateOrCreateRecord(protos.TableID_IMSI_TO_HWID, "sid1", &protos.LocationRecord{Location: "gw2"})
+	assert.NoError(t, err)
+	ids, err = store.GetRecordIDsByLocation(protos.TableID_IMSI_TO_HWID, "gw1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sid2"}, ids)
+	ids, err = store.GetRecordIDsByLocation(protos.TableID_IMSI_TO_HWID, "gw2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sid1", "sid4"}, ids)
+	// Locations which are prefixes of each other don't mix
+	ids, err = store.GetRecordIDsByLocation(protos.TableID_IMSI_TO_HWID, "gw")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, ids)
+
+	// Expired records and their index keys are deleted
+	numDeleted, err := store.DeleteExpiredRecords(protos.TableID_IMSI_TO_HWID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, numDeleted)
+	keys, err := db.ListKeys(protos.TableID_IMSI_TO_HWID.String())
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"sid1", "sid2", "sid4"}, keys)
+	indexKeys, err := db.ListKeys(protos.TableID_IMSI_TO_HWID.String() + "_BY_LOCATION")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"gw2/sid1", "gw1/sid2", "gw2/sid4"}, indexKeys)
+
+	// Deleting records deletes their index keys
+	err = store.DeleteRecords(protos.TableID_IMSI_TO_HWID, []string{"sid1", "sid5"})
+	assert.NoError(t, err)
+	indexKeys, err = db.ListKeys(protos.TableID_IMSI_TO_HWID.String() + "_BY_LOCATION")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"gw1/sid2", "gw2/sid4"}, indexKeys)
+}
+
+func TestPersistenceService_BackfillLocationIndex(t *testing.T) {
+	db := test_utils.NewMockDatastore()
+	store := storage.GetDirectorydPersistenceService(db)
+
+	// Records written before the index existed span several pages
+	numRecords := 1500
+	expired := time.Now().Unix() - 1
+	for i := 0; i < numRecords; i++ {
+		record := &protos.LocationRecord{Location: fmt.Sprintf("gw%d", i%2)}
+		if i%3 == 0 {
+			record.ExpirationTime = expired
+		}
+		marshaled, err := protos.MarshalIntern(record)
+		assert.NoError(t, err)
+		assert.NoError(t, db.Put(protos.TableID_IMSI_TO_HWID.String(), fmt.Sprintf("sid%04d", i), marshaled))
+	}
+	ids, err := store.GetRecordIDsByLocation(protos.TableID_IMSI_TO_HWID, "gw1")
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+
+	numIndexed, err := store.BackfillLocationIndex(protos.TableID_IMSI_TO_HWID)
+	assert.NoError(t, err)
+	assert.Equal(t, numRecords, numIndexed)
+	ids, err = store.GetRecordIDsByLocation(protos.TableID_IMSI_TO_HWID, "gw1")
+	assert.NoError(t, err)
+	assert.Len(t, ids, 500)
+
+	// Backfilling again adds nothing
+	numIndexed, err = store.BackfillLocationIndex(protos.TableID_IMSI_TO_HWID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, numIndexed)
+
+	// Expired records are deleted from all pages
+	numDeleted, err := store.DeleteExpiredRecords(protos.TableID_IMSI_TO_HWID)
+	assert.NoError(t, err)
+	assert.Equal(t, numRecords/3, numDeleted)
+	keys, err := db.ListKeys(protos.TableID_IMSI_TO_HWID.String())
+	assert.NoError(t, err)
+	assert.Len(t, keys, numRecords-numRecords/3)
+	indexKeys, err := db.ListKeys(protos.TableID_IMSI_TO_HWID.String() + "_BY_LOCATION")
+	assert.NoError(t, err)
+	assert.Len(t, indexKeys, numRecords-numRecords/3)
+}
+
+// racingDatastore runs beforeDelete before each conditional delete, standing
+// in for a write which lands between a read and the delete based on it
+type racingDatastore struct {
+	*test_utils.MockDatastore
+	beforeDelete func()
+}
+
+func (r *racingDatastore) DeleteManyIfGeneration(table string, generationsByKey map[string]uint64) (map[string]error, error) {
+	if r.beforeDelete != nil {
+		r.beforeDelete()
+		r.beforeDelete = nil
+	}
+	return r.MockDatastore.DeleteManyIfGeneration(table, generationsByKey)
+}
+
+func TestPersistenceService_DeletesKeepConcurrentWrites(t *testing.T) {
+	db := &racingDatastore{MockDatastore: test_utils.NewMockDatastore()}
+	store := storage.GetDirectorydPersistenceService(db)
+	expired := time.Now().Unix() - 1
+	valid := time.Now().Unix() + 3600
+
+	err := store.UpdateOrCreateRecords(protos.TableID_IMSI_TO_HWID, map[string]*protos.LocationRecord{
+		"sid1": {Location: "gw1"},
+		"sid2": {Location: "gw1"},
+		"sid3": {Location: "gw1"},
+	})
+	assert.NoError(t, err)
+
+	// sid2 moves to gw2 after gw1's stale records were read
+	db.beforeDelete = func() {
+		assert.NoError(t, store.UpdateOrCreateRecord(protos.TableID_IMSI_TO_HWID, "sid2", &protos.LocationRecord{Location: "gw2"}))
+	}
+	numDeleted, err := store.DeleteRecordsAtLocationExcept(protos.TableID_IMSI_TO_HWID, "gw1", []string{"sid1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, numDeleted)
+	ids, err := store.GetRecordIDsByLocation(protos.TableID_IMSI_TO_HWID, "gw1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sid1"}, ids)
+	ids, err = store.GetRecordIDsByLocation(protos.TableID_IMSI_TO_HWID, "gw2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sid2"}, ids)
+
+	// sid1 is renewed after it was read as expired
+	err = store.UpdateOrCreateRecord(protos.TableID_IMSI_TO_HWID, "sid1", &protos.LocationRecord{Location: "gw1", ExpirationTime: expired})
+	assert.NoError(t, err)
+	db.beforeDelete = func() {
+		assert.NoError(t, store.UpdateOrCreateRecord(protos.TableID_IMSI_TO_HWID, "sid1", &protos.LocationRecord{Location: "gw1", ExpirationTime: valid}))
+	}
+	numDeleted, err = store.DeleteExpiredRecords(protos.TableID_IMSI_TO_HWID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, numDeleted)
+	record, err := store.GetRecord(protos.TableID_IMSI_TO_HWID, "sid1")
+	assert.NoError(t, err)
+	assert.Equal(t, valid, record.ExpirationTime)
+	indexKeys, err := db.ListKeys(protos.TableID_IMSI_TO_HWID.String() + "_BY_LOCATION")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"gw1/sid1", "gw2/sid2"}, indexKeys)
+}
+
 func assertDatastoreWritesSucceeded(t *testing.T, store *test_utils.MockDatastore, location_map map[string]interface{}) {
 	test_utils.AssertDatastoreHasRows(
 		t, store,
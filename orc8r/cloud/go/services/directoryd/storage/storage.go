@@ -15,6 +15,9 @@ import (
 /*
 	Persistence service interface for location records. All Directoryd data accesses from
 	directoryd service must go through this interface.
+
+	Records whose expiration time has passed are treated as if they didn't
+	exist and are removed by DeleteExpiredRecords.
 */
 type DirectorydPersistenceService interface {
 
@@ -24,6 +27,30 @@ type DirectorydPersistenceService interface {
 	// Update existing location record or persist new location record
 	UpdateOrCreateRecord(tableId protos.TableID, recordId string, record *protos.LocationRecord) error
 
+	// Update existing location records or persist new location records,
+	// keyed by record ID
+	UpdateOrCreateRecords(tableId protos.TableID, records map[string]*protos.LocationRecord) error
+
 	// Delete location record
 	DeleteRecord(tableId protos.TableID, recordId string) error
+
+	// Delete location records. IDs without a record are ignored.
+	DeleteRecords(tableId protos.TableID, recordIds []string) error
+
+	// Get the sorted IDs of all records at a location
+	GetRecordIDsByLocation(tableId protos.TableID, location string) ([]string, error)
+
+	// Delete the records at a location whose IDs aren't in keptIds and return
+	// how many were deleted. Records moved or updated after they were read
+	// are kept.
+	DeleteRecordsAtLocationExcept(tableId protos.TableID, location string, keptIds []string) (int, error)
+
+	// Delete all expired location records of a table and return how many
+	// were deleted. Records renewed after they were read are kept.
+	DeleteExpiredRecords(tableId protos.TableID) (int, error)
+
+	// Add the location index entries missing for the records of a table and
+	// return how many were added. Records written before the location index
+	// existed are only found by GetRecordIDsByLocation once indexed.
+	BackfillLocationIndex(tableId protos.TableID) (int, error)
 }
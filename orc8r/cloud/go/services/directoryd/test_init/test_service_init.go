@@ -28,7 +28,7 @@ func StartTestService(t *testing.T) {
 	persistence_service := storage.GetDirectorydPersistenceService(db)
 
 	// Create directory gRPC servicer
-	directory_gRPC_servicer, err := servicers.NewDirectoryServicer(persistence_service)
+	directory_gRPC_servicer, err := servicers.NewDirectoryServicer(persistence_service, servicers.RecordTTLs{})
 	if err != nil {
 		glog.Errorf("Error creating directory gRPC servicer: %s", err)
 	}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/http2"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+)
+
+// ForwardedHeaderKey is set on requests which a dispatcher replica forwarded
+// to another replica. Forwarded requests are never forwarded again, so stale
+// routes can't make requests loop between replicas.
+const ForwardedHeaderKey = "Magma-Dispatcher-Forwarded"
+
+// RequestForwarder forwards gateway requests to other dispatcher replicas.
+type RequestForwarder interface {
+	// ForwardRequest sends the request to the replica at hostName, which
+	// passes it on to the gateway, and returns the gateway's response.
+	// Cancelling ctx cancels the request on the other replica.
+	ForwardRequest(ctx context.Context, hostName string, gwReq *protos.GatewayRequest) (*protos.GatewayResponse, error)
+}
+
+type httpRequestForwarder struct {
+	client *http2.H2CClient
+	port   int
+}
+
+// NewHTTPRequestForwarder returns a RequestForwarder which sends requests to
+// the SyncRPC HTTP server of the other replica at the given port, the same
+// way cloud services reach gateways.
+func NewHTTPRequestForwarder(port int) RequestForwarder {
+	return &httpRequestForwarder{client: http2.NewH2CClient(), port: port}
+}
+
+func (f *httpRequestForwarder) ForwardRequest(
+	ctx context.Context,
+	hostName string,
+	gwReq *protos.GatewayRequest,
+) (*protos.GatewayResponse, error) {
+	url := fmt.Sprintf("http://%s:%d%s", hostName, f.port, gwReq.Path)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(gwReq.Payload))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Host = gwReq.Authority
+	for k, v := range gwReq.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set(gateway_registry.GatewayIdHeaderKey, gwReq.GwId)
+	req.Header.Set(ForwardedHeaderKey, "true")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to forward request to %s: %s", hostName, err)
+	}
+	defer resp.Body.Close()
+	// Trailers are only available once the body has been read
+	payload, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read forwarded response from %s: %s", hostName, err)
+	}
+
+	headers := map[string]string{}
+	for _, h := range []http.Header{resp.Header, resp.Trailer} {
+		for k, vals := range h {
+			if k == "Trailer" {
+				continue
+			}
+			headers[k] = strings.Join(vals, ",")
+		}
+	}
+	return &protos.GatewayResponse{
+		Status:  strconv.Itoa(resp.StatusCode),
+		Headers: headers,
+		Payload: payload,
+	}, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+// ForwardingGatewayRPCBroker is a GatewayRPCBroker which lets multiple
+// dispatcher replicas cooperate. Requests for gateways connected to this
+// replica are handled by a local GatewayRPCBrokerImpl. Requests for other
+// gateways are forwarded to the replica which holds the gateway's stream
+// according to a shared RoutingTable, so cloud services can reach any
+// gateway through any replica.
+type ForwardingGatewayRPCBroker struct {
+	*GatewayRPCBrokerImpl
+
+	// hostName is the host name of this replica in the routing table
+	hostName  string
+	routes    RoutingTable
+	forwarder RequestForwarder
+
+	sync.RWMutex
+	// localGateways are the gateways connected to this replica
+	localGateways map[string]struct{}
+	// cancelsByReqId cancels requests in flight to other replicas
+	cancelsByReqId map[uint32]context.CancelFunc
+}
+
+func NewForwardingGatewayRPCBroker(
+	hostName string,
+	routes RoutingTable,
+	forwarder RequestForwarder,
+) *ForwardingGatewayRPCBroker {
+	return &ForwardingGatewayRPCBroker{
+		GatewayRPCBrokerImpl: NewGatewayReqRespBroker(),
+		hostName:             hostName,
+		routes:               routes,
+		forwarder:            forwarder,
+		localGateways:        map[string]struct{}{},
+		cancelsByReqId:       map[uint32]context.CancelFunc{},
+	}
+}
+
+func (broker *ForwardingGatewayRPCBroker) SendRequestToGateway(
+	gwReq *protos.GatewayRequest,
+) (*GatewayResponseChannel, error) {
+	if gwReq == nil || len(gwReq.GwId) == 0 {
+		return nil, errors.New("gwReq cannot be nil and gwId cannot be empty string")
+	}
+	_, wasForwarded := gwReq.Headers[ForwardedHeaderKey]
+	delete(gwReq.Headers, ForwardedHeaderKey)
+	if broker.isLocalGateway(gwReq.GwId) {
+		return broker.GatewayRPCBrokerImpl.SendRequestToGateway(gwReq)
+	}
+	if wasForwarded {
+		return nil, fmt.Errorf("Gateway %s is not connected to dispatcher %s", gwReq.GwId, broker.hostName)
+	}
+
+	hostName, err := broker.routes.GetReplica(gwReq.GwId)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find dispatcher for gateway %s: %s", gwReq.GwId, err)
+	}
+	if hostName == broker.hostName {
+		return nil, fmt.Errorf("Gateway %s is not connected to dispatcher %s", gwReq.GwId, broker.hostName)
+	}
+
+	respChan, reqId := broker.responseTable.InitializeResponse()
+	ctx, cancel := context.WithCancel(context.Background())
+	broker.Lock()
+	broker.cancelsByReqId[reqId] = cancel
+	broker.Unlock()
+	go broker.forwardRequest(ctx, hostName, reqId, gwReq)
+	return &GatewayResponseChannel{RespChan: respChan, ReqId: reqId}, nil
+}
+
+func (broker *ForwardingGatewayRPCBroker) forwardRequest(
+	ctx context.Context,
+	hostName string,
+	reqId uint32,
+	gwReq *protos.GatewayRequest,
+) {
+	defer func() {
+		broker.Lock()
+		delete(broker.cancelsByReqId, reqId)
+		broker.Unlock()
+	}()
+
+	gwResp, err := broker.forwarder.ForwardRequest(ctx, hostName, gwReq)
+	if err != nil {
+		gwResp = &protos.GatewayResponse{Err: err.Error()}
+	}
+	err = broker.responseTable.SendResponse(&protos.SyncRPCResponse{ReqId: reqId, RespBody: gwResp})
+	if err != nil {
+		glog.Errorf("Failed to process forwarded response for gateway %s: %s", gwReq.GwId, err)
+	}
+}
+
+func (broker *ForwardingGatewayRPCBroker) InitializeGateway(gwId string) chan *protos.SyncRPCRequest {
+	broker.Lock()
+	broker.localGateways[gwId] = struct{}{}
+	broker.Unlock()
+	// Don't wait for the first heartbeat to make the gateway reachable from
+	// other replicas
+	if err := broker.routes.SetReplica(gwId, broker.hostName); err != nil {
+		glog.Errorf("Failed to route gateway %s to dispatcher %s: %s", gwId, broker.hostName, err)
+	}
+	return broker.GatewayRPCBrokerImpl.InitializeGateway(gwId)
+}
+
+func (broker *ForwardingGatewayRPCBroker) CleanupGateway(gwId string) error {
+	broker.Lock()
+	delete(broker.localGateways, gwId)
+	broker.Unlock()
+	if err := broker.routes.DeleteReplica(gwId, broker.hostName); err != nil {
+		glog.Errorf("Failed to delete route of gateway %s: %s", gwId, err)
+	}
+	return broker.GatewayRPCBrokerImpl.CleanupGateway(gwId)
+}
+
+func (broker *ForwardingGatewayRPCBroker) CancelGatewayRequest(gwId string, reqId uint32) error {
+	broker.RLock()
+	cancel, isForwarded := broker.cancelsByReqId[reqId]
+	broker.RUnlock()
+	if isForwarded {
+		// The other replica cancels the request with the gateway once the
+		// forwarded request is closed
+		cancel()
+		return nil
+	}
+	if !broker.isLocalGateway(gwId) {
+		// The forwarded request already completed
+		return nil
+	}
+	return broker.GatewayRPCBrokerImpl.CancelGatewayRequest(gwId, reqId)
+}
+
+func (broker *ForwardingGatewayRPCBroker) isLocalGateway(gwId string) bool {
+	broker.RLock()
+	defer broker.RUnlock()
+	_, ok := broker.localGateways[gwId]
+	return ok
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker_test
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/broker"
+	"magma/orc8r/cloud/go/services/dispatcher/httpserver"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRoutingTable struct {
+	sync.Mutex
+	routes map[string]string
+}
+
+func (m *mockRoutingTable) GetReplica(gwId string) (string, error) {
+	m.Lock()
+	defer m.Unlock()
+	hostName, ok := m.routes[gwId]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return hostName, nil
+}
+
+func (m *mockRoutingTable) SetReplica(gwId string, hostName string) error {
+	m.Lock()
+	defer m.Unlock()
+	m.routes[gwId] = hostName
+	return nil
+}
+
+func (m *mockRoutingTable) DeleteReplica(gwId string, hostName string) error {
+	m.Lock()
+	defer m.Unlock()
+	if m.routes[gwId] == hostName {
+		delete(m.routes, gwId)
+	}
+	return nil
+}
+
+func TestForwardingGatewayRPCBroker(t *testing.T) {
+	routes := &mockRoutingTable{routes: map[string]string{}}
+
+	// Replica 2 holds the stream of gw1 and serves forwarded requests
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	replica2 := broker.NewForwardingGatewayRPCBroker("127.0.0.1", routes, broker.NewHTTPRequestForwarder(port))
+	go httpserver.NewSyncRPCHttpServer(replica2).Serve(lis)
+	queue := replica2.InitializeGateway("gw1")
+	assert.Equal(t, map[string]string{"gw1": "127.0.0.1"}, routes.routes)
+
+	replica1 := broker.NewForwardingGatewayRPCBroker("replica1", routes, broker.NewHTTPRequestForwarder(port))
+
+	// Requests to gw1 through replica 1 reach the gateway on replica 2
+	go func() {
+		req := <-queue
+		assert.Equal(t, "gw1", req.ReqBody.GwId)
+		assert.Equal(t, "magmad", req.ReqBody.Authority)
+		assert.Equal(t, "/magma.MagmadService/Reboot", req.ReqBody.Path)
+		assert.Equal(t, []byte("request"), req.ReqBody.Payload)
+		assert.NotContains(t, req.ReqBody.Headers, broker.ForwardedHeaderKey)
+		err := replica2.ProcessGatewayResponse(&protos.SyncRPCResponse{
+			ReqId: req.ReqId,
+			RespBody: &protos.GatewayResponse{
+				Status:  "200",
+				Headers: map[string]string{"Content-Type": "application/grpc", "Grpc-Status": "0"},
+				Payload: []byte("response"),
+			},
+		})
+		assert.NoError(t, err)
+	}()
+	respChan, err := replica1.SendRequestToGateway(&protos.GatewayRequest{
+		GwId:      "gw1",
+		Authority: "magmad",
+		Path:      "/magma.MagmadService/Reboot",
+		Headers:   map[string]string{"Content-Type": "application/grpc"},
+		Payload:   []byte("request"),
+	})
+	assert.NoError(t, err)
+	resp := receiveResponse(t, respChan)
+	assert.Equal(t, "200", resp.Status)
+	assert.Equal(t, "0", resp.Headers["Grpc-Status"])
+	assert.Equal(t, []byte("response"), resp.Payload)
+
+	// Stale routes don't make requests bounce between replicas
+	routes.SetReplica("gw2", "127.0.0.1")
+	respChan, err = replica1.SendRequestToGateway(&protos.GatewayRequest{
+		GwId:      "gw2",
+		Authority: "magmad",
+		Path:      "/magma.MagmadService/Reboot",
+	})
+	assert.NoError(t, err)
+	resp = receiveResponse(t, respChan)
+	assert.Equal(t, "500", resp.Status)
+	assert.Equal(t, "13", resp.Headers["Grpc-Status"])
+
+	// Requests for gateways routed to the replica itself fail right away
+	routes.SetReplica("gw3", "replica1")
+	_, err = replica1.SendRequestToGateway(&protos.GatewayRequest{GwId: "gw3"})
+	assert.EqualError(t, err, "Gateway gw3 is not connected to dispatcher replica1")
+
+	// Cleaning up the gateway removes its route
+	assert.NoError(t, replica2.CleanupGateway("gw1"))
+	_, err = routes.GetReplica("gw1")
+	assert.Error(t, err)
+}
+
+func receiveResponse(t *testing.T, respChan *broker.GatewayResponseChannel) *protos.GatewayResponse {
+	select {
+	case resp := <-respChan.RespChan:
+		return resp
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for forwarded response")
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker
+
+import (
+	"magma/orc8r/cloud/go/services/directoryd"
+)
+
+// RoutingTable is the routing table shared by all dispatcher replicas. It
+// maps each gateway to the host name of the replica which holds the gateway's
+// SyncRPC stream.
+type RoutingTable interface {
+	// GetReplica returns the host name of the replica the gateway is
+	// connected to.
+	GetReplica(gwId string) (string, error)
+	// SetReplica records that the gateway is connected to the replica at
+	// hostName.
+	SetReplica(gwId string, hostName string) error
+	// DeleteReplica removes the route for the gateway if it still points to
+	// the replica at hostName, so a replica which lost the stream doesn't
+	// remove the route of a replica which the gateway reconnected to.
+	DeleteReplica(gwId string, hostName string) error
+}
+
+type directorydRoutingTable struct{}
+
+// NewDirectorydRoutingTable returns a RoutingTable backed by directoryd's
+// HWID_TO_HOSTNAME table.
+func NewDirectorydRoutingTable() RoutingTable {
+	return &directorydRoutingTable{}
+}
+
+func (*directorydRoutingTable) GetReplica(gwId string) (string, error) {
+	return directoryd.GetHostNameByIMSI(gwId)
+}
+
+func (*directorydRoutingTable) SetReplica(gwId string, hostName string) error {
+	return directoryd.UpdateHostNameByHwId(gwId, hostName)
+}
+
+func (*directorydRoutingTable) DeleteReplica(gwId string, hostName string) error {
+	currentHostName, err := directoryd.GetHostNameByIMSI(gwId)
+	if err != nil || currentHostName != hostName {
+		// Nothing to delete, or the gateway moved to a different replica
+		return nil
+	}
+	return directoryd.DeleteHostNameByIMSI(gwId)
+}
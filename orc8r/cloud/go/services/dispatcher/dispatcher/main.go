@@ -44,12 +44,18 @@ func main() {
 		glog.Fatalf("Error creating service: %s", err)
 	}
 
-	// create a broker
-	broker := sync_rpc_broker.NewGatewayReqRespBroker()
-
 	// get ec2 public host name
 	hostName := getHostName()
 	glog.V(2).Infof("hostName is: %v\n", hostName)
+
+	// create a broker which forwards requests for gateways connected to
+	// other dispatcher replicas
+	broker := sync_rpc_broker.NewForwardingGatewayRPCBroker(
+		hostName,
+		sync_rpc_broker.NewDirectorydRoutingTable(),
+		sync_rpc_broker.NewHTTPRequestForwarder(HTTP_SERVER_PORT),
+	)
+
 	// create servicer
 	syncRpcServicer, err := servicers.NewSyncRPCService(hostName, broker)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 
 const (
 	DnsdNetworkType = "dnsd_network"
+	DnsdGatewayType = "dnsd_gateway"
 )
 
 type DnsNetworkConfigManager struct{}
@@ -50,3 +51,33 @@ func (*DnsNetworkConfigManager) Deserialize(message []byte) (interface{}, error)
 	err := protos.Unmarshal(message, cfg)
 	return cfg, err
 }
+
+type DnsGatewayConfigManager struct{}
+
+func (*DnsGatewayConfigManager) GetDomain() string {
+	return config.SerdeDomain
+}
+
+func (*DnsGatewayConfigManager) GetType() string {
+	return DnsdGatewayType
+}
+
+func (*DnsGatewayConfigManager) Serialize(config interface{}) ([]byte, error) {
+	castedConfig, ok := config.(*dns_protos.GatewayDNSConfig)
+	if !ok {
+		return nil, fmt.Errorf(
+			"Invalid config type. Expected *GatewayDNSConfig, received %s",
+			reflect.TypeOf(config),
+		)
+	}
+	if err := dns_protos.ValidateGatewayConfig(castedConfig); err != nil {
+		return nil, fmt.Errorf("Invalid gateway dns config: %s", err)
+	}
+	return protos.MarshalIntern(castedConfig)
+}
+
+func (*DnsGatewayConfigManager) Deserialize(message []byte) (interface{}, error) {
+	cfg := &dns_protos.GatewayDNSConfig{}
+	err := protos.Unmarshal(message, cfg)
+	return cfg, err
+}
@@ -11,11 +11,16 @@ package config
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/protos/mconfig"
+	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/config"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 	dns_protos "magma/orc8r/cloud/go/services/dnsd/protos"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
 )
@@ -27,24 +32,122 @@ func (builder *DnsdMconfigBuilder) Build(networkId string, gatewayId string) (ma
 	if err != nil {
 		return nil, err
 	}
-	if networkDNSconfig == nil {
+	gatewayDNSconfig, err := GetGatewayDNSConfig(networkId, gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	return buildFromDNSConfigs(networkDNSconfig, gatewayDNSconfig)
+}
+
+func (builder *DnsdMconfigBuilder) BuildFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (map[string]proto.Message, error) {
+	networkDNSconfig, err := GetNetworkDNSConfigFromNetwork(network)
+	if err != nil {
+		return nil, err
+	}
+	gatewayDNSconfig, err := getGatewayDNSConfigFromGraph(graph, gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	return buildFromDNSConfigs(networkDNSconfig, gatewayDNSconfig)
+}
+
+// GetAssociations associates the dnsd gateway entities with the magmad
+// gateway entities of the same gateways
+func (builder *DnsdMconfigBuilder) GetAssociations(configType string, key string, config []byte) ([]storage.GraphEdge, error) {
+	if configType != DnsdGatewayType {
+		return nil, nil
+	}
+	return []storage.GraphEdge{
+		{
+			From: orc8r_storage.TypeAndKey{Type: magmad_config.MagmadGatewayType, Key: key},
+			To:   orc8r_storage.TypeAndKey{Type: DnsdGatewayType, Key: key},
+		},
+	}, nil
+}
+
+// buildFromDNSConfigs layers the gateway's records on the network's: records
+// of a domain in the gateway config replace the network's records of the
+// same domain
+func buildFromDNSConfigs(networkDNSconfig *dns_protos.NetworkDNSConfig, gatewayDNSconfig *dns_protos.GatewayDNSConfig) (map[string]proto.Message, error) {
+	if networkDNSconfig == nil && gatewayDNSconfig == nil {
 		return map[string]proto.Message{}, nil
 	}
 
-	mconfigDnsD := &mconfig.DnsD{}
-	protos.FillIn(networkDNSconfig, mconfigDnsD)
-	mconfigDnsD.LogLevel = protos.LogLevel_INFO
+	gatewayRecordsByDomain := map[string]*dns_protos.NetworkDNSConfigRecordsItems{}
+	for _, item := range gatewayDNSconfig.GetRecords() {
+		gatewayRecordsByDomain[strings.ToLower(item.GetDomain())] = item
+	}
+	records := []*mconfig.NetworkDNSConfigRecordsItems{}
+	for _, item := range networkDNSconfig.GetRecords() {
+		domain := strings.ToLower(item.GetDomain())
+		if gatewayItem, overridden := gatewayRecordsByDomain[domain]; overridden {
+			item = gatewayItem
+			delete(gatewayRecordsByDomain, domain)
+		}
+		records = append(records, toMconfigRecordsItem(item))
+	}
+	// Domains only the gateway has records for, in the gateway config's order
+	for _, item := range gatewayDNSconfig.GetRecords() {
+		if _, notAdded := gatewayRecordsByDomain[strings.ToLower(item.GetDomain())]; notAdded {
+			records = append(records, toMconfigRecordsItem(item))
+		}
+	}
 
 	return map[string]proto.Message{
-		"dnsd": mconfigDnsD,
+		"dnsd": &mconfig.DnsD{
+			LogLevel:      protos.LogLevel_INFO,
+			EnableCaching: networkDNSconfig.GetEnableCaching(),
+			LocalTTL:      networkDNSconfig.GetLocalTTL(),
+			Records:       records,
+		},
 	}, nil
 }
 
+func toMconfigRecordsItem(item *dns_protos.NetworkDNSConfigRecordsItems) *mconfig.NetworkDNSConfigRecordsItems {
+	ret := &mconfig.NetworkDNSConfigRecordsItems{
+		Domain:      item.GetDomain(),
+		ARecord:     item.GetARecord(),
+		AaaaRecord:  item.GetAaaaRecord(),
+		CnameRecord: item.GetCnameRecord(),
+		PtrRecord:   item.GetPtrRecord(),
+		TxtRecord:   item.GetTxtRecord(),
+	}
+	for _, srv := range item.GetSrvRecord() {
+		ret.SrvRecord = append(ret.SrvRecord, &mconfig.NetworkDNSConfigRecordsItems_SrvRecord{
+			Priority: srv.GetPriority(),
+			Weight:   srv.GetWeight(),
+			Port:     srv.GetPort(),
+			Target:   srv.GetTarget(),
+		})
+	}
+	for _, mx := range item.GetMxRecord() {
+		ret.MxRecord = append(ret.MxRecord, &mconfig.NetworkDNSConfigRecordsItems_MxRecord{
+			Preference: mx.GetPreference(),
+			Exchange:   mx.GetExchange(),
+		})
+	}
+	return ret
+}
+
 func GetNetworkDNSConfig(networkId string) (*dns_protos.NetworkDNSConfig, error) {
 	iNetworkDNSconfigs, err := config.GetConfig(networkId, DnsdNetworkType, networkId)
 	if err != nil || iNetworkDNSconfigs == nil {
 		return nil, err
 	}
+	return castNetworkDNSConfig(iNetworkDNSconfigs)
+}
+
+// GetNetworkDNSConfigFromNetwork returns the DNS config of a configurator
+// network, or nil if the network has none
+func GetNetworkDNSConfigFromNetwork(network storage.Network) (*dns_protos.NetworkDNSConfig, error) {
+	iNetworkDNSconfig, err := serde.Deserialize(config.SerdeDomain, DnsdNetworkType, network.Configs[DnsdNetworkType])
+	if err != nil || iNetworkDNSconfig == nil {
+		return nil, err
+	}
+	return castNetworkDNSConfig(iNetworkDNSconfig)
+}
+
+func castNetworkDNSConfig(iNetworkDNSconfigs interface{}) (*dns_protos.NetworkDNSConfig, error) {
 	networkDNSconfig, ok := iNetworkDNSconfigs.(*dns_protos.NetworkDNSConfig)
 	if !ok {
 		return nil, fmt.Errorf(
@@ -55,3 +158,35 @@ func GetNetworkDNSConfig(networkId string) (*dns_protos.NetworkDNSConfig, error)
 	}
 	return networkDNSconfig, nil
 }
+
+func GetGatewayDNSConfig(networkId string, gatewayId string) (*dns_protos.GatewayDNSConfig, error) {
+	iGatewayDNSconfig, err := config.GetConfig(networkId, DnsdGatewayType, gatewayId)
+	if err != nil || iGatewayDNSconfig == nil {
+		return nil, err
+	}
+	return castGatewayDNSConfig(iGatewayDNSconfig)
+}
+
+func getGatewayDNSConfigFromGraph(graph storage.EntityGraph, gatewayId string) (*dns_protos.GatewayDNSConfig, error) {
+	gatewayEnt, found := graph.GetEntity(orc8r_storage.TypeAndKey{Type: DnsdGatewayType, Key: gatewayId})
+	if !found {
+		return nil, nil
+	}
+	iGatewayDNSconfig, err := serde.Deserialize(config.SerdeDomain, DnsdGatewayType, gatewayEnt.Config)
+	if err != nil || iGatewayDNSconfig == nil {
+		return nil, err
+	}
+	return castGatewayDNSConfig(iGatewayDNSconfig)
+}
+
+func castGatewayDNSConfig(iGatewayDNSconfig interface{}) (*dns_protos.GatewayDNSConfig, error) {
+	gatewayDNSconfig, ok := iGatewayDNSconfig.(*dns_protos.GatewayDNSConfig)
+	if !ok {
+		return nil, fmt.Errorf(
+			"Received unexpected type for gateway record. "+
+				"Expected *GatewayDNSConfig but got %s",
+			reflect.TypeOf(iGatewayDNSconfig),
+		)
+	}
+	return gatewayDNSconfig, nil
+}
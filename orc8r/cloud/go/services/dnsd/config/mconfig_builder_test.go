@@ -15,10 +15,14 @@ import (
 	"magma/orc8r/cloud/go/pluginimpl"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/protos/mconfig"
+	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/config"
 	config_test_init "magma/orc8r/cloud/go/services/config/test_init"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 	dnsd_config "magma/orc8r/cloud/go/services/dnsd/config"
 	dnsd_protos "magma/orc8r/cloud/go/services/dnsd/protos"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -65,3 +69,150 @@ func TestDNSDBuilder_Build(t *testing.T) {
 	}
 	assert.Equal(t, expected, actual)
 }
+
+func TestDNSDBuilder_Build_GatewayOverrides(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	config_test_init.StartTestService(t)
+
+	builder := &dnsd_config.DnsdMconfigBuilder{}
+	// Gateway records without network config
+	err := config.CreateConfig("network2", dnsd_config.DnsdGatewayType, "gw", &dnsd_protos.GatewayDNSConfig{
+		Records: []*dnsd_protos.NetworkDNSConfigRecordsItems{
+			{Domain: "portal.example.com", ARecord: []string{"192.168.128.1"}},
+		},
+	})
+	assert.NoError(t, err)
+	actual, err := builder.Build("network2", "gw")
+	assert.NoError(t, err)
+	expected := map[string]proto.Message{
+		"dnsd": &mconfig.DnsD{
+			LogLevel: protos.LogLevel_INFO,
+			Records: []*mconfig.NetworkDNSConfigRecordsItems{
+				{Domain: "portal.example.com", ARecord: []string{"192.168.128.1"}},
+			},
+		},
+	}
+	assertMconfigsEqual(t, expected, actual)
+
+	err = config.CreateConfig("network2", dnsd_config.DnsdNetworkType, "network2", &dnsd_protos.NetworkDNSConfig{
+		EnableCaching: true,
+		LocalTTL:      60,
+		Records: []*dnsd_protos.NetworkDNSConfigRecordsItems{
+			{Domain: "ims.example.com", ARecord: []string{"10.0.0.1"}},
+			{
+				Domain:    "_sip._udp.example.com",
+				SrvRecord: []*dnsd_protos.SrvRecord{{Priority: 10, Weight: 60, Port: 5060, Target: "ims.example.com"}},
+			},
+			{Domain: "example.com", MxRecord: []*dnsd_protos.MxRecord{{Preference: 10, Exchange: "mail.example.com"}}},
+		},
+	})
+	assert.NoError(t, err)
+	err = config.UpdateConfig("network2", dnsd_config.DnsdGatewayType, "gw", &dnsd_protos.GatewayDNSConfig{
+		Records: []*dnsd_protos.NetworkDNSConfigRecordsItems{
+			{Domain: "portal.example.com", TxtRecord: []string{"captive portal"}},
+			{Domain: "IMS.example.com", ARecord: []string{"192.168.128.2"}},
+			{Domain: "2.128.168.192.in-addr.arpa", PtrRecord: []string{"ims.example.com"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	// Gateway records replace network records of the same domain and are
+	// appended otherwise
+	actual, err = builder.Build("network2", "gw")
+	assert.NoError(t, err)
+	expected = map[string]proto.Message{
+		"dnsd": &mconfig.DnsD{
+			LogLevel:      protos.LogLevel_INFO,
+			EnableCaching: true,
+			LocalTTL:      60,
+			Records: []*mconfig.NetworkDNSConfigRecordsItems{
+				{Domain: "IMS.example.com", ARecord: []string{"192.168.128.2"}},
+				{
+					Domain: "_sip._udp.example.com",
+					SrvRecord: []*mconfig.NetworkDNSConfigRecordsItems_SrvRecord{
+						{Priority: 10, Weight: 60, Port: 5060, Target: "ims.example.com"},
+					},
+				},
+				{
+					Domain:   "example.com",
+					MxRecord: []*mconfig.NetworkDNSConfigRecordsItems_MxRecord{{Preference: 10, Exchange: "mail.example.com"}},
+				},
+				{Domain: "portal.example.com", TxtRecord: []string{"captive portal"}},
+				{Domain: "2.128.168.192.in-addr.arpa", PtrRecord: []string{"ims.example.com"}},
+			},
+		},
+	}
+	assertMconfigsEqual(t, expected, actual)
+
+	// Other gateways only get the network records
+	actual, err = builder.Build("network2", "gw2")
+	assert.NoError(t, err)
+	assert.Len(t, actual["dnsd"].(*mconfig.DnsD).Records, 3)
+}
+
+func TestDNSDBuilder_BuildFromGraph(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+
+	builder := &dnsd_config.DnsdMconfigBuilder{}
+	network := storage.Network{ID: "network3", Configs: map[string][]byte{}}
+	actual, err := builder.BuildFromGraph(network, storage.EntityGraph{}, "gw")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]proto.Message{}, actual)
+
+	networkConfig, err := serde.Serialize(config.SerdeDomain, dnsd_config.DnsdNetworkType, &dnsd_protos.NetworkDNSConfig{
+		Records: []*dnsd_protos.NetworkDNSConfigRecordsItems{
+			{Domain: "ims.example.com", ARecord: []string{"10.0.0.1"}},
+		},
+	})
+	assert.NoError(t, err)
+	network.Configs[dnsd_config.DnsdNetworkType] = networkConfig
+	gatewayConfig, err := serde.Serialize(config.SerdeDomain, dnsd_config.DnsdGatewayType, &dnsd_protos.GatewayDNSConfig{
+		Records: []*dnsd_protos.NetworkDNSConfigRecordsItems{
+			{Domain: "ims.example.com", AaaaRecord: []string{"2001:db8::1"}},
+		},
+	})
+	assert.NoError(t, err)
+	graph := storage.EntityGraph{
+		Entities: []storage.NetworkEntity{
+			{Type: dnsd_config.DnsdGatewayType, Key: "gw", Config: gatewayConfig},
+		},
+	}
+
+	actual, err = builder.BuildFromGraph(network, graph, "gw")
+	assert.NoError(t, err)
+	expected := map[string]proto.Message{
+		"dnsd": &mconfig.DnsD{
+			LogLevel: protos.LogLevel_INFO,
+			Records: []*mconfig.NetworkDNSConfigRecordsItems{
+				{Domain: "ims.example.com", AaaaRecord: []string{"2001:db8::1"}},
+			},
+		},
+	}
+	assertMconfigsEqual(t, expected, actual)
+}
+
+func TestDNSDBuilder_GetAssociations(t *testing.T) {
+	builder := &dnsd_config.DnsdMconfigBuilder{}
+	actual, err := builder.GetAssociations(dnsd_config.DnsdGatewayType, "gw", nil)
+	assert.NoError(t, err)
+	expected := []storage.GraphEdge{
+		{
+			From: orc8r_storage.TypeAndKey{Type: magmad_config.MagmadGatewayType, Key: "gw"},
+			To:   orc8r_storage.TypeAndKey{Type: dnsd_config.DnsdGatewayType, Key: "gw"},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	actual, err = builder.GetAssociations(dnsd_config.DnsdNetworkType, "network", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+}
+
+// assertMconfigsEqual compares with proto.Equal since configs read back from
+// storage have empty rather than nil repeated fields
+func assertMconfigsEqual(t *testing.T, expected map[string]proto.Message, actual map[string]proto.Message) {
+	assert.Equal(t, len(expected), len(actual))
+	for k, v := range expected {
+		assert.True(t, proto.Equal(v, actual[k]), "mconfig %s: expected %v, got %v", k, v, actual[k])
+	}
+}
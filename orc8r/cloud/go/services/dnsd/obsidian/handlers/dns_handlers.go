@@ -19,9 +19,12 @@ import (
 const (
 	ConfigKey         = "dns"
 	NetworkConfigPath = magmad_handlers.ConfigureNetwork + "/" + ConfigKey
+	GatewayConfigPath = magmad_handlers.ConfigureAG + "/" + ConfigKey
 )
 
 // GetObsidianHandlers returns all obsidian handlers for dnsd
 func GetObsidianHandlers() []handlers.Handler {
-	return obsidian.GetCRUDNetworkConfigHandlers(NetworkConfigPath, config.DnsdNetworkType, &models.NetworkDNSConfig{})
+	ret := obsidian.GetCRUDNetworkConfigHandlers(NetworkConfigPath, config.DnsdNetworkType, &models.NetworkDNSConfig{})
+	ret = append(ret, obsidian.GetCRUDGatewayConfigHandlers(GatewayConfigPath, config.DnsdGatewayType, &models.GatewayDNSConfig{})...)
+	return ret
 }
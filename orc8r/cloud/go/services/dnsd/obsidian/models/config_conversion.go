@@ -13,7 +13,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"magma/orc8r/cloud/go/protos"
 	dnsdprotos "magma/orc8r/cloud/go/services/dnsd/protos"
 
 	"github.com/go-openapi/strfmt"
@@ -26,9 +25,11 @@ func (m *NetworkDNSConfig) ValidateModel() error {
 }
 
 func (m *NetworkDNSConfig) ToServiceModel() (interface{}, error) {
-	magmadConfig := &dnsdprotos.NetworkDNSConfig{}
-
-	protos.FillIn(m, magmadConfig)
+	magmadConfig := &dnsdprotos.NetworkDNSConfig{
+		EnableCaching: m.EnableCaching,
+		LocalTTL:      m.LocalTTL,
+		Records:       recordsToServiceModel(m.Records),
+	}
 	if err := dnsdprotos.ValidateNetworkConfig(magmadConfig); err != nil {
 		return nil, err
 	}
@@ -36,13 +37,108 @@ func (m *NetworkDNSConfig) ToServiceModel() (interface{}, error) {
 }
 
 func (m *NetworkDNSConfig) FromServiceModel(magmadModel interface{}) error {
-	_, ok := magmadModel.(*dnsdprotos.NetworkDNSConfig)
+	magmadConfig, ok := magmadModel.(*dnsdprotos.NetworkDNSConfig)
 	if !ok {
 		return fmt.Errorf(
 			"Invalid magmad config type to convert to. Expected *NetworkDNSConfig but got %s",
 			reflect.TypeOf(magmadModel),
 		)
 	}
-	protos.FillIn(magmadModel, m)
+	m.EnableCaching = magmadConfig.EnableCaching
+	m.LocalTTL = magmadConfig.LocalTTL
+	m.Records = recordsFromServiceModel(magmadConfig.Records)
+	return nil
+}
+
+func (m *GatewayDNSConfig) ValidateModel() error {
+	return m.Validate(formatsRegistry)
+}
+
+func (m *GatewayDNSConfig) ToServiceModel() (interface{}, error) {
+	magmadConfig := &dnsdprotos.GatewayDNSConfig{Records: recordsToServiceModel(m.Records)}
+	if err := dnsdprotos.ValidateGatewayConfig(magmadConfig); err != nil {
+		return nil, err
+	}
+	return magmadConfig, nil
+}
+
+func (m *GatewayDNSConfig) FromServiceModel(magmadModel interface{}) error {
+	magmadConfig, ok := magmadModel.(*dnsdprotos.GatewayDNSConfig)
+	if !ok {
+		return fmt.Errorf(
+			"Invalid magmad config type to convert to. Expected *GatewayDNSConfig but got %s",
+			reflect.TypeOf(magmadModel),
+		)
+	}
+	m.Records = recordsFromServiceModel(magmadConfig.Records)
 	return nil
 }
+
+func recordsToServiceModel(records []*DNSConfigRecord) []*dnsdprotos.NetworkDNSConfigRecordsItems {
+	ret := make([]*dnsdprotos.NetworkDNSConfigRecordsItems, 0, len(records))
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+		item := &dnsdprotos.NetworkDNSConfigRecordsItems{
+			Domain:      record.Domain,
+			ARecord:     record.ARecord,
+			AaaaRecord:  record.AaaaRecord,
+			CnameRecord: record.CnameRecord,
+			PtrRecord:   record.PtrRecord,
+			TxtRecord:   record.TxtRecord,
+		}
+		for _, srv := range record.SrvRecord {
+			if srv == nil {
+				continue
+			}
+			item.SrvRecord = append(item.SrvRecord, &dnsdprotos.SrvRecord{
+				Priority: srv.Priority,
+				Weight:   srv.Weight,
+				Port:     srv.Port,
+				Target:   srv.Target,
+			})
+		}
+		for _, mx := range record.MxRecord {
+			if mx == nil {
+				continue
+			}
+			item.MxRecord = append(item.MxRecord, &dnsdprotos.MxRecord{
+				Preference: mx.Preference,
+				Exchange:   mx.Exchange,
+			})
+		}
+		ret = append(ret, item)
+	}
+	return ret
+}
+
+func recordsFromServiceModel(items []*dnsdprotos.NetworkDNSConfigRecordsItems) []*DNSConfigRecord {
+	ret := make([]*DNSConfigRecord, 0, len(items))
+	for _, item := range items {
+		record := &DNSConfigRecord{
+			Domain:      item.GetDomain(),
+			ARecord:     item.GetARecord(),
+			AaaaRecord:  item.GetAaaaRecord(),
+			CnameRecord: item.GetCnameRecord(),
+			PtrRecord:   item.GetPtrRecord(),
+			TxtRecord:   item.GetTxtRecord(),
+		}
+		for _, srv := range item.GetSrvRecord() {
+			record.SrvRecord = append(record.SrvRecord, &DNSSrvRecord{
+				Priority: srv.GetPriority(),
+				Weight:   srv.GetWeight(),
+				Port:     srv.GetPort(),
+				Target:   srv.GetTarget(),
+			})
+		}
+		for _, mx := range item.GetMxRecord() {
+			record.MxRecord = append(record.MxRecord, &DNSMxRecord{
+				Preference: mx.GetPreference(),
+				Exchange:   mx.GetExchange(),
+			})
+		}
+		ret = append(ret, record)
+	}
+	return ret
+}
@@ -31,7 +31,7 @@ func (m *NetworkDNSConfig) Reset()         { *m = NetworkDNSConfig{} }
 func (m *NetworkDNSConfig) String() string { return proto.CompactTextString(m) }
 func (*NetworkDNSConfig) ProtoMessage()    {}
 func (*NetworkDNSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dns_service_5cc92fc6c809cff9, []int{0}
+	return fileDescriptor_dns_service_29c1592d0e4b814d, []int{0}
 }
 func (m *NetworkDNSConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkDNSConfig.Unmarshal(m, b)
@@ -72,21 +72,27 @@ func (m *NetworkDNSConfig) GetRecords() []*NetworkDNSConfigRecordsItems {
 	return nil
 }
 
+// Records of a domain. PTR records are only allowed for reverse lookup
+// domains (in-addr.arpa and ip6.arpa).
 type NetworkDNSConfigRecordsItems struct {
-	ARecord              []string `protobuf:"bytes,1,rep,name=ARecord,proto3" json:"ARecord,omitempty"`
-	AaaaRecord           []string `protobuf:"bytes,2,rep,name=AaaaRecord,proto3" json:"AaaaRecord,omitempty"`
-	CnameRecord          []string `protobuf:"bytes,3,rep,name=CnameRecord,proto3" json:"CnameRecord,omitempty"`
-	Domain               string   `protobuf:"bytes,4,opt,name=Domain,proto3" json:"Domain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ARecord              []string     `protobuf:"bytes,1,rep,name=ARecord,proto3" json:"ARecord,omitempty"`
+	AaaaRecord           []string     `protobuf:"bytes,2,rep,name=AaaaRecord,proto3" json:"AaaaRecord,omitempty"`
+	CnameRecord          []string     `protobuf:"bytes,3,rep,name=CnameRecord,proto3" json:"CnameRecord,omitempty"`
+	Domain               string       `protobuf:"bytes,4,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	SrvRecord            []*SrvRecord `protobuf:"bytes,5,rep,name=SrvRecord,proto3" json:"SrvRecord,omitempty"`
+	PtrRecord            []string     `protobuf:"bytes,6,rep,name=PtrRecord,proto3" json:"PtrRecord,omitempty"`
+	TxtRecord            []string     `protobuf:"bytes,7,rep,name=TxtRecord,proto3" json:"TxtRecord,omitempty"`
+	MxRecord             []*MxRecord  `protobuf:"bytes,8,rep,name=MxRecord,proto3" json:"MxRecord,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *NetworkDNSConfigRecordsItems) Reset()         { *m = NetworkDNSConfigRecordsItems{} }
 func (m *NetworkDNSConfigRecordsItems) String() string { return proto.CompactTextString(m) }
 func (*NetworkDNSConfigRecordsItems) ProtoMessage()    {}
 func (*NetworkDNSConfigRecordsItems) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dns_service_5cc92fc6c809cff9, []int{1}
+	return fileDescriptor_dns_service_29c1592d0e4b814d, []int{1}
 }
 func (m *NetworkDNSConfigRecordsItems) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkDNSConfigRecordsItems.Unmarshal(m, b)
@@ -134,29 +140,219 @@ func (m *NetworkDNSConfigRecordsItems) GetDomain() string {
 	return ""
 }
 
+func (m *NetworkDNSConfigRecordsItems) GetSrvRecord() []*SrvRecord {
+	if m != nil {
+		return m.SrvRecord
+	}
+	return nil
+}
+
+func (m *NetworkDNSConfigRecordsItems) GetPtrRecord() []string {
+	if m != nil {
+		return m.PtrRecord
+	}
+	return nil
+}
+
+func (m *NetworkDNSConfigRecordsItems) GetTxtRecord() []string {
+	if m != nil {
+		return m.TxtRecord
+	}
+	return nil
+}
+
+func (m *NetworkDNSConfigRecordsItems) GetMxRecord() []*MxRecord {
+	if m != nil {
+		return m.MxRecord
+	}
+	return nil
+}
+
+// SRV record of a service domain such as _sip._udp.example.com
+type SrvRecord struct {
+	Priority             int32    `protobuf:"varint,1,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	Weight               int32    `protobuf:"varint,2,opt,name=Weight,proto3" json:"Weight,omitempty"`
+	Port                 int32    `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	Target               string   `protobuf:"bytes,4,opt,name=Target,proto3" json:"Target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SrvRecord) Reset()         { *m = SrvRecord{} }
+func (m *SrvRecord) String() string { return proto.CompactTextString(m) }
+func (*SrvRecord) ProtoMessage()    {}
+func (*SrvRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dns_service_29c1592d0e4b814d, []int{2}
+}
+func (m *SrvRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SrvRecord.Unmarshal(m, b)
+}
+func (m *SrvRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SrvRecord.Marshal(b, m, deterministic)
+}
+func (dst *SrvRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SrvRecord.Merge(dst, src)
+}
+func (m *SrvRecord) XXX_Size() int {
+	return xxx_messageInfo_SrvRecord.Size(m)
+}
+func (m *SrvRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SrvRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SrvRecord proto.InternalMessageInfo
+
+func (m *SrvRecord) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *SrvRecord) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *SrvRecord) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *SrvRecord) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+type MxRecord struct {
+	Preference           int32    `protobuf:"varint,1,opt,name=Preference,proto3" json:"Preference,omitempty"`
+	Exchange             string   `protobuf:"bytes,2,opt,name=Exchange,proto3" json:"Exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MxRecord) Reset()         { *m = MxRecord{} }
+func (m *MxRecord) String() string { return proto.CompactTextString(m) }
+func (*MxRecord) ProtoMessage()    {}
+func (*MxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dns_service_29c1592d0e4b814d, []int{3}
+}
+func (m *MxRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MxRecord.Unmarshal(m, b)
+}
+func (m *MxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MxRecord.Marshal(b, m, deterministic)
+}
+func (dst *MxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MxRecord.Merge(dst, src)
+}
+func (m *MxRecord) XXX_Size() int {
+	return xxx_messageInfo_MxRecord.Size(m)
+}
+func (m *MxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MxRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MxRecord proto.InternalMessageInfo
+
+func (m *MxRecord) GetPreference() int32 {
+	if m != nil {
+		return m.Preference
+	}
+	return 0
+}
+
+func (m *MxRecord) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+// Gateway DNS config, layered on the network DNS config when building the
+// gateway's mconfig. Records of a domain replace the network's records of
+// the same domain.
+type GatewayDNSConfig struct {
+	Records              []*NetworkDNSConfigRecordsItems `protobuf:"bytes,1,rep,name=Records,proto3" json:"Records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *GatewayDNSConfig) Reset()         { *m = GatewayDNSConfig{} }
+func (m *GatewayDNSConfig) String() string { return proto.CompactTextString(m) }
+func (*GatewayDNSConfig) ProtoMessage()    {}
+func (*GatewayDNSConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dns_service_29c1592d0e4b814d, []int{4}
+}
+func (m *GatewayDNSConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayDNSConfig.Unmarshal(m, b)
+}
+func (m *GatewayDNSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayDNSConfig.Marshal(b, m, deterministic)
+}
+func (dst *GatewayDNSConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayDNSConfig.Merge(dst, src)
+}
+func (m *GatewayDNSConfig) XXX_Size() int {
+	return xxx_messageInfo_GatewayDNSConfig.Size(m)
+}
+func (m *GatewayDNSConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayDNSConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayDNSConfig proto.InternalMessageInfo
+
+func (m *GatewayDNSConfig) GetRecords() []*NetworkDNSConfigRecordsItems {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NetworkDNSConfig)(nil), "magma.orc8r.dnsd.NetworkDNSConfig")
 	proto.RegisterType((*NetworkDNSConfigRecordsItems)(nil), "magma.orc8r.dnsd.NetworkDNSConfigRecordsItems")
+	proto.RegisterType((*SrvRecord)(nil), "magma.orc8r.dnsd.SrvRecord")
+	proto.RegisterType((*MxRecord)(nil), "magma.orc8r.dnsd.MxRecord")
+	proto.RegisterType((*GatewayDNSConfig)(nil), "magma.orc8r.dnsd.GatewayDNSConfig")
 }
 
-func init() { proto.RegisterFile("dns_service.proto", fileDescriptor_dns_service_5cc92fc6c809cff9) }
-
-var fileDescriptor_dns_service_5cc92fc6c809cff9 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0x03, 0x31,
-	0x10, 0x85, 0x49, 0x57, 0xdb, 0xed, 0x14, 0xa1, 0xe6, 0x20, 0x41, 0x44, 0x42, 0xf1, 0x90, 0x53,
-	0x0e, 0x7a, 0xf1, 0x5a, 0xb7, 0x82, 0x42, 0xe9, 0x21, 0xf6, 0xe4, 0x45, 0xa6, 0xd9, 0xb8, 0x2e,
-	0x36, 0x19, 0x49, 0x16, 0xfd, 0x1f, 0xfe, 0x00, 0x7f, 0xab, 0xb0, 0xdd, 0x4a, 0xed, 0xa1, 0xa7,
-	0xe1, 0x7d, 0xef, 0x3d, 0x1e, 0x0c, 0x9c, 0x96, 0x21, 0xbd, 0x24, 0x17, 0x3f, 0x6b, 0xeb, 0xf4,
-	0x47, 0xa4, 0x86, 0xf8, 0xd8, 0x63, 0xe5, 0x51, 0x53, 0xb4, 0xb7, 0x51, 0x97, 0x21, 0x95, 0x93,
-	0x1f, 0x06, 0xe3, 0x85, 0x6b, 0xbe, 0x28, 0xbe, 0xcf, 0x16, 0x4f, 0x05, 0x85, 0xd7, 0xba, 0xe2,
-	0x57, 0x70, 0x72, 0x1f, 0x70, 0xb5, 0x76, 0x05, 0xda, 0xb7, 0x3a, 0x54, 0x82, 0x49, 0xa6, 0x72,
-	0xf3, 0x1f, 0xf2, 0x73, 0xc8, 0xe7, 0x64, 0x71, 0xbd, 0x5c, 0xce, 0x45, 0x4f, 0x32, 0x75, 0x6c,
-	0xfe, 0x34, 0x7f, 0x80, 0x81, 0x71, 0x96, 0x62, 0x99, 0x44, 0x26, 0x33, 0x35, 0xba, 0xd6, 0x7a,
-	0x7f, 0x5a, 0xef, 0xcf, 0x76, 0x85, 0xc7, 0xc6, 0xf9, 0x64, 0xb6, 0xf5, 0xc9, 0x37, 0x83, 0x8b,
-	0x43, 0x49, 0x2e, 0x60, 0x30, 0xdd, 0x00, 0xc1, 0x64, 0xa6, 0x86, 0x66, 0x2b, 0xf9, 0x25, 0xc0,
-	0x14, 0x11, 0x3b, 0xb3, 0xd7, 0x9a, 0x3b, 0x84, 0x4b, 0x18, 0x15, 0x01, 0xbd, 0xeb, 0x02, 0x59,
-	0x1b, 0xd8, 0x45, 0xfc, 0x0c, 0xfa, 0x33, 0xf2, 0x58, 0x07, 0x71, 0x24, 0x99, 0x1a, 0x9a, 0x4e,
-	0xdd, 0xe5, 0xcf, 0xfd, 0xf6, 0xa1, 0x69, 0xb5, 0xb9, 0x37, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x2c, 0xcb, 0xd2, 0x1a, 0x6d, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("dns_service.proto", fileDescriptor_dns_service_29c1592d0e4b814d) }
+
+var fileDescriptor_dns_service_29c1592d0e4b814d = []byte{
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4b, 0x0b, 0xd3, 0x40,
+	0x10, 0x26, 0x4d, 0x1f, 0xc9, 0x14, 0xa1, 0xee, 0x41, 0x42, 0x2d, 0x12, 0x82, 0x87, 0x9c, 0x72,
+	0x50, 0x10, 0x3d, 0xd6, 0xb6, 0x3e, 0xa0, 0x96, 0xb0, 0x0d, 0x08, 0x22, 0xc8, 0x36, 0xd9, 0xa6,
+	0xa1, 0xcd, 0xae, 0x6c, 0x96, 0x3e, 0xfe, 0x8c, 0xff, 0xc5, 0x7f, 0x26, 0xd9, 0x6c, 0x1e, 0xb6,
+	0xe0, 0xc5, 0x53, 0xf2, 0x3d, 0x86, 0x6f, 0x26, 0x33, 0x81, 0xa7, 0x09, 0x2b, 0x7e, 0x14, 0x54,
+	0x9c, 0xb3, 0x98, 0x06, 0x3f, 0x05, 0x97, 0x1c, 0x4d, 0x72, 0x92, 0xe6, 0x24, 0xe0, 0x22, 0x7e,
+	0x2b, 0x82, 0x84, 0x15, 0x89, 0xf7, 0xcb, 0x80, 0xc9, 0x86, 0xca, 0x0b, 0x17, 0xc7, 0xe5, 0x66,
+	0xbb, 0xe0, 0x6c, 0x9f, 0xa5, 0xe8, 0x25, 0x3c, 0x59, 0x31, 0xb2, 0x3b, 0xd1, 0x05, 0x89, 0x0f,
+	0x19, 0x4b, 0x1d, 0xc3, 0x35, 0x7c, 0x0b, 0xff, 0x4d, 0xa2, 0x29, 0x58, 0x6b, 0x1e, 0x93, 0x53,
+	0x14, 0xad, 0x9d, 0x9e, 0x6b, 0xf8, 0x03, 0xdc, 0x60, 0xf4, 0x09, 0x46, 0x98, 0xc6, 0x5c, 0x24,
+	0x85, 0x63, 0xba, 0xa6, 0x3f, 0x7e, 0x15, 0x04, 0xf7, 0xd1, 0xc1, 0x7d, 0xac, 0x2e, 0xf8, 0x2c,
+	0x69, 0x5e, 0xe0, 0xba, 0xdc, 0xfb, 0xdd, 0x83, 0xd9, 0xbf, 0x9c, 0xc8, 0x81, 0xd1, 0xbc, 0x22,
+	0x1c, 0xc3, 0x35, 0x7d, 0x1b, 0xd7, 0x10, 0xbd, 0x00, 0x98, 0x13, 0x42, 0xb4, 0xd8, 0x53, 0x62,
+	0x87, 0x41, 0x2e, 0x8c, 0x17, 0x8c, 0xe4, 0x54, 0x1b, 0x4c, 0x65, 0xe8, 0x52, 0xe8, 0x19, 0x0c,
+	0x97, 0x3c, 0x27, 0x19, 0x73, 0xfa, 0xae, 0xe1, 0xdb, 0x58, 0x23, 0xf4, 0x0e, 0xec, 0xad, 0x38,
+	0xeb, 0xba, 0x81, 0x1a, 0xf0, 0xf9, 0xe3, 0x80, 0x8d, 0x05, 0xb7, 0x6e, 0x34, 0x03, 0x3b, 0x94,
+	0x42, 0x97, 0x0e, 0x55, 0x64, 0x4b, 0x94, 0x6a, 0x74, 0x95, 0x5a, 0x1d, 0x55, 0x6a, 0x43, 0xa0,
+	0x37, 0x60, 0x7d, 0xb9, 0x6a, 0xd1, 0x52, 0xa9, 0xd3, 0xc7, 0xd4, 0xda, 0x81, 0x1b, 0xaf, 0x77,
+	0xec, 0xb4, 0x5b, 0xae, 0x2d, 0x14, 0x19, 0x17, 0x99, 0xbc, 0xa9, 0xbd, 0x0e, 0x70, 0x83, 0xcb,
+	0x79, 0xbf, 0xd2, 0x2c, 0x3d, 0x48, 0xbd, 0x50, 0x8d, 0x10, 0x82, 0x7e, 0xc8, 0x85, 0x74, 0x4c,
+	0xc5, 0xaa, 0xf7, 0xd2, 0x1b, 0x11, 0x91, 0x52, 0x59, 0x7f, 0x9b, 0x0a, 0x79, 0x1f, 0xda, 0x26,
+	0xcb, 0x0d, 0x84, 0x82, 0xee, 0xa9, 0xa0, 0x2c, 0xa6, 0x3a, 0xad, 0xc3, 0x94, 0xbd, 0xac, 0xae,
+	0xf1, 0x81, 0xb0, 0x94, 0xaa, 0x44, 0x1b, 0x37, 0xd8, 0xfb, 0x0e, 0x93, 0x8f, 0x44, 0xd2, 0x0b,
+	0xb9, 0xb5, 0x87, 0xd9, 0x39, 0x2b, 0xe3, 0xbf, 0xce, 0xea, 0xbd, 0xf5, 0x6d, 0xa8, 0x7e, 0x89,
+	0x62, 0x57, 0x3d, 0x5f, 0xff, 0x19, 0x00, 0x2f, 0x4f, 0xd3, 0x42, 0x2f, 0x03, 0x00, 0x00,
 }
@@ -10,9 +10,26 @@ package protos
 
 import (
 	"errors"
+	"fmt"
 	"net"
+	"regexp"
+	"strings"
+	"unicode"
 )
 
+const (
+	maxUint16 = 65535
+	// Longest character-string of a TXT record (RFC 1035 3.3)
+	maxTxtRecordLength = 255
+	// Longest domain name in text form without the trailing dot (RFC 1035 2.3.4)
+	maxDomainLength = 253
+)
+
+// Domain labels are restricted to letters, digits, hyphens and the
+// underscores of service labels, which also keeps names from breaking out of
+// the comma and line separated dnsmasq config on the gateway.
+var domainLabelRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{1,63}$`)
+
 // See dns_service.proto for full documentation on all config protobuf
 // fields and which ones are optional/required.
 func ValidateNetworkConfig(config *NetworkDNSConfig) error {
@@ -25,15 +42,33 @@ func ValidateNetworkConfig(config *NetworkDNSConfig) error {
 	return nil
 }
 
+func ValidateGatewayConfig(config *GatewayDNSConfig) error {
+	if config == nil {
+		return errors.New("GatewayDNSConfig is nil.")
+	}
+	if err := validateNetworkDNSRecordsConfig(config.GetRecords()); err != nil {
+		return err
+	}
+	return nil
+}
+
 func validateNetworkDNSRecordsConfig(records []*NetworkDNSConfigRecordsItems) error {
 	if records == nil {
 		return nil
 	}
 
+	domains := map[string]bool{}
 	for _, item := range records {
 		if err := validateNetworkDNSConfigRecordsItems(item); err != nil {
 			return err
 		}
+		// Gateway records override network records by domain, so each
+		// domain may only have one records item
+		domain := strings.ToLower(item.GetDomain())
+		if domains[domain] {
+			return fmt.Errorf("Records of domain %s are listed more than once.", item.GetDomain())
+		}
+		domains[domain] = true
 	}
 	return nil
 }
@@ -58,6 +93,22 @@ func validateNetworkDNSConfigRecordsItems(config *NetworkDNSConfigRecordsItems)
 		return err
 	}
 
+	if err := validateNetworkDNSConfigSrvRecord(config.GetDomain(), config.GetSrvRecord()); err != nil {
+		return err
+	}
+
+	if err := validateNetworkDNSConfigPtrRecord(config.GetDomain(), config.GetPtrRecord()); err != nil {
+		return err
+	}
+
+	if err := validateNetworkDNSConfigTxtRecord(config.GetTxtRecord()); err != nil {
+		return err
+	}
+
+	if err := validateNetworkDNSConfigMxRecord(config.GetMxRecord()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -86,9 +137,23 @@ func validateNetworkDNSConfigAaaaRecord(AaaaRecord []string) error {
 }
 
 func validateNetworkDNSConfigDomain(domain string) error {
-	// TODO: Figure out how to validate a string is a domain
-	if domain == "" {
-		return errors.New("Domain cannot be empty string.")
+	return validateDomainName("Domain", domain)
+}
+
+// validateDomainName checks that name is a domain name made of valid labels,
+// optionally ending with a dot.
+func validateDomainName(field string, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s cannot be empty string.", field)
+	}
+	trimmed := strings.TrimSuffix(name, ".")
+	if len(trimmed) > maxDomainLength {
+		return fmt.Errorf("%s cannot be longer than %d characters.", field, maxDomainLength)
+	}
+	for _, label := range strings.Split(trimmed, ".") {
+		if !domainLabelRegex.MatchString(label) {
+			return fmt.Errorf("%s %q is not a valid domain name.", field, name)
+		}
 	}
 	return nil
 }
@@ -104,3 +169,82 @@ func validateNetworkDNSConfigCname(CnameRecord []string) error {
 	}
 	return nil
 }
+
+func validateNetworkDNSConfigSrvRecord(domain string, SrvRecord []*SrvRecord) error {
+	if len(SrvRecord) == 0 {
+		return nil
+	}
+	// SRV records are looked up under _service._proto.name (RFC 2782)
+	labels := strings.Split(domain, ".")
+	if len(labels) < 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+		return errors.New("SrvRecord domain must be in the form of _service._proto.name.")
+	}
+	for _, record := range SrvRecord {
+		if record == nil {
+			return errors.New("SrvRecord is nil.")
+		}
+		if err := validateUint16("SrvRecord Priority", record.GetPriority()); err != nil {
+			return err
+		}
+		if err := validateUint16("SrvRecord Weight", record.GetWeight()); err != nil {
+			return err
+		}
+		if err := validateUint16("SrvRecord Port", record.GetPort()); err != nil {
+			return err
+		}
+		if err := validateDomainName("SrvRecord Target", record.GetTarget()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNetworkDNSConfigPtrRecord(domain string, PtrRecord []string) error {
+	if len(PtrRecord) == 0 {
+		return nil
+	}
+	reverseDomain := strings.ToLower(strings.TrimSuffix(domain, "."))
+	if !strings.HasSuffix(reverseDomain, ".in-addr.arpa") && !strings.HasSuffix(reverseDomain, ".ip6.arpa") {
+		return errors.New("PtrRecord domain must be a reverse lookup domain under in-addr.arpa or ip6.arpa.")
+	}
+	for _, record := range PtrRecord {
+		if err := validateDomainName("PtrRecord", record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNetworkDNSConfigTxtRecord(TxtRecord []string) error {
+	for _, record := range TxtRecord {
+		if len(record) > maxTxtRecordLength {
+			return fmt.Errorf("TxtRecord cannot be longer than %d characters.", maxTxtRecordLength)
+		}
+		if strings.IndexFunc(record, unicode.IsControl) >= 0 {
+			return errors.New("TxtRecord cannot contain control characters.")
+		}
+	}
+	return nil
+}
+
+func validateNetworkDNSConfigMxRecord(MxRecord []*MxRecord) error {
+	for _, record := range MxRecord {
+		if record == nil {
+			return errors.New("MxRecord is nil.")
+		}
+		if err := validateUint16("MxRecord Preference", record.GetPreference()); err != nil {
+			return err
+		}
+		if err := validateDomainName("MxRecord Exchange", record.GetExchange()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateUint16(name string, value int32) error {
+	if value < 0 || value > maxUint16 {
+		return fmt.Errorf("%s must be between 0 and %d.", name, maxUint16)
+	}
+	return nil
+}
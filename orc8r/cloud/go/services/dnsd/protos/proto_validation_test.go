@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package protos_test
 
 import (
+	"strings"
 	"testing"
 
 	"magma/orc8r/cloud/go/services/dnsd/protos"
@@ -77,3 +78,120 @@ func TestValidateNetworkConfig(t *testing.T) {
 
 	// TODO: Test cname records
 }
+
+func TestValidateNetworkConfig_RecordTypes(t *testing.T) {
+	config := &protos.NetworkDNSConfig{}
+	validate := func(item *protos.NetworkDNSConfigRecordsItems) error {
+		config.Records = []*protos.NetworkDNSConfigRecordsItems{item}
+		return protos.ValidateNetworkConfig(config)
+	}
+
+	// SRV
+	srv := &protos.SrvRecord{Priority: 10, Weight: 60, Port: 5060, Target: "sip.example.com"}
+	assert.NoError(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "_sip._udp.example.com",
+		SrvRecord: []*protos.SrvRecord{srv},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "sip.example.com",
+		SrvRecord: []*protos.SrvRecord{srv},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "_sip._udp.example.com",
+		SrvRecord: []*protos.SrvRecord{{Port: 70000, Target: "sip.example.com"}},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "_sip._udp.example.com",
+		SrvRecord: []*protos.SrvRecord{{Port: 5060}},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "_sip._udp.example.com",
+		SrvRecord: []*protos.SrvRecord{{Port: 5060, Target: "x\naddress=/#/1.2.3.4"}},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "_sip._udp.example.com",
+		SrvRecord: []*protos.SrvRecord{{Port: 5060, Target: "sip.example.com,5061"}},
+	}))
+
+	// PTR
+	assert.NoError(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "1.88.168.192.in-addr.arpa",
+		PtrRecord: []string{"ims.example.com"},
+	}))
+	assert.NoError(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+		PtrRecord: []string{"ims.example.com"},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "example.com",
+		PtrRecord: []string{"ims.example.com"},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "1.88.168.192.in-addr.arpa",
+		PtrRecord: []string{""},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "1.88.168.192.in-addr.arpa",
+		PtrRecord: []string{"ims example.com"},
+	}))
+
+	// TXT
+	assert.NoError(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "example.com",
+		TxtRecord: []string{"v=spf1 -all", ""},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "example.com",
+		TxtRecord: []string{strings.Repeat("a", 256)},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:    "example.com",
+		TxtRecord: []string{"x\naddress=/#/1.2.3.4"},
+	}))
+
+	// MX
+	assert.NoError(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:   "example.com",
+		MxRecord: []*protos.MxRecord{{Preference: 10, Exchange: "mail.example.com"}},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:   "example.com",
+		MxRecord: []*protos.MxRecord{{Preference: -1, Exchange: "mail.example.com"}},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:   "example.com",
+		MxRecord: []*protos.MxRecord{{Preference: 10}},
+	}))
+	assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{
+		Domain:   "example.com",
+		MxRecord: []*protos.MxRecord{{Preference: 10, Exchange: "mail.example.com\r"}},
+	}))
+
+	// Domains must be valid domain names
+	assert.NoError(t, validate(&protos.NetworkDNSConfigRecordsItems{Domain: "example.com.", ARecord: []string{"192.168.88.99"}}))
+	for _, domain := range []string{"example..com", "exa mple.com", "example.com,1.2.3.4", "/#/example.com", strings.Repeat("a", 64) + ".com"} {
+		assert.Error(t, validate(&protos.NetworkDNSConfigRecordsItems{Domain: domain, ARecord: []string{"192.168.88.99"}}), domain)
+	}
+
+	// Domains can only be listed once
+	config.Records = []*protos.NetworkDNSConfigRecordsItems{
+		{Domain: "example.com", ARecord: []string{"192.168.88.99"}},
+		{Domain: "EXAMPLE.com", TxtRecord: []string{"hello"}},
+	}
+	assert.Error(t, protos.ValidateNetworkConfig(config))
+}
+
+func TestValidateGatewayConfig(t *testing.T) {
+	assert.Error(t, protos.ValidateGatewayConfig(nil))
+	assert.NoError(t, protos.ValidateGatewayConfig(&protos.GatewayDNSConfig{}))
+
+	config := &protos.GatewayDNSConfig{
+		Records: []*protos.NetworkDNSConfigRecordsItems{
+			{Domain: "ims.example.com", ARecord: []string{"192.168.88.99"}},
+		},
+	}
+	assert.NoError(t, protos.ValidateGatewayConfig(config))
+
+	config.Records[0].ARecord = []string{"not an ip"}
+	assert.Error(t, protos.ValidateGatewayConfig(config))
+}
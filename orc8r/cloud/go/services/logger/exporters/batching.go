@@ -0,0 +1,156 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+const (
+	DefaultQueueLength   = 100000
+	DefaultBatchSize     = 500
+	DefaultFlushInterval = time.Second * 10
+)
+
+// ErrQueueFull is returned by Submit when an exporter can't keep up with the
+// entries submitted to it. The entries are rejected rather than dropped, so
+// clients can back off and retry.
+var ErrQueueFull = errors.New("log exporter queue is full")
+
+// BatchingConfig configures how a BatchingExporter queues and writes entries
+type BatchingConfig struct {
+	// QueueLength is the maximum number of entries waiting to be written
+	QueueLength int
+	// BatchSize is the maximum number of entries written at once. A write is
+	// triggered as soon as a batch worth of entries is queued.
+	BatchSize int
+	// FlushInterval is the interval at which queued entries are written
+	FlushInterval time.Duration
+}
+
+// batchWriter writes a batch of documents to a sink. Errors which won't go
+// away on retry are wrapped in permanentError.
+type batchWriter interface {
+	write(docs []*LogDocument) error
+}
+
+type permanentError struct {
+	error
+}
+
+// BatchingExporter queues submitted entries in memory and writes them in
+// batches. Batches which fail to be written stay queued and are retried on
+// the next flush, unless the failure is permanent.
+type BatchingExporter struct {
+	name   string
+	writer batchWriter
+	config BatchingConfig
+
+	queueMutex sync.Mutex
+	queue      []*LogDocument
+	flushCh    chan struct{}
+	// flushMutex serializes flushes, which only ever remove entries from the
+	// head of the queue
+	flushMutex sync.Mutex
+}
+
+func newBatchingExporter(name string, writer batchWriter, config BatchingConfig) *BatchingExporter {
+	if config.QueueLength <= 0 {
+		config.QueueLength = DefaultQueueLength
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultFlushInterval
+	}
+	return &BatchingExporter{
+		name:    name,
+		writer:  writer,
+		config:  config,
+		flushCh: make(chan struct{}, 1),
+	}
+}
+
+// Submit queues entries to be written. All entries are rejected with
+// ErrQueueFull if they don't fit in the queue.
+func (e *BatchingExporter) Submit(logEntries []*protos.LogEntry) error {
+	docs, err := ConvertToLogDocuments(logEntries)
+	if err != nil {
+		return err
+	}
+	e.queueMutex.Lock()
+	defer e.queueMutex.Unlock()
+	if len(e.queue)+len(docs) > e.config.QueueLength {
+		glog.Warningf("%s exporter queue is full, rejecting %d entries", e.name, len(docs))
+		return ErrQueueFull
+	}
+	e.queue = append(e.queue, docs...)
+	if len(e.queue) >= e.config.BatchSize {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (e *BatchingExporter) Start() {
+	go e.flushEvery()
+}
+
+func (e *BatchingExporter) flushEvery() {
+	ticker := time.NewTicker(e.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-e.flushCh:
+		}
+		if err := e.Flush(); err != nil {
+			glog.Errorf("Error in exporting to %s: %v", e.name, err)
+		}
+	}
+}
+
+// Flush writes queued entries in batches until the queue is empty or a write
+// fails
+func (e *BatchingExporter) Flush() error {
+	e.flushMutex.Lock()
+	defer e.flushMutex.Unlock()
+	for {
+		e.queueMutex.Lock()
+		batch := e.queue
+		if len(batch) > e.config.BatchSize {
+			batch = batch[:e.config.BatchSize]
+		}
+		e.queueMutex.Unlock()
+		if len(batch) == 0 {
+			return nil
+		}
+
+		err := e.writer.write(batch)
+		if _, isPermanent := err.(permanentError); err != nil && !isPermanent {
+			return fmt.Errorf("Failed to export %d entries, retrying on next flush: %v", len(batch), err)
+		}
+		e.queueMutex.Lock()
+		e.queue = e.queue[len(batch):]
+		e.queueMutex.Unlock()
+		if err != nil {
+			return fmt.Errorf("Giving up on batch of %d entries: %v", len(batch), err)
+		}
+	}
+}
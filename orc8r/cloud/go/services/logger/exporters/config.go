@@ -0,0 +1,176 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/service/config"
+)
+
+// Keys of the logger service config
+const (
+	ScribeExportURLKey = "scribe_export_url"
+	ScribeAppIDKey     = "scribe_app_id"
+	ScribeAppSecretKey = "scribe_app_secret"
+	ExportersKey       = "exporters"
+	RoutesKey          = "routes"
+
+	// ScribeExporterName is the name routes use for the Scribe exporter
+	ScribeExporterName = "scribe"
+
+	ExporterTypeFile          = "file"
+	ExporterTypeElasticsearch = "elasticsearch"
+
+	ScribeExportInterval = time.Second * 60
+	ScribeQueueLength    = 100000
+)
+
+// NewExporterFromConfig creates the exporters configured in the logger
+// service config and returns a CategoryRouter over them. The Scribe exporter
+// is created if scribe_export_url is set. Without routes, all entries are
+// exported to all exporters.
+//
+// Example:
+//
+//	exporters:
+//	  local:
+//	    type: "file"
+//	    path: "/var/opt/magma/logs/entries.jsonl"
+//	    max_size_mb: 100
+//	    max_backups: 5
+//	  es:
+//	    type: "elasticsearch"
+//	    url: "http://elasticsearch:9200"
+//	    index: "magma-logs"
+//	    batch_size: 500
+//	    flush_interval_secs: 10
+//	    queue_length: 100000
+//	routes:
+//	  perfpipe_magma_rest_api_stats: ["es", "local"]
+//	  default: ["scribe"]
+func NewExporterFromConfig(serviceConfig *config.ConfigMap) (*CategoryRouter, error) {
+	exportersByName := map[string]Exporter{}
+
+	scribeURL, err := serviceConfig.GetStringParam(ScribeExportURLKey)
+	if err == nil && scribeURL != "" {
+		appID, err := serviceConfig.GetStringParam(ScribeAppIDKey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is required for the Scribe exporter", ScribeAppIDKey)
+		}
+		appSecret, err := serviceConfig.GetStringParam(ScribeAppSecretKey)
+		if err != nil {
+			return nil, fmt.Errorf("%s is required for the Scribe exporter", ScribeAppSecretKey)
+		}
+		exportersByName[ScribeExporterName] = NewScribeExporter(scribeURL, appID, appSecret, ScribeQueueLength, ScribeExportInterval)
+	}
+
+	if rawExporters, ok := serviceConfig.RawMap[ExportersKey]; ok && rawExporters != nil {
+		exporterMaps, ok := rawExporters.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s must be a map of exporter names to exporter configs", ExportersKey)
+		}
+		for rawName, rawExporter := range exporterMaps {
+			name, ok := rawName.(string)
+			if !ok {
+				return nil, fmt.Errorf("Exporter name %v must be a string", rawName)
+			}
+			if _, exists := exportersByName[name]; exists {
+				return nil, fmt.Errorf("Exporter %s is already configured", name)
+			}
+			exporterMap, ok := rawExporter.(map[interface{}]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Config of exporter %s must be a map", name)
+			}
+			exporter, err := newExporter(name, config.NewConfigMap(exporterMap))
+			if err != nil {
+				return nil, err
+			}
+			exportersByName[name] = exporter
+		}
+	}
+
+	routes, err := getRoutes(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	if routes == nil {
+		defaultRoute := make([]string, 0, len(exportersByName))
+		for name := range exportersByName {
+			defaultRoute = append(defaultRoute, name)
+		}
+		routes = map[string][]string{DefaultRoute: defaultRoute}
+	}
+	return NewCategoryRouter(exportersByName, routes)
+}
+
+func newExporter(name string, exporterConfig *config.ConfigMap) (Exporter, error) {
+	batchingConfig := BatchingConfig{}
+	batchingConfig.QueueLength, _ = exporterConfig.GetIntParam("queue_length")
+	batchingConfig.BatchSize, _ = exporterConfig.GetIntParam("batch_size")
+	flushIntervalSecs, _ := exporterConfig.GetIntParam("flush_interval_secs")
+	batchingConfig.FlushInterval = time.Duration(flushIntervalSecs) * time.Second
+
+	exporterType, _ := exporterConfig.GetStringParam("type")
+	switch exporterType {
+	case ExporterTypeFile:
+		fileConfig := FileConfig{MaxBackups: DefaultMaxBackups}
+		fileConfig.Path, _ = exporterConfig.GetStringParam("path")
+		maxSizeMB, _ := exporterConfig.GetIntParam("max_size_mb")
+		fileConfig.MaxSizeBytes = int64(maxSizeMB) * 1024 * 1024
+		if maxBackups, err := exporterConfig.GetIntParam("max_backups"); err == nil {
+			fileConfig.MaxBackups = maxBackups
+		}
+		return NewFileExporter(name, fileConfig, batchingConfig)
+	case ExporterTypeElasticsearch:
+		esConfig := ElasticsearchConfig{}
+		esConfig.URL, _ = exporterConfig.GetStringParam("url")
+		esConfig.Index, _ = exporterConfig.GetStringParam("index")
+		esConfig.Username, _ = exporterConfig.GetStringParam("username")
+		esConfig.Password, _ = exporterConfig.GetStringParam("password")
+		return NewElasticsearchExporter(name, esConfig, batchingConfig)
+	default:
+		return nil, fmt.Errorf("Unknown type '%s' of exporter %s, expected %s or %s",
+			exporterType, name, ExporterTypeFile, ExporterTypeElasticsearch)
+	}
+}
+
+// getRoutes returns nil if no routes are configured
+func getRoutes(serviceConfig *config.ConfigMap) (map[string][]string, error) {
+	rawRoutes, ok := serviceConfig.RawMap[RoutesKey]
+	if !ok || rawRoutes == nil {
+		return nil, nil
+	}
+	routeMaps, ok := rawRoutes.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a map of categories to exporter names", RoutesKey)
+	}
+	routes := map[string][]string{}
+	for rawCategory, rawNames := range routeMaps {
+		category, ok := rawCategory.(string)
+		if !ok {
+			return nil, fmt.Errorf("Route category %v must be a string", rawCategory)
+		}
+		nameList, ok := rawNames.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Route for %s must be a list of exporter names", category)
+		}
+		names := make([]string, 0, len(nameList))
+		for _, rawName := range nameList {
+			name, ok := rawName.(string)
+			if !ok {
+				return nil, fmt.Errorf("Route for %s must be a list of exporter names", category)
+			}
+			names = append(names, name)
+		}
+		routes[category] = names
+	}
+	return routes, nil
+}
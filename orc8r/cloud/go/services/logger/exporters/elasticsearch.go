@@ -0,0 +1,141 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultElasticsearchIndex = "magma-logs"
+
+	elasticsearchTimeout = time.Second * 30
+)
+
+// ElasticsearchConfig configures an Elasticsearch exporter
+type ElasticsearchConfig struct {
+	// URL of the Elasticsearch (or API compatible) server
+	URL string
+	// Index documents are written to
+	Index    string
+	Username string
+	Password string
+}
+
+// NewElasticsearchExporter creates an exporter which indexes entries as
+// LogDocuments through the Elasticsearch bulk API
+func NewElasticsearchExporter(name string, config ElasticsearchConfig, batchingConfig BatchingConfig) (*BatchingExporter, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("A URL must be configured for Elasticsearch exporter %s", name)
+	}
+	if config.Index == "" {
+		config.Index = DefaultElasticsearchIndex
+	}
+	writer := &elasticsearchWriter{
+		config:  config,
+		bulkURL: strings.TrimSuffix(config.URL, "/") + "/_bulk",
+		client:  &http.Client{Timeout: elasticsearchTimeout},
+	}
+	return newBatchingExporter(name, writer, batchingConfig), nil
+}
+
+type elasticsearchWriter struct {
+	config  ElasticsearchConfig
+	bulkURL string
+	client  *http.Client
+}
+
+type bulkAction struct {
+	Index bulkIndexAction `json:"index"`
+}
+
+type bulkIndexAction struct {
+	Index string `json:"_index"`
+}
+
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int             `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	} `json:"items"`
+}
+
+func (w *elasticsearchWriter) write(docs []*LogDocument) error {
+	action, err := json.Marshal(bulkAction{Index: bulkIndexAction{Index: w.config.Index}})
+	if err != nil {
+		return permanentError{err}
+	}
+	var body bytes.Buffer
+	for _, doc := range docs {
+		source, err := json.Marshal(doc)
+		if err != nil {
+			return permanentError{err}
+		}
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(source)
+		body.WriteByte('\n')
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.bulkURL, &body)
+	if err != nil {
+		return permanentError{err}
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if w.config.Username != "" {
+		req.SetBasicAuth(w.config.Username, w.config.Password)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errMsg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		err = fmt.Errorf("Elasticsearch status code %d: %s", resp.StatusCode, bytes.TrimSpace(errMsg))
+		// Retry when Elasticsearch is overloaded or failing, but not for
+		// requests it will never accept
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return err
+		}
+		return permanentError{err}
+	}
+
+	bulkResp := bulkResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&bulkResp); err != nil {
+		return permanentError{fmt.Errorf("Failed to decode Elasticsearch bulk response: %v", err)}
+	}
+	if !bulkResp.Errors {
+		return nil
+	}
+	// Retrying the batch would duplicate the documents which were indexed,
+	// so rejected documents are dropped
+	failed := 0
+	var firstErr json.RawMessage
+	for _, item := range bulkResp.Items {
+		for _, result := range item {
+			if result.Status >= 300 {
+				failed++
+				if firstErr == nil {
+					firstErr = result.Error
+				}
+			}
+		}
+	}
+	return permanentError{fmt.Errorf("Elasticsearch rejected %d of %d documents: %s", failed, len(docs), firstErr)}
+}
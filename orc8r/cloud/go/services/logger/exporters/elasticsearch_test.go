@@ -0,0 +1,116 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type bulkRecorder struct {
+	bodies    []string
+	requests  []*http.Request
+	responses []struct {
+		status int
+		body   string
+	}
+}
+
+func (r *bulkRecorder) respondWith(status int, body string) {
+	r.responses = append(r.responses, struct {
+		status int
+		body   string
+	}{status, body})
+}
+
+func (r *bulkRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	r.bodies = append(r.bodies, string(body))
+	r.requests = append(r.requests, req)
+	status, respBody := http.StatusOK, `{"errors":false,"items":[]}`
+	if len(r.responses) > 0 {
+		status, respBody = r.responses[0].status, r.responses[0].body
+		r.responses = r.responses[1:]
+	}
+	w.WriteHeader(status)
+	w.Write([]byte(respBody))
+}
+
+func TestElasticsearchExporter(t *testing.T) {
+	recorder := &bulkRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	_, err := exporters.NewElasticsearchExporter("es", exporters.ElasticsearchConfig{}, exporters.BatchingConfig{})
+	assert.EqualError(t, err, "A URL must be configured for Elasticsearch exporter es")
+
+	exporter, err := exporters.NewElasticsearchExporter(
+		"es",
+		exporters.ElasticsearchConfig{URL: server.URL + "/", Username: "user", Password: "pass"},
+		exporters.BatchingConfig{BatchSize: 2},
+	)
+	assert.NoError(t, err)
+	entries := []*protos.LogEntry{
+		{Category: "c1", Time: 1560000000, TagSet: []string{"tag"}},
+		{Category: "c2", Time: 1560000001},
+		{Category: "c3", Time: 1560000002},
+	}
+
+	// Entries are written in batches through the bulk API
+	assert.NoError(t, exporter.Submit(entries))
+	assert.NoError(t, exporter.Flush())
+	assert.Len(t, recorder.requests, 2)
+	req := recorder.requests[0]
+	assert.Equal(t, "/_bulk", req.URL.Path)
+	assert.Equal(t, "application/x-ndjson", req.Header.Get("Content-Type"))
+	username, password, ok := req.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+	expectedBody := `{"index":{"_index":"magma-logs"}}
+{"@timestamp":"2019-06-08T13:20:00Z","time":1560000000,"category":"c1","tagset":["tag"]}
+{"index":{"_index":"magma-logs"}}
+{"@timestamp":"2019-06-08T13:20:01Z","time":1560000001,"category":"c2"}
+`
+	assert.Equal(t, expectedBody, recorder.bodies[0])
+	assert.Equal(t, 2, strings.Count(recorder.bodies[1], "\n"))
+
+	// Server errors are retried on the next flush
+	recorder.respondWith(http.StatusServiceUnavailable, "unavailable")
+	assert.NoError(t, exporter.Submit(entries[:1]))
+	err = exporter.Flush()
+	assert.EqualError(t, err, "Failed to export 1 entries, retrying on next flush: Elasticsearch status code 503: unavailable")
+	assert.NoError(t, exporter.Flush())
+	assert.Len(t, recorder.requests, 4)
+	assert.Equal(t, recorder.bodies[2], recorder.bodies[3])
+
+	// Requests Elasticsearch rejects are not
+	recorder.respondWith(http.StatusBadRequest, "bad request")
+	assert.NoError(t, exporter.Submit(entries[:1]))
+	err = exporter.Flush()
+	assert.EqualError(t, err, "Giving up on batch of 1 entries: Elasticsearch status code 400: bad request")
+	assert.NoError(t, exporter.Flush())
+	assert.Len(t, recorder.requests, 5)
+
+	// Neither are documents rejected in a successful bulk request
+	recorder.respondWith(http.StatusOK, `{"errors":true,"items":[{"index":{"status":201}},{"index":{"status":400,"error":{"type":"mapper_parsing_exception"}}}]}`)
+	assert.NoError(t, exporter.Submit(entries[:2]))
+	err = exporter.Flush()
+	assert.EqualError(t, err, `Giving up on batch of 2 entries: Elasticsearch rejected 1 of 2 documents: {"type":"mapper_parsing_exception"}`)
+	assert.NoError(t, exporter.Flush())
+	assert.Len(t, recorder.requests, 6)
+}
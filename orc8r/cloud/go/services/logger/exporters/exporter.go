@@ -13,4 +13,7 @@ import "magma/orc8r/cloud/go/protos"
 type Exporter interface {
 	// export logEntries
 	Submit(logEntries []*protos.LogEntry) error
+
+	// Start starts exporting submitted entries in the background
+	Start()
 }
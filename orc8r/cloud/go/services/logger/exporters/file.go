@@ -0,0 +1,128 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	DefaultMaxFileSizeBytes = 100 * 1024 * 1024
+	DefaultMaxBackups       = 5
+)
+
+// FileConfig configures a file exporter
+type FileConfig struct {
+	// Path of the file entries are written to as JSON lines
+	Path string
+	// MaxSizeBytes is the size after which the file is rotated. Rotated files
+	// are renamed to <Path>.1, <Path>.2... with <Path>.1 the most recent.
+	MaxSizeBytes int64
+	// MaxBackups is the number of rotated files to keep
+	MaxBackups int
+}
+
+// NewFileExporter creates an exporter which appends entries to a local
+// rotating file, one JSON LogDocument per line
+func NewFileExporter(name string, config FileConfig, batchingConfig BatchingConfig) (*BatchingExporter, error) {
+	if config.Path == "" {
+		return nil, fmt.Errorf("A path must be configured for file exporter %s", name)
+	}
+	if config.MaxSizeBytes <= 0 {
+		config.MaxSizeBytes = DefaultMaxFileSizeBytes
+	}
+	if config.MaxBackups < 0 {
+		config.MaxBackups = DefaultMaxBackups
+	}
+	return newBatchingExporter(name, &rotatingFileWriter{config: config}, batchingConfig), nil
+}
+
+type rotatingFileWriter struct {
+	config FileConfig
+	file   *os.File
+	size   int64
+}
+
+func (w *rotatingFileWriter) write(docs []*LogDocument) error {
+	var buf bytes.Buffer
+	for _, doc := range docs {
+		line, err := json.Marshal(doc)
+		if err != nil {
+			return permanentError{err}
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	if w.size > 0 && w.size+int64(buf.Len()) > w.config.MaxSizeBytes {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := w.file.Write(buf.Bytes())
+	w.size += int64(n)
+	if err != nil {
+		// Don't retry a partially written batch
+		return permanentError{err}
+	}
+	return nil
+}
+
+func (w *rotatingFileWriter) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.config.Path), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(w.config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	w.file = file
+	w.size = info.Size()
+	return nil
+}
+
+// rotate shifts the current file and its backups by one, dropping the oldest
+// backup, and opens a new empty file
+func (w *rotatingFileWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.file = nil
+	path := w.config.Path
+	if w.config.MaxBackups == 0 {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		return w.open()
+	}
+	for i := w.config.MaxBackups - 1; i >= 1; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", path, i), fmt.Sprintf("%s.%d", path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(path, path+".1"); err != nil {
+		return err
+	}
+	return w.open()
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readLines(t *testing.T, path string) []string {
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+}
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logger_file_exporter")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs", "entries.jsonl")
+
+	_, err = exporters.NewFileExporter("local", exporters.FileConfig{}, exporters.BatchingConfig{})
+	assert.EqualError(t, err, "A path must be configured for file exporter local")
+
+	// Each line is 144 bytes, so the file is rotated every 3 entries
+	exporter, err := exporters.NewFileExporter(
+		"local",
+		exporters.FileConfig{Path: path, MaxSizeBytes: 450, MaxBackups: 2},
+		exporters.BatchingConfig{QueueLength: 4, BatchSize: 1},
+	)
+	assert.NoError(t, err)
+
+	err = exporter.Submit([]*protos.LogEntry{{Category: "test"}})
+	assert.EqualError(t, err, "LogEntry category:\"test\"  doesn't have time field set")
+
+	entry := &protos.LogEntry{
+		Category:  "perfpipe_magma_rest_api_stats",
+		Time:      1560000000,
+		NormalMap: map[string]string{"status": "200"},
+		IntMap:    map[string]int64{"port": 443},
+	}
+	assert.NoError(t, exporter.Submit([]*protos.LogEntry{entry, entry, entry}))
+	// Back-pressure once the queue is full
+	assert.Equal(t, exporters.ErrQueueFull, exporter.Submit([]*protos.LogEntry{entry, entry}))
+	assert.NoError(t, exporter.Flush())
+
+	lines := readLines(t, path)
+	assert.Len(t, lines, 3)
+	doc := &exporters.LogDocument{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), doc))
+	expected := &exporters.LogDocument{
+		Timestamp: "2019-06-08T13:20:00Z",
+		Time:      1560000000,
+		Category:  "perfpipe_magma_rest_api_stats",
+		Normal:    map[string]string{"status": "200"},
+		Int:       map[string]int64{"port": 443},
+	}
+	assert.Equal(t, expected, doc)
+
+	// Rotate twice, the oldest entries are dropped with the oldest backup
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, exporter.Submit([]*protos.LogEntry{entry, entry, entry}))
+		assert.NoError(t, exporter.Flush())
+	}
+	assert.Len(t, readLines(t, path), 3)
+	assert.Len(t, readLines(t, path+".1"), 3)
+	assert.Len(t, readLines(t, path+".2"), 3)
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+
+	// A new exporter appends to the existing file
+	exporter, err = exporters.NewFileExporter(
+		"local",
+		exporters.FileConfig{Path: path, MaxSizeBytes: 1000, MaxBackups: 2},
+		exporters.BatchingConfig{},
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Submit([]*protos.LogEntry{entry}))
+	assert.NoError(t, exporter.Flush())
+	assert.Len(t, readLines(t, path), 4)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+// LogDocument is the self-contained JSON representation of a LogEntry which
+// the file and Elasticsearch exporters write
+type LogDocument struct {
+	// Timestamp is the entry time in RFC 3339 format, which Elasticsearch
+	// maps to a date by default
+	Timestamp string `json:"@timestamp"`
+	// Time is the unix timestamp in seconds of the entry
+	Time       int64             `json:"time"`
+	Category   string            `json:"category"`
+	HardwareID string            `json:"hardware_id,omitempty"`
+	NetworkID  string            `json:"network_id,omitempty"`
+	GatewayID  string            `json:"gateway_id,omitempty"`
+	Normal     map[string]string `json:"normal,omitempty"`
+	Int        map[string]int64  `json:"int,omitempty"`
+	TagSet     []string          `json:"tagset,omitempty"`
+	NormVec    []string          `json:"normvector,omitempty"`
+}
+
+// ConvertToLogDocuments converts entries to LogDocuments. Gateway entries
+// are annotated with the network and logical ID of the gateway.
+func ConvertToLogDocuments(entries []*protos.LogEntry) ([]*LogDocument, error) {
+	docs := make([]*LogDocument, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Time == 0 {
+			return nil, fmt.Errorf("LogEntry %v doesn't have time field set", entry)
+		}
+		doc := &LogDocument{
+			Timestamp:  time.Unix(entry.Time, 0).UTC().Format(time.RFC3339),
+			Time:       entry.Time,
+			Category:   entry.Category,
+			HardwareID: entry.HwId,
+			Normal:     entry.NormalMap,
+			Int:        entry.IntMap,
+			TagSet:     entry.TagSet,
+			NormVec:    entry.Normvector,
+		}
+		nwId, gwId, err := getNwIdGwId(entry.HwId)
+		if err != nil {
+			glog.Errorf("Error retrieving nwId and gwId for hwId %s: %v\n", entry.HwId, err)
+		} else {
+			doc.NetworkID = nwId
+			doc.GatewayID = gwId
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
@@ -34,6 +34,8 @@ func (exporter *mockExporter) Submit(logEntries []*protos.LogEntry) error {
 	return nil
 }
 
+func (exporter *mockExporter) Start() {}
+
 // can assert methods called on this exporter
 type ExposedMockExporter struct {
 	mock.Mock
@@ -53,3 +55,7 @@ func (exporter *ExposedMockExporter) Submit(logEntries []*protos.LogEntry) error
 	fmt.Printf("entries to Export in json: %v\n", string(logJson))
 	return args.Error(0)
 }
+
+func (exporter *ExposedMockExporter) Start() {
+	exporter.Called()
+}
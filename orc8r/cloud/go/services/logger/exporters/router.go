@@ -0,0 +1,116 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"fmt"
+	"sort"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultRoute is the route of categories without a route of their own
+const DefaultRoute = "default"
+
+var droppedEntries = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "log_entries_dropped",
+		Help: "Number of log entries an exporter failed to accept",
+	},
+	[]string{"exporter"},
+)
+
+func init() {
+	prometheus.MustRegister(droppedEntries)
+}
+
+// CategoryRouter is an Exporter which hands each entry to the exporters
+// routed to for the entry's category
+type CategoryRouter struct {
+	exportersByName map[string]Exporter
+	// routes maps categories (or DefaultRoute) to exporter names
+	routes map[string][]string
+}
+
+// NewCategoryRouter creates a CategoryRouter. Entries of categories without
+// a route and no DefaultRoute are dropped.
+func NewCategoryRouter(exportersByName map[string]Exporter, routes map[string][]string) (*CategoryRouter, error) {
+	for category, names := range routes {
+		for _, name := range names {
+			if _, ok := exportersByName[name]; !ok {
+				return nil, fmt.Errorf("Route for %s refers to unknown exporter %s", category, name)
+			}
+		}
+	}
+	return &CategoryRouter{exportersByName: exportersByName, routes: routes}, nil
+}
+
+// Submit submits entries to the exporters of their categories. All exporters
+// are submitted to even if some fail, and the entries an exporter fails to
+// accept are counted as dropped by it. Submit succeeds if at least one
+// exporter accepted its entries, so clients don't resend entries which were
+// already exported. If all exporters failed, ErrQueueFull is returned if any
+// exporter's queue is full so clients back off, otherwise the first error.
+func (r *CategoryRouter) Submit(logEntries []*protos.LogEntry) error {
+	entriesByExporter := map[string][]*protos.LogEntry{}
+	for _, entry := range logEntries {
+		names, ok := r.routes[entry.Category]
+		if !ok {
+			names = r.routes[DefaultRoute]
+		}
+		if len(names) == 0 {
+			glog.V(2).Infof("Dropping log entry of category %s without route", entry.Category)
+		}
+		for _, name := range names {
+			entriesByExporter[name] = append(entriesByExporter[name], entry)
+		}
+	}
+
+	names := make([]string, 0, len(entriesByExporter))
+	for name := range entriesByExporter {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var firstErr error
+	queueFull, accepted := false, false
+	for _, name := range names {
+		err := r.exportersByName[name].Submit(entriesByExporter[name])
+		if err == nil {
+			accepted = true
+			continue
+		}
+		droppedEntries.WithLabelValues(name).Add(float64(len(entriesByExporter[name])))
+		if err == ErrQueueFull {
+			glog.V(2).Infof("Dropping %d log entries, %s exporter queue is full", len(entriesByExporter[name]), name)
+			queueFull = true
+		} else {
+			glog.Errorf("Error submitting to %s exporter: %v", name, err)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s exporter: %v", name, err)
+			}
+		}
+	}
+	if accepted || len(names) == 0 {
+		return nil
+	}
+	if queueFull {
+		return ErrQueueFull
+	}
+	return firstErr
+}
+
+// Start starts all exporters
+func (r *CategoryRouter) Start() {
+	for _, exporter := range r.exportersByName {
+		exporter.Start()
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"errors"
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/service/config"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+	"magma/orc8r/cloud/go/services/logger/exporters/mocks"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestCategoryRouter(t *testing.T) {
+	es := mocks.NewExposedMockExporter()
+	file := mocks.NewExposedMockExporter()
+	scribe := mocks.NewExposedMockExporter()
+	exportersByName := map[string]exporters.Exporter{"es": es, "file": file, "scribe": scribe}
+
+	_, err := exporters.NewCategoryRouter(exportersByName, map[string][]string{"c1": {"unknown"}})
+	assert.EqualError(t, err, "Route for c1 refers to unknown exporter unknown")
+
+	router, err := exporters.NewCategoryRouter(exportersByName, map[string][]string{
+		"c1":                   {"es", "file"},
+		"c2":                   {"file"},
+		exporters.DefaultRoute: {"scribe"},
+	})
+	assert.NoError(t, err)
+
+	c1 := &protos.LogEntry{Category: "c1", Time: 1}
+	c2 := &protos.LogEntry{Category: "c2", Time: 2}
+	c3 := &protos.LogEntry{Category: "c3", Time: 3}
+	es.On("Submit", []*protos.LogEntry{c1}).Return(nil)
+	file.On("Submit", []*protos.LogEntry{c1, c2}).Return(nil)
+	scribe.On("Submit", []*protos.LogEntry{c3}).Return(nil)
+	assert.NoError(t, router.Submit([]*protos.LogEntry{c1, c2, c3}))
+	es.AssertExpectations(t)
+	file.AssertExpectations(t)
+	scribe.AssertExpectations(t)
+
+	// Entries are accepted as long as one exporter accepts them, the
+	// entries of the failing exporters are counted as dropped
+	esDropped := getDroppedEntries(t, "es")
+	es.ExpectedCalls = nil
+	file.ExpectedCalls = nil
+	es.On("Submit", []*protos.LogEntry{c1}).Return(exporters.ErrQueueFull)
+	file.On("Submit", []*protos.LogEntry{c1}).Return(nil)
+	assert.NoError(t, router.Submit([]*protos.LogEntry{c1}))
+	file.AssertCalled(t, "Submit", []*protos.LogEntry{c1})
+	assert.Equal(t, esDropped+1, getDroppedEntries(t, "es"))
+
+	// Back-pressure is reported if no exporter accepts the entries
+	fileDropped := getDroppedEntries(t, "file")
+	file.ExpectedCalls = nil
+	file.On("Submit", []*protos.LogEntry{c1}).Return(errors.New("oops"))
+	assert.Equal(t, exporters.ErrQueueFull, router.Submit([]*protos.LogEntry{c1}))
+	assert.Equal(t, esDropped+2, getDroppedEntries(t, "es"))
+	assert.Equal(t, fileDropped+1, getDroppedEntries(t, "file"))
+
+	es.ExpectedCalls = nil
+	es.On("Submit", []*protos.LogEntry{c1}).Return(errors.New("oops"))
+	assert.EqualError(t, router.Submit([]*protos.LogEntry{c1}), "es exporter: oops")
+
+	// Entries without a route are dropped
+	router, err = exporters.NewCategoryRouter(exportersByName, map[string][]string{"c1": {"es"}})
+	assert.NoError(t, err)
+	assert.NoError(t, router.Submit([]*protos.LogEntry{c3}))
+
+	for _, e := range []*mocks.ExposedMockExporter{es, file, scribe} {
+		e.On("Start").Return()
+	}
+	router.Start()
+	for _, e := range []*mocks.ExposedMockExporter{es, file, scribe} {
+		e.AssertCalled(t, "Start")
+	}
+}
+
+func getDroppedEntries(t *testing.T, exporter string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != "log_entries_dropped" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "exporter" && label.GetValue() == exporter {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func loadConfig(t *testing.T, yml string) *config.ConfigMap {
+	rawMap := map[interface{}]interface{}{}
+	assert.NoError(t, yaml.Unmarshal([]byte(yml), &rawMap))
+	return config.NewConfigMap(rawMap)
+}
+
+func TestNewExporterFromConfig(t *testing.T) {
+	_, err := exporters.NewExporterFromConfig(loadConfig(t, `
+scribe_export_url: "http://localhost:8080"
+scribe_app_id: "app_id"
+scribe_app_secret: "app_secret"
+exporters:
+  local:
+    type: "file"
+    path: "/tmp/entries.jsonl"
+    max_size_mb: 10
+  es:
+    type: "elasticsearch"
+    url: "http://localhost:9200"
+    batch_size: 100
+routes:
+  perfpipe_magma_rest_api_stats: ["es", "local"]
+  default: ["scribe"]
+`))
+	assert.NoError(t, err)
+
+	// Scribe and routes are optional
+	_, err = exporters.NewExporterFromConfig(loadConfig(t, `
+exporters:
+  local:
+    type: "file"
+    path: "/tmp/entries.jsonl"
+`))
+	assert.NoError(t, err)
+
+	testCases := []struct{ yml, expectedErr string }{
+		{`scribe_export_url: "http://localhost:8080"`, "scribe_app_id is required for the Scribe exporter"},
+		{`exporters: ["file"]`, "exporters must be a map of exporter names to exporter configs"},
+		{`exporters: {local: "file"}`, "Config of exporter local must be a map"},
+		{`exporters: {local: {type: "kafka"}}`, "Unknown type 'kafka' of exporter local, expected file or elasticsearch"},
+		{`exporters: {local: {type: "file"}}`, "A path must be configured for file exporter local"},
+		{`exporters: {es: {type: "elasticsearch"}}`, "A URL must be configured for Elasticsearch exporter es"},
+		{`routes: {c1: "scribe"}`, "Route for c1 must be a list of exporter names"},
+		{`routes: {c1: ["scribe"]}`, "Route for c1 refers to unknown exporter scribe"},
+	}
+	for _, tc := range testCases {
+		_, err = exporters.NewExporterFromConfig(loadConfig(t, tc.yml))
+		assert.EqualError(t, err, tc.expectedErr)
+	}
+}
@@ -24,9 +24,7 @@ import (
 )
 
 const (
-	SCRIBE_EXPORTER_EXPORT_INTERVAL = time.Second * 60
-	SCRIBE_EXPORTER_QUEUE_LENGTH    = 100000
-	NGHTTPX_LOG_FILE_PATH           = "/var/log/nghttpx.log"
+	NGHTTPX_LOG_FILE_PATH = "/var/log/nghttpx.log"
 )
 
 var (
@@ -54,20 +52,14 @@ func main() {
 		nghttpxLogger.Run(NGHTTPX_LOG_FILE_PATH)
 	}
 
-	scribeExportURL := srv.Config.GetRequiredStringParam("scribe_export_url")
-	scribeAppID := srv.Config.GetRequiredStringParam("scribe_app_id")
-	scribeAppSecret := srv.Config.GetRequiredStringParam("scribe_app_secret")
-
-	// Initialize exporters
-	scribeExporter := exporters.NewScribeExporter(
-		scribeExportURL,
-		scribeAppID,
-		scribeAppSecret,
-		SCRIBE_EXPORTER_QUEUE_LENGTH,
-		SCRIBE_EXPORTER_EXPORT_INTERVAL,
-	)
+	// Initialize exporters. Clients log to the SCRIBE destination, entries
+	// are then routed to exporters by category.
+	router, err := exporters.NewExporterFromConfig(srv.Config)
+	if err != nil {
+		glog.Fatalf("Error initializing log exporters: %s", err)
+	}
 	logExporters := make(map[protos.LoggerDestination]exporters.Exporter)
-	logExporters[protos.LoggerDestination_SCRIBE] = scribeExporter
+	logExporters[protos.LoggerDestination_SCRIBE] = router
 
 	// Add servicers to the service
 	loggingServ, err := servicers.NewLoggingService(logExporters)
@@ -75,7 +67,7 @@ func main() {
 		glog.Fatalf("LoggingService Initialization Error: %s", err)
 	}
 	// start exporting asynchronously
-	scribeExporter.Start()
+	router.Start()
 
 	protos.RegisterLoggingServiceServer(srv.GrpcServer, loggingServ)
 	srv.GrpcServer.RegisterService(protos.GetLegacyLoggerDesc(), loggingServ)
@@ -16,6 +16,8 @@ import (
 	"magma/orc8r/cloud/go/services/logger/exporters"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type LoggingService struct {
@@ -42,5 +44,9 @@ func (srv *LoggingService) Log(ctx context.Context, request *protos.LogRequest)
 			fmt.Errorf("LoggerDestination %v not supported", request.Destination)
 	}
 	err := exporter.Submit(request.Entries)
+	if err == exporters.ErrQueueFull {
+		// Let clients know to back off and retry
+		return new(protos.Void), status.Error(codes.ResourceExhausted, err.Error())
+	}
 	return new(protos.Void), err
 }
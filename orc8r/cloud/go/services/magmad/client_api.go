@@ -166,6 +166,34 @@ func ListGateways(networkId string) ([]string, error) {
 	return gatewayIds, nil
 }
 
+// ListGatewaysPage lists a page of the network's logical gateway IDs matching
+// the ID prefix in ascending order. It returns the token for the next page,
+// which is empty if there are no more gateways.
+func ListGatewaysPage(networkId string, idPrefix string, pageSize uint32, pageToken string) ([]string, string, error) {
+	md, conn, err := getMagmadClient()
+	if err != nil {
+		return nil, "", err
+	}
+	defer conn.Close()
+	res, err := md.ListGatewaysPage(
+		context.Background(),
+		&mdprotos.ListGatewaysRequest{
+			NetworkId: networkId,
+			IdPrefix:  idPrefix,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	gatewayIds := res.GetGatewayIds()
+	if gatewayIds == nil {
+		gatewayIds = []string{}
+	}
+	return gatewayIds, res.GetNextPageToken(), nil
+}
+
 // FindGatewayId returns logical AG Id for the given registered HW Id
 func FindGatewayId(networkId string, hwId string) (string, error) {
 	md, conn, err := getMagmadClient()
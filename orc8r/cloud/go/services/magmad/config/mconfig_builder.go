@@ -14,9 +14,12 @@ import (
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/protos/mconfig"
+	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/config"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
 	"magma/orc8r/cloud/go/services/upgrade"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -29,6 +32,18 @@ func (builder *MagmadMconfigBuilder) Build(networkId string, gatewayId string) (
 	if err != nil {
 		return nil, err
 	}
+	return buildFromMagmadGatewayConfig(networkId, magmadGatewayConfig)
+}
+
+func (builder *MagmadMconfigBuilder) BuildFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (map[string]proto.Message, error) {
+	magmadGatewayConfig, err := getMagmadGatewayConfigFromGraph(graph, gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	return buildFromMagmadGatewayConfig(network.ID, magmadGatewayConfig)
+}
+
+func buildFromMagmadGatewayConfig(networkId string, magmadGatewayConfig *magmad_protos.MagmadGatewayConfig) (map[string]proto.Message, error) {
 	if magmadGatewayConfig == nil {
 		return map[string]proto.Message{}, nil
 	}
@@ -61,6 +76,22 @@ func getMagmadGatewayConfig(networkId string, logicalId string) (*magmad_protos.
 	if err != nil || iGatewayConfigs == nil {
 		return nil, err
 	}
+	return castMagmadGatewayConfig(iGatewayConfigs)
+}
+
+func getMagmadGatewayConfigFromGraph(graph storage.EntityGraph, logicalId string) (*magmad_protos.MagmadGatewayConfig, error) {
+	gatewayEnt, found := graph.GetEntity(orc8r_storage.TypeAndKey{Type: MagmadGatewayType, Key: logicalId})
+	if !found {
+		return nil, nil
+	}
+	iGatewayConfigs, err := serde.Deserialize(config.SerdeDomain, MagmadGatewayType, gatewayEnt.Config)
+	if err != nil || iGatewayConfigs == nil {
+		return nil, err
+	}
+	return castMagmadGatewayConfig(iGatewayConfigs)
+}
+
+func castMagmadGatewayConfig(iGatewayConfigs interface{}) (*magmad_protos.MagmadGatewayConfig, error) {
 	gatewayConfigs, ok := iGatewayConfigs.(*magmad_protos.MagmadGatewayConfig)
 	if !ok {
 		return nil, fmt.Errorf(
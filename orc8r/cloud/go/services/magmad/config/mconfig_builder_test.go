@@ -15,8 +15,10 @@ import (
 	"magma/orc8r/cloud/go/pluginimpl"
 	"magma/orc8r/cloud/go/protos"
 	mconfig_protos "magma/orc8r/cloud/go/protos/mconfig"
+	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/config"
 	config_test_init "magma/orc8r/cloud/go/services/config/test_init"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
 	magmadprotos "magma/orc8r/cloud/go/services/magmad/protos"
 	"magma/orc8r/cloud/go/services/upgrade"
@@ -104,3 +106,49 @@ func TestMagmadMconfigBuilder_Build(t *testing.T) {
 	}
 	assert.Equal(t, expected, actual)
 }
+
+func TestMagmadMconfigBuilder_BuildFromGraph(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	upgrade_test_init.StartTestService(t)
+
+	builder := &magmad_config.MagmadMconfigBuilder{}
+	network := storage.Network{ID: "network2"}
+	actual, err := builder.BuildFromGraph(network, storage.EntityGraph{}, "gw1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]proto.Message{}, actual)
+
+	gwConfig, err := serde.Serialize(config.SerdeDomain, magmad_config.MagmadGatewayType, &magmadprotos.MagmadGatewayConfig{
+		AutoupgradeEnabled:      true,
+		AutoupgradePollInterval: 300,
+		CheckinInterval:         60,
+		CheckinTimeout:          10,
+		DynamicServices:         []string{},
+		Tier:                    "default",
+	})
+	assert.NoError(t, err)
+	graph := storage.EntityGraph{
+		Entities: []storage.NetworkEntity{
+			{Type: magmad_config.MagmadGatewayType, Key: "gw1", Config: gwConfig},
+		},
+	}
+
+	actual, err = builder.BuildFromGraph(network, graph, "gw1")
+	expected := map[string]proto.Message{
+		"control_proxy": &mconfig_protos.ControlProxy{LogLevel: protos.LogLevel_INFO},
+		"magmad": &mconfig_protos.MagmaD{
+			LogLevel:                protos.LogLevel_INFO,
+			CheckinInterval:         60,
+			CheckinTimeout:          10,
+			AutoupgradeEnabled:      true,
+			AutoupgradePollInterval: 300,
+			PackageVersion:          "0.0.0-0",
+			Images:                  []*mconfig_protos.ImageSpec{},
+			TierId:                  "default",
+			DynamicServices:         []string{},
+			FeatureFlags:            map[string]bool{},
+		},
+		"metricsd": &mconfig_protos.MetricsD{LogLevel: protos.LogLevel_INFO},
+	}
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
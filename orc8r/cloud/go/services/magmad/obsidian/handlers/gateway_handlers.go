@@ -13,7 +13,6 @@ import (
 	"io"
 	"net/http"
 	"regexp"
-	"sort"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/obsidian/handlers"
@@ -54,13 +53,21 @@ func listGateways(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	gatewayIds, err := magmad.ListGateways(networkId)
+	pagination, httpErr := handlers.GetPaginationParams(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	// IDs are returned in ascending order
+	gatewayIds, nextPageToken, err := magmad.ListGatewaysPage(
+		networkId,
+		pagination.IDPrefix,
+		pagination.PageSize,
+		pagination.PageToken,
+	)
 	if err != nil {
 		return handlers.HttpError(err, http.StatusInternalServerError)
 	}
-
-	// Return a deterministic ordering of IDs
-	sort.Strings(gatewayIds)
+	handlers.SetNextPageToken(c, nextPageToken)
 	return c.JSON(http.StatusOK, gatewayIds)
 }
 
@@ -12,9 +12,11 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 
 	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/magmad"
 	"magma/orc8r/cloud/go/services/magmad/obsidian/handlers/view_factory"
 	"magma/orc8r/cloud/go/services/magmad/obsidian/models"
 
@@ -29,6 +31,29 @@ func ListFullGatewayViews(c echo.Context, factory view_factory.FullGatewayViewFa
 		return httpErr
 	}
 	gatewayIDs := getGatewayIDs(c.QueryParams())
+	if len(gatewayIDs) == 0 {
+		pagination, httpErr := handlers.GetPaginationParams(c)
+		if httpErr != nil {
+			return httpErr
+		}
+		if pagination.PageSize > 0 || pagination.IDPrefix != "" {
+			var nextPageToken string
+			var err error
+			gatewayIDs, nextPageToken, err = magmad.ListGatewaysPage(
+				networkID,
+				pagination.IDPrefix,
+				pagination.PageSize,
+				pagination.PageToken,
+			)
+			if err != nil {
+				return handlers.HttpError(err, http.StatusInternalServerError)
+			}
+			if len(gatewayIDs) == 0 {
+				return c.JSON(http.StatusOK, []*models.GatewayStateType{})
+			}
+			handlers.SetNextPageToken(c, nextPageToken)
+		}
+	}
 	gatewayStates, err := getGatewayStates(networkID, gatewayIDs, factory)
 	if err != nil {
 		return handlers.HttpError(err, http.StatusInternalServerError)
@@ -37,6 +62,8 @@ func ListFullGatewayViews(c echo.Context, factory view_factory.FullGatewayViewFa
 	if err != nil {
 		return handlers.HttpError(err, http.StatusInternalServerError)
 	}
+	// Keep the order of pages stable
+	sort.Slice(modelStates, func(i, j int) bool { return modelStates[i].GatewayID < modelStates[j].GatewayID })
 	return c.JSON(http.StatusOK, modelStates)
 }
 
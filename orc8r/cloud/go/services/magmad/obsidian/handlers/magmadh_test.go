@@ -11,8 +11,12 @@ package handlers_test
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 
+	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/obsidian/tests"
 	"magma/orc8r/cloud/go/plugin"
@@ -225,6 +229,32 @@ func TestMagmad(t *testing.T) {
 			listAGsTestCase.Name, r, exp1, exp2)
 	}
 
+	// Test Listing AGs One Page At A Time
+	listURL := fmt.Sprintf("%s/%s/gateways", testUrlRoot, networkId)
+	body, nextPageToken := listPage(t, listURL+"?page_size=1")
+	assert.Equal(t, `["TestAGHwId00002"]`, body)
+	assert.NotEmpty(t, nextPageToken)
+	body, nextPageToken = listPage(t, fmt.Sprintf("%s?page_size=1&page_token=%s", listURL, nextPageToken))
+	assert.Equal(t, fmt.Sprintf(`["%s"]`, requestedAGId), body)
+	assert.Empty(t, nextPageToken)
+	body, nextPageToken = listPage(t, listURL+"?id_prefix=my_")
+	assert.Equal(t, fmt.Sprintf(`["%s"]`, requestedAGId), body)
+	assert.Empty(t, nextPageToken)
+	tests.RunTest(t, tests.Testcase{
+		Name:                      "List AGs With Invalid Page Token",
+		Method:                    "GET",
+		Url:                       listURL + "?page_token=%3F",
+		Skip_payload_verification: true,
+		Expect_http_error_status:  true,
+	})
+	tests.RunTest(t, tests.Testcase{
+		Name:                      "List AGs With Invalid Page Size",
+		Method:                    "GET",
+		Url:                       listURL + "?page_size=0",
+		Skip_payload_verification: true,
+		Expect_http_error_status:  true,
+	})
+
 	// Test Removal Of Non Empty Network
 	removeNetworkTestCase = tests.Testcase{
 		Name:                      "Remove Non Empty Network",
@@ -436,3 +466,18 @@ func newDefaultGatewayConfig() *protos.MagmadGatewayConfig {
 		DynamicServices:         []string{},
 	}
 }
+
+// listPage returns the body and the next page token of a paginated list
+// request
+func listPage(t *testing.T, url string) (string, string) {
+	req, err := http.NewRequest("GET", url, nil)
+	assert.NoError(t, err)
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, tests.TestOperatorSerialNumber)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return strings.TrimSpace(string(body)), resp.Header.Get(handlers.NextPageTokenHeader)
+}
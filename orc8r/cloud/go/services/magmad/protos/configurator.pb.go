@@ -49,7 +49,7 @@ func (m *MagmadGatewayConfig) Reset()         { *m = MagmadGatewayConfig{} }
 func (m *MagmadGatewayConfig) String() string { return proto.CompactTextString(m) }
 func (*MagmadGatewayConfig) ProtoMessage()    {}
 func (*MagmadGatewayConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{0}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{0}
 }
 func (m *MagmadGatewayConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MagmadGatewayConfig.Unmarshal(m, b)
@@ -130,7 +130,7 @@ func (m *MagmadNetworkRecord) Reset()         { *m = MagmadNetworkRecord{} }
 func (m *MagmadNetworkRecord) String() string { return proto.CompactTextString(m) }
 func (*MagmadNetworkRecord) ProtoMessage()    {}
 func (*MagmadNetworkRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{1}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{1}
 }
 func (m *MagmadNetworkRecord) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MagmadNetworkRecord.Unmarshal(m, b)
@@ -169,7 +169,7 @@ func (m *NetworkRecordRequest) Reset()         { *m = NetworkRecordRequest{} }
 func (m *NetworkRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkRecordRequest) ProtoMessage()    {}
 func (*NetworkRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{2}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{2}
 }
 func (m *NetworkRecordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkRecordRequest.Unmarshal(m, b)
@@ -219,7 +219,7 @@ func (m *AccessGatewayRecord) Reset()         { *m = AccessGatewayRecord{} }
 func (m *AccessGatewayRecord) String() string { return proto.CompactTextString(m) }
 func (*AccessGatewayRecord) ProtoMessage()    {}
 func (*AccessGatewayRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{3}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{3}
 }
 func (m *AccessGatewayRecord) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessGatewayRecord.Unmarshal(m, b)
@@ -286,7 +286,7 @@ func (m *GatewayRecordRequest) Reset()         { *m = GatewayRecordRequest{} }
 func (m *GatewayRecordRequest) String() string { return proto.CompactTextString(m) }
 func (*GatewayRecordRequest) ProtoMessage()    {}
 func (*GatewayRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{4}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{4}
 }
 func (m *GatewayRecordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayRecordRequest.Unmarshal(m, b)
@@ -331,7 +331,7 @@ func (m *MarshaledConfigs) Reset()         { *m = MarshaledConfigs{} }
 func (m *MarshaledConfigs) String() string { return proto.CompactTextString(m) }
 func (*MarshaledConfigs) ProtoMessage()    {}
 func (*MarshaledConfigs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{5}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{5}
 }
 func (m *MarshaledConfigs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarshaledConfigs.Unmarshal(m, b)
@@ -370,7 +370,7 @@ func (m *GatewayConfigsRequestKey) Reset()         { *m = GatewayConfigsRequestK
 func (m *GatewayConfigsRequestKey) String() string { return proto.CompactTextString(m) }
 func (*GatewayConfigsRequestKey) ProtoMessage()    {}
 func (*GatewayConfigsRequestKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{6}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{6}
 }
 func (m *GatewayConfigsRequestKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayConfigsRequestKey.Unmarshal(m, b)
@@ -416,7 +416,7 @@ func (m *GatewayConfigsRequest) Reset()         { *m = GatewayConfigsRequest{} }
 func (m *GatewayConfigsRequest) String() string { return proto.CompactTextString(m) }
 func (*GatewayConfigsRequest) ProtoMessage()    {}
 func (*GatewayConfigsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{7}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{7}
 }
 func (m *GatewayConfigsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayConfigsRequest.Unmarshal(m, b)
@@ -462,7 +462,7 @@ func (m *NetworkConfigRequestKey) Reset()         { *m = NetworkConfigRequestKey
 func (m *NetworkConfigRequestKey) String() string { return proto.CompactTextString(m) }
 func (*NetworkConfigRequestKey) ProtoMessage()    {}
 func (*NetworkConfigRequestKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{8}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{8}
 }
 func (m *NetworkConfigRequestKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkConfigRequestKey.Unmarshal(m, b)
@@ -508,7 +508,7 @@ func (m *NetworkConfigRequest) Reset()         { *m = NetworkConfigRequest{} }
 func (m *NetworkConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkConfigRequest) ProtoMessage()    {}
 func (*NetworkConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configurator_2f0639bba139eac1, []int{9}
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{9}
 }
 func (m *NetworkConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkConfigRequest.Unmarshal(m, b)
@@ -542,6 +542,118 @@ func (m *NetworkConfigRequest) GetConfigs() *MarshaledConfigs {
 	return nil
 }
 
+type ListGatewaysRequest struct {
+	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Only list gateways whose logical IDs start with the prefix
+	IdPrefix string `protobuf:"bytes,2,opt,name=id_prefix,json=idPrefix,proto3" json:"id_prefix,omitempty"`
+	// Maximum number of gateways to return, 0 to return all of them
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token returned with the previous page, empty for the first page
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGatewaysRequest) Reset()         { *m = ListGatewaysRequest{} }
+func (m *ListGatewaysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGatewaysRequest) ProtoMessage()    {}
+func (*ListGatewaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{10}
+}
+func (m *ListGatewaysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGatewaysRequest.Unmarshal(m, b)
+}
+func (m *ListGatewaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGatewaysRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListGatewaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGatewaysRequest.Merge(dst, src)
+}
+func (m *ListGatewaysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGatewaysRequest.Size(m)
+}
+func (m *ListGatewaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGatewaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGatewaysRequest proto.InternalMessageInfo
+
+func (m *ListGatewaysRequest) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+func (m *ListGatewaysRequest) GetIdPrefix() string {
+	if m != nil {
+		return m.IdPrefix
+	}
+	return ""
+}
+
+func (m *ListGatewaysRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListGatewaysRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListGatewaysResponse struct {
+	GatewayIds []string `protobuf:"bytes,1,rep,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Token to request the next page with, empty on the last page
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGatewaysResponse) Reset()         { *m = ListGatewaysResponse{} }
+func (m *ListGatewaysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGatewaysResponse) ProtoMessage()    {}
+func (*ListGatewaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configurator_a4ab020171a6036f, []int{11}
+}
+func (m *ListGatewaysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGatewaysResponse.Unmarshal(m, b)
+}
+func (m *ListGatewaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGatewaysResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListGatewaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGatewaysResponse.Merge(dst, src)
+}
+func (m *ListGatewaysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGatewaysResponse.Size(m)
+}
+func (m *ListGatewaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGatewaysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGatewaysResponse proto.InternalMessageInfo
+
+func (m *ListGatewaysResponse) GetGatewayIds() []string {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+func (m *ListGatewaysResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MagmadGatewayConfig)(nil), "magma.orc8r.magmad.MagmadGatewayConfig")
 	proto.RegisterMapType((map[string]bool)(nil), "magma.orc8r.magmad.MagmadGatewayConfig.FeatureFlagsEntry")
@@ -554,6 +666,8 @@ func init() {
 	proto.RegisterType((*GatewayConfigsRequest)(nil), "magma.orc8r.magmad.GatewayConfigsRequest")
 	proto.RegisterType((*NetworkConfigRequestKey)(nil), "magma.orc8r.magmad.NetworkConfigRequestKey")
 	proto.RegisterType((*NetworkConfigRequest)(nil), "magma.orc8r.magmad.NetworkConfigRequest")
+	proto.RegisterType((*ListGatewaysRequest)(nil), "magma.orc8r.magmad.ListGatewaysRequest")
+	proto.RegisterType((*ListGatewaysResponse)(nil), "magma.orc8r.magmad.ListGatewaysResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -580,6 +694,9 @@ type MagmadConfiguratorClient interface {
 	// Lists all registered logical device Identities for the network specified
 	// by Identity parameter
 	ListGateways(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*protos.Identity_List, error)
+	// ListGatewaysPage lists the logical IDs of the network's gateways in
+	// ascending order, one page at a time
+	ListGatewaysPage(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*ListGatewaysResponse, error)
 	// FindGatewayId returns AG Identity with set logical_id
 	// (Identity.Gateway.logical_id) for the given
 	// registered HW Id (Identity.Gateway.hardware_id) on the network
@@ -642,6 +759,15 @@ func (c *magmadConfiguratorClient) ListGateways(ctx context.Context, in *protos.
 	return out, nil
 }
 
+func (c *magmadConfiguratorClient) ListGatewaysPage(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*ListGatewaysResponse, error) {
+	out := new(ListGatewaysResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.magmad.MagmadConfigurator/ListGatewaysPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *magmadConfiguratorClient) FindGatewayId(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*protos.Identity, error) {
 	out := new(protos.Identity)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.magmad.MagmadConfigurator/FindGatewayId", in, out, opts...)
@@ -755,6 +881,9 @@ type MagmadConfiguratorServer interface {
 	// Lists all registered logical device Identities for the network specified
 	// by Identity parameter
 	ListGateways(context.Context, *protos.Identity) (*protos.Identity_List, error)
+	// ListGatewaysPage lists the logical IDs of the network's gateways in
+	// ascending order, one page at a time
+	ListGatewaysPage(context.Context, *ListGatewaysRequest) (*ListGatewaysResponse, error)
 	// FindGatewayId returns AG Identity with set logical_id
 	// (Identity.Gateway.logical_id) for the given
 	// registered HW Id (Identity.Gateway.hardware_id) on the network
@@ -831,6 +960,24 @@ func _MagmadConfigurator_ListGateways_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MagmadConfigurator_ListGatewaysPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MagmadConfiguratorServer).ListGatewaysPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.magmad.MagmadConfigurator/ListGatewaysPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MagmadConfiguratorServer).ListGatewaysPage(ctx, req.(*ListGatewaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MagmadConfigurator_FindGatewayId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(protos.Identity)
 	if err := dec(in); err != nil {
@@ -1041,6 +1188,10 @@ var _MagmadConfigurator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListGateways",
 			Handler:    _MagmadConfigurator_ListGateways_Handler,
 		},
+		{
+			MethodName: "ListGatewaysPage",
+			Handler:    _MagmadConfigurator_ListGatewaysPage_Handler,
+		},
 		{
 			MethodName: "FindGatewayId",
 			Handler:    _MagmadConfigurator_FindGatewayId_Handler,
@@ -1090,61 +1241,69 @@ var _MagmadConfigurator_serviceDesc = grpc.ServiceDesc{
 	Metadata: "configurator.proto",
 }
 
-func init() { proto.RegisterFile("configurator.proto", fileDescriptor_configurator_2f0639bba139eac1) }
-
-var fileDescriptor_configurator_2f0639bba139eac1 = []byte{
-	// 845 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xdb, 0x36,
-	0x14, 0xc7, 0x21, 0x3b, 0x4e, 0xe2, 0x97, 0xa4, 0x49, 0x98, 0x14, 0x75, 0xbc, 0x02, 0x33, 0x8c,
-	0x01, 0x75, 0xd1, 0xc2, 0xc1, 0xdc, 0x4b, 0x5b, 0x20, 0x29, 0xba, 0xb4, 0x29, 0x8c, 0xa4, 0xc5,
-	0xc0, 0x6e, 0x3b, 0xec, 0x30, 0x83, 0x91, 0x5e, 0x6c, 0x21, 0x92, 0xa8, 0x91, 0x74, 0x0c, 0xfd,
-	0x0f, 0xbb, 0x0e, 0xfb, 0x2b, 0x76, 0xdd, 0x71, 0x7f, 0xdb, 0x60, 0x92, 0xb2, 0x45, 0x44, 0x6a,
-	0x9a, 0x60, 0x27, 0x53, 0x8f, 0xef, 0xc7, 0xf7, 0x7d, 0x1e, 0x29, 0x19, 0x88, 0xcf, 0x93, 0xcb,
-	0x70, 0x3c, 0x15, 0x4c, 0x71, 0xd1, 0x4f, 0x05, 0x57, 0x9c, 0x90, 0x98, 0x8d, 0x63, 0xd6, 0xe7,
-	0xc2, 0x7f, 0x29, 0xfa, 0x7a, 0x1d, 0xb4, 0xbf, 0xd5, 0x4f, 0x87, 0xda, 0x41, 0x1e, 0x5e, 0x70,
-	0xae, 0xa4, 0x12, 0x2c, 0x4d, 0xd1, 0x06, 0xb5, 0x0f, 0x1c, 0x07, 0x9f, 0xc7, 0x31, 0x4f, 0xec,
-	0xd6, 0x37, 0xce, 0x56, 0x18, 0x60, 0xa2, 0x42, 0x95, 0x99, 0xcd, 0xee, 0x3f, 0x75, 0xd8, 0xfb,
-	0xa8, 0x6b, 0x7c, 0x60, 0x0a, 0x67, 0x2c, 0x3b, 0xd1, 0x82, 0xc8, 0x53, 0xd8, 0xf1, 0x27, 0xe8,
-	0x5f, 0x85, 0xc9, 0x28, 0x4c, 0x14, 0x8a, 0x6b, 0x16, 0xb5, 0xbc, 0x8e, 0xd7, 0x6b, 0xd0, 0x6d,
-	0x6b, 0x1f, 0x5a, 0x33, 0x79, 0x02, 0xb9, 0x69, 0xa4, 0xc2, 0x18, 0xf9, 0x54, 0xb5, 0x6a, 0xda,
-	0xf3, 0x81, 0x35, 0xff, 0x64, 0xac, 0xe4, 0x10, 0xf6, 0xd8, 0x54, 0xf1, 0x69, 0x3a, 0x16, 0x2c,
-	0xc0, 0x11, 0x26, 0xec, 0x22, 0xc2, 0xa0, 0x55, 0xef, 0x78, 0xbd, 0x75, 0x4a, 0x0a, 0x5b, 0xef,
-	0xcd, 0x0e, 0x79, 0x0d, 0x07, 0xc5, 0x80, 0x94, 0x47, 0xd1, 0x52, 0xcd, 0x8a, 0xae, 0xf1, 0xa8,
-	0xe0, 0xf0, 0x23, 0x8f, 0xa2, 0x85, 0x2a, 0x02, 0x2b, 0x2a, 0x44, 0xd1, 0x6a, 0x74, 0xbc, 0x5e,
-	0x93, 0xea, 0x35, 0xf9, 0x0d, 0xb6, 0x2e, 0x91, 0xa9, 0xa9, 0xc0, 0xd1, 0x65, 0xc4, 0xc6, 0xb2,
-	0xb5, 0xda, 0xa9, 0xf7, 0x36, 0x06, 0xaf, 0xfa, 0x37, 0x89, 0xf7, 0x4b, 0xa0, 0xf4, 0x4f, 0x4d,
-	0xf0, 0xe9, 0x3c, 0xf6, 0x7d, 0xa2, 0x44, 0x46, 0x37, 0x2f, 0x0b, 0xa6, 0x39, 0xb4, 0x20, 0x4b,
-	0x58, 0x1c, 0xfa, 0x23, 0x89, 0xe2, 0x3a, 0xf4, 0x51, 0xb6, 0xd6, 0x3a, 0xf5, 0x5e, 0x93, 0x6e,
-	0x5b, 0xfb, 0x67, 0x6b, 0x6e, 0xbf, 0x81, 0xdd, 0x1b, 0xd9, 0xc8, 0x0e, 0xd4, 0xaf, 0x30, 0xd3,
-	0x9c, 0x9b, 0x74, 0xbe, 0x24, 0xfb, 0xd0, 0xb8, 0x66, 0xd1, 0x14, 0x35, 0xd1, 0x75, 0x6a, 0x1e,
-	0x5e, 0xd7, 0x5e, 0x7a, 0xdd, 0xa7, 0xf9, 0xdc, 0x3e, 0xa1, 0x9a, 0x71, 0x71, 0x45, 0xd1, 0xe7,
-	0x22, 0x98, 0xb7, 0x9d, 0xb0, 0x18, 0x6d, 0x0e, 0xbd, 0xee, 0x8e, 0x61, 0xdf, 0x71, 0xa2, 0xf8,
-	0xfb, 0x14, 0xa5, 0x22, 0x6f, 0x60, 0x55, 0x68, 0x83, 0xf6, 0xde, 0x18, 0x3c, 0xa9, 0xe6, 0xe0,
-	0xc6, 0xdb, 0x30, 0xf2, 0x00, 0x6a, 0x61, 0xa0, 0xa5, 0x35, 0x69, 0x2d, 0x0c, 0xba, 0x7f, 0x7b,
-	0xb0, 0xf7, 0xd6, 0xf7, 0x51, 0x4a, 0xcb, 0xcd, 0x8a, 0xfa, 0x1e, 0x1a, 0x93, 0xd9, 0x28, 0xcc,
-	0xeb, 0x3c, 0x76, 0xea, 0x38, 0x01, 0xc3, 0x77, 0x74, 0x65, 0x32, 0x1b, 0x2e, 0xfb, 0x58, 0x59,
-	0xf6, 0x41, 0x9e, 0x19, 0x3c, 0x0d, 0x9d, 0xe4, 0xc0, 0x49, 0x72, 0x32, 0x61, 0x51, 0x84, 0xc9,
-	0x18, 0xcf, 0x30, 0x33, 0xe4, 0xe6, 0xda, 0xd2, 0x85, 0xb6, 0x74, 0x9e, 0x30, 0xe5, 0x42, 0xe9,
-	0xd3, 0xd6, 0xa0, 0x7a, 0xdd, 0xfd, 0xc3, 0x83, 0x7d, 0x47, 0x69, 0x4e, 0xe6, 0x05, 0x34, 0xc7,
-	0x56, 0x50, 0x2e, 0xfa, 0xa1, 0x53, 0x6f, 0x68, 0x6f, 0x11, 0x5d, 0xfa, 0x15, 0x70, 0xd6, 0xaa,
-	0x71, 0x96, 0xe0, 0xc9, 0x71, 0x76, 0x9f, 0xc3, 0xce, 0x47, 0x26, 0xe4, 0x84, 0x45, 0x18, 0x98,
-	0x13, 0x27, 0x49, 0x0b, 0xd6, 0xcc, 0x2b, 0x42, 0xea, 0xac, 0x9b, 0x34, 0x7f, 0xec, 0xc6, 0xd0,
-	0x72, 0x4e, 0xa7, 0xb4, 0xe2, 0xcf, 0x30, 0xbb, 0x9f, 0xfe, 0xc7, 0xd0, 0x34, 0xb9, 0xcf, 0x30,
-	0xb3, 0xe0, 0x96, 0x86, 0xee, 0x5f, 0x1e, 0x3c, 0x2c, 0xad, 0x47, 0x8e, 0x97, 0xa7, 0x76, 0x63,
-	0xf0, 0xbc, 0xac, 0xe9, 0x2a, 0x9d, 0x66, 0x52, 0xc7, 0x6e, 0x8b, 0x1b, 0x83, 0xef, 0xca, 0xcf,
-	0xa1, 0x4b, 0x66, 0x09, 0x22, 0x82, 0x47, 0xf6, 0x78, 0x9a, 0x2d, 0x97, 0x43, 0x62, 0xb6, 0x6e,
-	0xe5, 0xb0, 0xf0, 0xbb, 0x85, 0xc3, 0x9f, 0xde, 0xe2, 0x36, 0x39, 0xe5, 0xc8, 0x51, 0x11, 0xc3,
-	0xb3, 0xb2, 0x16, 0x2a, 0x54, 0xfe, 0x2f, 0x14, 0x06, 0xff, 0xae, 0x01, 0x31, 0x77, 0xf5, 0xa4,
-	0xf0, 0x49, 0x21, 0x14, 0xb6, 0x29, 0x8e, 0x43, 0xa9, 0x50, 0xd8, 0x29, 0x90, 0xde, 0x17, 0x46,
-	0xe4, 0x5c, 0x83, 0x76, 0x39, 0x2b, 0xf2, 0x16, 0x36, 0xcf, 0x43, 0xa9, 0x6c, 0x88, 0x24, 0xe5,
-	0x6e, 0xed, 0x76, 0xa9, 0xb9, 0x3f, 0x0f, 0x25, 0x47, 0xb0, 0x75, 0x1a, 0x26, 0xf9, 0xeb, 0x75,
-	0x18, 0x54, 0xe5, 0xa8, 0x50, 0xf0, 0x19, 0x76, 0x0b, 0xe1, 0xf6, 0x2d, 0x53, 0x91, 0xe2, 0x6b,
-	0xaf, 0x21, 0xa1, 0xb0, 0xf7, 0x73, 0x1a, 0x30, 0x85, 0xae, 0xf9, 0xeb, 0x71, 0xed, 0x3a, 0x9e,
-	0xbf, 0xf0, 0x30, 0x20, 0xef, 0x60, 0xbf, 0x20, 0xf4, 0xd3, 0xe2, 0x8c, 0xdd, 0xad, 0xdd, 0x57,
-	0xb0, 0x45, 0x31, 0xe6, 0xd7, 0xb9, 0xb2, 0xaa, 0xf0, 0x12, 0x01, 0x85, 0xf9, 0xdb, 0xea, 0xe5,
-	0x0d, 0x95, 0x7d, 0x20, 0xaa, 0xe4, 0x1c, 0x99, 0xf9, 0xdb, 0x10, 0x49, 0x6e, 0x96, 0xfd, 0xe2,
-	0xec, 0xcf, 0x01, 0x3e, 0x60, 0x1e, 0x7d, 0xa7, 0xa9, 0x95, 0x7d, 0xf0, 0x16, 0x6c, 0x6e, 0x49,
-	0x58, 0xc2, 0xe6, 0x1c, 0xb6, 0xcc, 0xc0, 0xef, 0x4e, 0xa6, 0x24, 0xdb, 0x31, 0x90, 0x53, 0x2e,
-	0x7c, 0xbc, 0xa7, 0x9a, 0x1f, 0xd6, 0x7f, 0x5d, 0x35, 0x7f, 0xd1, 0x2e, 0xcc, 0xef, 0x8b, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xc3, 0x5e, 0xb1, 0x1d, 0x0a, 0x00, 0x00,
+func init() { proto.RegisterFile("configurator.proto", fileDescriptor_configurator_a4ab020171a6036f) }
+
+var fileDescriptor_configurator_a4ab020171a6036f = []byte{
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xd7, 0x26, 0x71, 0xb0, 0x9f, 0x63, 0x92, 0x8c, 0x8d, 0x70, 0x0c, 0x15, 0x96, 0x55, 0x15,
+	0x23, 0x90, 0xa3, 0x9a, 0x0b, 0x20, 0x25, 0x88, 0x06, 0x82, 0xac, 0x04, 0x14, 0x6d, 0x68, 0x0f,
+	0x3d, 0x74, 0x35, 0xd9, 0x7d, 0xb6, 0x47, 0x59, 0xef, 0x6c, 0x67, 0xc6, 0x09, 0xe6, 0x33, 0xb4,
+	0xc7, 0xaa, 0x9f, 0xa0, 0xc7, 0x5e, 0xfb, 0xf9, 0xaa, 0x9d, 0x99, 0xb5, 0x77, 0x95, 0x35, 0x21,
+	0x88, 0x93, 0x67, 0x7e, 0xef, 0xdf, 0xef, 0xfd, 0xe6, 0xcd, 0xac, 0x81, 0xf8, 0x3c, 0x1a, 0xb2,
+	0xd1, 0x54, 0x50, 0xc5, 0x45, 0x2f, 0x16, 0x5c, 0x71, 0x42, 0x26, 0x74, 0x34, 0xa1, 0x3d, 0x2e,
+	0xfc, 0x67, 0xa2, 0xa7, 0xd7, 0x41, 0xeb, 0x81, 0xde, 0xed, 0x6a, 0x07, 0xb9, 0x7b, 0xc6, 0xb9,
+	0x92, 0x4a, 0xd0, 0x38, 0x46, 0x1b, 0xd4, 0xda, 0xc9, 0x39, 0xf8, 0x7c, 0x32, 0xe1, 0x91, 0x35,
+	0xdd, 0xcb, 0x99, 0x58, 0x80, 0x91, 0x62, 0x6a, 0x66, 0x8c, 0x9d, 0xff, 0x56, 0xa1, 0xfe, 0x4e,
+	0xd7, 0x78, 0x4b, 0x15, 0x5e, 0xd2, 0xd9, 0x81, 0x26, 0x44, 0x1e, 0xc1, 0x96, 0x3f, 0x46, 0xff,
+	0x9c, 0x45, 0x1e, 0x8b, 0x14, 0x8a, 0x0b, 0x1a, 0x36, 0x9d, 0xb6, 0xd3, 0x2d, 0xb9, 0x9b, 0x16,
+	0x1f, 0x58, 0x98, 0x3c, 0x84, 0x14, 0xf2, 0x14, 0x9b, 0x20, 0x9f, 0xaa, 0xe6, 0x8a, 0xf6, 0xbc,
+	0x6d, 0xe1, 0x0f, 0x06, 0x25, 0xbb, 0x50, 0xa7, 0x53, 0xc5, 0xa7, 0xf1, 0x48, 0xd0, 0x00, 0x3d,
+	0x8c, 0xe8, 0x59, 0x88, 0x41, 0x73, 0xb5, 0xed, 0x74, 0xcb, 0x2e, 0xc9, 0x98, 0xde, 0x18, 0x0b,
+	0x79, 0x01, 0x3b, 0xd9, 0x80, 0x98, 0x87, 0xe1, 0x82, 0xcd, 0x9a, 0xae, 0x71, 0x37, 0xe3, 0x70,
+	0xc2, 0xc3, 0x70, 0xce, 0x8a, 0xc0, 0x9a, 0x62, 0x28, 0x9a, 0xa5, 0xb6, 0xd3, 0xad, 0xb8, 0x7a,
+	0x4d, 0x7e, 0x83, 0xda, 0x10, 0xa9, 0x9a, 0x0a, 0xf4, 0x86, 0x21, 0x1d, 0xc9, 0xe6, 0x7a, 0x7b,
+	0xb5, 0x5b, 0xed, 0x3f, 0xef, 0x5d, 0x55, 0xbc, 0x57, 0x20, 0x4a, 0xef, 0xd0, 0x04, 0x1f, 0x26,
+	0xb1, 0x6f, 0x22, 0x25, 0x66, 0xee, 0xc6, 0x30, 0x03, 0x25, 0xa2, 0x05, 0xb3, 0x88, 0x4e, 0x98,
+	0xef, 0x49, 0x14, 0x17, 0xcc, 0x47, 0xd9, 0xbc, 0xd5, 0x5e, 0xed, 0x56, 0xdc, 0x4d, 0x8b, 0x9f,
+	0x5a, 0xb8, 0xf5, 0x12, 0xb6, 0xaf, 0x64, 0x23, 0x5b, 0xb0, 0x7a, 0x8e, 0x33, 0xad, 0x73, 0xc5,
+	0x4d, 0x96, 0xa4, 0x01, 0xa5, 0x0b, 0x1a, 0x4e, 0x51, 0x2b, 0x5a, 0x76, 0xcd, 0xe6, 0xc5, 0xca,
+	0x33, 0xa7, 0xf3, 0x28, 0x3d, 0xb7, 0xf7, 0xa8, 0x2e, 0xb9, 0x38, 0x77, 0xd1, 0xe7, 0x22, 0x48,
+	0xda, 0x8e, 0xe8, 0x04, 0x6d, 0x0e, 0xbd, 0xee, 0x8c, 0xa0, 0x91, 0x73, 0x72, 0xf1, 0xf7, 0x29,
+	0x4a, 0x45, 0x5e, 0xc2, 0xba, 0xd0, 0x80, 0xf6, 0xae, 0xf6, 0x1f, 0x2e, 0xd7, 0x21, 0x1f, 0x6f,
+	0xc3, 0xc8, 0x6d, 0x58, 0x61, 0x81, 0xa6, 0x56, 0x71, 0x57, 0x58, 0xd0, 0xf9, 0xd7, 0x81, 0xfa,
+	0x2b, 0xdf, 0x47, 0x29, 0xad, 0x6e, 0x96, 0xd4, 0x8f, 0x50, 0x1a, 0x5f, 0x7a, 0x2c, 0xad, 0x73,
+	0x3f, 0x57, 0x27, 0x17, 0x30, 0x78, 0xed, 0xae, 0x8d, 0x2f, 0x07, 0x8b, 0x3e, 0xd6, 0x16, 0x7d,
+	0x90, 0xc7, 0x46, 0x9e, 0x92, 0x4e, 0xb2, 0x93, 0x4b, 0x72, 0x30, 0xa6, 0x61, 0x88, 0xd1, 0x08,
+	0x8f, 0x70, 0x66, 0x94, 0x4b, 0xb8, 0xc5, 0x73, 0x6e, 0x71, 0x92, 0x30, 0xe6, 0x42, 0xe9, 0x69,
+	0x2b, 0xb9, 0x7a, 0xdd, 0xf9, 0xc3, 0x81, 0x46, 0x8e, 0x69, 0xaa, 0xcc, 0x53, 0xa8, 0x8c, 0x2c,
+	0xa1, 0x94, 0xf4, 0x9d, 0x5c, 0xbd, 0x81, 0xbd, 0x45, 0xee, 0xc2, 0x2f, 0x23, 0xe7, 0xca, 0x72,
+	0x39, 0x0b, 0xe4, 0x49, 0xe5, 0xec, 0x3c, 0x81, 0xad, 0x77, 0x54, 0xc8, 0x31, 0x0d, 0x31, 0x30,
+	0x13, 0x27, 0x49, 0x13, 0x6e, 0x99, 0x27, 0x42, 0xea, 0xac, 0x1b, 0x6e, 0xba, 0xed, 0x4c, 0xa0,
+	0x99, 0x9b, 0x4e, 0x69, 0xc9, 0x1f, 0xe1, 0xec, 0xeb, 0xf8, 0xdf, 0x87, 0x8a, 0xc9, 0x7d, 0x84,
+	0x33, 0x2b, 0xdc, 0x02, 0xe8, 0xfc, 0xed, 0xc0, 0x9d, 0xc2, 0x7a, 0x64, 0x7f, 0x31, 0xb5, 0xd5,
+	0xfe, 0x93, 0xa2, 0xa6, 0x97, 0xf1, 0x34, 0x27, 0xb5, 0x9f, 0x6f, 0xb1, 0xda, 0xff, 0xbe, 0x78,
+	0x0e, 0xf3, 0xca, 0x2c, 0x84, 0x08, 0xe1, 0xae, 0x1d, 0x4f, 0x63, 0xca, 0xeb, 0x10, 0x19, 0xd3,
+	0xb5, 0x3a, 0xcc, 0xfd, 0xae, 0xd1, 0xe1, 0x2f, 0x07, 0x1a, 0x45, 0xe5, 0xc8, 0x5e, 0x56, 0x86,
+	0xc7, 0x45, 0x2d, 0x2c, 0x61, 0xf9, 0x6d, 0x54, 0xf8, 0xd3, 0x81, 0xfa, 0x31, 0x93, 0xca, 0x6a,
+	0x3d, 0x3f, 0x9d, 0xef, 0x00, 0x6c, 0x6b, 0xe9, 0x05, 0xac, 0x64, 0x9b, 0xbd, 0x07, 0x15, 0x16,
+	0x78, 0xb1, 0xc0, 0x21, 0xfb, 0x68, 0x9b, 0x2d, 0xb3, 0xe0, 0x44, 0xef, 0x13, 0x63, 0x4c, 0x47,
+	0xe8, 0x49, 0xf6, 0x09, 0xf5, 0xc5, 0xa9, 0xb9, 0xe5, 0x04, 0x38, 0x65, 0x9f, 0x30, 0x49, 0xac,
+	0x8d, 0x8a, 0x9f, 0x63, 0x64, 0xef, 0xa9, 0x76, 0xff, 0x90, 0x00, 0x1d, 0x0f, 0x1a, 0x79, 0x3a,
+	0x32, 0xe6, 0x91, 0x44, 0xf2, 0x00, 0xaa, 0x76, 0xe4, 0x3c, 0x16, 0xc8, 0xa6, 0xa3, 0x9f, 0x47,
+	0x98, 0x4f, 0xa1, 0x24, 0x3f, 0xc0, 0x66, 0x84, 0x1f, 0x95, 0x97, 0x49, 0x6e, 0x78, 0xd5, 0x12,
+	0xf8, 0x24, 0x2d, 0xd0, 0xff, 0xa7, 0x0c, 0xc4, 0x3c, 0x4e, 0x07, 0x99, 0x6f, 0x28, 0x71, 0x61,
+	0xd3, 0xc5, 0x11, 0x93, 0x0a, 0x85, 0xad, 0x4d, 0xba, 0x9f, 0x99, 0xc9, 0xdc, 0xbd, 0x6f, 0x15,
+	0x0f, 0x07, 0x79, 0x05, 0x1b, 0xd9, 0x5e, 0x48, 0xb1, 0x5b, 0xab, 0x55, 0x08, 0xf7, 0x92, 0x50,
+	0x82, 0xb0, 0x95, 0x4d, 0x91, 0xb4, 0x41, 0x0a, 0x1f, 0x88, 0x82, 0x33, 0x6c, 0x75, 0xaf, 0x77,
+	0xb4, 0xea, 0xee, 0x41, 0xed, 0x90, 0x45, 0xe9, 0x67, 0x6b, 0x10, 0x2c, 0xa3, 0xba, 0xa4, 0xd1,
+	0x53, 0xd8, 0xce, 0x84, 0xdb, 0xd7, 0x7b, 0x49, 0x8a, 0x2f, 0x7d, 0xde, 0x88, 0x0b, 0xf5, 0x9f,
+	0xe3, 0x80, 0x2a, 0xcc, 0xc3, 0x5f, 0x7e, 0x2a, 0xdb, 0x39, 0xcf, 0x5f, 0x38, 0x0b, 0xc8, 0x6b,
+	0x68, 0x64, 0x88, 0xbe, 0x9f, 0x8f, 0xf3, 0xcd, 0xda, 0x7d, 0x0e, 0x35, 0x17, 0x27, 0xfc, 0x22,
+	0x65, 0xb6, 0x2c, 0xbc, 0x80, 0x40, 0x66, 0xcc, 0x6c, 0xf5, 0xe2, 0x86, 0x8a, 0x3e, 0xbc, 0xcb,
+	0xe8, 0xec, 0x99, 0x31, 0xb3, 0x21, 0x92, 0x5c, 0x2d, 0xfb, 0xd9, 0x11, 0x3b, 0x06, 0x78, 0x8b,
+	0x69, 0xf4, 0x8d, 0x4e, 0xad, 0xe8, 0x8f, 0xc4, 0x5c, 0x9b, 0x6b, 0x12, 0x16, 0x68, 0x73, 0x0c,
+	0x35, 0x73, 0xe0, 0x37, 0x57, 0xa6, 0x20, 0xdb, 0x3e, 0x90, 0x43, 0x2e, 0x7c, 0xfc, 0x4a, 0x36,
+	0x3f, 0x95, 0x7f, 0x5d, 0x37, 0x7f, 0x7d, 0xcf, 0xcc, 0xef, 0xd3, 0xff, 0x07, 0x00, 0x6c, 0x8b,
+	0x7b, 0x0c, 0x75, 0x0b, 0x00, 0x00,
 }
@@ -84,6 +84,26 @@ func (md MagmadConfigurator) ListGateways(ctx context.Context, netId *protos.Ide
 	return res, nil
 }
 
+// ListGatewaysPage lists a page of logical gateway IDs of the network in
+// ascending order
+func (md MagmadConfigurator) ListGatewaysPage(ctx context.Context, req *magmadprotos.ListGatewaysRequest) (*magmadprotos.ListGatewaysResponse, error) {
+	res := &magmadprotos.ListGatewaysResponse{}
+	nid := req.GetNetworkId()
+	if len(nid) == 0 {
+		return res, status.Errorf(codes.InvalidArgument, "Invalid Network ID")
+	}
+	ids, nextPageToken, err := md.Store.ListKeysPage(
+		datastore.GetTableName(nid, AgRecordTableName),
+		datastore.ListKeysCriteria{KeyPrefix: req.GetIdPrefix(), PageSize: req.GetPageSize(), PageToken: req.GetPageToken()},
+	)
+	if err != nil {
+		return res, status.Error(codes.Internal, err.Error())
+	}
+	res.GatewayIds = ids
+	res.NextPageToken = nextPageToken
+	return res, nil
+}
+
 // FindGatewayId augments the given GW Identity including HW ID & Network ID
 // with logical AG Id corresponding to given registered HW Id on the network
 func (md MagmadConfigurator) FindGatewayId(ctx context.Context, gwId *protos.Identity) (*protos.Identity, error) {
@@ -0,0 +1,256 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package aggregation implements the metricsd aggregation stage, which
+// downsamples gateway metrics and rolls them up across the gateways of a
+// network before they reach the exporters.
+//
+// Windows are kept in the memory of the metricsd process, so a rollup only
+// covers the gateways which pushed their metrics to that process. With more
+// than one metricsd replica each replica would export partial rollups under
+// the same series, so aggregation rules require a single replica.
+package aggregation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// SubmitFunc hands aggregated metrics to the exporters
+type SubmitFunc func(metrics []exporters.MetricAndContext)
+
+// Aggregator accumulates samples of the metrics it has rules for and
+// periodically submits their aggregates. It is safe for concurrent use.
+type Aggregator struct {
+	rules       []Rule
+	rulesByName map[string]Rule
+	submit      SubmitFunc
+
+	sync.Mutex
+	// windows holds the open window of each metric
+	windows map[string]*window
+}
+
+type window struct {
+	// series holds the samples of each series in the window, keyed by
+	// network, gateway and labels
+	series map[string]*series
+}
+
+type series struct {
+	ctx    exporters.MetricsContext
+	labels []*dto.LabelPair
+	values []float64
+}
+
+// NewAggregator creates an Aggregator which submits aggregates through
+// submit. Rules must have been validated.
+func NewAggregator(rules []Rule, submit SubmitFunc) *Aggregator {
+	rulesByName := map[string]Rule{}
+	for _, rule := range rules {
+		rulesByName[rule.MetricName] = rule
+	}
+	return &Aggregator{rules: rules, rulesByName: rulesByName, submit: submit, windows: map[string]*window{}}
+}
+
+// Process accumulates the samples of metrics matching a rule and returns the
+// metrics which should be submitted to the exporters right away: metrics
+// without a rule, metrics of rules which keep raw samples, and summaries and
+// histograms, which aren't aggregated.
+func (a *Aggregator) Process(metrics []exporters.MetricAndContext) []exporters.MetricAndContext {
+	ret := make([]exporters.MetricAndContext, 0, len(metrics))
+	a.Lock()
+	defer a.Unlock()
+	for _, metric := range metrics {
+		rule, ok := a.rulesByName[metric.Context.MetricName]
+		if !ok || !isAggregatable(metric.Family) {
+			ret = append(ret, metric)
+			continue
+		}
+		if rule.KeepRaw {
+			ret = append(ret, metric)
+		}
+		a.addFamilyUnsafe(metric.Family, metric.Context)
+	}
+	return ret
+}
+
+// Start flushes the window of each rule every rule.Window in the background
+func (a *Aggregator) Start() {
+	for _, rule := range a.rules {
+		go a.flushEvery(rule.MetricName, rule.Window)
+	}
+}
+
+func (a *Aggregator) flushEvery(metricName string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		a.flush(metricName, now)
+	}
+}
+
+// Flush closes the windows of all rules and submits their aggregates
+func (a *Aggregator) Flush() {
+	now := time.Now()
+	for _, rule := range a.rules {
+		a.flush(rule.MetricName, now)
+	}
+}
+
+func (a *Aggregator) flush(metricName string, now time.Time) {
+	a.Lock()
+	w, ok := a.windows[metricName]
+	delete(a.windows, metricName)
+	a.Unlock()
+	if !ok {
+		return
+	}
+	aggregates := w.aggregate(a.rulesByName[metricName], now.UnixNano()/int64(time.Millisecond))
+	if len(aggregates) > 0 {
+		glog.V(2).Infof("submitting %d aggregates of %s", len(aggregates), metricName)
+		a.submit(aggregates)
+	}
+}
+
+func (a *Aggregator) addFamilyUnsafe(family *dto.MetricFamily, ctx exporters.MetricsContext) {
+	w, ok := a.windows[ctx.MetricName]
+	if !ok {
+		w = &window{series: map[string]*series{}}
+		a.windows[ctx.MetricName] = w
+	}
+	for _, metric := range family.GetMetric() {
+		value, ok := getValue(family.GetType(), metric)
+		if !ok {
+			continue
+		}
+		labels := sortedLabels(metric.GetLabel())
+		key := fmt.Sprintf("%s\x00%s\x00%s", ctx.NetworkID, ctx.GatewayID, labelsKey(labels))
+		s, ok := w.series[key]
+		if !ok {
+			s = &series{labels: labels}
+			w.series[key] = s
+		}
+		// Keep the latest context in case e.g. the hardware ID changed
+		s.ctx = ctx
+		s.values = append(s.values, value)
+	}
+}
+
+func (w *window) aggregate(rule Rule, timestampMs int64) []exporters.MetricAndContext {
+	var ret []exporters.MetricAndContext
+
+	// Sort series so aggregates are submitted in a stable order
+	keys := make([]string, 0, len(w.series))
+	for key := range w.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	type rollupGroup struct {
+		networkID string
+		labels    []*dto.LabelPair
+		values    []float64
+	}
+	var groups []*rollupGroup
+	groupsByKey := map[string]*rollupGroup{}
+
+	for _, key := range keys {
+		s := w.series[key]
+		value := Last.apply(s.values)
+		if rule.Downsample != "" {
+			value = rule.Downsample.apply(s.values)
+			ret = append(ret, makeGaugeMetric(s.ctx, s.labels, value, timestampMs))
+		}
+		if len(rule.Rollups) == 0 {
+			continue
+		}
+		groupKey := fmt.Sprintf("%s\x00%s", s.ctx.NetworkID, labelsKey(s.labels))
+		group, ok := groupsByKey[groupKey]
+		if !ok {
+			group = &rollupGroup{networkID: s.ctx.NetworkID, labels: s.labels}
+			groupsByKey[groupKey] = group
+			groups = append(groups, group)
+		}
+		group.values = append(group.values, value)
+	}
+
+	for _, group := range groups {
+		for _, f := range rule.Rollups {
+			name := rule.MetricName + f.metricSuffix()
+			ctx := exporters.MetricsContext{
+				MetricName:        name,
+				DecodedName:       name,
+				NetworkID:         group.networkID,
+				GatewayID:         exporters.NetworkAggregateID,
+				OriginatingEntity: group.networkID + "." + exporters.NetworkAggregateID,
+			}
+			ret = append(ret, makeGaugeMetric(ctx, group.labels, f.apply(group.values), timestampMs))
+		}
+	}
+	return ret
+}
+
+// isAggregatable returns true for metric types with a single value per sample
+func isAggregatable(family *dto.MetricFamily) bool {
+	switch family.GetType() {
+	case dto.MetricType_COUNTER, dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+		return true
+	}
+	return false
+}
+
+func getValue(metricType dto.MetricType, metric *dto.Metric) (float64, bool) {
+	switch {
+	case metricType == dto.MetricType_COUNTER && metric.Counter != nil:
+		return metric.Counter.GetValue(), true
+	case metricType == dto.MetricType_GAUGE && metric.Gauge != nil:
+		return metric.Gauge.GetValue(), true
+	case metricType == dto.MetricType_UNTYPED && metric.Untyped != nil:
+		return metric.Untyped.GetValue(), true
+	}
+	return 0, false
+}
+
+func sortedLabels(labels []*dto.LabelPair) []*dto.LabelPair {
+	ret := append([]*dto.LabelPair{}, labels...)
+	sort.Slice(ret, func(i, j int) bool { return ret[i].GetName() < ret[j].GetName() })
+	return ret
+}
+
+func labelsKey(labels []*dto.LabelPair) string {
+	pairs := make([]string, 0, len(labels))
+	for _, label := range labels {
+		pairs = append(pairs, label.GetName()+"="+label.GetValue())
+	}
+	return strings.Join(pairs, "\x00")
+}
+
+// makeGaugeMetric wraps an aggregate in a single-metric gauge family.
+// Aggregates are always gauges, e.g. the average of a counter isn't a counter.
+func makeGaugeMetric(ctx exporters.MetricsContext, labels []*dto.LabelPair, value float64, timestampMs int64) exporters.MetricAndContext {
+	family := &dto.MetricFamily{
+		Name: proto.String(ctx.MetricName),
+		Type: dto.MetricType_GAUGE.Enum(),
+		Metric: []*dto.Metric{{
+			Label:       labels,
+			Gauge:       &dto.Gauge{Value: proto.Float64(value)},
+			TimestampMs: proto.Int64(timestampMs),
+		}},
+	}
+	return exporters.MetricAndContext{Family: family, Context: ctx}
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package aggregation_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/services/metricsd/aggregation"
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
+	"magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+type submitRecorder struct {
+	submitted []exporters.MetricAndContext
+}
+
+func (r *submitRecorder) submit(metrics []exporters.MetricAndContext) {
+	r.submitted = append(r.submitted, metrics...)
+}
+
+// values returns the values of submitted gauges keyed by metric name,
+// network, gateway and label values
+func (r *submitRecorder) values() map[string]float64 {
+	ret := map[string]float64{}
+	for _, metric := range r.submitted {
+		for _, m := range metric.Family.GetMetric() {
+			key := metric.Context.MetricName + "/" + metric.Context.NetworkID + "/" + metric.Context.GatewayID
+			for _, label := range m.GetLabel() {
+				key += "/" + label.GetValue()
+			}
+			ret[key] = m.GetGauge().GetValue()
+		}
+	}
+	return ret
+}
+
+func makeGauge(networkID, gatewayID, name string, value float64, labels ...*dto.LabelPair) exporters.MetricAndContext {
+	metric := test_common.MakePromoGauge(value)
+	metric.Label = labels
+	family := &dto.MetricFamily{
+		Name:   test_common.MakeStringPointer(name),
+		Type:   dto.MetricType_GAUGE.Enum(),
+		Metric: []*dto.Metric{&metric},
+	}
+	return exporters.MetricAndContext{
+		Family: family,
+		Context: exporters.MetricsContext{
+			NetworkID:         networkID,
+			GatewayID:         gatewayID,
+			OriginatingEntity: networkID + "." + gatewayID,
+			MetricName:        name,
+			DecodedName:       name,
+		},
+	}
+}
+
+func makeLabel(name, value string) *dto.LabelPair {
+	return &dto.LabelPair{Name: test_common.MakeStringPointer(name), Value: test_common.MakeStringPointer(value)}
+}
+
+func TestAggregator(t *testing.T) {
+	recorder := &submitRecorder{}
+	rules := []aggregation.Rule{
+		{MetricName: "rollup_only", Window: time.Minute, Rollups: []aggregation.Func{aggregation.Sum, aggregation.Avg, aggregation.Min, aggregation.Max, aggregation.Count, "p50", "p90"}},
+		{MetricName: "downsampled", Window: time.Minute, Downsample: aggregation.Max, KeepRaw: true},
+	}
+	aggregator := aggregation.NewAggregator(rules, recorder.submit)
+
+	passthrough := aggregator.Process([]exporters.MetricAndContext{
+		makeGauge("n1", "g1", "rollup_only", 1),
+		makeGauge("n1", "g1", "rollup_only", 10), // later sample of the same series wins
+		makeGauge("n1", "g2", "rollup_only", 20),
+		makeGauge("n1", "g3", "rollup_only", 30),
+		makeGauge("n1", "g4", "rollup_only", 40),
+		makeGauge("n2", "g5", "rollup_only", 5),
+		makeGauge("n1", "g1", "downsampled", 3, makeLabel("service", "a")),
+		makeGauge("n1", "g1", "downsampled", 7, makeLabel("service", "a")),
+		makeGauge("n1", "g1", "downsampled", 1, makeLabel("service", "b")),
+		makeGauge("n1", "g1", "other", 1),
+	})
+	// Raw samples are only passed through for metrics without rules and
+	// rules which keep them
+	assert.Len(t, passthrough, 4)
+	for _, metric := range passthrough[:3] {
+		assert.Equal(t, "downsampled", metric.Context.MetricName)
+	}
+	assert.Equal(t, "other", passthrough[3].Context.MetricName)
+
+	// Summaries and histograms aren't aggregated
+	summary := test_common.MakeTestMetricFamily(dto.MetricType_SUMMARY, 1, nil)
+	passthrough = aggregator.Process([]exporters.MetricAndContext{
+		{Family: summary, Context: exporters.MetricsContext{NetworkID: "n1", GatewayID: "g1", MetricName: "rollup_only"}},
+	})
+	assert.Len(t, passthrough, 1)
+
+	assert.Empty(t, recorder.submitted)
+	aggregator.Flush()
+	assert.Equal(t, map[string]float64{
+		"rollup_only_sum/n1/network_aggregate":   100,
+		"rollup_only_avg/n1/network_aggregate":   25,
+		"rollup_only_min/n1/network_aggregate":   10,
+		"rollup_only_max/n1/network_aggregate":   40,
+		"rollup_only_count/n1/network_aggregate": 4,
+		"rollup_only_p50/n1/network_aggregate":   25,
+		"rollup_only_p90/n1/network_aggregate":   37,
+		"rollup_only_sum/n2/network_aggregate":   5,
+		"rollup_only_avg/n2/network_aggregate":   5,
+		"rollup_only_min/n2/network_aggregate":   5,
+		"rollup_only_max/n2/network_aggregate":   5,
+		"rollup_only_count/n2/network_aggregate": 1,
+		"rollup_only_p50/n2/network_aggregate":   5,
+		"rollup_only_p90/n2/network_aggregate":   5,
+		"downsampled/n1/g1/a":                    7,
+		"downsampled/n1/g1/b":                    1,
+	}, recorder.values())
+	assert.Len(t, recorder.submitted, 16)
+	for _, metric := range recorder.submitted {
+		if metric.Context.GatewayID == exporters.NetworkAggregateID {
+			assert.Equal(t, metric.Context.NetworkID+".network_aggregate", metric.Context.OriginatingEntity)
+		}
+		assert.Equal(t, dto.MetricType_GAUGE, metric.Family.GetType())
+		assert.NotNil(t, metric.Family.Metric[0].TimestampMs)
+	}
+
+	// Windows are reset after each flush
+	recorder.submitted = nil
+	aggregator.Flush()
+	assert.Empty(t, recorder.submitted)
+}
+
+func TestAggregator_RollupsOfDownsampledSeries(t *testing.T) {
+	recorder := &submitRecorder{}
+	rules := []aggregation.Rule{
+		{MetricName: "m", Window: time.Minute, Downsample: aggregation.Avg, Rollups: []aggregation.Func{aggregation.Sum, "p99.9"}},
+	}
+	aggregator := aggregation.NewAggregator(rules, recorder.submit)
+
+	aggregator.Process([]exporters.MetricAndContext{
+		makeGauge("n1", "g1", "m", 1, makeLabel("cell", "1")),
+		makeGauge("n1", "g1", "m", 3, makeLabel("cell", "1")),
+		makeGauge("n1", "g2", "m", 4, makeLabel("cell", "1")),
+		makeGauge("n1", "g2", "m", 8, makeLabel("cell", "2")),
+	})
+	aggregator.Flush()
+	assert.Equal(t, map[string]float64{
+		"m/n1/g1/1":                      2,
+		"m/n1/g2/1":                      4,
+		"m/n1/g2/2":                      8,
+		"m_sum/n1/network_aggregate/1":   6,
+		"m_p99_9/n1/network_aggregate/1": 3.998,
+		"m_sum/n1/network_aggregate/2":   8,
+		"m_p99_9/n1/network_aggregate/2": 8,
+	}, recorder.values())
+}
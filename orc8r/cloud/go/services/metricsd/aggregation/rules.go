@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/service/config"
+	"magma/orc8r/cloud/go/services/metricsd/confignames"
+)
+
+const DefaultWindow = time.Minute
+
+// Func is the name of an aggregation function: sum, avg, min, max, count,
+// last or a percentile such as p50 or p99.9
+type Func string
+
+const (
+	Sum   Func = "sum"
+	Avg   Func = "avg"
+	Min   Func = "min"
+	Max   Func = "max"
+	Count Func = "count"
+	// Last is only valid for downsampling, samples from different gateways
+	// are not ordered
+	Last Func = "last"
+)
+
+// Rule configures the aggregation of a single metric. Samples of the metric
+// are accumulated over Window, after which the aggregates are submitted to
+// the exporters.
+type Rule struct {
+	// MetricName is the decoded name of the metric to aggregate
+	MetricName string
+	Window     time.Duration
+
+	// Downsample reduces the samples of each series (i.e. each gateway and
+	// label set) in a window to a single value, which is exported in place
+	// of the raw samples. If empty, no per-series values are exported.
+	Downsample Func
+
+	// Rollups are computed across all series of a network which share the
+	// same labels. Each series contributes its downsampled value, or its last
+	// value if Downsample is empty. The result of rollup f is exported as
+	// <MetricName>_<f> for the network.
+	Rollups []Func
+
+	// KeepRaw forwards the raw samples to the exporters in addition to the
+	// aggregates
+	KeepRaw bool
+}
+
+// Validate returns an error if the rule is incomplete or uses unknown
+// aggregation functions
+func (r Rule) Validate() error {
+	if r.MetricName == "" {
+		return fmt.Errorf("Aggregation rule must specify a metric name")
+	}
+	if r.Window <= 0 {
+		return fmt.Errorf("Aggregation window of %s must be positive", r.MetricName)
+	}
+	if r.Downsample == "" && len(r.Rollups) == 0 {
+		return fmt.Errorf("Aggregation rule for %s must specify a downsample function or rollups", r.MetricName)
+	}
+	if r.Downsample != "" {
+		if err := r.Downsample.validate(); err != nil {
+			return fmt.Errorf("Invalid downsample function for %s: %s", r.MetricName, err)
+		}
+	}
+	for _, f := range r.Rollups {
+		if f == Last {
+			return fmt.Errorf("Invalid rollup for %s: %s is only valid for downsampling", r.MetricName, f)
+		}
+		if err := f.validate(); err != nil {
+			return fmt.Errorf("Invalid rollup for %s: %s", r.MetricName, err)
+		}
+	}
+	return nil
+}
+
+func (f Func) validate() error {
+	switch f {
+	case Sum, Avg, Min, Max, Count, Last:
+		return nil
+	}
+	if _, ok := f.quantile(); ok {
+		return nil
+	}
+	return fmt.Errorf("unknown aggregation function %s", f)
+}
+
+// quantile returns the quantile in [0, 1] of a percentile function
+func (f Func) quantile() (float64, bool) {
+	if !strings.HasPrefix(string(f), "p") {
+		return 0, false
+	}
+	percentile, err := strconv.ParseFloat(string(f)[1:], 64)
+	if err != nil || percentile <= 0 || percentile >= 100 {
+		return 0, false
+	}
+	return percentile / 100, true
+}
+
+// metricSuffix returns the suffix appended to the names of rollups, with
+// characters which aren't valid in metric names replaced
+func (f Func) metricSuffix() string {
+	return "_" + strings.Replace(string(f), ".", "_", -1)
+}
+
+// apply computes the function over values, which must be non-empty. values
+// are in the order in which they were received.
+func (f Func) apply(values []float64) float64 {
+	switch f {
+	case Sum:
+		return sum(values)
+	case Avg:
+		return sum(values) / float64(len(values))
+	case Min:
+		ret := values[0]
+		for _, v := range values[1:] {
+			ret = math.Min(ret, v)
+		}
+		return ret
+	case Max:
+		ret := values[0]
+		for _, v := range values[1:] {
+			ret = math.Max(ret, v)
+		}
+		return ret
+	case Count:
+		return float64(len(values))
+	case Last:
+		return values[len(values)-1]
+	}
+	q, _ := f.quantile()
+	return quantile(values, q)
+}
+
+func sum(values []float64) float64 {
+	ret := 0.0
+	for _, v := range values {
+		ret += v
+	}
+	return ret
+}
+
+// quantile interpolates linearly between the closest ranks
+func quantile(values []float64, q float64) float64 {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	rank := q * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+// GetRules reads aggregation rules from the metricsd config. Rules are
+// optional, so a config without rules returns an empty list.
+//
+// Example:
+//
+//	aggregationRules:
+//	  - metricName: "enodeb_rf_tx_enabled"
+//	    windowSecs: 60
+//	    downsample: "avg"
+//	    rollups: ["sum", "max", "p95"]
+//	    keepRaw: false
+func GetRules(metricsConfig *config.ConfigMap) ([]Rule, error) {
+	rawRules, ok := metricsConfig.RawMap[confignames.AggregationRules]
+	if !ok || rawRules == nil {
+		return []Rule{}, nil
+	}
+	return ParseRules(rawRules)
+}
+
+// ParseRules parses rules from their YAML representation
+func ParseRules(rawRules interface{}) ([]Rule, error) {
+	ruleList, ok := rawRules.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", confignames.AggregationRules)
+	}
+	ret := make([]Rule, 0, len(ruleList))
+	seen := map[string]bool{}
+	for i, rawRule := range ruleList {
+		ruleMap, ok := rawRule.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Aggregation rule %d must be a map", i)
+		}
+		rule, err := parseRule(config.NewConfigMap(ruleMap))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid aggregation rule %d: %s", i, err)
+		}
+		if seen[rule.MetricName] {
+			return nil, fmt.Errorf("Duplicate aggregation rule for %s", rule.MetricName)
+		}
+		seen[rule.MetricName] = true
+		ret = append(ret, rule)
+	}
+	return ret, nil
+}
+
+func parseRule(ruleMap *config.ConfigMap) (Rule, error) {
+	rule := Rule{Window: DefaultWindow}
+	var err error
+	if rule.MetricName, err = ruleMap.GetStringParam("metricName"); err != nil {
+		return rule, fmt.Errorf("metricName is required")
+	}
+	if _, ok := ruleMap.RawMap["windowSecs"]; ok {
+		windowSecs, err := ruleMap.GetIntParam("windowSecs")
+		if err != nil {
+			return rule, err
+		}
+		rule.Window = time.Duration(windowSecs) * time.Second
+	}
+	if _, ok := ruleMap.RawMap["downsample"]; ok {
+		downsample, err := ruleMap.GetStringParam("downsample")
+		if err != nil {
+			return rule, err
+		}
+		rule.Downsample = Func(downsample)
+	}
+	if rawRollups, ok := ruleMap.RawMap["rollups"]; ok {
+		rollupList, ok := rawRollups.([]interface{})
+		if !ok {
+			return rule, fmt.Errorf("rollups must be a list")
+		}
+		for _, rawRollup := range rollupList {
+			rollup, ok := rawRollup.(string)
+			if !ok {
+				return rule, fmt.Errorf("rollups must be strings")
+			}
+			rule.Rollups = append(rule.Rollups, Func(rollup))
+		}
+	}
+	if _, ok := ruleMap.RawMap["keepRaw"]; ok {
+		if rule.KeepRaw, err = ruleMap.GetBoolParam("keepRaw"); err != nil {
+			return rule, err
+		}
+	}
+	return rule, rule.Validate()
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package aggregation_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/service/config"
+	"magma/orc8r/cloud/go/services/metricsd/aggregation"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func loadConfig(t *testing.T, yml string) *config.ConfigMap {
+	rawMap := map[interface{}]interface{}{}
+	assert.NoError(t, yaml.Unmarshal([]byte(yml), &rawMap))
+	return config.NewConfigMap(rawMap)
+}
+
+func TestGetRules(t *testing.T) {
+	rules, err := aggregation.GetRules(loadConfig(t, `profile: "default"`))
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+
+	rules, err = aggregation.GetRules(loadConfig(t, `
+aggregationRules:
+  - metricName: "enodeb_rf_tx_enabled"
+    windowSecs: 30
+    downsample: "avg"
+    rollups: ["sum", "max", "p99.9"]
+    keepRaw: true
+  - metricName: "ue_connected"
+    rollups: ["count"]
+`))
+	assert.NoError(t, err)
+	expected := []aggregation.Rule{
+		{
+			MetricName: "enodeb_rf_tx_enabled",
+			Window:     30 * time.Second,
+			Downsample: aggregation.Avg,
+			Rollups:    []aggregation.Func{aggregation.Sum, aggregation.Max, "p99.9"},
+			KeepRaw:    true,
+		},
+		{
+			MetricName: "ue_connected",
+			Window:     aggregation.DefaultWindow,
+			Rollups:    []aggregation.Func{aggregation.Count},
+		},
+	}
+	assert.Equal(t, expected, rules)
+
+	testCases := []struct{ yml, expectedErr string }{
+		{`aggregationRules: "sum"`, "aggregationRules must be a list"},
+		{`aggregationRules: ["sum"]`, "Aggregation rule 0 must be a map"},
+		{`aggregationRules: [{rollups: ["sum"]}]`, "Invalid aggregation rule 0: metricName is required"},
+		{`aggregationRules: [{metricName: "m"}]`, "Invalid aggregation rule 0: Aggregation rule for m must specify a downsample function or rollups"},
+		{`aggregationRules: [{metricName: "m", windowSecs: 0, rollups: ["sum"]}]`, "Invalid aggregation rule 0: Aggregation window of m must be positive"},
+		{`aggregationRules: [{metricName: "m", downsample: "median"}]`, "Invalid aggregation rule 0: Invalid downsample function for m: unknown aggregation function median"},
+		{`aggregationRules: [{metricName: "m", rollups: ["p100"]}]`, "Invalid aggregation rule 0: Invalid rollup for m: unknown aggregation function p100"},
+		{`aggregationRules: [{metricName: "m", rollups: ["last"]}]`, "Invalid aggregation rule 0: Invalid rollup for m: last is only valid for downsampling"},
+		{`aggregationRules: [{metricName: "m", rollups: ["sum"]}, {metricName: "m", rollups: ["max"]}]`, "Duplicate aggregation rule for m"},
+	}
+	for _, tc := range testCases {
+		_, err = aggregation.GetRules(loadConfig(t, tc.yml))
+		assert.EqualError(t, err, tc.expectedErr)
+	}
+}
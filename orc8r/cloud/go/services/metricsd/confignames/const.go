@@ -18,6 +18,16 @@ const (
 	GraphiteReceivePort = "graphiteReceivePort"
 	GraphiteQueryPort   = "graphiteQueryPort"
 
+	InfluxDBAddress           = "influxDBAddress"
+	InfluxDBDatabase          = "influxDBDatabase"
+	InfluxDBRetentionPolicy   = "influxDBRetentionPolicy"
+	InfluxDBUsername          = "influxDBUsername"
+	InfluxDBPassword          = "influxDBPassword"
+	InfluxDBBatchSize         = "influxDBBatchSize"
+	InfluxDBFlushIntervalSecs = "influxDBFlushIntervalSecs"
+
+	AggregationRules = "aggregationRules"
+
 	AlertConfigWebServerURL = "alertConfigWebServerURL"
 	AlertmanagerApiURL      = "alertmanagerApiURL"
 )
@@ -13,4 +13,7 @@ const (
 	TAGS_LABEL_NAME    = "tags"
 
 	CloudMetricID = "cloud"
+	// NetworkAggregateID is the gateway ID of metrics aggregated across all
+	// gateways of a network
+	NetworkAggregateID = "network_aggregate"
 )
@@ -0,0 +1,326 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
+
+	"github.com/golang/glog"
+)
+
+const (
+	DefaultBatchSize         = 5000
+	DefaultFlushInterval     = time.Second * 10
+	DefaultMaxRetries        = 3
+	DefaultRetryBackoff      = time.Second
+	DefaultMaxBufferedPoints = 100000
+	DefaultUDPPayloadSize    = 512
+
+	httpTimeout = time.Second * 10
+
+	// msToNsSuffix turns a millisecond timestamp into a nanosecond one
+	msToNsSuffix = "000000"
+)
+
+// InfluxDBConfig configures an InfluxDBExporter
+type InfluxDBConfig struct {
+	// Address of the InfluxDB server. http:// and https:// addresses are
+	// written to through the HTTP /write API, udp://host:port addresses
+	// through the UDP service. UDP points are sent with nanosecond
+	// timestamps, so the [[udp]] listener must keep its default precision.
+	Address string
+	// Database and RetentionPolicy to write to over HTTP. They are ignored
+	// for UDP, where the database is set in the InfluxDB UDP listener config.
+	Database        string
+	RetentionPolicy string
+	Username        string
+	Password        string
+
+	// BatchSize is the maximum number of points sent in a single write. A
+	// write is also triggered as soon as a batch worth of points (or
+	// MaxBufferedPoints, if smaller) is buffered.
+	BatchSize int
+	// FlushInterval is the interval at which buffered points are written
+	FlushInterval time.Duration
+	// MaxRetries is the number of times a failed write is retried. Retries
+	// back off exponentially starting at RetryBackoff.
+	MaxRetries   int
+	RetryBackoff time.Duration
+	// MaxBufferedPoints bounds the number of points buffered while InfluxDB
+	// is slow or unreachable. Once it is reached, the oldest points are
+	// dropped to make room for new ones.
+	MaxBufferedPoints int
+	// UDPPayloadSize is the maximum size of a UDP datagram
+	UDPPayloadSize int
+}
+
+// InfluxDBExporter converts metrics to InfluxDB line protocol and writes them
+// to InfluxDB in batches
+type InfluxDBExporter struct {
+	config InfluxDBConfig
+	writer pointWriter
+
+	sync.Mutex
+	points  []string
+	dropped int
+	flushCh chan struct{}
+}
+
+// pointWriter writes a batch of points in line protocol. Errors which are
+// not worth retrying are wrapped in permanentError.
+type pointWriter interface {
+	write(lines []string) error
+}
+
+type permanentError struct {
+	error
+}
+
+// NewInfluxDBExporter creates a new InfluxDBExporter. Unset numeric config
+// values are replaced with their defaults.
+func NewInfluxDBExporter(config InfluxDBConfig) (exporters.Exporter, error) {
+	config = withDefaults(config)
+	addr, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid InfluxDB address %s: %s", config.Address, err)
+	}
+
+	var writer pointWriter
+	switch addr.Scheme {
+	case "http", "https":
+		if config.Database == "" {
+			return nil, fmt.Errorf("An InfluxDB database must be configured for HTTP writes")
+		}
+		writer = newHTTPWriter(addr, config)
+	case "udp":
+		if addr.Host == "" {
+			return nil, fmt.Errorf("Invalid InfluxDB UDP address %s", config.Address)
+		}
+		writer = &udpWriter{address: addr.Host, payloadSize: config.UDPPayloadSize}
+	default:
+		return nil, fmt.Errorf("Unsupported InfluxDB address scheme %s, expected http, https or udp", addr.Scheme)
+	}
+	return &InfluxDBExporter{
+		config:  config,
+		writer:  writer,
+		flushCh: make(chan struct{}, 1),
+	}, nil
+}
+
+func withDefaults(config InfluxDBConfig) InfluxDBConfig {
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultFlushInterval
+	}
+	if config.MaxRetries <= 0 {
+		config.MaxRetries = DefaultMaxRetries
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = DefaultRetryBackoff
+	}
+	if config.MaxBufferedPoints <= 0 {
+		config.MaxBufferedPoints = DefaultMaxBufferedPoints
+	}
+	if config.UDPPayloadSize <= 0 {
+		config.UDPPayloadSize = DefaultUDPPayloadSize
+	}
+	return config
+}
+
+// Submit converts metrics to points and buffers them to be written on the
+// next flush. This function never blocks on InfluxDB.
+func (e *InfluxDBExporter) Submit(metrics []exporters.MetricAndContext) error {
+	nowMs := time.Now().UnixNano() / int64(time.Millisecond)
+	var lines []string
+	for _, metric := range metrics {
+		lines = append(lines, FamilyToLines(metric.Family, metric.Context, nowMs)...)
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	e.points = append(e.points, lines...)
+	if overflow := len(e.points) - e.config.MaxBufferedPoints; overflow > 0 {
+		e.points = e.points[overflow:]
+		e.dropped += overflow
+	}
+	// Write early once a batch is ready or the buffer is full
+	if len(e.points) >= e.config.BatchSize || len(e.points) >= e.config.MaxBufferedPoints {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Start runs the flush loop in a goroutine
+func (e *InfluxDBExporter) Start() {
+	go e.flushEvery()
+}
+
+func (e *InfluxDBExporter) flushEvery() {
+	ticker := time.NewTicker(e.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-e.flushCh:
+		}
+		if err := e.Flush(); err != nil {
+			glog.Errorf("Error writing to InfluxDB: %s", err)
+		}
+	}
+}
+
+// Flush writes all buffered points in batches. Batches which fail after all
+// retries are dropped.
+func (e *InfluxDBExporter) Flush() error {
+	e.Lock()
+	points := e.points
+	dropped := e.dropped
+	e.points = nil
+	e.dropped = 0
+	e.Unlock()
+
+	if dropped > 0 {
+		glog.Errorf("Dropped %d InfluxDB points because the export buffer was full", dropped)
+	}
+	var lastErr error
+	for start := 0; start < len(points); start += e.config.BatchSize {
+		end := start + e.config.BatchSize
+		if end > len(points) {
+			end = len(points)
+		}
+		if err := e.writeWithRetries(points[start:end]); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (e *InfluxDBExporter) writeWithRetries(lines []string) error {
+	backoff := e.config.RetryBackoff
+	var err error
+	for attempt := 0; attempt <= e.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		err = e.writer.write(lines)
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(permanentError); ok {
+			break
+		}
+	}
+	return fmt.Errorf("Dropping batch of %d points: %s", len(lines), err)
+}
+
+type httpWriter struct {
+	writeURL string
+	username string
+	password string
+	client   *http.Client
+}
+
+func newHTTPWriter(addr *url.URL, config InfluxDBConfig) *httpWriter {
+	query := url.Values{}
+	query.Set("db", config.Database)
+	query.Set("precision", "ms")
+	if config.RetentionPolicy != "" {
+		query.Set("rp", config.RetentionPolicy)
+	}
+	writeURL := *addr
+	writeURL.Path = strings.TrimSuffix(writeURL.Path, "/") + "/write"
+	writeURL.RawQuery = query.Encode()
+	return &httpWriter{
+		writeURL: writeURL.String(),
+		username: config.Username,
+		password: config.Password,
+		client:   &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (w *httpWriter) write(lines []string) error {
+	req, err := http.NewRequest(http.MethodPost, w.writeURL, strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		return permanentError{err}
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if w.username != "" {
+		req.SetBasicAuth(w.username, w.password)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusOK {
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("InfluxDB write failed with status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	// Client errors (e.g. malformed points, missing database) won't go
+	// away on retry
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		return permanentError{err}
+	}
+	return err
+}
+
+type udpWriter struct {
+	address     string
+	payloadSize int
+}
+
+// write packs lines into datagrams of at most payloadSize bytes. Lines
+// larger than a datagram are sent on their own. Unlike the HTTP API, the UDP
+// service takes no precision with each write, so the millisecond timestamps
+// of the lines are converted to nanoseconds.
+func (w *udpWriter) write(lines []string) error {
+	conn, err := net.Dial("udp", w.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var payload bytes.Buffer
+	for _, line := range lines {
+		if payload.Len() > 0 && payload.Len()+len(line)+len(msToNsSuffix)+1 > w.payloadSize {
+			if _, err := conn.Write(payload.Bytes()); err != nil {
+				return err
+			}
+			payload.Reset()
+		}
+		payload.WriteString(line)
+		payload.WriteString(msToNsSuffix)
+		payload.WriteString("\n")
+	}
+	if payload.Len() > 0 {
+		if _, err := conn.Write(payload.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
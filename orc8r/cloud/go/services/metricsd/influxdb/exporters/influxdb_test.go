@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	mxd_exp "magma/orc8r/cloud/go/services/metricsd/exporters"
+	"magma/orc8r/cloud/go/services/metricsd/influxdb/exporters"
+	"magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+type writeRecorder struct {
+	sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   []string
+}
+
+func (r *writeRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+	body, _ := ioutil.ReadAll(req.Body)
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, string(body))
+	status := http.StatusNoContent
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (r *writeRecorder) numRequests() int {
+	r.Lock()
+	defer r.Unlock()
+	return len(r.requests)
+}
+
+func makeGauges(count int) []mxd_exp.MetricAndContext {
+	ret := make([]mxd_exp.MetricAndContext, 0, count)
+	for i := 0; i < count; i++ {
+		family := test_common.MakeTestMetricFamily(dto.MetricType_GAUGE, 1, []*dto.LabelPair{})
+		ret = append(ret, mxd_exp.MetricAndContext{Family: family, Context: testContext})
+	}
+	return ret
+}
+
+func TestInfluxDBExporter_HTTP(t *testing.T) {
+	recorder := &writeRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	exporter, err := exporters.NewInfluxDBExporter(exporters.InfluxDBConfig{
+		Address:         server.URL,
+		Database:        "magma",
+		RetentionPolicy: "week",
+		Username:        "user",
+		Password:        "pass",
+		BatchSize:       2,
+		RetryBackoff:    time.Millisecond,
+	})
+	assert.NoError(t, err)
+	influxExporter := exporter.(*exporters.InfluxDBExporter)
+
+	// 3 points are written in 2 batches
+	assert.NoError(t, exporter.Submit(makeGauges(3)))
+	assert.NoError(t, influxExporter.Flush())
+	assert.Len(t, recorder.requests, 2)
+	req := recorder.requests[0]
+	assert.Equal(t, "/write", req.URL.Path)
+	assert.Equal(t, "magma", req.URL.Query().Get("db"))
+	assert.Equal(t, "week", req.URL.Query().Get("rp"))
+	assert.Equal(t, "ms", req.URL.Query().Get("precision"))
+	username, password, ok := req.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+	assert.Len(t, strings.Split(recorder.bodies[0], "\n"), 2)
+	assert.Len(t, strings.Split(recorder.bodies[1], "\n"), 1)
+	assert.True(t, strings.HasPrefix(recorder.bodies[1], "test_metric,gatewayID=gID,networkID=nID,originatingEntity=nID.gID value=0 "))
+
+	// Nothing left to flush
+	assert.NoError(t, influxExporter.Flush())
+	assert.Len(t, recorder.requests, 2)
+
+	// Server errors are retried
+	recorder.statuses = []int{http.StatusServiceUnavailable, http.StatusInternalServerError}
+	assert.NoError(t, exporter.Submit(makeGauges(1)))
+	assert.NoError(t, influxExporter.Flush())
+	assert.Len(t, recorder.requests, 5)
+
+	// Client errors are not
+	recorder.statuses = []int{http.StatusBadRequest}
+	assert.NoError(t, exporter.Submit(makeGauges(1)))
+	err = influxExporter.Flush()
+	assert.EqualError(t, err, "Dropping batch of 1 points: InfluxDB write failed with status 400: ")
+	assert.Len(t, recorder.requests, 6)
+
+	// Batches are dropped once retries are exhausted
+	recorder.statuses = []int{500, 500, 500, 500}
+	assert.NoError(t, exporter.Submit(makeGauges(1)))
+	err = influxExporter.Flush()
+	assert.EqualError(t, err, "Dropping batch of 1 points: InfluxDB write failed with status 500: ")
+	assert.Len(t, recorder.requests, 10)
+}
+
+func TestInfluxDBExporter_BackPressure(t *testing.T) {
+	recorder := &writeRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	exporter, err := exporters.NewInfluxDBExporter(exporters.InfluxDBConfig{
+		Address:           server.URL,
+		Database:          "magma",
+		BatchSize:         10,
+		MaxBufferedPoints: 3,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Submit(makeGauges(5)))
+	assert.NoError(t, exporter.(*exporters.InfluxDBExporter).Flush())
+	assert.Len(t, recorder.requests, 1)
+	assert.Len(t, strings.Split(recorder.bodies[0], "\n"), 3)
+
+	// A full buffer triggers a write without waiting for the flush interval
+	exporter.Start()
+	assert.NoError(t, exporter.Submit(makeGauges(3)))
+	assert.NoError(t, exporter.Submit(makeGauges(3)))
+	for i := 0; i < 100 && recorder.numRequests() < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 2, recorder.numRequests())
+}
+
+func TestInfluxDBExporter_UDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	exporter, err := exporters.NewInfluxDBExporter(exporters.InfluxDBConfig{
+		Address:        "udp://" + conn.LocalAddr().String(),
+		UDPPayloadSize: 100,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Submit(makeGauges(3)))
+	assert.NoError(t, exporter.(*exporters.InfluxDBExporter).Flush())
+
+	// Each ~80 byte point is sent in its own datagram
+	buf := make([]byte, 1024)
+	for i := 0; i < 3; i++ {
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(buf[:n]), "test_metric,gatewayID=gID"))
+		assert.Equal(t, 1, strings.Count(string(buf[:n]), "\n"))
+		// Timestamps are in nanoseconds
+		fields := strings.Fields(string(buf[:n]))
+		timestamp, err := strconv.ParseInt(fields[len(fields)-1], 10, 64)
+		assert.NoError(t, err)
+		assert.InDelta(t, time.Now().UnixNano(), timestamp, float64(time.Minute))
+	}
+}
+
+func TestNewInfluxDBExporter_InvalidConfig(t *testing.T) {
+	_, err := exporters.NewInfluxDBExporter(exporters.InfluxDBConfig{Address: "http://localhost:8086"})
+	assert.EqualError(t, err, "An InfluxDB database must be configured for HTTP writes")
+	_, err = exporters.NewInfluxDBExporter(exporters.InfluxDBConfig{Address: "tcp://localhost:8086"})
+	assert.EqualError(t, err, "Unsupported InfluxDB address scheme tcp, expected http, https or udp")
+	_, err = exporters.NewInfluxDBExporter(exporters.InfluxDBConfig{Address: "udp://"})
+	assert.EqualError(t, err, "Invalid InfluxDB UDP address udp://")
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	NetworkTagName           = "networkID"
+	GatewayTagName           = "gatewayID"
+	OriginatingEntityTagName = "originatingEntity"
+
+	// Field names follow the layout used by telegraf for prometheus metrics:
+	// counters and gauges have a single value field, summaries and
+	// histograms have count and sum fields plus one field per quantile or
+	// bucket upper bound.
+	valueFieldName = "value"
+	countFieldName = "count"
+	sumFieldName   = "sum"
+)
+
+var (
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	tagEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+)
+
+type field struct {
+	key   string
+	value string
+}
+
+// FamilyToLines converts every metric in a family into a point in InfluxDB
+// line protocol. The metric name from the context is used as measurement,
+// the network, gateway and originating entity of the context as well as the
+// metric labels are used as tags. Metrics without a timestamp are stamped
+// with defaultTimestampMs. Timestamps are in milliseconds.
+func FamilyToLines(family *dto.MetricFamily, ctx exporters.MetricsContext, defaultTimestampMs int64) []string {
+	measurement := ctx.MetricName
+	if measurement == "" {
+		measurement = family.GetName()
+	}
+	ret := make([]string, 0, len(family.GetMetric()))
+	for _, metric := range family.GetMetric() {
+		fields := getFields(family.GetType(), metric)
+		if len(fields) == 0 {
+			continue
+		}
+		timestampMs := metric.GetTimestampMs()
+		if timestampMs == 0 {
+			timestampMs = defaultTimestampMs
+		}
+		ret = append(ret, makeLine(measurement, getTags(metric, ctx), fields, timestampMs))
+	}
+	return ret
+}
+
+func getTags(metric *dto.Metric, ctx exporters.MetricsContext) map[string]string {
+	tags := map[string]string{}
+	for _, label := range protos.GetDecodedLabel(metric) {
+		tags[label.GetName()] = label.GetValue()
+	}
+	tags[NetworkTagName] = ctx.NetworkID
+	tags[GatewayTagName] = ctx.GatewayID
+	tags[OriginatingEntityTagName] = ctx.OriginatingEntity
+	return tags
+}
+
+func getFields(metricType dto.MetricType, metric *dto.Metric) []field {
+	var fields []field
+	switch metricType {
+	case dto.MetricType_COUNTER:
+		fields = appendFloatField(fields, valueFieldName, metric.GetCounter().GetValue())
+	case dto.MetricType_GAUGE:
+		fields = appendFloatField(fields, valueFieldName, metric.GetGauge().GetValue())
+	case dto.MetricType_UNTYPED:
+		fields = appendFloatField(fields, valueFieldName, metric.GetUntyped().GetValue())
+	case dto.MetricType_SUMMARY:
+		summary := metric.GetSummary()
+		fields = appendUintField(fields, countFieldName, summary.GetSampleCount())
+		fields = appendFloatField(fields, sumFieldName, summary.GetSampleSum())
+		for _, quantile := range summary.GetQuantile() {
+			fields = appendFloatField(fields, formatFloat(quantile.GetQuantile()), quantile.GetValue())
+		}
+	case dto.MetricType_HISTOGRAM:
+		histogram := metric.GetHistogram()
+		fields = appendUintField(fields, countFieldName, histogram.GetSampleCount())
+		fields = appendFloatField(fields, sumFieldName, histogram.GetSampleSum())
+		for _, bucket := range histogram.GetBucket() {
+			fields = appendUintField(fields, formatFloat(bucket.GetUpperBound()), bucket.GetCumulativeCount())
+		}
+	}
+	return fields
+}
+
+// appendFloatField skips NaN and infinite values since line protocol can't
+// represent them
+func appendFloatField(fields []field, key string, value float64) []field {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fields
+	}
+	return append(fields, field{key: key, value: formatFloat(value)})
+}
+
+func appendUintField(fields []field, key string, value uint64) []field {
+	return append(fields, field{key: key, value: strconv.FormatUint(value, 10) + "i"})
+}
+
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// makeLine formats a point as
+// measurement,tag1=v1,tag2=v2 field1=v1,field2=v2 timestamp
+// Tags are sorted by key as recommended by InfluxDB, tags with empty values
+// are left out since line protocol doesn't allow them.
+func makeLine(measurement string, tags map[string]string, fields []field, timestampMs int64) string {
+	tagKeys := make([]string, 0, len(tags))
+	for key, value := range tags {
+		if key != "" && value != "" {
+			tagKeys = append(tagKeys, key)
+		}
+	}
+	sort.Strings(tagKeys)
+
+	var line strings.Builder
+	line.WriteString(measurementEscaper.Replace(measurement))
+	for _, key := range tagKeys {
+		line.WriteString(",")
+		line.WriteString(tagEscaper.Replace(key))
+		line.WriteString("=")
+		line.WriteString(tagEscaper.Replace(tags[key]))
+	}
+	for i, f := range fields {
+		if i == 0 {
+			line.WriteString(" ")
+		} else {
+			line.WriteString(",")
+		}
+		line.WriteString(tagEscaper.Replace(f.key))
+		line.WriteString("=")
+		line.WriteString(f.value)
+	}
+	line.WriteString(" ")
+	line.WriteString(strconv.FormatInt(timestampMs, 10))
+	return line.String()
+}
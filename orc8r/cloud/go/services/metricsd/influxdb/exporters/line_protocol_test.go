@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters_test
+
+import (
+	"testing"
+
+	mxd_exp "magma/orc8r/cloud/go/services/metricsd/exporters"
+	"magma/orc8r/cloud/go/services/metricsd/influxdb/exporters"
+	"magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+var testContext = mxd_exp.MetricsContext{
+	NetworkID:         "nID",
+	GatewayID:         "gID",
+	OriginatingEntity: "nID.gID",
+	MetricName:        "test_metric",
+}
+
+func TestFamilyToLines(t *testing.T) {
+	labels := []*dto.LabelPair{
+		{Name: test_common.MakeStringPointer("service"), Value: test_common.MakeStringPointer("magmad")},
+	}
+
+	counter := test_common.MakePromoCounter(42)
+	counter.Label = labels
+	family := &dto.MetricFamily{Type: dto.MetricType_COUNTER.Enum(), Metric: []*dto.Metric{&counter}}
+	assert.Equal(
+		t,
+		[]string{"test_metric,gatewayID=gID,networkID=nID,originatingEntity=nID.gID,service=magmad value=42 1000"},
+		exporters.FamilyToLines(family, testContext, 1000),
+	)
+
+	gauge := test_common.MakePromoGauge(1.5)
+	gauge.TimestampMs = func(ts int64) *int64 { return &ts }(2000)
+	family = &dto.MetricFamily{Type: dto.MetricType_GAUGE.Enum(), Metric: []*dto.Metric{&gauge}}
+	assert.Equal(
+		t,
+		[]string{"test_metric,gatewayID=gID,networkID=nID,originatingEntity=nID.gID value=1.5 2000"},
+		exporters.FamilyToLines(family, testContext, 1000),
+	)
+
+	summary := test_common.MakePromoSummary(map[float64]float64{0.5: 0.05}, []float64{1, 2, 3})
+	family = &dto.MetricFamily{Type: dto.MetricType_SUMMARY.Enum(), Metric: []*dto.Metric{&summary}}
+	assert.Equal(
+		t,
+		[]string{"test_metric,gatewayID=gID,networkID=nID,originatingEntity=nID.gID count=3i,sum=6,0.5=2 1000"},
+		exporters.FamilyToLines(family, testContext, 1000),
+	)
+
+	histogram := test_common.MakePromoHistogram([]float64{1, 5}, []float64{0.5, 3, 7})
+	family = &dto.MetricFamily{Type: dto.MetricType_HISTOGRAM.Enum(), Metric: []*dto.Metric{&histogram}}
+	assert.Equal(
+		t,
+		[]string{"test_metric,gatewayID=gID,networkID=nID,originatingEntity=nID.gID count=3i,sum=10.5,1=1i,5=2i 1000"},
+		exporters.FamilyToLines(family, testContext, 1000),
+	)
+
+	// Special characters are escaped, empty tags and NaN values are left out
+	emptySummary := test_common.MakePromoSummary(map[float64]float64{0.5: 0.05}, []float64{})
+	emptySummary.Label = []*dto.LabelPair{
+		{Name: test_common.MakeStringPointer("a key"), Value: test_common.MakeStringPointer("x=1,y")},
+	}
+	family = &dto.MetricFamily{Type: dto.MetricType_SUMMARY.Enum(), Metric: []*dto.Metric{&emptySummary}}
+	ctx := mxd_exp.MetricsContext{NetworkID: "nID", MetricName: "my metric,1"}
+	assert.Equal(
+		t,
+		[]string{`my\ metric\,1,a\ key=x\=1\,y,networkID=nID count=0i,sum=0 1000`},
+		exporters.FamilyToLines(family, ctx, 1000),
+	)
+}
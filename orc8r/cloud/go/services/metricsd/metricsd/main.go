@@ -17,6 +17,7 @@ import (
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/metricsd"
+	"magma/orc8r/cloud/go/services/metricsd/aggregation"
 	"magma/orc8r/cloud/go/services/metricsd/collection"
 	"magma/orc8r/cloud/go/services/metricsd/confignames"
 	"magma/orc8r/cloud/go/services/metricsd/servicers"
@@ -44,6 +45,15 @@ func main() {
 		log.Fatalf("Error loading metrics profile: %s", err)
 	}
 
+	aggregationRules, err := aggregation.GetRules(srv.Config)
+	if err != nil {
+		log.Fatalf("Error loading aggregation rules: %s", err)
+	}
+	var aggregator *aggregation.Aggregator
+	if len(aggregationRules) > 0 {
+		aggregator = controllerServer.EnableAggregation(aggregationRules)
+	}
+
 	// Initialize metrics gatherer
 	metricsChannel := make(chan *io_prometheus_client.MetricFamily)
 	gatherer, err := collection.NewMetricsGatherer(selectedProfile.Collectors, CloudMetricsCollectInterval, metricsChannel)
@@ -58,6 +68,9 @@ func main() {
 		controllerServer.RegisterExporter(exporter)
 		exporter.Start()
 	}
+	if aggregator != nil {
+		aggregator.Start()
+	}
 
 	err = srv.Run()
 	if err != nil {
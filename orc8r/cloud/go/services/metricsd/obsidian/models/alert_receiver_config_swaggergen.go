@@ -23,28 +23,74 @@ type AlertReceiverConfig struct {
 	// Required: true
 	Name *string `json:"name"`
 
+	// email configs
+	EmailConfigs []*EmailReceiver `json:"email_configs"`
+
+	// pagerduty configs
+	PagerdutyConfigs []*PagerdutyReceiver `json:"pagerduty_configs"`
+
 	// slack configs
 	SLACKConfigs []*SLACKReceiver `json:"slack_configs"`
+
+	// webhook configs
+	WebhookConfigs []*WebhookReceiver `json:"webhook_configs"`
 }
 
 // Validate validates this alert receiver config
 func (m *AlertReceiverConfig) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEmailConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePagerdutyConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSLACKConfigs(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateWebhookConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *AlertReceiverConfig) validateEmailConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EmailConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.EmailConfigs); i++ {
+		if swag.IsZero(m.EmailConfigs[i]) { // not required
+			continue
+		}
+
+		if m.EmailConfigs[i] != nil {
+			if err := m.EmailConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("email_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *AlertReceiverConfig) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -54,6 +100,31 @@ func (m *AlertReceiverConfig) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *AlertReceiverConfig) validatePagerdutyConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PagerdutyConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PagerdutyConfigs); i++ {
+		if swag.IsZero(m.PagerdutyConfigs[i]) { // not required
+			continue
+		}
+
+		if m.PagerdutyConfigs[i] != nil {
+			if err := m.PagerdutyConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pagerduty_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *AlertReceiverConfig) validateSLACKConfigs(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.SLACKConfigs) { // not required
@@ -79,6 +150,31 @@ func (m *AlertReceiverConfig) validateSLACKConfigs(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *AlertReceiverConfig) validateWebhookConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.WebhookConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.WebhookConfigs); i++ {
+		if swag.IsZero(m.WebhookConfigs[i]) { // not required
+			continue
+		}
+
+		if m.WebhookConfigs[i] != nil {
+			if err := m.WebhookConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("webhook_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *AlertReceiverConfig) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package receivers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const baseConfig = `
+route:
+  receiver: null_receiver
+receivers:
+  - name: null_receiver
+`
+
+func TestClient_ReceiverRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "receivers_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configPath := filepath.Join(dir, "alertmanager.yml")
+	assert.NoError(t, ioutil.WriteFile(configPath, []byte(baseConfig), 0660))
+
+	requireTLS := true
+	receiver := Receiver{
+		Name: "oncall",
+		SlackConfigs: []*SlackConfig{
+			{APIURL: "https://hooks.slack.com/services/secret", Channel: "#alerts"},
+		},
+		EmailConfigs: []*EmailConfig{
+			{
+				To:           "oncall@example.com",
+				From:         "magma@example.com",
+				Smarthost:    "smtp.example.com:587",
+				AuthUsername: "magma",
+				AuthPassword: "smtp-password",
+				Headers:      map[string]string{"Subject": "Magma alert"},
+				RequireTLS:   &requireTLS,
+			},
+		},
+		WebhookConfigs: []*WebhookConfig{
+			{URL: "https://hooks.example.com/alerts"},
+		},
+		PagerDutyConfigs: []*PagerDutyConfig{
+			{RoutingKey: "pagerduty-routing-key", Severity: "critical", Details: map[string]string{"network": "test"}},
+		},
+	}
+	expected := receiver
+
+	client := NewClient(configPath)
+	err = client.CreateReceiver(&receiver, "test")
+	assert.NoError(t, err)
+
+	recs, err := client.GetReceivers("test")
+	assert.NoError(t, err)
+	assert.Equal(t, []Receiver{expected}, recs)
+
+	// Secrets must be written as is for alertmanager to use them
+	amConfig, _, err := config.LoadFile(configPath)
+	assert.NoError(t, err)
+	var amReceiver *config.Receiver
+	for _, rcv := range amConfig.Receivers {
+		if rcv.Name == "test_oncall" {
+			amReceiver = rcv
+		}
+	}
+	if !assert.NotNil(t, amReceiver) {
+		return
+	}
+	assert.Equal(t, "https://hooks.slack.com/services/secret", amReceiver.SlackConfigs[0].APIURL.String())
+	assert.Equal(t, "smtp-password", string(amReceiver.EmailConfigs[0].AuthPassword))
+	assert.Equal(t, "https://hooks.example.com/alerts", amReceiver.WebhookConfigs[0].URL.String())
+	assert.Equal(t, "pagerduty-routing-key", string(amReceiver.PagerdutyConfigs[0].RoutingKey))
+
+	// Invalid receivers aren't written
+	invalid := Receiver{Name: "invalid", WebhookConfigs: []*WebhookConfig{{URL: "hooks.example.com"}}}
+	err = client.CreateReceiver(&invalid, "test")
+	assert.Error(t, err)
+	recs, err = client.GetReceivers("test")
+	assert.NoError(t, err)
+	assert.Len(t, recs, 1)
+}
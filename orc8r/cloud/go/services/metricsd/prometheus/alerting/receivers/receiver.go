@@ -10,6 +10,8 @@ package receivers
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"strings"
 
 	"magma/orc8r/cloud/go/services/metricsd/prometheus/exporters"
@@ -70,11 +72,8 @@ func (c *Config) Validate() error {
 		if _, ok := receiverNames[rcv.Name]; ok {
 			return fmt.Errorf("notification config name %s is not unique", rcv.Name)
 		}
-		for _, sc := range rcv.SlackConfigs {
-			err := validateURL(sc.APIURL)
-			if err != nil {
-				return err
-			}
+		if err := rcv.validate(c.Global); err != nil {
+			return fmt.Errorf("receiver %s: %v", rcv.Name, err)
 		}
 		receiverNames[rcv.Name] = struct{}{}
 	}
@@ -99,6 +98,22 @@ func validateURL(url string) error {
 	return nil
 }
 
+// validateHTTPURL is a stricter validateURL for configs alertmanager parses
+// as URLs
+func validateHTTPURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid url %s: %v", rawURL, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q for url %s", parsed.Scheme, rawURL)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("missing host for url %s", rawURL)
+	}
+	return nil
+}
+
 // checkReceiver returns an error if a node in the routing tree
 // references a receiver not in the given map.
 func checkReceiver(r *config.Route, receivers map[string]struct{}) error {
@@ -120,7 +135,38 @@ func checkReceiver(r *config.Route, receivers map[string]struct{}) error {
 type Receiver struct {
 	Name string `yaml:"name" json:"name"`
 
-	SlackConfigs []*SlackConfig `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
+	SlackConfigs     []*SlackConfig     `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
+	EmailConfigs     []*EmailConfig     `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
+	WebhookConfigs   []*WebhookConfig   `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
+	PagerDutyConfigs []*PagerDutyConfig `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
+}
+
+// validate checks the receiver's notifier configs the same way alertmanager
+// does when loading its config. Email configs fall back to the SMTP settings
+// of global, which may be nil.
+func (r *Receiver) validate(global *config.GlobalConfig) error {
+	for _, sc := range r.SlackConfigs {
+		err := validateURL(sc.APIURL)
+		if err != nil {
+			return err
+		}
+	}
+	for _, ec := range r.EmailConfigs {
+		if err := ec.validate(global); err != nil {
+			return err
+		}
+	}
+	for _, wc := range r.WebhookConfigs {
+		if err := wc.validate(); err != nil {
+			return err
+		}
+	}
+	for _, pc := range r.PagerDutyConfigs {
+		if err := pc.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Secure replaces the receiver's name with a networkID prefix
@@ -152,3 +198,94 @@ type SlackConfig struct {
 	Channel  string `yaml:"channel" json:"channel"`
 	Username string `yaml:"username" json:"username"`
 }
+
+// EmailConfig uses strings instead of Secrets for the AuthPassword and
+// AuthSecret fields, see SlackConfig. Smarthost and From default to the
+// global smtp_smarthost and smtp_from.
+type EmailConfig struct {
+	To           string            `yaml:"to" json:"to"`
+	From         string            `yaml:"from,omitempty" json:"from,omitempty"`
+	Hello        string            `yaml:"hello,omitempty" json:"hello,omitempty"`
+	Smarthost    string            `yaml:"smarthost,omitempty" json:"smarthost,omitempty"`
+	AuthUsername string            `yaml:"auth_username,omitempty" json:"auth_username,omitempty"`
+	AuthPassword string            `yaml:"auth_password,omitempty" json:"auth_password,omitempty"`
+	AuthSecret   string            `yaml:"auth_secret,omitempty" json:"auth_secret,omitempty"`
+	AuthIdentity string            `yaml:"auth_identity,omitempty" json:"auth_identity,omitempty"`
+	Headers      map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`
+	HTML         string            `yaml:"html,omitempty" json:"html,omitempty"`
+	Text         string            `yaml:"text,omitempty" json:"text,omitempty"`
+	RequireTLS   *bool             `yaml:"require_tls,omitempty" json:"require_tls,omitempty"`
+}
+
+func (ec *EmailConfig) validate(global *config.GlobalConfig) error {
+	if ec.To == "" {
+		return fmt.Errorf("missing to address in email config")
+	}
+	smarthost, from := ec.Smarthost, ec.From
+	if global != nil {
+		if smarthost == "" {
+			smarthost = global.SMTPSmarthost
+		}
+		if from == "" {
+			from = global.SMTPFrom
+		}
+	}
+	if smarthost == "" {
+		return fmt.Errorf("no smarthost in email config and no global smtp_smarthost")
+	}
+	if _, _, err := net.SplitHostPort(smarthost); err != nil {
+		return fmt.Errorf("invalid smarthost %s: %v", smarthost, err)
+	}
+	if from == "" {
+		return fmt.Errorf("no from address in email config and no global smtp_from")
+	}
+	// Header names are case insensitive
+	normalizedHeaders := map[string]struct{}{}
+	for header := range ec.Headers {
+		normalized := strings.Title(header)
+		if _, ok := normalizedHeaders[normalized]; ok {
+			return fmt.Errorf("duplicate header %q in email config", normalized)
+		}
+		normalizedHeaders[normalized] = struct{}{}
+	}
+	return nil
+}
+
+// WebhookConfig sends alerts as JSON to URL
+type WebhookConfig struct {
+	URL string `yaml:"url" json:"url"`
+}
+
+func (wc *WebhookConfig) validate() error {
+	if wc.URL == "" {
+		return fmt.Errorf("missing url in webhook config")
+	}
+	return validateHTTPURL(wc.URL)
+}
+
+// PagerDutyConfig uses strings instead of Secrets for the ServiceKey and
+// RoutingKey fields, see SlackConfig. ServiceKey is used for the PagerDuty
+// Events API v1 and RoutingKey for v2, one of them must be set.
+type PagerDutyConfig struct {
+	ServiceKey  string            `yaml:"service_key,omitempty" json:"service_key,omitempty"`
+	RoutingKey  string            `yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
+	URL         string            `yaml:"url,omitempty" json:"url,omitempty"`
+	Client      string            `yaml:"client,omitempty" json:"client,omitempty"`
+	ClientURL   string            `yaml:"client_url,omitempty" json:"client_url,omitempty"`
+	Description string            `yaml:"description,omitempty" json:"description,omitempty"`
+	Details     map[string]string `yaml:"details,omitempty" json:"details,omitempty"`
+	Severity    string            `yaml:"severity,omitempty" json:"severity,omitempty"`
+	Class       string            `yaml:"class,omitempty" json:"class,omitempty"`
+	Component   string            `yaml:"component,omitempty" json:"component,omitempty"`
+	Group       string            `yaml:"group,omitempty" json:"group,omitempty"`
+}
+
+func (pc *PagerDutyConfig) validate() error {
+	if pc.ServiceKey == "" && pc.RoutingKey == "" {
+		return fmt.Errorf("missing service or routing key in PagerDuty config")
+	}
+	if pc.URL != "" {
+		return validateHTTPURL(pc.URL)
+	}
+	return nil
+}
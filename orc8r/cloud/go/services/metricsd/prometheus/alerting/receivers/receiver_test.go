@@ -62,4 +62,104 @@ func TestConfig_Validate(t *testing.T) {
 	}
 	err = invalidSlackConfig.Validate()
 	assert.Error(t, err)
+
+	testCases := []struct {
+		name     string
+		receiver Receiver
+		global   *config.GlobalConfig
+		valid    bool
+	}{
+		{
+			name: "valid email",
+			receiver: Receiver{Name: "rcv", EmailConfigs: []*EmailConfig{
+				{To: "oncall@example.com", From: "magma@example.com", Smarthost: "smtp.example.com:587", AuthPassword: "password"},
+			}},
+			valid: true,
+		},
+		{
+			name: "email using global smtp settings",
+			receiver: Receiver{Name: "rcv", EmailConfigs: []*EmailConfig{
+				{To: "oncall@example.com"},
+			}},
+			global: &config.GlobalConfig{SMTPSmarthost: "smtp.example.com:25", SMTPFrom: "magma@example.com"},
+			valid:  true,
+		},
+		{
+			name: "email without to",
+			receiver: Receiver{Name: "rcv", EmailConfigs: []*EmailConfig{
+				{From: "magma@example.com", Smarthost: "smtp.example.com:587"},
+			}},
+		},
+		{
+			name: "email without smarthost",
+			receiver: Receiver{Name: "rcv", EmailConfigs: []*EmailConfig{
+				{To: "oncall@example.com", From: "magma@example.com"},
+			}},
+			global: &defaultGlobalConf,
+		},
+		{
+			name: "email smarthost without port",
+			receiver: Receiver{Name: "rcv", EmailConfigs: []*EmailConfig{
+				{To: "oncall@example.com", From: "magma@example.com", Smarthost: "smtp.example.com"},
+			}},
+		},
+		{
+			name: "email duplicate headers",
+			receiver: Receiver{Name: "rcv", EmailConfigs: []*EmailConfig{
+				{To: "oncall@example.com", From: "magma@example.com", Smarthost: "smtp.example.com:587", Headers: map[string]string{"subject": "a", "Subject": "b"}},
+			}},
+		},
+		{
+			name: "valid webhook",
+			receiver: Receiver{Name: "rcv", WebhookConfigs: []*WebhookConfig{
+				{URL: "https://hooks.example.com/alerts"},
+			}},
+			valid: true,
+		},
+		{
+			name: "webhook without url",
+			receiver: Receiver{Name: "rcv", WebhookConfigs: []*WebhookConfig{
+				{},
+			}},
+		},
+		{
+			name: "webhook with unsupported scheme",
+			receiver: Receiver{Name: "rcv", WebhookConfigs: []*WebhookConfig{
+				{URL: "ftp://hooks.example.com/alerts"},
+			}},
+		},
+		{
+			name: "valid pagerduty",
+			receiver: Receiver{Name: "rcv", PagerDutyConfigs: []*PagerDutyConfig{
+				{RoutingKey: "0123456789abcdef", Severity: "critical"},
+			}},
+			valid: true,
+		},
+		{
+			name: "pagerduty without key",
+			receiver: Receiver{Name: "rcv", PagerDutyConfigs: []*PagerDutyConfig{
+				{Description: "no key"},
+			}},
+		},
+		{
+			name: "pagerduty with invalid url",
+			receiver: Receiver{Name: "rcv", PagerDutyConfigs: []*PagerDutyConfig{
+				{ServiceKey: "0123456789abcdef", URL: "events.pagerduty.com"},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		receiver := tc.receiver
+		conf := Config{
+			Route:     &config.Route{Receiver: "rcv"},
+			Receivers: []*Receiver{&receiver},
+			Global:    tc.global,
+		}
+		err := conf.Validate()
+		if tc.valid {
+			assert.NoError(t, err, tc.name)
+		} else {
+			assert.Error(t, err, tc.name)
+		}
+	}
 }
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	obsidian_config "magma/orc8r/cloud/go/obsidian/config"
+	alerthandlers "magma/orc8r/cloud/go/services/metricsd/prometheus/alerting/handlers"
+	"magma/orc8r/cloud/go/services/metricsd/prometheus/alerting/receivers"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAlertmanagerConfig = `
+route:
+  receiver: null_receiver
+receivers:
+  - name: null_receiver
+`
+
+func TestAlertReceiverHandlers_RoundTrip(t *testing.T) {
+	obsidian_config.TLS = false // To bypass access control
+
+	dir, err := ioutil.TempDir("", "alert_receiver_handlers_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configPath := filepath.Join(dir, "alertmanager.yml")
+	assert.NoError(t, ioutil.WriteFile(configPath, []byte(testAlertmanagerConfig), 0660))
+
+	alertmanager := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer alertmanager.Close()
+
+	// Alert config web server as run alongside alertmanager
+	receiverClient := receivers.NewClient(configPath)
+	configServer := echo.New()
+	configServer.POST("/:network_id/receiver", alerthandlers.GetReceiverPostHandler(receiverClient, strings.TrimPrefix(alertmanager.URL, "http://")))
+	configServer.GET("/:network_id/receiver", alerthandlers.GetGetReceiversHandler(receiverClient))
+	webServer := httptest.NewServer(configServer)
+	defer webServer.Close()
+
+	receiverJSON := `{
+		"name": "oncall",
+		"slack_configs": [{"api_url": "https://hooks.slack.com/services/secret", "channel": "#alerts", "username": "magma"}],
+		"email_configs": [{"to": "oncall@example.com", "from": "magma@example.com", "smarthost": "smtp.example.com:587", "auth_password": "smtp-password"}],
+		"webhook_configs": [{"url": "https://hooks.example.com/alerts"}],
+		"pagerduty_configs": [{"routing_key": "pagerduty-routing-key", "severity": "critical"}]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBufferString(receiverJSON))
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues("network1")
+	err = GetConfigureAlertReceiverHandler(webServer.URL)(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(echo.GET, "/", nil)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues("network1")
+	err = GetRetrieveAlertReceiverHandler(webServer.URL)(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var expected receivers.Receiver
+	assert.NoError(t, json.Unmarshal([]byte(receiverJSON), &expected))
+	var actual []receivers.Receiver
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+	assert.Equal(t, []receivers.Receiver{expected}, actual)
+
+	// Invalid receivers are rejected by the web server
+	req = httptest.NewRequest(echo.POST, "/", bytes.NewBufferString(`{"name": "invalid", "pagerduty_configs": [{"severity": "critical"}]}`))
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues("network1")
+	err = GetConfigureAlertReceiverHandler(webServer.URL)(c)
+	assert.Error(t, err)
+}
@@ -17,6 +17,7 @@ import (
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/magmad"
+	"magma/orc8r/cloud/go/services/metricsd/aggregation"
 	"magma/orc8r/cloud/go/services/metricsd/exporters"
 
 	"github.com/golang/glog"
@@ -26,6 +27,8 @@ import (
 
 type MetricsControllerServer struct {
 	exporters []exporters.Exporter
+	// aggregator is nil unless aggregation is enabled
+	aggregator *aggregation.Aggregator
 }
 
 func NewMetricsControllerServer() *MetricsControllerServer {
@@ -45,12 +48,7 @@ func (srv *MetricsControllerServer) Collect(ctx context.Context, in *protos.Metr
 	glog.V(2).Infof("collecting %v metrics from gateway %v\n", len(in.Family), in.GatewayId)
 
 	metricsToSubmit := metricsContainerToMetricAndContexts(in, networkID, hardwareID, gatewayID)
-	for _, e := range srv.exporters {
-		err := e.Submit(metricsToSubmit)
-		if err != nil {
-			glog.Error(err)
-		}
-	}
+	srv.submit(srv.aggregate(metricsToSubmit))
 	return new(protos.Void), nil
 }
 
@@ -59,27 +57,22 @@ func (srv *MetricsControllerServer) Collect(ctx context.Context, in *protos.Metr
 // forever.
 func (srv *MetricsControllerServer) ConsumeCloudMetrics(inputChan chan *prometheus_proto.MetricFamily, hostName string) error {
 	for family := range inputChan {
-		for _, e := range srv.exporters {
-			decodedName := protos.GetDecodedName(family)
-			networkID, gatewayID := unpackCloudMetricName(decodedName)
-			if networkID == "" {
-				networkID = exporters.CloudMetricID
-			}
-			if gatewayID == "" {
-				gatewayID = hostName
-			}
-			ctx := exporters.MetricsContext{
-				MetricName:        removeCloudMetricLabels(decodedName),
-				NetworkID:         networkID,
-				GatewayID:         gatewayID,
-				OriginatingEntity: networkID + "." + gatewayID,
-				DecodedName:       decodedName,
-			}
-			err := e.Submit([]exporters.MetricAndContext{{Family: family, Context: ctx}})
-			if err != nil {
-				glog.Error(err)
-			}
+		decodedName := protos.GetDecodedName(family)
+		networkID, gatewayID := unpackCloudMetricName(decodedName)
+		if networkID == "" {
+			networkID = exporters.CloudMetricID
+		}
+		if gatewayID == "" {
+			gatewayID = hostName
 		}
+		ctx := exporters.MetricsContext{
+			MetricName:        removeCloudMetricLabels(decodedName),
+			NetworkID:         networkID,
+			GatewayID:         gatewayID,
+			OriginatingEntity: networkID + "." + gatewayID,
+			DecodedName:       decodedName,
+		}
+		srv.submit(srv.aggregate([]exporters.MetricAndContext{{Family: family, Context: ctx}}))
 	}
 	return nil
 }
@@ -89,6 +82,34 @@ func (srv *MetricsControllerServer) RegisterExporter(e exporters.Exporter) []exp
 	return srv.exporters
 }
 
+// EnableAggregation routes metrics through an aggregation stage configured
+// by rules before they are submitted to the exporters. The returned
+// Aggregator must be started to periodically submit its aggregates.
+func (srv *MetricsControllerServer) EnableAggregation(rules []aggregation.Rule) *aggregation.Aggregator {
+	srv.aggregator = aggregation.NewAggregator(rules, srv.submit)
+	return srv.aggregator
+}
+
+// aggregate returns the metrics which bypass aggregation
+func (srv *MetricsControllerServer) aggregate(metrics []exporters.MetricAndContext) []exporters.MetricAndContext {
+	if srv.aggregator == nil {
+		return metrics
+	}
+	return srv.aggregator.Process(metrics)
+}
+
+func (srv *MetricsControllerServer) submit(metrics []exporters.MetricAndContext) {
+	if len(metrics) == 0 {
+		return
+	}
+	for _, e := range srv.exporters {
+		err := e.Submit(metrics)
+		if err != nil {
+			glog.Error(err)
+		}
+	}
+}
+
 func (srv *MetricsControllerServer) getNetworkAndGatewayID(hardwareID string) (string, string, error) {
 	if len(hardwareID) == 0 {
 		return "", "", errors.New("Empty Hardware ID")
@@ -19,6 +19,7 @@ import (
 	"magma/orc8r/cloud/go/services/magmad"
 	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
 	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
+	"magma/orc8r/cloud/go/services/metricsd/aggregation"
 	"magma/orc8r/cloud/go/services/metricsd/exporters"
 	"magma/orc8r/cloud/go/services/metricsd/servicers"
 
@@ -200,3 +201,37 @@ func TestConsume(t *testing.T) {
 	time.Sleep(time.Second)
 	assert.Equal(t, 2, len(e.queue))
 }
+
+func TestConsumeWithAggregation(t *testing.T) {
+	metricsChan := make(chan *dto.MetricFamily)
+	e := &testMetricExporter{}
+
+	srv := servicers.NewMetricsControllerServer()
+	srv.RegisterExporter(e)
+	aggregator := srv.EnableAggregation([]aggregation.Rule{
+		{MetricName: "test1", Window: time.Minute, Rollups: []aggregation.Func{aggregation.Sum}},
+	})
+
+	go srv.ConsumeCloudMetrics(metricsChan, "Host_name_place_holder")
+	fam1 := "test1_gatewayId_gw1_networkId_nw1"
+	fam2 := "test1_gatewayId_gw2_networkId_nw1"
+	fam3 := "test2"
+	gaugeType := dto.MetricType_GAUGE
+	value1, value2 := 1.0, 2.0
+	go func() {
+		metricsChan <- &dto.MetricFamily{Name: &fam1, Type: &gaugeType, Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: &value1}}}}
+		metricsChan <- &dto.MetricFamily{Name: &fam2, Type: &gaugeType, Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: &value2}}}}
+		metricsChan <- &dto.MetricFamily{Name: &fam3, Metric: []*dto.Metric{{}}}
+	}()
+	time.Sleep(time.Second)
+
+	// Only the metric without a rule is exported right away
+	assert.Equal(t, 1, len(e.queue))
+	e.queue = e.queue[:0]
+
+	aggregator.Flush()
+	assert.Equal(t, 1, len(e.queue))
+	assert.Equal(t, "3", e.queue[0].Value())
+	assert.Equal(t, "test1_sum", e.queue[0].Name())
+	assert.Equal(t, "nw1.network_aggregate", e.queue[0].Entity())
+}
@@ -106,15 +106,29 @@ func GetStates(networkID string, stateIDs []StateID) (map[StateID]StateValue, er
 	if err != nil {
 		return nil, err
 	}
+	return toStateValuesByID(res.States), nil
+}
 
-	idToValue := map[StateID]StateValue{}
-	for _, state := range res.States {
-		stateID := StateID{Type: state.Type, DeviceID: state.DeviceID}
-		stateValue := StateValue{}
-		json.Unmarshal(state.Value, &stateValue)
-		idToValue[stateID] = stateValue
+// SearchStates returns a map of all states on the network of the given types
+// whose device IDs start with keyPrefix. Empty types or keyPrefix don't
+// filter anything.
+func SearchStates(networkID string, types []string, keyPrefix string) (map[StateID]StateValue, error) {
+	client, err := getStateClient()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.SearchStates(
+		context.Background(), &protos.SearchStatesRequest{
+			NetworkID: networkID,
+			Types:     types,
+			KeyPrefix: keyPrefix,
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
-	return idToValue, nil
+	return toStateValuesByID(res.States), nil
 }
 
 // DeleteStates deletes states specified by the networkID and a list of type and key
@@ -133,6 +147,17 @@ func DeleteStates(networkID string, stateIDs []StateID) error {
 	return err
 }
 
+func toStateValuesByID(states []*protos.State) map[StateID]StateValue {
+	idToValue := map[StateID]StateValue{}
+	for _, state := range states {
+		stateID := StateID{Type: state.Type, DeviceID: state.DeviceID}
+		stateValue := StateValue{}
+		json.Unmarshal(state.Value, &stateValue)
+		idToValue[stateID] = stateValue
+	}
+	return idToValue
+}
+
 func toProtosStateIDs(stateIDs []StateID) []*protos.StateID {
 	ids := []*protos.StateID{}
 	for _, state := range stateIDs {
@@ -37,6 +37,14 @@ func ValidateDeleteStatesRequest(req *protos.DeleteStatesRequest) error {
 	return nil
 }
 
+// ValidateSearchStatesRequest checks that all required fields exist
+func ValidateSearchStatesRequest(req *protos.SearchStatesRequest) error {
+	if len(req.GetNetworkID()) == 0 {
+		return errors.New("Network ID must be specified")
+	}
+	return nil
+}
+
 func validateStates(req *protos.ReportStatesRequest) error {
 	states := req.GetStates()
 	if states == nil || len(states) == 0 {
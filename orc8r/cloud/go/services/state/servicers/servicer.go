@@ -24,14 +24,16 @@ import (
 
 type stateServicer struct {
 	factory blobstore.BlobStorageFactory
+	ttls    StateTTLs
 }
 
-// NewStateServicer returns a state server backed by storage passed in
-func NewStateServicer(factory blobstore.BlobStorageFactory) (protos.StateServiceServer, error) {
+// NewStateServicer returns a state server backed by storage passed in.
+// Expired states according to ttls are never returned by the server.
+func NewStateServicer(factory blobstore.BlobStorageFactory, ttls StateTTLs) (protos.StateServiceServer, error) {
 	if factory == nil {
 		return nil, fmt.Errorf("Storage factory is nil")
 	}
-	return &stateServicer{factory}, nil
+	return &stateServicer{factory: factory, ttls: ttls}, nil
 }
 
 // GetStates retrieves states from blobstorage
@@ -48,6 +50,35 @@ func (srv *stateServicer) GetStates(context context.Context, req *protos.GetStat
 	}
 	states, err := store.GetMany(req.GetNetworkID(), ids)
 	store.Commit()
+	states = srv.ttls.filterExpired(states, time.Now())
+	return &protos.GetStatesResponse{States: protos.BlobsToStates(states)}, nil
+}
+
+// SearchStates retrieves all states on a network matching the request from
+// blobstorage
+func (srv *stateServicer) SearchStates(context context.Context, req *protos.SearchStatesRequest) (*protos.GetStatesResponse, error) {
+	if err := ValidateSearchStatesRequest(req); err != nil {
+		return nil, err
+	}
+
+	networkID := req.GetNetworkID()
+	filter := blobstore.SearchFilter{NetworkID: &networkID, Types: req.GetTypes()}
+	if req.GetKeyPrefix() != "" {
+		keyPrefix := req.GetKeyPrefix()
+		filter.KeyPrefix = &keyPrefix
+	}
+
+	store, err := srv.factory.StartTransaction()
+	if err != nil {
+		return nil, err
+	}
+	blobsByNetwork, err := store.Search(filter)
+	if err != nil {
+		store.Rollback()
+		return nil, err
+	}
+	store.Commit()
+	states := srv.ttls.filterExpired(blobsByNetwork[networkID], time.Now())
 	return &protos.GetStatesResponse{States: protos.BlobsToStates(states)}, nil
 }
 
@@ -0,0 +1,95 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"encoding/json"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	stateservice "magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+)
+
+// StateTTLs maps state types to how long states of that type are kept after
+// they were last reported. States of types without a TTL never expire.
+type StateTTLs map[string]time.Duration
+
+// isExpired returns true if the state blob outlived the TTL of its type.
+func (ttls StateTTLs) isExpired(blob blobstore.Blob, now time.Time) bool {
+	ttl, ok := ttls[blob.Type]
+	if !ok {
+		return false
+	}
+	value := stateservice.StateValue{}
+	if err := json.Unmarshal(blob.Value, &value); err != nil {
+		glog.Errorf("Failed to unmarshal state (%s, %s): %s", blob.Type, blob.Key, err)
+		return false
+	}
+	reportTime := time.Unix(0, int64(value.Time)*int64(time.Millisecond))
+	return now.Sub(reportTime) > ttl
+}
+
+// filterExpired returns the blobs which haven't expired yet.
+func (ttls StateTTLs) filterExpired(blobs []blobstore.Blob, now time.Time) []blobstore.Blob {
+	if len(ttls) == 0 {
+		return blobs
+	}
+	ret := make([]blobstore.Blob, 0, len(blobs))
+	for _, blob := range blobs {
+		if !ttls.isExpired(blob, now) {
+			ret = append(ret, blob)
+		}
+	}
+	return ret
+}
+
+// DeleteExpiredStates deletes the states on all networks which outlived the
+// TTL of their type. Services should call this periodically so states
+// reported by decommissioned gateways don't stay around forever.
+func DeleteExpiredStates(factory blobstore.BlobStorageFactory, ttls StateTTLs) error {
+	if len(ttls) == 0 {
+		return nil
+	}
+	types := make([]string, 0, len(ttls))
+	for t := range ttls {
+		types = append(types, t)
+	}
+
+	store, err := factory.StartTransaction()
+	if err != nil {
+		return err
+	}
+	blobsByNetwork, err := store.Search(blobstore.SearchFilter{Types: types})
+	if err != nil {
+		store.Rollback()
+		return err
+	}
+
+	now := time.Now()
+	for networkID, blobs := range blobsByNetwork {
+		var expiredIDs []storage.TypeAndKey
+		for _, blob := range blobs {
+			if ttls.isExpired(blob, now) {
+				expiredIDs = append(expiredIDs, storage.TypeAndKey{Type: blob.Type, Key: blob.Key})
+			}
+		}
+		if len(expiredIDs) == 0 {
+			continue
+		}
+		if err := store.Delete(networkID, expiredIDs); err != nil {
+			store.Rollback()
+			return err
+		}
+		glog.V(2).Infof("Deleted %d expired states on network %s", len(expiredIDs), networkID)
+	}
+	return store.Commit()
+}
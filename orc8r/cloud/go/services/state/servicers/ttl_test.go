@@ -0,0 +1,87 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/protos"
+	stateservice "magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/servicers"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestStateTTLs(t *testing.T) {
+	factory := blobstore.NewMemoryBlobStorageFactory()
+	ttls := servicers.StateTTLs{"gw_state": time.Hour}
+	srv, err := servicers.NewStateServicer(factory, ttls)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	store, err := factory.StartTransaction()
+	assert.NoError(t, err)
+	err = store.CreateOrUpdate("network1", []blobstore.Blob{
+		makeStateBlob(t, "gw_state", "fresh", now.Add(-time.Minute)),
+		makeStateBlob(t, "gw_state", "stale", now.Add(-2*time.Hour)),
+		makeStateBlob(t, "other", "old", now.Add(-24*time.Hour)),
+	})
+	assert.NoError(t, err)
+	err = store.CreateOrUpdate("network2", []blobstore.Blob{
+		makeStateBlob(t, "gw_state", "stale", now.Add(-2*time.Hour)),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	// Expired states aren't returned even before they are deleted
+	res, err := srv.SearchStates(context.Background(), &protos.SearchStatesRequest{NetworkID: "network1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fresh", "old"}, getDeviceIDs(res.States))
+	res, err = srv.GetStates(context.Background(), &protos.GetStatesRequest{
+		NetworkID: "network1",
+		Ids:       []*protos.StateID{{Type: "gw_state", DeviceID: "stale"}},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, res.States)
+
+	// Expired states are deleted on all networks, types without a TTL are kept
+	err = servicers.DeleteExpiredStates(factory, ttls)
+	assert.NoError(t, err)
+	store, err = factory.StartTransaction()
+	assert.NoError(t, err)
+	blobsByNetwork, err := store.Search(blobstore.SearchFilter{})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+	assert.Equal(t, 1, len(blobsByNetwork))
+	assert.Equal(t, []string{"fresh", "old"}, getDeviceIDs(protos.BlobsToStates(blobsByNetwork["network1"])))
+
+	_, err = srv.SearchStates(context.Background(), &protos.SearchStatesRequest{})
+	assert.EqualError(t, err, "Network ID must be specified")
+}
+
+func makeStateBlob(t *testing.T, stateType string, key string, reportTime time.Time) blobstore.Blob {
+	value, err := json.Marshal(stateservice.StateValue{
+		ReporterID: key,
+		Time:       uint64(reportTime.UnixNano()) / uint64(time.Millisecond),
+	})
+	assert.NoError(t, err)
+	return blobstore.Blob{Type: stateType, Key: key, Value: value}
+}
+
+func getDeviceIDs(states []*protos.State) []string {
+	ret := make([]string, 0, len(states))
+	for _, state := range states {
+		ret = append(ret, state.DeviceID)
+	}
+	return ret
+}
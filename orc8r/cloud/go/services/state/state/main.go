@@ -10,18 +10,26 @@ package main
 
 import (
 	"database/sql"
+	"time"
 
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/state"
 	"magma/orc8r/cloud/go/services/state/servicers"
 
 	"github.com/golang/glog"
 )
 
+const (
+	stateTTLsKey           = "stateTTLSecs"
+	cleanupIntervalKey     = "expiredStateCleanupIntervalSecs"
+	defaultCleanupInterval = 10 * time.Minute
+)
+
 func main() {
 	srv, err := service.NewOrchestratorService(orc8r.ModuleName, state.ServiceName)
 	if err != nil {
@@ -38,13 +46,61 @@ func main() {
 		glog.Fatalf("Error initializing state database: %s", err)
 	}
 
-	server, err := servicers.NewStateServicer(store)
+	ttls := getStateTTLs(srv.Config)
+	server, err := servicers.NewStateServicer(store, ttls)
 	if err != nil {
 		glog.Fatalf("Error creating state server: %s", err)
 	}
 	protos.RegisterStateServiceServer(srv.GrpcServer, server)
+
+	go deleteExpiredStatesPeriodically(store, ttls, getCleanupInterval(srv.Config))
+
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error running service: %s", err)
 	}
 }
+
+func deleteExpiredStatesPeriodically(store blobstore.BlobStorageFactory, ttls servicers.StateTTLs, interval time.Duration) {
+	if len(ttls) == 0 {
+		return
+	}
+	for range time.Tick(interval) {
+		if err := servicers.DeleteExpiredStates(store, ttls); err != nil {
+			glog.Errorf("Error deleting expired states: %s", err)
+		}
+	}
+}
+
+// getStateTTLs reads the per-type state TTLs from the service config
+func getStateTTLs(cfg *config.ConfigMap) servicers.StateTTLs {
+	ttls := servicers.StateTTLs{}
+	if cfg == nil {
+		return ttls
+	}
+	rawTTLs, ok := cfg.RawMap[stateTTLsKey].(map[interface{}]interface{})
+	if !ok {
+		return ttls
+	}
+	for rawType, rawTTL := range rawTTLs {
+		stateType, typeOk := rawType.(string)
+		ttlSecs, ttlOk := rawTTL.(int)
+		if !typeOk || !ttlOk || ttlSecs <= 0 {
+			glog.Errorf("Ignoring invalid state TTL %v: %v", rawType, rawTTL)
+			continue
+		}
+		ttls[stateType] = time.Duration(ttlSecs) * time.Second
+	}
+	return ttls
+}
+
+func getCleanupInterval(cfg *config.ConfigMap) time.Duration {
+	if cfg == nil {
+		return defaultCleanupInterval
+	}
+	intervalSecs, err := cfg.GetIntParam(cleanupIntervalKey)
+	if err != nil || intervalSecs <= 0 {
+		return defaultCleanupInterval
+	}
+	return time.Duration(intervalSecs) * time.Second
+}
@@ -23,7 +23,7 @@ import (
 func StartTestService(t *testing.T) {
 	factory := blobstore.NewMemoryBlobStorageFactory()
 	srv, lis := test_utils.NewTestService(t, orc8r.ModuleName, state.ServiceName)
-	server, err := servicers.NewStateServicer(factory)
+	server, err := servicers.NewStateServicer(factory, nil)
 	if err != nil {
 		t.Fatalf("Failure to start state test service: %v", err)
 	}
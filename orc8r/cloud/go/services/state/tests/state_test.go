@@ -90,6 +90,22 @@ func TestStateService(t *testing.T) {
 	assert.NoError(t, err)
 	testGetStatesResponse(t, states, bundle2)
 
+	// Search by type and key prefix
+	states, err = state.SearchStates(networkID, nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(states))
+	testGetStatesResponse(t, states, bundle0, bundle1, bundle2)
+	states, err = state.SearchStates(networkID, []string{typeName}, "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(states))
+	testGetStatesResponse(t, states, bundle1)
+	states, err = state.SearchStates(networkID, []string{"otherType"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(states))
+	states, err = state.SearchStates("otherNetwork", nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(states))
+
 	// Delete and read back
 	err = state.DeleteStates(networkID, []state.StateID{bundle0.ID, bundle2.ID})
 	assert.NoError(t, err)
@@ -0,0 +1,57 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package streamer
+
+import (
+	"magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/registry"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func getStreamNotifierClient() (protos.StreamNotifierClient, *grpc.ClientConn, error) {
+	conn, err := registry.GetConnection(ServiceName)
+	if err != nil {
+		initErr := errors.NewInitError(err, ServiceName)
+		glog.Error(initErr)
+		return nil, nil, initErr
+	}
+	return protos.NewStreamNotifierClient(conn), conn, err
+}
+
+// NotifyStreamUpdate tells the streamer that the data behind a stream changed
+// in a network, so that gateways with persistent streams get the change right
+// away. An empty networkID notifies gateways on every network.
+func NotifyStreamUpdate(streamName string, networkID string) error {
+	client, conn, err := getStreamNotifierClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = client.NotifyStreamUpdate(
+		context.Background(),
+		&protos.StreamUpdateNotification{StreamName: streamName, NetworkId: networkID},
+	)
+	return err
+}
+
+// NotifyStreamUpdateAsync calls NotifyStreamUpdate in the background and logs
+// any error. Services which change stream data can use this so that a
+// streamer outage doesn't fail or delay their own requests.
+func NotifyStreamUpdateAsync(streamName string, networkID string) {
+	go func() {
+		if err := NotifyStreamUpdate(streamName, networkID); err != nil {
+			glog.Errorf("Failed to notify streamer of update to stream %s in network %s: %s", streamName, networkID, err)
+		}
+	}()
+}
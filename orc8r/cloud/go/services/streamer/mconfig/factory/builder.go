@@ -9,6 +9,8 @@ LICENSE file in the root directory of this source tree.
 package factory
 
 import (
+	"magma/orc8r/cloud/go/services/configurator/storage"
+
 	"github.com/golang/protobuf/proto"
 )
 
@@ -20,3 +22,28 @@ type MconfigBuilder interface {
 	// modules.
 	Build(networkId string, gatewayId string) (map[string]proto.Message, error)
 }
+
+// GraphMconfigBuilder is an MconfigBuilder which can also build its partial
+// mconfig from configurator's view of a network instead of loading configs
+// from the legacy config service.
+type GraphMconfigBuilder interface {
+	MconfigBuilder
+
+	// BuildFromGraph builds a partial mconfig for the gateway with the given
+	// logical ID. network holds the network-level configs and graph is the
+	// entity graph which contains the gateway's entity. The same key
+	// uniqueness requirements as Build apply.
+	BuildFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (map[string]proto.Message, error)
+}
+
+// EntityAssociator is implemented by GraphMconfigBuilders which read configs
+// of entities other than the magmad gateway entity from the entity graph.
+// Configs are written to configurator as unconnected entities, so the
+// builder declares which edges the entity of each of its configs takes part
+// in for those entities to end up in the gateway's graph.
+type EntityAssociator interface {
+	// GetAssociations returns the edges between the entity of the given
+	// config and other entities. It returns no edges for config types which
+	// the builder does not read.
+	GetAssociations(configType string, key string, config []byte) ([]storage.GraphEdge, error)
+}
@@ -15,7 +15,9 @@ import (
 	"time"
 
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 )
@@ -66,9 +68,55 @@ func CreateMconfig(networkId string, gatewayId string) (*protos.GatewayConfigs,
 	factory.RLock()
 	defer factory.RUnlock()
 
+	return createMconfigUnsafe(func(builder MconfigBuilder) (map[string]proto.Message, error) {
+		return builder.Build(networkId, gatewayId)
+	})
+}
+
+// CreateMconfigFromGraph creates an mconfig for a gateway from a configurator
+// network and the entity graph containing the gateway. Builders which
+// implement GraphMconfigBuilder are given the network and graph directly;
+// all other builders fall back to Build with the network and gateway IDs.
+// Note that the keys which builders return must be globally unique.
+func CreateMconfigFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (*protos.GatewayConfigs, error) {
+	factory.RLock()
+	defer factory.RUnlock()
+
+	return createMconfigUnsafe(func(builder MconfigBuilder) (map[string]proto.Message, error) {
+		graphBuilder, ok := builder.(GraphMconfigBuilder)
+		if !ok {
+			return builder.Build(network.ID, gatewayId)
+		}
+		return graphBuilder.BuildFromGraph(network, graph, gatewayId)
+	})
+}
+
+// GetEntityAssociations returns the edges between the configurator entity of
+// the given config and other entities, as declared by all registered
+// builders which implement EntityAssociator.
+func GetEntityAssociations(configType string, key string, config []byte) ([]storage.GraphEdge, error) {
+	factory.RLock()
+	defer factory.RUnlock()
+
+	ret := []storage.GraphEdge{}
+	for _, builder := range factory.builders {
+		associator, ok := builder.(EntityAssociator)
+		if !ok {
+			continue
+		}
+		edges, err := associator.GetAssociations(configType, key, config)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, edges...)
+	}
+	return ret, nil
+}
+
+func createMconfigUnsafe(build func(builder MconfigBuilder) (map[string]proto.Message, error)) (*protos.GatewayConfigs, error) {
 	ret := map[string]*any.Any{}
 	for _, builder := range factory.builders {
-		subConfig, err := builder.Build(networkId, gatewayId)
+		subConfig, err := build(builder)
 		if err != nil {
 			return nil, err
 		}
@@ -14,7 +14,9 @@ import (
 	"time"
 
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 	"magma/orc8r/cloud/go/services/streamer/mconfig/test_protos"
+	orc8r_storage "magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -31,6 +33,24 @@ func (builder *mockMconfigBuilder) Build(networkId string, gatewayId string) (ma
 	return builder.result, builder.err
 }
 
+type mockGraphMconfigBuilder struct {
+	mockMconfigBuilder
+	graphResult map[string]proto.Message
+}
+
+func (builder *mockGraphMconfigBuilder) BuildFromGraph(network storage.Network, graph storage.EntityGraph, gatewayId string) (map[string]proto.Message, error) {
+	return builder.graphResult, builder.err
+}
+
+type mockEntityAssociator struct {
+	mockGraphMconfigBuilder
+	edges []storage.GraphEdge
+}
+
+func (builder *mockEntityAssociator) GetAssociations(configType string, key string, config []byte) ([]storage.GraphEdge, error) {
+	return builder.edges, builder.err
+}
+
 type mockClock struct {
 	now time.Time
 }
@@ -115,3 +135,79 @@ func TestCreateMconfig_BuilderError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "FOO", err.Error())
 }
+
+func TestCreateMconfigFromGraph(t *testing.T) {
+	factory.builders = factory.builders[:0]
+	factory.clock = &mockClock{now: time.Unix(1551916956, 0)}
+
+	// Legacy builders fall back to Build, graph builders use BuildFromGraph
+	builder1 := &mockMconfigBuilder{
+		result: map[string]proto.Message{
+			"builder1_1": &test_protos.Message1{Field: "hello"},
+		},
+	}
+	builder2 := &mockGraphMconfigBuilder{
+		mockMconfigBuilder: mockMconfigBuilder{
+			result: map[string]proto.Message{
+				"builder2_1": &test_protos.Message1{Field: "legacy"},
+			},
+		},
+		graphResult: map[string]proto.Message{
+			"builder2_1": &test_protos.Message1{Field: "graph"},
+		},
+	}
+	RegisterMconfigBuilders(builder1, builder2)
+
+	actual, err := CreateMconfigFromGraph(storage.Network{ID: "foo"}, storage.EntityGraph{}, "bar")
+	assert.NoError(t, err)
+
+	expectedMap := map[string]proto.Message{
+		"builder1_1": &test_protos.Message1{Field: "hello"},
+		"builder2_1": &test_protos.Message1{Field: "graph"},
+	}
+	expectedAny := make(map[string]*any.Any, len(expectedMap))
+	for k, v := range expectedMap {
+		anyV, err := ptypes.MarshalAny(v)
+		assert.NoError(t, err)
+		expectedAny[k] = anyV
+	}
+	expected := &protos.GatewayConfigs{
+		ConfigsByKey: expectedAny,
+		Metadata: &protos.GatewayConfigsMetadata{
+			CreatedAt: 1551916956,
+		},
+	}
+	assert.Equal(t, *expected, *actual)
+
+	// Errors from graph builders are propagated
+	builder2.err = errors.New("FOO")
+	_, err = CreateMconfigFromGraph(storage.Network{ID: "foo"}, storage.EntityGraph{}, "bar")
+	assert.Error(t, err)
+	assert.Equal(t, "FOO", err.Error())
+}
+
+func TestGetEntityAssociations(t *testing.T) {
+	factory.builders = factory.builders[:0]
+
+	edge1 := storage.GraphEdge{
+		From: orc8r_storage.TypeAndKey{Type: "gw", Key: "gw1"},
+		To:   orc8r_storage.TypeAndKey{Type: "foo", Key: "gw1"},
+	}
+	edge2 := storage.GraphEdge{
+		From: orc8r_storage.TypeAndKey{Type: "foo", Key: "gw1"},
+		To:   orc8r_storage.TypeAndKey{Type: "bar", Key: "bar1"},
+	}
+	builder1 := &mockEntityAssociator{edges: []storage.GraphEdge{edge1}}
+	builder2 := &mockGraphMconfigBuilder{}
+	builder3 := &mockEntityAssociator{edges: []storage.GraphEdge{edge2}}
+	RegisterMconfigBuilders(builder1, builder2, builder3)
+
+	actual, err := GetEntityAssociations("foo", "gw1", []byte("config"))
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.GraphEdge{edge1, edge2}, actual)
+
+	builder3.err = errors.New("FOO")
+	_, err = GetEntityAssociations("foo", "gw1", []byte("config"))
+	assert.Error(t, err)
+	assert.Equal(t, "FOO", err.Error())
+}
@@ -11,6 +11,7 @@ package mconfig
 import (
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/magmad"
+	"magma/orc8r/cloud/go/services/streamer"
 	"magma/orc8r/cloud/go/services/streamer/mconfig/factory"
 	"magma/orc8r/cloud/go/services/streamer/providers"
 
@@ -25,7 +26,7 @@ func GetProvider() providers.StreamProvider {
 type ConfigProvider struct{}
 
 func (provider *ConfigProvider) GetStreamName() string {
-	return "configs"
+	return streamer.MconfigStreamName
 }
 
 func (provider *ConfigProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
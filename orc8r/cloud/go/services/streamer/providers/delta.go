@@ -0,0 +1,197 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// DefaultDeltaCacheSize is the default number of snapshots that a DeltaCache
+// keeps around to diff against. Snapshots hold a hash of every key of a
+// stream, so caches of large streams should stay small.
+const DefaultDeltaCacheSize = 8
+
+// DeltaStreamProvider is a StreamProvider which supports incremental
+// streaming. Instead of sending every update on every request, it only sends
+// the keys which changed or were deleted since the digest that the gateway
+// sent in its request.
+type DeltaStreamProvider interface {
+	StreamProvider
+
+	// GetDeltaUpdates returns a batch of updates for a gateway given its
+	// hardware ID. If extraArgs holds a StreamDigest which the provider
+	// recognizes, the batch only contains the changes since that digest.
+	// Otherwise the batch is a full resync.
+	GetDeltaUpdates(gatewayId string, extraArgs *any.Any) (*protos.DataUpdateBatch, error)
+}
+
+// DeltaCache computes incremental update batches for a stream. It keeps a
+// bounded number of recent snapshots of stream contents indexed by their
+// digest so it can diff the current contents against the snapshot that a
+// gateway last applied. Snapshots are content-addressed, so a single cache
+// can be shared by all gateways of a stream. DeltaCache is thread-safe.
+//
+// Snapshots are only kept in the memory of the streamer replica which
+// computed them. A gateway whose request reaches another replica gets a
+// resync instead of a delta, which is correct but as expensive as not
+// streaming incrementally. Incremental streaming therefore pays off with a
+// single replica, or with load balancing which keeps each gateway on the same
+// replica. Persistent streams stay on one replica until they reconnect.
+type DeltaCache struct {
+	sync.Mutex
+	capacity  int
+	snapshots map[string]snapshot
+	// digests in insertion order, used to evict the oldest snapshot
+	order []string
+}
+
+// snapshot maps each key of a stream to a hash of its value
+type snapshot map[string][sha256.Size]byte
+
+// NewDeltaCache returns a DeltaCache which holds at most capacity snapshots.
+func NewDeltaCache(capacity int) *DeltaCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &DeltaCache{capacity: capacity, snapshots: map[string]snapshot{}}
+}
+
+// GetDeltaBatch returns a batch which brings a gateway from the digest in
+// extraArgs to the contents described by updates. If extraArgs does not hold
+// a StreamDigest or the digest is not in the cache, a resync batch with all
+// the updates is returned. The returned batch always carries the digest of
+// updates.
+func (c *DeltaCache) GetDeltaBatch(updates []*protos.DataUpdate, extraArgs *any.Any) (*protos.DataUpdateBatch, error) {
+	lastDigest, err := GetStreamDigest(extraArgs)
+	if err != nil {
+		return nil, err
+	}
+	current := newSnapshot(updates)
+	digest := current.digest()
+
+	c.Lock()
+	previous, found := c.snapshots[lastDigest]
+	c.putUnsafe(digest, current)
+	c.Unlock()
+
+	if lastDigest == "" || !found {
+		return &protos.DataUpdateBatch{Updates: updates, Resync: true, Digest: digest}, nil
+	}
+
+	changed := make([]*protos.DataUpdate, 0)
+	for _, update := range updates {
+		if prevHash, exists := previous[update.Key]; !exists || prevHash != current[update.Key] {
+			changed = append(changed, update)
+		}
+	}
+	deleted := make([]string, 0)
+	for key := range previous {
+		if _, exists := current[key]; !exists {
+			deleted = append(deleted, key)
+		}
+	}
+	sort.Strings(deleted)
+	return &protos.DataUpdateBatch{Updates: changed, DeletedKeys: deleted, Digest: digest}, nil
+}
+
+// NetworkDeltaCaches holds a separate DeltaCache for each network of a
+// stream, so that the snapshots of busy networks do not evict those of
+// other networks and every network's snapshot count stays bounded.
+// NetworkDeltaCaches is thread-safe.
+type NetworkDeltaCaches struct {
+	sync.Mutex
+	capacity int
+	caches   map[string]*DeltaCache
+}
+
+// NewNetworkDeltaCaches returns a NetworkDeltaCaches whose caches hold at
+// most capacity snapshots each.
+func NewNetworkDeltaCaches(capacity int) *NetworkDeltaCaches {
+	return &NetworkDeltaCaches{capacity: capacity, caches: map[string]*DeltaCache{}}
+}
+
+// GetDeltaBatch returns a batch which brings a gateway of the network from
+// the digest in extraArgs to the contents described by updates. See
+// DeltaCache.GetDeltaBatch.
+func (c *NetworkDeltaCaches) GetDeltaBatch(networkID string, updates []*protos.DataUpdate, extraArgs *any.Any) (*protos.DataUpdateBatch, error) {
+	c.Lock()
+	cache, ok := c.caches[networkID]
+	if !ok {
+		cache = NewDeltaCache(c.capacity)
+		c.caches[networkID] = cache
+	}
+	c.Unlock()
+	return cache.GetDeltaBatch(updates, extraArgs)
+}
+
+func (c *DeltaCache) putUnsafe(digest string, snap snapshot) {
+	if _, exists := c.snapshots[digest]; exists {
+		return
+	}
+	if len(c.order) >= c.capacity {
+		delete(c.snapshots, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.snapshots[digest] = snap
+	c.order = append(c.order, digest)
+}
+
+// GetStreamDigest returns the digest held by a StreamDigest in the extra args
+// of a stream request. An empty digest is returned if extraArgs is nil or
+// holds a different message type.
+func GetStreamDigest(extraArgs *any.Any) (string, error) {
+	if extraArgs == nil || !ptypes.Is(extraArgs, &protos.StreamDigest{}) {
+		return "", nil
+	}
+	streamDigest := &protos.StreamDigest{}
+	if err := ptypes.UnmarshalAny(extraArgs, streamDigest); err != nil {
+		return "", fmt.Errorf("Failed to unmarshal stream digest: %s", err)
+	}
+	return streamDigest.GetDigest(), nil
+}
+
+// GetUpdatesDigest returns the digest of a full set of updates, which is the
+// digest DeltaCache would give the same contents.
+func GetUpdatesDigest(updates []*protos.DataUpdate) string {
+	return newSnapshot(updates).digest()
+}
+
+func newSnapshot(updates []*protos.DataUpdate) snapshot {
+	ret := make(snapshot, len(updates))
+	for _, update := range updates {
+		ret[update.Key] = sha256.Sum256(update.Value)
+	}
+	return ret
+}
+
+func (s snapshot) digest() string {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, key := range keys {
+		valueHash := s[key]
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write(valueHash[:])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers_test
+
+import (
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer/providers"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeltaCache_GetDeltaBatch(t *testing.T) {
+	cache := providers.NewDeltaCache(2)
+
+	v1 := []*protos.DataUpdate{
+		{Key: "a", Value: []byte("1")},
+		{Key: "b", Value: []byte("2")},
+		{Key: "c", Value: []byte("3")},
+	}
+	// No digest: resync
+	batch1, err := cache.GetDeltaBatch(v1, nil)
+	assert.NoError(t, err)
+	assert.True(t, batch1.Resync)
+	assert.Equal(t, v1, batch1.Updates)
+	assert.NotEmpty(t, batch1.Digest)
+
+	// Same contents in a different order produce the same digest and an
+	// empty delta
+	v1Reordered := []*protos.DataUpdate{v1[2], v1[0], v1[1]}
+	batch, err := cache.GetDeltaBatch(v1Reordered, digestArgs(t, batch1.Digest))
+	assert.NoError(t, err)
+	assert.Equal(t, &protos.DataUpdateBatch{Updates: []*protos.DataUpdate{}, DeletedKeys: []string{}, Digest: batch1.Digest}, batch)
+
+	// Update b, delete c, add d
+	v2 := []*protos.DataUpdate{
+		{Key: "a", Value: []byte("1")},
+		{Key: "b", Value: []byte("22")},
+		{Key: "d", Value: []byte("4")},
+	}
+	batch2, err := cache.GetDeltaBatch(v2, digestArgs(t, batch1.Digest))
+	assert.NoError(t, err)
+	assert.False(t, batch2.Resync)
+	assert.Equal(t, []*protos.DataUpdate{v2[1], v2[2]}, batch2.Updates)
+	assert.Equal(t, []string{"c"}, batch2.DeletedKeys)
+	assert.NotEqual(t, batch1.Digest, batch2.Digest)
+
+	// Unknown digest: resync
+	batch, err = cache.GetDeltaBatch(v2, digestArgs(t, "foo"))
+	assert.NoError(t, err)
+	assert.Equal(t, &protos.DataUpdateBatch{Updates: v2, Resync: true, Digest: batch2.Digest}, batch)
+
+	// Extra args of another type: resync
+	otherArgs, err := ptypes.MarshalAny(&protos.NetworkID{Id: "foo"})
+	assert.NoError(t, err)
+	batch, err = cache.GetDeltaBatch(v2, otherArgs)
+	assert.NoError(t, err)
+	assert.True(t, batch.Resync)
+
+	// Evict the first snapshot, after which its digest causes a resync
+	v3 := []*protos.DataUpdate{{Key: "a", Value: []byte("3")}}
+	_, err = cache.GetDeltaBatch(v3, nil)
+	assert.NoError(t, err)
+	batch, err = cache.GetDeltaBatch(v2, digestArgs(t, batch1.Digest))
+	assert.NoError(t, err)
+	assert.True(t, batch.Resync)
+}
+
+func TestNetworkDeltaCaches_GetDeltaBatch(t *testing.T) {
+	caches := providers.NewNetworkDeltaCaches(1)
+
+	v1 := []*protos.DataUpdate{{Key: "a", Value: []byte("1")}}
+	batch1, err := caches.GetDeltaBatch("network1", v1, nil)
+	assert.NoError(t, err)
+	assert.True(t, batch1.Resync)
+
+	// Snapshots of another network do not evict network1's snapshot
+	v2 := []*protos.DataUpdate{{Key: "b", Value: []byte("2")}}
+	_, err = caches.GetDeltaBatch("network2", v2, nil)
+	assert.NoError(t, err)
+	batch, err := caches.GetDeltaBatch("network1", v1, digestArgs(t, batch1.Digest))
+	assert.NoError(t, err)
+	assert.False(t, batch.Resync)
+	assert.Empty(t, batch.Updates)
+
+	// Digests are not shared across networks
+	batch, err = caches.GetDeltaBatch("network2", v1, digestArgs(t, batch1.Digest))
+	assert.NoError(t, err)
+	assert.True(t, batch.Resync)
+}
+
+func digestArgs(t *testing.T, digest string) *any.Any {
+	ret, err := ptypes.MarshalAny(&protos.StreamDigest{Digest: digest})
+	assert.NoError(t, err)
+	return ret
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers
+
+import (
+	"sync"
+)
+
+// updateSubscriber is a persistent stream waiting for change notifications
+type updateSubscriber struct {
+	networkID string
+	ch        chan struct{}
+}
+
+type updateNotifier struct {
+	sync.RWMutex
+	subscribersByStream map[string]map[*updateSubscriber]struct{}
+}
+
+var notifier = &updateNotifier{subscribersByStream: map[string]map[*updateSubscriber]struct{}{}}
+
+// NotifyStreamUpdate notifies all persistent streams for streamName that the
+// data behind the stream changed in networkID, so they can push the change to
+// their gateways. An empty networkID notifies streams on every network.
+// Providers can call this directly; other services should go through the
+// streamer service's StreamNotifier. This function is thread-safe and never
+// blocks: pending notifications for a stream are coalesced.
+func NotifyStreamUpdate(streamName string, networkID string) {
+	notifier.RLock()
+	defer notifier.RUnlock()
+	for sub := range notifier.subscribersByStream[streamName] {
+		if networkID != "" && sub.networkID != "" && sub.networkID != networkID {
+			continue
+		}
+		select {
+		case sub.ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// SubscribeStreamUpdates returns a channel which receives a value whenever
+// NotifyStreamUpdate is called for streamName on networkID. An empty
+// networkID subscribes to changes on every network. The returned function
+// must be called to unsubscribe once the caller is done.
+func SubscribeStreamUpdates(streamName string, networkID string) (<-chan struct{}, func()) {
+	sub := &updateSubscriber{networkID: networkID, ch: make(chan struct{}, 1)}
+
+	notifier.Lock()
+	defer notifier.Unlock()
+	if _, ok := notifier.subscribersByStream[streamName]; !ok {
+		notifier.subscribersByStream[streamName] = map[*updateSubscriber]struct{}{}
+	}
+	notifier.subscribersByStream[streamName][sub] = struct{}{}
+
+	unsubscribe := func() {
+		notifier.Lock()
+		defer notifier.Unlock()
+		delete(notifier.subscribersByStream[streamName], sub)
+		if len(notifier.subscribersByStream[streamName]) == 0 {
+			delete(notifier.subscribersByStream, streamName)
+		}
+	}
+	return sub.ch, unsubscribe
+}
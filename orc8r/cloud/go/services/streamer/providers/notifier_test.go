@@ -0,0 +1,59 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers_test
+
+import (
+	"testing"
+
+	"magma/orc8r/cloud/go/services/streamer/providers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyStreamUpdate(t *testing.T) {
+	network1, unsubscribe1 := providers.SubscribeStreamUpdates("stream1", "network1")
+	allNetworks, unsubscribeAll := providers.SubscribeStreamUpdates("stream1", "")
+	otherStream, unsubscribeOther := providers.SubscribeStreamUpdates("stream2", "network1")
+	defer unsubscribeAll()
+	defer unsubscribeOther()
+
+	// Notifications for other networks are filtered out
+	providers.NotifyStreamUpdate("stream1", "network2")
+	assertNotified(t, false, network1)
+	assertNotified(t, true, allNetworks)
+	assertNotified(t, false, otherStream)
+
+	// Pending notifications are coalesced
+	providers.NotifyStreamUpdate("stream1", "network1")
+	providers.NotifyStreamUpdate("stream1", "network1")
+	assertNotified(t, true, network1)
+	assertNotified(t, false, network1)
+	assertNotified(t, true, allNetworks)
+	assertNotified(t, false, allNetworks)
+
+	// Empty network notifies everyone on the stream
+	providers.NotifyStreamUpdate("stream1", "")
+	assertNotified(t, true, network1)
+	assertNotified(t, true, allNetworks)
+	assertNotified(t, false, otherStream)
+
+	unsubscribe1()
+	providers.NotifyStreamUpdate("stream1", "network1")
+	assertNotified(t, false, network1)
+	assertNotified(t, true, allNetworks)
+}
+
+func assertNotified(t *testing.T, expected bool, ch <-chan struct{}) {
+	select {
+	case <-ch:
+		assert.True(t, expected, "unexpected notification")
+	default:
+		assert.False(t, expected, "expected a notification")
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer/providers"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamUpdatesTableName is the table in which change notifications are
+// recorded, with one record per stream and network whose generation is
+// bumped on every notification.
+const StreamUpdatesTableName = "stream_updates"
+
+// RelayInterval is how often each streamer replica polls for the
+// notifications received by the other replicas
+var RelayInterval = 5 * time.Second
+
+// relayPageSize is the number of notification records read per query
+const relayPageSize = 1000
+
+// StreamNotifierServer relays change notifications from other cloud services
+// to the persistent streams open on every streamer replica. Notifications
+// are sent to the streams open on the replica which received them right
+// away, and recorded in a table shared by all replicas so that the other
+// replicas pick them up in RelayStreamUpdates.
+type StreamNotifierServer struct {
+	store datastore.Api
+}
+
+func NewStreamNotifierServer(store datastore.Api) *StreamNotifierServer {
+	return &StreamNotifierServer{store: store}
+}
+
+func (srv *StreamNotifierServer) NotifyStreamUpdate(ctx context.Context, req *protos.StreamUpdateNotification) (*protos.Void, error) {
+	ret := &protos.Void{}
+	if req == nil {
+		return ret, status.Error(codes.InvalidArgument, "nil request")
+	}
+	// Notifications are only meant for cloud services
+	if protos.GetClientGateway(ctx) != nil {
+		return ret, status.Error(codes.PermissionDenied, "Gateways may not send stream notifications")
+	}
+	if req.GetStreamName() == "" {
+		return ret, status.Error(codes.InvalidArgument, "Stream name is empty")
+	}
+	providers.NotifyStreamUpdate(req.GetStreamName(), req.GetNetworkId())
+	err := srv.store.Put(StreamUpdatesTableName, getNotificationKey(req.GetStreamName(), req.GetNetworkId()), []byte{})
+	if err != nil {
+		return ret, status.Errorf(codes.Internal, "Failed to record stream notification: %s", err)
+	}
+	return ret, nil
+}
+
+// RelayStreamUpdates polls the notifications recorded by all streamer
+// replicas every interval and sends the new ones to the persistent streams
+// open on this replica, until done is closed.
+func (srv *StreamNotifierServer) RelayStreamUpdates(interval time.Duration, done <-chan struct{}) {
+	// Notifications recorded before we started are not relayed
+	seen, err := srv.getNotificationGenerations()
+	if err != nil {
+		glog.Errorf("Failed to load stream notifications: %s", err)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		generations, err := srv.getNotificationGenerations()
+		if err != nil {
+			glog.Errorf("Failed to load stream notifications: %s", err)
+			continue
+		}
+		for key, generation := range generations {
+			if lastGeneration, ok := seen[key]; ok && lastGeneration == generation {
+				continue
+			}
+			streamName, networkID := parseNotificationKey(key)
+			providers.NotifyStreamUpdate(streamName, networkID)
+		}
+		seen = generations
+	}
+}
+
+func (srv *StreamNotifierServer) getNotificationGenerations() (map[string]uint64, error) {
+	ret := map[string]uint64{}
+	pageToken := ""
+	for {
+		keys, nextPageToken, err := srv.store.ListKeysPage(
+			StreamUpdatesTableName,
+			datastore.ListKeysCriteria{PageSize: relayPageSize, PageToken: pageToken},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) > 0 {
+			records, err := srv.store.GetMany(StreamUpdatesTableName, keys)
+			if err != nil {
+				return nil, err
+			}
+			for key, record := range records {
+				ret[key] = record.Generation
+			}
+		}
+		if nextPageToken == "" {
+			return ret, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// Stream names never contain a colon, so the key splits unambiguously
+func getNotificationKey(streamName string, networkID string) string {
+	return streamName + ":" + networkID
+}
+
+func parseNotificationKey(key string) (string, string) {
+	parts := strings.SplitN(key, ":", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
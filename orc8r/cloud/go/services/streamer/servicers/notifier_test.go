@@ -0,0 +1,81 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer/providers"
+	"magma/orc8r/cloud/go/services/streamer/servicers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamNotifierServer_NotifyStreamUpdate(t *testing.T) {
+	store, err := datastore.NewSqlDb("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	srv := servicers.NewStreamNotifierServer(store)
+	notifications, unsubscribe := providers.SubscribeStreamUpdates("notify1", "network1")
+	defer unsubscribe()
+
+	// Streams on this replica are notified right away and the notification
+	// is recorded for the other replicas
+	_, err = srv.NotifyStreamUpdate(context.Background(), &protos.StreamUpdateNotification{StreamName: "notify1", NetworkId: "network1"})
+	assert.NoError(t, err)
+	assertNotifiedWithin(t, notifications, time.Second)
+	_, generation, err := store.Get(servicers.StreamUpdatesTableName, "notify1:network1")
+	assert.NoError(t, err)
+
+	_, err = srv.NotifyStreamUpdate(context.Background(), &protos.StreamUpdateNotification{StreamName: "notify1", NetworkId: "network1"})
+	assert.NoError(t, err)
+	_, newGeneration, err := store.Get(servicers.StreamUpdatesTableName, "notify1:network1")
+	assert.NoError(t, err)
+	assert.Equal(t, generation+1, newGeneration)
+
+	_, err = srv.NotifyStreamUpdate(context.Background(), &protos.StreamUpdateNotification{})
+	assert.Error(t, err)
+}
+
+func TestStreamNotifierServer_RelayStreamUpdates(t *testing.T) {
+	store, err := datastore.NewSqlDb("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	srv := servicers.NewStreamNotifierServer(store)
+	notifications, unsubscribe := providers.SubscribeStreamUpdates("relay1", "network1")
+	defer unsubscribe()
+
+	// Notifications recorded before the relay started are not relayed
+	assert.NoError(t, store.Put(servicers.StreamUpdatesTableName, "relay1:network1", []byte{}))
+	done := make(chan struct{})
+	defer close(done)
+	go srv.RelayStreamUpdates(10*time.Millisecond, done)
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case <-notifications:
+		assert.Fail(t, "unexpected notification")
+	default:
+	}
+
+	// Notifications recorded by other replicas are relayed
+	assert.NoError(t, store.Put(servicers.StreamUpdatesTableName, "relay1:network1", []byte{}))
+	assertNotifiedWithin(t, notifications, time.Second)
+	assert.NoError(t, store.Put(servicers.StreamUpdatesTableName, "relay1:", []byte{}))
+	assertNotifiedWithin(t, notifications, time.Second)
+}
+
+func assertNotifiedWithin(t *testing.T, ch <-chan struct{}, timeout time.Duration) {
+	select {
+	case <-ch:
+	case <-time.After(timeout):
+		assert.Fail(t, "expected a notification")
+	}
+}
@@ -16,14 +16,24 @@ the updates would be pushed through that channel.
 package servicers
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/magmad"
 	"magma/orc8r/cloud/go/services/streamer/providers"
 
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// KeepaliveInterval is how often persistent streams send a keepalive batch
+// when no change was notified. Keepalives don't call the stream's provider,
+// which is only polled again on change notifications.
+var KeepaliveInterval = 30 * time.Second
+
 type StreamingServer struct{}
 
 func GetUpdatesUnverified(
@@ -34,15 +44,109 @@ func GetUpdatesUnverified(
 	if err != nil {
 		return status.Errorf(codes.Unavailable, "Stream %s does not exist", request.GetStreamName())
 	}
-	updates, err := streamProvider.GetUpdates(request.GetGatewayId(), request.ExtraArgs)
+	updateBatch, err := getUpdateBatch(streamProvider, request)
 	if err != nil {
 		return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
 	}
+	if err := stream.Send(updateBatch); err != nil {
+		return err
+	}
+	if !request.GetPersistent() {
+		return nil
+	}
+	return pushUpdates(streamProvider, request, getBatchDigest(updateBatch), stream)
+}
+
+// pushUpdates keeps a persistent stream open, pushing a new batch whenever
+// the stream's data changes and keepalives in between, until the gateway
+// closes the stream.
+func pushUpdates(
+	streamProvider providers.StreamProvider,
+	request *protos.StreamRequest,
+	digest string,
+	stream protos.Streamer_GetUpdatesServer,
+) error {
+	// If we can't find the gateway's network we'll listen for changes on all
+	// networks, which is only less efficient
+	networkID, err := magmad.FindGatewayNetworkId(request.GetGatewayId())
+	if err != nil {
+		glog.V(2).Infof("Could not find network for gateway %s: %s", request.GetGatewayId(), err)
+		networkID = ""
+	}
+	notifications, unsubscribe := providers.SubscribeStreamUpdates(request.GetStreamName(), networkID)
+	defer unsubscribe()
+
+	keepalive := time.NewTicker(KeepaliveInterval)
+	defer keepalive.Stop()
+
+	_, isDeltaProvider := streamProvider.(providers.DeltaStreamProvider)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-keepalive.C:
+			if err := stream.Send(getKeepaliveBatch(digest, isDeltaProvider)); err != nil {
+				return err
+			}
+			continue
+		case <-notifications:
+		}
+
+		if isDeltaProvider {
+			// Ask only for the changes since the last batch we sent
+			request.ExtraArgs, err = ptypes.MarshalAny(&protos.StreamDigest{Digest: digest})
+			if err != nil {
+				return status.Errorf(codes.Internal, "Error marshaling stream digest: %s", err)
+			}
+		}
+		updateBatch, err := getUpdateBatch(streamProvider, request)
+		if err != nil {
+			return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
+		}
+		newDigest := getBatchDigest(updateBatch)
+		if newDigest == digest {
+			continue
+		}
+		if err := stream.Send(updateBatch); err != nil {
+			return err
+		}
+		digest = newDigest
+	}
+}
+
+// getKeepaliveBatch returns a batch without updates. Incremental streams
+// carry the digest of the last batch sent, which the gateway keeps.
+func getKeepaliveBatch(digest string, isDeltaProvider bool) *protos.DataUpdateBatch {
+	if !isDeltaProvider {
+		return &protos.DataUpdateBatch{}
+	}
+	return &protos.DataUpdateBatch{Digest: digest}
+}
+
+// getBatchDigest returns the digest of the stream's contents after applying
+// the batch. Providers without incremental streaming always send a full
+// resync, so the digest of their updates is used instead.
+func getBatchDigest(batch *protos.DataUpdateBatch) string {
+	if batch.GetDigest() != "" {
+		return batch.GetDigest()
+	}
+	return providers.GetUpdatesDigest(batch.GetUpdates())
+}
+
+// getUpdateBatch returns an incremental batch if the provider supports it,
+// and a full resync of all updates otherwise.
+func getUpdateBatch(streamProvider providers.StreamProvider, request *protos.StreamRequest) (*protos.DataUpdateBatch, error) {
+	if deltaProvider, ok := streamProvider.(providers.DeltaStreamProvider); ok {
+		return deltaProvider.GetDeltaUpdates(request.GetGatewayId(), request.ExtraArgs)
+	}
+	updates, err := streamProvider.GetUpdates(request.GetGatewayId(), request.ExtraArgs)
+	if err != nil {
+		return nil, err
+	}
 	updateBatch := new(protos.DataUpdateBatch)
 	updateBatch.Resync = true
 	updateBatch.Updates = updates
-	stream.Send(updateBatch)
-	return nil
+	return updateBatch, nil
 }
 
 func (srv *StreamingServer) GetUpdates(
@@ -10,14 +10,18 @@ package servicers_test
 
 import (
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/registry"
 	"magma/orc8r/cloud/go/services/streamer"
 	"magma/orc8r/cloud/go/services/streamer/providers"
+	"magma/orc8r/cloud/go/services/streamer/servicers"
 	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
@@ -38,6 +42,15 @@ func (m *mockStreamProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([
 	return m.retVal, m.retErr
 }
 
+type mockDeltaStreamProvider struct {
+	mockStreamProvider
+	cache *providers.DeltaCache
+}
+
+func (m *mockDeltaStreamProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any) (*protos.DataUpdateBatch, error) {
+	return m.cache.GetDeltaBatch(m.retVal, extraArgs)
+}
+
 func TestStreamingServer_GetUpdates(t *testing.T) {
 	streamer_test_init.StartTestService(t)
 	conn, err := registry.GetConnection(streamer.ServiceName)
@@ -85,3 +98,181 @@ func TestStreamingServer_GetUpdates(t *testing.T) {
 	_, err = streamerClient.Recv()
 	assert.Error(t, err, "Stream stream_dne does not exist", codes.Unavailable)
 }
+
+func TestStreamingServer_GetUpdates_Delta(t *testing.T) {
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	defer conn.Close()
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	provider := &mockDeltaStreamProvider{
+		mockStreamProvider: mockStreamProvider{
+			name: "delta1",
+			retVal: []*protos.DataUpdate{
+				{Key: "a", Value: []byte("123")},
+				{Key: "b", Value: []byte("456")},
+			},
+		},
+		cache: providers.NewDeltaCache(providers.DefaultDeltaCacheSize),
+	}
+	providers.RegisterStreamProvider(provider)
+
+	// First request without a digest resyncs
+	streamerClient, err := grpcClient.GetUpdates(
+		context.Background(),
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "delta1"},
+	)
+	assert.NoError(t, err)
+	actual, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, actual.Resync)
+	assert.Equal(t, 2, len(actual.Updates))
+	assert.NotEmpty(t, actual.Digest)
+
+	// Second request with the digest only gets the changes
+	provider.retVal = []*protos.DataUpdate{
+		{Key: "a", Value: []byte("789")},
+	}
+	extraArgs, err := ptypes.MarshalAny(&protos.StreamDigest{Digest: actual.Digest})
+	assert.NoError(t, err)
+	streamerClient, err = grpcClient.GetUpdates(
+		context.Background(),
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "delta1", ExtraArgs: extraArgs},
+	)
+	assert.NoError(t, err)
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, actual.Resync)
+	assert.Equal(t, 1, len(actual.Updates))
+	assert.Equal(t, protos.TestMarshal(provider.retVal[0]), protos.TestMarshal(actual.Updates[0]))
+	assert.Equal(t, []string{"b"}, actual.DeletedKeys)
+}
+
+func TestStreamingServer_GetUpdates_Persistent(t *testing.T) {
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	defer conn.Close()
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	provider := &mockDeltaStreamProvider{
+		mockStreamProvider: mockStreamProvider{
+			name:   "persistent1",
+			retVal: []*protos.DataUpdate{{Key: "a", Value: []byte("123")}},
+		},
+		cache: providers.NewDeltaCache(providers.DefaultDeltaCacheSize),
+	}
+	providers.RegisterStreamProvider(provider)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	streamerClient, err := grpcClient.GetUpdates(
+		ctx,
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "persistent1", Persistent: true},
+	)
+	assert.NoError(t, err)
+	actual, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, actual.Resync)
+	assert.Equal(t, 1, len(actual.Updates))
+
+	// A change notification pushes only the changes down the open stream
+	provider.retVal = []*protos.DataUpdate{
+		{Key: "a", Value: []byte("123")},
+		{Key: "b", Value: []byte("456")},
+	}
+	go func() {
+		// Keep notifying until the server has subscribed to the stream
+		for ctx.Err() == nil {
+			providers.NotifyStreamUpdate("persistent1", "")
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, actual.Resync)
+	assert.Equal(t, 1, len(actual.Updates))
+	assert.Equal(t, "b", actual.Updates[0].Key)
+	assert.Empty(t, actual.DeletedKeys)
+}
+
+func TestStreamingServer_GetUpdates_PersistentKeepalive(t *testing.T) {
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	defer conn.Close()
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	oldInterval := servicers.KeepaliveInterval
+	servicers.KeepaliveInterval = 20 * time.Millisecond
+	defer func() { servicers.KeepaliveInterval = oldInterval }()
+
+	provider := &mockLockedStreamProvider{name: "persistent2", retVal: []*protos.DataUpdate{{Key: "a", Value: []byte("123")}}}
+	providers.RegisterStreamProvider(provider)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	streamerClient, err := grpcClient.GetUpdates(
+		ctx,
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "persistent2", Persistent: true},
+	)
+	assert.NoError(t, err)
+	actual, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, actual.Resync)
+
+	// Unchanged data only gets keepalives, which don't poll the provider
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, actual.Resync)
+	assert.Empty(t, actual.Updates)
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.Empty(t, actual.Updates)
+	assert.Equal(t, 1, provider.getNumCalls())
+
+	// Changes are pushed once notified
+	provider.setUpdates([]*protos.DataUpdate{{Key: "a", Value: []byte("456")}})
+	providers.NotifyStreamUpdate("persistent2", "")
+	for {
+		actual, err = streamerClient.Recv()
+		assert.NoError(t, err)
+		if err != nil || actual.Resync {
+			break
+		}
+	}
+	assert.Equal(t, 1, len(actual.Updates))
+	assert.Equal(t, []byte("456"), actual.Updates[0].Value)
+	assert.Equal(t, 2, provider.getNumCalls())
+}
+
+type mockLockedStreamProvider struct {
+	sync.Mutex
+	name     string
+	retVal   []*protos.DataUpdate
+	numCalls int
+}
+
+func (m *mockLockedStreamProvider) GetStreamName() string {
+	return m.name
+}
+
+func (m *mockLockedStreamProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.numCalls++
+	return m.retVal, nil
+}
+
+func (m *mockLockedStreamProvider) setUpdates(updates []*protos.DataUpdate) {
+	m.Lock()
+	defer m.Unlock()
+	m.retVal = updates
+}
+
+func (m *mockLockedStreamProvider) getNumCalls() int {
+	m.Lock()
+	defer m.Unlock()
+	return m.numCalls
+}
@@ -9,3 +9,7 @@ LICENSE file in the root directory of this source tree.
 package streamer
 
 const ServiceName = "STREAMER"
+
+// MconfigStreamName is the name of the stream which serves mconfigs to
+// gateways
+const MconfigStreamName = "configs"
@@ -11,6 +11,7 @@ package main
 import (
 	"log"
 
+	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
@@ -25,10 +26,20 @@ func main() {
 		log.Fatalf("Error creating service: %s", err)
 	}
 
+	// Notifications are shared with the other streamer replicas through the
+	// datastore
+	store, err := datastore.NewSqlDb(datastore.SQL_DRIVER, datastore.DATABASE_SOURCE)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %s", err)
+	}
+
 	// Add servicers to the service
 	servicer := &servicers.StreamingServer{}
 	protos.RegisterStreamerServer(srv.GrpcServer, servicer)
 	srv.GrpcServer.RegisterService(protos.GetLegacyStreamerDesc(), servicer)
+	notifier := servicers.NewStreamNotifierServer(store)
+	protos.RegisterStreamNotifierServer(srv.GrpcServer, notifier)
+	go notifier.RelayStreamUpdates(servicers.RelayInterval, nil)
 
 	// Run the service
 	err = srv.Run()
@@ -33,5 +33,6 @@ func (srv *testStreamingServer) GetUpdates(
 func StartTestService(t *testing.T) {
 	srv, lis := test_utils.NewTestService(t, orc8r.ModuleName, streamer.ServiceName)
 	protos.RegisterStreamerServer(srv.GrpcServer, &testStreamingServer{})
+	protos.RegisterStreamNotifierServer(srv.GrpcServer, servicers.NewStreamNotifierServer(test_utils.NewMockDatastore()))
 	go srv.RunTest(lis)
 }
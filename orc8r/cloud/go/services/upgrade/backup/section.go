@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package backup provides the network backup section for the upgrade service
+package backup
+
+import (
+	"encoding/json"
+
+	"magma/orc8r/cloud/go/backup"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/upgrade"
+	upgradeprotos "magma/orc8r/cloud/go/services/upgrade/protos"
+)
+
+const SectionName = "upgrade_tiers"
+
+// TiersSection archives the upgrade tiers of a network. Release channels are
+// shared by all networks and are not part of network archives.
+type TiersSection struct{}
+
+func (*TiersSection) GetName() string {
+	return SectionName
+}
+
+func (*TiersSection) Export(networkID string) (json.RawMessage, error) {
+	tiers, err := upgrade.GetTiers(networkID, []string{})
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]json.RawMessage, len(tiers))
+	for tierID, tier := range tiers {
+		marshaledTier, err := protos.Marshal(tier)
+		if err != nil {
+			return nil, err
+		}
+		ret[tierID] = marshaledTier
+	}
+	return json.Marshal(ret)
+}
+
+func (*TiersSection) Import(ctx backup.ImportContext, data json.RawMessage) error {
+	tiers := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &tiers); err != nil {
+		return err
+	}
+	for tierID, marshaledTier := range tiers {
+		tier := &upgradeprotos.TierInfo{}
+		if err := protos.Unmarshal(marshaledTier, tier); err != nil {
+			return err
+		}
+		if err := upgrade.CreateTier(ctx.NetworkID, tierID, tier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*TiersSection) Clear(networkID string) error {
+	tiers, err := upgrade.GetTiers(networkID, []string{})
+	if err != nil {
+		return err
+	}
+	for tierID := range tiers {
+		if err := upgrade.DeleteTier(networkID, tierID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
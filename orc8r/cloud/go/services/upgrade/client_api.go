@@ -180,3 +180,64 @@ func DeleteTier(networkId string, tierId string) error {
 	_, err = client.DeleteTier(context.Background(), req)
 	return err
 }
+
+// A rollout moves tiers of a network to a new version one tier at a time,
+// promoting the rollout to the next tier only if the gateways of the
+// previous tier stayed healthy.
+
+// Start a rollout in a network. Only one rollout can be in progress in a
+// network at a time.
+func CreateRollout(networkId string, rolloutId string, plan *upgrade_protos.RolloutPlan) error {
+	client, conn, err := getUpgradeServiceClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	req := &upgrade_protos.CreateRolloutRequest{
+		NetworkId: networkId,
+		RolloutId: rolloutId,
+		Plan:      plan,
+	}
+	_, err = client.CreateRollout(context.Background(), req)
+	return err
+}
+
+func GetRollout(networkId string, rolloutId string) (*upgrade_protos.Rollout, error) {
+	client, conn, err := getUpgradeServiceClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	req := &upgrade_protos.GetRolloutRequest{NetworkId: networkId, RolloutId: rolloutId}
+	return client.GetRollout(context.Background(), req)
+}
+
+func ListRollouts(networkId string) (map[string]*upgrade_protos.Rollout, error) {
+	client, conn, err := getUpgradeServiceClient()
+	if err != nil {
+		return map[string]*upgrade_protos.Rollout{}, err
+	}
+	defer conn.Close()
+
+	req := &upgrade_protos.ListRolloutsRequest{NetworkId: networkId}
+	res, err := client.ListRollouts(context.Background(), req)
+	if err != nil {
+		return map[string]*upgrade_protos.Rollout{}, err
+	}
+	return res.GetRollouts(), nil
+}
+
+// Abort a rollout in progress, reverting the tier it is soaking.
+func AbortRollout(networkId string, rolloutId string) error {
+	client, conn, err := getUpgradeServiceClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	req := &upgrade_protos.AbortRolloutRequest{NetworkId: networkId, RolloutId: rolloutId}
+	_, err = client.AbortRollout(context.Background(), req)
+	return err
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package health
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/services/upgrade/protos"
+)
+
+// GetUnhealthyGateways evaluates the signals of a tier's gateways against
+// the criteria of a rollout to version. It returns a map of the unhealthy
+// gateways to the reason why they are unhealthy.
+func GetUnhealthyGateways(
+	signals []*GatewaySignals,
+	criteria *protos.HealthCriteria,
+	version string,
+	now time.Time,
+) map[string]string {
+	ret := map[string]string{}
+	maxCheckinAge := time.Duration(criteria.GetMaxCheckinAgeSecs()) * time.Second
+	for _, gw := range signals {
+		if reason := getUnhealthyReason(gw, criteria, maxCheckinAge, version, now); reason != "" {
+			ret[gw.GatewayID] = reason
+		}
+	}
+	return ret
+}
+
+func getUnhealthyReason(
+	gw *GatewaySignals,
+	criteria *protos.HealthCriteria,
+	maxCheckinAge time.Duration,
+	version string,
+	now time.Time,
+) string {
+	if gw.LastCheckin.IsZero() {
+		return "never checked in"
+	}
+	if age := now.Sub(gw.LastCheckin); age > maxCheckinAge {
+		return fmt.Sprintf("last checked in %s ago", age.Truncate(time.Second))
+	}
+	requiredMeta := criteria.GetRequiredStatusMeta()
+	keys := make([]string, 0, len(requiredMeta))
+	for key := range requiredMeta {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		expected := requiredMeta[key]
+		actual, ok := gw.StatusMeta[key]
+		if !ok {
+			return fmt.Sprintf("status meta %s is missing", key)
+		}
+		if actual != expected {
+			return fmt.Sprintf("status meta %s is %q instead of %q", key, actual, expected)
+		}
+	}
+	if criteria.GetRequireTargetVersion() && gw.PackageVersion != version {
+		return fmt.Sprintf("running version %q instead of %q", gw.PackageVersion, version)
+	}
+	return ""
+}
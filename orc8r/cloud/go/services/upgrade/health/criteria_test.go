@@ -0,0 +1,79 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package health_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/services/upgrade/health"
+	"magma/orc8r/cloud/go/services/upgrade/protos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUnhealthyGateways(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	criteria := &protos.HealthCriteria{MaxCheckinAgeSecs: 60}
+	signals := []*health.GatewaySignals{
+		{GatewayID: "fresh", LastCheckin: now.Add(-30 * time.Second), PackageVersion: "1.1.0"},
+		{GatewayID: "stale", LastCheckin: now.Add(-90 * time.Second), PackageVersion: "1.1.0"},
+		{GatewayID: "never"},
+	}
+	assert.Equal(
+		t,
+		map[string]string{
+			"stale": "last checked in 1m30s ago",
+			"never": "never checked in",
+		},
+		health.GetUnhealthyGateways(signals, criteria, "1.1.0", now),
+	)
+
+	// Status meta and version requirements
+	criteria = &protos.HealthCriteria{
+		MaxCheckinAgeSecs:    60,
+		RequiredStatusMeta:   map[string]string{"mme": "running", "sessiond": "running"},
+		RequireTargetVersion: true,
+	}
+	signals = []*health.GatewaySignals{
+		{
+			GatewayID:      "healthy",
+			LastCheckin:    now,
+			PackageVersion: "1.1.0",
+			StatusMeta:     map[string]string{"mme": "running", "sessiond": "running", "other": "x"},
+		},
+		{
+			GatewayID:      "missing_meta",
+			LastCheckin:    now,
+			PackageVersion: "1.1.0",
+			StatusMeta:     map[string]string{"mme": "running"},
+		},
+		{
+			GatewayID:      "wrong_meta",
+			LastCheckin:    now,
+			PackageVersion: "1.1.0",
+			StatusMeta:     map[string]string{"mme": "crashed", "sessiond": "running"},
+		},
+		{
+			GatewayID:      "old_version",
+			LastCheckin:    now,
+			PackageVersion: "1.0.0",
+			StatusMeta:     map[string]string{"mme": "running", "sessiond": "running"},
+		},
+	}
+	assert.Equal(
+		t,
+		map[string]string{
+			"missing_meta": "status meta sessiond is missing",
+			"wrong_meta":   `status meta mme is "crashed" instead of "running"`,
+			"old_version":  `running version "1.0.0" instead of "1.1.0"`,
+		},
+		health.GetUnhealthyGateways(signals, criteria, "1.1.0", now),
+	)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package health provides the gateway health signals which rollouts use to
+// decide whether a tier can be promoted.
+package health
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/services/checkind"
+	"magma/orc8r/cloud/go/services/config"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
+)
+
+// MagmaPackageName is the name of the gateway package whose version is
+// compared to the version of a rollout
+const MagmaPackageName = "magma"
+
+// GatewaySignals are the health signals of a gateway as of its last checkin
+type GatewaySignals struct {
+	GatewayID string
+	// LastCheckin is zero if the gateway never checked in
+	LastCheckin time.Time
+	// PackageVersion is the version of the magma package the gateway runs
+	PackageVersion string
+	// StatusMeta is the service status meta of the gateway's last checkin
+	StatusMeta map[string]string
+}
+
+// SignalSource provides the health signals of the gateways in a tier
+type SignalSource interface {
+	GetTierSignals(networkID string, tierID string) ([]*GatewaySignals, error)
+}
+
+type checkindSignalSource struct{}
+
+// NewCheckindSignalSource returns a SignalSource which finds the gateways of
+// a tier through their magmad gateway configs and reads their signals from
+// checkind
+func NewCheckindSignalSource() SignalSource {
+	return &checkindSignalSource{}
+}
+
+func (*checkindSignalSource) GetTierSignals(networkID string, tierID string) ([]*GatewaySignals, error) {
+	gatewayConfigs, err := config.GetConfigsByType(networkID, magmad_config.MagmadGatewayType)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load gateway configs: %v", err)
+	}
+	gatewayIDs := make([]string, 0, len(gatewayConfigs))
+	for tk, iConfig := range gatewayConfigs {
+		gatewayConfig, ok := iConfig.(*magmad_protos.MagmadGatewayConfig)
+		if ok && gatewayConfig.GetTier() == tierID {
+			gatewayIDs = append(gatewayIDs, tk.Key)
+		}
+	}
+	sort.Strings(gatewayIDs)
+
+	ret := make([]*GatewaySignals, 0, len(gatewayIDs))
+	for _, gatewayID := range gatewayIDs {
+		signals := &GatewaySignals{GatewayID: gatewayID}
+		status, err := checkind.GetStatus(networkID, gatewayID)
+		if err == errors.ErrNotFound {
+			ret = append(ret, signals)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get status of gateway %s: %v", gatewayID, err)
+		}
+		signals.LastCheckin = time.Unix(0, int64(status.GetTime())*int64(time.Millisecond))
+		checkin := status.GetCheckin()
+		signals.PackageVersion = checkin.GetMagmaPkgVersion()
+		for _, pkg := range checkin.GetPlatformInfo().GetPackages() {
+			if pkg.GetName() == MagmaPackageName {
+				signals.PackageVersion = pkg.GetVersion()
+			}
+		}
+		signals.StatusMeta = checkin.GetStatus().GetMeta()
+		ret = append(ret, signals)
+	}
+	return ret, nil
+}
@@ -26,6 +26,9 @@ const (
 	ReleaseChannelsManagePath = ReleaseChannelsRootPath + "/:channel_id"
 	TiersRootPath             = handlers.REST_ROOT + "/networks/:network_id/tiers"
 	TiersManagePath           = TiersRootPath + "/:tier_id"
+	RolloutsRootPath          = handlers.REST_ROOT + "/networks/:network_id/rollouts"
+	RolloutsManagePath        = RolloutsRootPath + "/:rollout_id"
+	RolloutAbortPath          = RolloutsManagePath + "/abort"
 )
 
 // GetObsidianHandlers returns the obsidian handlers for upgrade
@@ -41,6 +44,10 @@ func GetObsidianHandlers() []handlers.Handler {
 		{Path: TiersManagePath, Methods: handlers.GET, HandlerFunc: getTierHandler},
 		{Path: TiersManagePath, Methods: handlers.PUT, HandlerFunc: updateTierHandler},
 		{Path: TiersManagePath, Methods: handlers.DELETE, HandlerFunc: deleteTierHandler},
+		{Path: RolloutsRootPath, Methods: handlers.GET, HandlerFunc: listRolloutsHandler},
+		{Path: RolloutsRootPath, Methods: handlers.POST, HandlerFunc: createRolloutHandler},
+		{Path: RolloutsManagePath, Methods: handlers.GET, HandlerFunc: getRolloutHandler},
+		{Path: RolloutAbortPath, Methods: handlers.POST, HandlerFunc: abortRolloutHandler},
 	}
 }
 
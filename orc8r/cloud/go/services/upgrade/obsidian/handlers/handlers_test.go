@@ -253,3 +253,134 @@ func TestTiers(t *testing.T) {
 	}
 	tests.RunTest(t, removeNetworkTestCase)
 }
+
+func TestRollouts(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	magmad_test_init.StartTestService(t)
+	upgrade_test_init.StartTestService(t)
+	restPort := tests.StartObsidian(t)
+	netUrlRoot := fmt.Sprintf("http://localhost:%d%s/networks", restPort, handlers.REST_ROOT)
+
+	registerNetworkTestCase := tests.Testcase{
+		Name:                      "Register Network",
+		Method:                    "POST",
+		Url:                       fmt.Sprintf("%s?requested_id=upgrade_rollout_test_network", netUrlRoot),
+		Payload:                   `{"name":"This Is A Test Network Name"}`,
+		Skip_payload_verification: true,
+	}
+	_, networkId, err := tests.RunTest(t, registerNetworkTestCase)
+	assert.NoError(t, err)
+	json.Unmarshal([]byte(networkId), &networkId)
+
+	createTierTestCase := tests.Testcase{
+		Name:                      "Create Tier",
+		Method:                    "POST",
+		Url:                       fmt.Sprintf("%s/%s/tiers", netUrlRoot, networkId),
+		Payload:                   `{"id": "canary", "name": "canary", "version": "1.0.0-0"}`,
+		Skip_payload_verification: true,
+	}
+	tests.RunTest(t, createTierTestCase)
+	createTierTestCase.Payload = `{"id": "prod", "name": "prod", "version": "1.0.0-0"}`
+	tests.RunTest(t, createTierTestCase)
+
+	testUrlRoot := fmt.Sprintf("%s/%s/rollouts", netUrlRoot, networkId)
+	listRolloutsTestCase := tests.Testcase{
+		Name:     "List Rollouts",
+		Method:   "GET",
+		Url:      testUrlRoot,
+		Payload:  "",
+		Expected: "[]",
+	}
+	tests.RunTest(t, listRolloutsTestCase)
+
+	// Plans missing health criteria are rejected
+	status, _, err := tests.SendHttpRequest(
+		"POST",
+		testUrlRoot,
+		`{"id": "r1", "version": "1.1.0-0", "tiers": ["canary", "prod"]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, status)
+
+	const planContents = `{
+		"id": "r1",
+		"version": "1.1.0-0",
+		"tiers": ["canary", "prod"],
+		"soak_period_secs": 3600,
+		"health_criteria": {"max_checkin_age_secs": 300, "max_unhealthy_gateways": 1}
+	}`
+	createRolloutTestCase := tests.Testcase{
+		Name:     "Create Rollout",
+		Method:   "POST",
+		Url:      testUrlRoot,
+		Payload:  planContents,
+		Expected: `"r1"`,
+	}
+	tests.RunTest(t, createRolloutTestCase)
+
+	listRolloutsTestCase.Expected = `["r1"]`
+	tests.RunTest(t, listRolloutsTestCase)
+
+	// Canary tier is upgraded
+	getTierTestCase := tests.Testcase{
+		Name:     "Get Tier",
+		Method:   "GET",
+		Url:      fmt.Sprintf("%s/%s/tiers/canary", netUrlRoot, networkId),
+		Payload:  "",
+		Expected: `{"id": "canary", "name": "canary", "version": "1.1.0-0", "images": null}`,
+	}
+	tests.RunTest(t, getTierTestCase)
+
+	status, body, err := tests.SendHttpRequest("GET", testUrlRoot+"/r1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	rollout := map[string]map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(body), &rollout))
+	assert.Equal(t, "IN_PROGRESS", rollout["status"]["state"])
+	assert.Equal(t, "canary", rollout["status"]["current_tier"])
+	assert.Equal(t, map[string]interface{}{"canary": "1.0.0-0"}, rollout["status"]["previous_versions"])
+	assert.Equal(t, "1.1.0-0", rollout["plan"]["version"])
+
+	// Only one rollout at a time
+	status, _, err = tests.SendHttpRequest(
+		"POST",
+		testUrlRoot,
+		`{"id": "r2", "version": "1.2.0-0", "tiers": ["prod"], "health_criteria": {"max_checkin_age_secs": 300}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 409, status)
+
+	// Abort reverts the canary tier
+	abortRolloutTestCase := tests.Testcase{
+		Name:     "Abort Rollout",
+		Method:   "POST",
+		Url:      testUrlRoot + "/r1/abort",
+		Payload:  "",
+		Expected: "",
+	}
+	tests.RunTest(t, abortRolloutTestCase)
+	getTierTestCase.Expected = `{"id": "canary", "name": "canary", "version": "1.0.0-0", "images": null}`
+	tests.RunTest(t, getTierTestCase)
+
+	status, body, err = tests.SendHttpRequest("GET", testUrlRoot+"/r1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	assert.NoError(t, json.Unmarshal([]byte(body), &rollout))
+	assert.Equal(t, "ABORTED", rollout["status"]["state"])
+
+	// Aborting twice conflicts, unknown rollouts 404
+	status, _, err = tests.SendHttpRequest("POST", testUrlRoot+"/r1/abort", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 409, status)
+	status, _, err = tests.SendHttpRequest("GET", testUrlRoot+"/r3", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 404, status)
+
+	// Remove network
+	removeNetworkTestCase := tests.Testcase{
+		Name:     "Force Remove Non Empty Network",
+		Method:   "DELETE",
+		Url:      fmt.Sprintf("%s/%s?mode=force", netUrlRoot, networkId),
+		Payload:  "",
+		Expected: "",
+	}
+	tests.RunTest(t, removeNetworkTestCase)
+}
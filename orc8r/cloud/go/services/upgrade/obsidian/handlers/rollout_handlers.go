@@ -0,0 +1,177 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	upgrade_client "magma/orc8r/cloud/go/services/upgrade"
+	"magma/orc8r/cloud/go/services/upgrade/obsidian/models"
+	"magma/orc8r/cloud/go/services/upgrade/protos"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func listRolloutsHandler(c echo.Context) error {
+	networkId, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	rollouts, err := upgrade_client.ListRollouts(networkId)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+
+	ret := make([]string, 0, len(rollouts))
+	for rolloutId := range rollouts {
+		ret = append(ret, rolloutId)
+	}
+	// Return a deterministic ordering of rollouts
+	sort.Strings(ret)
+	return c.JSON(http.StatusOK, ret)
+}
+
+func createRolloutHandler(c echo.Context) error {
+	networkId, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	restPlan := new(models.RolloutPlan)
+	if err := c.Bind(restPlan); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := restPlan.Validate(strfmt.Default); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+
+	err := upgrade_client.CreateRollout(networkId, string(restPlan.ID), rolloutPlanModelToProto(restPlan))
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	// Return the ID of the created rollout
+	return c.JSON(http.StatusCreated, restPlan.ID)
+}
+
+func getRolloutHandler(c echo.Context) error {
+	networkId, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	rolloutId := c.Param("rollout_id")
+	if rolloutId == "" {
+		return noRolloutIdError()
+	}
+
+	rollout, err := upgrade_client.GetRollout(networkId, rolloutId)
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	return c.JSON(http.StatusOK, rolloutProtoToModel(rolloutId, rollout))
+}
+
+func abortRolloutHandler(c echo.Context) error {
+	networkId, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	rolloutId := c.Param("rollout_id")
+	if rolloutId == "" {
+		return noRolloutIdError()
+	}
+
+	err := upgrade_client.AbortRollout(networkId, rolloutId)
+	if err != nil {
+		return rolloutHttpError(err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func rolloutPlanModelToProto(model *models.RolloutPlan) *protos.RolloutPlan {
+	tiers := make([]string, 0, len(model.Tiers))
+	for _, tier := range model.Tiers {
+		tiers = append(tiers, string(tier))
+	}
+	return &protos.RolloutPlan{
+		Version:        *model.Version,
+		Tiers:          tiers,
+		SoakPeriodSecs: model.SoakPeriodSecs,
+		HealthCriteria: &protos.HealthCriteria{
+			MaxCheckinAgeSecs:    *model.HealthCriteria.MaxCheckinAgeSecs,
+			RequiredStatusMeta:   model.HealthCriteria.RequiredStatusMeta,
+			RequireTargetVersion: model.HealthCriteria.RequireTargetVersion,
+			MaxUnhealthyGateways: model.HealthCriteria.MaxUnhealthyGateways,
+		},
+	}
+}
+
+func rolloutProtoToModel(rolloutId string, rollout *protos.Rollout) *models.Rollout {
+	plan := rollout.GetPlan()
+	tiers := make([]models.TierID, 0, len(plan.GetTiers()))
+	for _, tier := range plan.GetTiers() {
+		tiers = append(tiers, models.TierID(tier))
+	}
+	version := plan.GetVersion()
+	maxCheckinAgeSecs := plan.GetHealthCriteria().GetMaxCheckinAgeSecs()
+
+	rolloutStatus := rollout.GetStatus()
+	var currentTier models.TierID
+	if idx := int(rolloutStatus.GetCurrentTierIndex()); idx < len(tiers) {
+		currentTier = tiers[idx]
+	}
+	return &models.Rollout{
+		Plan: &models.RolloutPlan{
+			ID:             models.RolloutID(rolloutId),
+			Version:        &version,
+			Tiers:          tiers,
+			SoakPeriodSecs: plan.GetSoakPeriodSecs(),
+			HealthCriteria: &models.HealthCriteria{
+				MaxCheckinAgeSecs:    &maxCheckinAgeSecs,
+				RequiredStatusMeta:   plan.GetHealthCriteria().GetRequiredStatusMeta(),
+				RequireTargetVersion: plan.GetHealthCriteria().GetRequireTargetVersion(),
+				MaxUnhealthyGateways: plan.GetHealthCriteria().GetMaxUnhealthyGateways(),
+			},
+		},
+		Status: &models.RolloutStatus{
+			State:             rolloutStatus.GetState().String(),
+			CurrentTier:       currentTier,
+			TierStartedAt:     rolloutStatus.GetTierStartedAt(),
+			PreviousVersions:  rolloutStatus.GetPreviousVersions(),
+			UnhealthyGateways: rolloutStatus.GetUnhealthyGateways(),
+			Message:           rolloutStatus.GetMessage(),
+			CreatedAt:         rolloutStatus.GetCreatedAt(),
+			UpdatedAt:         rolloutStatus.GetUpdatedAt(),
+		},
+	}
+}
+
+// rolloutHttpError maps the errors of the rollout RPCs to HTTP statuses
+func rolloutHttpError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return handlers.HttpError(err, http.StatusNotFound)
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return handlers.HttpError(err, http.StatusConflict)
+	default:
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+}
+
+func noRolloutIdError() error {
+	return handlers.HttpError(
+		errors.New("Missing rollout ID"),
+		http.StatusBadRequest,
+	)
+}
@@ -10,6 +10,7 @@ package protos
 
 import (
 	"errors"
+	"fmt"
 )
 
 func ValidateCreateOrUpdateReleaseChannelReq(req *CreateOrUpdateReleaseChannelRequest) error {
@@ -112,3 +113,81 @@ func ValidateTierInfo(info *TierInfo) error {
 	}
 	return nil
 }
+
+func ValidateCreateRolloutReq(req *CreateRolloutRequest) error {
+	if req == nil {
+		return errors.New("Request is nil")
+	}
+	if req.GetNetworkId() == "" {
+		return errors.New("NetworkID must be specified")
+	}
+	if req.GetRolloutId() == "" {
+		return errors.New("Rollout ID must be specified")
+	}
+	return ValidateRolloutPlan(req.GetPlan())
+}
+
+func ValidateGetRolloutReq(req *GetRolloutRequest) error {
+	if req == nil {
+		return errors.New("Request is nil")
+	}
+	if req.GetNetworkId() == "" {
+		return errors.New("NetworkID must be specified")
+	}
+	if req.GetRolloutId() == "" {
+		return errors.New("Rollout ID must be specified")
+	}
+	return nil
+}
+
+func ValidateListRolloutsReq(req *ListRolloutsRequest) error {
+	if req == nil {
+		return errors.New("Request is nil")
+	}
+	if req.GetNetworkId() == "" {
+		return errors.New("NetworkID must be specified")
+	}
+	return nil
+}
+
+func ValidateAbortRolloutReq(req *AbortRolloutRequest) error {
+	if req == nil {
+		return errors.New("Request is nil")
+	}
+	if req.GetNetworkId() == "" {
+		return errors.New("NetworkID must be specified")
+	}
+	if req.GetRolloutId() == "" {
+		return errors.New("Rollout ID must be specified")
+	}
+	return nil
+}
+
+func ValidateRolloutPlan(plan *RolloutPlan) error {
+	if plan == nil {
+		return errors.New("Rollout plan must be specified")
+	}
+	if plan.GetVersion() == "" {
+		return errors.New("Version must be specified")
+	}
+	if len(plan.GetTiers()) == 0 {
+		return errors.New("At least one tier must be specified")
+	}
+	seenTiers := map[string]bool{}
+	for _, tier := range plan.GetTiers() {
+		if tier == "" {
+			return errors.New("Tier IDs must not be empty")
+		}
+		if seenTiers[tier] {
+			return fmt.Errorf("Tier %s is listed more than once", tier)
+		}
+		seenTiers[tier] = true
+	}
+	if plan.GetHealthCriteria() == nil {
+		return errors.New("Health criteria must be specified")
+	}
+	if plan.GetHealthCriteria().GetMaxCheckinAgeSecs() == 0 {
+		return errors.New("Max checkin age must be positive")
+	}
+	return nil
+}
@@ -24,6 +24,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type RolloutStatus_State int32
+
+const (
+	RolloutStatus_IN_PROGRESS RolloutStatus_State = 0
+	// All tiers were upgraded and passed their health evaluation
+	RolloutStatus_SUCCEEDED RolloutStatus_State = 1
+	// A tier failed its health evaluation and was reverted
+	RolloutStatus_HALTED RolloutStatus_State = 2
+	// The rollout was aborted and its current tier was reverted
+	RolloutStatus_ABORTED RolloutStatus_State = 3
+	// A tier failed its health evaluation and is being reverted. The
+	// rollout is halted once the revert succeeds.
+	RolloutStatus_REVERTING RolloutStatus_State = 4
+)
+
+var RolloutStatus_State_name = map[int32]string{
+	0: "IN_PROGRESS",
+	1: "SUCCEEDED",
+	2: "HALTED",
+	3: "ABORTED",
+	4: "REVERTING",
+}
+var RolloutStatus_State_value = map[string]int32{
+	"IN_PROGRESS": 0,
+	"SUCCEEDED":   1,
+	"HALTED":      2,
+	"ABORTED":     3,
+	"REVERTING":   4,
+}
+
+func (x RolloutStatus_State) String() string {
+	return proto.EnumName(RolloutStatus_State_name, int32(x))
+}
+func (RolloutStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{19, 0}
+}
+
 type ListReleaseChannelsResponse struct {
 	ChannelIds           []string `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -35,7 +72,7 @@ func (m *ListReleaseChannelsResponse) Reset()         { *m = ListReleaseChannels
 func (m *ListReleaseChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListReleaseChannelsResponse) ProtoMessage()    {}
 func (*ListReleaseChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{0}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{0}
 }
 func (m *ListReleaseChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListReleaseChannelsResponse.Unmarshal(m, b)
@@ -74,7 +111,7 @@ func (m *CreateOrUpdateReleaseChannelRequest) Reset()         { *m = CreateOrUpd
 func (m *CreateOrUpdateReleaseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateOrUpdateReleaseChannelRequest) ProtoMessage()    {}
 func (*CreateOrUpdateReleaseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{1}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{1}
 }
 func (m *CreateOrUpdateReleaseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateOrUpdateReleaseChannelRequest.Unmarshal(m, b)
@@ -119,7 +156,7 @@ func (m *GetReleaseChannelRequest) Reset()         { *m = GetReleaseChannelReque
 func (m *GetReleaseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReleaseChannelRequest) ProtoMessage()    {}
 func (*GetReleaseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{2}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{2}
 }
 func (m *GetReleaseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetReleaseChannelRequest.Unmarshal(m, b)
@@ -157,7 +194,7 @@ func (m *DeleteReleaseChannelRequest) Reset()         { *m = DeleteReleaseChanne
 func (m *DeleteReleaseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteReleaseChannelRequest) ProtoMessage()    {}
 func (*DeleteReleaseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{3}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{3}
 }
 func (m *DeleteReleaseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteReleaseChannelRequest.Unmarshal(m, b)
@@ -198,7 +235,7 @@ func (m *GetTiersRequest) Reset()         { *m = GetTiersRequest{} }
 func (m *GetTiersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTiersRequest) ProtoMessage()    {}
 func (*GetTiersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{4}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{4}
 }
 func (m *GetTiersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTiersRequest.Unmarshal(m, b)
@@ -244,7 +281,7 @@ func (m *GetTiersResponse) Reset()         { *m = GetTiersResponse{} }
 func (m *GetTiersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTiersResponse) ProtoMessage()    {}
 func (*GetTiersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{5}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{5}
 }
 func (m *GetTiersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTiersResponse.Unmarshal(m, b)
@@ -286,7 +323,7 @@ func (m *CreateTierRequest) Reset()         { *m = CreateTierRequest{} }
 func (m *CreateTierRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateTierRequest) ProtoMessage()    {}
 func (*CreateTierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{6}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{6}
 }
 func (m *CreateTierRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateTierRequest.Unmarshal(m, b)
@@ -342,7 +379,7 @@ func (m *UpdateTierRequest) Reset()         { *m = UpdateTierRequest{} }
 func (m *UpdateTierRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateTierRequest) ProtoMessage()    {}
 func (*UpdateTierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{7}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{7}
 }
 func (m *UpdateTierRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateTierRequest.Unmarshal(m, b)
@@ -396,7 +433,7 @@ func (m *DeleteTierRequest) Reset()         { *m = DeleteTierRequest{} }
 func (m *DeleteTierRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteTierRequest) ProtoMessage()    {}
 func (*DeleteTierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{8}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{8}
 }
 func (m *DeleteTierRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteTierRequest.Unmarshal(m, b)
@@ -430,6 +467,229 @@ func (m *DeleteTierRequest) GetTierIdToDelete() string {
 	return ""
 }
 
+type CreateRolloutRequest struct {
+	NetworkId            string       `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	RolloutId            string       `protobuf:"bytes,2,opt,name=rollout_id,json=rolloutId,proto3" json:"rollout_id,omitempty"`
+	Plan                 *RolloutPlan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateRolloutRequest) Reset()         { *m = CreateRolloutRequest{} }
+func (m *CreateRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRolloutRequest) ProtoMessage()    {}
+func (*CreateRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{9}
+}
+func (m *CreateRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRolloutRequest.Unmarshal(m, b)
+}
+func (m *CreateRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRolloutRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRolloutRequest.Merge(dst, src)
+}
+func (m *CreateRolloutRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRolloutRequest.Size(m)
+}
+func (m *CreateRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRolloutRequest proto.InternalMessageInfo
+
+func (m *CreateRolloutRequest) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+func (m *CreateRolloutRequest) GetRolloutId() string {
+	if m != nil {
+		return m.RolloutId
+	}
+	return ""
+}
+
+func (m *CreateRolloutRequest) GetPlan() *RolloutPlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+type GetRolloutRequest struct {
+	NetworkId            string   `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	RolloutId            string   `protobuf:"bytes,2,opt,name=rollout_id,json=rolloutId,proto3" json:"rollout_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRolloutRequest) Reset()         { *m = GetRolloutRequest{} }
+func (m *GetRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRolloutRequest) ProtoMessage()    {}
+func (*GetRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{10}
+}
+func (m *GetRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRolloutRequest.Unmarshal(m, b)
+}
+func (m *GetRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRolloutRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRolloutRequest.Merge(dst, src)
+}
+func (m *GetRolloutRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRolloutRequest.Size(m)
+}
+func (m *GetRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRolloutRequest proto.InternalMessageInfo
+
+func (m *GetRolloutRequest) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+func (m *GetRolloutRequest) GetRolloutId() string {
+	if m != nil {
+		return m.RolloutId
+	}
+	return ""
+}
+
+type ListRolloutsRequest struct {
+	NetworkId            string   `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRolloutsRequest) Reset()         { *m = ListRolloutsRequest{} }
+func (m *ListRolloutsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRolloutsRequest) ProtoMessage()    {}
+func (*ListRolloutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{11}
+}
+func (m *ListRolloutsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRolloutsRequest.Unmarshal(m, b)
+}
+func (m *ListRolloutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRolloutsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRolloutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolloutsRequest.Merge(dst, src)
+}
+func (m *ListRolloutsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRolloutsRequest.Size(m)
+}
+func (m *ListRolloutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolloutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRolloutsRequest proto.InternalMessageInfo
+
+func (m *ListRolloutsRequest) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+type ListRolloutsResponse struct {
+	// Maps rollout ID to rollout for each rollout in the network
+	Rollouts             map[string]*Rollout `protobuf:"bytes,1,rep,name=rollouts,proto3" json:"rollouts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListRolloutsResponse) Reset()         { *m = ListRolloutsResponse{} }
+func (m *ListRolloutsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRolloutsResponse) ProtoMessage()    {}
+func (*ListRolloutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{12}
+}
+func (m *ListRolloutsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRolloutsResponse.Unmarshal(m, b)
+}
+func (m *ListRolloutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRolloutsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRolloutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolloutsResponse.Merge(dst, src)
+}
+func (m *ListRolloutsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRolloutsResponse.Size(m)
+}
+func (m *ListRolloutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolloutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRolloutsResponse proto.InternalMessageInfo
+
+func (m *ListRolloutsResponse) GetRollouts() map[string]*Rollout {
+	if m != nil {
+		return m.Rollouts
+	}
+	return nil
+}
+
+type AbortRolloutRequest struct {
+	NetworkId            string   `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	RolloutId            string   `protobuf:"bytes,2,opt,name=rollout_id,json=rolloutId,proto3" json:"rollout_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbortRolloutRequest) Reset()         { *m = AbortRolloutRequest{} }
+func (m *AbortRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*AbortRolloutRequest) ProtoMessage()    {}
+func (*AbortRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{13}
+}
+func (m *AbortRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortRolloutRequest.Unmarshal(m, b)
+}
+func (m *AbortRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortRolloutRequest.Marshal(b, m, deterministic)
+}
+func (dst *AbortRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortRolloutRequest.Merge(dst, src)
+}
+func (m *AbortRolloutRequest) XXX_Size() int {
+	return xxx_messageInfo_AbortRolloutRequest.Size(m)
+}
+func (m *AbortRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortRolloutRequest proto.InternalMessageInfo
+
+func (m *AbortRolloutRequest) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+func (m *AbortRolloutRequest) GetRolloutId() string {
+	if m != nil {
+		return m.RolloutId
+	}
+	return ""
+}
+
 type ReleaseChannel struct {
 	SupportedVersions    []string `protobuf:"bytes,1,rep,name=supported_versions,json=supportedVersions,proto3" json:"supported_versions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -441,7 +701,7 @@ func (m *ReleaseChannel) Reset()         { *m = ReleaseChannel{} }
 func (m *ReleaseChannel) String() string { return proto.CompactTextString(m) }
 func (*ReleaseChannel) ProtoMessage()    {}
 func (*ReleaseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{9}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{14}
 }
 func (m *ReleaseChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReleaseChannel.Unmarshal(m, b)
@@ -480,7 +740,7 @@ func (m *ImageSpec) Reset()         { *m = ImageSpec{} }
 func (m *ImageSpec) String() string { return proto.CompactTextString(m) }
 func (*ImageSpec) ProtoMessage()    {}
 func (*ImageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{10}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{15}
 }
 func (m *ImageSpec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageSpec.Unmarshal(m, b)
@@ -527,7 +787,7 @@ func (m *TierInfo) Reset()         { *m = TierInfo{} }
 func (m *TierInfo) String() string { return proto.CompactTextString(m) }
 func (*TierInfo) ProtoMessage()    {}
 func (*TierInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{11}
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{16}
 }
 func (m *TierInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TierInfo.Unmarshal(m, b)
@@ -568,6 +828,285 @@ func (m *TierInfo) GetImages() []*ImageSpec {
 	return nil
 }
 
+type HealthCriteria struct {
+	// A gateway which hasn't checked in for longer is unhealthy
+	MaxCheckinAgeSecs uint32 `protobuf:"varint,1,opt,name=max_checkin_age_secs,json=maxCheckinAgeSecs,proto3" json:"max_checkin_age_secs,omitempty"`
+	// Entries every gateway must report in its checkin status meta,
+	// e.g. {"mme_status": "running"}
+	RequiredStatusMeta map[string]string `protobuf:"bytes,2,rep,name=required_status_meta,json=requiredStatusMeta,proto3" json:"required_status_meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If set, gateways which don't report the rollout version at the end of
+	// the soak period are unhealthy
+	RequireTargetVersion bool `protobuf:"varint,3,opt,name=require_target_version,json=requireTargetVersion,proto3" json:"require_target_version,omitempty"`
+	// Number of unhealthy gateways a tier tolerates before the rollout halts
+	MaxUnhealthyGateways uint32   `protobuf:"varint,4,opt,name=max_unhealthy_gateways,json=maxUnhealthyGateways,proto3" json:"max_unhealthy_gateways,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCriteria) Reset()         { *m = HealthCriteria{} }
+func (m *HealthCriteria) String() string { return proto.CompactTextString(m) }
+func (*HealthCriteria) ProtoMessage()    {}
+func (*HealthCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{17}
+}
+func (m *HealthCriteria) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCriteria.Unmarshal(m, b)
+}
+func (m *HealthCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCriteria.Marshal(b, m, deterministic)
+}
+func (dst *HealthCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCriteria.Merge(dst, src)
+}
+func (m *HealthCriteria) XXX_Size() int {
+	return xxx_messageInfo_HealthCriteria.Size(m)
+}
+func (m *HealthCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCriteria proto.InternalMessageInfo
+
+func (m *HealthCriteria) GetMaxCheckinAgeSecs() uint32 {
+	if m != nil {
+		return m.MaxCheckinAgeSecs
+	}
+	return 0
+}
+
+func (m *HealthCriteria) GetRequiredStatusMeta() map[string]string {
+	if m != nil {
+		return m.RequiredStatusMeta
+	}
+	return nil
+}
+
+func (m *HealthCriteria) GetRequireTargetVersion() bool {
+	if m != nil {
+		return m.RequireTargetVersion
+	}
+	return false
+}
+
+func (m *HealthCriteria) GetMaxUnhealthyGateways() uint32 {
+	if m != nil {
+		return m.MaxUnhealthyGateways
+	}
+	return 0
+}
+
+type RolloutPlan struct {
+	// Version the tiers are moved to
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Tiers in the order they are upgraded. The first tier is the canary.
+	Tiers []string `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// Time a tier runs the new version before its health is evaluated
+	SoakPeriodSecs       uint32          `protobuf:"varint,3,opt,name=soak_period_secs,json=soakPeriodSecs,proto3" json:"soak_period_secs,omitempty"`
+	HealthCriteria       *HealthCriteria `protobuf:"bytes,4,opt,name=health_criteria,json=healthCriteria,proto3" json:"health_criteria,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RolloutPlan) Reset()         { *m = RolloutPlan{} }
+func (m *RolloutPlan) String() string { return proto.CompactTextString(m) }
+func (*RolloutPlan) ProtoMessage()    {}
+func (*RolloutPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{18}
+}
+func (m *RolloutPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RolloutPlan.Unmarshal(m, b)
+}
+func (m *RolloutPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RolloutPlan.Marshal(b, m, deterministic)
+}
+func (dst *RolloutPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutPlan.Merge(dst, src)
+}
+func (m *RolloutPlan) XXX_Size() int {
+	return xxx_messageInfo_RolloutPlan.Size(m)
+}
+func (m *RolloutPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutPlan proto.InternalMessageInfo
+
+func (m *RolloutPlan) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *RolloutPlan) GetTiers() []string {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *RolloutPlan) GetSoakPeriodSecs() uint32 {
+	if m != nil {
+		return m.SoakPeriodSecs
+	}
+	return 0
+}
+
+func (m *RolloutPlan) GetHealthCriteria() *HealthCriteria {
+	if m != nil {
+		return m.HealthCriteria
+	}
+	return nil
+}
+
+type RolloutStatus struct {
+	State RolloutStatus_State `protobuf:"varint,1,opt,name=state,proto3,enum=magma.orc8r.upgrade.RolloutStatus_State" json:"state,omitempty"`
+	// Index in the plan's tiers of the tier being soaked
+	CurrentTierIndex uint32 `protobuf:"varint,2,opt,name=current_tier_index,json=currentTierIndex,proto3" json:"current_tier_index,omitempty"`
+	// Unix time (in seconds) the current tier was upgraded at
+	TierStartedAt int64 `protobuf:"varint,3,opt,name=tier_started_at,json=tierStartedAt,proto3" json:"tier_started_at,omitempty"`
+	// Maps the tiers the rollout upgraded to their version before the rollout
+	PreviousVersions map[string]string `protobuf:"bytes,4,rep,name=previous_versions,json=previousVersions,proto3" json:"previous_versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Maps the gateways of the last evaluated tier which were unhealthy to
+	// the reason why
+	UnhealthyGateways map[string]string `protobuf:"bytes,5,rep,name=unhealthy_gateways,json=unhealthyGateways,proto3" json:"unhealthy_gateways,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Human readable explanation of the state
+	Message              string   `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RolloutStatus) Reset()         { *m = RolloutStatus{} }
+func (m *RolloutStatus) String() string { return proto.CompactTextString(m) }
+func (*RolloutStatus) ProtoMessage()    {}
+func (*RolloutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{19}
+}
+func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RolloutStatus.Unmarshal(m, b)
+}
+func (m *RolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RolloutStatus.Marshal(b, m, deterministic)
+}
+func (dst *RolloutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStatus.Merge(dst, src)
+}
+func (m *RolloutStatus) XXX_Size() int {
+	return xxx_messageInfo_RolloutStatus.Size(m)
+}
+func (m *RolloutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
+
+func (m *RolloutStatus) GetState() RolloutStatus_State {
+	if m != nil {
+		return m.State
+	}
+	return RolloutStatus_IN_PROGRESS
+}
+
+func (m *RolloutStatus) GetCurrentTierIndex() uint32 {
+	if m != nil {
+		return m.CurrentTierIndex
+	}
+	return 0
+}
+
+func (m *RolloutStatus) GetTierStartedAt() int64 {
+	if m != nil {
+		return m.TierStartedAt
+	}
+	return 0
+}
+
+func (m *RolloutStatus) GetPreviousVersions() map[string]string {
+	if m != nil {
+		return m.PreviousVersions
+	}
+	return nil
+}
+
+func (m *RolloutStatus) GetUnhealthyGateways() map[string]string {
+	if m != nil {
+		return m.UnhealthyGateways
+	}
+	return nil
+}
+
+func (m *RolloutStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *RolloutStatus) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *RolloutStatus) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type Rollout struct {
+	Plan                 *RolloutPlan   `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	Status               *RolloutStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Rollout) Reset()         { *m = Rollout{} }
+func (m *Rollout) String() string { return proto.CompactTextString(m) }
+func (*Rollout) ProtoMessage()    {}
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_4034cb8585ca5f5f, []int{20}
+}
+func (m *Rollout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rollout.Unmarshal(m, b)
+}
+func (m *Rollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rollout.Marshal(b, m, deterministic)
+}
+func (dst *Rollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rollout.Merge(dst, src)
+}
+func (m *Rollout) XXX_Size() int {
+	return xxx_messageInfo_Rollout.Size(m)
+}
+func (m *Rollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rollout proto.InternalMessageInfo
+
+func (m *Rollout) GetPlan() *RolloutPlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *Rollout) GetStatus() *RolloutStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ListReleaseChannelsResponse)(nil), "magma.orc8r.upgrade.ListReleaseChannelsResponse")
 	proto.RegisterType((*CreateOrUpdateReleaseChannelRequest)(nil), "magma.orc8r.upgrade.CreateOrUpdateReleaseChannelRequest")
@@ -579,9 +1118,23 @@ func init() {
 	proto.RegisterType((*CreateTierRequest)(nil), "magma.orc8r.upgrade.CreateTierRequest")
 	proto.RegisterType((*UpdateTierRequest)(nil), "magma.orc8r.upgrade.UpdateTierRequest")
 	proto.RegisterType((*DeleteTierRequest)(nil), "magma.orc8r.upgrade.DeleteTierRequest")
+	proto.RegisterType((*CreateRolloutRequest)(nil), "magma.orc8r.upgrade.CreateRolloutRequest")
+	proto.RegisterType((*GetRolloutRequest)(nil), "magma.orc8r.upgrade.GetRolloutRequest")
+	proto.RegisterType((*ListRolloutsRequest)(nil), "magma.orc8r.upgrade.ListRolloutsRequest")
+	proto.RegisterType((*ListRolloutsResponse)(nil), "magma.orc8r.upgrade.ListRolloutsResponse")
+	proto.RegisterMapType((map[string]*Rollout)(nil), "magma.orc8r.upgrade.ListRolloutsResponse.RolloutsEntry")
+	proto.RegisterType((*AbortRolloutRequest)(nil), "magma.orc8r.upgrade.AbortRolloutRequest")
 	proto.RegisterType((*ReleaseChannel)(nil), "magma.orc8r.upgrade.ReleaseChannel")
 	proto.RegisterType((*ImageSpec)(nil), "magma.orc8r.upgrade.ImageSpec")
 	proto.RegisterType((*TierInfo)(nil), "magma.orc8r.upgrade.TierInfo")
+	proto.RegisterType((*HealthCriteria)(nil), "magma.orc8r.upgrade.HealthCriteria")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.upgrade.HealthCriteria.RequiredStatusMetaEntry")
+	proto.RegisterType((*RolloutPlan)(nil), "magma.orc8r.upgrade.RolloutPlan")
+	proto.RegisterType((*RolloutStatus)(nil), "magma.orc8r.upgrade.RolloutStatus")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.upgrade.RolloutStatus.PreviousVersionsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.upgrade.RolloutStatus.UnhealthyGatewaysEntry")
+	proto.RegisterType((*Rollout)(nil), "magma.orc8r.upgrade.Rollout")
+	proto.RegisterEnum("magma.orc8r.upgrade.RolloutStatus_State", RolloutStatus_State_name, RolloutStatus_State_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -610,6 +1163,15 @@ type UpgradeServiceClient interface {
 	UpdateTier(ctx context.Context, in *UpdateTierRequest, opts ...grpc.CallOption) (*protos.Void, error)
 	// Delete a tier in a network.
 	DeleteTier(ctx context.Context, in *DeleteTierRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// Start a rollout in a network by upgrading the plan's first tier.
+	// A network can only have one rollout in progress at a time.
+	CreateRollout(ctx context.Context, in *CreateRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// Get a rollout and its status.
+	GetRollout(ctx context.Context, in *GetRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
+	// List all rollouts of a network.
+	ListRollouts(ctx context.Context, in *ListRolloutsRequest, opts ...grpc.CallOption) (*ListRolloutsResponse, error)
+	// Abort a rollout in progress, reverting the tier being soaked.
+	AbortRollout(ctx context.Context, in *AbortRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error)
 }
 
 type upgradeServiceClient struct {
@@ -701,6 +1263,42 @@ func (c *upgradeServiceClient) DeleteTier(ctx context.Context, in *DeleteTierReq
 	return out, nil
 }
 
+func (c *upgradeServiceClient) CreateRollout(ctx context.Context, in *CreateRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/CreateRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *upgradeServiceClient) GetRollout(ctx context.Context, in *GetRolloutRequest, opts ...grpc.CallOption) (*Rollout, error) {
+	out := new(Rollout)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/GetRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *upgradeServiceClient) ListRollouts(ctx context.Context, in *ListRolloutsRequest, opts ...grpc.CallOption) (*ListRolloutsResponse, error) {
+	out := new(ListRolloutsResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/ListRollouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *upgradeServiceClient) AbortRollout(ctx context.Context, in *AbortRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/AbortRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UpgradeServiceServer is the server API for UpgradeService service.
 type UpgradeServiceServer interface {
 	CreateReleaseChannel(context.Context, *CreateOrUpdateReleaseChannelRequest) (*protos.Void, error)
@@ -717,6 +1315,15 @@ type UpgradeServiceServer interface {
 	UpdateTier(context.Context, *UpdateTierRequest) (*protos.Void, error)
 	// Delete a tier in a network.
 	DeleteTier(context.Context, *DeleteTierRequest) (*protos.Void, error)
+	// Start a rollout in a network by upgrading the plan's first tier.
+	// A network can only have one rollout in progress at a time.
+	CreateRollout(context.Context, *CreateRolloutRequest) (*protos.Void, error)
+	// Get a rollout and its status.
+	GetRollout(context.Context, *GetRolloutRequest) (*Rollout, error)
+	// List all rollouts of a network.
+	ListRollouts(context.Context, *ListRolloutsRequest) (*ListRolloutsResponse, error)
+	// Abort a rollout in progress, reverting the tier being soaked.
+	AbortRollout(context.Context, *AbortRolloutRequest) (*protos.Void, error)
 }
 
 func RegisterUpgradeServiceServer(s *grpc.Server, srv UpgradeServiceServer) {
@@ -885,6 +1492,78 @@ func _UpgradeService_DeleteTier_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UpgradeService_CreateRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).CreateRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/CreateRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).CreateRollout(ctx, req.(*CreateRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UpgradeService_GetRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).GetRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/GetRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).GetRollout(ctx, req.(*GetRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UpgradeService_ListRollouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolloutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).ListRollouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/ListRollouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).ListRollouts(ctx, req.(*ListRolloutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UpgradeService_AbortRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).AbortRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/AbortRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).AbortRollout(ctx, req.(*AbortRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UpgradeService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.upgrade.UpgradeService",
 	HandlerType: (*UpgradeServiceServer)(nil),
@@ -925,58 +1604,116 @@ var _UpgradeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTier",
 			Handler:    _UpgradeService_DeleteTier_Handler,
 		},
+		{
+			MethodName: "CreateRollout",
+			Handler:    _UpgradeService_CreateRollout_Handler,
+		},
+		{
+			MethodName: "GetRollout",
+			Handler:    _UpgradeService_GetRollout_Handler,
+		},
+		{
+			MethodName: "ListRollouts",
+			Handler:    _UpgradeService_ListRollouts_Handler,
+		},
+		{
+			MethodName: "AbortRollout",
+			Handler:    _UpgradeService_AbortRollout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "upgrade_service.proto",
 }
 
 func init() {
-	proto.RegisterFile("upgrade_service.proto", fileDescriptor_upgrade_service_e8f4c9695f70c9a6)
-}
-
-var fileDescriptor_upgrade_service_e8f4c9695f70c9a6 = []byte{
-	// 687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xef, 0x4e, 0x13, 0x4f,
-	0x14, 0xed, 0xd2, 0x1f, 0xa5, 0xbd, 0x25, 0xfc, 0xd8, 0x01, 0xe3, 0x5a, 0x82, 0xe2, 0xa0, 0xa6,
-	0x7e, 0xa0, 0x18, 0x88, 0x86, 0x90, 0xa0, 0x44, 0x14, 0xb2, 0x89, 0xd1, 0xb8, 0xfc, 0x31, 0x1a,
-	0xc9, 0x66, 0xed, 0x5e, 0x70, 0x43, 0x77, 0x67, 0x9d, 0xd9, 0x62, 0x78, 0x02, 0x3f, 0xf9, 0x34,
-	0xbe, 0x81, 0x4f, 0x66, 0x66, 0x67, 0x96, 0x02, 0x9d, 0xd6, 0x4a, 0xf8, 0xd4, 0xbd, 0x77, 0xee,
-	0x3d, 0x73, 0xe6, 0xcc, 0x9d, 0x93, 0xc2, 0xad, 0x6e, 0x7a, 0xcc, 0x83, 0x10, 0x7d, 0x81, 0xfc,
-	0x34, 0x6a, 0x63, 0x2b, 0xe5, 0x2c, 0x63, 0x64, 0x26, 0x0e, 0x8e, 0xe3, 0xa0, 0xc5, 0x78, 0x7b,
-	0x8d, 0xb7, 0x74, 0x49, 0xe3, 0x4e, 0x1e, 0x2e, 0xe7, 0x15, 0x62, 0xb9, 0xcd, 0xe2, 0x98, 0x25,
-	0xaa, 0x9e, 0x3e, 0x87, 0xb9, 0x37, 0x91, 0xc8, 0x3c, 0xec, 0x60, 0x20, 0x70, 0xeb, 0x6b, 0x90,
-	0x24, 0xd8, 0x11, 0x1e, 0x8a, 0x94, 0x25, 0x02, 0xc9, 0x3d, 0xa8, 0xb7, 0x55, 0xce, 0x8f, 0x42,
-	0xe1, 0x58, 0x0b, 0xe5, 0x66, 0xcd, 0x03, 0x9d, 0x72, 0x43, 0x41, 0x7f, 0x58, 0xb0, 0xb8, 0xc5,
-	0x31, 0xc8, 0xf0, 0x1d, 0xdf, 0x4f, 0xc3, 0x20, 0xc3, 0xcb, 0x50, 0x1e, 0x7e, 0xeb, 0xa2, 0xc8,
-	0xc8, 0x7d, 0x98, 0x2c, 0x80, 0x92, 0x20, 0x46, 0xc7, 0x5a, 0xb0, 0x9a, 0x35, 0xaf, 0x00, 0x7f,
-	0x1b, 0xc4, 0x48, 0x36, 0x60, 0x42, 0x87, 0xce, 0xd8, 0x82, 0xd5, 0xac, 0xaf, 0x2c, 0xb6, 0x0c,
-	0x87, 0x69, 0x5d, 0xc1, 0x2f, 0x7a, 0xe8, 0x06, 0x38, 0x3b, 0x98, 0x5d, 0x77, 0x77, 0xba, 0x09,
-	0x73, 0xaf, 0xb0, 0x83, 0xd7, 0xe7, 0x4f, 0xdf, 0xc3, 0xff, 0x3b, 0x98, 0xed, 0x45, 0xc8, 0x45,
-	0xd1, 0x35, 0x0f, 0x90, 0x60, 0xf6, 0x9d, 0xf1, 0x13, 0x3f, 0x0a, 0x75, 0x4f, 0x4d, 0x67, 0xdc,
-	0x50, 0xaa, 0x9b, 0x45, 0xc8, 0xfd, 0xa3, 0xa8, 0x93, 0x21, 0x77, 0xc6, 0x94, 0xba, 0x32, 0xb5,
-	0x9d, 0x67, 0xe8, 0x2f, 0x0b, 0xa6, 0x7b, 0x98, 0xfa, 0x4e, 0xb6, 0x61, 0x5c, 0x96, 0xa8, 0xdb,
-	0xa8, 0xaf, 0x3c, 0x31, 0xaa, 0x74, 0xb5, 0xab, 0x95, 0x47, 0xaf, 0x93, 0x8c, 0x9f, 0x79, 0xaa,
-	0xbd, 0xf1, 0x01, 0xa0, 0x97, 0x24, 0xd3, 0x50, 0x3e, 0xc1, 0x33, 0xcd, 0x51, 0x7e, 0x92, 0x55,
-	0x18, 0x3f, 0x0d, 0x3a, 0x5d, 0xd4, 0xb7, 0x31, 0x6f, 0xdc, 0x47, 0x22, 0xb8, 0xc9, 0x11, 0xf3,
-	0x54, 0xed, 0xfa, 0xd8, 0x9a, 0x25, 0x67, 0xc2, 0x56, 0x33, 0x21, 0x57, 0x47, 0xd4, 0xe2, 0x36,
-	0x4c, 0xe4, 0x5a, 0x44, 0x61, 0xbe, 0x5f, 0xcd, 0xab, 0xc8, 0xd0, 0x0d, 0xc9, 0x3a, 0xd4, 0xd4,
-	0x42, 0x72, 0xc4, 0x9c, 0xf2, 0x28, 0x54, 0xaa, 0x99, 0xfe, 0xa2, 0x3f, 0x2d, 0xb0, 0xd5, 0x54,
-	0xde, 0x04, 0x93, 0x4d, 0x98, 0xec, 0xe6, 0x60, 0xa1, 0x2f, 0x33, 0xa3, 0x91, 0xa9, 0xeb, 0x16,
-	0x99, 0xa0, 0x87, 0x60, 0xab, 0x21, 0xfb, 0x07, 0x3a, 0x8f, 0xc1, 0xd6, 0x74, 0xfc, 0x8c, 0xf9,
-	0x61, 0xde, 0xae, 0x89, 0x4d, 0x29, 0x62, 0x7b, 0x4c, 0x81, 0xd2, 0x17, 0x30, 0x75, 0x79, 0x7a,
-	0xc9, 0x12, 0x10, 0xd1, 0x4d, 0x53, 0xc6, 0x25, 0xe9, 0x53, 0xe4, 0x22, 0x62, 0x49, 0xf1, 0x8c,
-	0xed, 0xf3, 0x95, 0x03, 0xbd, 0x40, 0x9f, 0x42, 0xcd, 0x8d, 0x83, 0x63, 0xdc, 0x4d, 0xb1, 0x4d,
-	0x08, 0xfc, 0x77, 0x61, 0xd4, 0xf3, 0x6f, 0x32, 0x0b, 0xe3, 0x8c, 0x87, 0xf9, 0xac, 0x5a, 0xcd,
-	0xb2, 0xa7, 0x02, 0x9a, 0x42, 0xb5, 0x38, 0xaf, 0xb1, 0xcb, 0x81, 0x09, 0xbd, 0xb7, 0x26, 0x5e,
-	0x84, 0xe4, 0x19, 0x54, 0x22, 0xb9, 0xa1, 0x70, 0xca, 0xf9, 0x30, 0xdf, 0x35, 0x8a, 0x79, 0xce,
-	0xc9, 0xd3, 0xd5, 0x2b, 0xbf, 0x2b, 0x30, 0xb5, 0xaf, 0x56, 0x77, 0x95, 0xff, 0x11, 0x84, 0x59,
-	0x35, 0x74, 0x57, 0x24, 0x58, 0x33, 0x42, 0x8e, 0xe0, 0x59, 0x0d, 0xfb, 0x52, 0xe7, 0x01, 0x8b,
-	0x42, 0x5a, 0x22, 0x11, 0xd8, 0x7d, 0x36, 0x43, 0x96, 0x06, 0xbd, 0x41, 0x33, 0xf0, 0x28, 0xc6,
-	0x46, 0x4b, 0xe4, 0x33, 0xcc, 0x18, 0xbc, 0x99, 0xf4, 0xd3, 0x6a, 0x98, 0x3d, 0x60, 0x88, 0xb1,
-	0xd3, 0x92, 0xd4, 0xcb, 0x74, 0xf8, 0x9b, 0xd6, 0xeb, 0x10, 0x66, 0x4d, 0xbe, 0x4a, 0xcc, 0x94,
-	0x87, 0x58, 0xb0, 0x19, 0xfe, 0x23, 0x54, 0x0b, 0xab, 0x23, 0x0f, 0xfe, 0xe2, 0x84, 0x0a, 0xe6,
-	0xe1, 0x48, 0x7e, 0x49, 0x4b, 0xc4, 0x05, 0xe8, 0xb9, 0x18, 0x79, 0x34, 0x44, 0x96, 0x0b, 0xaf,
-	0xd9, 0xcc, 0xd2, 0x05, 0xe8, 0xd9, 0xd0, 0x00, 0xa8, 0x3e, 0x9f, 0x1a, 0x08, 0xd5, 0xb3, 0x90,
-	0x01, 0x50, 0x7d, 0x1e, 0x63, 0x84, 0x7a, 0x59, 0xfd, 0x54, 0x51, 0x7f, 0x09, 0xbe, 0xa8, 0xdf,
-	0xd5, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xa8, 0x91, 0x54, 0x55, 0x08, 0x00, 0x00,
+	proto.RegisterFile("upgrade_service.proto", fileDescriptor_upgrade_service_4034cb8585ca5f5f)
+}
+
+var fileDescriptor_upgrade_service_4034cb8585ca5f5f = []byte{
+	// 1358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x18, 0xef, 0x6e, 0x13, 0xc7,
+	0x33, 0x17, 0xc7, 0x89, 0x3d, 0x4e, 0x1c, 0x7b, 0x31, 0xe0, 0x9f, 0xf9, 0xd1, 0xa6, 0x47, 0x8b,
+	0x8c, 0xd4, 0x1a, 0x14, 0x68, 0x4b, 0xa9, 0xa0, 0x18, 0xc7, 0x04, 0x4b, 0x40, 0xc2, 0x39, 0xa1,
+	0xa2, 0x2a, 0x3a, 0x2d, 0xbe, 0xc1, 0x39, 0xc5, 0xbe, 0x33, 0x7b, 0xeb, 0x90, 0xa8, 0x0f, 0x50,
+	0xa9, 0x52, 0x9f, 0xa1, 0xdf, 0xfb, 0xb5, 0xea, 0x2b, 0xf4, 0x45, 0xfa, 0x22, 0xd5, 0xfe, 0xb9,
+	0xd8, 0x97, 0xac, 0x8d, 0x83, 0xf2, 0xc9, 0xb7, 0x33, 0x3b, 0xff, 0x67, 0x76, 0x66, 0x0c, 0x17,
+	0x87, 0x83, 0x2e, 0xa3, 0x1e, 0xba, 0x11, 0xb2, 0x03, 0xbf, 0x83, 0xb5, 0x01, 0x0b, 0x79, 0x48,
+	0x2e, 0xf4, 0x69, 0xb7, 0x4f, 0x6b, 0x21, 0xeb, 0xdc, 0x65, 0x35, 0x7d, 0xa5, 0xf2, 0x3f, 0x79,
+	0xbc, 0x29, 0x6f, 0x44, 0x37, 0x3b, 0x61, 0xbf, 0x1f, 0x06, 0xea, 0xbe, 0xfd, 0x00, 0xae, 0x3c,
+	0xf5, 0x23, 0xee, 0x60, 0x0f, 0x69, 0x84, 0x8d, 0x3d, 0x1a, 0x04, 0xd8, 0x8b, 0x1c, 0x8c, 0x06,
+	0x61, 0x10, 0x21, 0xf9, 0x14, 0x72, 0x1d, 0x05, 0x73, 0x7d, 0x2f, 0x2a, 0x5b, 0x6b, 0xa9, 0x6a,
+	0xd6, 0x01, 0x0d, 0x6a, 0x79, 0x91, 0xfd, 0xab, 0x05, 0xd7, 0x1a, 0x0c, 0x29, 0xc7, 0x2d, 0xb6,
+	0x3b, 0xf0, 0x28, 0xc7, 0x24, 0x2b, 0x07, 0xdf, 0x0d, 0x31, 0xe2, 0xe4, 0x33, 0x58, 0x8e, 0x19,
+	0x05, 0xb4, 0x8f, 0x65, 0x6b, 0xcd, 0xaa, 0x66, 0x9d, 0x98, 0xf9, 0x73, 0xda, 0x47, 0x72, 0x1f,
+	0x96, 0xf4, 0xb1, 0x3c, 0xbf, 0x66, 0x55, 0x73, 0xeb, 0xd7, 0x6a, 0x06, 0x63, 0x6a, 0x27, 0xf8,
+	0xc7, 0x34, 0xf6, 0x7d, 0x28, 0x6f, 0x22, 0xff, 0x58, 0xe9, 0xf6, 0x43, 0xb8, 0xb2, 0x81, 0x3d,
+	0xfc, 0x78, 0xfd, 0xed, 0x17, 0xb0, 0xba, 0x89, 0x7c, 0xc7, 0x47, 0x16, 0xc5, 0x54, 0x57, 0x01,
+	0x02, 0xe4, 0xef, 0x43, 0xb6, 0xef, 0xfa, 0x9e, 0xa6, 0xc9, 0x6a, 0x48, 0xcb, 0x13, 0xde, 0xe5,
+	0x3e, 0x32, 0xf7, 0xad, 0xdf, 0xe3, 0xc8, 0xca, 0xf3, 0xca, 0xbb, 0x02, 0xf4, 0x58, 0x42, 0xec,
+	0xbf, 0x2c, 0x28, 0x8c, 0x78, 0xea, 0x98, 0x3c, 0x86, 0xb4, 0xb8, 0xa2, 0xa2, 0x91, 0x5b, 0xbf,
+	0x65, 0xf4, 0xd2, 0x49, 0xaa, 0x9a, 0x3c, 0x35, 0x03, 0xce, 0x8e, 0x1c, 0x45, 0x5e, 0xf9, 0x11,
+	0x60, 0x04, 0x24, 0x05, 0x48, 0xed, 0xe3, 0x91, 0xd6, 0x51, 0x7c, 0x92, 0xdb, 0x90, 0x3e, 0xa0,
+	0xbd, 0x21, 0xea, 0x68, 0x5c, 0x35, 0xca, 0x11, 0x1c, 0x5a, 0xc1, 0xdb, 0xd0, 0x51, 0x77, 0xef,
+	0xcd, 0xdf, 0xb5, 0x44, 0x4e, 0x14, 0x55, 0x4e, 0x08, 0xec, 0x8c, 0xbe, 0xb8, 0x0c, 0x4b, 0xd2,
+	0x17, 0xbe, 0x27, 0xe5, 0x65, 0x9d, 0x45, 0x71, 0x6c, 0x79, 0xe4, 0x1e, 0x64, 0x15, 0x22, 0x78,
+	0x1b, 0x96, 0x53, 0xb3, 0xa8, 0x92, 0xe1, 0xfa, 0xcb, 0xfe, 0xdd, 0x82, 0xa2, 0xca, 0xca, 0xf3,
+	0xd0, 0xe4, 0x21, 0x2c, 0x0f, 0x25, 0x33, 0xcf, 0x15, 0x90, 0xd9, 0x94, 0xc9, 0x69, 0x12, 0x01,
+	0xb0, 0x5f, 0x43, 0x51, 0x25, 0xd9, 0x19, 0xd4, 0xb9, 0x01, 0x45, 0xad, 0x8e, 0xcb, 0x43, 0xd7,
+	0x93, 0xe4, 0x5a, 0xb1, 0xbc, 0x52, 0x6c, 0x27, 0x54, 0x4c, 0xed, 0xdf, 0x2c, 0x28, 0x29, 0xc7,
+	0x3b, 0x61, 0xaf, 0x17, 0x0e, 0xf9, 0x8c, 0x22, 0xae, 0x02, 0x30, 0x45, 0x30, 0x32, 0x3a, 0xab,
+	0x21, 0x2d, 0x8f, 0xdc, 0x81, 0x85, 0x41, 0x8f, 0x06, 0xda, 0xde, 0x35, 0x73, 0x55, 0xaa, 0xdb,
+	0xdb, 0x3d, 0x1a, 0x38, 0xf2, 0xb6, 0xfd, 0x02, 0x8a, 0xa2, 0x1e, 0xcf, 0x51, 0x11, 0xfb, 0x0e,
+	0x5c, 0x90, 0x8f, 0x95, 0x02, 0xcc, 0x58, 0x65, 0xf6, 0x3f, 0x16, 0x94, 0x92, 0x64, 0xba, 0x90,
+	0xda, 0x90, 0xd1, 0xbc, 0xe3, 0x5a, 0xfa, 0xd6, 0x68, 0x9b, 0x89, 0x38, 0x36, 0x58, 0x97, 0xd4,
+	0x31, 0xa3, 0xca, 0x2b, 0x58, 0x49, 0xa0, 0x0c, 0x85, 0xb5, 0x9e, 0x2c, 0xac, 0xff, 0x4f, 0x73,
+	0xe8, 0x78, 0x5d, 0xb5, 0xe1, 0x42, 0xfd, 0x4d, 0xc8, 0xce, 0xd7, 0xa7, 0x3f, 0x40, 0x3e, 0xf9,
+	0xe2, 0x91, 0xaf, 0x80, 0x44, 0xc3, 0xc1, 0x20, 0x64, 0x22, 0xd1, 0x0f, 0x90, 0x45, 0x7e, 0x18,
+	0xc4, 0x4f, 0x7f, 0xf1, 0x18, 0xf3, 0x52, 0x23, 0xec, 0xaf, 0x21, 0xdb, 0xea, 0xd3, 0x2e, 0xb6,
+	0x07, 0xd8, 0x21, 0x04, 0x16, 0xc6, 0x9e, 0x47, 0xf9, 0x4d, 0x4a, 0x90, 0x0e, 0x99, 0x27, 0xdf,
+	0x37, 0xab, 0x9a, 0x72, 0xd4, 0xc1, 0x1e, 0x40, 0x26, 0xae, 0x11, 0x23, 0x55, 0x19, 0x96, 0xb4,
+	0x6c, 0xad, 0x73, 0x7c, 0x24, 0xdf, 0xc0, 0xa2, 0x2f, 0x04, 0x46, 0xe5, 0x94, 0x0c, 0xda, 0x27,
+	0x46, 0xff, 0x1d, 0xeb, 0xe4, 0xe8, 0xdb, 0xf6, 0xbf, 0xf3, 0x90, 0x7f, 0x82, 0xb4, 0xc7, 0xf7,
+	0x1a, 0xcc, 0xe7, 0xc8, 0x7c, 0x4a, 0x6e, 0x42, 0xa9, 0x4f, 0x0f, 0xdd, 0xce, 0x1e, 0x76, 0xf6,
+	0xfd, 0xc0, 0xa5, 0x5d, 0xd1, 0x4e, 0x3b, 0x91, 0x54, 0x64, 0xc5, 0x29, 0xf6, 0xe9, 0x61, 0x43,
+	0xa1, 0xea, 0x5d, 0x6c, 0x63, 0x27, 0x22, 0x7d, 0x28, 0x31, 0x7c, 0x37, 0xf4, 0x19, 0x7a, 0x6e,
+	0xc4, 0x29, 0x1f, 0x46, 0x6e, 0x1f, 0x39, 0x95, 0x4f, 0x77, 0x6e, 0xfd, 0x7b, 0xa3, 0x26, 0x49,
+	0x99, 0x35, 0x47, 0xd3, 0xb7, 0x25, 0xf9, 0x33, 0xe4, 0x54, 0xa5, 0x10, 0x61, 0xa7, 0x10, 0xe4,
+	0x0e, 0x5c, 0xd2, 0x50, 0x97, 0x53, 0xd6, 0x45, 0x1e, 0xc7, 0x43, 0xd6, 0x62, 0xc6, 0x89, 0x95,
+	0xd9, 0x91, 0x48, 0x1d, 0x12, 0x41, 0x25, 0xac, 0x1a, 0x06, 0x7b, 0x52, 0xf2, 0x91, 0xdb, 0xa5,
+	0x1c, 0xdf, 0xd3, 0xa3, 0xa8, 0xbc, 0x20, 0xed, 0x12, 0x36, 0xef, 0xc6, 0xc8, 0x4d, 0x8d, 0xab,
+	0x34, 0xe1, 0xf2, 0x04, 0xd5, 0x0c, 0x29, 0x5c, 0x1a, 0x4f, 0xe1, 0xec, 0x78, 0x92, 0xfe, 0x6d,
+	0x41, 0x6e, 0xec, 0x31, 0x18, 0x8f, 0xa3, 0x95, 0x8c, 0x63, 0x29, 0xee, 0x63, 0xaa, 0xef, 0xa9,
+	0x03, 0xa9, 0x42, 0x21, 0x0a, 0xe9, 0xbe, 0x3b, 0x40, 0xe6, 0x87, 0x9e, 0x0a, 0x47, 0x4a, 0xaa,
+	0x9d, 0x17, 0xf0, 0x6d, 0x09, 0x96, 0xb1, 0x78, 0x0a, 0xab, 0xca, 0x06, 0xb7, 0xa3, 0x7d, 0x5b,
+	0x5e, 0x98, 0x32, 0x37, 0x24, 0xc3, 0xe0, 0xe4, 0xf7, 0x12, 0x67, 0xfb, 0xcf, 0xf4, 0x71, 0xe1,
+	0x2a, 0xf3, 0xc9, 0x03, 0x48, 0x8b, 0x10, 0xab, 0xb4, 0xcc, 0xaf, 0x57, 0xa7, 0x95, 0xa9, 0x22,
+	0xa9, 0x89, 0x1f, 0x74, 0x14, 0x19, 0xf9, 0x12, 0x48, 0x67, 0xc8, 0x18, 0x06, 0xdc, 0xd5, 0x0d,
+	0xcc, 0xc3, 0x43, 0xe9, 0xb0, 0x15, 0xa7, 0xa0, 0x31, 0xaa, 0x04, 0x3c, 0x3c, 0x24, 0xd7, 0x61,
+	0x55, 0xde, 0x8a, 0x38, 0x95, 0x85, 0x47, 0xb9, 0x34, 0x3b, 0xe5, 0xac, 0x08, 0x70, 0x5b, 0x41,
+	0xeb, 0x9c, 0x20, 0x14, 0x07, 0x0c, 0x0f, 0xfc, 0x70, 0x18, 0x8d, 0x8a, 0x73, 0x41, 0xa6, 0xdf,
+	0xdd, 0x19, 0x34, 0xdc, 0xd6, 0xb4, 0x71, 0xf9, 0xaa, 0xdc, 0x2b, 0x0c, 0x4e, 0x80, 0xc9, 0x1e,
+	0x10, 0x43, 0xfe, 0xa4, 0xa5, 0x9c, 0xef, 0x66, 0x90, 0x73, 0x2a, 0xbf, 0x94, 0xa0, 0xe2, 0xf0,
+	0x24, 0x5c, 0x24, 0x48, 0x1f, 0xa3, 0x88, 0x76, 0xb1, 0xbc, 0xa8, 0x12, 0x44, 0x1f, 0xc5, 0xcb,
+	0xd5, 0x91, 0xdd, 0x4c, 0x7a, 0x63, 0x49, 0x7a, 0x23, 0xab, 0x21, 0x75, 0xf9, 0xee, 0xc5, 0xed,
+	0x98, 0xf2, 0x72, 0x46, 0xa1, 0x35, 0xa4, 0xce, 0x2b, 0x0d, 0xb8, 0x68, 0x34, 0xf6, 0x2c, 0xd9,
+	0x5c, 0xd9, 0x80, 0x4b, 0x66, 0x4b, 0xce, 0x54, 0x13, 0x5b, 0x90, 0x96, 0x99, 0x41, 0x56, 0x21,
+	0xd7, 0x7a, 0xee, 0x6e, 0x3b, 0x5b, 0x9b, 0x4e, 0xb3, 0xdd, 0x2e, 0xcc, 0x91, 0x15, 0xc8, 0xb6,
+	0x77, 0x1b, 0x8d, 0x66, 0x73, 0xa3, 0xb9, 0x51, 0xb0, 0x08, 0xc0, 0xe2, 0x93, 0xfa, 0xd3, 0x9d,
+	0xe6, 0x46, 0x61, 0x9e, 0xe4, 0x60, 0xa9, 0xfe, 0x68, 0xcb, 0x11, 0x87, 0x94, 0xb8, 0xe7, 0x34,
+	0x5f, 0x36, 0x9d, 0x9d, 0xd6, 0xf3, 0xcd, 0xc2, 0x82, 0xfd, 0x0b, 0x2c, 0x69, 0x77, 0x1f, 0x37,
+	0x67, 0xeb, 0x2c, 0xcd, 0x99, 0xdc, 0x83, 0x45, 0xf5, 0x7c, 0xe9, 0x1e, 0x64, 0x7f, 0x38, 0xa4,
+	0x8e, 0xa6, 0x58, 0xff, 0x23, 0x0b, 0xf9, 0x5d, 0x75, 0xa3, 0xad, 0x76, 0x0f, 0x82, 0xc7, 0x73,
+	0x47, 0xb2, 0x95, 0x98, 0x33, 0x72, 0x86, 0x7d, 0xa1, 0x52, 0x4c, 0x50, 0xbe, 0x0c, 0x7d, 0xcf,
+	0x9e, 0x23, 0xbe, 0x1a, 0x29, 0x4e, 0xb4, 0xab, 0x49, 0xf3, 0xaf, 0x99, 0xf1, 0x2c, 0x4b, 0x85,
+	0x3d, 0x47, 0x7e, 0xd6, 0xa3, 0x46, 0x02, 0x1e, 0x91, 0xd3, 0x6a, 0x55, 0x6e, 0x4d, 0x9e, 0x19,
+	0xcc, 0x4b, 0x95, 0x3d, 0x27, 0xfc, 0x65, 0x32, 0xfe, 0xbc, 0xfd, 0xf5, 0x1a, 0x4a, 0xa6, 0x9d,
+	0x86, 0x98, 0x55, 0x9e, 0xb2, 0xfe, 0x98, 0xd9, 0xbf, 0x82, 0x4c, 0xbc, 0x66, 0x90, 0xcf, 0x3f,
+	0xb0, 0x85, 0x28, 0x36, 0x5f, 0xcc, 0xb4, 0xab, 0xd8, 0x73, 0xa4, 0x05, 0x30, 0xda, 0x20, 0xc8,
+	0xf5, 0x29, 0x6e, 0x19, 0x9b, 0xa4, 0xcd, 0x5a, 0xb6, 0x00, 0x46, 0x2b, 0xc0, 0x04, 0x56, 0xa7,
+	0x76, 0x84, 0x89, 0xac, 0x46, 0xe3, 0xfb, 0x04, 0x56, 0xa7, 0xe6, 0x7b, 0x33, 0xab, 0x2d, 0x58,
+	0x49, 0x4c, 0xea, 0xe4, 0xc6, 0x14, 0x1b, 0x93, 0x03, 0x9f, 0x99, 0xe1, 0x0e, 0xc0, 0x68, 0xdc,
+	0x9e, 0xa0, 0xdb, 0xa9, 0x79, 0xbc, 0x32, 0x75, 0xf6, 0x94, 0x89, 0xba, 0x3c, 0x3e, 0xfd, 0x92,
+	0xea, 0x0c, 0x03, 0xb2, 0xe2, 0x7c, 0x63, 0xe6, 0x51, 0xda, 0x9e, 0x23, 0xcf, 0x60, 0x79, 0x7c,
+	0xb2, 0x9d, 0x20, 0xc6, 0x30, 0xfc, 0x1a, 0x7d, 0xf1, 0x28, 0xf3, 0xd3, 0xa2, 0xfa, 0xaf, 0xe3,
+	0x8d, 0xfa, 0xbd, 0xfd, 0xdf, 0x00, 0x88, 0xd2, 0x40, 0xb7, 0x2e, 0x11, 0x00, 0x00,
 }
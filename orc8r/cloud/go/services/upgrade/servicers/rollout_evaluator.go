@@ -0,0 +1,236 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/services/upgrade/health"
+	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
+
+	"github.com/golang/glog"
+)
+
+// RolloutEvaluator drives the rollouts in progress. The health of the
+// gateways of the tier being soaked is evaluated against the rollout's
+// health criteria, both while the tier soaks and once its soak period is
+// over. Unhealthy tiers are reverted and halt the rollout, tiers still
+// healthy at the end of their soak period are promoted to the next tier of
+// the plan.
+type RolloutEvaluator struct {
+	srv     *UpgradeService
+	signals health.SignalSource
+}
+
+func NewRolloutEvaluator(srv *UpgradeService, signals health.SignalSource) *RolloutEvaluator {
+	return &RolloutEvaluator{srv: srv, signals: signals}
+}
+
+// Run evaluates the rollouts in progress every interval. It never returns.
+func (e *RolloutEvaluator) Run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		if err := e.EvaluateRollouts(now); err != nil {
+			glog.Errorf("Error evaluating rollouts: %v", err)
+		}
+	}
+}
+
+// EvaluateRollouts evaluates the rollout in progress in each network as of
+// now. Rollouts which fail to be evaluated are retried on the next call.
+func (e *RolloutEvaluator) EvaluateRollouts(now time.Time) error {
+	networkIDs, err := e.srv.store.ListKeys(activeRolloutTableName)
+	if err != nil {
+		return err
+	}
+	var firstErr error
+	for _, networkID := range networkIDs {
+		if err := e.evaluateNetwork(networkID, now); err != nil {
+			glog.Errorf("Error evaluating rollout of network %s: %v", networkID, err)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("network %s: %v", networkID, err)
+			}
+		}
+	}
+	return firstErr
+}
+
+func (e *RolloutEvaluator) evaluateNetwork(networkID string, now time.Time) error {
+	srv := e.srv
+	rolloutID, _, err := srv.getActiveRolloutMarker(networkID)
+	if err != nil || rolloutID == "" {
+		return err
+	}
+	rollout, generation, err := srv.getRollout(networkID, rolloutID)
+	if err == datastore.ErrNotFound {
+		// The rollout is still being created
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rollout.GetStatus().GetState() == upgrade_protos.RolloutStatus_REVERTING {
+		return e.revertAndHalt(networkID, rolloutID, rollout, generation, now)
+	}
+	if rollout.GetStatus().GetState() != upgrade_protos.RolloutStatus_IN_PROGRESS {
+		srv.clearActiveRollout(networkID, rolloutID)
+		return nil
+	}
+
+	// All rollout updates below are conditional on the generation read above,
+	// so replicas evaluating the same rollout or a concurrent abort can't both
+	// succeed. The loser's update fails and is retried on the next run.
+	plan, rolloutStatus := rollout.GetPlan(), rollout.GetStatus()
+	tierIdx := rolloutStatus.GetCurrentTierIndex()
+	tierID := plan.GetTiers()[tierIdx]
+	soakPeriod := time.Duration(plan.GetSoakPeriodSecs()) * time.Second
+	soaking := now.Before(time.Unix(rolloutStatus.GetTierStartedAt(), 0).Add(soakPeriod))
+	signals, err := e.signals.GetTierSignals(networkID, tierID)
+	if err != nil {
+		return err
+	}
+	if soaking {
+		// Gateways which didn't pick up the new version yet are only held to
+		// it at the end of the soak period
+		signals = getUpgradedGateways(signals, plan.GetVersion())
+	}
+	unhealthy := health.GetUnhealthyGateways(signals, plan.GetHealthCriteria(), plan.GetVersion(), now)
+	if uint32(len(unhealthy)) > plan.GetHealthCriteria().GetMaxUnhealthyGateways() {
+		// The rollout stays active until the tier is reverted, so that a
+		// failed revert is retried on the next run
+		_, previousVersion := getCurrentTier(rollout)
+		rolloutStatus.UnhealthyGateways = unhealthy
+		rolloutStatus.UpdatedAt = now.Unix()
+		rolloutStatus.State = upgrade_protos.RolloutStatus_REVERTING
+		rolloutStatus.Message = fmt.Sprintf(
+			"Reverting tier %s to version %s with %d of %d gateways unhealthy",
+			tierID, previousVersion, len(unhealthy), len(signals))
+		if err := srv.putRollout(networkID, rolloutID, rollout, generation); err != nil {
+			return err
+		}
+		glog.Infof("Rollout %s of network %s: %s", rolloutID, networkID, rolloutStatus.Message)
+		return e.revertAndHalt(networkID, rolloutID, rollout, generation+1, now)
+	}
+	if soaking {
+		return nil
+	}
+	rolloutStatus.UnhealthyGateways = unhealthy
+	rolloutStatus.UpdatedAt = now.Unix()
+
+	if int(tierIdx)+1 == len(plan.GetTiers()) {
+		rolloutStatus.State = upgrade_protos.RolloutStatus_SUCCEEDED
+		rolloutStatus.Message = fmt.Sprintf("All tiers upgraded to version %s", plan.GetVersion())
+		glog.Infof("Rollout %s of network %s: %s", rolloutID, networkID, rolloutStatus.Message)
+		return srv.finishRollout(networkID, rolloutID, rollout, generation)
+	}
+
+	nextTierID := plan.GetTiers()[tierIdx+1]
+	if _, recorded := rolloutStatus.GetPreviousVersions()[nextTierID]; !recorded {
+		// The next tier's version is persisted before the tier is upgraded,
+		// otherwise a promotion retried after a failed update would record
+		// the new version and reverting the tier would be a no-op
+		previousVersion, err := srv.getTierVersion(networkID, nextTierID)
+		if err == datastore.ErrNotFound {
+			return e.haltForDeletedTier(networkID, rolloutID, rollout, generation, nextTierID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to promote rollout to tier %s: %v", nextTierID, err)
+		}
+		if rolloutStatus.PreviousVersions == nil {
+			rolloutStatus.PreviousVersions = map[string]string{}
+		}
+		rolloutStatus.PreviousVersions[nextTierID] = previousVersion
+		if err := srv.putRollout(networkID, rolloutID, rollout, generation); err != nil {
+			return err
+		}
+		generation++
+	}
+
+	_, err = srv.setTierVersion(networkID, nextTierID, plan.GetVersion())
+	if err == datastore.ErrNotFound {
+		return e.haltForDeletedTier(networkID, rolloutID, rollout, generation, nextTierID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to promote rollout to tier %s: %v", nextTierID, err)
+	}
+	rolloutStatus.CurrentTierIndex = tierIdx + 1
+	rolloutStatus.TierStartedAt = now.Unix()
+	rolloutStatus.Message = fmt.Sprintf("Promoted from tier %s, soaking tier %s", tierID, nextTierID)
+	err = srv.putRollout(networkID, rolloutID, rollout, generation)
+	if datastore.IsErrGenerationConflict(err) {
+		// The rollout was aborted while the next tier was being upgraded
+		srv.revertTier(networkID, nextTierID, rolloutStatus.PreviousVersions[nextTierID])
+	}
+	if err != nil {
+		return err
+	}
+	glog.Infof("Rollout %s of network %s: %s", rolloutID, networkID, rolloutStatus.Message)
+	return nil
+}
+
+// revertAndHalt reverts the current tier of a rollout to its version before
+// the rollout, and halts the rollout once the tier is reverted. Rollouts
+// whose revert fails stay in the REVERTING state and are retried.
+func (e *RolloutEvaluator) revertAndHalt(
+	networkID string,
+	rolloutID string,
+	rollout *upgrade_protos.Rollout,
+	generation uint64,
+	now time.Time,
+) error {
+	tierID, previousVersion := getCurrentTier(rollout)
+	_, err := e.srv.setTierVersion(networkID, tierID, previousVersion)
+	switch {
+	case err == datastore.ErrNotFound:
+		rollout.Status.Message = fmt.Sprintf(
+			"Halted with %d gateways of tier %s unhealthy, the tier no longer exists",
+			len(rollout.Status.GetUnhealthyGateways()), tierID)
+	case err != nil:
+		return fmt.Errorf("failed to revert tier %s to version %s: %v", tierID, previousVersion, err)
+	default:
+		rollout.Status.Message = fmt.Sprintf(
+			"Halted with %d gateways of tier %s unhealthy, reverted the tier to version %s",
+			len(rollout.Status.GetUnhealthyGateways()), tierID, previousVersion)
+	}
+	rollout.Status.State = upgrade_protos.RolloutStatus_HALTED
+	rollout.Status.UpdatedAt = now.Unix()
+	if err := e.srv.finishRollout(networkID, rolloutID, rollout, generation); err != nil {
+		return err
+	}
+	glog.Infof("Rollout %s of network %s: %s", rolloutID, networkID, rollout.Status.Message)
+	return nil
+}
+
+// getUpgradedGateways returns the signals of the gateways running version
+func getUpgradedGateways(signals []*health.GatewaySignals, version string) []*health.GatewaySignals {
+	ret := []*health.GatewaySignals{}
+	for _, gw := range signals {
+		if gw.PackageVersion == version {
+			ret = append(ret, gw)
+		}
+	}
+	return ret
+}
+
+// haltForDeletedTier halts a rollout whose next tier was deleted during the
+// rollout. The healthy tiers keep the new version.
+func (e *RolloutEvaluator) haltForDeletedTier(
+	networkID string,
+	rolloutID string,
+	rollout *upgrade_protos.Rollout,
+	generation uint64,
+	tierID string,
+) error {
+	rollout.Status.State = upgrade_protos.RolloutStatus_HALTED
+	rollout.Status.Message = fmt.Sprintf("Halted since tier %s no longer exists", tierID)
+	return e.srv.finishRollout(networkID, rolloutID, rollout, generation)
+}
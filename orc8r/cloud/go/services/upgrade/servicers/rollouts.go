@@ -0,0 +1,314 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/protos"
+	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//------------------------------------------------------------------------------
+// Rollout APIs
+//------------------------------------------------------------------------------
+
+func (srv *UpgradeService) CreateRollout(
+	context context.Context,
+	request *upgrade_protos.CreateRolloutRequest,
+) (*protos.Void, error) {
+	ret := &protos.Void{}
+	if err := upgrade_protos.ValidateCreateRolloutReq(request); err != nil {
+		return ret, protos.NewGrpcValidationError(err)
+	}
+
+	networkID, rolloutID, plan := request.GetNetworkId(), request.GetRolloutId(), request.GetPlan()
+	activeRolloutID, markerGeneration, err := srv.getOrCreateActiveRolloutMarker(networkID)
+	if err != nil {
+		glog.Errorf("Error while getting active rollout of network %s: %s", networkID, err)
+		return ret, status.Errorf(codes.Aborted, "Error while creating rollout")
+	}
+	if activeRolloutID != "" {
+		return ret, status.Errorf(codes.FailedPrecondition, "Rollout %s is already in progress", activeRolloutID)
+	}
+	exists, err := srv.store.DoesKeyExist(getRolloutTableName(networkID), rolloutID)
+	if err != nil {
+		glog.Errorf("Error while checking if rollout %s exists: %s", rolloutID, err)
+		return ret, status.Errorf(codes.Aborted, "Error while creating rollout")
+	}
+	if exists {
+		return ret, status.Errorf(codes.AlreadyExists, "Rollout %s already exists", rolloutID)
+	}
+	for _, tierID := range plan.GetTiers() {
+		if !srv.doesTierExist(networkID, tierID) {
+			return ret, status.Errorf(codes.FailedPrecondition, "Tier %s does not exist", tierID)
+		}
+	}
+
+	// Claiming the marker at the generation read above guarantees that only
+	// one rollout is created at a time, even across service replicas
+	err = srv.store.PutIfGeneration(activeRolloutTableName, networkID, []byte(rolloutID), markerGeneration)
+	if datastore.IsErrGenerationConflict(err) {
+		return ret, protos.NewGrpcConflictError("Another rollout was created concurrently in network %s", networkID)
+	}
+	if err != nil {
+		glog.Errorf("Error while marking rollout %s as active: %s", rolloutID, err)
+		return ret, status.Errorf(codes.Unavailable, "Error while creating rollout")
+	}
+
+	canaryTier := plan.GetTiers()[0]
+	previousVersion, err := srv.setTierVersion(networkID, canaryTier, plan.GetVersion())
+	if err != nil {
+		glog.Errorf("Error while upgrading tier %s: %s", canaryTier, err)
+		srv.clearActiveRollout(networkID, rolloutID)
+		return ret, status.Errorf(codes.Unavailable, "Error while creating rollout")
+	}
+	now := time.Now().Unix()
+	rollout := &upgrade_protos.Rollout{
+		Plan: plan,
+		Status: &upgrade_protos.RolloutStatus{
+			State:            upgrade_protos.RolloutStatus_IN_PROGRESS,
+			CurrentTierIndex: 0,
+			TierStartedAt:    now,
+			PreviousVersions: map[string]string{canaryTier: previousVersion},
+			Message:          fmt.Sprintf("Soaking tier %s", canaryTier),
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		},
+	}
+	if err := srv.createRollout(networkID, rolloutID, rollout); err != nil {
+		glog.Errorf("Error while persisting rollout %s: %s", rolloutID, err)
+		srv.revertTier(networkID, canaryTier, previousVersion)
+		srv.clearActiveRollout(networkID, rolloutID)
+		return ret, status.Errorf(codes.Unavailable, "Error while creating rollout")
+	}
+	return ret, nil
+}
+
+func (srv *UpgradeService) GetRollout(
+	context context.Context,
+	request *upgrade_protos.GetRolloutRequest,
+) (*upgrade_protos.Rollout, error) {
+	ret := &upgrade_protos.Rollout{}
+	if err := upgrade_protos.ValidateGetRolloutReq(request); err != nil {
+		return ret, protos.NewGrpcValidationError(err)
+	}
+
+	rollout, _, err := srv.getRollout(request.GetNetworkId(), request.GetRolloutId())
+	if err == datastore.ErrNotFound {
+		return ret, status.Errorf(codes.NotFound, "Rollout %s does not exist", request.GetRolloutId())
+	}
+	if err != nil {
+		glog.Errorf("Error while getting rollout %s: %s", request.GetRolloutId(), err)
+		return ret, status.Errorf(codes.Aborted, "Error while getting rollout")
+	}
+	return rollout, nil
+}
+
+func (srv *UpgradeService) ListRollouts(
+	context context.Context,
+	request *upgrade_protos.ListRolloutsRequest,
+) (*upgrade_protos.ListRolloutsResponse, error) {
+	ret := &upgrade_protos.ListRolloutsResponse{}
+	if err := upgrade_protos.ValidateListRolloutsReq(request); err != nil {
+		return ret, protos.NewGrpcValidationError(err)
+	}
+
+	table := getRolloutTableName(request.GetNetworkId())
+	rolloutIDs, err := srv.store.ListKeys(table)
+	if err != nil {
+		glog.Errorf("Error while listing rollouts: %s", err)
+		return ret, status.Errorf(codes.Aborted, "Error while listing rollouts")
+	}
+	marshaledRollouts, err := srv.store.GetMany(table, rolloutIDs)
+	if err != nil {
+		glog.Errorf("Error while loading rollouts: %s", err)
+		return ret, status.Errorf(codes.Aborted, "Error while listing rollouts")
+	}
+	ret.Rollouts = make(map[string]*upgrade_protos.Rollout, len(marshaledRollouts))
+	for rolloutID, val := range marshaledRollouts {
+		rollout := &upgrade_protos.Rollout{}
+		if err := protos.Unmarshal(val.Value, rollout); err != nil {
+			glog.Errorf("Error while unmarshaling rollout %s: %s", rolloutID, err)
+			return ret, status.Errorf(codes.Aborted, "Error while listing rollouts")
+		}
+		ret.Rollouts[rolloutID] = rollout
+	}
+	return ret, nil
+}
+
+func (srv *UpgradeService) AbortRollout(
+	context context.Context,
+	request *upgrade_protos.AbortRolloutRequest,
+) (*protos.Void, error) {
+	ret := &protos.Void{}
+	if err := upgrade_protos.ValidateAbortRolloutReq(request); err != nil {
+		return ret, protos.NewGrpcValidationError(err)
+	}
+
+	networkID, rolloutID := request.GetNetworkId(), request.GetRolloutId()
+	rollout, generation, err := srv.getRollout(networkID, rolloutID)
+	if err == datastore.ErrNotFound {
+		return ret, status.Errorf(codes.NotFound, "Rollout %s does not exist", rolloutID)
+	}
+	if err != nil {
+		glog.Errorf("Error while getting rollout %s: %s", rolloutID, err)
+		return ret, status.Errorf(codes.Aborted, "Error while aborting rollout")
+	}
+	if rollout.GetStatus().GetState() != upgrade_protos.RolloutStatus_IN_PROGRESS {
+		return ret, status.Errorf(codes.FailedPrecondition, "Rollout %s is not in progress", rolloutID)
+	}
+
+	// The tier is only reverted once the aborted state is persisted, so that
+	// a concurrent promotion can't leave another tier on the new version
+	tierID, previousVersion := getCurrentTier(rollout)
+	rollout.Status.State = upgrade_protos.RolloutStatus_ABORTED
+	rollout.Status.Message = fmt.Sprintf("Aborted, reverted tier %s to version %s", tierID, previousVersion)
+	rollout.Status.UpdatedAt = time.Now().Unix()
+	err = srv.finishRollout(networkID, rolloutID, rollout, generation)
+	if datastore.IsErrGenerationConflict(err) {
+		return ret, protos.NewGrpcConflictError("Rollout %s was modified concurrently, retry the abort", rolloutID)
+	}
+	if err != nil {
+		glog.Errorf("Error while persisting aborted rollout %s: %s", rolloutID, err)
+		return ret, status.Errorf(codes.Unavailable, "Error while aborting rollout")
+	}
+	srv.revertTier(networkID, tierID, previousVersion)
+	return ret, nil
+}
+
+func getRolloutTableName(networkID string) string {
+	return datastore.GetTableName(networkID, RolloutTableName)
+}
+
+// getActiveRolloutMarker returns the ID of the rollout in progress in the
+// network, or an empty string if there is none, along with the generation of
+// the network's marker record
+func (srv *UpgradeService) getActiveRolloutMarker(networkID string) (string, uint64, error) {
+	rolloutID, generation, err := srv.store.Get(activeRolloutTableName, networkID)
+	return string(rolloutID), generation, err
+}
+
+// getOrCreateActiveRolloutMarker is getActiveRolloutMarker for callers about
+// to claim the marker. Conditional writes need an existing record, so the
+// marker of a network is created empty on its first rollout and is never
+// deleted afterwards.
+func (srv *UpgradeService) getOrCreateActiveRolloutMarker(networkID string) (string, uint64, error) {
+	rolloutID, generation, err := srv.getActiveRolloutMarker(networkID)
+	if err != datastore.ErrNotFound {
+		return rolloutID, generation, err
+	}
+	if err := srv.store.Put(activeRolloutTableName, networkID, []byte{}); err != nil {
+		return "", 0, err
+	}
+	return srv.getActiveRolloutMarker(networkID)
+}
+
+// clearActiveRollout empties the network's marker if it still points to the
+// given rollout. Failures are logged since the RolloutEvaluator clears
+// markers of finished rollouts on its next run.
+func (srv *UpgradeService) clearActiveRollout(networkID string, rolloutID string) {
+	activeRolloutID, generation, err := srv.getActiveRolloutMarker(networkID)
+	if err == datastore.ErrNotFound || (err == nil && activeRolloutID != rolloutID) {
+		return
+	}
+	if err == nil {
+		err = srv.store.PutIfGeneration(activeRolloutTableName, networkID, []byte{}, generation)
+	}
+	if err != nil {
+		glog.Errorf("Error while clearing active rollout %s of network %s: %s", rolloutID, networkID, err)
+	}
+}
+
+func (srv *UpgradeService) getRollout(networkID string, rolloutID string) (*upgrade_protos.Rollout, uint64, error) {
+	marshaledRollout, generation, err := srv.store.Get(getRolloutTableName(networkID), rolloutID)
+	if err != nil {
+		return nil, 0, err
+	}
+	rollout := &upgrade_protos.Rollout{}
+	err = protos.Unmarshal(marshaledRollout, rollout)
+	return rollout, generation, err
+}
+
+func (srv *UpgradeService) createRollout(networkID string, rolloutID string, rollout *upgrade_protos.Rollout) error {
+	marshaledRollout, err := protos.MarshalIntern(rollout)
+	if err != nil {
+		return err
+	}
+	return srv.store.Put(getRolloutTableName(networkID), rolloutID, marshaledRollout)
+}
+
+// putRollout updates a rollout only if it is still at the generation it was
+// read at, and returns datastore.ErrGenerationConflict otherwise. On success
+// the rollout's generation is incremented by one.
+func (srv *UpgradeService) putRollout(networkID string, rolloutID string, rollout *upgrade_protos.Rollout, generation uint64) error {
+	marshaledRollout, err := protos.MarshalIntern(rollout)
+	if err != nil {
+		return err
+	}
+	return srv.store.PutIfGeneration(getRolloutTableName(networkID), rolloutID, marshaledRollout, generation)
+}
+
+// finishRollout persists a rollout which is no longer in progress and clears
+// it as the network's active rollout
+func (srv *UpgradeService) finishRollout(networkID string, rolloutID string, rollout *upgrade_protos.Rollout, generation uint64) error {
+	if err := srv.putRollout(networkID, rolloutID, rollout, generation); err != nil {
+		return err
+	}
+	srv.clearActiveRollout(networkID, rolloutID)
+	return nil
+}
+
+func (srv *UpgradeService) getTierVersion(networkID string, tierID string) (string, error) {
+	marshaledTier, _, err := srv.store.Get(getTierTableName(networkID), tierID)
+	if err != nil {
+		return "", err
+	}
+	tier := &upgrade_protos.TierInfo{}
+	err = protos.Unmarshal(marshaledTier, tier)
+	return tier.GetVersion(), err
+}
+
+// setTierVersion sets the version of an existing tier and returns its
+// previous version
+func (srv *UpgradeService) setTierVersion(networkID string, tierID string, version string) (string, error) {
+	marshaledTier, _, err := srv.store.Get(getTierTableName(networkID), tierID)
+	if err != nil {
+		return "", err
+	}
+	tier := &upgrade_protos.TierInfo{}
+	if err := protos.Unmarshal(marshaledTier, tier); err != nil {
+		return "", err
+	}
+	previousVersion := tier.GetVersion()
+	tier.Version = version
+	return previousVersion, srv.putTier(networkID, tierID, tier)
+}
+
+// getCurrentTier returns the tier being soaked and its version before the
+// rollout
+func getCurrentTier(rollout *upgrade_protos.Rollout) (string, string) {
+	tierID := rollout.GetPlan().GetTiers()[rollout.GetStatus().GetCurrentTierIndex()]
+	return tierID, rollout.GetStatus().GetPreviousVersions()[tierID]
+}
+
+// revertTier moves a tier back to its version before the rollout. Failures
+// are logged since the rollout has to stop either way.
+func (srv *UpgradeService) revertTier(networkID string, tierID string, previousVersion string) {
+	if _, err := srv.setTierVersion(networkID, tierID, previousVersion); err != nil {
+		glog.Errorf("Error while reverting tier %s to version %s: %s", tierID, previousVersion, err)
+	}
+}
@@ -0,0 +1,422 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/upgrade/health"
+	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
+	"magma/orc8r/cloud/go/services/upgrade/servicers"
+
+	"github.com/golang/protobuf/proto"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//------------------------------------------------------------------------------
+// Rollout tests
+//------------------------------------------------------------------------------
+
+type mockSignalSource struct {
+	signalsByTier map[string][]*health.GatewaySignals
+}
+
+func (m *mockSignalSource) GetTierSignals(networkID string, tierID string) ([]*health.GatewaySignals, error) {
+	return m.signalsByTier[tierID], nil
+}
+
+// faultyDatastore runs failWrite before writes to the wrapped datastore and
+// fails the write if it returns an error
+type faultyDatastore struct {
+	datastore.Api
+	failWrite func(table string, key string) error
+}
+
+func (f *faultyDatastore) Put(table string, key string, value []byte) error {
+	if err := f.failWrite(table, key); err != nil {
+		return err
+	}
+	return f.Api.Put(table, key, value)
+}
+
+func (f *faultyDatastore) PutIfGeneration(table string, key string, value []byte, generation uint64) error {
+	if err := f.failWrite(table, key); err != nil {
+		return err
+	}
+	return f.Api.PutIfGeneration(table, key, value, generation)
+}
+
+// Rollouts are updated with conditional writes, so the fixtures use a real
+// datastore which tracks generations
+func setupRolloutFixtures(t *testing.T) datastore.Api {
+	ds, err := datastore.NewSqlDb("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	setupTierVersioningFixtures(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.0.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+		"prod":   {Name: "prod", Version: "0.9.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "fred", Order: 111}}},
+	})
+	return ds
+}
+
+func getTestRolloutPlan() *upgrade_protos.RolloutPlan {
+	return &upgrade_protos.RolloutPlan{
+		Version:        "1.1.0-0",
+		Tiers:          []string{"canary", "prod"},
+		SoakPeriodSecs: 600,
+		HealthCriteria: &upgrade_protos.HealthCriteria{MaxCheckinAgeSecs: 120},
+	}
+}
+
+func TestUpgradeService_CreateRollout(t *testing.T) {
+	ctx := context.Background()
+	ds := setupRolloutFixtures(t)
+	srv := servicers.NewUpgradeService(ds)
+
+	// Invalid plan
+	plan := getTestRolloutPlan()
+	plan.Tiers = []string{"canary", "canary"}
+	_, err := srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: plan})
+	assert.Error(t, err)
+
+	// Nonexistent tier
+	plan.Tiers = []string{"canary", "t3"}
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: plan})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Canary tier is upgraded
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.1.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+		"prod":   {Name: "prod", Version: "0.9.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "fred", Order: 111}}},
+	})
+
+	rollout, err := srv.GetRollout(ctx, &upgrade_protos.GetRolloutRequest{NetworkId: "network", RolloutId: "r1"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(getTestRolloutPlan(), rollout.Plan))
+	assert.Equal(t, upgrade_protos.RolloutStatus_IN_PROGRESS, rollout.Status.State)
+	assert.Equal(t, uint32(0), rollout.Status.CurrentTierIndex)
+	assert.Equal(t, map[string]string{"canary": "1.0.0-0"}, rollout.Status.PreviousVersions)
+
+	// Only one rollout at a time
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r2", Plan: getTestRolloutPlan()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.GetRollout(ctx, &upgrade_protos.GetRolloutRequest{NetworkId: "network", RolloutId: "r2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Rollout IDs can't be reused
+	_, err = srv.AbortRollout(ctx, &upgrade_protos.AbortRolloutRequest{NetworkId: "network", RolloutId: "r1"})
+	assert.NoError(t, err)
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r2", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+	rollouts, err := srv.ListRollouts(ctx, &upgrade_protos.ListRolloutsRequest{NetworkId: "network"})
+	assert.NoError(t, err)
+	assert.Len(t, rollouts.Rollouts, 2)
+	assert.Equal(t, upgrade_protos.RolloutStatus_ABORTED, rollouts.Rollouts["r1"].Status.State)
+	assert.Equal(t, upgrade_protos.RolloutStatus_IN_PROGRESS, rollouts.Rollouts["r2"].Status.State)
+}
+
+func TestUpgradeService_AbortRollout(t *testing.T) {
+	ctx := context.Background()
+	ds := setupRolloutFixtures(t)
+	srv := servicers.NewUpgradeService(ds)
+
+	_, err := srv.AbortRollout(ctx, &upgrade_protos.AbortRolloutRequest{NetworkId: "network", RolloutId: "r1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+	_, err = srv.AbortRollout(ctx, &upgrade_protos.AbortRolloutRequest{NetworkId: "network", RolloutId: "r1"})
+	assert.NoError(t, err)
+
+	rollout, err := srv.GetRollout(ctx, &upgrade_protos.GetRolloutRequest{NetworkId: "network", RolloutId: "r1"})
+	assert.NoError(t, err)
+	assert.Equal(t, upgrade_protos.RolloutStatus_ABORTED, rollout.Status.State)
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.0.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+	})
+
+	// Can't abort twice
+	_, err = srv.AbortRollout(ctx, &upgrade_protos.AbortRolloutRequest{NetworkId: "network", RolloutId: "r1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRolloutEvaluator_Promotion(t *testing.T) {
+	ctx := context.Background()
+	ds := setupRolloutFixtures(t)
+	srv := servicers.NewUpgradeService(ds)
+	start := time.Now()
+	signals := &mockSignalSource{signalsByTier: map[string][]*health.GatewaySignals{
+		"canary": {{GatewayID: "gw1", LastCheckin: start.Add(10 * time.Minute)}},
+		"prod": {
+			{GatewayID: "gw2", LastCheckin: start.Add(20 * time.Minute)},
+			{GatewayID: "gw3", LastCheckin: start.Add(20 * time.Minute)},
+		},
+	}}
+	evaluator := servicers.NewRolloutEvaluator(srv, signals)
+
+	_, err := srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+
+	// Canary is still soaking
+	assert.NoError(t, evaluator.EvaluateRollouts(start.Add(5*time.Minute)))
+	rollout := getRollout(t, srv, "r1")
+	assert.Equal(t, upgrade_protos.RolloutStatus_IN_PROGRESS, rollout.Status.State)
+	assert.Equal(t, uint32(0), rollout.Status.CurrentTierIndex)
+
+	// Healthy canary is promoted to prod
+	promotedAt := start.Add(11 * time.Minute)
+	assert.NoError(t, evaluator.EvaluateRollouts(promotedAt))
+	rollout = getRollout(t, srv, "r1")
+	assert.Equal(t, upgrade_protos.RolloutStatus_IN_PROGRESS, rollout.Status.State)
+	assert.Equal(t, uint32(1), rollout.Status.CurrentTierIndex)
+	assert.Equal(t, promotedAt.Unix(), rollout.Status.TierStartedAt)
+	assert.Equal(t, map[string]string{"canary": "1.0.0-0", "prod": "0.9.0-0"}, rollout.Status.PreviousVersions)
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.1.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+		"prod":   {Name: "prod", Version: "1.1.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "fred", Order: 111}}},
+	})
+
+	// Prod soak period starts at the promotion
+	assert.NoError(t, evaluator.EvaluateRollouts(promotedAt.Add(9*time.Minute)))
+	assert.Equal(t, upgrade_protos.RolloutStatus_IN_PROGRESS, getRollout(t, srv, "r1").Status.State)
+
+	assert.NoError(t, evaluator.EvaluateRollouts(promotedAt.Add(10*time.Minute)))
+	rollout = getRollout(t, srv, "r1")
+	assert.Equal(t, upgrade_protos.RolloutStatus_SUCCEEDED, rollout.Status.State)
+	assert.Empty(t, rollout.Status.UnhealthyGateways)
+
+	// A new rollout can start once the previous one is done
+	plan := getTestRolloutPlan()
+	plan.Version = "1.2.0-0"
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r2", Plan: plan})
+	assert.NoError(t, err)
+}
+
+func TestRolloutEvaluator_Halt(t *testing.T) {
+	ctx := context.Background()
+	ds := setupRolloutFixtures(t)
+	srv := servicers.NewUpgradeService(ds)
+	start := time.Now()
+	signals := &mockSignalSource{signalsByTier: map[string][]*health.GatewaySignals{
+		"canary": {
+			{GatewayID: "gw1", LastCheckin: start.Add(10 * time.Minute)},
+			{GatewayID: "gw2", LastCheckin: start},
+		},
+	}}
+	evaluator := servicers.NewRolloutEvaluator(srv, signals)
+
+	_, err := srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+
+	assert.NoError(t, evaluator.EvaluateRollouts(start.Add(11*time.Minute)))
+	rollout := getRollout(t, srv, "r1")
+	assert.Equal(t, upgrade_protos.RolloutStatus_HALTED, rollout.Status.State)
+	assert.Equal(t, map[string]string{"gw2": "last checked in 11m0s ago"}, rollout.Status.UnhealthyGateways)
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.0.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+		"prod":   {Name: "prod", Version: "0.9.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "fred", Order: 111}}},
+	})
+
+	// Halted rollouts are no longer evaluated
+	assert.NoError(t, evaluator.EvaluateRollouts(start.Add(30*time.Minute)))
+	assert.Equal(t, upgrade_protos.RolloutStatus_HALTED, getRollout(t, srv, "r1").Status.State)
+
+	// The unhealthy gateway is tolerated with a higher threshold
+	plan := getTestRolloutPlan()
+	plan.HealthCriteria.MaxUnhealthyGateways = 1
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r2", Plan: plan})
+	assert.NoError(t, err)
+	assert.NoError(t, evaluator.EvaluateRollouts(time.Now().Add(11*time.Minute)))
+	rollout = getRollout(t, srv, "r2")
+	assert.Equal(t, upgrade_protos.RolloutStatus_IN_PROGRESS, rollout.Status.State)
+	assert.Equal(t, uint32(1), rollout.Status.CurrentTierIndex)
+}
+
+func TestRolloutEvaluator_HaltWhileSoaking(t *testing.T) {
+	ctx := context.Background()
+	ds := setupRolloutFixtures(t)
+	srv := servicers.NewUpgradeService(ds)
+	start := time.Now()
+	signals := &mockSignalSource{signalsByTier: map[string][]*health.GatewaySignals{
+		"canary": {
+			{GatewayID: "gw1", LastCheckin: start, PackageVersion: "1.1.0-0"},
+			{GatewayID: "gw2", LastCheckin: start, PackageVersion: "1.0.0-0"},
+		},
+	}}
+	evaluator := servicers.NewRolloutEvaluator(srv, signals)
+
+	_, err := srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+
+	// Upgraded gateways are evaluated during the soak period
+	assert.NoError(t, evaluator.EvaluateRollouts(start.Add(time.Minute)))
+	assert.Equal(t, upgrade_protos.RolloutStatus_IN_PROGRESS, getRollout(t, srv, "r1").Status.State)
+
+	// gw1 regresses before the end of the soak period. gw2 is not upgraded
+	// yet, so it isn't held to its checkins.
+	assert.NoError(t, evaluator.EvaluateRollouts(start.Add(5*time.Minute)))
+	rollout := getRollout(t, srv, "r1")
+	assert.Equal(t, upgrade_protos.RolloutStatus_HALTED, rollout.Status.State)
+	assert.Equal(t, map[string]string{"gw1": "last checked in 5m0s ago"}, rollout.Status.UnhealthyGateways)
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.0.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+	})
+}
+
+func TestRolloutEvaluator_RevertRetry(t *testing.T) {
+	ctx := context.Background()
+	ds := &faultyDatastore{Api: setupRolloutFixtures(t), failWrite: func(table string, key string) error { return nil }}
+	srv := servicers.NewUpgradeService(ds)
+	start := time.Now()
+	signals := &mockSignalSource{signalsByTier: map[string][]*health.GatewaySignals{
+		"canary": {{GatewayID: "gw1", LastCheckin: start}},
+	}}
+	evaluator := servicers.NewRolloutEvaluator(srv, signals)
+
+	_, err := srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+
+	// The canary fails its health evaluation but can't be reverted
+	ds.failWrite = func(table string, key string) error {
+		if table == "network_tierVersions" {
+			return errors.New("mock write error")
+		}
+		return nil
+	}
+	assert.Error(t, evaluator.EvaluateRollouts(start.Add(11*time.Minute)))
+	rollout := getRollout(t, srv, "r1")
+	assert.Equal(t, upgrade_protos.RolloutStatus_REVERTING, rollout.Status.State)
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.1.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+	})
+
+	// The rollout stays active until the revert is retried successfully
+	plan := getTestRolloutPlan()
+	plan.Version = "1.2.0-0"
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r2", Plan: plan})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	ds.failWrite = func(table string, key string) error { return nil }
+	assert.NoError(t, evaluator.EvaluateRollouts(start.Add(12*time.Minute)))
+	rollout = getRollout(t, srv, "r1")
+	assert.Equal(t, upgrade_protos.RolloutStatus_HALTED, rollout.Status.State)
+	assert.Equal(t, "Halted with 1 gateways of tier canary unhealthy, reverted the tier to version 1.0.0-0", rollout.Status.Message)
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.0.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+	})
+}
+
+func TestUpgradeService_CreateRollout_Conflict(t *testing.T) {
+	ctx := context.Background()
+	base := setupRolloutFixtures(t)
+	ds := &faultyDatastore{Api: base, failWrite: func(table string, key string) error { return nil }}
+	srv := servicers.NewUpgradeService(ds)
+
+	// Another replica claims the marker after it was created and read
+	markerWrites := 0
+	ds.failWrite = func(table string, key string) error {
+		if table == "activeRollouts" {
+			markerWrites++
+		}
+		if markerWrites == 2 {
+			ds.failWrite = func(table string, key string) error { return nil }
+			_, err := servicers.NewUpgradeService(base).CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r2", Plan: getTestRolloutPlan()})
+			assert.NoError(t, err)
+		}
+		return nil
+	}
+	_, err := srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.True(t, protos.IsGrpcConflictError(err))
+	_, err = srv.GetRollout(ctx, &upgrade_protos.GetRolloutRequest{NetworkId: "network", RolloutId: "r1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, map[string]string{"canary": "1.0.0-0"}, getRollout(t, srv, "r2").Status.PreviousVersions)
+}
+
+func TestUpgradeService_CreateRollout_PersistFailure(t *testing.T) {
+	ctx := context.Background()
+	ds := &faultyDatastore{Api: setupRolloutFixtures(t), failWrite: func(table string, key string) error {
+		if table == "network_rollouts" {
+			return errors.New("mock write error")
+		}
+		return nil
+	}}
+	srv := servicers.NewUpgradeService(ds)
+
+	// Canary tier is reverted and the network has no active rollout
+	_, err := srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"canary": {Name: "canary", Version: "1.0.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "bill", Order: 222}}},
+	})
+
+	ds.failWrite = func(table string, key string) error { return nil }
+	_, err = srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+}
+
+func TestRolloutEvaluator_PromotionRetry(t *testing.T) {
+	ctx := context.Background()
+	ds := &faultyDatastore{Api: setupRolloutFixtures(t), failWrite: func(table string, key string) error { return nil }}
+	srv := servicers.NewUpgradeService(ds)
+	start := time.Now()
+	signals := &mockSignalSource{signalsByTier: map[string][]*health.GatewaySignals{
+		"canary": {{GatewayID: "gw1", LastCheckin: start.Add(10 * time.Minute)}},
+	}}
+	evaluator := servicers.NewRolloutEvaluator(srv, signals)
+
+	_, err := srv.CreateRollout(ctx, &upgrade_protos.CreateRolloutRequest{NetworkId: "network", RolloutId: "r1", Plan: getTestRolloutPlan()})
+	assert.NoError(t, err)
+
+	// Prod is upgraded but the promotion fails to be persisted
+	rolloutWrites := 0
+	ds.failWrite = func(table string, key string) error {
+		if table == "network_rollouts" {
+			rolloutWrites++
+			if rolloutWrites == 2 {
+				return errors.New("mock write error")
+			}
+		}
+		return nil
+	}
+	assert.Error(t, evaluator.EvaluateRollouts(start.Add(11*time.Minute)))
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"prod": {Name: "prod", Version: "1.1.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "fred", Order: 111}}},
+	})
+
+	// The retry keeps the version prod had before the rollout
+	assert.NoError(t, evaluator.EvaluateRollouts(start.Add(12*time.Minute)))
+	rollout := getRollout(t, srv, "r1")
+	assert.Equal(t, uint32(1), rollout.Status.CurrentTierIndex)
+	assert.Equal(t, map[string]string{"canary": "1.0.0-0", "prod": "0.9.0-0"}, rollout.Status.PreviousVersions)
+
+	_, err = srv.AbortRollout(ctx, &upgrade_protos.AbortRolloutRequest{NetworkId: "network", RolloutId: "r1"})
+	assert.NoError(t, err)
+	assertDatastoreHasTierVersioningRows(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"prod": {Name: "prod", Version: "0.9.0-0", Images: []*upgrade_protos.ImageSpec{{Name: "fred", Order: 111}}},
+	})
+}
+
+func getRollout(t *testing.T, srv *servicers.UpgradeService, rolloutID string) *upgrade_protos.Rollout {
+	rollout, err := srv.GetRollout(context.Background(), &upgrade_protos.GetRolloutRequest{NetworkId: "network", RolloutId: rolloutID})
+	assert.NoError(t, err)
+	return rollout
+}
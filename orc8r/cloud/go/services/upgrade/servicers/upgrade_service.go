@@ -18,6 +18,11 @@ LICENSE file in the root directory of this source tree.
 //	A per-network table that maps a tier to its model. Tiers are a way to
 //	partition a network into groups of gateways which can be targeted to
 //	update to a specific version in order to implement a rolling upgrade.
+// 3. rollout => Rollout
+//	A per-network table that maps a rollout ID to the rollout's plan and
+//	status. Rollouts move tiers to a new version one tier at a time.
+// 4. network => rollout ID
+//	A global table of the rollout in progress in each network.
 //
 // UpgradeService implements the UpgradeServiceServer interface defined in the
 // .go file generated by upgrade_service.proto. See .proto file for interface
@@ -27,6 +32,7 @@ package servicers
 import (
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer"
 	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
 
 	"github.com/golang/glog"
@@ -41,6 +47,13 @@ const (
 
 	// NetworkID-partitioned tables: tier string -> protos.TierInfo
 	TierTableName = "tierVersions"
+
+	// NetworkID-partitioned tables: rollout string -> protos.Rollout
+	RolloutTableName = "rollouts"
+
+	// Global table: networkID string -> rollout ID of the rollout in progress,
+	// empty if there is none. Only updated with conditional writes.
+	activeRolloutTableName = "activeRollouts"
 )
 
 type UpgradeService struct {
@@ -322,6 +335,7 @@ func (srv *UpgradeService) DeleteTier(
 		glog.Errorf("Error while deleting tier: %s", err)
 		return ret, status.Errorf(codes.Unavailable, "Error while deleting tier")
 	}
+	streamer.NotifyStreamUpdateAsync(streamer.MconfigStreamName, networkID)
 	return ret, nil
 }
 
@@ -351,5 +365,12 @@ func (srv *UpgradeService) putTier(networkID string, tierName string, tierInfo *
 	if err != nil {
 		return err
 	}
-	return srv.store.Put(getTierTableName(networkID), tierName, marshalledTier)
+	err = srv.store.Put(getTierTableName(networkID), tierName, marshalledTier)
+	if err != nil {
+		return err
+	}
+	// Tier versions and images feed into gateway mconfigs, so let persistent
+	// mconfig streams know about the change
+	streamer.NotifyStreamUpdateAsync(streamer.MconfigStreamName, networkID)
+	return nil
 }
@@ -10,15 +10,22 @@ package main
 
 import (
 	"log"
+	"time"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/upgrade"
+	"magma/orc8r/cloud/go/services/upgrade/health"
 	"magma/orc8r/cloud/go/services/upgrade/protos"
 	"magma/orc8r/cloud/go/services/upgrade/servicers"
 )
 
+const (
+	rolloutEvaluationIntervalKey     = "rolloutEvaluationIntervalSecs"
+	defaultRolloutEvaluationInterval = 30 * time.Second
+)
+
 func main() {
 	// Create the service
 	srv, err := service.NewOrchestratorService(orc8r.ModuleName, upgrade.ServiceName)
@@ -36,6 +43,16 @@ func main() {
 	servicer := servicers.NewUpgradeService(store)
 	protos.RegisterUpgradeServiceServer(srv.GrpcServer, servicer)
 
+	// Drive staged rollouts with checkind health signals
+	evaluationInterval := defaultRolloutEvaluationInterval
+	if srv.Config != nil {
+		if intervalSecs, err := srv.Config.GetIntParam(rolloutEvaluationIntervalKey); err == nil && intervalSecs > 0 {
+			evaluationInterval = time.Second * time.Duration(intervalSecs)
+		}
+	}
+	evaluator := servicers.NewRolloutEvaluator(servicer, health.NewCheckindSignalSource())
+	go evaluator.Run(evaluationInterval)
+
 	// Run the service
 	err = srv.Run()
 	if err != nil {
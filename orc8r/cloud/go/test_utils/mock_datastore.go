@@ -14,7 +14,7 @@ import (
 	"magma/orc8r/cloud/go/datastore"
 )
 
-type mockDatastoreTable map[string][]byte
+type mockDatastoreTable map[string]datastore.ValueWrapper
 
 // Datastore backed by a golang map
 type MockDatastore struct {
@@ -41,29 +41,39 @@ func NewMockDatastore() *MockDatastore {
 
 func (m *MockDatastore) initTable(table string) {
 	if _, ok := m.store[table]; !ok {
-		m.store[table] = make(map[string][]byte, 0)
+		m.store[table] = make(mockDatastoreTable, 0)
 	}
 }
 
+// write stores the value like SqlDb does: new records start at generation 0
+// and every update increments the generation.
+func (m *MockDatastore) write(table string, key string, value []byte) {
+	generation := uint64(0)
+	if existing, ok := m.store[table][key]; ok {
+		generation = existing.Generation + 1
+	}
+	m.store[table][key] = datastore.ValueWrapper{Value: value, Generation: generation}
+}
+
 func (m *MockDatastore) Put(table string, key string, value []byte) error {
 	m.initTable(table)
-	m.store[table][key] = value
+	m.write(table, key, value)
 	return nil
 }
 
 func (m *MockDatastore) PutMany(table string, valuesToPut map[string][]byte) (map[string]error, error) {
 	m.initTable(table)
 	for k, v := range valuesToPut {
-		m.store[table][k] = v
+		m.write(table, k, v)
 	}
 	return map[string]error{}, nil
 }
 
 func (m *MockDatastore) Get(table string, key string) ([]byte, uint64, error) {
 	m.initTable(table)
-	value, ok := m.store[table][key]
+	wrapper, ok := m.store[table][key]
 	if ok {
-		return value, 0, nil
+		return wrapper.Value, wrapper.Generation, nil
 	}
 	return nil, 0, datastore.ErrNotFound
 }
@@ -72,12 +82,9 @@ func (m *MockDatastore) GetMany(table string, keys []string) (map[string]datasto
 	m.initTable(table)
 	ret := make(map[string]datastore.ValueWrapper, len(keys))
 	for _, k := range keys {
-		val, ok := m.store[table][k]
+		wrapper, ok := m.store[table][k]
 		if ok {
-			ret[k] = datastore.ValueWrapper{
-				Value:      val,
-				Generation: 0,
-			}
+			ret[k] = wrapper
 		}
 	}
 	return ret, nil
@@ -98,6 +105,67 @@ func (m *MockDatastore) DeleteMany(table string, keys []string) (map[string]erro
 	return map[string]error{}, nil
 }
 
+func (m *MockDatastore) checkGeneration(table string, key string, generation uint64) error {
+	m.initTable(table)
+	existing, ok := m.store[table][key]
+	if !ok {
+		return datastore.ErrNotFound
+	}
+	if existing.Generation != generation {
+		return datastore.ErrGenerationConflict
+	}
+	return nil
+}
+
+func (m *MockDatastore) PutIfGeneration(table string, key string, value []byte, generation uint64) error {
+	if err := m.checkGeneration(table, key, generation); err != nil {
+		return err
+	}
+	m.write(table, key, value)
+	return nil
+}
+
+func (m *MockDatastore) PutManyIfGeneration(table string, valuesToPut map[string]datastore.ValueWrapper) (map[string]error, error) {
+	errs := map[string]error{}
+	for k, v := range valuesToPut {
+		if err := m.PutIfGeneration(table, k, v.Value, v.Generation); err != nil {
+			errs[k] = err
+		}
+	}
+	return errs, nil
+}
+
+func (m *MockDatastore) InsertMany(table string, valuesToPut map[string][]byte) (map[string]error, error) {
+	m.initTable(table)
+	errs := map[string]error{}
+	for k, v := range valuesToPut {
+		if _, ok := m.store[table][k]; ok {
+			errs[k] = datastore.ErrAlreadyExists
+			continue
+		}
+		m.write(table, k, v)
+	}
+	return errs, nil
+}
+
+func (m *MockDatastore) DeleteIfGeneration(table string, key string, generation uint64) error {
+	if err := m.checkGeneration(table, key, generation); err != nil {
+		return err
+	}
+	delete(m.store[table], key)
+	return nil
+}
+
+func (m *MockDatastore) DeleteManyIfGeneration(table string, generationsByKey map[string]uint64) (map[string]error, error) {
+	errs := map[string]error{}
+	for k, generation := range generationsByKey {
+		if err := m.DeleteIfGeneration(table, k, generation); err != nil {
+			errs[k] = err
+		}
+	}
+	return errs, nil
+}
+
 func (m *MockDatastore) ListKeys(table string) ([]string, error) {
 	m.initTable(table)
 	keys := make([]string, 0, len(m.store[table]))
@@ -107,6 +175,14 @@ func (m *MockDatastore) ListKeys(table string) ([]string, error) {
 	return keys, nil
 }
 
+func (m *MockDatastore) ListKeysPage(table string, criteria datastore.ListKeysCriteria) ([]string, string, error) {
+	keys, err := m.ListKeys(table)
+	if err != nil {
+		return nil, "", err
+	}
+	return datastore.PageKeys(keys, criteria)
+}
+
 func (m *MockDatastore) DeleteTable(table string) error {
 	m.initTable(table)
 	delete(m.store, table)
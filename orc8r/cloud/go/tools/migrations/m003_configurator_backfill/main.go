@@ -0,0 +1,36 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package main
+
+import (
+	"flag"
+	"log"
+
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/tools/migrations"
+	"magma/orc8r/cloud/go/tools/migrations/m003_configurator_backfill/migration"
+
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	flag.Parse()
+
+	// The edges between configurator entities are declared by the mconfig
+	// builders of the plugins
+	plugin.LoadAllPluginsFatalOnError(&plugin.DefaultOrchestratorPluginLoader{})
+
+	dbDriver := migrations.GetEnvWithDefault("SQL_DRIVER", "postgres")
+	dbSource := migrations.GetEnvWithDefault("DATABASE_SOURCE", "dbname=magma user=magma password=magma host=192.168.80.20")
+
+	err := migration.Migrate(dbDriver, dbSource)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// DB migration script to copy all configs of the config service into
+// configurator's storage. The config service only mirrors the configs it
+// writes, so configs written before that have to be backfilled for
+// configurator to serve gateway mconfigs. Configs whose mirroring failed are
+// resynced too, so the migration is safe to run again.
+package migration
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"magma/orc8r/cloud/go/services/config/servicers"
+	cfg_storage "magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/sql_utils"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/cloud/go/tools/migrations"
+
+	"github.com/golang/glog"
+)
+
+// Redeclare table names
+const NetworkTable = "networks"
+const ConfigTable = "configurations"
+
+func Migrate(dbDriver string, dbSource string) error {
+	db, err := sql_utils.Open(dbDriver, dbSource)
+	if err != nil {
+		return fmt.Errorf("Could not open DB connection: %s", err)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("Error opening transaction: %s", err)
+	}
+
+	glog.Error("Loading configs...")
+	configsByNetwork, err := GetAllConfigs(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("Error committing transaction: %s", err)
+	}
+
+	configuratorFactory := cfg_storage.NewSQLConfiguratorStorageFactory(db, &cfg_storage.DefaultIDGenerator{})
+	err = configuratorFactory.InitializeServiceStorage()
+	if err != nil {
+		return fmt.Errorf("Failed to initialize configurator storage: %s", err)
+	}
+	for _, networkId := range getSortedKeys(configsByNetwork) {
+		glog.Errorf("Backfilling configurator for network %s...", networkId)
+		err = servicers.BackfillConfigurator(configuratorFactory, networkId, configsByNetwork[networkId])
+		if err != nil {
+			return fmt.Errorf("Failed to backfill configurator for network %s: %s", networkId, err)
+		}
+	}
+	return nil
+}
+
+// GetAllConfigs returns the configs of every network, keyed by network ID
+// and then by type and key
+func GetAllConfigs(tx *sql.Tx) (map[string]map[storage.TypeAndKey][]byte, error) {
+	networkIds, err := migrations.GetAllKeysFromTable(tx, NetworkTable)
+	if err != nil {
+		return nil, fmt.Errorf("Could not load network IDs: %s", err)
+	}
+
+	ret := make(map[string]map[storage.TypeAndKey][]byte, len(networkIds))
+	for _, networkId := range networkIds {
+		configs, err := getNetworkConfigs(tx, networkId)
+		if err != nil {
+			return nil, fmt.Errorf("Could not load configs for network %s: %s", networkId, err)
+		}
+		ret[networkId] = configs
+	}
+	return ret, nil
+}
+
+func getNetworkConfigs(tx *sql.Tx, networkId string) (map[storage.TypeAndKey][]byte, error) {
+	ret := map[storage.TypeAndKey][]byte{}
+
+	// The config table of a network is only created on its first config
+	tableName := migrations.GetTableName(networkId, ConfigTable)
+	exists, err := migrations.DoesTableExist(tx, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking if table %s exists: %s", tableName, err)
+	}
+	if !exists {
+		return ret, nil
+	}
+
+	rows, err := tx.Query(fmt.Sprintf("SELECT type, key, value FROM %s", tableName))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var configType, key string
+		var value []byte
+		err = rows.Scan(&configType, &key, &value)
+		if err != nil {
+			return nil, err
+		}
+		ret[storage.TypeAndKey{Type: configType, Key: key}] = value
+	}
+	return ret, rows.Err()
+}
+
+func getSortedKeys(configsByNetwork map[string]map[storage.TypeAndKey][]byte) []string {
+	ret := make([]string, 0, len(configsByNetwork))
+	for networkId := range configsByNetwork {
+		ret = append(ret, networkId)
+	}
+	sort.Strings(ret)
+	return ret
+}
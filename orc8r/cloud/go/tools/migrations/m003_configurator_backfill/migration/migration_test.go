@@ -0,0 +1,58 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package migration_test
+
+import (
+	"testing"
+
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/cloud/go/tools/migrations/m003_configurator_backfill/migration"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestGetAllConfigs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error opening stub DB conn: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT key FROM networks").
+		WillReturnRows(sqlmock.NewRows([]string{"key"}).AddRow("network1").AddRow("network2"))
+	mock.ExpectQuery("SELECT EXISTS").WithArgs("network1_configurations").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("SELECT type, key, value FROM network1_configurations").
+		WillReturnRows(
+			sqlmock.NewRows([]string{"type", "key", "value"}).
+				AddRow("network_type", "network1", []byte("network_config")).
+				AddRow("gw", "gw1", []byte("gw_config")),
+		)
+	// network2 has never had a config written
+	mock.ExpectQuery("SELECT EXISTS").WithArgs("network2_configurations").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("Error opening stub DB tx: %s", err)
+	}
+	actual, err := migration.GetAllConfigs(tx)
+	assert.NoError(t, err)
+	expected := map[string]map[storage.TypeAndKey][]byte{
+		"network1": {
+			{Type: "network_type", Key: "network1"}: []byte("network_config"),
+			{Type: "gw", Key: "gw1"}:                []byte("gw_config"),
+		},
+		"network2": {},
+	}
+	assert.Equal(t, expected, actual)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
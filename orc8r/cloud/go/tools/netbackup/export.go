@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"magma/orc8r/cloud/go/backup"
+	"magma/orc8r/cloud/go/tools/commands"
+)
+
+var exportOutput string
+
+// Export command - writes an archive of a network to a file or stdout
+func init() {
+	cmd := CommandRegistry.Add(
+		"export",
+		"Export everything belonging to a network into an archive",
+		export)
+	f := cmd.Flags()
+	f.StringVar(&exportOutput, "o", "", "Archive file to write, stdout if not set")
+	f.Usage = func() {
+		fmt.Fprintf(os.Stderr, // std Usage() & PrintDefaults() use Stderr
+			"\tUsage: %s %s [OPTIONS] <Network ID>\n", os.Args[0], cmd.Name())
+		f.PrintDefaults()
+	}
+}
+
+func export(cmd *commands.Command, args []string) int {
+	f := cmd.Flags()
+	networkID := strings.TrimSpace(f.Arg(0))
+	if f.NArg() != 1 || len(networkID) == 0 {
+		f.Usage()
+		log.Fatalf("A single Network ID must be specified.")
+	}
+
+	archive, err := backup.Export(networkID)
+	if err != nil {
+		log.Fatalf("Error exporting network %s: %s", networkID, err)
+	}
+	marshaledArchive, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		log.Fatalf("Error marshaling archive: %s", err)
+	}
+	if len(exportOutput) == 0 {
+		fmt.Println(string(marshaledArchive))
+		return 0
+	}
+	// Archives contain subscriber keys, keep them private
+	if err := ioutil.WriteFile(exportOutput, marshaledArchive, 0600); err != nil {
+		log.Fatalf("Error writing archive to %s: %s", exportOutput, err)
+	}
+	fmt.Printf("Exported network %s to %s\n", networkID, exportOutput)
+	return 0
+}
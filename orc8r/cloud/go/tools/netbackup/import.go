@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"magma/orc8r/cloud/go/backup"
+	"magma/orc8r/cloud/go/tools/commands"
+)
+
+var importOpts backup.ImportOptions
+
+// Import command - recreates a network from an archive file
+func init() {
+	cmd := CommandRegistry.Add(
+		"import",
+		"Create a network from an archive",
+		importNetwork)
+	f := cmd.Flags()
+	f.StringVar(&importOpts.NetworkID, "network", "",
+		"ID of the network to create, the archived network ID if not set")
+	f.BoolVar(&importOpts.SkipGateways, "skip_gateways", false,
+		"Do not import gateways and their configs")
+	f.Usage = func() {
+		fmt.Fprintf(os.Stderr, // std Usage() & PrintDefaults() use Stderr
+			"\tUsage: %s %s [OPTIONS] <Archive File>\n", os.Args[0], cmd.Name())
+		f.PrintDefaults()
+	}
+}
+
+func importNetwork(cmd *commands.Command, args []string) int {
+	f := cmd.Flags()
+	archiveFile := strings.TrimSpace(f.Arg(0))
+	if f.NArg() != 1 || len(archiveFile) == 0 {
+		f.Usage()
+		log.Fatalf("A single archive file must be specified.")
+	}
+
+	marshaledArchive, err := ioutil.ReadFile(archiveFile)
+	if err != nil {
+		log.Fatalf("Error reading archive %s: %s", archiveFile, err)
+	}
+	archive := &backup.Archive{}
+	if err := json.Unmarshal(marshaledArchive, archive); err != nil {
+		log.Fatalf("Error unmarshaling archive %s: %s", archiveFile, err)
+	}
+	networkID, err := backup.Import(archive, importOpts)
+	if err != nil {
+		log.Fatalf("Error importing archive %s: %s", archiveFile, err)
+	}
+	fmt.Printf("Imported network %s from %s\n", networkID, archiveFile)
+	return 0
+}
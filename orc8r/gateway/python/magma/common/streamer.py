@@ -16,7 +16,7 @@ import abc
 import grpc
 import snowflake
 from google.protobuf import any_pb2
-from orc8r.protos.streamer_pb2 import StreamRequest
+from orc8r.protos.streamer_pb2 import StreamDigest, StreamRequest
 from orc8r.protos.streamer_pb2_grpc import StreamerStub
 
 from magma.common import serialization_utils
@@ -68,6 +68,60 @@ class StreamerClient(threading.Thread):
             """
             raise NotImplementedError()
 
+    class DeltaCallback(Callback):
+        """
+        Callback for streams which support incremental streaming. The digest
+        of the last batch applied is sent with every request, so the cloud
+        only sends the keys which changed or were deleted since then.
+        """
+
+        def __init__(self):
+            self._digests = {}
+
+        def get_request_args(self, stream_name: str) -> Any:
+            digest = self._digests.get(stream_name)
+            if not digest:
+                return None
+            return StreamDigest(digest=digest)
+
+        def process_update(self, stream_name, updates, resync):
+            self.process_delta_update(stream_name, updates, [], resync)
+
+        def process_batch(self, stream_name, update_batch):
+            """
+            Applies a batch and records its digest. The digest is forgotten
+            if the batch fails to apply, so the next request asks for a
+            resync.
+            """
+            try:
+                self.process_delta_update(
+                    stream_name,
+                    update_batch.updates,
+                    update_batch.deleted_keys,
+                    update_batch.resync,
+                )
+            except Exception:
+                self._digests.pop(stream_name, None)
+                raise
+            self._digests[stream_name] = update_batch.digest
+
+        @abc.abstractmethod
+        def process_delta_update(self, stream_name, updates, deleted_keys,
+                                 resync):
+            """
+            Called when we get an update from the cloud. This method will
+            be called in the event loop provided to the StreamerClient.
+
+            Args:
+                stream_name (string): Name of the stream
+                updates (protos.DataUpdate[]): Array of updated keys, or of
+                    all the keys if resync is true
+                deleted_keys (string[]): Keys deleted since the last batch
+                resync (boolean): if true, the application should replace
+                    its contents with the updates
+            """
+            raise NotImplementedError()
+
     def __init__(self, stream_callbacks, loop):
         """
         Args:
@@ -88,17 +142,33 @@ class StreamerClient(threading.Thread):
             'streamer', 'reconnect_sec', 60)
         self._reconnect_pause = max(5, self._reconnect_pause)
         logging.info("Streamer reconnect pause: %d", self._reconnect_pause)
+        # Streams stay open, with a keepalive from the cloud every 30 seconds.
+        # A stream which stays silent for longer than this is cancelled.
         self._stream_timeout = get_service_config_value(
             'streamer', 'stream_timeout', 150)
         logging.info("Streamer timeout: %d", self._stream_timeout)
 
     def run(self):
+        # Each stream stays open, so every stream gets its own thread
+        threads = []
+        for stream_name, callback in self._stream_callbacks.items():
+            thread = threading.Thread(
+                target=self._process_stream_forever,
+                args=(stream_name, callback),
+                daemon=True,
+            )
+            thread.start()
+            threads.append(thread)
+        for thread in threads:
+            thread.join()
+
+    def _process_stream_forever(self, stream_name, callback):
         while True:
             try:
                 channel = ServiceRegistry.get_rpc_channel(
                         'streamer', ServiceRegistry.CLOUD)
                 client = StreamerStub(channel)
-                self.process_all_streams(client)
+                self.process_stream(client, stream_name, callback)
             except Exception as exp:  # pylint: disable=broad-except
                 logging.error("Error with streamer: %s", exp)
 
@@ -107,34 +177,57 @@ class StreamerClient(threading.Thread):
             # TODO: make this more intelligent (exponential backoffs, etc.)
             time.sleep(self._reconnect_pause)
 
-    def process_all_streams(self, client):
-        for stream_name, callback in self._stream_callbacks.items():
-            try:
-                self.process_stream_updates(client, stream_name, callback)
-
-                STREAMER_RESPONSES.labels(result='Success').inc()
-            except grpc.RpcError as err:
-                logging.error(
-                    "Error! Streaming from the cloud failed! [%s] %s",
-                    err.code(), err.details())
-                STREAMER_RESPONSES.labels(result='RpcError').inc()
-            except ValueError as err:
-                logging.error("Error! Streaming from cloud failed! %s", err)
-                STREAMER_RESPONSES.labels(result='ValueError').inc()
+    def process_stream(self, client, stream_name, callback):
+        try:
+            self.process_stream_updates(client, stream_name, callback)
+
+            STREAMER_RESPONSES.labels(result='Success').inc()
+        except grpc.RpcError as err:
+            logging.error(
+                "Error! Streaming from the cloud failed! [%s] %s",
+                err.code(), err.details())
+            STREAMER_RESPONSES.labels(result='RpcError').inc()
+        except ValueError as err:
+            logging.error("Error! Streaming from cloud failed! %s", err)
+            STREAMER_RESPONSES.labels(result='ValueError').inc()
 
     def process_stream_updates(self, client, stream_name, callback):
         extra_args = self._get_extra_args_any(callback, stream_name)
         request = StreamRequest(gatewayId=snowflake.snowflake(),
                                 stream_name=stream_name,
-                                extra_args=extra_args)
-        for update_batch in client.GetUpdates(
-                request, timeout=self._stream_timeout):
-            self._loop.call_soon_threadsafe(
-                callback.process_update,
-                stream_name,
-                update_batch.updates,
-                update_batch.resync,
-            )
+                                extra_args=extra_args,
+                                persistent=True)
+        # The stream has no deadline. Instead, a watchdog restarted by every
+        # batch, keepalives included, cancels it once it goes silent.
+        responses = client.GetUpdates(request)
+        watchdog = None
+        try:
+            for update_batch in responses:
+                if watchdog is not None:
+                    watchdog.cancel()
+                watchdog = threading.Timer(
+                    self._stream_timeout, responses.cancel)
+                watchdog.daemon = True
+                watchdog.start()
+
+                if _is_keepalive(update_batch):
+                    continue
+                if isinstance(callback, StreamerClient.DeltaCallback):
+                    self._loop.call_soon_threadsafe(
+                        callback.process_batch,
+                        stream_name,
+                        update_batch,
+                    )
+                    continue
+                self._loop.call_soon_threadsafe(
+                    callback.process_update,
+                    stream_name,
+                    update_batch.updates,
+                    update_batch.resync,
+                )
+        finally:
+            if watchdog is not None:
+                watchdog.cancel()
 
     @staticmethod
     def _get_extra_args_any(callback, stream_name):
@@ -147,6 +240,15 @@ class StreamerClient(threading.Thread):
             return extra_any
 
 
+def _is_keepalive(update_batch):
+    """
+    Keepalives are batches with no updates and no deleted keys which don't
+    ask for a resync. They leave the stream's contents and digest unchanged.
+    """
+    return not (update_batch.resync or update_batch.updates or
+                update_batch.deleted_keys)
+
+
 def get_stream_serialize_filename(stream_name):
     return '/var/opt/magma/streams/{}'.format(stream_name)
 
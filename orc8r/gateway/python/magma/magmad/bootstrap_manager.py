@@ -57,11 +57,14 @@ class BootstrapManager(SDWatchdogTask):
     verify the device. As a result of the bootstrap process, the
     gateways' session certs would be written to /var/opt/magma/certs.
     Before the session certs expire, bootstrap would make sure we
-    fetch new certs by maintaining a timer internally.
+    fetch new certs by maintaining a timer internally. New certs are first
+    requested by renewing the current ones, and only bootstrapped from
+    scratch when the renewal fails close to expiry.
     """
     # delay in asyncio should not exceed one day
     PERIODIC_BOOTSTRAP_CHECK_INTERVAL = datetime.timedelta(hours=1)
     PREEXPIRY_BOOTSTRAP_INTERVAL = datetime.timedelta(hours=20)
+    PREEXPIRY_RENEWAL_INTERVAL = datetime.timedelta(days=2)
     SHORT_BOOTSTRAP_RETRY_INTERVAL = datetime.timedelta(seconds=30)
     LONG_BOOTSTRAP_RETRY_INTERVAL = datetime.timedelta(minutes=1)
 
@@ -101,7 +104,7 @@ class BootstrapManager(SDWatchdogTask):
         elif self._state == BootstrapState.SCHEDULED_BOOTSTRAP:
             await self._bootstrap_now()
         elif self._state == BootstrapState.SCHEDULED_CHECK:
-            await self._bootstrap_now()
+            await self._bootstrap_check()
         elif self._state == BootstrapState.IDLE:
             pass
 
@@ -158,8 +161,9 @@ class BootstrapManager(SDWatchdogTask):
         """Check whether bootstrap is need
 
         Check whether cert is present and still valid
-        If so, a future _bootstrap_check will be scheduled.
-        Otherwise _bootstrap_now will be called immediately
+        If it is expiring soon, try to renew it with _renew_now first.
+        If the cert is valid or renewed, a future _bootstrap_check will be
+        scheduled. Otherwise _bootstrap_now will be called immediately
         """
         # flag to ensure the loop is still running, successfully or not
         self.heartbeat()
@@ -172,6 +176,13 @@ class BootstrapManager(SDWatchdogTask):
             return
 
         now = datetime.datetime.utcnow()
+        if cert.not_valid_before <= now < cert.not_valid_after and \
+                now + self.PREEXPIRY_RENEWAL_INTERVAL > cert.not_valid_after:
+            logging.info(
+                'Certificate is expiring at %s, start renewal',
+                cert.not_valid_after)
+            if await self._renew_now():
+                return
         if now + self.PREEXPIRY_BOOTSTRAP_INTERVAL > cert.not_valid_after:
             logging.info(
                 'Certificate is expiring soon at %s, start bootstrapping',
@@ -188,6 +199,66 @@ class BootstrapManager(SDWatchdogTask):
         await self._bootstrap_success_cb(False)
         self._schedule_next_bootstrap_check()
 
+    async def _renew_now(self):
+        """Renew the cert using the current, still valid one
+
+        Unlike _bootstrap_now, no challenge is needed since the call goes
+        through the control proxy, which authenticates with the current cert.
+        On success the new cert is written and a future _bootstrap_check is
+        scheduled.
+
+        Returns:
+            True if the cert was renewed, False otherwise
+        """
+        try:
+            self._gateway_key = ec.generate_private_key(
+                ec.SECP384R1(), default_backend())
+            csr = self._create_csr()
+        except Exception as exp:
+            logging.error('Fail to create renewal csr: %s', exp)
+            BOOTSTRAP_EXCEPTION.labels(
+                cause='RenewCreateCSR:%s' % type(exp).__name__).inc()
+            return False
+
+        try:
+            chan = ServiceRegistry.get_rpc_channel(
+                'bootstrapper', ServiceRegistry.CLOUD)
+        except ValueError as exp:
+            logging.error('Failed to get rpc channel: %s', exp)
+            BOOTSTRAP_EXCEPTION.labels(cause='RenewGetRPC').inc()
+            return False
+
+        client = BootstrapperStub(chan)
+        try:
+            cert = await grpc_async_wrapper(
+                client.RenewCertificate.future(csr),
+                self._loop
+            )
+        except grpc.RpcError as err:
+            logging.error(
+                'RenewCertificate error! [%s] %s', err.code(), err.details())
+            BOOTSTRAP_EXCEPTION.labels(cause='RenewCertificateResp').inc()
+            return False
+
+        if not self._is_valid_certificate(cert):
+            BOOTSTRAP_EXCEPTION.labels(cause='RenewInvalidCert').inc()
+            return False
+        try:
+            cert_utils.write_key(self._gateway_key, self._gateway_key_file)
+            cert_utils.write_cert(cert.cert_der, self._gateway_cert_file)
+        except Exception as exp:
+            BOOTSTRAP_EXCEPTION.labels(
+                cause='RenewWriteCert:%s' % type(exp).__name__).inc()
+            logging.error('Failed to write cert: %s', exp)
+            return False
+
+        # need to restart control_proxy
+        await self._bootstrap_success_cb(True)
+        self._gateway_key = None
+        self._schedule_next_bootstrap_check()
+        logging.info("Renewed certificate successfully!")
+        return True
+
     async def _bootstrap_now(self):
         """Main entrance to bootstrapping
 
@@ -67,6 +67,9 @@ class DummpyBootstrapperServer(bootstrapper_pb2_grpc.BootstrapperServicer):
     def RequestSign(self, request, context):
         return create_cert_message()
 
+    def RenewCertificate(self, request, context):
+        return create_cert_message()
+
 
 class BootstrapManagerTest(TestCase):
     @patch('magma.common.cert_utils.write_key')
@@ -159,15 +162,19 @@ class BootstrapManagerTest(TestCase):
         self.loop.run_until_complete(test())
 
     @patch('magma.common.cert_utils.load_cert')
+    @patch('%s.BootstrapManager._renew_now' % BM)
     @patch('%s.BootstrapManager._bootstrap_now' % BM)
     @patch('%s.BootstrapManager._schedule_next_bootstrap_check' % BM)
     def test__bootstrap_check(self,
                               schedule_bootstrap_check_mock,
                               bootstrap_now_mock,
+                              renew_now_mock,
                               load_cert_mock):
         async def test():
             make_awaitable(self.manager._bootstrap_now)
             make_awaitable(self.manager._bootstrap_success_cb)
+            # renewal fails
+            make_awaitable(self.manager._renew_now)
 
             # cannot load cert
             load_cert_mock.side_effect = IOError
@@ -191,8 +198,19 @@ class BootstrapManagerTest(TestCase):
             not_after = not_before + datetime.timedelta(hours=1)
             load_cert_mock.return_value = create_cert(not_before, not_after)
             await self.manager._bootstrap_check()
+            renew_now_mock.assert_has_calls([call()])
             bootstrap_now_mock.assert_has_calls([call()])
 
+            # expiring cert is renewed
+            bootstrap_now_mock.reset_mock()
+            renew_now_mock.reset_mock()
+            future = asyncio.Future()
+            future.set_result(True)
+            renew_now_mock.return_value = future
+            await self.manager._bootstrap_check()
+            renew_now_mock.assert_has_calls([call()])
+            bootstrap_now_mock.assert_not_called()
+
             # cert is present and valid,
             load_cert_mock.reset_mock()
             not_before = datetime.datetime.utcnow()
@@ -234,6 +252,39 @@ class BootstrapManagerTest(TestCase):
         self.manager._task.cancel()
         self.loop.run_until_complete(test())
 
+    @patch('%s.BootstrapManager._schedule_next_bootstrap_check' % BM)
+    @patch('%s.ServiceRegistry.get_rpc_channel' % BM)
+    @patch('%s.cert_utils.write_cert' % BM)
+    @patch('magma.common.cert_utils.write_key')
+    def test__renew_now(self,
+                        write_key_mock,
+                        write_cert_mock,
+                        rpc_channel_mock,
+                        schedule_mock):
+        async def test():
+            make_awaitable(self.manager._bootstrap_success_cb)
+
+            # fail to get channel
+            rpc_channel_mock.side_effect = ValueError
+            self.assertFalse(await self.manager._renew_now())
+            write_cert_mock.assert_not_called()
+
+            rpc_channel_mock.side_effect = None
+            rpc_channel_mock.return_value = self.channel
+            self.assertTrue(await self.manager._renew_now())
+            rpc_channel_mock.assert_has_calls(
+                [call('bootstrapper', bm.ServiceRegistry.CLOUD)])
+            write_key_mock.assert_has_calls(
+                [call(ANY, self.manager._gateway_key_file)])
+            write_cert_mock.assert_has_calls(
+                [call(ANY, self.manager._gateway_cert_file)])
+            self.manager._bootstrap_success_cb.assert_has_calls([call(True)])
+            schedule_mock.assert_has_calls([call()])
+
+        # Cancel the loop so that there's no periodic bootstrap/bootstrap_check
+        self.manager._task.cancel()
+        self.loop.run_until_complete(test())
+
     @patch('%s.BootstrapManager._schedule_next_bootstrap' % BM)
     @patch('%s.ServiceRegistry.get_bootstrap_rpc_channel' % BM)
     def test__bootstrap_fail(self,
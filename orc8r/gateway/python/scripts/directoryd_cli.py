@@ -15,11 +15,15 @@ import sys
 import grpc
 from grpc import StatusCode
 from magma.common.rpc_utils import grpc_wrapper
-from orc8r.protos.directoryd_pb2 import GetLocationRequest, \
-    UpdateDirectoryLocationRequest
+from orc8r.protos.directoryd_pb2 import GetIDsByLocationRequest, \
+    GetLocationRequest, UpdateDirectoryLocationRequest
 from orc8r.protos.directoryd_pb2_grpc import DirectoryServiceStub
 
 
+TABLE_HELP = 'table ID. 0 = maps IMSI to HwId; 1 = maps HwId to HostName; ' \
+             '2 = maps UE IP to IMSI'
+
+
 @grpc_wrapper
 def get_location_handler(client, args):
     get_location_request_msg = GetLocationRequest()
@@ -41,9 +45,20 @@ def update_location_handler(client, args):
     update_location_request_msg.table = int(args.table)
     update_location_request_msg.id = args.object_id
     update_location_request_msg.record.location = args.location
+    update_location_request_msg.ttl_secs = args.ttl_secs
     client.UpdateLocation(update_location_request_msg)
     print("Location Updated: %s => %s" % (args.object_id, args.location))
 
+
+@grpc_wrapper
+def get_ids_handler(client, args):
+    get_ids_request_msg = GetIDsByLocationRequest()
+    get_ids_request_msg.table = int(args.table)
+    get_ids_request_msg.location = args.location
+    location_ids_msg = client.GetIDsByLocation(get_ids_request_msg)
+    for object_id in location_ids_msg.ids:
+        print("%s => %s" % (object_id, args.location))
+
 @grpc_wrapper
 def delete_location_handler(client, args):
     delete_location_request_msg = GetLocationRequest()
@@ -71,7 +86,7 @@ def main():
     subparser = subparsers.add_parser(
         'get_location', help='Get location of an object')
     subparser.add_argument('table',
-        help='table ID. 0 = maps IMSI to HwId; 1 = maps HwId to HostName')
+        help=TABLE_HELP)
     subparser.add_argument('object_id', help='ID of the object')
     subparser.set_defaults(func=get_location_handler)
 
@@ -79,16 +94,25 @@ def main():
     subparser = subparsers.add_parser(
         'update_location', help='Get location of an object')
     subparser.add_argument('table',
-        help='table ID. 0 = maps IMSI to HwId; 1 = maps HwId to HostName')
+        help=TABLE_HELP)
     subparser.add_argument('object_id', help='ID of the object')
     subparser.add_argument('location', help='Location of an object')
+    subparser.add_argument('--ttl_secs', type=int, default=0,
+        help='Seconds until the record expires. 0 = default TTL of the table')
     subparser.set_defaults(func=update_location_handler)
 
+    # get_ids
+    subparser = subparsers.add_parser(
+        'get_ids', help='Get IDs of all objects at a location')
+    subparser.add_argument('table', help=TABLE_HELP)
+    subparser.add_argument('location', help='Location of the objects')
+    subparser.set_defaults(func=get_ids_handler)
+
     # get_location
     subparser = subparsers.add_parser(
         'delete_location', help='Delete location of an object')
     subparser.add_argument('table',
-        help='table ID. 0 = maps IMSI to HwId; 1 = maps HwId to HostName')
+        help=TABLE_HELP)
     subparser.add_argument('object_id', help='ID of the object')
     subparser.set_defaults(func=delete_location_handler)
 
@@ -22,6 +22,9 @@ from generate_service_config import generate_template_config
 
 CONFIG_OVERRIDE_DIR = '/var/opt/magma/tmp'
 
+# Characters which would end a dnsmasq config value or line
+UNSAFE_NAME_CHARS = frozenset(',\r\n\t ')
+
 
 def _get_addresses(cfg, mconfig):
     """
@@ -39,12 +42,85 @@ def _get_addresses(cfg, mconfig):
             *record.aaaa_record,
             #  *record.cname_record, TODO: Figure out how to repr CNAME
         ]
+        if not _is_safe_name(record.domain):
+            continue
         for ip in domain_records:
             addresses.append({'domain': record.domain, 'ip': ip})
 
     return addresses
 
 
+def _is_safe_name(name):
+    """
+    Return whether a domain name can be written into the dnsmasq config
+    without ending the value or line. The cloud validates domain names, this
+    guards the config against names which slipped past it.
+    """
+    if any(c in UNSAFE_NAME_CHARS or not c.isprintable() for c in name):
+        logging.error("Skipping DNS record with invalid name %r", name)
+        return False
+    return True
+
+
+def _quote_txt(text):
+    """
+    Quote a TXT record string for dnsmasq, escaping quotes and backslashes.
+    Commas are kept inside the quotes. Returns None for text with control
+    characters such as line breaks, which can't be quoted.
+    """
+    if not text.isprintable():
+        logging.error("Skipping TXT record with control characters %r", text)
+        return None
+    return '"%s"' % text.replace('\\', '\\\\').replace('"', '\\"')
+
+
+def _get_records(mconfig):
+    """
+    Return the SRV, PTR, TXT and MX records of the mconfig as dicts of the
+    fields used in the dnsmasq config template.
+
+    EG: {'srv': [{'domain': '_sip._udp.example.com', 'target': 'ims.example.com',
+                  'port': 5060, 'priority': 10, 'weight': 60}],
+         'ptr': [{'domain': '1.0.168.192.in-addr.arpa',
+                  'target': 'ims.example.com'}],
+         'txt': [{'domain': 'example.com', 'text': '"v=spf1 -all"'}],
+         'mx': [{'domain': 'example.com', 'exchange': 'mail.example.com',
+                 'preference': 10}]}
+    """
+    records = {'srv': [], 'ptr': [], 'txt': [], 'mx': []}
+    for record in mconfig.records:
+        if not _is_safe_name(record.domain):
+            continue
+        for srv in record.srv_record:
+            if not _is_safe_name(srv.target):
+                continue
+            records['srv'].append({
+                'domain': record.domain,
+                'target': srv.target,
+                'port': srv.port,
+                'priority': srv.priority,
+                'weight': srv.weight,
+            })
+        for target in record.ptr_record:
+            if not _is_safe_name(target):
+                continue
+            records['ptr'].append({'domain': record.domain, 'target': target})
+        for text in record.txt_record:
+            quoted = _quote_txt(text)
+            if quoted is None:
+                continue
+            records['txt'].append({'domain': record.domain, 'text': quoted})
+        for mx in record.mx_record:
+            if not _is_safe_name(mx.exchange):
+                continue
+            records['mx'].append({
+                'domain': record.domain,
+                'exchange': mx.exchange,
+                'preference': mx.preference,
+            })
+    return records
+
+
 def get_context():
     """
     Provide context to pass to Jinja2 for templating.
@@ -70,6 +146,7 @@ def get_context():
             %d addresses." % (dhcp_block_size))
 
     context['addresses'] = _get_addresses(cfg, mconfig)
+    context['records'] = _get_records(mconfig)
     return context
 
 